@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ApplicationNotHealthyError is returned when an application has no running
+// instances or has crashed or flapping instances.
+type ApplicationNotHealthyError struct {
+	Name string
+}
+
+func (e ApplicationNotHealthyError) Error() string {
+	return fmt.Sprintf("Application '%s' does not have all instances running", e.Name)
+}
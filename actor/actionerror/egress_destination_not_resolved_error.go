@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// EgressDestinationNotResolvedError is returned when the destination of an
+// egress check is neither an IP address nor a hostname that resolves to one.
+type EgressDestinationNotResolvedError struct {
+	Destination string
+}
+
+func (e EgressDestinationNotResolvedError) Error() string {
+	return fmt.Sprintf("Unable to resolve destination %s", e.Destination)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidPluginKeyError is returned when a plugin key is not a base64-encoded
+// Ed25519 public key.
+type InvalidPluginKeyError struct {
+	Name string
+}
+
+func (e InvalidPluginKeyError) Error() string {
+	return fmt.Sprintf("Plugin key %s is not a base64-encoded Ed25519 public key", e.Name)
+}
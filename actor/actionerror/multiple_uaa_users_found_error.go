@@ -0,0 +1,17 @@
+package actionerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MultipleUAAUsersFoundError is returned when a username matches users from
+// more than one origin in the UAA.
+type MultipleUAAUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (e MultipleUAAUsersFoundError) Error() string {
+	return fmt.Sprintf("User '%s' exists in multiple origins: %s", e.Username, strings.Join(e.Origins, ", "))
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginKeyNameTakenError is returned when adding a plugin key with the name
+// of an existing key.
+type PluginKeyNameTakenError struct {
+	Name string
+}
+
+func (e PluginKeyNameTakenError) Error() string {
+	return fmt.Sprintf("Plugin key named '%s' already exists, please use another name.", e.Name)
+}
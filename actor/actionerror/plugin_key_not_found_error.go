@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginKeyNotFoundError is returned when a trusted plugin key does not
+// exist.
+type PluginKeyNotFoundError struct {
+	Name string
+}
+
+func (e PluginKeyNotFoundError) Error() string {
+	return fmt.Sprintf("Plugin key %s not found", e.Name)
+}
@@ -0,0 +1,8 @@
+package actionerror
+
+// PluginNotSignedError is returned when a plugin binary has no signature.
+type PluginNotSignedError struct{}
+
+func (PluginNotSignedError) Error() string {
+	return "plugin binary is not signed"
+}
@@ -0,0 +1,9 @@
+package actionerror
+
+// PluginSignatureInvalidError is returned when a plugin binary's signature
+// cannot be verified with any of the trusted plugin keys.
+type PluginSignatureInvalidError struct{}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "plugin binary signature does not match any trusted plugin key"
+}
@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// PluginVersionNotFoundError is an error returned when the plugin repositories
+// list a plugin, but not the requested version of it.
+type PluginVersionNotFoundError struct {
+	PluginName string
+	Version    string
+}
+
+// Error outputs that the plugin version cannot be found in any repositories.
+func (e PluginVersionNotFoundError) Error() string {
+	return fmt.Sprintf("Plugin %s %s not found in any registered repo", e.PluginName, e.Version)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+// RouteSwapError is returned when routes could not be moved from one
+// application to another. UnrevertedRoutes lists the routes whose mappings
+// could not be restored to their original state.
+type RouteSwapError struct {
+	Err              error
+	UnrevertedRoutes []string
+}
+
+func (RouteSwapError) Error() string {
+	return "route swap failed"
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// RouteSwapSameApplicationError is returned when the routes of an
+// application are swapped with the application itself.
+type RouteSwapSameApplicationError struct {
+	Name string
+}
+
+func (e RouteSwapSameApplicationError) Error() string {
+	return fmt.Sprintf("Cannot swap the routes of application '%s' with itself", e.Name)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// SecurityGroupNameTakenError is returned when creating a security group fails
+// because a security group with that name already exists.
+type SecurityGroupNameTakenError struct {
+	Name string
+}
+
+func (e SecurityGroupNameTakenError) Error() string {
+	return fmt.Sprintf("Security group %s already exists", e.Name)
+}
@@ -0,0 +1,12 @@
+package actionerror
+
+import "fmt"
+
+// UAAGroupNotFoundError is returned when a group is not found in the UAA.
+type UAAGroupNotFoundError struct {
+	Name string
+}
+
+func (e UAAGroupNotFoundError) Error() string {
+	return fmt.Sprintf("Group '%s' not found.", e.Name)
+}
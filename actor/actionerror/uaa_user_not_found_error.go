@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// UAAUserNotFoundError is returned when a user is not found in the UAA.
+type UAAUserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (e UAAUserNotFoundError) Error() string {
+	return fmt.Sprintf("User '%s' not found.", e.Username)
+}
@@ -3,26 +3,32 @@ package cfnetworkingaction
 import (
 	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
 
 type Policy struct {
-	SourceName      string
-	DestinationName string
-	Protocol        string
-	StartPort       int
-	EndPort         int
+	SourceName           string
+	DestinationName      string
+	Protocol             string
+	StartPort            int
+	EndPort              int
+	DestinationSpaceName string
+	DestinationOrgName   string
 }
 
-func (actor Actor) AddNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+// AddNetworkPolicy allows the source app to connect to the destination app,
+// which can be in another space or org than the source app.
+func (actor Actor) AddNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
 	var allWarnings Warnings
 
-	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
+	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, srcSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
 	}
 
-	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, spaceGUID)
+	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, destSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
@@ -46,6 +52,8 @@ func (actor Actor) AddNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol
 	return allWarnings, err
 }
 
+// NetworkPoliciesBySpace returns the policies of the apps in the space,
+// including those that allow them to connect to apps in other spaces.
 func (actor Actor) NetworkPoliciesBySpace(spaceGUID string) ([]Policy, Warnings, error) {
 	var allWarnings Warnings
 
@@ -61,18 +69,10 @@ func (actor Actor) NetworkPoliciesBySpace(spaceGUID string) ([]Policy, Warnings,
 		return []Policy{}, allWarnings, err
 	}
 
-	appNameByGuid := map[string]string{}
-	for _, app := range applications {
-		appNameByGuid[app.GUID] = app.Name
-	}
-
-	var policies []Policy
-	emptyPolicy := Policy{}
-	for _, v1Policy := range v1Policies {
-		policy := actor.transformPolicy(appNameByGuid, v1Policy)
-		if policy != emptyPolicy {
-			policies = append(policies, policy)
-		}
+	policies, transformWarnings, err := actor.transformPolicies(spaceGUID, applications, v1Policies)
+	allWarnings = append(allWarnings, transformWarnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
 	}
 
 	return policies, allWarnings, nil
@@ -88,11 +88,6 @@ func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName
 		return []Policy{}, allWarnings, err
 	}
 
-	appNameByGuid := map[string]string{}
-	for _, app := range applications {
-		appNameByGuid[app.GUID] = app.Name
-	}
-
 	var v1Policies []cfnetv1.Policy
 
 	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
@@ -107,30 +102,35 @@ func (actor Actor) NetworkPoliciesBySpaceAndAppName(spaceGUID string, srcAppName
 		return []Policy{}, allWarnings, err
 	}
 
-	var policies []Policy
-	emptyPolicy := Policy{}
+	var srcPolicies []cfnetv1.Policy
 	for _, v1Policy := range v1Policies {
 		if v1Policy.Source.ID == appGUID {
-			policy := actor.transformPolicy(appNameByGuid, v1Policy)
-			if policy != emptyPolicy {
-				policies = append(policies, policy)
-			}
+			srcPolicies = append(srcPolicies, v1Policy)
 		}
 	}
 
+	policies, transformWarnings, err := actor.transformPolicies(spaceGUID, applications, srcPolicies)
+	allWarnings = append(allWarnings, transformWarnings...)
+	if err != nil {
+		return []Policy{}, allWarnings, err
+	}
+
 	return policies, allWarnings, nil
 }
 
-func (actor Actor) RemoveNetworkPolicy(spaceGUID, srcAppName, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
+// RemoveNetworkPolicy removes the policy that allows the source app to
+// connect to the destination app, which can be in another space or org than
+// the source app.
+func (actor Actor) RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol string, startPort, endPort int) (Warnings, error) {
 	var allWarnings Warnings
 
-	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, spaceGUID)
+	srcApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(srcAppName, srcSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
 	}
 
-	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, spaceGUID)
+	destApp, warnings, err := actor.V3Actor.GetApplicationByNameAndSpace(destAppName, destSpaceGUID)
 	allWarnings = append(allWarnings, Warnings(warnings)...)
 	if err != nil {
 		return allWarnings, err
@@ -164,17 +164,123 @@ func (actor Actor) RemoveNetworkPolicy(spaceGUID, srcAppName, destAppName, proto
 	return allWarnings, actionerror.PolicyDoesNotExistError{}
 }
 
-func (Actor) transformPolicy(appNameByGuid map[string]string, v1Policy cfnetv1.Policy) Policy {
-	srcName, srcOk := appNameByGuid[v1Policy.Source.ID]
-	dstName, dstOk := appNameByGuid[v1Policy.Destination.ID]
-	if srcOk && dstOk {
-		return Policy{
-			SourceName:      srcName,
-			DestinationName: dstName,
-			Protocol:        string(v1Policy.Destination.Protocol),
-			StartPort:       v1Policy.Destination.Ports.Start,
-			EndPort:         v1Policy.Destination.Ports.End,
+// transformPolicies converts the policies whose source app is in the space.
+// Destination apps outside of the space are looked up along with their spaces
+// and orgs. Policies whose apps cannot be found are omitted.
+func (actor Actor) transformPolicies(spaceGUID string, spaceApps []v3action.Application, v1Policies []cfnetv1.Policy) ([]Policy, Warnings, error) {
+	resolved, warnings, err := actor.resolvePolicies(spaceGUID, spaceApps, v1Policies)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var policies []Policy
+	for _, policy := range resolved {
+		policies = append(policies, policy.Policy)
+	}
+	return policies, warnings, nil
+}
+
+// resolvedPolicy is a policy along with the networking policy it was
+// converted from.
+type resolvedPolicy struct {
+	Policy
+	v1Policy cfnetv1.Policy
+}
+
+func (actor Actor) resolvePolicies(spaceGUID string, spaceApps []v3action.Application, v1Policies []cfnetv1.Policy) ([]resolvedPolicy, Warnings, error) {
+	var allWarnings Warnings
+
+	appsByGUID := map[string]v3action.Application{}
+	for _, app := range spaceApps {
+		app.SpaceGUID = spaceGUID
+		appsByGUID[app.GUID] = app
+	}
+
+	var srcPolicies []cfnetv1.Policy
+	var otherAppGUIDs []string
+	seenAppGUIDs := map[string]bool{}
+	for _, v1Policy := range v1Policies {
+		if _, ok := appsByGUID[v1Policy.Source.ID]; !ok {
+			continue
+		}
+		srcPolicies = append(srcPolicies, v1Policy)
+
+		destGUID := v1Policy.Destination.ID
+		if _, ok := appsByGUID[destGUID]; !ok && !seenAppGUIDs[destGUID] {
+			seenAppGUIDs[destGUID] = true
+			otherAppGUIDs = append(otherAppGUIDs, destGUID)
+		}
+	}
+
+	otherApps, warnings, err := actor.V3Actor.GetApplicationsByGUIDs(otherAppGUIDs...)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	for _, app := range otherApps {
+		appsByGUID[app.GUID] = app
+	}
+
+	var spaceGUIDs []string
+	seenSpaceGUIDs := map[string]bool{}
+	for _, v1Policy := range srcPolicies {
+		destApp, ok := appsByGUID[v1Policy.Destination.ID]
+		if ok && !seenSpaceGUIDs[destApp.SpaceGUID] {
+			seenSpaceGUIDs[destApp.SpaceGUID] = true
+			spaceGUIDs = append(spaceGUIDs, destApp.SpaceGUID)
+		}
+	}
+
+	spaces, warnings, err := actor.V3Actor.GetSpacesByGUIDs(spaceGUIDs...)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spacesByGUID := map[string]v3action.Space{}
+	var orgGUIDs []string
+	seenOrgGUIDs := map[string]bool{}
+	for _, space := range spaces {
+		spacesByGUID[space.GUID] = space
+		orgGUID := space.Relationships[constant.RelationshipTypeOrganization].GUID
+		if !seenOrgGUIDs[orgGUID] {
+			seenOrgGUIDs[orgGUID] = true
+			orgGUIDs = append(orgGUIDs, orgGUID)
 		}
 	}
-	return Policy{}
+
+	orgs, warnings, err := actor.V3Actor.GetOrganizationsByGUIDs(orgGUIDs...)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgNamesByGUID := map[string]string{}
+	for _, org := range orgs {
+		orgNamesByGUID[org.GUID] = org.Name
+	}
+
+	var policies []resolvedPolicy
+	for _, v1Policy := range srcPolicies {
+		destApp, ok := appsByGUID[v1Policy.Destination.ID]
+		if !ok {
+			continue
+		}
+
+		destSpace := spacesByGUID[destApp.SpaceGUID]
+		policies = append(policies, resolvedPolicy{
+			Policy: Policy{
+				SourceName:           appsByGUID[v1Policy.Source.ID].Name,
+				DestinationName:      destApp.Name,
+				Protocol:             string(v1Policy.Destination.Protocol),
+				StartPort:            v1Policy.Destination.Ports.Start,
+				EndPort:              v1Policy.Destination.Ports.End,
+				DestinationSpaceName: destSpace.Name,
+				DestinationOrgName:   orgNamesByGUID[destSpace.Relationships[constant.RelationshipTypeOrganization].GUID],
+			},
+			v1Policy: v1Policy,
+		})
+	}
+
+	return policies, allWarnings, nil
 }
@@ -0,0 +1,216 @@
+package cfnetworkingaction
+
+import (
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// NetworkPolicyChanges are the policies that have to be added to and removed
+// from a space for its policies to match the desired policies.
+type NetworkPolicyChanges struct {
+	Add    []Policy
+	Remove []Policy
+
+	add    []cfnetv1.Policy
+	remove []cfnetv1.Policy
+}
+
+// Unchanged returns true when the space's policies already match the
+// desired policies.
+func (changes NetworkPolicyChanges) Unchanged() bool {
+	return len(changes.Add) == 0 && len(changes.Remove) == 0
+}
+
+// GetNetworkPolicyChanges compares the policies of the apps in the space with
+// the desired policies. The source apps of the desired policies must be in
+// the space, and their destination apps must be in the space and org named by
+// DestinationSpaceName and DestinationOrgName.
+func (actor Actor) GetNetworkPolicyChanges(spaceGUID string, desired []Policy) (NetworkPolicyChanges, Warnings, error) {
+	var allWarnings Warnings
+
+	applications, warnings, err := actor.V3Actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return NetworkPolicyChanges{}, allWarnings, err
+	}
+
+	v1Policies, err := actor.NetworkingClient.ListPolicies()
+	if err != nil {
+		return NetworkPolicyChanges{}, allWarnings, err
+	}
+
+	current, resolveWarnings, err := actor.resolvePolicies(spaceGUID, applications, v1Policies)
+	allWarnings = append(allWarnings, resolveWarnings...)
+	if err != nil {
+		return NetworkPolicyChanges{}, allWarnings, err
+	}
+
+	existing := map[cfnetv1.Policy]bool{}
+	for _, policy := range current {
+		existing[policy.v1Policy] = true
+	}
+
+	resolver := newAppResolver(actor.V3Actor, spaceGUID)
+	for _, app := range applications {
+		resolver.appGUIDs[appKey{spaceGUID: spaceGUID, name: app.Name}] = app.GUID
+	}
+
+	var changes NetworkPolicyChanges
+	wanted := map[cfnetv1.Policy]bool{}
+	for _, policy := range desired {
+		v1Policy, resolveWarnings, err := resolver.resolve(policy)
+		allWarnings = append(allWarnings, resolveWarnings...)
+		if err != nil {
+			return NetworkPolicyChanges{}, allWarnings, err
+		}
+
+		if wanted[v1Policy] {
+			continue
+		}
+		wanted[v1Policy] = true
+
+		if !existing[v1Policy] {
+			changes.Add = append(changes.Add, policy)
+			changes.add = append(changes.add, v1Policy)
+		}
+	}
+
+	for _, policy := range current {
+		if !wanted[policy.v1Policy] {
+			changes.Remove = append(changes.Remove, policy.Policy)
+			changes.remove = append(changes.remove, policy.v1Policy)
+		}
+	}
+
+	return changes, allWarnings, nil
+}
+
+// ApplyNetworkPolicyChanges adds and removes the policies in the changes. The
+// policies are added first, so that connections that are only changing ports
+// are not interrupted.
+func (actor Actor) ApplyNetworkPolicyChanges(changes NetworkPolicyChanges) error {
+	if len(changes.add) > 0 {
+		err := actor.NetworkingClient.CreatePolicies(changes.add)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(changes.remove) > 0 {
+		return actor.NetworkingClient.RemovePolicies(changes.remove)
+	}
+	return nil
+}
+
+type appKey struct {
+	spaceGUID string
+	name      string
+}
+
+type spaceKey struct {
+	orgName   string
+	spaceName string
+}
+
+// appResolver looks up the GUIDs of the apps in policies, looking up every
+// org, space and app only once.
+type appResolver struct {
+	v3Actor   V3Actor
+	spaceGUID string
+
+	orgGUIDs   map[string]string
+	spaceGUIDs map[spaceKey]string
+	appGUIDs   map[appKey]string
+}
+
+func newAppResolver(v3Actor V3Actor, spaceGUID string) *appResolver {
+	return &appResolver{
+		v3Actor:    v3Actor,
+		spaceGUID:  spaceGUID,
+		orgGUIDs:   map[string]string{},
+		spaceGUIDs: map[spaceKey]string{},
+		appGUIDs:   map[appKey]string{},
+	}
+}
+
+func (resolver *appResolver) resolve(policy Policy) (cfnetv1.Policy, Warnings, error) {
+	var allWarnings Warnings
+
+	srcGUID, ok := resolver.appGUIDs[appKey{spaceGUID: resolver.spaceGUID, name: policy.SourceName}]
+	if !ok {
+		return cfnetv1.Policy{}, nil, actionerror.ApplicationNotFoundError{Name: policy.SourceName}
+	}
+
+	destSpaceGUID, warnings, err := resolver.spaceGUIDByName(policy.DestinationOrgName, policy.DestinationSpaceName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return cfnetv1.Policy{}, allWarnings, err
+	}
+
+	destGUID, warnings, err := resolver.appGUIDByName(destSpaceGUID, policy.DestinationName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return cfnetv1.Policy{}, allWarnings, err
+	}
+
+	return cfnetv1.Policy{
+		Source: cfnetv1.PolicySource{
+			ID: srcGUID,
+		},
+		Destination: cfnetv1.PolicyDestination{
+			ID:       destGUID,
+			Protocol: cfnetv1.PolicyProtocol(policy.Protocol),
+			Ports: cfnetv1.Ports{
+				Start: policy.StartPort,
+				End:   policy.EndPort,
+			},
+		},
+	}, allWarnings, nil
+}
+
+func (resolver *appResolver) spaceGUIDByName(orgName string, spaceName string) (string, Warnings, error) {
+	key := spaceKey{orgName: orgName, spaceName: spaceName}
+	if guid, ok := resolver.spaceGUIDs[key]; ok {
+		return guid, nil, nil
+	}
+
+	var allWarnings Warnings
+	orgGUID, ok := resolver.orgGUIDs[orgName]
+	if !ok {
+		org, warnings, err := resolver.v3Actor.GetOrganizationByName(orgName)
+		allWarnings = append(allWarnings, Warnings(warnings)...)
+		if err != nil {
+			return "", allWarnings, err
+		}
+		orgGUID = org.GUID
+		resolver.orgGUIDs[orgName] = orgGUID
+	}
+
+	space, warnings, err := resolver.v3Actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	allWarnings = append(allWarnings, Warnings(warnings)...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+	resolver.spaceGUIDs[key] = space.GUID
+	return space.GUID, allWarnings, nil
+}
+
+func (resolver *appResolver) appGUIDByName(spaceGUID string, appName string) (string, Warnings, error) {
+	key := appKey{spaceGUID: spaceGUID, name: appName}
+	if guid, ok := resolver.appGUIDs[key]; ok {
+		return guid, nil, nil
+	}
+
+	// All of the apps in the targeted space are known, so a missing app cannot
+	// be found by looking it up.
+	if spaceGUID == resolver.spaceGUID {
+		return "", nil, actionerror.ApplicationNotFoundError{Name: appName}
+	}
+
+	app, warnings, err := resolver.v3Actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+	resolver.appGUIDs[key] = app.GUID
+	return app.GUID, Warnings(warnings), nil
+}
@@ -0,0 +1,286 @@
+package cfnetworkingaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction/cfnetworkingactionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy Sync", func() {
+	var (
+		actor                *Actor
+		fakeV3Actor          *cfnetworkingactionfakes.FakeV3Actor
+		fakeNetworkingClient *cfnetworkingactionfakes.FakeNetworkingClient
+
+		desired    []Policy
+		changes    NetworkPolicyChanges
+		warnings   Warnings
+		executeErr error
+	)
+
+	v1Policy := func(srcGUID string, destGUID string, protocol string, port int) cfnetv1.Policy {
+		return cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{ID: srcGUID},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       destGUID,
+				Protocol: cfnetv1.PolicyProtocol(protocol),
+				Ports:    cfnetv1.Ports{Start: port, End: port},
+			},
+		}
+	}
+
+	policy := func(src string, dest string, protocol string, port int, spaceName string, orgName string) Policy {
+		return Policy{
+			SourceName:           src,
+			DestinationName:      dest,
+			Protocol:             protocol,
+			StartPort:            port,
+			EndPort:              port,
+			DestinationSpaceName: spaceName,
+			DestinationOrgName:   orgName,
+		}
+	}
+
+	BeforeEach(func() {
+		fakeV3Actor = new(cfnetworkingactionfakes.FakeV3Actor)
+		fakeNetworkingClient = new(cfnetworkingactionfakes.FakeNetworkingClient)
+		actor = NewActor(fakeNetworkingClient, fakeV3Actor)
+
+		fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{
+			{Name: "appA", GUID: "appAGUID"},
+			{Name: "appB", GUID: "appBGUID"},
+		}, v3action.Warnings{"GetApplicationsBySpaceWarning"}, nil)
+
+		fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+			v1Policy("appAGUID", "appBGUID", "tcp", 8080),
+			v1Policy("appAGUID", "appBGUID", "tcp", 9000),
+			v1Policy("appAGUID", "appDGUID", "udp", 53),
+		}, nil)
+
+		fakeV3Actor.GetApplicationsByGUIDsReturns([]v3action.Application{
+			{Name: "appD", GUID: "appDGUID", SpaceGUID: "otherSpaceGUID"},
+		}, nil, nil)
+		fakeV3Actor.GetSpacesByGUIDsReturns([]v3action.Space{
+			{
+				Name: "space",
+				GUID: "spaceGUID",
+				Relationships: ccv3.Relationships{
+					constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "orgGUID"},
+				},
+			},
+			{
+				Name: "otherSpace",
+				GUID: "otherSpaceGUID",
+				Relationships: ccv3.Relationships{
+					constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "otherOrgGUID"},
+				},
+			},
+		}, nil, nil)
+		fakeV3Actor.GetOrganizationsByGUIDsReturns([]v3action.Organization{
+			{Name: "org", GUID: "orgGUID"},
+			{Name: "otherOrg", GUID: "otherOrgGUID"},
+		}, nil, nil)
+
+		fakeV3Actor.GetOrganizationByNameStub = func(name string) (v3action.Organization, v3action.Warnings, error) {
+			return v3action.Organization{Name: name, GUID: name + "GUID"}, v3action.Warnings{"GetOrganizationByNameWarning"}, nil
+		}
+		fakeV3Actor.GetSpaceByNameAndOrganizationStub = func(spaceName string, orgGUID string) (v3action.Space, v3action.Warnings, error) {
+			return v3action.Space{Name: spaceName, GUID: spaceName + "GUID"}, nil, nil
+		}
+		fakeV3Actor.GetApplicationByNameAndSpaceStub = func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+			if appName == "appD" && spaceGUID == "otherSpaceGUID" {
+				return v3action.Application{Name: "appD", GUID: "appDGUID"}, v3action.Warnings{"GetApplicationByNameAndSpaceWarning"}, nil
+			}
+			return v3action.Application{}, nil, actionerror.ApplicationNotFoundError{Name: appName}
+		}
+
+		desired = []Policy{
+			policy("appA", "appB", "tcp", 8080, "space", "org"),
+			policy("appA", "appD", "udp", 53, "otherSpace", "otherOrg"),
+			policy("appB", "appA", "tcp", 8080, "space", "org"),
+			policy("appA", "appB", "tcp", 8080, "space", "org"),
+		}
+	})
+
+	Describe("GetNetworkPolicyChanges", func() {
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetNetworkPolicyChanges("spaceGUID", desired)
+		})
+
+		It("returns the policies to add and remove", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(changes.Add).To(Equal([]Policy{
+				policy("appB", "appA", "tcp", 8080, "space", "org"),
+			}))
+			Expect(changes.Remove).To(Equal([]Policy{
+				policy("appA", "appB", "tcp", 9000, "space", "org"),
+			}))
+			Expect(changes.Unchanged()).To(BeFalse())
+			Expect(warnings).To(ConsistOf(
+				"GetApplicationsBySpaceWarning",
+				"GetOrganizationByNameWarning",
+				"GetOrganizationByNameWarning",
+				"GetApplicationByNameAndSpaceWarning",
+			))
+		})
+
+		It("looks up every destination org, space and app once", func() {
+			Expect(fakeV3Actor.GetOrganizationByNameCallCount()).To(Equal(2))
+			Expect(fakeV3Actor.GetOrganizationByNameArgsForCall(0)).To(Equal("org"))
+			Expect(fakeV3Actor.GetOrganizationByNameArgsForCall(1)).To(Equal("otherOrg"))
+
+			Expect(fakeV3Actor.GetSpaceByNameAndOrganizationCallCount()).To(Equal(2))
+			spaceName, orgGUID := fakeV3Actor.GetSpaceByNameAndOrganizationArgsForCall(1)
+			Expect(spaceName).To(Equal("otherSpace"))
+			Expect(orgGUID).To(Equal("otherOrgGUID"))
+
+			Expect(fakeV3Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		})
+
+		When("the space's policies match the desired policies", func() {
+			BeforeEach(func() {
+				desired = []Policy{
+					policy("appA", "appB", "tcp", 8080, "space", "org"),
+					policy("appA", "appB", "tcp", 9000, "space", "org"),
+					policy("appA", "appD", "udp", 53, "otherSpace", "otherOrg"),
+				}
+			})
+
+			It("returns no changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes.Unchanged()).To(BeTrue())
+			})
+		})
+
+		When("no policies are desired", func() {
+			BeforeEach(func() {
+				desired = nil
+			})
+
+			It("removes all of the space's policies", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes.Add).To(BeEmpty())
+				Expect(changes.Remove).To(HaveLen(3))
+			})
+		})
+
+		When("a source app is not in the space", func() {
+			BeforeEach(func() {
+				desired = []Policy{policy("appC", "appB", "tcp", 8080, "space", "org")}
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "appC"}))
+			})
+		})
+
+		When("a destination app in the space does not exist", func() {
+			BeforeEach(func() {
+				desired = []Policy{policy("appA", "appC", "tcp", 8080, "space", "org")}
+			})
+
+			It("returns an ApplicationNotFoundError without looking the app up", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "appC"}))
+				Expect(fakeV3Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a destination org does not exist", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetOrganizationByNameStub = nil
+				fakeV3Actor.GetOrganizationByNameReturns(v3action.Organization{}, v3action.Warnings{"GetOrganizationByNameWarning"}, actionerror.OrganizationNotFoundError{Name: "org"})
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "org"}))
+				Expect(warnings).To(ContainElement("GetOrganizationByNameWarning"))
+			})
+		})
+
+		When("listing the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, errors.New("apple"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("apple"))
+			})
+		})
+
+		When("getting the space's applications fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetApplicationsBySpaceReturns(nil, v3action.Warnings{"GetApplicationsBySpaceWarning"}, errors.New("banana"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("banana"))
+				Expect(warnings).To(ConsistOf("GetApplicationsBySpaceWarning"))
+			})
+		})
+	})
+
+	Describe("ApplyNetworkPolicyChanges", func() {
+		var applyErr error
+
+		JustBeforeEach(func() {
+			changes, _, executeErr = actor.GetNetworkPolicyChanges("spaceGUID", desired)
+			Expect(executeErr).ToNot(HaveOccurred())
+			applyErr = actor.ApplyNetworkPolicyChanges(changes)
+		})
+
+		It("creates the added policies and then removes the removed policies", func() {
+			Expect(applyErr).ToNot(HaveOccurred())
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+				v1Policy("appBGUID", "appAGUID", "tcp", 8080),
+			}))
+
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+				v1Policy("appAGUID", "appBGUID", "tcp", 9000),
+			}))
+		})
+
+		When("there is nothing to remove", func() {
+			BeforeEach(func() {
+				desired = append(desired, policy("appA", "appB", "tcp", 9000, "space", "org"))
+			})
+
+			It("does not remove any policies", func() {
+				Expect(applyErr).ToNot(HaveOccurred())
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("creating the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.CreatePoliciesReturns(errors.New("apple"))
+			})
+
+			It("returns the error without removing any policies", func() {
+				Expect(applyErr).To(MatchError("apple"))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("removing the policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.RemovePoliciesReturns(errors.New("banana"))
+			})
+
+			It("returns the error", func() {
+				Expect(applyErr).To(MatchError("banana"))
+			})
+		})
+	})
+})
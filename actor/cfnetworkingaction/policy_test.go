@@ -8,6 +8,8 @@ import (
 	. "code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction/cfnetworkingactionfakes"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -35,18 +37,49 @@ var _ = Describe("Policy", func() {
 			return v3action.Application{}, nil, nil
 		}
 
+		fakeV3Actor.GetApplicationsByGUIDsReturns([]v3action.Application{
+			{
+				Name:      "appD",
+				GUID:      "appDGUID",
+				SpaceGUID: "otherSpaceGUID",
+			},
+		}, []string{"GetApplicationsByGUIDsWarning"}, nil)
+
+		fakeV3Actor.GetSpacesByGUIDsReturns([]v3action.Space{
+			{
+				Name: "space",
+				GUID: "space",
+				Relationships: ccv3.Relationships{
+					constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "orgGUID"},
+				},
+			},
+			{
+				Name: "otherSpace",
+				GUID: "otherSpaceGUID",
+				Relationships: ccv3.Relationships{
+					constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "otherOrgGUID"},
+				},
+			},
+		}, []string{"GetSpacesByGUIDsWarning"}, nil)
+
+		fakeV3Actor.GetOrganizationsByGUIDsReturns([]v3action.Organization{
+			{Name: "org", GUID: "orgGUID"},
+			{Name: "otherOrg", GUID: "otherOrgGUID"},
+		}, []string{"GetOrganizationsByGUIDsWarning"}, nil)
+
 		actor = NewActor(fakeNetworkingClient, fakeV3Actor)
 	})
 
 	Describe("AddNetworkPolicy", func() {
 		JustBeforeEach(func() {
-			spaceGuid := "space"
+			srcSpaceGuid := "space"
 			srcApp := "appA"
+			destSpaceGuid := "destSpace"
 			destApp := "appB"
 			protocol := "tcp"
 			startPort := 8080
 			endPort := 8090
-			warnings, executeErr = actor.AddNetworkPolicy(spaceGuid, srcApp, destApp, protocol, startPort, endPort)
+			warnings, executeErr = actor.AddNetworkPolicy(srcSpaceGuid, srcApp, destSpaceGuid, destApp, protocol, startPort, endPort)
 		})
 
 		It("creates policies", func() {
@@ -60,7 +93,7 @@ var _ = Describe("Policy", func() {
 
 			destAppName, spaceGUID := fakeV3Actor.GetApplicationByNameAndSpaceArgsForCall(1)
 			Expect(destAppName).To(Equal("appB"))
-			Expect(spaceGUID).To(Equal("space"))
+			Expect(spaceGUID).To(Equal("destSpace"))
 
 			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
 			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
@@ -134,6 +167,30 @@ var _ = Describe("Policy", func() {
 						End:   8080,
 					},
 				},
+			}, {
+				Source: cfnetv1.PolicySource{
+					ID: "appAGUID",
+				},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       "appDGUID",
+					Protocol: "udp",
+					Ports: cfnetv1.Ports{
+						Start: 53,
+						End:   53,
+					},
+				},
+			}, {
+				Source: cfnetv1.PolicySource{
+					ID: "appAGUID",
+				},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       "appEGUID",
+					Protocol: "tcp",
+					Ports: cfnetv1.Ports{
+						Start: 8080,
+						End:   8080,
+					},
+				},
 			}, {
 				Source: cfnetv1.PolicySource{
 					ID: "appBGUID",
@@ -188,17 +245,33 @@ var _ = Describe("Policy", func() {
 			It("lists only policies for which the app is a source", func() {
 				Expect(policies).To(Equal(
 					[]Policy{{
-						SourceName:      "appA",
-						DestinationName: "appB",
-						Protocol:        "tcp",
-						StartPort:       8080,
-						EndPort:         8080,
+						SourceName:           "appA",
+						DestinationName:      "appB",
+						Protocol:             "tcp",
+						StartPort:            8080,
+						EndPort:              8080,
+						DestinationSpaceName: "space",
+						DestinationOrgName:   "org",
+					}, {
+						SourceName:           "appA",
+						DestinationName:      "appD",
+						Protocol:             "udp",
+						StartPort:            53,
+						EndPort:              53,
+						DestinationSpaceName: "otherSpace",
+						DestinationOrgName:   "otherOrg",
 					}},
 				))
 			})
 
 			It("passes through the source app argument", func() {
-				Expect(warnings).To(Equal(Warnings([]string{"GetApplicationsBySpaceWarning", "v3ActorWarningA"})))
+				Expect(warnings).To(Equal(Warnings([]string{
+					"GetApplicationsBySpaceWarning",
+					"v3ActorWarningA",
+					"GetApplicationsByGUIDsWarning",
+					"GetSpacesByGUIDsWarning",
+					"GetOrganizationsByGUIDsWarning",
+				})))
 				Expect(executeErr).NotTo(HaveOccurred())
 
 				Expect(fakeV3Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
@@ -270,6 +343,30 @@ var _ = Describe("Policy", func() {
 						End:   8080,
 					},
 				},
+			}, {
+				Source: cfnetv1.PolicySource{
+					ID: "appAGUID",
+				},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       "appDGUID",
+					Protocol: "udp",
+					Ports: cfnetv1.Ports{
+						Start: 53,
+						End:   53,
+					},
+				},
+			}, {
+				Source: cfnetv1.PolicySource{
+					ID: "appAGUID",
+				},
+				Destination: cfnetv1.PolicyDestination{
+					ID:       "appEGUID",
+					Protocol: "tcp",
+					Ports: cfnetv1.Ports{
+						Start: 8080,
+						End:   8080,
+					},
+				},
 			}, {
 				Source: cfnetv1.PolicySource{
 					ID: "appBGUID",
@@ -319,20 +416,37 @@ var _ = Describe("Policy", func() {
 		It("lists policies", func() {
 			Expect(policies).To(Equal(
 				[]Policy{{
-					SourceName:      "appA",
-					DestinationName: "appB",
-					Protocol:        "tcp",
-					StartPort:       8080,
-					EndPort:         8080,
+					SourceName:           "appA",
+					DestinationName:      "appB",
+					Protocol:             "tcp",
+					StartPort:            8080,
+					EndPort:              8080,
+					DestinationSpaceName: "space",
+					DestinationOrgName:   "org",
 				}, {
-					SourceName:      "appB",
-					DestinationName: "appB",
-					Protocol:        "tcp",
-					StartPort:       8080,
-					EndPort:         8080,
+					SourceName:           "appA",
+					DestinationName:      "appD",
+					Protocol:             "udp",
+					StartPort:            53,
+					EndPort:              53,
+					DestinationSpaceName: "otherSpace",
+					DestinationOrgName:   "otherOrg",
+				}, {
+					SourceName:           "appB",
+					DestinationName:      "appB",
+					Protocol:             "tcp",
+					StartPort:            8080,
+					EndPort:              8080,
+					DestinationSpaceName: "space",
+					DestinationOrgName:   "org",
 				}},
 			))
-			Expect(warnings).To(Equal(Warnings([]string{"GetApplicationsBySpaceWarning"})))
+			Expect(warnings).To(Equal(Warnings([]string{
+				"GetApplicationsBySpaceWarning",
+				"GetApplicationsByGUIDsWarning",
+				"GetSpacesByGUIDsWarning",
+				"GetOrganizationsByGUIDsWarning",
+			})))
 			Expect(executeErr).NotTo(HaveOccurred())
 
 			Expect(fakeV3Actor.GetApplicationsBySpaceCallCount()).To(Equal(1))
@@ -342,6 +456,51 @@ var _ = Describe("Policy", func() {
 			Expect(fakeNetworkingClient.ListPoliciesArgsForCall(0)).To(BeNil())
 		})
 
+		It("looks up the destination apps outside of the space with their spaces and orgs", func() {
+			Expect(fakeV3Actor.GetApplicationsByGUIDsCallCount()).To(Equal(1))
+			Expect(fakeV3Actor.GetApplicationsByGUIDsArgsForCall(0)).To(Equal([]string{"appDGUID", "appEGUID"}))
+
+			Expect(fakeV3Actor.GetSpacesByGUIDsCallCount()).To(Equal(1))
+			Expect(fakeV3Actor.GetSpacesByGUIDsArgsForCall(0)).To(Equal([]string{"space", "otherSpaceGUID"}))
+
+			Expect(fakeV3Actor.GetOrganizationsByGUIDsCallCount()).To(Equal(1))
+			Expect(fakeV3Actor.GetOrganizationsByGUIDsArgsForCall(0)).To(Equal([]string{"orgGUID", "otherOrgGUID"}))
+		})
+
+		When("getting the destination apps fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetApplicationsByGUIDsReturns(nil, []string{"GetApplicationsByGUIDsWarning"}, errors.New("banana"))
+			})
+
+			It("returns a sensible error", func() {
+				Expect(policies).To(Equal([]Policy{}))
+				Expect(warnings).To(Equal(Warnings([]string{"GetApplicationsBySpaceWarning", "GetApplicationsByGUIDsWarning"})))
+				Expect(executeErr).To(MatchError("banana"))
+			})
+		})
+
+		When("getting the destination spaces fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetSpacesByGUIDsReturns(nil, []string{"GetSpacesByGUIDsWarning"}, errors.New("banana"))
+			})
+
+			It("returns a sensible error", func() {
+				Expect(policies).To(Equal([]Policy{}))
+				Expect(executeErr).To(MatchError("banana"))
+			})
+		})
+
+		When("getting the destination orgs fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetOrganizationsByGUIDsReturns(nil, []string{"GetOrganizationsByGUIDsWarning"}, errors.New("banana"))
+			})
+
+			It("returns a sensible error", func() {
+				Expect(policies).To(Equal([]Policy{}))
+				Expect(executeErr).To(MatchError("banana"))
+			})
+		})
+
 		When("getting the applications fails", func() {
 			BeforeEach(func() {
 				fakeV3Actor.GetApplicationsBySpaceReturns([]v3action.Application{}, []string{"GetApplicationsBySpaceWarning"}, errors.New("banana"))
@@ -384,13 +543,14 @@ var _ = Describe("Policy", func() {
 		})
 
 		JustBeforeEach(func() {
-			spaceGuid := "space"
+			srcSpaceGuid := "space"
 			srcApp := "appA"
+			destSpaceGuid := "destSpace"
 			destApp := "appB"
 			protocol := "udp"
 			startPort := 123
 			endPort := 345
-			warnings, executeErr = actor.RemoveNetworkPolicy(spaceGuid, srcApp, destApp, protocol, startPort, endPort)
+			warnings, executeErr = actor.RemoveNetworkPolicy(srcSpaceGuid, srcApp, destSpaceGuid, destApp, protocol, startPort, endPort)
 		})
 		It("removes policies", func() {
 			Expect(warnings).To(Equal(Warnings([]string{"v3ActorWarningA", "v3ActorWarningB"})))
@@ -403,7 +563,7 @@ var _ = Describe("Policy", func() {
 
 			destAppName, spaceGUID := fakeV3Actor.GetApplicationByNameAndSpaceArgsForCall(1)
 			Expect(destAppName).To(Equal("appB"))
-			Expect(spaceGUID).To(Equal("space"))
+			Expect(spaceGUID).To(Equal("destSpace"))
 
 			Expect(fakeNetworkingClient.ListPoliciesCallCount()).To(Equal(1))
 
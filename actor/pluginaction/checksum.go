@@ -1,8 +1,40 @@
 package pluginaction
 
-import "code.cloudfoundry.org/cli/util/configv3"
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+)
 
 func (actor Actor) ValidateFileChecksum(path string, checksum string) bool {
 	plugin := configv3.Plugin{Location: path}
 	return plugin.CalculateSHA1() == checksum
 }
+
+// ValidateFileSHA256Checksum returns true if the SHA-256 digest of the file
+// matches the provided hex encoded checksum.
+func (actor Actor) ValidateFileSHA256Checksum(path string, checksum string) bool {
+	digest, err := fileSHA256(path)
+	if err != nil {
+		return false
+	}
+	return fmt.Sprintf("%x", digest) == checksum
+}
+
+func fileSHA256(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
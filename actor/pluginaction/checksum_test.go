@@ -50,4 +50,40 @@ var _ = Describe("Checksums", func() {
 			})
 		})
 	})
+
+	Describe("ValidateFileSHA256Checksum", func() {
+		var file *os.File
+		BeforeEach(func() {
+			var err error
+			file, err = ioutil.TempFile("", "")
+			defer file.Close()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(file.Name(), []byte("foo"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := os.Remove(file.Name())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the checksums match", func() {
+			It("returns true", func() {
+				Expect(actor.ValidateFileSHA256Checksum(file.Name(), "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")).To(BeTrue())
+			})
+		})
+
+		When("the checksums do not match", func() {
+			It("returns false", func() {
+				Expect(actor.ValidateFileSHA256Checksum(file.Name(), "0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33")).To(BeFalse())
+			})
+		})
+
+		When("the file does not exist", func() {
+			It("returns false", func() {
+				Expect(actor.ValidateFileSHA256Checksum("/i/do/not/exist", "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")).To(BeFalse())
+			})
+		})
+	})
 })
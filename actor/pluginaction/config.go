@@ -7,11 +7,14 @@ import "code.cloudfoundry.org/cli/util/configv3"
 // Config is a way of getting basic CF configuration
 type Config interface {
 	AddPlugin(configv3.Plugin)
+	AddPluginKey(keyName string, publicKey string)
 	AddPluginRepository(repoName string, repoURL string)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	PluginHome() string
+	PluginKeys() []configv3.PluginKey
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	RemovePlugin(string)
+	RemovePluginKey(keyName string)
 	WritePluginConfig() error
 }
@@ -0,0 +1,32 @@
+package pluginaction
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/util/pluginfile"
+)
+
+// GetInstalledPluginsForPluginFile returns the installed plugins in the format
+// of the plugin file read by install-plugins. Each plugin lists the SHA-256
+// checksum of its binary for the given platform and, if it was installed by
+// install-plugins or update-plugins, the repository or URL it came from.
+func (actor Actor) GetInstalledPluginsForPluginFile(platform string) ([]pluginfile.Plugin, error) {
+	plugins := []pluginfile.Plugin{}
+	for _, installedPlugin := range actor.config.Plugins() {
+		digest, err := fileSHA256(installedPlugin.Location)
+		if err != nil {
+			return nil, err
+		}
+
+		plugins = append(plugins, pluginfile.Plugin{
+			Name:       installedPlugin.Name,
+			Version:    installedPlugin.Version.String(),
+			Repository: installedPlugin.Repository,
+			URL:        installedPlugin.URL,
+			Checksums: map[string]string{
+				platform: fmt.Sprintf("%x", digest),
+			},
+		})
+	}
+	return plugins, nil
+}
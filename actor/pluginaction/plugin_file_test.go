@@ -0,0 +1,116 @@
+package pluginaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/pluginfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin File Actions", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig, nil)
+	})
+
+	Describe("GetInstalledPluginsForPluginFile", func() {
+		var (
+			tmpDir     string
+			plugins    []pluginfile.Plugin
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "plugin-file")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			plugins, executeErr = actor.GetInstalledPluginsForPluginFile("linux64")
+		})
+
+		When("the plugin binaries exist", func() {
+			BeforeEach(func() {
+				pluginPath := filepath.Join(tmpDir, "some-plugin")
+				Expect(ioutil.WriteFile(pluginPath, []byte("foo"), 0700)).To(Succeed())
+
+				fakeConfig.PluginsReturns([]configv3.Plugin{
+					{
+						Name:     "some-plugin",
+						Location: pluginPath,
+						Version:  configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+					},
+					{
+						Name:       "repo-plugin",
+						Location:   pluginPath,
+						Version:    configv3.PluginVersion{Major: 2},
+						Repository: "CF-Community",
+					},
+					{
+						Name:     "url-plugin",
+						Location: pluginPath,
+						Version:  configv3.PluginVersion{Major: 3},
+						URL:      "https://example.com/url-plugin",
+					},
+				})
+			})
+
+			It("returns the plugins with their sources and the checksums of their binaries", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plugins).To(Equal([]pluginfile.Plugin{
+					{
+						Name:    "some-plugin",
+						Version: "1.2.3",
+						Checksums: map[string]string{
+							"linux64": "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+						},
+					},
+					{
+						Name:       "repo-plugin",
+						Version:    "2.0.0",
+						Repository: "CF-Community",
+						Checksums: map[string]string{
+							"linux64": "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+						},
+					},
+					{
+						Name:    "url-plugin",
+						Version: "3.0.0",
+						URL:     "https://example.com/url-plugin",
+						Checksums: map[string]string{
+							"linux64": "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+						},
+					},
+				}))
+			})
+		})
+
+		When("a plugin binary is missing", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginsReturns([]configv3.Plugin{
+					{Name: "some-plugin", Location: filepath.Join(tmpDir, "missing")},
+				})
+			})
+
+			It("returns the error", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			})
+		})
+	})
+})
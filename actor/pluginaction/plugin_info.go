@@ -4,29 +4,47 @@ import (
 	"runtime"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/generic"
 )
 
 type PluginInfo struct {
-	Name     string
-	Version  string
-	URL      string
-	Checksum string
+	Name      string
+	Version   string
+	URL       string
+	Checksum  string
+	SHA256    string
+	Signature string
 }
 
 // GetPluginInfoFromRepositoriesForPlatform returns the newest version of the specified plugin
 // and all the repositories that contain that version.
 func (actor Actor) GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (PluginInfo, []string, error) {
+	return actor.getPluginInfoFromRepositoriesForPlatform(pluginName, "", pluginRepos, platform)
+}
+
+// GetPluginVersionInfoFromRepositoriesForPlatform returns the specified
+// version of the plugin and all the repositories that contain that version. A
+// repository lists every version it provides as a separate plugin entry.
+func (actor Actor) GetPluginVersionInfoFromRepositoriesForPlatform(pluginName string, version string, pluginRepos []configv3.PluginRepository, platform string) (PluginInfo, []string, error) {
+	return actor.getPluginInfoFromRepositoriesForPlatform(pluginName, version, pluginRepos, platform)
+}
+
+func (actor Actor) getPluginInfoFromRepositoriesForPlatform(pluginName string, version string, pluginRepos []configv3.PluginRepository, platform string) (PluginInfo, []string, error) {
 	var reposWithPlugin []string
 	var newestPluginInfo PluginInfo
 	var pluginFoundWithIncompatibleBinary bool
+	var pluginFoundWithOtherVersion bool
 
 	for _, repo := range pluginRepos {
-		pluginInfo, err := actor.getPluginInfoFromRepositoryForPlatform(pluginName, repo, platform)
+		pluginInfo, err := actor.getPluginInfoFromRepositoryForPlatform(pluginName, version, repo, platform)
 		switch err.(type) {
 		case actionerror.PluginNotFoundInRepositoryError:
 			continue
+		case actionerror.PluginVersionNotFoundError:
+			pluginFoundWithOtherVersion = true
+			continue
 		case actionerror.NoCompatibleBinaryError:
 			pluginFoundWithIncompatibleBinary = true
 			continue
@@ -49,6 +67,9 @@ func (actor Actor) GetPluginInfoFromRepositoriesForPlatform(pluginName string, p
 		if pluginFoundWithIncompatibleBinary {
 			return PluginInfo{}, nil, actionerror.NoCompatibleBinaryError{}
 		}
+		if pluginFoundWithOtherVersion {
+			return PluginInfo{}, nil, actionerror.PluginVersionNotFoundError{PluginName: pluginName, Version: version}
+		}
 		return PluginInfo{}, nil, actionerror.PluginNotFoundInAnyRepositoryError{PluginName: pluginName}
 	}
 	return newestPluginInfo, reposWithPlugin, nil
@@ -60,37 +81,69 @@ func (actor Actor) GetPlatformString(runtimeGOOS string, runtimeGOARCH string) s
 }
 
 // getPluginInfoFromRepositoryForPlatform returns the plugin info, if found, from
-// the specified repository for the specified platform.
-func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, pluginRepo configv3.PluginRepository, platform string) (PluginInfo, error) {
+// the specified repository for the specified platform. If version is empty,
+// the newest version in the repository is returned.
+func (actor Actor) getPluginInfoFromRepositoryForPlatform(pluginName string, version string, pluginRepo configv3.PluginRepository, platform string) (PluginInfo, error) {
 	pluginRepository, err := actor.client.GetPluginRepository(pluginRepo.URL)
 	if err != nil {
 		return PluginInfo{}, err
 	}
 
-	var pluginFoundWithIncompatibleBinary bool
+	var (
+		pluginInfo                        PluginInfo
+		pluginFound                       bool
+		pluginFoundWithIncompatibleBinary bool
+		pluginFoundWithOtherVersion       bool
+	)
 
 	for _, plugin := range pluginRepository.Plugins {
-		if plugin.Name == pluginName {
-			for _, pluginBinary := range plugin.Binaries {
-				if pluginBinary.Platform == platform {
-					return PluginInfo{
-						Name:     plugin.Name,
-						Version:  plugin.Version,
-						URL:      pluginBinary.URL,
-						Checksum: pluginBinary.Checksum,
-					}, nil
-				}
-			}
+		if plugin.Name != pluginName {
+			continue
+		}
+		if version != "" && plugin.Version != version {
+			pluginFoundWithOtherVersion = true
+			continue
+		}
+
+		binary, ok := binaryForPlatform(plugin.Binaries, platform)
+		if !ok {
 			pluginFoundWithIncompatibleBinary = true
+			continue
+		}
+
+		if !pluginFound || lessThan(pluginInfo.Version, plugin.Version) {
+			pluginInfo = PluginInfo{
+				Name:      plugin.Name,
+				Version:   plugin.Version,
+				URL:       binary.URL,
+				Checksum:  binary.Checksum,
+				SHA256:    binary.SHA256,
+				Signature: binary.Signature,
+			}
+			pluginFound = true
 		}
 	}
 
-	if pluginFoundWithIncompatibleBinary {
+	switch {
+	case pluginFound:
+		return pluginInfo, nil
+	case pluginFoundWithIncompatibleBinary:
 		return PluginInfo{}, actionerror.NoCompatibleBinaryError{}
+	case pluginFoundWithOtherVersion:
+		return PluginInfo{}, actionerror.PluginVersionNotFoundError{PluginName: pluginName, Version: version}
+	default:
+		return PluginInfo{}, actionerror.PluginNotFoundInRepositoryError{
+			PluginName:     pluginName,
+			RepositoryName: pluginRepo.Name,
+		}
 	}
+}
 
-	return PluginInfo{}, actionerror.PluginNotFoundInRepositoryError{
-		PluginName:     pluginName,
-		RepositoryName: pluginRepo.Name,
+func binaryForPlatform(binaries []plugin.PluginBinary, platform string) (plugin.PluginBinary, bool) {
+	for _, binary := range binaries {
+		if binary.Platform == platform {
+			return binary, true
+		}
 	}
+	return plugin.PluginBinary{}, false
 }
@@ -47,7 +47,7 @@ var _ = Describe("plugin info actions", func() {
 								Name:    "some-plugin",
 								Version: "1.2.3",
 								Binaries: []plugin.PluginBinary{
-									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum"},
+									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum", SHA256: "somesha256", Signature: "somesignature"},
 									{Platform: "win64", URL: "http://some-windows-url", Checksum: "anotherchecksum"},
 									{Platform: "linux64", URL: "http://some-linux-url", Checksum: "lastchecksum"},
 								},
@@ -97,6 +97,9 @@ var _ = Describe("plugin info actions", func() {
 						Expect(pluginInfo.Name).To(Equal("some-plugin"))
 						Expect(pluginInfo.Version).To(Equal("1.2.3"))
 						Expect(pluginInfo.URL).To(Equal("http://some-darwin-url"))
+						Expect(pluginInfo.Checksum).To(Equal("somechecksum"))
+						Expect(pluginInfo.SHA256).To(Equal("somesha256"))
+						Expect(pluginInfo.Signature).To(Equal("somesignature"))
 						Expect(repos).To(ConsistOf("some-repo"))
 					})
 				})
@@ -326,4 +329,92 @@ var _ = Describe("plugin info actions", func() {
 			})
 		})
 	})
+	Describe("GetPluginVersionInfoFromRepositoriesForPlatform", func() {
+		var pluginRepositories []configv3.PluginRepository
+
+		BeforeEach(func() {
+			pluginRepositories = []configv3.PluginRepository{
+				{Name: "repo1", URL: "url1"},
+				{Name: "repo2", URL: "url2"},
+			}
+
+			fakeClient.GetPluginRepositoryStub = func(repoURL string) (plugin.PluginRepository, error) {
+				switch repoURL {
+				case "url1":
+					return plugin.PluginRepository{Plugins: []plugin.Plugin{
+						{Name: "some-plugin", Version: "2.0.0", Binaries: []plugin.PluginBinary{
+							{Platform: "some-platform", URL: "url1-2.0.0", Checksum: "checksum-2.0.0"},
+						}},
+						{Name: "some-plugin", Version: "1.0.0", Binaries: []plugin.PluginBinary{
+							{Platform: "some-platform", URL: "url1-1.0.0", Checksum: "checksum-1.0.0"},
+						}},
+						{Name: "some-plugin", Version: "0.5.0", Binaries: []plugin.PluginBinary{
+							{Platform: "other-platform", URL: "url1-0.5.0", Checksum: "checksum-0.5.0"},
+						}},
+					}}, nil
+				default:
+					return plugin.PluginRepository{Plugins: []plugin.Plugin{
+						{Name: "some-plugin", Version: "1.0.0", Binaries: []plugin.PluginBinary{
+							{Platform: "some-platform", URL: "url2-1.0.0", Checksum: "checksum-1.0.0"},
+						}},
+					}}, nil
+				}
+			}
+		})
+
+		When("the repositories list the requested version", func() {
+			It("returns that version and the repositories that contain it", func() {
+				pluginInfo, repos, err := actor.GetPluginVersionInfoFromRepositoriesForPlatform("some-plugin", "1.0.0", pluginRepositories, "some-platform")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pluginInfo).To(Equal(PluginInfo{
+					Name:     "some-plugin",
+					Version:  "1.0.0",
+					URL:      "url1-1.0.0",
+					Checksum: "checksum-1.0.0",
+				}))
+				Expect(repos).To(ConsistOf("repo1", "repo2"))
+			})
+		})
+
+		When("the requested version is not listed", func() {
+			It("returns a PluginVersionNotFoundError", func() {
+				_, _, err := actor.GetPluginVersionInfoFromRepositoriesForPlatform("some-plugin", "3.0.0", pluginRepositories, "some-platform")
+				Expect(err).To(MatchError(actionerror.PluginVersionNotFoundError{PluginName: "some-plugin", Version: "3.0.0"}))
+			})
+		})
+
+		When("the requested version has no binary for the platform", func() {
+			It("returns a NoCompatibleBinaryError", func() {
+				_, _, err := actor.GetPluginVersionInfoFromRepositoriesForPlatform("some-plugin", "0.5.0", pluginRepositories, "some-platform")
+				Expect(err).To(MatchError(actionerror.NoCompatibleBinaryError{}))
+			})
+		})
+
+		When("the plugin is not listed at all", func() {
+			It("returns a PluginNotFoundInAnyRepositoryError", func() {
+				_, _, err := actor.GetPluginVersionInfoFromRepositoriesForPlatform("other-plugin", "1.0.0", pluginRepositories, "some-platform")
+				Expect(err).To(MatchError(actionerror.PluginNotFoundInAnyRepositoryError{PluginName: "other-plugin"}))
+			})
+		})
+	})
+
+	When("a repository lists several versions of a plugin", func() {
+		BeforeEach(func() {
+			fakeClient.GetPluginRepositoryReturns(plugin.PluginRepository{Plugins: []plugin.Plugin{
+				{Name: "some-plugin", Version: "1.0.0", Binaries: []plugin.PluginBinary{
+					{Platform: "some-platform", URL: "url-1.0.0"},
+				}},
+				{Name: "some-plugin", Version: "2.0.0", Binaries: []plugin.PluginBinary{
+					{Platform: "some-platform", URL: "url-2.0.0"},
+				}},
+			}}, nil)
+		})
+
+		It("GetPluginInfoFromRepositoriesForPlatform returns the newest version", func() {
+			pluginInfo, _, err := actor.GetPluginInfoFromRepositoriesForPlatform("some-plugin", []configv3.PluginRepository{{Name: "repo", URL: "url"}}, "some-platform")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pluginInfo.Version).To(Equal("2.0.0"))
+			Expect(pluginInfo.URL).To(Equal("url-2.0.0"))
+		})
+	})
 })
@@ -0,0 +1,50 @@
+package pluginaction
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"golang.org/x/crypto/ed25519"
+)
+
+// AddPluginKey trusts the provided base64-encoded Ed25519 public key to sign
+// plugin binaries.
+func (actor Actor) AddPluginKey(keyName string, publicKey string) error {
+	_, err := decodePluginKey(publicKey)
+	if err != nil {
+		return actionerror.InvalidPluginKeyError{Name: keyName}
+	}
+
+	for _, key := range actor.config.PluginKeys() {
+		if strings.EqualFold(key.Name, keyName) {
+			return actionerror.PluginKeyNameTakenError{Name: key.Name}
+		}
+	}
+
+	actor.config.AddPluginKey(keyName, publicKey)
+	return nil
+}
+
+// RemovePluginKey stops trusting the plugin key with the provided name.
+func (actor Actor) RemovePluginKey(keyName string) error {
+	for _, key := range actor.config.PluginKeys() {
+		if strings.EqualFold(key.Name, keyName) {
+			actor.config.RemovePluginKey(key.Name)
+			return nil
+		}
+	}
+
+	return actionerror.PluginKeyNotFoundError{Name: keyName}
+}
+
+func decodePluginKey(publicKey string) (ed25519.PublicKey, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != ed25519.PublicKeySize {
+		return nil, actionerror.InvalidPluginKeyError{}
+	}
+	return ed25519.PublicKey(decoded), nil
+}
@@ -0,0 +1,86 @@
+package pluginaction_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin Key Actions", func() {
+	const publicKey = "11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="
+
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig, nil)
+
+		fakeConfig.PluginKeysReturns([]configv3.PluginKey{
+			{Name: "Existing-Key", PublicKey: publicKey},
+		})
+	})
+
+	Describe("AddPluginKey", func() {
+		When("the key is valid and the name is not taken", func() {
+			It("adds the key to the config", func() {
+				err := actor.AddPluginKey("some-key", publicKey)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.AddPluginKeyCallCount()).To(Equal(1))
+				name, key := fakeConfig.AddPluginKeyArgsForCall(0)
+				Expect(name).To(Equal("some-key"))
+				Expect(key).To(Equal(publicKey))
+			})
+		})
+
+		When("the key is not base64 encoded", func() {
+			It("returns an InvalidPluginKeyError", func() {
+				err := actor.AddPluginKey("some-key", "not base64!")
+				Expect(err).To(MatchError(actionerror.InvalidPluginKeyError{Name: "some-key"}))
+				Expect(fakeConfig.AddPluginKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the key is not an Ed25519 public key", func() {
+			It("returns an InvalidPluginKeyError", func() {
+				err := actor.AddPluginKey("some-key", "c29tZS1rZXk=")
+				Expect(err).To(MatchError(actionerror.InvalidPluginKeyError{Name: "some-key"}))
+				Expect(fakeConfig.AddPluginKeyCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a key with the same name already exists", func() {
+			It("returns a PluginKeyNameTakenError", func() {
+				err := actor.AddPluginKey("existing-key", publicKey)
+				Expect(err).To(MatchError(actionerror.PluginKeyNameTakenError{Name: "Existing-Key"}))
+				Expect(fakeConfig.AddPluginKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RemovePluginKey", func() {
+		When("the key exists", func() {
+			It("removes the key from the config", func() {
+				err := actor.RemovePluginKey("existing-key")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.RemovePluginKeyCallCount()).To(Equal(1))
+				Expect(fakeConfig.RemovePluginKeyArgsForCall(0)).To(Equal("Existing-Key"))
+			})
+		})
+
+		When("the key does not exist", func() {
+			It("returns a PluginKeyNotFoundError", func() {
+				err := actor.RemovePluginKey("some-key")
+				Expect(err).To(MatchError(actionerror.PluginKeyNotFoundError{Name: "some-key"}))
+				Expect(fakeConfig.RemovePluginKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -14,6 +14,12 @@ type FakeConfig struct {
 	addPluginArgsForCall []struct {
 		arg1 configv3.Plugin
 	}
+	AddPluginKeyStub        func(keyName string, publicKey string)
+	addPluginKeyMutex       sync.RWMutex
+	addPluginKeyArgsForCall []struct {
+		keyName   string
+		publicKey string
+	}
 	AddPluginRepositoryStub        func(repoName string, repoURL string)
 	addPluginRepositoryMutex       sync.RWMutex
 	addPluginRepositoryArgsForCall []struct {
@@ -42,6 +48,15 @@ type FakeConfig struct {
 	pluginHomeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PluginKeysStub        func() []configv3.PluginKey
+	pluginKeysMutex       sync.RWMutex
+	pluginKeysArgsForCall []struct{}
+	pluginKeysReturns     struct {
+		result1 []configv3.PluginKey
+	}
+	pluginKeysReturnsOnCall map[int]struct {
+		result1 []configv3.PluginKey
+	}
 	PluginRepositoriesStub        func() []configv3.PluginRepository
 	pluginRepositoriesMutex       sync.RWMutex
 	pluginRepositoriesArgsForCall []struct{}
@@ -65,6 +80,11 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemovePluginKeyStub        func(keyName string)
+	removePluginKeyMutex       sync.RWMutex
+	removePluginKeyArgsForCall []struct {
+		keyName string
+	}
 	WritePluginConfigStub        func() error
 	writePluginConfigMutex       sync.RWMutex
 	writePluginConfigArgsForCall []struct{}
@@ -102,6 +122,31 @@ func (fake *FakeConfig) AddPluginArgsForCall(i int) configv3.Plugin {
 	return fake.addPluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) AddPluginKey(keyName string, publicKey string) {
+	fake.addPluginKeyMutex.Lock()
+	fake.addPluginKeyArgsForCall = append(fake.addPluginKeyArgsForCall, struct {
+		keyName   string
+		publicKey string
+	}{keyName, publicKey})
+	fake.recordInvocation("AddPluginKey", []interface{}{keyName, publicKey})
+	fake.addPluginKeyMutex.Unlock()
+	if fake.AddPluginKeyStub != nil {
+		fake.AddPluginKeyStub(keyName, publicKey)
+	}
+}
+
+func (fake *FakeConfig) AddPluginKeyCallCount() int {
+	fake.addPluginKeyMutex.RLock()
+	defer fake.addPluginKeyMutex.RUnlock()
+	return len(fake.addPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginKeyArgsForCall(i int) (string, string) {
+	fake.addPluginKeyMutex.RLock()
+	defer fake.addPluginKeyMutex.RUnlock()
+	return fake.addPluginKeyArgsForCall[i].keyName, fake.addPluginKeyArgsForCall[i].publicKey
+}
+
 func (fake *FakeConfig) AddPluginRepository(repoName string, repoURL string) {
 	fake.addPluginRepositoryMutex.Lock()
 	fake.addPluginRepositoryArgsForCall = append(fake.addPluginRepositoryArgsForCall, struct {
@@ -218,6 +263,46 @@ func (fake *FakeConfig) PluginHomeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginKeys() []configv3.PluginKey {
+	fake.pluginKeysMutex.Lock()
+	ret, specificReturn := fake.pluginKeysReturnsOnCall[len(fake.pluginKeysArgsForCall)]
+	fake.pluginKeysArgsForCall = append(fake.pluginKeysArgsForCall, struct{}{})
+	fake.recordInvocation("PluginKeys", []interface{}{})
+	fake.pluginKeysMutex.Unlock()
+	if fake.PluginKeysStub != nil {
+		return fake.PluginKeysStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pluginKeysReturns.result1
+}
+
+func (fake *FakeConfig) PluginKeysCallCount() int {
+	fake.pluginKeysMutex.RLock()
+	defer fake.pluginKeysMutex.RUnlock()
+	return len(fake.pluginKeysArgsForCall)
+}
+
+func (fake *FakeConfig) PluginKeysReturns(result1 []configv3.PluginKey) {
+	fake.PluginKeysStub = nil
+	fake.pluginKeysReturns = struct {
+		result1 []configv3.PluginKey
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginKeysReturnsOnCall(i int, result1 []configv3.PluginKey) {
+	fake.PluginKeysStub = nil
+	if fake.pluginKeysReturnsOnCall == nil {
+		fake.pluginKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.PluginKey
+		})
+	}
+	fake.pluginKeysReturnsOnCall[i] = struct {
+		result1 []configv3.PluginKey
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginRepositories() []configv3.PluginRepository {
 	fake.pluginRepositoriesMutex.Lock()
 	ret, specificReturn := fake.pluginRepositoriesReturnsOnCall[len(fake.pluginRepositoriesArgsForCall)]
@@ -322,6 +407,30 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RemovePluginKey(keyName string) {
+	fake.removePluginKeyMutex.Lock()
+	fake.removePluginKeyArgsForCall = append(fake.removePluginKeyArgsForCall, struct {
+		keyName string
+	}{keyName})
+	fake.recordInvocation("RemovePluginKey", []interface{}{keyName})
+	fake.removePluginKeyMutex.Unlock()
+	if fake.RemovePluginKeyStub != nil {
+		fake.RemovePluginKeyStub(keyName)
+	}
+}
+
+func (fake *FakeConfig) RemovePluginKeyCallCount() int {
+	fake.removePluginKeyMutex.RLock()
+	defer fake.removePluginKeyMutex.RUnlock()
+	return len(fake.removePluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) RemovePluginKeyArgsForCall(i int) string {
+	fake.removePluginKeyMutex.RLock()
+	defer fake.removePluginKeyMutex.RUnlock()
+	return fake.removePluginKeyArgsForCall[i].keyName
+}
+
 func (fake *FakeConfig) WritePluginConfig() error {
 	fake.writePluginConfigMutex.Lock()
 	ret, specificReturn := fake.writePluginConfigReturnsOnCall[len(fake.writePluginConfigArgsForCall)]
@@ -367,18 +476,24 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addPluginMutex.RLock()
 	defer fake.addPluginMutex.RUnlock()
+	fake.addPluginKeyMutex.RLock()
+	defer fake.addPluginKeyMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
+	fake.pluginKeysMutex.RLock()
+	defer fake.pluginKeysMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removePluginKeyMutex.RLock()
+	defer fake.removePluginKeyMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
 	defer fake.writePluginConfigMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
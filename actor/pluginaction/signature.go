@@ -0,0 +1,77 @@
+package pluginaction
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util"
+	"golang.org/x/crypto/ed25519"
+)
+
+// PluginSignatureExtension is appended to a plugin's path or URL to locate
+// its detached signature.
+const PluginSignatureExtension = ".sig"
+
+// GetDetachedPluginSignature returns the contents of the signature file
+// published next to a plugin binary, either on disk or at a URL. An empty
+// string is returned when no signature can be found.
+func (actor Actor) GetDetachedPluginSignature(pluginLocation string, tempPluginDir string) string {
+	signatureLocation := pluginLocation + PluginSignatureExtension
+
+	if !util.IsHTTPScheme(pluginLocation) {
+		raw, err := ioutil.ReadFile(signatureLocation)
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(raw))
+	}
+
+	tempFile, err := makeTempFile(tempPluginDir)
+	if err != nil {
+		return ""
+	}
+
+	err = actor.client.DownloadPlugin(signatureLocation, tempFile.Name(), nil)
+	if err != nil {
+		return ""
+	}
+
+	raw, err := ioutil.ReadFile(tempFile.Name())
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+// VerifyPluginSignature checks that signature is a base64-encoded Ed25519
+// signature of the SHA-256 digest of the file at path, made by one of the
+// trusted plugin keys.
+func (actor Actor) VerifyPluginSignature(path string, signature string) error {
+	if signature == "" {
+		return actionerror.PluginNotSignedError{}
+	}
+
+	rawSignature, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return actionerror.PluginSignatureInvalidError{}
+	}
+
+	digest, err := fileSHA256(path)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range actor.config.PluginKeys() {
+		publicKey, err := decodePluginKey(key.PublicKey)
+		if err != nil {
+			continue
+		}
+		if ed25519.Verify(publicKey, digest, rawSignature) {
+			return nil
+		}
+	}
+
+	return actionerror.PluginSignatureInvalidError{}
+}
@@ -0,0 +1,174 @@
+package pluginaction_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ed25519"
+)
+
+var _ = Describe("Signature Actions", func() {
+	var (
+		actor            *Actor
+		fakeConfig       *pluginactionfakes.FakeConfig
+		fakePluginClient *pluginactionfakes.FakePluginClient
+		tempDir          string
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		fakePluginClient = new(pluginactionfakes.FakePluginClient)
+		actor = NewActor(fakeConfig, fakePluginClient)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "signature-actions-test")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("GetDetachedPluginSignature", func() {
+		When("the plugin is a local file", func() {
+			var pluginPath string
+
+			BeforeEach(func() {
+				pluginPath = filepath.Join(tempDir, "some-plugin")
+			})
+
+			When("the signature file exists", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(pluginPath+".sig", []byte("some-signature\n"), 0600)).To(Succeed())
+				})
+
+				It("returns the signature", func() {
+					Expect(actor.GetDetachedPluginSignature(pluginPath, tempDir)).To(Equal("some-signature"))
+				})
+			})
+
+			When("the signature file does not exist", func() {
+				It("returns an empty signature", func() {
+					Expect(actor.GetDetachedPluginSignature(pluginPath, tempDir)).To(BeEmpty())
+				})
+			})
+		})
+
+		When("the plugin is a URL", func() {
+			When("the signature can be downloaded", func() {
+				BeforeEach(func() {
+					fakePluginClient.DownloadPluginStub = func(_ string, path string, _ plugin.ProxyReader) error {
+						return ioutil.WriteFile(path, []byte("some-signature"), 0600)
+					}
+				})
+
+				It("downloads the signature next to the plugin URL", func() {
+					Expect(actor.GetDetachedPluginSignature("https://example.com/some-plugin", tempDir)).To(Equal("some-signature"))
+
+					Expect(fakePluginClient.DownloadPluginCallCount()).To(Equal(1))
+					url, path, proxyReader := fakePluginClient.DownloadPluginArgsForCall(0)
+					Expect(url).To(Equal("https://example.com/some-plugin.sig"))
+					Expect(filepath.Dir(path)).To(Equal(tempDir))
+					Expect(proxyReader).To(BeNil())
+				})
+			})
+
+			When("the signature cannot be downloaded", func() {
+				BeforeEach(func() {
+					fakePluginClient.DownloadPluginReturns(errors.New("some-error"))
+				})
+
+				It("returns an empty signature", func() {
+					Expect(actor.GetDetachedPluginSignature("https://example.com/some-plugin", tempDir)).To(BeEmpty())
+				})
+			})
+		})
+	})
+
+	Describe("VerifyPluginSignature", func() {
+		var (
+			pluginPath string
+			privateKey ed25519.PrivateKey
+			signature  string
+			err        error
+		)
+
+		BeforeEach(func() {
+			pluginPath = filepath.Join(tempDir, "some-plugin")
+			Expect(ioutil.WriteFile(pluginPath, []byte("some-plugin-binary"), 0700)).To(Succeed())
+
+			var publicKey ed25519.PublicKey
+			publicKey, privateKey, err = ed25519.GenerateKey(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			digest := sha256.Sum256([]byte("some-plugin-binary"))
+			signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, digest[:]))
+
+			fakeConfig.PluginKeysReturns([]configv3.PluginKey{
+				{Name: "invalid-key", PublicKey: "c29tZS1rZXk="},
+				{Name: "some-key", PublicKey: base64.StdEncoding.EncodeToString(publicKey)},
+			})
+		})
+
+		JustBeforeEach(func() {
+			err = actor.VerifyPluginSignature(pluginPath, signature)
+		})
+
+		When("the signature was made by a trusted key", func() {
+			It("returns no error", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		When("there is no signature", func() {
+			BeforeEach(func() {
+				signature = ""
+			})
+
+			It("returns a PluginNotSignedError", func() {
+				Expect(err).To(MatchError(actionerror.PluginNotSignedError{}))
+			})
+		})
+
+		When("the signature is not base64 encoded", func() {
+			BeforeEach(func() {
+				signature = "not base64!"
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(err).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+			})
+		})
+
+		When("the binary does not match the signature", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pluginPath, []byte("some-other-binary"), 0700)).To(Succeed())
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(err).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+			})
+		})
+
+		When("the signing key is not trusted", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginKeysReturns(nil)
+			})
+
+			It("returns a PluginSignatureInvalidError", func() {
+				Expect(err).To(MatchError(actionerror.PluginSignatureInvalidError{}))
+			})
+		})
+	})
+})
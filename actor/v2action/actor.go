@@ -1,6 +1,8 @@
 // Package v2action contains the business logic for the commands/v2 package
 package v2action
 
+import "time"
+
 // Warnings is a list of warnings returned back from the cloud controller
 type Warnings []string
 
@@ -10,6 +12,11 @@ type Actor struct {
 	Config                Config
 	UAAClient             UAAClient
 
+	// Now and Sleep are used when polling the Cloud Controller for the state
+	// of an application. They can be replaced to control time in tests.
+	Now   func() time.Time
+	Sleep func(time.Duration)
+
 	domainCache map[string]Domain
 }
 
@@ -19,6 +26,8 @@ func NewActor(ccClient CloudControllerClient, uaaClient UAAClient, config Config
 		CloudControllerClient: ccClient,
 		Config:                config,
 		UAAClient:             uaaClient,
+		Now:                   time.Now,
+		Sleep:                 time.Sleep,
 		domainCache:           map[string]Domain{},
 	}
 }
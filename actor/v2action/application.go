@@ -179,6 +179,24 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 	return apps, Warnings(warnings), nil
 }
 
+// GetStoppedApplicationsUpdatedBeforeBySpace returns the stopped applications
+// in the space that have not been updated since the provided time.
+func (actor Actor) GetStoppedApplicationsUpdatedBeforeBySpace(spaceGUID string, updatedBefore time.Time) ([]Application, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	var stoppedApps []Application
+	for _, app := range apps {
+		if app.Stopped() && app.UpdatedAt.Before(updatedBefore) {
+			stoppedApps = append(stoppedApps, app)
+		}
+	}
+
+	return stoppedApps, warnings, nil
+}
+
 // GetRouteApplications returns a list of apps associated with the provided
 // Route GUID.
 func (actor Actor) GetRouteApplications(routeGUID string) ([]Application, Warnings, error) {
@@ -433,6 +433,48 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetStoppedApplicationsUpdatedBeforeBySpace", func() {
+		var cutoff time.Time
+
+		BeforeEach(func() {
+			cutoff = time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+		})
+
+		When("the space has stopped and started applications", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{
+						{GUID: "old-stopped-guid", State: constant.ApplicationStopped, UpdatedAt: cutoff.Add(-time.Hour)},
+						{GUID: "new-stopped-guid", State: constant.ApplicationStopped, UpdatedAt: cutoff.Add(time.Hour)},
+						{GUID: "old-started-guid", State: constant.ApplicationStarted, UpdatedAt: cutoff.Add(-time.Hour)},
+					},
+					ccv2.Warnings{"apps-warning"},
+					nil)
+			})
+
+			It("returns the stopped applications not updated since the cutoff", func() {
+				apps, warnings, err := actor.GetStoppedApplicationsUpdatedBeforeBySpace("some-space-guid", cutoff)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("apps-warning"))
+				Expect(apps).To(ConsistOf(
+					Application{GUID: "old-stopped-guid", State: constant.ApplicationStopped, UpdatedAt: cutoff.Add(-time.Hour)},
+				))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, errors.New("apps-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetStoppedApplicationsUpdatedBeforeBySpace("some-space-guid", cutoff)
+				Expect(err).To(MatchError("apps-error"))
+				Expect(warnings).To(ConsistOf("apps-warning"))
+			})
+		})
+	})
+
 	Describe("GetRouteApplications", func() {
 		When("the CC client returns no errors", func() {
 			BeforeEach(func() {
@@ -159,12 +159,18 @@ func isEmptyDirectory(name string) (bool, error) {
 }
 
 func (actor *Actor) UploadBuildpack(GUID string, pathToBuildpackBits string, progBar SimpleProgressBar) (Warnings, error) {
+	return actor.uploadBuildpackAs(GUID, pathToBuildpackBits, pathToBuildpackBits, progBar)
+}
+
+// uploadBuildpackAs uploads the bits at pathToBuildpackBits under the file
+// name of uploadPath.
+func (actor *Actor) uploadBuildpackAs(GUID string, pathToBuildpackBits string, uploadPath string, progBar SimpleProgressBar) (Warnings, error) {
 	progressBarReader, size, err := progBar.Initialize(pathToBuildpackBits)
 	if err != nil {
 		return Warnings{}, err
 	}
 
-	warnings, err := actor.CloudControllerClient.UploadBuildpack(GUID, pathToBuildpackBits, progressBarReader, size)
+	warnings, err := actor.CloudControllerClient.UploadBuildpack(GUID, uploadPath, progressBarReader, size)
 	if err != nil {
 		if e, ok := err.(ccerror.BuildpackAlreadyExistsForStackError); ok {
 			return Warnings(warnings), actionerror.BuildpackAlreadyExistsForStackError{Message: e.Message}
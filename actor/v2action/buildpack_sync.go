@@ -0,0 +1,258 @@
+package v2action
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/buildpackfile"
+)
+
+// BuildpackSyncChange describes what has to be done to a single buildpack to
+// match a buildpacks file.
+type BuildpackSyncChange struct {
+	// Buildpack is the desired state of the buildpack. Its GUID is empty if the
+	// buildpack has to be created.
+	Buildpack Buildpack
+
+	// PathToBits is the prepared zip file of the buildpack's bits and Checksum
+	// its SHA-256 checksum. Both are empty for buildpacks being deleted.
+	PathToBits string
+	Checksum   string
+
+	Create bool
+	Update bool
+	Upload bool
+	Delete bool
+}
+
+// Unchanged returns true if the buildpack already matches the buildpacks
+// file.
+func (change BuildpackSyncChange) Unchanged() bool {
+	return !change.Create && !change.Update && !change.Upload && !change.Delete
+}
+
+// GetBuildpackSyncChanges compares the buildpacks in the buildpacks file with
+// the buildpacks on the Cloud Controller. The bits of every buildpack in the
+// file are prepared in tmpDirPath and compared by checksum with the last
+// uploaded bits. When deleteUnlisted is true, buildpacks missing from the file
+// are deleted.
+//
+// Deletions are returned first, followed by the buildpacks in the file sorted
+// by position, which is the order they should be applied in.
+func (actor *Actor) GetBuildpackSyncChanges(desired []buildpackfile.Buildpack, deleteUnlisted bool, tmpDirPath string, downloader Downloader) ([]BuildpackSyncChange, Warnings, error) {
+	ccBuildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks()
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	sorted := make([]buildpackfile.Buildpack, len(desired))
+	copy(sorted, desired)
+	sort.SliceStable(sorted, func(i int, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	matched := map[string]bool{}
+	var changes []BuildpackSyncChange
+	for _, buildpack := range sorted {
+		existing, found := findSyncBuildpack(ccBuildpacks, buildpack)
+		if found {
+			matched[existing.GUID] = true
+		}
+
+		change, changeErr := actor.getBuildpackSyncChange(buildpack, existing, found, tmpDirPath, downloader)
+		if changeErr != nil {
+			return nil, Warnings(warnings), changeErr
+		}
+		changes = append(changes, change)
+	}
+
+	if deleteUnlisted {
+		var deletions []BuildpackSyncChange
+		for _, ccBuildpack := range ccBuildpacks {
+			if !matched[ccBuildpack.GUID] {
+				deletions = append(deletions, BuildpackSyncChange{Buildpack: Buildpack(ccBuildpack), Delete: true})
+			}
+		}
+		changes = append(deletions, changes...)
+	}
+
+	return changes, Warnings(warnings), nil
+}
+
+// ApplyBuildpackSyncChange creates, updates, uploads or deletes the buildpack
+// as described by the change. Uploaded bits are named by syncUploadFilename so
+// that unchanged bits are not uploaded again.
+func (actor *Actor) ApplyBuildpackSyncChange(change BuildpackSyncChange, progBar SimpleProgressBar) (Warnings, error) {
+	var allWarnings Warnings
+	buildpack := change.Buildpack
+
+	if change.Delete {
+		warnings, err := actor.CloudControllerClient.DeleteBuildpack(buildpack.GUID)
+		return Warnings(warnings), err
+	}
+
+	if change.Create {
+		created, warnings, err := actor.CloudControllerClient.CreateBuildpack(ccv2.Buildpack{
+			Name:     buildpack.Name,
+			Stack:    buildpack.Stack,
+			Position: buildpack.Position,
+			Enabled:  buildpack.Enabled,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			switch err.(type) {
+			case ccerror.BuildpackAlreadyExistsWithoutStackError:
+				return allWarnings, actionerror.BuildpackAlreadyExistsWithoutStackError{BuildpackName: buildpack.Name}
+			case ccerror.BuildpackNameTakenError:
+				return allWarnings, actionerror.BuildpackNameTakenError{Name: buildpack.Name}
+			default:
+				return allWarnings, err
+			}
+		}
+		buildpack.GUID = created.GUID
+	}
+
+	// Bits cannot be uploaded to a locked buildpack, so it is locked again
+	// after the upload.
+	relock := change.Upload && buildpack.Locked.IsSet && buildpack.Locked.Value
+	if change.Update || relock {
+		unlocked := buildpack
+		if relock {
+			unlocked.Locked = types.NullBool{IsSet: true, Value: false}
+		}
+
+		_, warnings, err := actor.UpdateBuildpack(unlocked)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if change.Upload {
+		warnings, err := actor.uploadBuildpackAs(buildpack.GUID, change.PathToBits, syncUploadFilename(buildpack.Name, change.Checksum), progBar)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if relock {
+		_, warnings, err := actor.UpdateBuildpack(buildpack)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor *Actor) getBuildpackSyncChange(desired buildpackfile.Buildpack, existing ccv2.Buildpack, found bool, tmpDirPath string, downloader Downloader) (BuildpackSyncChange, error) {
+	bitsDir, err := ioutil.TempDir(tmpDirPath, "buildpack-")
+	if err != nil {
+		return BuildpackSyncChange{}, err
+	}
+
+	pathToBits, err := actor.PrepareBuildpackBits(desired.Path, bitsDir, downloader)
+	if err != nil {
+		return BuildpackSyncChange{}, err
+	}
+
+	checksum, err := sha256Checksum(pathToBits)
+	if err != nil {
+		return BuildpackSyncChange{}, err
+	}
+
+	change := BuildpackSyncChange{
+		Buildpack: Buildpack{
+			GUID:     existing.GUID,
+			Name:     desired.Name,
+			Stack:    desired.Stack,
+			Position: types.NullInt{IsSet: true, Value: desired.Position},
+			Enabled:  existing.Enabled,
+			Locked:   existing.Locked,
+		},
+		PathToBits: pathToBits,
+		Checksum:   checksum,
+	}
+	if desired.Enabled != nil {
+		change.Buildpack.Enabled = types.NullBool{IsSet: true, Value: *desired.Enabled}
+	}
+	if desired.Locked != nil {
+		change.Buildpack.Locked = types.NullBool{IsSet: true, Value: *desired.Locked}
+	}
+
+	if !found {
+		if !change.Buildpack.Enabled.IsSet {
+			change.Buildpack.Enabled = types.NullBool{IsSet: true, Value: true}
+		}
+		change.Create = true
+		change.Upload = true
+		change.Update = change.Buildpack.Locked.IsSet && change.Buildpack.Locked.Value
+		return change, nil
+	}
+
+	// A buildpack without a stack in the file keeps its existing stack, and
+	// a buildpack without a stack on the Cloud Controller is assigned the one
+	// in the file.
+	if change.Buildpack.Stack == "" {
+		change.Buildpack.Stack = existing.Stack
+	}
+	change.Update = change.Buildpack.Position != existing.Position ||
+		change.Buildpack.Enabled != existing.Enabled ||
+		change.Buildpack.Locked != existing.Locked ||
+		change.Buildpack.Stack != existing.Stack
+	// The Cloud Controller only records the name of the uploaded file, so bits
+	// uploaded by anything other than this command are always uploaded again.
+	change.Upload = existing.Filename != syncUploadFilename(desired.Name, checksum)
+	return change, nil
+}
+
+// syncUploadFilename returns the file name bits are uploaded as, which
+// records their checksum on the Cloud Controller.
+func syncUploadFilename(buildpackName string, checksum string) string {
+	return fmt.Sprintf("%s_%s.zip", buildpackName, checksum)
+}
+
+// findSyncBuildpack returns the existing buildpack with the same name and
+// stack. If there is only one buildpack with that name, it is matched when
+// either it or the buildpack in the file has no stack.
+func findSyncBuildpack(ccBuildpacks []ccv2.Buildpack, desired buildpackfile.Buildpack) (ccv2.Buildpack, bool) {
+	var sameName []ccv2.Buildpack
+	for _, ccBuildpack := range ccBuildpacks {
+		if ccBuildpack.Name != desired.Name {
+			continue
+		}
+		if ccBuildpack.Stack == desired.Stack {
+			return ccBuildpack, true
+		}
+		sameName = append(sameName, ccBuildpack)
+	}
+
+	if len(sameName) == 1 && (desired.Stack == "" || sameName[0].Stack == "") {
+		return sameName[0], true
+	}
+	return ccv2.Buildpack{}, false
+}
+
+func sha256Checksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
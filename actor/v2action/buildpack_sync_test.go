@@ -0,0 +1,362 @@
+package v2action_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/buildpackfile"
+)
+
+var _ = Describe("Buildpack Sync", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetBuildpackSyncChanges", func() {
+		var (
+			tmpDir         string
+			bitsPath       string
+			checksum       string
+			desired        []buildpackfile.Buildpack
+			deleteUnlisted bool
+
+			changes    []BuildpackSyncChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "buildpack-sync")
+			Expect(err).ToNot(HaveOccurred())
+
+			bitsPath = filepath.Join(tmpDir, "some-buildpack.zip")
+			Expect(ioutil.WriteFile(bitsPath, []byte("some-bits"), 0600)).To(Succeed())
+			checksum = fmt.Sprintf("%x", sha256.Sum256([]byte("some-bits")))
+
+			deleteUnlisted = false
+			enabled := false
+			desired = []buildpackfile.Buildpack{
+				{Name: "new-buildpack", Position: 2, Enabled: &enabled, Path: bitsPath},
+				{Name: "existing-buildpack", Stack: "some-stack", Position: 1, Path: bitsPath},
+			}
+
+			fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{
+				{
+					GUID:     "existing-guid",
+					Name:     "existing-buildpack",
+					Stack:    "some-stack",
+					Position: types.NullInt{IsSet: true, Value: 1},
+					Enabled:  types.NullBool{IsSet: true, Value: true},
+					Locked:   types.NullBool{IsSet: true, Value: false},
+					Filename: "existing-buildpack_" + checksum + ".zip",
+				},
+				{
+					GUID:     "unlisted-guid",
+					Name:     "unlisted-buildpack",
+					Position: types.NullInt{IsSet: true, Value: 2},
+				},
+			}, ccv2.Warnings{"get-warning"}, nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetBuildpackSyncChanges(desired, deleteUnlisted, tmpDir, nil)
+		})
+
+		It("returns the changes sorted by position", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-warning"))
+			Expect(changes).To(HaveLen(2))
+
+			Expect(changes[0].Buildpack.GUID).To(Equal("existing-guid"))
+			Expect(changes[0].Checksum).To(Equal(checksum))
+			Expect(changes[0].Unchanged()).To(BeTrue())
+
+			Expect(changes[1]).To(Equal(BuildpackSyncChange{
+				Buildpack: Buildpack{
+					Name:     "new-buildpack",
+					Position: types.NullInt{IsSet: true, Value: 2},
+					Enabled:  types.NullBool{IsSet: true, Value: false},
+				},
+				PathToBits: bitsPath,
+				Checksum:   checksum,
+				Create:     true,
+				Upload:     true,
+			}))
+		})
+
+		When("the existing buildpack's settings and bits differ", func() {
+			BeforeEach(func() {
+				locked := true
+				desired[1].Position = 3
+				desired[1].Locked = &locked
+				Expect(ioutil.WriteFile(bitsPath, []byte("other-bits"), 0600)).To(Succeed())
+			})
+
+			It("updates and uploads the buildpack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes[1].Buildpack.GUID).To(Equal("existing-guid"))
+				Expect(changes[1].Buildpack.Position).To(Equal(types.NullInt{IsSet: true, Value: 3}))
+				Expect(changes[1].Buildpack.Enabled).To(Equal(types.NullBool{IsSet: true, Value: true}))
+				Expect(changes[1].Buildpack.Locked).To(Equal(types.NullBool{IsSet: true, Value: true}))
+				Expect(changes[1].Update).To(BeTrue())
+				Expect(changes[1].Upload).To(BeTrue())
+			})
+		})
+
+		When("unlisted buildpacks should be deleted", func() {
+			BeforeEach(func() {
+				deleteUnlisted = true
+			})
+
+			It("returns the deletions first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(HaveLen(3))
+				Expect(changes[0].Delete).To(BeTrue())
+				Expect(changes[0].Buildpack.GUID).To(Equal("unlisted-guid"))
+			})
+		})
+
+		When("a buildpack without a stack matches the only buildpack with that name", func() {
+			BeforeEach(func() {
+				desired[1].Stack = ""
+			})
+
+			It("matches the existing buildpack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes[0].Buildpack.GUID).To(Equal("existing-guid"))
+				Expect(changes[0].Buildpack.Stack).To(Equal("some-stack"))
+			})
+		})
+
+		When("the existing buildpack has no stack and the file sets one", func() {
+			BeforeEach(func() {
+				desired = desired[1:]
+				fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{
+					{
+						GUID:     "existing-guid",
+						Name:     "existing-buildpack",
+						Position: types.NullInt{IsSet: true, Value: 1},
+						Filename: "existing-buildpack_" + checksum + ".zip",
+					},
+				}, nil, nil)
+			})
+
+			It("updates the stack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(HaveLen(1))
+				Expect(changes[0].Buildpack.GUID).To(Equal("existing-guid"))
+				Expect(changes[0].Buildpack.Stack).To(Equal("some-stack"))
+				Expect(changes[0].Update).To(BeTrue())
+				Expect(changes[0].Upload).To(BeFalse())
+			})
+		})
+
+		When("the existing bits were uploaded by another tool", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns([]ccv2.Buildpack{
+					{
+						GUID:     "existing-guid",
+						Name:     "existing-buildpack",
+						Stack:    "some-stack",
+						Position: types.NullInt{IsSet: true, Value: 1},
+						Enabled:  types.NullBool{IsSet: true, Value: true},
+						Locked:   types.NullBool{IsSet: true, Value: false},
+						Filename: "build-" + checksum + "-existing-buildpack.zip",
+					},
+				}, nil, nil)
+			})
+
+			It("uploads the bits again", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes[0].Buildpack.GUID).To(Equal("existing-guid"))
+				Expect(changes[0].Update).To(BeFalse())
+				Expect(changes[0].Upload).To(BeTrue())
+			})
+		})
+
+		When("the bits cannot be prepared", func() {
+			BeforeEach(func() {
+				desired[0].Path = filepath.Join(tmpDir, "does-not-exist")
+			})
+
+			It("returns the error", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			})
+		})
+
+		When("getting the buildpacks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv2.Warnings{"get-warning"}, errors.New("get-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-error"))
+				Expect(warnings).To(ConsistOf("get-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyBuildpackSyncChange", func() {
+		var (
+			change BuildpackSyncChange
+			fakePb *v2actionfakes.FakeSimpleProgressBar
+			reader *strings.Reader
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakePb = new(v2actionfakes.FakeSimpleProgressBar)
+			reader = strings.NewReader("some-bits")
+			fakePb.InitializeReturns(reader, 9, nil)
+
+			fakeCloudControllerClient.CreateBuildpackReturns(ccv2.Buildpack{GUID: "created-guid"}, ccv2.Warnings{"create-warning"}, nil)
+			fakeCloudControllerClient.UpdateBuildpackReturns(ccv2.Buildpack{}, ccv2.Warnings{"update-warning"}, nil)
+			fakeCloudControllerClient.UploadBuildpackReturns(ccv2.Warnings{"upload-warning"}, nil)
+			fakeCloudControllerClient.DeleteBuildpackReturns(ccv2.Warnings{"delete-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyBuildpackSyncChange(change, fakePb)
+		})
+
+		When("the buildpack is created", func() {
+			BeforeEach(func() {
+				change = BuildpackSyncChange{
+					Buildpack: Buildpack{
+						Name:     "some-buildpack",
+						Stack:    "some-stack",
+						Position: types.NullInt{IsSet: true, Value: 2},
+						Enabled:  types.NullBool{IsSet: true, Value: true},
+					},
+					PathToBits: "/some/path.zip",
+					Checksum:   "some-checksum",
+					Create:     true,
+					Upload:     true,
+				}
+			})
+
+			It("creates the buildpack and uploads the bits named after their checksum", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning", "upload-warning"))
+
+				Expect(fakeCloudControllerClient.CreateBuildpackCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateBuildpackArgsForCall(0)).To(Equal(ccv2.Buildpack{
+					Name:     "some-buildpack",
+					Stack:    "some-stack",
+					Position: types.NullInt{IsSet: true, Value: 2},
+					Enabled:  types.NullBool{IsSet: true, Value: true},
+				}))
+
+				Expect(fakeCloudControllerClient.UpdateBuildpackCallCount()).To(Equal(0))
+
+				Expect(fakePb.InitializeArgsForCall(0)).To(Equal("/some/path.zip"))
+				Expect(fakeCloudControllerClient.UploadBuildpackCallCount()).To(Equal(1))
+				guid, uploadPath, uploadReader, size := fakeCloudControllerClient.UploadBuildpackArgsForCall(0)
+				Expect(guid).To(Equal("created-guid"))
+				Expect(uploadPath).To(Equal("some-buildpack_some-checksum.zip"))
+				Expect(uploadReader).To(Equal(reader))
+				Expect(size).To(Equal(int64(9)))
+			})
+
+			When("the name is taken", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.CreateBuildpackReturns(ccv2.Buildpack{}, nil, ccerror.BuildpackNameTakenError{})
+				})
+
+				It("returns a BuildpackNameTakenError", func() {
+					Expect(executeErr).To(MatchError(actionerror.BuildpackNameTakenError{Name: "some-buildpack"}))
+					Expect(fakeCloudControllerClient.UploadBuildpackCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("a locked buildpack is updated and uploaded", func() {
+			BeforeEach(func() {
+				change = BuildpackSyncChange{
+					Buildpack: Buildpack{
+						GUID:     "some-guid",
+						Name:     "some-buildpack",
+						Position: types.NullInt{IsSet: true, Value: 2},
+						Locked:   types.NullBool{IsSet: true, Value: true},
+					},
+					PathToBits: "/some/path.zip",
+					Checksum:   "some-checksum",
+					Update:     true,
+					Upload:     true,
+				}
+			})
+
+			It("unlocks the buildpack for the upload and locks it again", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-warning", "upload-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateBuildpackCallCount()).To(Equal(2))
+				unlocked := fakeCloudControllerClient.UpdateBuildpackArgsForCall(0)
+				Expect(unlocked.GUID).To(Equal("some-guid"))
+				Expect(unlocked.Position).To(Equal(types.NullInt{IsSet: true, Value: 2}))
+				Expect(unlocked.Locked).To(Equal(types.NullBool{IsSet: true, Value: false}))
+
+				locked := fakeCloudControllerClient.UpdateBuildpackArgsForCall(1)
+				Expect(locked.Locked).To(Equal(types.NullBool{IsSet: true, Value: true}))
+			})
+		})
+
+		When("only the settings are updated", func() {
+			BeforeEach(func() {
+				change = BuildpackSyncChange{
+					Buildpack: Buildpack{GUID: "some-guid", Name: "some-buildpack"},
+					Update:    true,
+				}
+			})
+
+			It("does not upload any bits", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateBuildpackCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UploadBuildpackCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the buildpack is deleted", func() {
+			BeforeEach(func() {
+				change = BuildpackSyncChange{
+					Buildpack: Buildpack{GUID: "some-guid", Name: "some-buildpack"},
+					Delete:    true,
+				}
+			})
+
+			It("deletes the buildpack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeCloudControllerClient.DeleteBuildpackCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteBuildpackArgsForCall(0)).To(Equal("some-guid"))
+			})
+		})
+	})
+})
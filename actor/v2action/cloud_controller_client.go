@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 //go:generate counterfeiter . CloudControllerClient
@@ -14,16 +15,25 @@ type CloudControllerClient interface {
 	CreateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	CreateBuildpack(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error)
 	CreateOrganization(orgName string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	CreatePrivateDomain(domainName string, orgGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
+	CreateSecurityGroup(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
 	CreateServiceBinding(appGUID string, serviceBindingGUID string, bindingName string, acceptsIncomplete bool, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	CreateSpace(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error)
+	CreateSpaceQuotaDefinition(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
 	CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
+	DeleteBuildpack(buildpackGUID string) (ccv2.Warnings, error)
 	DeleteOrganizationJob(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
 	DeleteRouteApplication(routeGUID string, appGUID string) (ccv2.Warnings, error)
 	DeleteSecurityGroupSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	DeleteSecurityGroupStagingSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string, acceptsIncomplete bool) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	DeleteServiceInstance(serviceInstanceGUID string) (ccv2.Warnings, error)
+	DeleteServiceKey(serviceKeyGUID string) (ccv2.Warnings, error)
 	DeleteSpaceJob(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
+	DeleteUser(userGUID string) (ccv2.Warnings, error)
+	DeleteUserProvidedServiceInstance(userProvidedServiceInstanceGUID string) (ccv2.Warnings, error)
 	GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error)
 	GetApplicationApplicationInstances(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error)
 	GetApplicationApplicationInstanceStatuses(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
@@ -31,11 +41,15 @@ type CloudControllerClient interface {
 	GetApplications(filters ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error)
 	GetBuildpacks(filters ...ccv2.Filter) ([]ccv2.Buildpack, ccv2.Warnings, error)
 	GetConfigFeatureFlags() ([]ccv2.FeatureFlag, ccv2.Warnings, error)
+	GetConfigRunningSecurityGroups() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	GetConfigStagingSecurityGroups() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	GetOrganization(guid string) (ccv2.Organization, ccv2.Warnings, error)
 	GetOrganizationPrivateDomains(orgGUID string, filters ...ccv2.Filter) ([]ccv2.Domain, ccv2.Warnings, error)
 	GetOrganizationQuota(guid string) (ccv2.OrganizationQuota, ccv2.Warnings, error)
 	GetOrganizationQuotas(filters ...ccv2.Filter) ([]ccv2.OrganizationQuota, ccv2.Warnings, error)
+	GetOrganizationSpaceQuotaDefinitions(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
+	GetOrganizationUsersByRole(role constant.UserRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetOrganizations(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error)
 	GetPrivateDomain(domainGUID string) (ccv2.Domain, ccv2.Warnings, error)
 	GetRouteApplications(routeGUID string, filters ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error)
@@ -51,6 +65,7 @@ type CloudControllerClient interface {
 	GetServiceInstanceServiceBindings(serviceInstanceGUID string) ([]ccv2.ServiceBinding, ccv2.Warnings, error)
 	GetServiceInstanceSharedFrom(serviceInstanceGUID string) (ccv2.ServiceInstanceSharedFrom, ccv2.Warnings, error)
 	GetServiceInstanceSharedTos(serviceInstanceGUID string) ([]ccv2.ServiceInstanceSharedTo, ccv2.Warnings, error)
+	GetServiceKeys(filters ...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error)
 	GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
 	GetServicePlans(filters ...ccv2.Filter) ([]ccv2.ServicePlan, ccv2.Warnings, error)
 	GetServicePlanVisibilities(filters ...ccv2.Filter) ([]ccv2.ServicePlanVisibility, ccv2.Warnings, error)
@@ -59,6 +74,7 @@ type CloudControllerClient interface {
 	GetSharedDomains(filters ...ccv2.Filter) ([]ccv2.Domain, ccv2.Warnings, error)
 	GetSpaceQuotaDefinition(guid string) (ccv2.SpaceQuota, ccv2.Warnings, error)
 	GetSpaceRoutes(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error)
+	GetSpaceUsersByRole(role constant.UserRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
 	GetSpaces(filters ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error)
 	GetSpaceSecurityGroups(spaceGUID string, filters ...ccv2.Filter) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	GetSpaceServiceInstances(spaceGUID string, includeUserProvidedServices bool, filters ...ccv2.Filter) ([]ccv2.ServiceInstance, ccv2.Warnings, error)
@@ -72,10 +88,16 @@ type CloudControllerClient interface {
 	UpdateApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
 	UpdateBuildpack(buildpack ccv2.Buildpack) (ccv2.Buildpack, ccv2.Warnings, error)
 	UpdateOrganizationManagerByUsername(guid string, username string) (ccv2.Warnings, error)
+	UpdateOrganizationQuota(orgGUID string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	UpdateOrganizationUserByRole(role constant.UserRole, orgGUID string, username string) (ccv2.Warnings, error)
 	UpdateResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error)
 	UpdateRouteApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
+	UpdateSecurityGroup(guid string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
 	UpdateSecurityGroupSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	UpdateSecurityGroupStagingSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
+	UpdateSpaceQuotaDefinition(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	UpdateSpaceQuotaDefinitionSpace(spaceQuotaGUID string, spaceGUID string) (ccv2.Warnings, error)
+	UpdateSpaceUserByRole(role constant.UserRole, spaceGUID string, username string) (ccv2.Warnings, error)
 	UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
 	UploadBuildpack(buildpackGUID string, buildpackPath string, buildpack io.Reader, buildpackLength int64) (ccv2.Warnings, error)
 	UploadDroplet(appGUID string, droplet io.Reader, dropletLength int64) (ccv2.Job, ccv2.Warnings, error)
@@ -6,10 +6,14 @@ import "time"
 
 type Config interface {
 	AccessToken() string
+	CACertFile() string
+	ClientCertFile() string
+	ClientKeyFile() string
 	PollingInterval() time.Duration
 	RefreshToken() string
 	SetAccessToken(accessToken string)
 	SetRefreshToken(refreshToken string)
+	SetTLSCertificateFiles(caCertFile string, clientCertFile string, clientKeyFile string)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SetUAAClientCredentials(client string, clientSecret string)
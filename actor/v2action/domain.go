@@ -40,6 +40,17 @@ func isResourceNotFoundError(err error) bool {
 	return isResourceNotFound
 }
 
+// CreatePrivateDomain creates a private domain owned by the organization.
+func (actor Actor) CreatePrivateDomain(domainName string, orgGUID string) (Domain, Warnings, error) {
+	domain, warnings, err := actor.CloudControllerClient.CreatePrivateDomain(domainName, orgGUID)
+	if err != nil {
+		return Domain{}, Warnings(warnings), err
+	}
+
+	actor.saveDomain(domain)
+	return Domain(domain), Warnings(warnings), nil
+}
+
 // GetDomain returns the shared or private domain associated with the provided
 // Domain GUID.
 func (actor Actor) GetDomain(domainGUID string) (Domain, Warnings, error) {
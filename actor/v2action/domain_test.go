@@ -595,4 +595,43 @@ var _ = Describe("Domain Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreatePrivateDomain", func() {
+		When("the domain is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreatePrivateDomainReturns(
+					ccv2.Domain{GUID: "some-domain-guid", Name: "some-domain", Type: constant.PrivateDomain},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the domain and caches it", func() {
+				domain, warnings, err := actor.CreatePrivateDomain("some-domain", "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(domain).To(Equal(Domain{GUID: "some-domain-guid", Name: "some-domain", Type: constant.PrivateDomain}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				domainName, orgGUID := fakeCloudControllerClient.CreatePrivateDomainArgsForCall(0)
+				Expect(domainName).To(Equal("some-domain"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+
+				_, _, err = actor.GetDomain("some-domain-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetSharedDomainCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetPrivateDomainCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the request fails", func() {
+			It("returns the error and warnings", func() {
+				expectedErr := errors.New("create domain failed")
+				fakeCloudControllerClient.CreatePrivateDomainReturns(ccv2.Domain{}, ccv2.Warnings{"warning-1"}, expectedErr)
+
+				_, warnings, err := actor.CreatePrivateDomain("some-domain", "some-org-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
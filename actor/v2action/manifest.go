@@ -3,6 +3,7 @@ package v2action
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 )
 
 func (actor Actor) CreateApplicationManifestByNameAndSpace(appName string, spaceGUID string) (manifest.Application, Warnings, error) {
@@ -68,3 +69,7 @@ func (actor Actor) CreateApplicationManifestByNameAndSpace(appName string, space
 func (Actor) WriteApplicationManifest(manifestApp manifest.Application, manifestFilePath string) error {
 	return manifest.WriteApplicationManifest(manifestApp, manifestFilePath)
 }
+
+func (Actor) ValidateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) (manifest.ManifestValidation, error) {
+	return manifest.ValidateManifest(pathToManifest, pathsToVarsFiles, vars)
+}
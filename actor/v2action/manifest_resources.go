@@ -0,0 +1,90 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+// ValidateManifestResources checks that the stacks, service instances and
+// route domains used by the manifest applications exist in the organization
+// and space, returning an issue for each one that does not.
+func (actor Actor) ValidateManifestResources(apps []manifest.Application, orgGUID string, spaceGUID string) ([]manifest.ValidationIssue, Warnings, error) {
+	var (
+		allWarnings Warnings
+		issues      []manifest.ValidationIssue
+	)
+
+	domains, warnings, err := actor.GetOrganizationDomains(orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var domainNames []string
+	for _, domain := range domains {
+		domainNames = append(domainNames, domain.Name)
+	}
+
+	foundStacks := map[string]bool{}
+	foundServiceInstances := map[string]bool{}
+
+	for i, app := range apps {
+		appPath := fmt.Sprintf("applications[%d]", i)
+
+		if app.StackName != "" {
+			found, cached := foundStacks[app.StackName]
+			if !cached {
+				_, warnings, err = actor.GetStackByName(app.StackName)
+				allWarnings = append(allWarnings, warnings...)
+				if _, notFound := err.(actionerror.StackNotFoundError); err != nil && !notFound {
+					return nil, allWarnings, err
+				}
+				found = err == nil
+				foundStacks[app.StackName] = found
+			}
+
+			if !found {
+				issues = append(issues, manifest.ValidationIssue{
+					Path:     appPath + ".stack",
+					Severity: manifest.ValidationError,
+					Message:  fmt.Sprintf("stack %s does not exist", app.StackName),
+				})
+			}
+		}
+
+		for j, serviceInstanceName := range app.Services {
+			found, cached := foundServiceInstances[serviceInstanceName]
+			if !cached {
+				_, warnings, err = actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if _, notFound := err.(actionerror.ServiceInstanceNotFoundError); err != nil && !notFound {
+					return nil, allWarnings, err
+				}
+				found = err == nil
+				foundServiceInstances[serviceInstanceName] = found
+			}
+
+			if !found {
+				issues = append(issues, manifest.ValidationIssue{
+					Path:     fmt.Sprintf("%s.services[%d]", appPath, j),
+					Severity: manifest.ValidationError,
+					Message:  fmt.Sprintf("service instance %s does not exist in the targeted space", serviceInstanceName),
+				})
+			}
+		}
+
+		for j, route := range app.Routes {
+			if _, found := manifest.RouteDomain(route, domainNames); !found {
+				issues = append(issues, manifest.ValidationIssue{
+					Path:     fmt.Sprintf("%s.routes[%d].route", appPath, j),
+					Severity: manifest.ValidationError,
+					Message:  fmt.Sprintf("route %s is not on a domain of the targeted org", route),
+				})
+			}
+		}
+	}
+
+	return issues, allWarnings, nil
+}
@@ -0,0 +1,127 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/manifest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest Resources Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("ValidateManifestResources", func() {
+		var (
+			apps       []manifest.Application
+			issues     []manifest.ValidationIssue
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			apps = []manifest.Application{
+				{
+					Name:      "web",
+					StackName: "cflinuxfs3",
+					Services:  []string{"db", "missing-service"},
+					Routes:    []string{"web.example.com", "web.missing.com/path"},
+				},
+				{
+					Name:      "worker",
+					StackName: "missing-stack",
+					Services:  []string{"missing-service"},
+				},
+			}
+
+			fakeCloudControllerClient.GetSharedDomainsReturns([]ccv2.Domain{{Name: "example.com"}}, ccv2.Warnings{"shared-domains-warning"}, nil)
+			fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(nil, ccv2.Warnings{"private-domains-warning"}, nil)
+			fakeCloudControllerClient.GetStacksStub = func(filters ...ccv2.Filter) ([]ccv2.Stack, ccv2.Warnings, error) {
+				if filters[0].Values[0] == "cflinuxfs3" {
+					return []ccv2.Stack{{Name: "cflinuxfs3"}}, ccv2.Warnings{"stacks-warning"}, nil
+				}
+				return nil, ccv2.Warnings{"stacks-warning"}, nil
+			}
+			fakeCloudControllerClient.GetSpaceServiceInstancesStub = func(spaceGUID string, includeUserProvidedServices bool, filters ...ccv2.Filter) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+				if filters[0].Values[0] == "db" {
+					return []ccv2.ServiceInstance{{Name: "db"}}, ccv2.Warnings{"service-instances-warning"}, nil
+				}
+				return nil, ccv2.Warnings{"service-instances-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			issues, warnings, executeErr = actor.ValidateManifestResources(apps, "some-org-guid", "some-space-guid")
+		})
+
+		It("returns an issue for every missing resource", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("shared-domains-warning"))
+			Expect(warnings).To(ContainElement("private-domains-warning"))
+			Expect(warnings).To(ContainElement("stacks-warning"))
+			Expect(warnings).To(ContainElement("service-instances-warning"))
+
+			Expect(issues).To(Equal([]manifest.ValidationIssue{
+				{Path: "applications[0].services[1]", Severity: manifest.ValidationError, Message: "service instance missing-service does not exist in the targeted space"},
+				{Path: "applications[0].routes[1].route", Severity: manifest.ValidationError, Message: "route web.missing.com/path is not on a domain of the targeted org"},
+				{Path: "applications[1].stack", Severity: manifest.ValidationError, Message: "stack missing-stack does not exist"},
+				{Path: "applications[1].services[0]", Severity: manifest.ValidationError, Message: "service instance missing-service does not exist in the targeted space"},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationPrivateDomainsCallCount()).To(Equal(1))
+			orgGUID, _ := fakeCloudControllerClient.GetOrganizationPrivateDomainsArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(2))
+			spaceGUID, _, _ := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(2))
+		})
+
+		When("getting a stack fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksStub = nil
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"stacks-warning"}, errors.New("stacks error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("stacks error"))
+				Expect(warnings).To(ContainElement("stacks-warning"))
+			})
+		})
+
+		When("getting a service instance fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesStub = nil
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"service-instances-warning"}, errors.New("service instances error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("service instances error"))
+				Expect(warnings).To(ContainElement("service-instances-warning"))
+			})
+		})
+
+		When("getting the domains fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSharedDomainsReturns(nil, ccv2.Warnings{"shared-domains-warning"}, errors.New("domains error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("domains error"))
+				Expect(warnings).To(ConsistOf("shared-domains-warning"))
+			})
+		})
+	})
+})
@@ -107,6 +107,12 @@ func (actor Actor) DeleteOrganization(orgName string) (Warnings, error) {
 	return allWarnings, err
 }
 
+// SetOrganizationQuota assigns the organization quota to the organization.
+func (actor Actor) SetOrganizationQuota(orgGUID string, quotaGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateOrganizationQuota(orgGUID, quotaGUID)
+	return Warnings(warnings), err
+}
+
 func (actor Actor) GetOrganizations() ([]Organization, Warnings, error) {
 	var returnedOrgs []Organization
 	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations()
@@ -587,4 +587,20 @@ var _ = Describe("Org Actions", func() {
 			})
 		})
 	})
+
+	Describe("SetOrganizationQuota", func() {
+		It("assigns the quota and returns the warnings", func() {
+			expectedErr := errors.New("update quota failed")
+			fakeCloudControllerClient.UpdateOrganizationQuotaReturns(ccv2.Organization{}, ccv2.Warnings{"warning-1"}, expectedErr)
+
+			warnings, err := actor.SetOrganizationQuota("some-org-guid", "some-quota-guid")
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.UpdateOrganizationQuotaCallCount()).To(Equal(1))
+			orgGUID, quotaGUID := fakeCloudControllerClient.UpdateOrganizationQuotaArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(quotaGUID).To(Equal("some-quota-guid"))
+		})
+	})
 })
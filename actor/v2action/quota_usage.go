@@ -0,0 +1,178 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// QuotaUsage is how much of its quota an organization or space uses. Limits of
+// -1 are unlimited; a space without a space quota has only unlimited limits.
+type QuotaUsage struct {
+	// Name is the name of the organization or space.
+	Name string
+
+	// QuotaName is the name of the quota, empty for a space without a space
+	// quota.
+	QuotaName string
+
+	// Memory is the memory in megabytes used by the instances of started apps.
+	Memory      int
+	MemoryLimit int
+
+	// AppInstances is the number of instances of started apps.
+	AppInstances     int
+	AppInstanceLimit int
+
+	Routes     int
+	RouteLimit int
+
+	// ServiceInstances is the number of managed service instances; user
+	// provided service instances do not count against quotas.
+	ServiceInstances     int
+	ServiceInstanceLimit int
+
+	// ReservedRoutePorts is the number of routes with a TCP port.
+	ReservedRoutePorts     int
+	ReservedRoutePortLimit int
+}
+
+// OrganizationQuotaUsage is the quota usage of an organization and of each of
+// its spaces.
+type OrganizationQuotaUsage struct {
+	QuotaUsage
+	Spaces []QuotaUsage
+}
+
+// GetOrganizationQuotaUsage adds up the apps, routes and service instances of
+// every space in the organization and returns them with the organization and
+// space quota limits. Spaces are sorted by name.
+func (actor Actor) GetOrganizationQuotaUsage(orgName string) (OrganizationQuotaUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	usage := OrganizationQuotaUsage{
+		QuotaUsage: unlimitedQuotaUsage(org.Name),
+	}
+
+	if org.QuotaDefinitionGUID != "" {
+		quota, quotaWarnings, quotaErr := actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+		allWarnings = append(allWarnings, quotaWarnings...)
+		if quotaErr != nil {
+			return OrganizationQuotaUsage{}, allWarnings, quotaErr
+		}
+
+		usage.QuotaName = quota.Name
+		usage.MemoryLimit = quota.MemoryLimit
+		usage.AppInstanceLimit = quota.AppInstanceLimit
+		usage.RouteLimit = quota.TotalRoutes
+		usage.ServiceInstanceLimit = quota.TotalServices
+		usage.ReservedRoutePortLimit = quota.TotalReservedRoutePorts
+	}
+
+	spaces, warnings, err := actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	for _, space := range spaces {
+		spaceUsage, spaceWarnings, spaceErr := actor.getSpaceQuotaUsage(space)
+		allWarnings = append(allWarnings, spaceWarnings...)
+		if spaceErr != nil {
+			return OrganizationQuotaUsage{}, allWarnings, spaceErr
+		}
+
+		usage.Memory += spaceUsage.Memory
+		usage.AppInstances += spaceUsage.AppInstances
+		usage.Routes += spaceUsage.Routes
+		usage.ServiceInstances += spaceUsage.ServiceInstances
+		usage.ReservedRoutePorts += spaceUsage.ReservedRoutePorts
+		usage.Spaces = append(usage.Spaces, spaceUsage)
+	}
+
+	sort.Slice(usage.Spaces, func(i, j int) bool {
+		return usage.Spaces[i].Name < usage.Spaces[j].Name
+	})
+
+	return usage, allWarnings, nil
+}
+
+func (actor Actor) getSpaceQuotaUsage(space Space) (QuotaUsage, Warnings, error) {
+	var allWarnings Warnings
+
+	usage := unlimitedQuotaUsage(space.Name)
+
+	if space.SpaceQuotaDefinitionGUID != "" {
+		quota, warnings, err := actor.GetSpaceQuota(space.SpaceQuotaDefinitionGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return QuotaUsage{}, allWarnings, err
+		}
+
+		usage.QuotaName = quota.Name
+		usage.MemoryLimit = quota.MemoryLimit
+		usage.AppInstanceLimit = quota.AppInstanceLimit
+		usage.RouteLimit = quota.TotalRoutes
+		usage.ServiceInstanceLimit = quota.TotalServices
+		usage.ReservedRoutePortLimit = quota.TotalReservedRoutePorts
+	}
+
+	apps, warnings, err := actor.GetApplicationsBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	for _, app := range apps {
+		if app.State != constant.ApplicationStarted {
+			continue
+		}
+		usage.Memory += int(app.Memory.Value) * app.Instances.Value
+		usage.AppInstances += app.Instances.Value
+	}
+
+	// The routes' domains are not needed, so the client is used directly.
+	routes, ccWarnings, err := actor.CloudControllerClient.GetSpaceRoutes(space.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	usage.Routes = len(routes)
+	for _, route := range routes {
+		if route.Port.IsSet {
+			usage.ReservedRoutePorts++
+		}
+	}
+
+	serviceInstances, warnings, err := actor.GetServiceInstancesBySpace(space.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return QuotaUsage{}, allWarnings, err
+	}
+
+	for _, serviceInstance := range serviceInstances {
+		if serviceInstance.IsManaged() {
+			usage.ServiceInstances++
+		}
+	}
+
+	return usage, allWarnings, nil
+}
+
+func unlimitedQuotaUsage(name string) QuotaUsage {
+	return QuotaUsage{
+		Name:                   name,
+		MemoryLimit:            -1,
+		AppInstanceLimit:       -1,
+		RouteLimit:             -1,
+		ServiceInstanceLimit:   -1,
+		ReservedRoutePortLimit: -1,
+	}
+}
@@ -0,0 +1,214 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("GetOrganizationQuotaUsage", func() {
+		var (
+			usage      OrganizationQuotaUsage
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			usage, warnings, executeErr = actor.GetOrganizationQuotaUsage("some-org")
+		})
+
+		When("the organization exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "org-quota-guid"}},
+					ccv2.Warnings{"get-org-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetOrganizationQuotaReturns(
+					ccv2.OrganizationQuota{
+						Name:                    "default",
+						MemoryLimit:             4096,
+						AppInstanceLimit:        -1,
+						TotalRoutes:             10,
+						TotalServices:           5,
+						TotalReservedRoutePorts: 0,
+					},
+					ccv2.Warnings{"get-org-quota-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv2.Space{
+						{GUID: "space-guid-2", Name: "prod", SpaceQuotaDefinitionGUID: "space-quota-guid"},
+						{GUID: "space-guid-1", Name: "dev"},
+					},
+					ccv2.Warnings{"get-spaces-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpaceQuotaDefinitionReturns(
+					ccv2.SpaceQuota{
+						Name:                    "small",
+						MemoryLimit:             1024,
+						AppInstanceLimit:        4,
+						TotalRoutes:             -1,
+						TotalServices:           2,
+						TotalReservedRoutePorts: 1,
+					},
+					ccv2.Warnings{"get-space-quota-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationsStub = func(filters ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error) {
+					if filters[0].Values[0] == "space-guid-2" {
+						return []ccv2.Application{
+							{
+								State:     constant.ApplicationStarted,
+								Memory:    types.NullByteSizeInMb{IsSet: true, Value: 256},
+								Instances: types.NullInt{IsSet: true, Value: 3},
+							},
+							{
+								State:     constant.ApplicationStopped,
+								Memory:    types.NullByteSizeInMb{IsSet: true, Value: 1024},
+								Instances: types.NullInt{IsSet: true, Value: 1},
+							},
+						}, ccv2.Warnings{"get-apps-warning"}, nil
+					}
+					return []ccv2.Application{
+						{
+							State:     constant.ApplicationStarted,
+							Memory:    types.NullByteSizeInMb{IsSet: true, Value: 512},
+							Instances: types.NullInt{IsSet: true, Value: 1},
+						},
+					}, nil, nil
+				}
+				fakeCloudControllerClient.GetSpaceRoutesStub = func(spaceGUID string, _ ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error) {
+					if spaceGUID == "space-guid-2" {
+						return []ccv2.Route{
+							{Host: "web"},
+							{Port: types.NullInt{IsSet: true, Value: 1024}},
+						}, ccv2.Warnings{"get-routes-warning"}, nil
+					}
+					return []ccv2.Route{{Host: "dev"}}, nil, nil
+				}
+				fakeCloudControllerClient.GetSpaceServiceInstancesStub = func(spaceGUID string, _ bool, _ ...ccv2.Filter) ([]ccv2.ServiceInstance, ccv2.Warnings, error) {
+					if spaceGUID == "space-guid-2" {
+						return []ccv2.ServiceInstance{
+							{Type: constant.ServiceInstanceTypeManagedService},
+							{Type: constant.ServiceInstanceTypeUserProvidedService},
+						}, ccv2.Warnings{"get-service-instances-warning"}, nil
+					}
+					return nil, nil, nil
+				}
+			})
+
+			It("returns the organization and space usage against their quotas", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"get-org-warning",
+					"get-org-quota-warning",
+					"get-spaces-warning",
+					"get-space-quota-warning",
+					"get-apps-warning",
+					"get-routes-warning",
+					"get-service-instances-warning",
+				))
+
+				Expect(usage).To(Equal(OrganizationQuotaUsage{
+					QuotaUsage: QuotaUsage{
+						Name:                   "some-org",
+						QuotaName:              "default",
+						Memory:                 1280,
+						MemoryLimit:            4096,
+						AppInstances:           4,
+						AppInstanceLimit:       -1,
+						Routes:                 3,
+						RouteLimit:             10,
+						ServiceInstances:       1,
+						ServiceInstanceLimit:   5,
+						ReservedRoutePorts:     1,
+						ReservedRoutePortLimit: 0,
+					},
+					Spaces: []QuotaUsage{
+						{
+							Name:                   "dev",
+							Memory:                 512,
+							MemoryLimit:            -1,
+							AppInstances:           1,
+							AppInstanceLimit:       -1,
+							Routes:                 1,
+							RouteLimit:             -1,
+							ServiceInstanceLimit:   -1,
+							ReservedRoutePortLimit: -1,
+						},
+						{
+							Name:                   "prod",
+							QuotaName:              "small",
+							Memory:                 768,
+							MemoryLimit:            1024,
+							AppInstances:           3,
+							AppInstanceLimit:       4,
+							Routes:                 2,
+							RouteLimit:             -1,
+							ServiceInstances:       1,
+							ServiceInstanceLimit:   2,
+							ReservedRoutePorts:     1,
+							ReservedRoutePortLimit: 1,
+						},
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("org-quota-guid"))
+				Expect(fakeCloudControllerClient.GetSpaceQuotaDefinitionCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpaceQuotaDefinitionArgsForCall(0)).To(Equal("space-quota-guid"))
+			})
+		})
+
+		When("the organization does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-org-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and the warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+			})
+		})
+
+		When("getting the space routes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get routes failed")
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+					ccv2.Warnings{"get-org-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns([]ccv2.Space{{GUID: "space-guid", Name: "dev"}}, nil, nil)
+				fakeCloudControllerClient.GetSpaceRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-routes-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,151 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/actor/actionerror"
+
+// SwapApplicationRoutes maps every route bound to oldApp onto newApp, waits
+// for newApp to become healthy and then unmaps those routes from oldApp. If
+// any step fails, the route mappings changed so far are reverted and a
+// RouteSwapError is returned.
+func (actor Actor) SwapApplicationRoutes(oldApp Application, newApp Application) (Routes, Warnings, error) {
+	if oldApp.GUID == newApp.GUID {
+		return nil, nil, actionerror.RouteSwapSameApplicationError{Name: newApp.Name}
+	}
+
+	var allWarnings Warnings
+
+	oldRoutes, warnings, err := actor.GetApplicationRoutes(oldApp.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(oldRoutes) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	newRoutes, warnings, err := actor.GetApplicationRoutes(newApp.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	alreadyMapped := map[string]bool{}
+	for _, route := range newRoutes {
+		alreadyMapped[route.GUID] = true
+	}
+
+	var mappedToNewApp Routes
+	for _, route := range oldRoutes {
+		if alreadyMapped[route.GUID] {
+			continue
+		}
+
+		warnings, err = actor.MapRouteToApplication(route.GUID, newApp.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			revertWarnings, revertErr := actor.revertRouteSwap(oldApp, newApp, mappedToNewApp, nil, err)
+			return nil, append(allWarnings, revertWarnings...), revertErr
+		}
+		mappedToNewApp = append(mappedToNewApp, route)
+	}
+
+	warnings, err = actor.waitForApplicationHealthy(newApp)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		revertWarnings, revertErr := actor.revertRouteSwap(oldApp, newApp, mappedToNewApp, nil, err)
+		return nil, append(allWarnings, revertWarnings...), revertErr
+	}
+
+	var unmappedFromOldApp Routes
+	for _, route := range oldRoutes {
+		warnings, err = actor.UnmapRouteFromApplication(route.GUID, oldApp.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			revertWarnings, revertErr := actor.revertRouteSwap(oldApp, newApp, mappedToNewApp, unmappedFromOldApp, err)
+			return nil, append(allWarnings, revertWarnings...), revertErr
+		}
+		unmappedFromOldApp = append(unmappedFromOldApp, route)
+	}
+
+	return oldRoutes, allWarnings, nil
+}
+
+// waitForApplicationHealthy polls the application instances until all of
+// them are running. It returns an error if the application is not started,
+// has crashed or flapping instances, or is not healthy within the startup
+// timeout.
+func (actor Actor) waitForApplicationHealthy(app Application) (Warnings, error) {
+	if !app.Started() {
+		return nil, actionerror.ApplicationNotStartedError{Name: app.Name}
+	}
+
+	var allWarnings Warnings
+	timeout := actor.Now().Add(actor.Config.StartupTimeout())
+	for {
+		healthy, warnings, err := actor.applicationHealthy(app)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil || healthy {
+			return allWarnings, err
+		}
+
+		if !actor.Now().Before(timeout) {
+			return allWarnings, actionerror.ApplicationNotHealthyError{Name: app.Name}
+		}
+		actor.Sleep(actor.Config.PollingInterval())
+	}
+}
+
+// applicationHealthy returns whether all instances of the application are
+// running, and an error if any of them crashed or is flapping.
+func (actor Actor) applicationHealthy(app Application) (bool, Warnings, error) {
+	instances, warnings, err := actor.GetApplicationInstancesByApplication(app.GUID)
+	if err != nil {
+		if _, ok := err.(actionerror.ApplicationInstancesNotFoundError); ok {
+			return false, warnings, nil
+		}
+		return false, warnings, err
+	}
+
+	running := 0
+	for _, instance := range instances {
+		if instance.Crashed() || instance.Flapping() {
+			return false, warnings, actionerror.ApplicationNotHealthyError{Name: app.Name}
+		}
+		if instance.Running() {
+			running++
+		}
+	}
+
+	return running > 0 && running == len(instances), warnings, nil
+}
+
+// revertRouteSwap remaps the unmapped routes to oldApp and unmaps the mapped
+// routes from newApp. The returned RouteSwapError records the routes that
+// could not be restored.
+func (actor Actor) revertRouteSwap(oldApp Application, newApp Application, mapped Routes, unmapped Routes, swapErr error) (Warnings, error) {
+	var (
+		allWarnings      Warnings
+		unrevertedRoutes []string
+	)
+
+	for _, route := range unmapped {
+		warnings, err := actor.MapRouteToApplication(route.GUID, oldApp.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			unrevertedRoutes = append(unrevertedRoutes, route.String())
+		}
+	}
+
+	for _, route := range mapped {
+		warnings, err := actor.UnmapRouteFromApplication(route.GUID, newApp.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			unrevertedRoutes = append(unrevertedRoutes, route.String())
+		}
+	}
+
+	return allWarnings, actionerror.RouteSwapError{
+		Err:              swapErr,
+		UnrevertedRoutes: unrevertedRoutes,
+	}
+}
@@ -0,0 +1,322 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Swap Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeConfig                *v2actionfakes.FakeConfig
+		now                       time.Time
+		sleeps                    []time.Duration
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, nil, fakeConfig)
+
+		now = time.Unix(0, 0)
+		sleeps = nil
+		actor.Now = func() time.Time {
+			return now
+		}
+		actor.Sleep = func(duration time.Duration) {
+			sleeps = append(sleeps, duration)
+			now = now.Add(duration)
+		}
+	})
+
+	Describe("SwapApplicationRoutes", func() {
+		var (
+			oldApp Application
+			newApp Application
+
+			routes     Routes
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			oldApp = Application{GUID: "old-app-guid", Name: "old-app", State: constant.ApplicationStarted}
+			newApp = Application{GUID: "new-app-guid", Name: "new-app", State: constant.ApplicationStarted}
+
+			fakeCloudControllerClient.GetSharedDomainReturns(
+				ccv2.Domain{GUID: "domain-guid", Name: "example.com"},
+				nil,
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			routes, warnings, executeErr = actor.SwapApplicationRoutes(oldApp, newApp)
+		})
+
+		When("the old and new applications are the same", func() {
+			BeforeEach(func() {
+				newApp = oldApp
+			})
+
+			It("returns a RouteSwapSameApplicationError without changing any routes", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteSwapSameApplicationError{Name: "old-app"}))
+				Expect(fakeCloudControllerClient.GetApplicationRoutesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the old application has no routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("does not map or unmap any routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+				Expect(routes).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the old application has routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRoutesStub = func(appGUID string, _ ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error) {
+					switch appGUID {
+					case "old-app-guid":
+						return []ccv2.Route{
+							{GUID: "route-guid-1", Host: "host-1", DomainGUID: "domain-guid"},
+							{GUID: "route-guid-2", Host: "host-2", DomainGUID: "domain-guid"},
+						}, ccv2.Warnings{"get-old-routes-warning"}, nil
+					default:
+						return []ccv2.Route{
+							{GUID: "route-guid-2", Host: "host-2", DomainGUID: "domain-guid"},
+						}, ccv2.Warnings{"get-new-routes-warning"}, nil
+					}
+				}
+			})
+
+			When("the new application is healthy", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationApplicationInstancesReturns(
+						map[int]ccv2.ApplicationInstance{
+							0: {ID: 0, State: constant.ApplicationInstanceRunning},
+						},
+						ccv2.Warnings{"get-instances-warning"},
+						nil)
+					fakeCloudControllerClient.UpdateRouteApplicationReturns(ccv2.Route{}, ccv2.Warnings{"map-warning"}, nil)
+					fakeCloudControllerClient.DeleteRouteApplicationReturns(ccv2.Warnings{"unmap-warning"}, nil)
+				})
+
+				It("maps the missing routes to the new app and unmaps all routes from the old app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-old-routes-warning", "get-new-routes-warning", "map-warning", "get-instances-warning", "unmap-warning", "unmap-warning"))
+					Expect(routes).To(HaveLen(2))
+					Expect(routes.Summary()).To(Equal("host-1.example.com, host-2.example.com"))
+
+					Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(1))
+					routeGUID, appGUID := fakeCloudControllerClient.UpdateRouteApplicationArgsForCall(0)
+					Expect(routeGUID).To(Equal("route-guid-1"))
+					Expect(appGUID).To(Equal("new-app-guid"))
+
+					Expect(fakeCloudControllerClient.GetApplicationApplicationInstancesCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationApplicationInstancesArgsForCall(0)).To(Equal("new-app-guid"))
+
+					Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(2))
+					routeGUID, appGUID = fakeCloudControllerClient.DeleteRouteApplicationArgsForCall(0)
+					Expect(routeGUID).To(Equal("route-guid-1"))
+					Expect(appGUID).To(Equal("old-app-guid"))
+					routeGUID, appGUID = fakeCloudControllerClient.DeleteRouteApplicationArgsForCall(1)
+					Expect(routeGUID).To(Equal("route-guid-2"))
+					Expect(appGUID).To(Equal("old-app-guid"))
+				})
+
+				When("unmapping a route from the old app fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = errors.New("unmap-error")
+						fakeCloudControllerClient.DeleteRouteApplicationReturnsOnCall(1, ccv2.Warnings{"unmap-warning"}, expectedErr)
+					})
+
+					It("remaps the unmapped routes to the old app and unmaps the new mappings", func() {
+						Expect(executeErr).To(MatchError(actionerror.RouteSwapError{Err: expectedErr}))
+						Expect(routes).To(BeEmpty())
+
+						Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(2))
+						routeGUID, appGUID := fakeCloudControllerClient.UpdateRouteApplicationArgsForCall(1)
+						Expect(routeGUID).To(Equal("route-guid-1"))
+						Expect(appGUID).To(Equal("old-app-guid"))
+
+						Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(3))
+						routeGUID, appGUID = fakeCloudControllerClient.DeleteRouteApplicationArgsForCall(2)
+						Expect(routeGUID).To(Equal("route-guid-1"))
+						Expect(appGUID).To(Equal("new-app-guid"))
+					})
+				})
+			})
+
+			When("the new application is still starting", func() {
+				BeforeEach(func() {
+					fakeConfig.StartupTimeoutReturns(time.Minute)
+					fakeCloudControllerClient.GetApplicationApplicationInstancesReturnsOnCall(0,
+						nil,
+						ccv2.Warnings{"get-instances-warning-1"},
+						ccerror.ResourceNotFoundError{})
+					fakeCloudControllerClient.GetApplicationApplicationInstancesReturnsOnCall(1,
+						map[int]ccv2.ApplicationInstance{
+							0: {ID: 0, State: constant.ApplicationInstanceRunning},
+							1: {ID: 1, State: constant.ApplicationInstanceStarting},
+						},
+						ccv2.Warnings{"get-instances-warning-2"},
+						nil)
+					fakeCloudControllerClient.GetApplicationApplicationInstancesReturnsOnCall(2,
+						map[int]ccv2.ApplicationInstance{
+							0: {ID: 0, State: constant.ApplicationInstanceRunning},
+							1: {ID: 1, State: constant.ApplicationInstanceRunning},
+						},
+						ccv2.Warnings{"get-instances-warning-3"},
+						nil)
+				})
+
+				It("polls until all instances are running before unmapping the old app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("get-instances-warning-1"))
+					Expect(warnings).To(ContainElement("get-instances-warning-2"))
+					Expect(warnings).To(ContainElement("get-instances-warning-3"))
+
+					Expect(fakeCloudControllerClient.GetApplicationApplicationInstancesCallCount()).To(Equal(3))
+					Expect(sleeps).To(HaveLen(2))
+					Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(2))
+				})
+			})
+
+			When("the new application does not become healthy before the startup timeout", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationApplicationInstancesReturns(
+						map[int]ccv2.ApplicationInstance{
+							0: {ID: 0, State: constant.ApplicationInstanceStarting},
+						},
+						nil,
+						nil)
+				})
+
+				It("reverts the mappings and returns an ApplicationNotHealthyError", func() {
+					Expect(executeErr).To(MatchError(actionerror.RouteSwapError{
+						Err: actionerror.ApplicationNotHealthyError{Name: "new-app"},
+					}))
+					Expect(fakeCloudControllerClient.GetApplicationApplicationInstancesCallCount()).To(Equal(1))
+
+					Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(1))
+					_, appGUID := fakeCloudControllerClient.DeleteRouteApplicationArgsForCall(0)
+					Expect(appGUID).To(Equal("new-app-guid"))
+				})
+
+				When("the startup timeout allows several polls", func() {
+					BeforeEach(func() {
+						fakeConfig.StartupTimeoutReturns(3 * time.Second)
+						fakeConfig.PollingIntervalReturns(time.Second)
+					})
+
+					It("polls every polling interval until the timeout and returns an ApplicationNotHealthyError", func() {
+						Expect(executeErr).To(MatchError(actionerror.RouteSwapError{
+							Err: actionerror.ApplicationNotHealthyError{Name: "new-app"},
+						}))
+						Expect(fakeCloudControllerClient.GetApplicationApplicationInstancesCallCount()).To(Equal(4))
+						Expect(sleeps).To(Equal([]time.Duration{time.Second, time.Second, time.Second}))
+						Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			When("the new application has a crashed instance", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationApplicationInstancesReturns(
+						map[int]ccv2.ApplicationInstance{
+							0: {ID: 0, State: constant.ApplicationInstanceRunning},
+							1: {ID: 1, State: constant.ApplicationInstanceCrashed},
+						},
+						nil,
+						nil)
+				})
+
+				It("unmaps the new mappings and does not touch the old app", func() {
+					Expect(executeErr).To(MatchError(actionerror.RouteSwapError{
+						Err: actionerror.ApplicationNotHealthyError{Name: "new-app"},
+					}))
+
+					Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(1))
+					routeGUID, appGUID := fakeCloudControllerClient.DeleteRouteApplicationArgsForCall(0)
+					Expect(routeGUID).To(Equal("route-guid-1"))
+					Expect(appGUID).To(Equal("new-app-guid"))
+				})
+			})
+
+			When("the new application is stopped", func() {
+				BeforeEach(func() {
+					newApp.State = constant.ApplicationStopped
+				})
+
+				It("returns an ApplicationNotStartedError wrapped in a RouteSwapError", func() {
+					Expect(executeErr).To(MatchError(actionerror.RouteSwapError{
+						Err: actionerror.ApplicationNotStartedError{Name: "new-app"},
+					}))
+					Expect(fakeCloudControllerClient.GetApplicationApplicationInstancesCallCount()).To(Equal(0))
+				})
+			})
+
+			When("mapping a route to the new app fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("map-error")
+					fakeCloudControllerClient.UpdateRouteApplicationReturns(ccv2.Route{}, ccv2.Warnings{"map-warning"}, expectedErr)
+				})
+
+				It("returns the error without unmapping anything", func() {
+					Expect(executeErr).To(MatchError(actionerror.RouteSwapError{Err: expectedErr}))
+					Expect(warnings).To(ContainElement("map-warning"))
+					Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			When("reverting a mapping fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationApplicationInstancesReturns(nil, nil, nil)
+					fakeCloudControllerClient.DeleteRouteApplicationReturns(nil, errors.New("revert-error"))
+				})
+
+				It("reports the routes that could not be reverted", func() {
+					Expect(executeErr).To(MatchError(actionerror.RouteSwapError{
+						Err:              actionerror.ApplicationNotHealthyError{Name: "new-app"},
+						UnrevertedRoutes: []string{"host-1.example.com"},
+					}))
+				})
+			})
+		})
+
+		When("getting the old application routes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-routes-error")
+				fakeCloudControllerClient.GetApplicationRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+})
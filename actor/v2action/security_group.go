@@ -55,8 +55,9 @@ func (actor Actor) GetSecurityGroupByName(securityGroupName string) (SecurityGro
 	}
 
 	securityGroup := SecurityGroup{
-		Name: securityGroups[0].Name,
-		GUID: securityGroups[0].GUID,
+		Name:  securityGroups[0].Name,
+		GUID:  securityGroups[0].GUID,
+		Rules: securityGroups[0].Rules,
 	}
 	return securityGroup, Warnings(warnings), nil
 }
@@ -0,0 +1,223 @@
+package v2action
+
+import (
+	"bytes"
+	"net"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// EgressRuleMismatch is the part of a security group rule that does not match
+// the checked destination.
+type EgressRuleMismatch string
+
+const (
+	EgressRuleProtocolMismatch    EgressRuleMismatch = "protocol"
+	EgressRuleDestinationMismatch EgressRuleMismatch = "destination"
+	EgressRulePortsMismatch       EgressRuleMismatch = "ports"
+)
+
+// EgressRuleCheck is a security group rule checked against a destination.
+type EgressRuleCheck struct {
+	SecurityGroupRule
+
+	// Global is true when the rule's security group is applied to every space
+	// in the CF instance.
+	Global bool
+
+	// Mismatch is empty when the rule allows traffic to the destination.
+	Mismatch EgressRuleMismatch
+}
+
+// EgressCheck is the result of checking the security group rules that apply
+// to a space against a destination.
+type EgressCheck struct {
+	// IP is the checked IP address, which is resolved from the destination
+	// when it is a hostname.
+	IP    string
+	Rules []EgressRuleCheck
+}
+
+// AllowingRule returns the first rule that allows traffic to the destination,
+// or false when every rule denies it.
+func (check EgressCheck) AllowingRule() (EgressRuleCheck, bool) {
+	for _, rule := range check.Rules {
+		if rule.Mismatch == "" {
+			return rule, true
+		}
+	}
+	return EgressRuleCheck{}, false
+}
+
+// CheckSpaceEgress checks whether the security groups applied to apps in the
+// space for the given lifecycle allow traffic to the destination. The
+// destination is an IP address or a hostname that is resolved locally. Port is
+// ignored for the icmp protocol. Global security groups are checked first,
+// followed by the groups bound to the space.
+func (actor Actor) CheckSpaceEgress(spaceGUID string, destination string, port int, protocol string, lifecycle constant.SecurityGroupLifecycle) (EgressCheck, Warnings, error) {
+	var (
+		globalGroups []ccv2.SecurityGroup
+		spaceGroups  []SecurityGroup
+		allWarnings  Warnings
+	)
+
+	switch lifecycle {
+	case constant.SecurityGroupLifecycleRunning:
+		ccv2Groups, ccv2Warnings, err := actor.CloudControllerClient.GetConfigRunningSecurityGroups()
+		allWarnings = append(allWarnings, ccv2Warnings...)
+		if err != nil {
+			return EgressCheck{}, allWarnings, err
+		}
+		globalGroups = ccv2Groups
+
+		groups, warnings, err := actor.GetSpaceRunningSecurityGroupsBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return EgressCheck{}, allWarnings, err
+		}
+		spaceGroups = groups
+	case constant.SecurityGroupLifecycleStaging:
+		ccv2Groups, ccv2Warnings, err := actor.CloudControllerClient.GetConfigStagingSecurityGroups()
+		allWarnings = append(allWarnings, ccv2Warnings...)
+		if err != nil {
+			return EgressCheck{}, allWarnings, err
+		}
+		globalGroups = ccv2Groups
+
+		groups, warnings, err := actor.GetSpaceStagingSecurityGroupsBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return EgressCheck{}, allWarnings, err
+		}
+		spaceGroups = groups
+	default:
+		return EgressCheck{}, nil, actionerror.InvalidLifecycleError{Lifecycle: lifecycle}
+	}
+
+	ip := net.ParseIP(destination)
+	if ip == nil {
+		ips, err := net.LookupIP(destination)
+		if err != nil || len(ips) == 0 {
+			return EgressCheck{}, allWarnings, actionerror.EgressDestinationNotResolvedError{Destination: destination}
+		}
+		ip = ips[0]
+		for _, resolved := range ips {
+			if resolved.To4() != nil {
+				ip = resolved
+				break
+			}
+		}
+	}
+
+	check := EgressCheck{IP: ip.String()}
+	protocol = strings.ToLower(protocol)
+
+	checked := map[string]bool{}
+	addRules := func(securityGroup SecurityGroup, global bool) {
+		if checked[securityGroup.GUID] {
+			return
+		}
+		checked[securityGroup.GUID] = true
+
+		for _, rule := range extractSecurityGroupRules(securityGroup, lifecycle) {
+			check.Rules = append(check.Rules, EgressRuleCheck{
+				SecurityGroupRule: rule,
+				Global:            global,
+				Mismatch:          egressRuleMismatch(rule, ip, port, protocol),
+			})
+		}
+	}
+
+	for _, securityGroup := range globalGroups {
+		addRules(SecurityGroup(securityGroup), true)
+	}
+	for _, securityGroup := range spaceGroups {
+		addRules(securityGroup, false)
+	}
+
+	return check, allWarnings, nil
+}
+
+func egressRuleMismatch(rule SecurityGroupRule, ip net.IP, port int, protocol string) EgressRuleMismatch {
+	ruleProtocol := strings.ToLower(rule.Protocol)
+	if ruleProtocol != "all" && ruleProtocol != protocol {
+		return EgressRuleProtocolMismatch
+	}
+
+	if !egressDestinationsContain(rule.Destination, ip) {
+		return EgressRuleDestinationMismatch
+	}
+
+	if ruleProtocol != "all" && protocol != "icmp" && !egressPortsContain(rule.Ports, port) {
+		return EgressRulePortsMismatch
+	}
+
+	return ""
+}
+
+// egressDestinationsContain returns true when the IP is in one of the comma
+// separated destinations, which are IP addresses, CIDRs or ranges of IP
+// addresses such as 10.0.0.1-10.0.0.255.
+func egressDestinationsContain(destinations string, ip net.IP) bool {
+	for _, destination := range strings.Split(destinations, ",") {
+		destination = strings.TrimSpace(destination)
+
+		switch {
+		case strings.Contains(destination, "/"):
+			_, network, err := net.ParseCIDR(destination)
+			if err == nil && network.Contains(ip) {
+				return true
+			}
+		case strings.Contains(destination, "-"):
+			bounds := strings.SplitN(destination, "-", 2)
+			start := normalizeIP(net.ParseIP(strings.TrimSpace(bounds[0])))
+			end := normalizeIP(net.ParseIP(strings.TrimSpace(bounds[1])))
+			checked := normalizeIP(ip)
+			if start != nil && end != nil && len(start) == len(checked) && len(end) == len(checked) &&
+				bytes.Compare(start, checked) <= 0 && bytes.Compare(checked, end) <= 0 {
+				return true
+			}
+		default:
+			if destinationIP := net.ParseIP(destination); destinationIP != nil && destinationIP.Equal(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// egressPortsContain returns true when the port is in one of the comma
+// separated ports, which are ports or ranges of ports such as 8080-8090.
+func egressPortsContain(ports string, port int) bool {
+	for _, portRange := range strings.Split(ports, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(portRange), "-", 2)
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			continue
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				continue
+			}
+		}
+		if start <= port && port <= end {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeIP returns the 4 byte form of IPv4 addresses so that they can be
+// compared with each other.
+func normalizeIP(ip net.IP) net.IP {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return ipv4
+	}
+	return ip
+}
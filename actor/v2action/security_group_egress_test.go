@@ -0,0 +1,256 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Egress Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("CheckSpaceEgress", func() {
+		var (
+			destination string
+			port        int
+			protocol    string
+			lifecycle   constant.SecurityGroupLifecycle
+
+			check      EgressCheck
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			destination = "10.0.1.5"
+			port = 443
+			protocol = "tcp"
+			lifecycle = constant.SecurityGroupLifecycleRunning
+
+			fakeCloudControllerClient.GetConfigRunningSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{
+					{
+						GUID: "global-guid",
+						Name: "dns",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+						},
+					},
+				},
+				ccv2.Warnings{"global-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(
+				[]ccv2.SecurityGroup{
+					{
+						GUID: "global-guid",
+						Name: "dns",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+						},
+					},
+					{
+						GUID: "space-guid-1",
+						Name: "private",
+						Rules: []ccv2.SecurityGroupRule{
+							{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+							{Protocol: "tcp", Destination: "10.0.1.0-10.0.1.10", Ports: "80,8080-8090"},
+							{Protocol: "tcp", Destination: "10.0.1.1,10.0.1.5", Ports: "400-500"},
+						},
+					},
+				},
+				ccv2.Warnings{"space-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			check, warnings, executeErr = actor.CheckSpaceEgress("some-space-guid", destination, port, protocol, lifecycle)
+		})
+
+		It("checks the global and space running rules once each and returns all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("global-warning", "space-warning"))
+
+			Expect(fakeCloudControllerClient.GetConfigRunningSecurityGroupsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetSpaceSecurityGroupsCallCount()).To(Equal(1))
+			spaceGUID, _ := fakeCloudControllerClient.GetSpaceSecurityGroupsArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(check.IP).To(Equal("10.0.1.5"))
+			Expect(check.Rules).To(Equal([]EgressRuleCheck{
+				{
+					SecurityGroupRule: SecurityGroupRule{Name: "dns", Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53", Lifecycle: constant.SecurityGroupLifecycleRunning},
+					Global:            true,
+					Mismatch:          EgressRuleProtocolMismatch,
+				},
+				{
+					SecurityGroupRule: SecurityGroupRule{Name: "private", Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443", Lifecycle: constant.SecurityGroupLifecycleRunning},
+					Mismatch:          EgressRuleDestinationMismatch,
+				},
+				{
+					SecurityGroupRule: SecurityGroupRule{Name: "private", Protocol: "tcp", Destination: "10.0.1.0-10.0.1.10", Ports: "80,8080-8090", Lifecycle: constant.SecurityGroupLifecycleRunning},
+					Mismatch:          EgressRulePortsMismatch,
+				},
+				{
+					SecurityGroupRule: SecurityGroupRule{Name: "private", Protocol: "tcp", Destination: "10.0.1.1,10.0.1.5", Ports: "400-500", Lifecycle: constant.SecurityGroupLifecycleRunning},
+				},
+			}))
+
+			rule, allowed := check.AllowingRule()
+			Expect(allowed).To(BeTrue())
+			Expect(rule.Destination).To(Equal("10.0.1.1,10.0.1.5"))
+		})
+
+		When("no rule allows the destination", func() {
+			BeforeEach(func() {
+				port = 22
+			})
+
+			It("returns no allowing rule", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, allowed := check.AllowingRule()
+				Expect(allowed).To(BeFalse())
+			})
+		})
+
+		When("the lifecycle is staging", func() {
+			BeforeEach(func() {
+				lifecycle = constant.SecurityGroupLifecycleStaging
+				fakeCloudControllerClient.GetConfigStagingSecurityGroupsReturns(nil, ccv2.Warnings{"global-staging-warning"}, nil)
+				fakeCloudControllerClient.GetSpaceStagingSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID: "staging-guid",
+							Name: "staging",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "all", Destination: "10.0.0.0/8"},
+							},
+						},
+					},
+					ccv2.Warnings{"space-staging-warning"},
+					nil,
+				)
+			})
+
+			It("checks the staging rules", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("global-staging-warning", "space-staging-warning"))
+				Expect(fakeCloudControllerClient.GetConfigRunningSecurityGroupsCallCount()).To(Equal(0))
+
+				rule, allowed := check.AllowingRule()
+				Expect(allowed).To(BeTrue())
+				Expect(rule.Name).To(Equal("staging"))
+				Expect(rule.Lifecycle).To(Equal(constant.SecurityGroupLifecycleStaging))
+			})
+		})
+
+		When("the protocol is icmp", func() {
+			BeforeEach(func() {
+				protocol = "ICMP"
+				port = 0
+				fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(
+					[]ccv2.SecurityGroup{
+						{
+							GUID: "icmp-guid",
+							Name: "icmp",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "icmp", Destination: "10.0.0.0/8"},
+							},
+						},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("ignores ports", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, allowed := check.AllowingRule()
+				Expect(allowed).To(BeTrue())
+			})
+		})
+
+		When("the lifecycle is invalid", func() {
+			BeforeEach(func() {
+				lifecycle = "some-lifecycle"
+			})
+
+			It("returns an InvalidLifecycleError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidLifecycleError{Lifecycle: "some-lifecycle"}))
+			})
+		})
+
+		When("getting the global security groups fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetConfigRunningSecurityGroupsReturns(nil, ccv2.Warnings{"global-warning"}, errors.New("global-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("global-error"))
+				Expect(warnings).To(ConsistOf("global-warning"))
+			})
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceSecurityGroupsReturns(nil, ccv2.Warnings{"space-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns a SpaceNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{GUID: "some-space-guid"}))
+				Expect(warnings).To(ConsistOf("global-warning", "space-warning"))
+			})
+		})
+
+		When("the destination is not an IP address and does not resolve", func() {
+			BeforeEach(func() {
+				destination = "does-not-exist.invalid"
+			})
+
+			It("returns an EgressDestinationNotResolvedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.EgressDestinationNotResolvedError{Destination: "does-not-exist.invalid"}))
+			})
+		})
+
+		DescribeTable("destination formats",
+			func(ruleDestination string, allowed bool) {
+				fakeCloudControllerClient.GetConfigRunningSecurityGroupsReturns([]ccv2.SecurityGroup{
+					{
+						GUID:  "guid",
+						Rules: []ccv2.SecurityGroupRule{{Protocol: "all", Destination: ruleDestination}},
+					},
+				}, nil, nil)
+
+				check, _, err := actor.CheckSpaceEgress("some-space-guid", "192.168.1.20", 443, "tcp", constant.SecurityGroupLifecycleRunning)
+				Expect(err).ToNot(HaveOccurred())
+				_, isAllowed := check.AllowingRule()
+				Expect(isAllowed).To(Equal(allowed))
+			},
+			Entry("matching IP", "192.168.1.20", true),
+			Entry("other IP", "192.168.1.21", false),
+			Entry("matching CIDR", "192.168.0.0/16", true),
+			Entry("other CIDR", "192.168.2.0/24", false),
+			Entry("matching range", "192.168.1.1-192.168.1.100", true),
+			Entry("other range", "192.168.1.21-192.168.1.100", false),
+			Entry("list including a match", "10.0.0.1, 192.168.1.0/24", true),
+			Entry("invalid destination", "not-an-ip", false),
+		)
+	})
+})
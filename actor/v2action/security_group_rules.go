@@ -0,0 +1,106 @@
+package v2action
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
+)
+
+// SecurityGroupRuleChanges are the rules added to and removed from a security
+// group by updating it with a rules file.
+type SecurityGroupRuleChanges struct {
+	Added   []securitygroupfile.Rule
+	Removed []securitygroupfile.Rule
+}
+
+// Unchanged returns true when updating the security group does not change its
+// rules.
+func (changes SecurityGroupRuleChanges) Unchanged() bool {
+	return len(changes.Added) == 0 && len(changes.Removed) == 0
+}
+
+// CreateSecurityGroup creates a security group with the provided rules.
+func (actor Actor) CreateSecurityGroup(name string, rules []securitygroupfile.Rule) (SecurityGroup, Warnings, error) {
+	securityGroup, warnings, err := actor.CloudControllerClient.CreateSecurityGroup(name, convertSecurityGroupFileRules(rules))
+	if _, ok := err.(ccerror.SecurityGroupNameTakenError); ok {
+		return SecurityGroup{}, Warnings(warnings), actionerror.SecurityGroupNameTakenError{Name: name}
+	}
+	return SecurityGroup(securityGroup), Warnings(warnings), err
+}
+
+// GetSecurityGroupRuleChanges returns the rules that replacing the security
+// group's rules with the provided rules would add and remove. Rules are
+// compared ignoring the case of the protocol and whitespace in the destination
+// and ports, and a rule that appears more than once is counted each time.
+func (actor Actor) GetSecurityGroupRuleChanges(securityGroup SecurityGroup, rules []securitygroupfile.Rule) SecurityGroupRuleChanges {
+	existing := map[securitygroupfile.Rule]int{}
+	for _, rule := range securityGroup.Rules {
+		existing[normalizeSecurityGroupRule(convertCCSecurityGroupRule(rule))]++
+	}
+
+	var changes SecurityGroupRuleChanges
+	for _, rule := range rules {
+		normalized := normalizeSecurityGroupRule(rule)
+		if existing[normalized] > 0 {
+			existing[normalized]--
+			continue
+		}
+		changes.Added = append(changes.Added, rule)
+	}
+
+	for _, ccRule := range securityGroup.Rules {
+		rule := convertCCSecurityGroupRule(ccRule)
+		normalized := normalizeSecurityGroupRule(rule)
+		if existing[normalized] > 0 {
+			existing[normalized]--
+			changes.Removed = append(changes.Removed, rule)
+		}
+	}
+
+	return changes
+}
+
+// UpdateSecurityGroupRules replaces the rules of the security group with the
+// provided rules.
+func (actor Actor) UpdateSecurityGroupRules(securityGroupGUID string, rules []securitygroupfile.Rule) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateSecurityGroup(securityGroupGUID, convertSecurityGroupFileRules(rules))
+	return Warnings(warnings), err
+}
+
+func convertSecurityGroupFileRules(rules []securitygroupfile.Rule) []ccv2.SecurityGroupRule {
+	ccRules := make([]ccv2.SecurityGroupRule, 0, len(rules))
+	for _, rule := range rules {
+		ccRules = append(ccRules, ccv2.SecurityGroupRule{
+			Description: rule.Description,
+			Destination: rule.Destination,
+			Ports:       rule.Ports,
+			Protocol:    rule.Protocol,
+			Type:        rule.Type,
+			Code:        rule.Code,
+			Log:         rule.Log,
+		})
+	}
+	return ccRules
+}
+
+func convertCCSecurityGroupRule(rule ccv2.SecurityGroupRule) securitygroupfile.Rule {
+	return securitygroupfile.Rule{
+		Protocol:    rule.Protocol,
+		Destination: rule.Destination,
+		Ports:       rule.Ports,
+		Type:        rule.Type,
+		Code:        rule.Code,
+		Log:         rule.Log,
+		Description: rule.Description,
+	}
+}
+
+func normalizeSecurityGroupRule(rule securitygroupfile.Rule) securitygroupfile.Rule {
+	rule.Protocol = strings.ToLower(strings.TrimSpace(rule.Protocol))
+	rule.Destination = strings.Replace(rule.Destination, " ", "", -1)
+	rule.Ports = strings.Replace(rule.Ports, " ", "", -1)
+	return rule
+}
@@ -0,0 +1,189 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Security Group Rule Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("CreateSecurityGroup", func() {
+		var (
+			securityGroup SecurityGroup
+			warnings      Warnings
+			executeErr    error
+		)
+
+		JustBeforeEach(func() {
+			securityGroup, warnings, executeErr = actor.CreateSecurityGroup("some-security-group", []securitygroupfile.Rule{
+				{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443", Description: "https"},
+				{Protocol: "icmp", Destination: "10.0.0.0/24", Type: types.NullInt{IsSet: true, Value: 0}, Code: types.NullInt{IsSet: true, Value: -1}},
+			})
+		})
+
+		When("the security group is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSecurityGroupReturns(
+					ccv2.SecurityGroup{GUID: "some-security-group-guid", Name: "some-security-group"},
+					ccv2.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("creates the security group with the rules and returns it with warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-warning"))
+				Expect(securityGroup.GUID).To(Equal("some-security-group-guid"))
+
+				Expect(fakeCloudControllerClient.CreateSecurityGroupCallCount()).To(Equal(1))
+				name, rules := fakeCloudControllerClient.CreateSecurityGroupArgsForCall(0)
+				Expect(name).To(Equal("some-security-group"))
+				Expect(rules).To(Equal([]ccv2.SecurityGroupRule{
+					{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443", Description: "https"},
+					{Protocol: "icmp", Destination: "10.0.0.0/24", Type: types.NullInt{IsSet: true, Value: 0}, Code: types.NullInt{IsSet: true, Value: -1}},
+				}))
+			})
+		})
+
+		When("the name is taken", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"create-warning"}, ccerror.SecurityGroupNameTakenError{})
+			})
+
+			It("returns a SecurityGroupNameTakenError and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SecurityGroupNameTakenError{Name: "some-security-group"}))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+
+		When("creating the security group fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"create-warning"}, errors.New("create-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("create-error"))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("GetSecurityGroupRuleChanges", func() {
+		var (
+			securityGroup SecurityGroup
+			rules         []securitygroupfile.Rule
+			changes       SecurityGroupRuleChanges
+		)
+
+		BeforeEach(func() {
+			securityGroup = SecurityGroup{
+				Rules: []ccv2.SecurityGroupRule{
+					{Protocol: "TCP", Destination: "10.0.0.0/24", Ports: "80, 443"},
+					{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+					{Protocol: "all", Destination: "10.0.1.5"},
+					{Protocol: "all", Destination: "10.0.1.5"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes = actor.GetSecurityGroupRuleChanges(securityGroup, rules)
+		})
+
+		When("the rules are the same", func() {
+			BeforeEach(func() {
+				rules = []securitygroupfile.Rule{
+					{Protocol: "all", Destination: "10.0.1.5"},
+					{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80,443"},
+					{Protocol: "all", Destination: "10.0.1.5"},
+					{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+				}
+			})
+
+			It("returns no changes", func() {
+				Expect(changes.Unchanged()).To(BeTrue())
+			})
+		})
+
+		When("the rules are different", func() {
+			BeforeEach(func() {
+				rules = []securitygroupfile.Rule{
+					{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80,443"},
+					{Protocol: "all", Destination: "10.0.1.5"},
+					{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53", Log: types.NullBool{IsSet: true, Value: true}},
+				}
+			})
+
+			It("returns the added and removed rules", func() {
+				Expect(changes.Unchanged()).To(BeFalse())
+				Expect(changes.Added).To(Equal([]securitygroupfile.Rule{
+					{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53", Log: types.NullBool{IsSet: true, Value: true}},
+				}))
+				Expect(changes.Removed).To(Equal([]securitygroupfile.Rule{
+					{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+					{Protocol: "all", Destination: "10.0.1.5"},
+				}))
+			})
+		})
+	})
+
+	Describe("UpdateSecurityGroupRules", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateSecurityGroupRules("some-security-group-guid", []securitygroupfile.Rule{
+				{Protocol: "all", Destination: "10.0.1.5", Log: types.NullBool{IsSet: true, Value: true}},
+			})
+		})
+
+		When("the security group is updated", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"update-warning"}, nil)
+			})
+
+			It("replaces the rules and returns warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateSecurityGroupCallCount()).To(Equal(1))
+				guid, rules := fakeCloudControllerClient.UpdateSecurityGroupArgsForCall(0)
+				Expect(guid).To(Equal("some-security-group-guid"))
+				Expect(rules).To(Equal([]ccv2.SecurityGroupRule{
+					{Protocol: "all", Destination: "10.0.1.5", Log: types.NullBool{IsSet: true, Value: true}},
+				}))
+			})
+		})
+
+		When("updating the security group fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateSecurityGroupReturns(ccv2.SecurityGroup{}, ccv2.Warnings{"update-warning"}, errors.New("update-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(warnings).To(ConsistOf("update-warning"))
+			})
+		})
+	})
+})
@@ -1115,6 +1115,9 @@ var _ = Describe("Security Group Actions", func() {
 						{
 							GUID: "some-security-group-guid",
 							Name: "some-security-group",
+							Rules: []ccv2.SecurityGroupRule{
+								{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+							},
 						},
 					},
 					ccv2.Warnings{"warning-1", "warning-2"},
@@ -1125,6 +1128,9 @@ var _ = Describe("Security Group Actions", func() {
 			It("returns the security group and all warnings", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(securityGroup.GUID).To(Equal("some-security-group-guid"))
+				Expect(securityGroup.Rules).To(Equal([]ccv2.SecurityGroupRule{
+					{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+				}))
 				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 
 				Expect(fakeCloudControllerClient.GetSecurityGroupsCallCount()).To(Equal(1))
@@ -89,3 +89,57 @@ func (actor Actor) GetServiceInstancesBySpace(spaceGUID string) ([]ServiceInstan
 
 	return serviceInstances, Warnings(warnings), nil
 }
+
+// GetUnboundServiceInstancesBySpace returns the service instances in the
+// space that are not bound to any application.
+func (actor Actor) GetUnboundServiceInstancesBySpace(spaceGUID string) ([]ServiceInstance, Warnings, error) {
+	var allWarnings Warnings
+
+	instances, warnings, err := actor.GetServiceInstancesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var unboundInstances []ServiceInstance
+	for _, instance := range instances {
+		var (
+			bindings    []ccv2.ServiceBinding
+			ccWarnings  ccv2.Warnings
+			bindingsErr error
+		)
+
+		if instance.IsUserProvided() {
+			bindings, ccWarnings, bindingsErr = actor.CloudControllerClient.GetUserProvidedServiceInstanceServiceBindings(instance.GUID)
+		} else {
+			bindings, ccWarnings, bindingsErr = actor.CloudControllerClient.GetServiceInstanceServiceBindings(instance.GUID)
+		}
+		allWarnings = append(allWarnings, ccWarnings...)
+		if bindingsErr != nil {
+			return nil, allWarnings, bindingsErr
+		}
+
+		if len(bindings) == 0 {
+			unboundInstances = append(unboundInstances, instance)
+		}
+	}
+
+	return unboundInstances, allWarnings, nil
+}
+
+// DeleteServiceInstance deletes the provided managed or user provided service
+// instance.
+func (actor Actor) DeleteServiceInstance(instance ServiceInstance) (Warnings, error) {
+	var (
+		warnings ccv2.Warnings
+		err      error
+	)
+
+	if instance.IsUserProvided() {
+		warnings, err = actor.CloudControllerClient.DeleteUserProvidedServiceInstance(instance.GUID)
+	} else {
+		warnings, err = actor.CloudControllerClient.DeleteServiceInstance(instance.GUID)
+	}
+
+	return Warnings(warnings), err
+}
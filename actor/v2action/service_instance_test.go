@@ -328,4 +328,96 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+	Describe("GetUnboundServiceInstancesBySpace", func() {
+		var (
+			serviceInstances []ServiceInstance
+			warnings         Warnings
+			executeErr       error
+		)
+
+		JustBeforeEach(func() {
+			serviceInstances, warnings, executeErr = actor.GetUnboundServiceInstancesBySpace("some-space-guid")
+		})
+
+		When("the space has bound and unbound service instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{
+						{GUID: "bound-guid", Name: "bound", Type: constant.ServiceInstanceTypeManagedService},
+						{GUID: "unbound-guid", Name: "unbound", Type: constant.ServiceInstanceTypeManagedService},
+						{GUID: "unbound-ups-guid", Name: "unbound-ups", Type: constant.ServiceInstanceTypeUserProvidedService},
+					},
+					ccv2.Warnings{"instances-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceInstanceServiceBindingsStub = func(serviceInstanceGUID string) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+					if serviceInstanceGUID == "bound-guid" {
+						return []ccv2.ServiceBinding{{GUID: "binding-guid"}}, ccv2.Warnings{"bindings-warning"}, nil
+					}
+					return nil, ccv2.Warnings{"bindings-warning"}, nil
+				}
+				fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsReturns(nil, ccv2.Warnings{"ups-bindings-warning"}, nil)
+			})
+
+			It("returns only the unbound service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instances-warning", "bindings-warning", "bindings-warning", "ups-bindings-warning"))
+				Expect(serviceInstances).To(ConsistOf(
+					ServiceInstance{GUID: "unbound-guid", Name: "unbound", Type: constant.ServiceInstanceTypeManagedService},
+					ServiceInstance{GUID: "unbound-ups-guid", Name: "unbound-ups", Type: constant.ServiceInstanceTypeUserProvidedService},
+				))
+
+				Expect(fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetUserProvidedServiceInstanceServiceBindingsArgsForCall(0)).To(Equal("unbound-ups-guid"))
+			})
+		})
+
+		When("getting the bindings fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{GUID: "some-guid", Type: constant.ServiceInstanceTypeManagedService}},
+					nil,
+					nil)
+				fakeCloudControllerClient.GetServiceInstanceServiceBindingsReturns(nil, ccv2.Warnings{"bindings-warning"}, errors.New("bindings-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("bindings-error"))
+				Expect(warnings).To(ConsistOf("bindings-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		When("the service instance is managed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteServiceInstanceReturns(ccv2.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the managed service instance", func() {
+				warnings, err := actor.DeleteServiceInstance(ServiceInstance{GUID: "some-guid", Type: constant.ServiceInstanceTypeManagedService})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)).To(Equal("some-guid"))
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the service instance is user provided", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteUserProvidedServiceInstanceReturns(ccv2.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("deletes the user provided service instance", func() {
+				warnings, err := actor.DeleteServiceInstance(ServiceInstance{GUID: "some-guid", Type: constant.ServiceInstanceTypeUserProvidedService})
+				Expect(err).To(MatchError("delete-error"))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceArgsForCall(0)).To(Equal("some-guid"))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
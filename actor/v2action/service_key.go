@@ -0,0 +1,66 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// ServiceKey represents a set of credentials for a service instance.
+type ServiceKey struct {
+	ccv2.ServiceKey
+
+	// ServiceInstanceName is the name of the service instance the key belongs
+	// to.
+	ServiceInstanceName string
+}
+
+// DeleteServiceKey deletes the service key with the given GUID.
+func (actor Actor) DeleteServiceKey(serviceKeyGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteServiceKey(serviceKeyGUID)
+	return Warnings(warnings), err
+}
+
+// GetServiceKeysBySpace returns the service keys of the service instances in
+// the space.
+func (actor Actor) GetServiceKeysBySpace(spaceGUID string) ([]ServiceKey, Warnings, error) {
+	var allWarnings Warnings
+
+	instances, warnings, err := actor.GetServiceInstancesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var instanceGUIDs []string
+	instanceNames := map[string]string{}
+	for _, instance := range instances {
+		if instance.IsManaged() {
+			instanceGUIDs = append(instanceGUIDs, instance.GUID)
+			instanceNames[instance.GUID] = instance.Name
+		}
+	}
+
+	if len(instanceGUIDs) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	ccv2Keys, ccWarnings, err := actor.CloudControllerClient.GetServiceKeys(ccv2.Filter{
+		Type:     constant.ServiceInstanceGUIDFilter,
+		Operator: constant.InOperator,
+		Values:   instanceGUIDs,
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var keys []ServiceKey
+	for _, key := range ccv2Keys {
+		keys = append(keys, ServiceKey{
+			ServiceKey:          key,
+			ServiceInstanceName: instanceNames[key.ServiceInstanceGUID],
+		})
+	}
+
+	return keys, allWarnings, nil
+}
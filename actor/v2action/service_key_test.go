@@ -0,0 +1,110 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Key Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("DeleteServiceKey", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceKeyReturns(ccv2.Warnings{"delete-warning"}, errors.New("delete-error"))
+		})
+
+		It("deletes the key and returns the warnings and error", func() {
+			warnings, err := actor.DeleteServiceKey("some-key-guid")
+			Expect(err).To(MatchError("delete-error"))
+			Expect(warnings).To(ConsistOf("delete-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteServiceKeyCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeleteServiceKeyArgsForCall(0)).To(Equal("some-key-guid"))
+		})
+	})
+
+	Describe("GetServiceKeysBySpace", func() {
+		var (
+			keys       []ServiceKey
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			keys, warnings, executeErr = actor.GetServiceKeysBySpace("some-space-guid")
+		})
+
+		When("the space has managed service instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{
+						{GUID: "instance-guid-1", Name: "instance-1", Type: constant.ServiceInstanceTypeManagedService},
+						{GUID: "ups-guid", Name: "ups", Type: constant.ServiceInstanceTypeUserProvidedService},
+					},
+					ccv2.Warnings{"instances-warning"},
+					nil)
+				fakeCloudControllerClient.GetServiceKeysReturns(
+					[]ccv2.ServiceKey{
+						{GUID: "key-guid-1", Name: "key-1", ServiceInstanceGUID: "instance-guid-1"},
+						{GUID: "key-guid-2", Name: "key-2", ServiceInstanceGUID: "instance-guid-1"},
+					},
+					ccv2.Warnings{"keys-warning"},
+					nil)
+			})
+
+			It("returns the keys with the names of their service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("instances-warning", "keys-warning"))
+				Expect(keys).To(HaveLen(2))
+				Expect(keys[0].GUID).To(Equal("key-guid-1"))
+				Expect(keys[0].ServiceInstanceName).To(Equal("instance-1"))
+				Expect(keys[1].GUID).To(Equal("key-guid-2"))
+
+				Expect(fakeCloudControllerClient.GetServiceKeysCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceKeysArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.ServiceInstanceGUIDFilter,
+					Operator: constant.InOperator,
+					Values:   []string{"instance-guid-1"},
+				}))
+			})
+		})
+
+		When("the space has no managed service instances", func() {
+			It("does not look up service keys", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(keys).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetServiceKeysCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the service keys fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{{GUID: "instance-guid-1", Type: constant.ServiceInstanceTypeManagedService}},
+					nil,
+					nil)
+				fakeCloudControllerClient.GetServiceKeysReturns(nil, ccv2.Warnings{"keys-warning"}, errors.New("keys-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("keys-error"))
+				Expect(warnings).To(ConsistOf("keys-warning"))
+			})
+		})
+	})
+})
@@ -9,6 +9,12 @@ import (
 // Space represents a CLI Space
 type Space ccv2.Space
 
+// CreateSpace creates a space with the provided name in the organization.
+func (actor Actor) CreateSpace(spaceName string, orgGUID string) (Space, Warnings, error) {
+	space, warnings, err := actor.CloudControllerClient.CreateSpace(spaceName, orgGUID)
+	return Space(space), Warnings(warnings), err
+}
+
 func (actor Actor) DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (Warnings, error) {
 	var allWarnings Warnings
 
@@ -17,3 +17,38 @@ func (actor Actor) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 
 	return SpaceQuota(spaceQuota), Warnings(warnings), err
 }
+
+// CreateSpaceQuota creates the space quota in the organization set on the
+// quota.
+func (actor Actor) CreateSpaceQuota(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	createdQuota, warnings, err := actor.CloudControllerClient.CreateSpaceQuotaDefinition(ccv2.SpaceQuota(spaceQuota))
+	return SpaceQuota(createdQuota), Warnings(warnings), err
+}
+
+// GetOrganizationSpaceQuotas returns the space quotas defined in the
+// organization.
+func (actor Actor) GetOrganizationSpaceQuotas(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	ccv2SpaceQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationSpaceQuotaDefinitions(orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	spaceQuotas := make([]SpaceQuota, len(ccv2SpaceQuotas))
+	for i, ccv2SpaceQuota := range ccv2SpaceQuotas {
+		spaceQuotas[i] = SpaceQuota(ccv2SpaceQuota)
+	}
+	return spaceQuotas, Warnings(warnings), nil
+}
+
+// SetSpaceQuota assigns the space quota to the space.
+func (actor Actor) SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateSpaceQuotaDefinitionSpace(spaceQuotaGUID, spaceGUID)
+	return Warnings(warnings), err
+}
+
+// UpdateSpaceQuota updates the limits of the space quota with the GUID set on
+// the quota.
+func (actor Actor) UpdateSpaceQuota(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	updatedQuota, warnings, err := actor.CloudControllerClient.UpdateSpaceQuotaDefinition(ccv2.SpaceQuota(spaceQuota))
+	return SpaceQuota(updatedQuota), Warnings(warnings), err
+}
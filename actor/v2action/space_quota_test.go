@@ -77,4 +77,86 @@ var _ = Describe("SpaceQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateSpaceQuota", func() {
+		It("creates the space quota and returns it with the warnings", func() {
+			fakeCloudControllerClient.CreateSpaceQuotaDefinitionReturns(
+				ccv2.SpaceQuota{GUID: "some-space-quota-guid", Name: "small"},
+				ccv2.Warnings{"warning-1"},
+				nil,
+			)
+
+			spaceQuota, warnings, err := actor.CreateSpaceQuota(SpaceQuota{Name: "small", OrganizationGUID: "some-org-guid", MemoryLimit: 1024})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spaceQuota).To(Equal(SpaceQuota{GUID: "some-space-quota-guid", Name: "small"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.CreateSpaceQuotaDefinitionCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateSpaceQuotaDefinitionArgsForCall(0)).To(Equal(
+				ccv2.SpaceQuota{Name: "small", OrganizationGUID: "some-org-guid", MemoryLimit: 1024}))
+		})
+	})
+
+	Describe("GetOrganizationSpaceQuotas", func() {
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationSpaceQuotaDefinitionsReturns(
+					[]ccv2.SpaceQuota{{GUID: "quota-guid-1", Name: "small"}, {GUID: "quota-guid-2", Name: "large"}},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the space quotas and warnings", func() {
+				spaceQuotas, warnings, err := actor.GetOrganizationSpaceQuotas("some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spaceQuotas).To(Equal([]SpaceQuota{{GUID: "quota-guid-1", Name: "small"}, {GUID: "quota-guid-2", Name: "large"}}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeCloudControllerClient.GetOrganizationSpaceQuotaDefinitionsArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		When("the request fails", func() {
+			It("returns the error and warnings", func() {
+				expectedErr := errors.New("get quotas failed")
+				fakeCloudControllerClient.GetOrganizationSpaceQuotaDefinitionsReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+
+				_, warnings, err := actor.GetOrganizationSpaceQuotas("some-org-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("SetSpaceQuota", func() {
+		It("assigns the space quota and returns the warnings", func() {
+			fakeCloudControllerClient.UpdateSpaceQuotaDefinitionSpaceReturns(ccv2.Warnings{"warning-1"}, nil)
+
+			warnings, err := actor.SetSpaceQuota("some-space-guid", "some-space-quota-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.UpdateSpaceQuotaDefinitionSpaceCallCount()).To(Equal(1))
+			spaceQuotaGUID, spaceGUID := fakeCloudControllerClient.UpdateSpaceQuotaDefinitionSpaceArgsForCall(0)
+			Expect(spaceQuotaGUID).To(Equal("some-space-quota-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Describe("UpdateSpaceQuota", func() {
+		It("updates the space quota and returns it with the warnings", func() {
+			fakeCloudControllerClient.UpdateSpaceQuotaDefinitionReturns(
+				ccv2.SpaceQuota{GUID: "some-space-quota-guid", Name: "small", MemoryLimit: 2048},
+				ccv2.Warnings{"warning-1"},
+				nil,
+			)
+
+			spaceQuota, warnings, err := actor.UpdateSpaceQuota(SpaceQuota{GUID: "some-space-quota-guid", Name: "small", MemoryLimit: 2048})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spaceQuota).To(Equal(SpaceQuota{GUID: "some-space-quota-guid", Name: "small", MemoryLimit: 2048}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(fakeCloudControllerClient.UpdateSpaceQuotaDefinitionArgsForCall(0)).To(Equal(
+				ccv2.SpaceQuota{GUID: "some-space-quota-guid", Name: "small", MemoryLimit: 2048}))
+		})
+	})
 })
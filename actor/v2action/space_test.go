@@ -381,5 +381,25 @@ var _ = Describe("Space", func() {
 				})
 			})
 		})
+
+		Describe("CreateSpace", func() {
+			It("creates the space and returns it with the warnings", func() {
+				fakeCloudControllerClient.CreateSpaceReturns(
+					ccv2.Space{GUID: "some-space-guid", Name: "some-space"},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+
+				space, warnings, err := actor.CreateSpace("some-space", "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(space).To(Equal(Space{GUID: "some-space-guid", Name: "some-space"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.CreateSpaceCallCount()).To(Equal(1))
+				spaceName, orgGUID := fakeCloudControllerClient.CreateSpaceArgsForCall(0)
+				Expect(spaceName).To(Equal("some-space"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
 	})
 })
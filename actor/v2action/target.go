@@ -1,17 +1,58 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"time"
 
-type TargetSettings ccv2.TargetSettings
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
+)
+
+// TargetSettings represents configuration for targeting a Cloud Controller.
+type TargetSettings struct {
+	// DialTimeout is the DNS timeout used to make all requests to the Cloud
+	// Controller.
+	DialTimeout time.Duration
+
+	// SkipSSLValidation controls whether the Cloud Controller's certificate
+	// chain and host name are verified.
+	SkipSSLValidation bool
+
+	// URL is a fully qualified URL to the Cloud Controller API.
+	URL string
+
+	// CACertFile is the path of a CA certificate trusted in addition to the
+	// system's certificates.
+	CACertFile string
+
+	// ClientCertFile and ClientKeyFile are the paths of the client certificate
+	// and private key presented for mutual TLS.
+	ClientCertFile string
+	ClientKeyFile  string
+}
 
 // SetTarget targets the Cloud Controller using the client and sets target
 // information in the actor based on the response.
 func (actor Actor) SetTarget(settings TargetSettings) (Warnings, error) {
-	if actor.Config.Target() == settings.URL && actor.Config.SkipSSLValidation() == settings.SkipSSLValidation {
+	if actor.Config.Target() == settings.URL &&
+		actor.Config.SkipSSLValidation() == settings.SkipSSLValidation &&
+		actor.Config.CACertFile() == settings.CACertFile &&
+		actor.Config.ClientCertFile() == settings.ClientCertFile &&
+		actor.Config.ClientKeyFile() == settings.ClientKeyFile {
 		return nil, nil
 	}
 
-	warnings, err := actor.CloudControllerClient.TargetCF(ccv2.TargetSettings(settings))
+	rootCAs, certificates, err := tlsconfig.LoadCertificates(settings.CACertFile, settings.ClientCertFile, settings.ClientKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := actor.CloudControllerClient.TargetCF(ccv2.TargetSettings{
+		DialTimeout:       settings.DialTimeout,
+		SkipSSLValidation: settings.SkipSSLValidation,
+		RootCAs:           rootCAs,
+		Certificates:      certificates,
+		URL:               settings.URL,
+	})
 	if err != nil {
 		return Warnings(warnings), err
 	}
@@ -25,6 +66,7 @@ func (actor Actor) SetTarget(settings TargetSettings) (Warnings, error) {
 		actor.CloudControllerClient.RoutingEndpoint(),
 		settings.SkipSSLValidation,
 	)
+	actor.Config.SetTLSCertificateFiles(settings.CACertFile, settings.ClientCertFile, settings.ClientKeyFile)
 	actor.Config.SetTokenInformation("", "", "")
 
 	return Warnings(warnings), nil
@@ -33,5 +75,6 @@ func (actor Actor) SetTarget(settings TargetSettings) (Warnings, error) {
 // ClearTarget clears target information from the actor.
 func (actor Actor) ClearTarget() {
 	actor.Config.SetTargetInformation("", "", "", "", "", "", false)
+	actor.Config.SetTLSCertificateFiles("", "", "")
 	actor.Config.SetTokenInformation("", "", "")
 }
@@ -3,6 +3,7 @@ package v2action_test
 import (
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -87,6 +88,45 @@ var _ = Describe("Targeting", func() {
 			Expect(sshOAuthClient).To(BeEmpty())
 		})
 
+		It("saves the certificate files", func() {
+			_, err := actor.SetTarget(settings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConfig.SetTLSCertificateFilesCallCount()).To(Equal(1))
+			caCertFile, clientCertFile, clientKeyFile := fakeConfig.SetTLSCertificateFilesArgsForCall(0)
+			Expect(caCertFile).To(BeEmpty())
+			Expect(clientCertFile).To(BeEmpty())
+			Expect(clientKeyFile).To(BeEmpty())
+		})
+
+		When("the certificate files cannot be loaded", func() {
+			BeforeEach(func() {
+				settings.ClientCertFile = "/non-existent/client.pem"
+				settings.ClientKeyFile = "/non-existent/client-key.pem"
+			})
+
+			It("returns the error without targeting the API", func() {
+				_, err := actor.SetTarget(settings)
+				Expect(err).To(BeAssignableToTypeOf(tlsconfig.InvalidClientCertificateError{}))
+				Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetTargetInformationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the certificate files differ from the saved ones", func() {
+			BeforeEach(func() {
+				settings.URL = "https://some-api.com"
+				fakeConfig.TargetReturns("https://some-api.com")
+				fakeConfig.SkipSSLValidationReturns(skipSSLValidation)
+				fakeConfig.CACertFileReturns("some-old-ca-cert")
+			})
+
+			It("targets the API again", func() {
+				_, err := actor.SetTarget(settings)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.TargetCFCallCount()).To(Equal(1))
+			})
+		})
+
 		When("setting the same API and skip SSL configuration", func() {
 			var APIURL string
 
@@ -122,6 +162,15 @@ var _ = Describe("Targeting", func() {
 			Expect(sslDisabled).To(BeFalse())
 		})
 
+		It("clears the certificate files", func() {
+			actor.ClearTarget()
+			Expect(fakeConfig.SetTLSCertificateFilesCallCount()).To(Equal(1))
+			caCertFile, clientCertFile, clientKeyFile := fakeConfig.SetTLSCertificateFilesArgsForCall(0)
+			Expect(caCertFile).To(BeEmpty())
+			Expect(clientCertFile).To(BeEmpty())
+			Expect(clientKeyFile).To(BeEmpty())
+		})
+
 		It("clears all the token information", func() {
 			actor.ClearTarget()
 
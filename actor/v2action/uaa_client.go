@@ -8,9 +8,17 @@ import (
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
+	AddGroupMember(groupID string, userID string) error
 	APIVersion() string
 	Authenticate(ID string, secret string, origin string, grantType constant.GrantType) (string, string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
+	DeleteUser(userID string) error
+	GetGroups(filter string) ([]uaa.Group, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
+	GetUsers(filter string) ([]uaa.User, error)
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
+	RemoveGroupMember(groupID string, userID string) error
+	ResetUserPassword(userID string, password string) error
+	SetUserActive(userID string, active bool) error
+	UnlockUser(userID string) error
 }
@@ -0,0 +1,65 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// UAAGroup represents a group in the UAA.
+type UAAGroup uaa.Group
+
+// AddUserToUAAGroup adds the UAA user to the group. The origin is only
+// required when the username exists in more than one origin.
+func (actor Actor) AddUserToUAAGroup(username string, origin string, groupName string) error {
+	user, group, err := actor.getUAAUserAndGroup(username, origin, groupName)
+	if err != nil {
+		return err
+	}
+
+	return actor.UAAClient.AddGroupMember(group.ID, user.ID)
+}
+
+// GetUAAGroups returns the UAA groups matching the SCIM filter, or all groups
+// when the filter is empty.
+func (actor Actor) GetUAAGroups(filter string) ([]UAAGroup, error) {
+	groups, err := actor.UAAClient.GetGroups(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var uaaGroups []UAAGroup
+	for _, group := range groups {
+		uaaGroups = append(uaaGroups, UAAGroup(group))
+	}
+	return uaaGroups, nil
+}
+
+// RemoveUserFromUAAGroup removes the UAA user from the group. The origin is
+// only required when the username exists in more than one origin.
+func (actor Actor) RemoveUserFromUAAGroup(username string, origin string, groupName string) error {
+	user, group, err := actor.getUAAUserAndGroup(username, origin, groupName)
+	if err != nil {
+		return err
+	}
+
+	return actor.UAAClient.RemoveGroupMember(group.ID, user.ID)
+}
+
+func (actor Actor) getUAAUserAndGroup(username string, origin string, groupName string) (UAAUser, UAAGroup, error) {
+	groups, err := actor.UAAClient.GetGroups(fmt.Sprintf("displayName eq %s", scimString(groupName)))
+	if err != nil {
+		return UAAUser{}, UAAGroup{}, err
+	}
+	if len(groups) == 0 {
+		return UAAUser{}, UAAGroup{}, actionerror.UAAGroupNotFoundError{Name: groupName}
+	}
+
+	user, err := actor.GetUAAUser(username, origin)
+	if err != nil {
+		return UAAUser{}, UAAGroup{}, err
+	}
+
+	return user, UAAGroup(groups[0]), nil
+}
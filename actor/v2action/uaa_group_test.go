@@ -0,0 +1,100 @@
+package v2action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UAA Group Actions", func() {
+	var (
+		actor         *Actor
+		fakeUAAClient *v2actionfakes.FakeUAAClient
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		actor = NewActor(nil, fakeUAAClient, nil)
+	})
+
+	Describe("GetUAAGroups", func() {
+		BeforeEach(func() {
+			fakeUAAClient.GetGroupsReturns([]uaa.Group{{ID: "group-id-1", DisplayName: "some-group"}}, nil)
+		})
+
+		It("returns the groups", func() {
+			groups, err := actor.GetUAAGroups("")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(Equal([]UAAGroup{{ID: "group-id-1", DisplayName: "some-group"}}))
+		})
+	})
+
+	Describe("AddUserToUAAGroup", func() {
+		var err error
+
+		JustBeforeEach(func() {
+			err = actor.AddUserToUAAGroup("some-user", "", "some-group")
+		})
+
+		When("the user and group exist", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetGroupsReturns([]uaa.Group{{ID: "some-group-id"}}, nil)
+				fakeUAAClient.GetUsersReturns([]uaa.User{{ID: "some-user-id"}}, nil)
+			})
+
+			It("adds the user to the group", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeUAAClient.GetGroupsArgsForCall(0)).To(Equal(`displayName eq "some-group"`))
+				groupID, userID := fakeUAAClient.AddGroupMemberArgsForCall(0)
+				Expect(groupID).To(Equal("some-group-id"))
+				Expect(userID).To(Equal("some-user-id"))
+			})
+		})
+
+		When("the group does not exist", func() {
+			It("returns a UAAGroupNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.UAAGroupNotFoundError{Name: "some-group"}))
+				Expect(fakeUAAClient.AddGroupMemberCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the user does not exist", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetGroupsReturns([]uaa.Group{{ID: "some-group-id"}}, nil)
+			})
+
+			It("returns a UAAUserNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.UAAUserNotFoundError{Username: "some-user"}))
+			})
+		})
+
+		When("getting the groups fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetGroupsReturns(nil, errors.New("uaa-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("uaa-error"))
+			})
+		})
+	})
+
+	Describe("RemoveUserFromUAAGroup", func() {
+		BeforeEach(func() {
+			fakeUAAClient.GetGroupsReturns([]uaa.Group{{ID: "some-group-id"}}, nil)
+			fakeUAAClient.GetUsersReturns([]uaa.User{{ID: "some-user-id"}}, nil)
+		})
+
+		It("removes the user from the group", func() {
+			Expect(actor.RemoveUserFromUAAGroup("some-user", "", "some-group")).To(Succeed())
+			groupID, userID := fakeUAAClient.RemoveGroupMemberArgsForCall(0)
+			Expect(groupID).To(Equal("some-group-id"))
+			Expect(userID).To(Equal("some-user-id"))
+		})
+	})
+})
@@ -1,10 +1,21 @@
 package v2action
 
-import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
 
 // User represents a CLI user.
 type User ccv2.User
 
+// UAAUser represents a user account in the UAA.
+type UAAUser uaa.User
+
 // CreateUser creates a new user in UAA and registers it with cloud controller.
 func (actor Actor) CreateUser(username string, password string, origin string) (User, Warnings, error) {
 	uaaUser, err := actor.UAAClient.CreateUser(username, password, origin)
@@ -16,3 +27,102 @@ func (actor Actor) CreateUser(username string, password string, origin string) (
 
 	return User(ccUser), Warnings(ccWarnings), err
 }
+
+// DeleteUser deletes the user from the Cloud Controller and the UAA. The
+// origin is only required when the username exists in more than one origin.
+func (actor Actor) DeleteUser(username string, origin string) (Warnings, error) {
+	user, err := actor.GetUAAUser(username, origin)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := actor.CloudControllerClient.DeleteUser(user.ID)
+	if _, ok := err.(ccerror.ResourceNotFoundError); !ok && err != nil {
+		return Warnings(warnings), err
+	}
+
+	return Warnings(warnings), actor.UAAClient.DeleteUser(user.ID)
+}
+
+// GetUAAUser returns the UAA user with the username. The origin is only
+// required when the username exists in more than one origin.
+func (actor Actor) GetUAAUser(username string, origin string) (UAAUser, error) {
+	filter := fmt.Sprintf("userName eq %s", scimString(username))
+	if origin != "" {
+		filter = fmt.Sprintf("%s and origin eq %s", filter, scimString(origin))
+	}
+
+	users, err := actor.UAAClient.GetUsers(filter)
+	if err != nil {
+		return UAAUser{}, err
+	}
+
+	switch len(users) {
+	case 0:
+		return UAAUser{}, actionerror.UAAUserNotFoundError{Username: username, Origin: origin}
+	case 1:
+		return UAAUser(users[0]), nil
+	default:
+		var origins []string
+		for _, user := range users {
+			origins = append(origins, user.Origin)
+		}
+		return UAAUser{}, actionerror.MultipleUAAUsersFoundError{Username: username, Origins: origins}
+	}
+}
+
+// GetUAAUsers returns the UAA users matching the SCIM filter, or all users
+// when the filter is empty.
+func (actor Actor) GetUAAUsers(filter string) ([]UAAUser, error) {
+	users, err := actor.UAAClient.GetUsers(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var uaaUsers []UAAUser
+	for _, user := range users {
+		uaaUsers = append(uaaUsers, UAAUser(user))
+	}
+	return uaaUsers, nil
+}
+
+// LockUser deactivates the UAA user, so that they can no longer log in.
+func (actor Actor) LockUser(username string, origin string) error {
+	user, err := actor.GetUAAUser(username, origin)
+	if err != nil {
+		return err
+	}
+
+	return actor.UAAClient.SetUserActive(user.ID, false)
+}
+
+// ResetUserPassword sets the password of the UAA user.
+func (actor Actor) ResetUserPassword(username string, origin string, password string) error {
+	user, err := actor.GetUAAUser(username, origin)
+	if err != nil {
+		return err
+	}
+
+	return actor.UAAClient.ResetUserPassword(user.ID, password)
+}
+
+// UnlockUser reactivates the UAA user, and unlocks them if they were locked
+// out by too many failed login attempts.
+func (actor Actor) UnlockUser(username string, origin string) error {
+	user, err := actor.GetUAAUser(username, origin)
+	if err != nil {
+		return err
+	}
+
+	err = actor.UAAClient.SetUserActive(user.ID, true)
+	if err != nil {
+		return err
+	}
+
+	return actor.UAAClient.UnlockUser(user.ID)
+}
+
+// scimString quotes the value for use in a SCIM filter.
+func scimString(value string) string {
+	return `"` + strings.Replace(strings.Replace(value, `\`, `\\`, -1), `"`, `\"`, -1) + `"`
+}
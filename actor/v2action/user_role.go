@@ -0,0 +1,46 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+
+// AddOrganizationUserRole gives the user with the provided username the role
+// in the organization.
+func (actor Actor) AddOrganizationUserRole(role constant.UserRole, orgGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateOrganizationUserByRole(role, orgGUID, username)
+	return Warnings(warnings), err
+}
+
+// AddSpaceUserRole gives the user with the provided username the role in the
+// space.
+func (actor Actor) AddSpaceUserRole(role constant.UserRole, spaceGUID string, username string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UpdateSpaceUserByRole(role, spaceGUID, username)
+	return Warnings(warnings), err
+}
+
+// GetOrganizationUsersByRole returns the users that have the role in the
+// organization.
+func (actor Actor) GetOrganizationUsersByRole(role constant.UserRole, orgGUID string) ([]User, Warnings, error) {
+	ccv2Users, warnings, err := actor.CloudControllerClient.GetOrganizationUsersByRole(role, orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	users := make([]User, len(ccv2Users))
+	for i, ccv2User := range ccv2Users {
+		users[i] = User(ccv2User)
+	}
+	return users, Warnings(warnings), nil
+}
+
+// GetSpaceUsersByRole returns the users that have the role in the space.
+func (actor Actor) GetSpaceUsersByRole(role constant.UserRole, spaceGUID string) ([]User, Warnings, error) {
+	ccv2Users, warnings, err := actor.CloudControllerClient.GetSpaceUsersByRole(role, spaceGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	users := make([]User, len(ccv2Users))
+	for i, ccv2User := range ccv2Users {
+		users[i] = User(ccv2User)
+	}
+	return users, Warnings(warnings), nil
+}
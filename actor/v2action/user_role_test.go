@@ -0,0 +1,133 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("User Role Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil)
+	})
+
+	Describe("AddOrganizationUserRole", func() {
+		It("adds the role and returns the warnings", func() {
+			expectedErr := errors.New("add role failed")
+			fakeCloudControllerClient.UpdateOrganizationUserByRoleReturns(ccv2.Warnings{"warning-1"}, expectedErr)
+
+			warnings, err := actor.AddOrganizationUserRole(constant.OrgManager, "some-org-guid", "some-user")
+			Expect(err).To(MatchError(expectedErr))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.UpdateOrganizationUserByRoleCallCount()).To(Equal(1))
+			role, orgGUID, username := fakeCloudControllerClient.UpdateOrganizationUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.OrgManager))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(username).To(Equal("some-user"))
+		})
+	})
+
+	Describe("AddSpaceUserRole", func() {
+		It("adds the role and returns the warnings", func() {
+			fakeCloudControllerClient.UpdateSpaceUserByRoleReturns(ccv2.Warnings{"warning-1"}, nil)
+
+			warnings, err := actor.AddSpaceUserRole(constant.SpaceDeveloper, "some-space-guid", "some-user")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.UpdateSpaceUserByRoleCallCount()).To(Equal(1))
+			role, spaceGUID, username := fakeCloudControllerClient.UpdateSpaceUserByRoleArgsForCall(0)
+			Expect(role).To(Equal(constant.SpaceDeveloper))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(username).To(Equal("some-user"))
+		})
+	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(
+					[]ccv2.User{{GUID: "user-guid-1", Username: "user-1"}},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the users and warnings", func() {
+				users, warnings, err := actor.GetOrganizationUsersByRole(constant.OrgAuditor, "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(users).To(ConsistOf(User{GUID: "user-guid-1", Username: "user-1"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				role, orgGUID := fakeCloudControllerClient.GetOrganizationUsersByRoleArgsForCall(0)
+				Expect(role).To(Equal(constant.OrgAuditor))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
+
+		When("the request fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get users failed")
+				fakeCloudControllerClient.GetOrganizationUsersByRoleReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetOrganizationUsersByRole(constant.OrgAuditor, "some-org-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceUsersByRoleReturns(
+					[]ccv2.User{{GUID: "user-guid-1", Username: "user-1"}},
+					ccv2.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the users and warnings", func() {
+				users, warnings, err := actor.GetSpaceUsersByRole(constant.SpaceDeveloper, "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(users).To(ConsistOf(User{GUID: "user-guid-1", Username: "user-1"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				role, spaceGUID := fakeCloudControllerClient.GetSpaceUsersByRoleArgsForCall(0)
+				Expect(role).To(Equal(constant.SpaceDeveloper))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		When("the request fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get users failed")
+				fakeCloudControllerClient.GetSpaceUsersByRoleReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetSpaceUsersByRole(constant.SpaceDeveloper, "some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
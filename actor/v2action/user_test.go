@@ -3,8 +3,10 @@ package v2action_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/uaa"
 	. "github.com/onsi/ginkgo"
@@ -121,4 +123,168 @@ var _ = Describe("User Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetUAAUser", func() {
+		var (
+			user UAAUser
+			err  error
+		)
+
+		JustBeforeEach(func() {
+			user, err = actor.GetUAAUser(`some"user`, "")
+		})
+
+		When("one user matches", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetUsersReturns([]uaa.User{{ID: "some-user-id", Username: `some"user`, Origin: "uaa"}}, nil)
+			})
+
+			It("returns the user", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(user).To(Equal(UAAUser{ID: "some-user-id", Username: `some"user`, Origin: "uaa"}))
+
+				Expect(fakeUAAClient.GetUsersCallCount()).To(Equal(1))
+				Expect(fakeUAAClient.GetUsersArgsForCall(0)).To(Equal(`userName eq "some\"user"`))
+			})
+		})
+
+		When("no users match", func() {
+			It("returns a UAAUserNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.UAAUserNotFoundError{Username: `some"user`}))
+			})
+		})
+
+		When("users in multiple origins match", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetUsersReturns([]uaa.User{{ID: "id-1", Origin: "uaa"}, {ID: "id-2", Origin: "ldap"}}, nil)
+			})
+
+			It("returns a MultipleUAAUsersFoundError", func() {
+				Expect(err).To(MatchError(actionerror.MultipleUAAUsersFoundError{Username: `some"user`, Origins: []string{"uaa", "ldap"}}))
+			})
+		})
+
+		When("an origin is provided", func() {
+			It("filters by the origin", func() {
+				_, _ = actor.GetUAAUser("some-user", "ldap")
+				Expect(fakeUAAClient.GetUsersArgsForCall(1)).To(Equal(`userName eq "some-user" and origin eq "ldap"`))
+			})
+		})
+	})
+
+	Describe("GetUAAUsers", func() {
+		BeforeEach(func() {
+			fakeUAAClient.GetUsersReturns([]uaa.User{{ID: "id-1"}, {ID: "id-2"}}, nil)
+		})
+
+		It("returns the users matching the filter", func() {
+			users, err := actor.GetUAAUsers(`origin eq "ldap"`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(Equal([]UAAUser{{ID: "id-1"}, {ID: "id-2"}}))
+			Expect(fakeUAAClient.GetUsersArgsForCall(0)).To(Equal(`origin eq "ldap"`))
+		})
+	})
+
+	Describe("DeleteUser", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeUAAClient.GetUsersReturns([]uaa.User{{ID: "some-user-id"}}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.DeleteUser("some-user", "")
+		})
+
+		When("no errors occur", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteUserReturns(ccv2.Warnings{"warning-1"}, nil)
+			})
+
+			It("deletes the user from the Cloud Controller and the UAA", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeCloudControllerClient.DeleteUserArgsForCall(0)).To(Equal("some-user-id"))
+				Expect(fakeUAAClient.DeleteUserArgsForCall(0)).To(Equal("some-user-id"))
+			})
+		})
+
+		When("the user does not exist in the Cloud Controller", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteUserReturns(ccv2.Warnings{"warning-1"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("deletes the user from the UAA", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeUAAClient.DeleteUserCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the Cloud Controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteUserReturns(ccv2.Warnings{"warning-1"}, errors.New("cc-error"))
+			})
+
+			It("returns the error and does not delete the user from the UAA", func() {
+				Expect(err).To(MatchError("cc-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeUAAClient.DeleteUserCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("LockUser", func() {
+		BeforeEach(func() {
+			fakeUAAClient.GetUsersReturns([]uaa.User{{ID: "some-user-id"}}, nil)
+		})
+
+		It("deactivates the user", func() {
+			Expect(actor.LockUser("some-user", "")).To(Succeed())
+			userID, active := fakeUAAClient.SetUserActiveArgsForCall(0)
+			Expect(userID).To(Equal("some-user-id"))
+			Expect(active).To(BeFalse())
+		})
+	})
+
+	Describe("UnlockUser", func() {
+		BeforeEach(func() {
+			fakeUAAClient.GetUsersReturns([]uaa.User{{ID: "some-user-id"}}, nil)
+		})
+
+		It("activates and unlocks the user", func() {
+			Expect(actor.UnlockUser("some-user", "")).To(Succeed())
+			userID, active := fakeUAAClient.SetUserActiveArgsForCall(0)
+			Expect(userID).To(Equal("some-user-id"))
+			Expect(active).To(BeTrue())
+			Expect(fakeUAAClient.UnlockUserArgsForCall(0)).To(Equal("some-user-id"))
+		})
+
+		When("activating the user fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.SetUserActiveReturns(errors.New("uaa-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(actor.UnlockUser("some-user", "")).To(MatchError("uaa-error"))
+				Expect(fakeUAAClient.UnlockUserCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ResetUserPassword", func() {
+		BeforeEach(func() {
+			fakeUAAClient.GetUsersReturns([]uaa.User{{ID: "some-user-id"}}, nil)
+		})
+
+		It("sets the user's password", func() {
+			Expect(actor.ResetUserPassword("some-user", "", "some-password")).To(Succeed())
+			userID, password := fakeUAAClient.ResetUserPasswordArgsForCall(0)
+			Expect(userID).To(Equal("some-user-id"))
+			Expect(password).To(Equal("some-password"))
+		})
+	})
 })
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 )
 
 type FakeCloudControllerClient struct {
@@ -71,6 +72,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreatePrivateDomainStub        func(domainName string, orgGUID string) (ccv2.Domain, ccv2.Warnings, error)
+	createPrivateDomainMutex       sync.RWMutex
+	createPrivateDomainArgsForCall []struct {
+		domainName string
+		orgGUID    string
+	}
+	createPrivateDomainReturns struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createPrivateDomainReturnsOnCall map[int]struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateRouteStub        func(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
@@ -87,6 +104,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateSecurityGroupStub        func(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	createSecurityGroupMutex       sync.RWMutex
+	createSecurityGroupArgsForCall []struct {
+		name  string
+		rules []ccv2.SecurityGroupRule
+	}
+	createSecurityGroupReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateServiceBindingStub        func(appGUID string, serviceBindingGUID string, bindingName string, acceptsIncomplete bool, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error)
 	createServiceBindingMutex       sync.RWMutex
 	createServiceBindingArgsForCall []struct {
@@ -106,6 +139,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	CreateSpaceStub        func(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		spaceName string
+		orgGUID   string
+	}
+	createSpaceReturns struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}
+	CreateSpaceQuotaDefinitionStub        func(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	createSpaceQuotaDefinitionMutex       sync.RWMutex
+	createSpaceQuotaDefinitionArgsForCall []struct {
+		spaceQuota ccv2.SpaceQuota
+	}
+	createSpaceQuotaDefinitionReturns struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createSpaceQuotaDefinitionReturnsOnCall map[int]struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
 	CreateUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -121,6 +185,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteBuildpackStub        func(buildpackGUID string) (ccv2.Warnings, error)
+	deleteBuildpackMutex       sync.RWMutex
+	deleteBuildpackArgsForCall []struct {
+		buildpackGUID string
+	}
+	deleteBuildpackReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteBuildpackReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteOrganizationJobStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationJobMutex       sync.RWMutex
 	deleteOrganizationJobArgsForCall []struct {
@@ -207,6 +284,32 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteServiceInstanceStub        func(serviceInstanceGUID string) (ccv2.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		serviceInstanceGUID string
+	}
+	deleteServiceInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteServiceKeyStub        func(serviceKeyGUID string) (ccv2.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		serviceKeyGUID string
+	}
+	deleteServiceKeyReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteSpaceJobStub        func(spaceGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteSpaceJobMutex       sync.RWMutex
 	deleteSpaceJobArgsForCall []struct {
@@ -222,6 +325,32 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	DeleteUserStub        func(userGUID string) (ccv2.Warnings, error)
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		userGUID string
+	}
+	deleteUserReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	DeleteUserProvidedServiceInstanceStub        func(userProvidedServiceInstanceGUID string) (ccv2.Warnings, error)
+	deleteUserProvidedServiceInstanceMutex       sync.RWMutex
+	deleteUserProvidedServiceInstanceArgsForCall []struct {
+		userProvidedServiceInstanceGUID string
+	}
+	deleteUserProvidedServiceInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	GetApplicationStub        func(guid string) (ccv2.Application, ccv2.Warnings, error)
 	getApplicationMutex       sync.RWMutex
 	getApplicationArgsForCall []struct {
@@ -326,6 +455,32 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetConfigRunningSecurityGroupsStub        func() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	getConfigRunningSecurityGroupsMutex       sync.RWMutex
+	getConfigRunningSecurityGroupsArgsForCall []struct{}
+	getConfigRunningSecurityGroupsReturns     struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getConfigRunningSecurityGroupsReturnsOnCall map[int]struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetConfigStagingSecurityGroupsStub        func() ([]ccv2.SecurityGroup, ccv2.Warnings, error)
+	getConfigStagingSecurityGroupsMutex       sync.RWMutex
+	getConfigStagingSecurityGroupsArgsForCall []struct{}
+	getConfigStagingSecurityGroupsReturns     struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getConfigStagingSecurityGroupsReturnsOnCall map[int]struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -402,6 +557,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetOrganizationSpaceQuotaDefinitionsStub        func(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error)
+	getOrganizationSpaceQuotaDefinitionsMutex       sync.RWMutex
+	getOrganizationSpaceQuotaDefinitionsArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationSpaceQuotaDefinitionsReturns struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationSpaceQuotaDefinitionsReturnsOnCall map[int]struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	GetOrganizationUsersByRoleStub        func(role constant.UserRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getOrganizationUsersByRoleMutex       sync.RWMutex
+	getOrganizationUsersByRoleArgsForCall []struct {
+		role    constant.UserRole
+		orgGUID string
+	}
+	getOrganizationUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getOrganizationUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -628,6 +814,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetServiceKeysStub        func(filters ...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error)
+	getServiceKeysMutex       sync.RWMutex
+	getServiceKeysArgsForCall []struct {
+		filters []ccv2.Filter
+	}
+	getServiceKeysReturns struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getServiceKeysReturnsOnCall map[int]struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetServicePlanStub        func(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error)
 	getServicePlanMutex       sync.RWMutex
 	getServicePlanArgsForCall []struct {
@@ -749,6 +950,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetSpaceUsersByRoleStub        func(role constant.UserRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error)
+	getSpaceUsersByRoleMutex       sync.RWMutex
+	getSpaceUsersByRoleArgsForCall []struct {
+		role      constant.UserRole
+		spaceGUID string
+	}
+	getSpaceUsersByRoleReturns struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getSpaceUsersByRoleReturnsOnCall map[int]struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSpacesStub        func(filters ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -943,6 +1160,37 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	UpdateOrganizationQuotaStub        func(orgGUID string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error)
+	updateOrganizationQuotaMutex       sync.RWMutex
+	updateOrganizationQuotaArgsForCall []struct {
+		orgGUID   string
+		quotaGUID string
+	}
+	updateOrganizationQuotaReturns struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateOrganizationUserByRoleStub        func(role constant.UserRole, orgGUID string, username string) (ccv2.Warnings, error)
+	updateOrganizationUserByRoleMutex       sync.RWMutex
+	updateOrganizationUserByRoleArgsForCall []struct {
+		role     constant.UserRole
+		orgGUID  string
+		username string
+	}
+	updateOrganizationUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateOrganizationUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UpdateResourceMatchStub        func(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error)
 	updateResourceMatchMutex       sync.RWMutex
 	updateResourceMatchArgsForCall []struct {
@@ -974,6 +1222,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateSecurityGroupStub        func(guid string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error)
+	updateSecurityGroupMutex       sync.RWMutex
+	updateSecurityGroupArgsForCall []struct {
+		guid  string
+		rules []ccv2.SecurityGroupRule
+	}
+	updateSecurityGroupReturns struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSecurityGroupReturnsOnCall map[int]struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}
 	UpdateSecurityGroupSpaceStub        func(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error)
 	updateSecurityGroupSpaceMutex       sync.RWMutex
 	updateSecurityGroupSpaceArgsForCall []struct {
@@ -1002,6 +1266,50 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	UpdateSpaceQuotaDefinitionStub        func(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error)
+	updateSpaceQuotaDefinitionMutex       sync.RWMutex
+	updateSpaceQuotaDefinitionArgsForCall []struct {
+		spaceQuota ccv2.SpaceQuota
+	}
+	updateSpaceQuotaDefinitionReturns struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateSpaceQuotaDefinitionReturnsOnCall map[int]struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UpdateSpaceQuotaDefinitionSpaceStub        func(spaceQuotaGUID string, spaceGUID string) (ccv2.Warnings, error)
+	updateSpaceQuotaDefinitionSpaceMutex       sync.RWMutex
+	updateSpaceQuotaDefinitionSpaceArgsForCall []struct {
+		spaceQuotaGUID string
+		spaceGUID      string
+	}
+	updateSpaceQuotaDefinitionSpaceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceQuotaDefinitionSpaceReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	UpdateSpaceUserByRoleStub        func(role constant.UserRole, spaceGUID string, username string) (ccv2.Warnings, error)
+	updateSpaceUserByRoleMutex       sync.RWMutex
+	updateSpaceUserByRoleArgsForCall []struct {
+		role      constant.UserRole
+		spaceGUID string
+		username  string
+	}
+	updateSpaceUserByRoleReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	updateSpaceUserByRoleReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	UploadApplicationPackageStub        func(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error)
 	uploadApplicationPackageMutex       sync.RWMutex
 	uploadApplicationPackageArgsForCall []struct {
@@ -1337,6 +1645,61 @@ func (fake *FakeCloudControllerClient) CreateOrganizationReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreatePrivateDomain(domainName string, orgGUID string) (ccv2.Domain, ccv2.Warnings, error) {
+	fake.createPrivateDomainMutex.Lock()
+	ret, specificReturn := fake.createPrivateDomainReturnsOnCall[len(fake.createPrivateDomainArgsForCall)]
+	fake.createPrivateDomainArgsForCall = append(fake.createPrivateDomainArgsForCall, struct {
+		domainName string
+		orgGUID    string
+	}{domainName, orgGUID})
+	fake.recordInvocation("CreatePrivateDomain", []interface{}{domainName, orgGUID})
+	fake.createPrivateDomainMutex.Unlock()
+	if fake.CreatePrivateDomainStub != nil {
+		return fake.CreatePrivateDomainStub(domainName, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createPrivateDomainReturns.result1, fake.createPrivateDomainReturns.result2, fake.createPrivateDomainReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreatePrivateDomainCallCount() int {
+	fake.createPrivateDomainMutex.RLock()
+	defer fake.createPrivateDomainMutex.RUnlock()
+	return len(fake.createPrivateDomainArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreatePrivateDomainArgsForCall(i int) (string, string) {
+	fake.createPrivateDomainMutex.RLock()
+	defer fake.createPrivateDomainMutex.RUnlock()
+	return fake.createPrivateDomainArgsForCall[i].domainName, fake.createPrivateDomainArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) CreatePrivateDomainReturns(result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.CreatePrivateDomainStub = nil
+	fake.createPrivateDomainReturns = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreatePrivateDomainReturnsOnCall(i int, result1 ccv2.Domain, result2 ccv2.Warnings, result3 error) {
+	fake.CreatePrivateDomainStub = nil
+	if fake.createPrivateDomainReturnsOnCall == nil {
+		fake.createPrivateDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Domain
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createPrivateDomainReturnsOnCall[i] = struct {
+		result1 ccv2.Domain
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateRoute(route ccv2.Route, generatePort bool) (ccv2.Route, ccv2.Warnings, error) {
 	fake.createRouteMutex.Lock()
 	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
@@ -1392,6 +1755,66 @@ func (fake *FakeCloudControllerClient) CreateRouteReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateSecurityGroup(name string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	var rulesCopy []ccv2.SecurityGroupRule
+	if rules != nil {
+		rulesCopy = make([]ccv2.SecurityGroupRule, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.createSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.createSecurityGroupReturnsOnCall[len(fake.createSecurityGroupArgsForCall)]
+	fake.createSecurityGroupArgsForCall = append(fake.createSecurityGroupArgsForCall, struct {
+		name  string
+		rules []ccv2.SecurityGroupRule
+	}{name, rulesCopy})
+	fake.recordInvocation("CreateSecurityGroup", []interface{}{name, rulesCopy})
+	fake.createSecurityGroupMutex.Unlock()
+	if fake.CreateSecurityGroupStub != nil {
+		return fake.CreateSecurityGroupStub(name, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSecurityGroupReturns.result1, fake.createSecurityGroupReturns.result2, fake.createSecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupCallCount() int {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return len(fake.createSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupArgsForCall(i int) (string, []ccv2.SecurityGroupRule) {
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
+	return fake.createSecurityGroupArgsForCall[i].name, fake.createSecurityGroupArgsForCall[i].rules
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	fake.createSecurityGroupReturns = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSecurityGroupReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSecurityGroupStub = nil
+	if fake.createSecurityGroupReturnsOnCall == nil {
+		fake.createSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateServiceBinding(appGUID string, serviceBindingGUID string, bindingName string, acceptsIncomplete bool, parameters map[string]interface{}) (ccv2.ServiceBinding, ccv2.Warnings, error) {
 	fake.createServiceBindingMutex.Lock()
 	ret, specificReturn := fake.createServiceBindingReturnsOnCall[len(fake.createServiceBindingArgsForCall)]
@@ -1450,6 +1873,115 @@ func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateSpace(spaceName string, orgGUID string) (ccv2.Space, ccv2.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		spaceName string
+		orgGUID   string
+	}{spaceName, orgGUID})
+	fake.recordInvocation("CreateSpace", []interface{}{spaceName, orgGUID})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(spaceName, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceArgsForCall(i int) (string, string) {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].spaceName, fake.createSpaceArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturns(result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceReturnsOnCall(i int, result1 ccv2.Space, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Space
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Space
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinition(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.createSpaceQuotaDefinitionMutex.Lock()
+	ret, specificReturn := fake.createSpaceQuotaDefinitionReturnsOnCall[len(fake.createSpaceQuotaDefinitionArgsForCall)]
+	fake.createSpaceQuotaDefinitionArgsForCall = append(fake.createSpaceQuotaDefinitionArgsForCall, struct {
+		spaceQuota ccv2.SpaceQuota
+	}{spaceQuota})
+	fake.recordInvocation("CreateSpaceQuotaDefinition", []interface{}{spaceQuota})
+	fake.createSpaceQuotaDefinitionMutex.Unlock()
+	if fake.CreateSpaceQuotaDefinitionStub != nil {
+		return fake.CreateSpaceQuotaDefinitionStub(spaceQuota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceQuotaDefinitionReturns.result1, fake.createSpaceQuotaDefinitionReturns.result2, fake.createSpaceQuotaDefinitionReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinitionCallCount() int {
+	fake.createSpaceQuotaDefinitionMutex.RLock()
+	defer fake.createSpaceQuotaDefinitionMutex.RUnlock()
+	return len(fake.createSpaceQuotaDefinitionArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinitionArgsForCall(i int) ccv2.SpaceQuota {
+	fake.createSpaceQuotaDefinitionMutex.RLock()
+	defer fake.createSpaceQuotaDefinitionMutex.RUnlock()
+	return fake.createSpaceQuotaDefinitionArgsForCall[i].spaceQuota
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinitionReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceQuotaDefinitionStub = nil
+	fake.createSpaceQuotaDefinitionReturns = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateSpaceQuotaDefinitionReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.CreateSpaceQuotaDefinitionStub = nil
+	if fake.createSpaceQuotaDefinitionReturnsOnCall == nil {
+		fake.createSpaceQuotaDefinitionReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceQuotaDefinitionReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -1504,6 +2036,57 @@ func (fake *FakeCloudControllerClient) CreateUserReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteBuildpack(buildpackGUID string) (ccv2.Warnings, error) {
+	fake.deleteBuildpackMutex.Lock()
+	ret, specificReturn := fake.deleteBuildpackReturnsOnCall[len(fake.deleteBuildpackArgsForCall)]
+	fake.deleteBuildpackArgsForCall = append(fake.deleteBuildpackArgsForCall, struct {
+		buildpackGUID string
+	}{buildpackGUID})
+	fake.recordInvocation("DeleteBuildpack", []interface{}{buildpackGUID})
+	fake.deleteBuildpackMutex.Unlock()
+	if fake.DeleteBuildpackStub != nil {
+		return fake.DeleteBuildpackStub(buildpackGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteBuildpackReturns.result1, fake.deleteBuildpackReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteBuildpackCallCount() int {
+	fake.deleteBuildpackMutex.RLock()
+	defer fake.deleteBuildpackMutex.RUnlock()
+	return len(fake.deleteBuildpackArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteBuildpackArgsForCall(i int) string {
+	fake.deleteBuildpackMutex.RLock()
+	defer fake.deleteBuildpackMutex.RUnlock()
+	return fake.deleteBuildpackArgsForCall[i].buildpackGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteBuildpackReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteBuildpackStub = nil
+	fake.deleteBuildpackReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteBuildpackReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteBuildpackStub = nil
+	if fake.deleteBuildpackReturnsOnCall == nil {
+		fake.deleteBuildpackReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteBuildpackReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteOrganizationJob(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteOrganizationJobMutex.Lock()
 	ret, specificReturn := fake.deleteOrganizationJobReturnsOnCall[len(fake.deleteOrganizationJobArgsForCall)]
@@ -1820,6 +2403,108 @@ func (fake *FakeCloudControllerClient) DeleteServiceBindingReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteServiceInstance(serviceInstanceGUID string) (ccv2.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		serviceInstanceGUID string
+	}{serviceInstanceGUID})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{serviceInstanceGUID})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceInstanceReturns.result1, fake.deleteServiceInstanceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceArgsForCall(i int) string {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return fake.deleteServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceInstanceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKey(serviceKeyGUID string) (ccv2.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		serviceKeyGUID string
+	}{serviceKeyGUID})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{serviceKeyGUID})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(serviceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceKeyReturns.result1, fake.deleteServiceKeyReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return fake.deleteServiceKeyArgsForCall[i].serviceKeyGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteServiceKeyReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteSpaceJob(spaceGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteSpaceJobMutex.Lock()
 	ret, specificReturn := fake.deleteSpaceJobReturnsOnCall[len(fake.deleteSpaceJobArgsForCall)]
@@ -1874,6 +2559,108 @@ func (fake *FakeCloudControllerClient) DeleteSpaceJobReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteUser(userGUID string) (ccv2.Warnings, error) {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		userGUID string
+	}{userGUID})
+	fake.recordInvocation("DeleteUser", []interface{}{userGUID})
+	fake.deleteUserMutex.Unlock()
+	if fake.DeleteUserStub != nil {
+		return fake.DeleteUserStub(userGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteUserReturns.result1, fake.deleteUserReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserArgsForCall(i int) string {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return fake.deleteUserArgsForCall[i].userGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstance(userProvidedServiceInstanceGUID string) (ccv2.Warnings, error) {
+	fake.deleteUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteUserProvidedServiceInstanceReturnsOnCall[len(fake.deleteUserProvidedServiceInstanceArgsForCall)]
+	fake.deleteUserProvidedServiceInstanceArgsForCall = append(fake.deleteUserProvidedServiceInstanceArgsForCall, struct {
+		userProvidedServiceInstanceGUID string
+	}{userProvidedServiceInstanceGUID})
+	fake.recordInvocation("DeleteUserProvidedServiceInstance", []interface{}{userProvidedServiceInstanceGUID})
+	fake.deleteUserProvidedServiceInstanceMutex.Unlock()
+	if fake.DeleteUserProvidedServiceInstanceStub != nil {
+		return fake.DeleteUserProvidedServiceInstanceStub(userProvidedServiceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteUserProvidedServiceInstanceReturns.result1, fake.deleteUserProvidedServiceInstanceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceCallCount() int {
+	fake.deleteUserProvidedServiceInstanceMutex.RLock()
+	defer fake.deleteUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.deleteUserProvidedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceArgsForCall(i int) string {
+	fake.deleteUserProvidedServiceInstanceMutex.RLock()
+	defer fake.deleteUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.deleteUserProvidedServiceInstanceArgsForCall[i].userProvidedServiceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserProvidedServiceInstanceStub = nil
+	fake.deleteUserProvidedServiceInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteUserProvidedServiceInstanceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteUserProvidedServiceInstanceStub = nil
+	if fake.deleteUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.deleteUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) GetApplication(guid string) (ccv2.Application, ccv2.Warnings, error) {
 	fake.getApplicationMutex.Lock()
 	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
@@ -2245,6 +3032,98 @@ func (fake *FakeCloudControllerClient) GetConfigFeatureFlagsReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetConfigRunningSecurityGroups() ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getConfigRunningSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.getConfigRunningSecurityGroupsReturnsOnCall[len(fake.getConfigRunningSecurityGroupsArgsForCall)]
+	fake.getConfigRunningSecurityGroupsArgsForCall = append(fake.getConfigRunningSecurityGroupsArgsForCall, struct{}{})
+	fake.recordInvocation("GetConfigRunningSecurityGroups", []interface{}{})
+	fake.getConfigRunningSecurityGroupsMutex.Unlock()
+	if fake.GetConfigRunningSecurityGroupsStub != nil {
+		return fake.GetConfigRunningSecurityGroupsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getConfigRunningSecurityGroupsReturns.result1, fake.getConfigRunningSecurityGroupsReturns.result2, fake.getConfigRunningSecurityGroupsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetConfigRunningSecurityGroupsCallCount() int {
+	fake.getConfigRunningSecurityGroupsMutex.RLock()
+	defer fake.getConfigRunningSecurityGroupsMutex.RUnlock()
+	return len(fake.getConfigRunningSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetConfigRunningSecurityGroupsReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetConfigRunningSecurityGroupsStub = nil
+	fake.getConfigRunningSecurityGroupsReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetConfigRunningSecurityGroupsReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetConfigRunningSecurityGroupsStub = nil
+	if fake.getConfigRunningSecurityGroupsReturnsOnCall == nil {
+		fake.getConfigRunningSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getConfigRunningSecurityGroupsReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetConfigStagingSecurityGroups() ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
+	fake.getConfigStagingSecurityGroupsMutex.Lock()
+	ret, specificReturn := fake.getConfigStagingSecurityGroupsReturnsOnCall[len(fake.getConfigStagingSecurityGroupsArgsForCall)]
+	fake.getConfigStagingSecurityGroupsArgsForCall = append(fake.getConfigStagingSecurityGroupsArgsForCall, struct{}{})
+	fake.recordInvocation("GetConfigStagingSecurityGroups", []interface{}{})
+	fake.getConfigStagingSecurityGroupsMutex.Unlock()
+	if fake.GetConfigStagingSecurityGroupsStub != nil {
+		return fake.GetConfigStagingSecurityGroupsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getConfigStagingSecurityGroupsReturns.result1, fake.getConfigStagingSecurityGroupsReturns.result2, fake.getConfigStagingSecurityGroupsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetConfigStagingSecurityGroupsCallCount() int {
+	fake.getConfigStagingSecurityGroupsMutex.RLock()
+	defer fake.getConfigStagingSecurityGroupsMutex.RUnlock()
+	return len(fake.getConfigStagingSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetConfigStagingSecurityGroupsReturns(result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetConfigStagingSecurityGroupsStub = nil
+	fake.getConfigStagingSecurityGroupsReturns = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetConfigStagingSecurityGroupsReturnsOnCall(i int, result1 []ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.GetConfigStagingSecurityGroupsStub = nil
+	if fake.getConfigStagingSecurityGroupsReturnsOnCall == nil {
+		fake.getConfigStagingSecurityGroupsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getConfigStagingSecurityGroupsReturnsOnCall[i] = struct {
+		result1 []ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.getJobMutex.Lock()
 	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
@@ -2516,6 +3395,115 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotaDefinitions(orgGUID string) ([]ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.getOrganizationSpaceQuotaDefinitionsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpaceQuotaDefinitionsReturnsOnCall[len(fake.getOrganizationSpaceQuotaDefinitionsArgsForCall)]
+	fake.getOrganizationSpaceQuotaDefinitionsArgsForCall = append(fake.getOrganizationSpaceQuotaDefinitionsArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationSpaceQuotaDefinitions", []interface{}{orgGUID})
+	fake.getOrganizationSpaceQuotaDefinitionsMutex.Unlock()
+	if fake.GetOrganizationSpaceQuotaDefinitionsStub != nil {
+		return fake.GetOrganizationSpaceQuotaDefinitionsStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationSpaceQuotaDefinitionsReturns.result1, fake.getOrganizationSpaceQuotaDefinitionsReturns.result2, fake.getOrganizationSpaceQuotaDefinitionsReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotaDefinitionsCallCount() int {
+	fake.getOrganizationSpaceQuotaDefinitionsMutex.RLock()
+	defer fake.getOrganizationSpaceQuotaDefinitionsMutex.RUnlock()
+	return len(fake.getOrganizationSpaceQuotaDefinitionsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotaDefinitionsArgsForCall(i int) string {
+	fake.getOrganizationSpaceQuotaDefinitionsMutex.RLock()
+	defer fake.getOrganizationSpaceQuotaDefinitionsMutex.RUnlock()
+	return fake.getOrganizationSpaceQuotaDefinitionsArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotaDefinitionsReturns(result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationSpaceQuotaDefinitionsStub = nil
+	fake.getOrganizationSpaceQuotaDefinitionsReturns = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationSpaceQuotaDefinitionsReturnsOnCall(i int, result1 []ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationSpaceQuotaDefinitionsStub = nil
+	if fake.getOrganizationSpaceQuotaDefinitionsReturnsOnCall == nil {
+		fake.getOrganizationSpaceQuotaDefinitionsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpaceQuotaDefinitionsReturnsOnCall[i] = struct {
+		result1 []ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRole(role constant.UserRole, orgGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersByRoleReturnsOnCall[len(fake.getOrganizationUsersByRoleArgsForCall)]
+	fake.getOrganizationUsersByRoleArgsForCall = append(fake.getOrganizationUsersByRoleArgsForCall, struct {
+		role    constant.UserRole
+		orgGUID string
+	}{role, orgGUID})
+	fake.recordInvocation("GetOrganizationUsersByRole", []interface{}{role, orgGUID})
+	fake.getOrganizationUsersByRoleMutex.Unlock()
+	if fake.GetOrganizationUsersByRoleStub != nil {
+		return fake.GetOrganizationUsersByRoleStub(role, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationUsersByRoleReturns.result1, fake.getOrganizationUsersByRoleReturns.result2, fake.getOrganizationUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleCallCount() int {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return len(fake.getOrganizationUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleArgsForCall(i int) (constant.UserRole, string) {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return fake.getOrganizationUsersByRoleArgsForCall[i].role, fake.getOrganizationUsersByRoleArgsForCall[i].orgGUID
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	fake.getOrganizationUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	if fake.getOrganizationUsersByRoleReturnsOnCall == nil {
+		fake.getOrganizationUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(filters ...ccv2.Filter) ([]ccv2.Organization, ccv2.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -3327,6 +4315,60 @@ func (fake *FakeCloudControllerClient) GetServiceInstanceSharedTosReturnsOnCall(
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceKeys(filters ...ccv2.Filter) ([]ccv2.ServiceKey, ccv2.Warnings, error) {
+	fake.getServiceKeysMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysReturnsOnCall[len(fake.getServiceKeysArgsForCall)]
+	fake.getServiceKeysArgsForCall = append(fake.getServiceKeysArgsForCall, struct {
+		filters []ccv2.Filter
+	}{filters})
+	fake.recordInvocation("GetServiceKeys", []interface{}{filters})
+	fake.getServiceKeysMutex.Unlock()
+	if fake.GetServiceKeysStub != nil {
+		return fake.GetServiceKeysStub(filters...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeysReturns.result1, fake.getServiceKeysReturns.result2, fake.getServiceKeysReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysCallCount() int {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return len(fake.getServiceKeysArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysArgsForCall(i int) []ccv2.Filter {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return fake.getServiceKeysArgsForCall[i].filters
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturns(result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	fake.getServiceKeysReturns = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceKeysReturnsOnCall(i int, result1 []ccv2.ServiceKey, result2 ccv2.Warnings, result3 error) {
+	fake.GetServiceKeysStub = nil
+	if fake.getServiceKeysReturnsOnCall == nil {
+		fake.getServiceKeysReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.ServiceKey
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysReturnsOnCall[i] = struct {
+		result1 []ccv2.ServiceKey
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServicePlan(servicePlanGUID string) (ccv2.ServicePlan, ccv2.Warnings, error) {
 	fake.getServicePlanMutex.Lock()
 	ret, specificReturn := fake.getServicePlanReturnsOnCall[len(fake.getServicePlanArgsForCall)]
@@ -3760,6 +4802,61 @@ func (fake *FakeCloudControllerClient) GetSpaceRoutesReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRole(role constant.UserRole, spaceGUID string) ([]ccv2.User, ccv2.Warnings, error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersByRoleReturnsOnCall[len(fake.getSpaceUsersByRoleArgsForCall)]
+	fake.getSpaceUsersByRoleArgsForCall = append(fake.getSpaceUsersByRoleArgsForCall, struct {
+		role      constant.UserRole
+		spaceGUID string
+	}{role, spaceGUID})
+	fake.recordInvocation("GetSpaceUsersByRole", []interface{}{role, spaceGUID})
+	fake.getSpaceUsersByRoleMutex.Unlock()
+	if fake.GetSpaceUsersByRoleStub != nil {
+		return fake.GetSpaceUsersByRoleStub(role, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceUsersByRoleReturns.result1, fake.getSpaceUsersByRoleReturns.result2, fake.getSpaceUsersByRoleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleCallCount() int {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return len(fake.getSpaceUsersByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleArgsForCall(i int) (constant.UserRole, string) {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return fake.getSpaceUsersByRoleArgsForCall[i].role, fake.getSpaceUsersByRoleArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturns(result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	fake.getSpaceUsersByRoleReturns = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsersByRoleReturnsOnCall(i int, result1 []ccv2.User, result2 ccv2.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	if fake.getSpaceUsersByRoleReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.User
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsersByRoleReturnsOnCall[i] = struct {
+		result1 []ccv2.User
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaces(filters ...ccv2.Filter) ([]ccv2.Space, ccv2.Warnings, error) {
 	fake.getSpacesMutex.Lock()
 	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
@@ -4458,6 +5555,114 @@ func (fake *FakeCloudControllerClient) UpdateOrganizationManagerByUsernameReturn
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (ccv2.Organization, ccv2.Warnings, error) {
+	fake.updateOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationQuotaReturnsOnCall[len(fake.updateOrganizationQuotaArgsForCall)]
+	fake.updateOrganizationQuotaArgsForCall = append(fake.updateOrganizationQuotaArgsForCall, struct {
+		orgGUID   string
+		quotaGUID string
+	}{orgGUID, quotaGUID})
+	fake.recordInvocation("UpdateOrganizationQuota", []interface{}{orgGUID, quotaGUID})
+	fake.updateOrganizationQuotaMutex.Unlock()
+	if fake.UpdateOrganizationQuotaStub != nil {
+		return fake.UpdateOrganizationQuotaStub(orgGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateOrganizationQuotaReturns.result1, fake.updateOrganizationQuotaReturns.result2, fake.updateOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaCallCount() int {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return len(fake.updateOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaArgsForCall(i int) (string, string) {
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	return fake.updateOrganizationQuotaArgsForCall[i].orgGUID, fake.updateOrganizationQuotaArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturns(result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	fake.updateOrganizationQuotaReturns = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationQuotaReturnsOnCall(i int, result1 ccv2.Organization, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateOrganizationQuotaStub = nil
+	if fake.updateOrganizationQuotaReturnsOnCall == nil {
+		fake.updateOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Organization
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 ccv2.Organization
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRole(role constant.UserRole, orgGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateOrganizationUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationUserByRoleReturnsOnCall[len(fake.updateOrganizationUserByRoleArgsForCall)]
+	fake.updateOrganizationUserByRoleArgsForCall = append(fake.updateOrganizationUserByRoleArgsForCall, struct {
+		role     constant.UserRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("UpdateOrganizationUserByRole", []interface{}{role, orgGUID, username})
+	fake.updateOrganizationUserByRoleMutex.Unlock()
+	if fake.UpdateOrganizationUserByRoleStub != nil {
+		return fake.UpdateOrganizationUserByRoleStub(role, orgGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateOrganizationUserByRoleReturns.result1, fake.updateOrganizationUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleCallCount() int {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return len(fake.updateOrganizationUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleArgsForCall(i int) (constant.UserRole, string, string) {
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
+	return fake.updateOrganizationUserByRoleArgsForCall[i].role, fake.updateOrganizationUserByRoleArgsForCall[i].orgGUID, fake.updateOrganizationUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	fake.updateOrganizationUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateOrganizationUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateOrganizationUserByRoleStub = nil
+	if fake.updateOrganizationUserByRoleReturnsOnCall == nil {
+		fake.updateOrganizationUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateResourceMatch(resourcesToMatch []ccv2.Resource) ([]ccv2.Resource, ccv2.Warnings, error) {
 	var resourcesToMatchCopy []ccv2.Resource
 	if resourcesToMatch != nil {
@@ -4572,6 +5777,66 @@ func (fake *FakeCloudControllerClient) UpdateRouteApplicationReturnsOnCall(i int
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSecurityGroup(guid string, rules []ccv2.SecurityGroupRule) (ccv2.SecurityGroup, ccv2.Warnings, error) {
+	var rulesCopy []ccv2.SecurityGroupRule
+	if rules != nil {
+		rulesCopy = make([]ccv2.SecurityGroupRule, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.updateSecurityGroupMutex.Lock()
+	ret, specificReturn := fake.updateSecurityGroupReturnsOnCall[len(fake.updateSecurityGroupArgsForCall)]
+	fake.updateSecurityGroupArgsForCall = append(fake.updateSecurityGroupArgsForCall, struct {
+		guid  string
+		rules []ccv2.SecurityGroupRule
+	}{guid, rulesCopy})
+	fake.recordInvocation("UpdateSecurityGroup", []interface{}{guid, rulesCopy})
+	fake.updateSecurityGroupMutex.Unlock()
+	if fake.UpdateSecurityGroupStub != nil {
+		return fake.UpdateSecurityGroupStub(guid, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSecurityGroupReturns.result1, fake.updateSecurityGroupReturns.result2, fake.updateSecurityGroupReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupCallCount() int {
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
+	return len(fake.updateSecurityGroupArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupArgsForCall(i int) (string, []ccv2.SecurityGroupRule) {
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
+	return fake.updateSecurityGroupArgsForCall[i].guid, fake.updateSecurityGroupArgsForCall[i].rules
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupReturns(result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupStub = nil
+	fake.updateSecurityGroupReturns = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSecurityGroupReturnsOnCall(i int, result1 ccv2.SecurityGroup, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSecurityGroupStub = nil
+	if fake.updateSecurityGroupReturnsOnCall == nil {
+		fake.updateSecurityGroupReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SecurityGroup
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateSecurityGroupReturnsOnCall[i] = struct {
+		result1 ccv2.SecurityGroup
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSecurityGroupSpace(securityGroupGUID string, spaceGUID string) (ccv2.Warnings, error) {
 	fake.updateSecurityGroupSpaceMutex.Lock()
 	ret, specificReturn := fake.updateSecurityGroupSpaceReturnsOnCall[len(fake.updateSecurityGroupSpaceArgsForCall)]
@@ -4676,6 +5941,165 @@ func (fake *FakeCloudControllerClient) UpdateSecurityGroupStagingSpaceReturnsOnC
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinition(spaceQuota ccv2.SpaceQuota) (ccv2.SpaceQuota, ccv2.Warnings, error) {
+	fake.updateSpaceQuotaDefinitionMutex.Lock()
+	ret, specificReturn := fake.updateSpaceQuotaDefinitionReturnsOnCall[len(fake.updateSpaceQuotaDefinitionArgsForCall)]
+	fake.updateSpaceQuotaDefinitionArgsForCall = append(fake.updateSpaceQuotaDefinitionArgsForCall, struct {
+		spaceQuota ccv2.SpaceQuota
+	}{spaceQuota})
+	fake.recordInvocation("UpdateSpaceQuotaDefinition", []interface{}{spaceQuota})
+	fake.updateSpaceQuotaDefinitionMutex.Unlock()
+	if fake.UpdateSpaceQuotaDefinitionStub != nil {
+		return fake.UpdateSpaceQuotaDefinitionStub(spaceQuota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSpaceQuotaDefinitionReturns.result1, fake.updateSpaceQuotaDefinitionReturns.result2, fake.updateSpaceQuotaDefinitionReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionCallCount() int {
+	fake.updateSpaceQuotaDefinitionMutex.RLock()
+	defer fake.updateSpaceQuotaDefinitionMutex.RUnlock()
+	return len(fake.updateSpaceQuotaDefinitionArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionArgsForCall(i int) ccv2.SpaceQuota {
+	fake.updateSpaceQuotaDefinitionMutex.RLock()
+	defer fake.updateSpaceQuotaDefinitionMutex.RUnlock()
+	return fake.updateSpaceQuotaDefinitionArgsForCall[i].spaceQuota
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionReturns(result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceQuotaDefinitionStub = nil
+	fake.updateSpaceQuotaDefinitionReturns = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionReturnsOnCall(i int, result1 ccv2.SpaceQuota, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateSpaceQuotaDefinitionStub = nil
+	if fake.updateSpaceQuotaDefinitionReturnsOnCall == nil {
+		fake.updateSpaceQuotaDefinitionReturnsOnCall = make(map[int]struct {
+			result1 ccv2.SpaceQuota
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceQuotaDefinitionReturnsOnCall[i] = struct {
+		result1 ccv2.SpaceQuota
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionSpace(spaceQuotaGUID string, spaceGUID string) (ccv2.Warnings, error) {
+	fake.updateSpaceQuotaDefinitionSpaceMutex.Lock()
+	ret, specificReturn := fake.updateSpaceQuotaDefinitionSpaceReturnsOnCall[len(fake.updateSpaceQuotaDefinitionSpaceArgsForCall)]
+	fake.updateSpaceQuotaDefinitionSpaceArgsForCall = append(fake.updateSpaceQuotaDefinitionSpaceArgsForCall, struct {
+		spaceQuotaGUID string
+		spaceGUID      string
+	}{spaceQuotaGUID, spaceGUID})
+	fake.recordInvocation("UpdateSpaceQuotaDefinitionSpace", []interface{}{spaceQuotaGUID, spaceGUID})
+	fake.updateSpaceQuotaDefinitionSpaceMutex.Unlock()
+	if fake.UpdateSpaceQuotaDefinitionSpaceStub != nil {
+		return fake.UpdateSpaceQuotaDefinitionSpaceStub(spaceQuotaGUID, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceQuotaDefinitionSpaceReturns.result1, fake.updateSpaceQuotaDefinitionSpaceReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionSpaceCallCount() int {
+	fake.updateSpaceQuotaDefinitionSpaceMutex.RLock()
+	defer fake.updateSpaceQuotaDefinitionSpaceMutex.RUnlock()
+	return len(fake.updateSpaceQuotaDefinitionSpaceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionSpaceArgsForCall(i int) (string, string) {
+	fake.updateSpaceQuotaDefinitionSpaceMutex.RLock()
+	defer fake.updateSpaceQuotaDefinitionSpaceMutex.RUnlock()
+	return fake.updateSpaceQuotaDefinitionSpaceArgsForCall[i].spaceQuotaGUID, fake.updateSpaceQuotaDefinitionSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionSpaceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceQuotaDefinitionSpaceStub = nil
+	fake.updateSpaceQuotaDefinitionSpaceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceQuotaDefinitionSpaceReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceQuotaDefinitionSpaceStub = nil
+	if fake.updateSpaceQuotaDefinitionSpaceReturnsOnCall == nil {
+		fake.updateSpaceQuotaDefinitionSpaceReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceQuotaDefinitionSpaceReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRole(role constant.UserRole, spaceGUID string, username string) (ccv2.Warnings, error) {
+	fake.updateSpaceUserByRoleMutex.Lock()
+	ret, specificReturn := fake.updateSpaceUserByRoleReturnsOnCall[len(fake.updateSpaceUserByRoleArgsForCall)]
+	fake.updateSpaceUserByRoleArgsForCall = append(fake.updateSpaceUserByRoleArgsForCall, struct {
+		role      constant.UserRole
+		spaceGUID string
+		username  string
+	}{role, spaceGUID, username})
+	fake.recordInvocation("UpdateSpaceUserByRole", []interface{}{role, spaceGUID, username})
+	fake.updateSpaceUserByRoleMutex.Unlock()
+	if fake.UpdateSpaceUserByRoleStub != nil {
+		return fake.UpdateSpaceUserByRoleStub(role, spaceGUID, username)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSpaceUserByRoleReturns.result1, fake.updateSpaceUserByRoleReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleCallCount() int {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return len(fake.updateSpaceUserByRoleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleArgsForCall(i int) (constant.UserRole, string, string) {
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
+	return fake.updateSpaceUserByRoleArgsForCall[i].role, fake.updateSpaceUserByRoleArgsForCall[i].spaceGUID, fake.updateSpaceUserByRoleArgsForCall[i].username
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturns(result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	fake.updateSpaceUserByRoleReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateSpaceUserByRoleReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.UpdateSpaceUserByRoleStub = nil
+	if fake.updateSpaceUserByRoleReturnsOnCall == nil {
+		fake.updateSpaceUserByRoleReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceUserByRoleReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UploadApplicationPackage(appGUID string, existingResources []ccv2.Resource, newResources ccv2.Reader, newResourcesLength int64) (ccv2.Job, ccv2.Warnings, error) {
 	var existingResourcesCopy []ccv2.Resource
 	if existingResources != nil {
@@ -5139,12 +6563,22 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.createBuildpackMutex.RUnlock()
 	fake.createOrganizationMutex.RLock()
 	defer fake.createOrganizationMutex.RUnlock()
+	fake.createPrivateDomainMutex.RLock()
+	defer fake.createPrivateDomainMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createSecurityGroupMutex.RLock()
+	defer fake.createSecurityGroupMutex.RUnlock()
 	fake.createServiceBindingMutex.RLock()
 	defer fake.createServiceBindingMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	fake.createSpaceQuotaDefinitionMutex.RLock()
+	defer fake.createSpaceQuotaDefinitionMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteBuildpackMutex.RLock()
+	defer fake.deleteBuildpackMutex.RUnlock()
 	fake.deleteOrganizationJobMutex.RLock()
 	defer fake.deleteOrganizationJobMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
@@ -5157,8 +6591,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteSecurityGroupStagingSpaceMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
 	fake.deleteSpaceJobMutex.RLock()
 	defer fake.deleteSpaceJobMutex.RUnlock()
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	fake.deleteUserProvidedServiceInstanceMutex.RLock()
+	defer fake.deleteUserProvidedServiceInstanceMutex.RUnlock()
 	fake.getApplicationMutex.RLock()
 	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationApplicationInstancesMutex.RLock()
@@ -5173,6 +6615,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getBuildpacksMutex.RUnlock()
 	fake.getConfigFeatureFlagsMutex.RLock()
 	defer fake.getConfigFeatureFlagsMutex.RUnlock()
+	fake.getConfigRunningSecurityGroupsMutex.RLock()
+	defer fake.getConfigRunningSecurityGroupsMutex.RUnlock()
+	fake.getConfigStagingSecurityGroupsMutex.RLock()
+	defer fake.getConfigStagingSecurityGroupsMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
@@ -5183,6 +6629,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationQuotaMutex.RUnlock()
 	fake.getOrganizationQuotasMutex.RLock()
 	defer fake.getOrganizationQuotasMutex.RUnlock()
+	fake.getOrganizationSpaceQuotaDefinitionsMutex.RLock()
+	defer fake.getOrganizationSpaceQuotaDefinitionsMutex.RUnlock()
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPrivateDomainMutex.RLock()
@@ -5213,6 +6663,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getServiceInstanceSharedFromMutex.RUnlock()
 	fake.getServiceInstanceSharedTosMutex.RLock()
 	defer fake.getServiceInstanceSharedTosMutex.RUnlock()
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
 	fake.getServicePlanMutex.RLock()
 	defer fake.getServicePlanMutex.RUnlock()
 	fake.getServicePlansMutex.RLock()
@@ -5229,6 +6681,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceQuotaDefinitionMutex.RUnlock()
 	fake.getSpaceRoutesMutex.RLock()
 	defer fake.getSpaceRoutesMutex.RUnlock()
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
 	fake.getSpaceSecurityGroupsMutex.RLock()
@@ -5255,14 +6709,26 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateBuildpackMutex.RUnlock()
 	fake.updateOrganizationManagerByUsernameMutex.RLock()
 	defer fake.updateOrganizationManagerByUsernameMutex.RUnlock()
+	fake.updateOrganizationQuotaMutex.RLock()
+	defer fake.updateOrganizationQuotaMutex.RUnlock()
+	fake.updateOrganizationUserByRoleMutex.RLock()
+	defer fake.updateOrganizationUserByRoleMutex.RUnlock()
 	fake.updateResourceMatchMutex.RLock()
 	defer fake.updateResourceMatchMutex.RUnlock()
 	fake.updateRouteApplicationMutex.RLock()
 	defer fake.updateRouteApplicationMutex.RUnlock()
+	fake.updateSecurityGroupMutex.RLock()
+	defer fake.updateSecurityGroupMutex.RUnlock()
 	fake.updateSecurityGroupSpaceMutex.RLock()
 	defer fake.updateSecurityGroupSpaceMutex.RUnlock()
 	fake.updateSecurityGroupStagingSpaceMutex.RLock()
 	defer fake.updateSecurityGroupStagingSpaceMutex.RUnlock()
+	fake.updateSpaceQuotaDefinitionMutex.RLock()
+	defer fake.updateSpaceQuotaDefinitionMutex.RUnlock()
+	fake.updateSpaceQuotaDefinitionSpaceMutex.RLock()
+	defer fake.updateSpaceQuotaDefinitionSpaceMutex.RUnlock()
+	fake.updateSpaceUserByRoleMutex.RLock()
+	defer fake.updateSpaceUserByRoleMutex.RUnlock()
 	fake.uploadApplicationPackageMutex.RLock()
 	defer fake.uploadApplicationPackageMutex.RUnlock()
 	fake.uploadBuildpackMutex.RLock()
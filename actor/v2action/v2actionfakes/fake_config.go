@@ -18,6 +18,33 @@ type FakeConfig struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CACertFileStub        func() string
+	cACertFileMutex       sync.RWMutex
+	cACertFileArgsForCall []struct{}
+	cACertFileReturns     struct {
+		result1 string
+	}
+	cACertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ClientCertFileStub        func() string
+	clientCertFileMutex       sync.RWMutex
+	clientCertFileArgsForCall []struct{}
+	clientCertFileReturns     struct {
+		result1 string
+	}
+	clientCertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ClientKeyFileStub        func() string
+	clientKeyFileMutex       sync.RWMutex
+	clientKeyFileArgsForCall []struct{}
+	clientKeyFileReturns     struct {
+		result1 string
+	}
+	clientKeyFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PollingIntervalStub        func() time.Duration
 	pollingIntervalMutex       sync.RWMutex
 	pollingIntervalArgsForCall []struct{}
@@ -46,6 +73,13 @@ type FakeConfig struct {
 	setRefreshTokenArgsForCall []struct {
 		refreshToken string
 	}
+	SetTLSCertificateFilesStub        func(caCertFile string, clientCertFile string, clientKeyFile string)
+	setTLSCertificateFilesMutex       sync.RWMutex
+	setTLSCertificateFilesArgsForCall []struct {
+		caCertFile     string
+		clientCertFile string
+		clientKeyFile  string
+	}
 	SetTargetInformationStub        func(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	setTargetInformationMutex       sync.RWMutex
 	setTargetInformationArgsForCall []struct {
@@ -190,6 +224,126 @@ func (fake *FakeConfig) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) CACertFile() string {
+	fake.cACertFileMutex.Lock()
+	ret, specificReturn := fake.cACertFileReturnsOnCall[len(fake.cACertFileArgsForCall)]
+	fake.cACertFileArgsForCall = append(fake.cACertFileArgsForCall, struct{}{})
+	fake.recordInvocation("CACertFile", []interface{}{})
+	fake.cACertFileMutex.Unlock()
+	if fake.CACertFileStub != nil {
+		return fake.CACertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cACertFileReturns.result1
+}
+
+func (fake *FakeConfig) CACertFileCallCount() int {
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	return len(fake.cACertFileArgsForCall)
+}
+
+func (fake *FakeConfig) CACertFileReturns(result1 string) {
+	fake.CACertFileStub = nil
+	fake.cACertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CACertFileReturnsOnCall(i int, result1 string) {
+	fake.CACertFileStub = nil
+	if fake.cACertFileReturnsOnCall == nil {
+		fake.cACertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cACertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientCertFile() string {
+	fake.clientCertFileMutex.Lock()
+	ret, specificReturn := fake.clientCertFileReturnsOnCall[len(fake.clientCertFileArgsForCall)]
+	fake.clientCertFileArgsForCall = append(fake.clientCertFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientCertFile", []interface{}{})
+	fake.clientCertFileMutex.Unlock()
+	if fake.ClientCertFileStub != nil {
+		return fake.ClientCertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.clientCertFileReturns.result1
+}
+
+func (fake *FakeConfig) ClientCertFileCallCount() int {
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	return len(fake.clientCertFileArgsForCall)
+}
+
+func (fake *FakeConfig) ClientCertFileReturns(result1 string) {
+	fake.ClientCertFileStub = nil
+	fake.clientCertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientCertFileReturnsOnCall(i int, result1 string) {
+	fake.ClientCertFileStub = nil
+	if fake.clientCertFileReturnsOnCall == nil {
+		fake.clientCertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.clientCertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientKeyFile() string {
+	fake.clientKeyFileMutex.Lock()
+	ret, specificReturn := fake.clientKeyFileReturnsOnCall[len(fake.clientKeyFileArgsForCall)]
+	fake.clientKeyFileArgsForCall = append(fake.clientKeyFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientKeyFile", []interface{}{})
+	fake.clientKeyFileMutex.Unlock()
+	if fake.ClientKeyFileStub != nil {
+		return fake.ClientKeyFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.clientKeyFileReturns.result1
+}
+
+func (fake *FakeConfig) ClientKeyFileCallCount() int {
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
+	return len(fake.clientKeyFileArgsForCall)
+}
+
+func (fake *FakeConfig) ClientKeyFileReturns(result1 string) {
+	fake.ClientKeyFileStub = nil
+	fake.clientKeyFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientKeyFileReturnsOnCall(i int, result1 string) {
+	fake.ClientKeyFileStub = nil
+	if fake.clientKeyFileReturnsOnCall == nil {
+		fake.clientKeyFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.clientKeyFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) PollingInterval() time.Duration {
 	fake.pollingIntervalMutex.Lock()
 	ret, specificReturn := fake.pollingIntervalReturnsOnCall[len(fake.pollingIntervalArgsForCall)]
@@ -318,6 +472,32 @@ func (fake *FakeConfig) SetRefreshTokenArgsForCall(i int) string {
 	return fake.setRefreshTokenArgsForCall[i].refreshToken
 }
 
+func (fake *FakeConfig) SetTLSCertificateFiles(caCertFile string, clientCertFile string, clientKeyFile string) {
+	fake.setTLSCertificateFilesMutex.Lock()
+	fake.setTLSCertificateFilesArgsForCall = append(fake.setTLSCertificateFilesArgsForCall, struct {
+		caCertFile     string
+		clientCertFile string
+		clientKeyFile  string
+	}{caCertFile, clientCertFile, clientKeyFile})
+	fake.recordInvocation("SetTLSCertificateFiles", []interface{}{caCertFile, clientCertFile, clientKeyFile})
+	fake.setTLSCertificateFilesMutex.Unlock()
+	if fake.SetTLSCertificateFilesStub != nil {
+		fake.SetTLSCertificateFilesStub(caCertFile, clientCertFile, clientKeyFile)
+	}
+}
+
+func (fake *FakeConfig) SetTLSCertificateFilesCallCount() int {
+	fake.setTLSCertificateFilesMutex.RLock()
+	defer fake.setTLSCertificateFilesMutex.RUnlock()
+	return len(fake.setTLSCertificateFilesArgsForCall)
+}
+
+func (fake *FakeConfig) SetTLSCertificateFilesArgsForCall(i int) (string, string, string) {
+	fake.setTLSCertificateFilesMutex.RLock()
+	defer fake.setTLSCertificateFilesMutex.RUnlock()
+	return fake.setTLSCertificateFilesArgsForCall[i].caCertFile, fake.setTLSCertificateFilesArgsForCall[i].clientCertFile, fake.setTLSCertificateFilesArgsForCall[i].clientKeyFile
+}
+
 func (fake *FakeConfig) SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool) {
 	fake.setTargetInformationMutex.Lock()
 	fake.setTargetInformationArgsForCall = append(fake.setTargetInformationArgsForCall, struct {
@@ -743,6 +923,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
 	defer fake.accessTokenMutex.RUnlock()
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
@@ -751,6 +937,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
+	fake.setTLSCertificateFilesMutex.RLock()
+	defer fake.setTLSCertificateFilesMutex.RUnlock()
 	fake.setTargetInformationMutex.RLock()
 	defer fake.setTargetInformationMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
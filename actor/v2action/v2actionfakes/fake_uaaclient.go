@@ -10,6 +10,18 @@ import (
 )
 
 type FakeUAAClient struct {
+	AddGroupMemberStub        func(groupID string, userID string) error
+	addGroupMemberMutex       sync.RWMutex
+	addGroupMemberArgsForCall []struct {
+		groupID string
+		userID  string
+	}
+	addGroupMemberReturns struct {
+		result1 error
+	}
+	addGroupMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
 	APIVersionStub        func() string
 	aPIVersionMutex       sync.RWMutex
 	aPIVersionArgsForCall []struct{}
@@ -52,6 +64,30 @@ type FakeUAAClient struct {
 		result1 uaa.User
 		result2 error
 	}
+	DeleteUserStub        func(userID string) error
+	deleteUserMutex       sync.RWMutex
+	deleteUserArgsForCall []struct {
+		userID string
+	}
+	deleteUserReturns struct {
+		result1 error
+	}
+	deleteUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetGroupsStub        func(filter string) ([]uaa.Group, error)
+	getGroupsMutex       sync.RWMutex
+	getGroupsArgsForCall []struct {
+		filter string
+	}
+	getGroupsReturns struct {
+		result1 []uaa.Group
+		result2 error
+	}
+	getGroupsReturnsOnCall map[int]struct {
+		result1 []uaa.Group
+		result2 error
+	}
 	GetSSHPasscodeStub        func(accessToken string, sshOAuthClient string) (string, error)
 	getSSHPasscodeMutex       sync.RWMutex
 	getSSHPasscodeArgsForCall []struct {
@@ -66,6 +102,19 @@ type FakeUAAClient struct {
 		result1 string
 		result2 error
 	}
+	GetUsersStub        func(filter string) ([]uaa.User, error)
+	getUsersMutex       sync.RWMutex
+	getUsersArgsForCall []struct {
+		filter string
+	}
+	getUsersReturns struct {
+		result1 []uaa.User
+		result2 error
+	}
+	getUsersReturnsOnCall map[int]struct {
+		result1 []uaa.User
+		result2 error
+	}
 	RefreshAccessTokenStub        func(refreshToken string) (uaa.RefreshedTokens, error)
 	refreshAccessTokenMutex       sync.RWMutex
 	refreshAccessTokenArgsForCall []struct {
@@ -79,10 +128,106 @@ type FakeUAAClient struct {
 		result1 uaa.RefreshedTokens
 		result2 error
 	}
+	RemoveGroupMemberStub        func(groupID string, userID string) error
+	removeGroupMemberMutex       sync.RWMutex
+	removeGroupMemberArgsForCall []struct {
+		groupID string
+		userID  string
+	}
+	removeGroupMemberReturns struct {
+		result1 error
+	}
+	removeGroupMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResetUserPasswordStub        func(userID string, password string) error
+	resetUserPasswordMutex       sync.RWMutex
+	resetUserPasswordArgsForCall []struct {
+		userID   string
+		password string
+	}
+	resetUserPasswordReturns struct {
+		result1 error
+	}
+	resetUserPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetUserActiveStub        func(userID string, active bool) error
+	setUserActiveMutex       sync.RWMutex
+	setUserActiveArgsForCall []struct {
+		userID string
+		active bool
+	}
+	setUserActiveReturns struct {
+		result1 error
+	}
+	setUserActiveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnlockUserStub        func(userID string) error
+	unlockUserMutex       sync.RWMutex
+	unlockUserArgsForCall []struct {
+		userID string
+	}
+	unlockUserReturns struct {
+		result1 error
+	}
+	unlockUserReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeUAAClient) AddGroupMember(groupID string, userID string) error {
+	fake.addGroupMemberMutex.Lock()
+	ret, specificReturn := fake.addGroupMemberReturnsOnCall[len(fake.addGroupMemberArgsForCall)]
+	fake.addGroupMemberArgsForCall = append(fake.addGroupMemberArgsForCall, struct {
+		groupID string
+		userID  string
+	}{groupID, userID})
+	fake.recordInvocation("AddGroupMember", []interface{}{groupID, userID})
+	fake.addGroupMemberMutex.Unlock()
+	if fake.AddGroupMemberStub != nil {
+		return fake.AddGroupMemberStub(groupID, userID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.addGroupMemberReturns.result1
+}
+
+func (fake *FakeUAAClient) AddGroupMemberCallCount() int {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return len(fake.addGroupMemberArgsForCall)
+}
+
+func (fake *FakeUAAClient) AddGroupMemberArgsForCall(i int) (string, string) {
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
+	return fake.addGroupMemberArgsForCall[i].groupID, fake.addGroupMemberArgsForCall[i].userID
+}
+
+func (fake *FakeUAAClient) AddGroupMemberReturns(result1 error) {
+	fake.AddGroupMemberStub = nil
+	fake.addGroupMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) AddGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.AddGroupMemberStub = nil
+	if fake.addGroupMemberReturnsOnCall == nil {
+		fake.addGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addGroupMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUAAClient) APIVersion() string {
 	fake.aPIVersionMutex.Lock()
 	ret, specificReturn := fake.aPIVersionReturnsOnCall[len(fake.aPIVersionArgsForCall)]
@@ -233,6 +378,105 @@ func (fake *FakeUAAClient) CreateUserReturnsOnCall(i int, result1 uaa.User, resu
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) DeleteUser(userID string) error {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		userID string
+	}{userID})
+	fake.recordInvocation("DeleteUser", []interface{}{userID})
+	fake.deleteUserMutex.Unlock()
+	if fake.DeleteUserStub != nil {
+		return fake.DeleteUserStub(userID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteUserReturns.result1
+}
+
+func (fake *FakeUAAClient) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeUAAClient) DeleteUserArgsForCall(i int) string {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return fake.deleteUserArgsForCall[i].userID
+}
+
+func (fake *FakeUAAClient) DeleteUserReturns(result1 error) {
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) DeleteUserReturnsOnCall(i int, result1 error) {
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) GetGroups(filter string) ([]uaa.Group, error) {
+	fake.getGroupsMutex.Lock()
+	ret, specificReturn := fake.getGroupsReturnsOnCall[len(fake.getGroupsArgsForCall)]
+	fake.getGroupsArgsForCall = append(fake.getGroupsArgsForCall, struct {
+		filter string
+	}{filter})
+	fake.recordInvocation("GetGroups", []interface{}{filter})
+	fake.getGroupsMutex.Unlock()
+	if fake.GetGroupsStub != nil {
+		return fake.GetGroupsStub(filter)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getGroupsReturns.result1, fake.getGroupsReturns.result2
+}
+
+func (fake *FakeUAAClient) GetGroupsCallCount() int {
+	fake.getGroupsMutex.RLock()
+	defer fake.getGroupsMutex.RUnlock()
+	return len(fake.getGroupsArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetGroupsArgsForCall(i int) string {
+	fake.getGroupsMutex.RLock()
+	defer fake.getGroupsMutex.RUnlock()
+	return fake.getGroupsArgsForCall[i].filter
+}
+
+func (fake *FakeUAAClient) GetGroupsReturns(result1 []uaa.Group, result2 error) {
+	fake.GetGroupsStub = nil
+	fake.getGroupsReturns = struct {
+		result1 []uaa.Group
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetGroupsReturnsOnCall(i int, result1 []uaa.Group, result2 error) {
+	fake.GetGroupsStub = nil
+	if fake.getGroupsReturnsOnCall == nil {
+		fake.getGroupsReturnsOnCall = make(map[int]struct {
+			result1 []uaa.Group
+			result2 error
+		})
+	}
+	fake.getGroupsReturnsOnCall[i] = struct {
+		result1 []uaa.Group
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error) {
 	fake.getSSHPasscodeMutex.Lock()
 	ret, specificReturn := fake.getSSHPasscodeReturnsOnCall[len(fake.getSSHPasscodeArgsForCall)]
@@ -285,6 +529,57 @@ func (fake *FakeUAAClient) GetSSHPasscodeReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) GetUsers(filter string) ([]uaa.User, error) {
+	fake.getUsersMutex.Lock()
+	ret, specificReturn := fake.getUsersReturnsOnCall[len(fake.getUsersArgsForCall)]
+	fake.getUsersArgsForCall = append(fake.getUsersArgsForCall, struct {
+		filter string
+	}{filter})
+	fake.recordInvocation("GetUsers", []interface{}{filter})
+	fake.getUsersMutex.Unlock()
+	if fake.GetUsersStub != nil {
+		return fake.GetUsersStub(filter)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getUsersReturns.result1, fake.getUsersReturns.result2
+}
+
+func (fake *FakeUAAClient) GetUsersCallCount() int {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return len(fake.getUsersArgsForCall)
+}
+
+func (fake *FakeUAAClient) GetUsersArgsForCall(i int) string {
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
+	return fake.getUsersArgsForCall[i].filter
+}
+
+func (fake *FakeUAAClient) GetUsersReturns(result1 []uaa.User, result2 error) {
+	fake.GetUsersStub = nil
+	fake.getUsersReturns = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) GetUsersReturnsOnCall(i int, result1 []uaa.User, result2 error) {
+	fake.GetUsersStub = nil
+	if fake.getUsersReturnsOnCall == nil {
+		fake.getUsersReturnsOnCall = make(map[int]struct {
+			result1 []uaa.User
+			result2 error
+		})
+	}
+	fake.getUsersReturnsOnCall[i] = struct {
+		result1 []uaa.User
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error) {
 	fake.refreshAccessTokenMutex.Lock()
 	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
@@ -336,19 +631,230 @@ func (fake *FakeUAAClient) RefreshAccessTokenReturnsOnCall(i int, result1 uaa.Re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) RemoveGroupMember(groupID string, userID string) error {
+	fake.removeGroupMemberMutex.Lock()
+	ret, specificReturn := fake.removeGroupMemberReturnsOnCall[len(fake.removeGroupMemberArgsForCall)]
+	fake.removeGroupMemberArgsForCall = append(fake.removeGroupMemberArgsForCall, struct {
+		groupID string
+		userID  string
+	}{groupID, userID})
+	fake.recordInvocation("RemoveGroupMember", []interface{}{groupID, userID})
+	fake.removeGroupMemberMutex.Unlock()
+	if fake.RemoveGroupMemberStub != nil {
+		return fake.RemoveGroupMemberStub(groupID, userID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removeGroupMemberReturns.result1
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberCallCount() int {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return len(fake.removeGroupMemberArgsForCall)
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberArgsForCall(i int) (string, string) {
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	return fake.removeGroupMemberArgsForCall[i].groupID, fake.removeGroupMemberArgsForCall[i].userID
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberReturns(result1 error) {
+	fake.RemoveGroupMemberStub = nil
+	fake.removeGroupMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) RemoveGroupMemberReturnsOnCall(i int, result1 error) {
+	fake.RemoveGroupMemberStub = nil
+	if fake.removeGroupMemberReturnsOnCall == nil {
+		fake.removeGroupMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeGroupMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) ResetUserPassword(userID string, password string) error {
+	fake.resetUserPasswordMutex.Lock()
+	ret, specificReturn := fake.resetUserPasswordReturnsOnCall[len(fake.resetUserPasswordArgsForCall)]
+	fake.resetUserPasswordArgsForCall = append(fake.resetUserPasswordArgsForCall, struct {
+		userID   string
+		password string
+	}{userID, password})
+	fake.recordInvocation("ResetUserPassword", []interface{}{userID, password})
+	fake.resetUserPasswordMutex.Unlock()
+	if fake.ResetUserPasswordStub != nil {
+		return fake.ResetUserPasswordStub(userID, password)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resetUserPasswordReturns.result1
+}
+
+func (fake *FakeUAAClient) ResetUserPasswordCallCount() int {
+	fake.resetUserPasswordMutex.RLock()
+	defer fake.resetUserPasswordMutex.RUnlock()
+	return len(fake.resetUserPasswordArgsForCall)
+}
+
+func (fake *FakeUAAClient) ResetUserPasswordArgsForCall(i int) (string, string) {
+	fake.resetUserPasswordMutex.RLock()
+	defer fake.resetUserPasswordMutex.RUnlock()
+	return fake.resetUserPasswordArgsForCall[i].userID, fake.resetUserPasswordArgsForCall[i].password
+}
+
+func (fake *FakeUAAClient) ResetUserPasswordReturns(result1 error) {
+	fake.ResetUserPasswordStub = nil
+	fake.resetUserPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) ResetUserPasswordReturnsOnCall(i int, result1 error) {
+	fake.ResetUserPasswordStub = nil
+	if fake.resetUserPasswordReturnsOnCall == nil {
+		fake.resetUserPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetUserPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) SetUserActive(userID string, active bool) error {
+	fake.setUserActiveMutex.Lock()
+	ret, specificReturn := fake.setUserActiveReturnsOnCall[len(fake.setUserActiveArgsForCall)]
+	fake.setUserActiveArgsForCall = append(fake.setUserActiveArgsForCall, struct {
+		userID string
+		active bool
+	}{userID, active})
+	fake.recordInvocation("SetUserActive", []interface{}{userID, active})
+	fake.setUserActiveMutex.Unlock()
+	if fake.SetUserActiveStub != nil {
+		return fake.SetUserActiveStub(userID, active)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setUserActiveReturns.result1
+}
+
+func (fake *FakeUAAClient) SetUserActiveCallCount() int {
+	fake.setUserActiveMutex.RLock()
+	defer fake.setUserActiveMutex.RUnlock()
+	return len(fake.setUserActiveArgsForCall)
+}
+
+func (fake *FakeUAAClient) SetUserActiveArgsForCall(i int) (string, bool) {
+	fake.setUserActiveMutex.RLock()
+	defer fake.setUserActiveMutex.RUnlock()
+	return fake.setUserActiveArgsForCall[i].userID, fake.setUserActiveArgsForCall[i].active
+}
+
+func (fake *FakeUAAClient) SetUserActiveReturns(result1 error) {
+	fake.SetUserActiveStub = nil
+	fake.setUserActiveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) SetUserActiveReturnsOnCall(i int, result1 error) {
+	fake.SetUserActiveStub = nil
+	if fake.setUserActiveReturnsOnCall == nil {
+		fake.setUserActiveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setUserActiveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) UnlockUser(userID string) error {
+	fake.unlockUserMutex.Lock()
+	ret, specificReturn := fake.unlockUserReturnsOnCall[len(fake.unlockUserArgsForCall)]
+	fake.unlockUserArgsForCall = append(fake.unlockUserArgsForCall, struct {
+		userID string
+	}{userID})
+	fake.recordInvocation("UnlockUser", []interface{}{userID})
+	fake.unlockUserMutex.Unlock()
+	if fake.UnlockUserStub != nil {
+		return fake.UnlockUserStub(userID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.unlockUserReturns.result1
+}
+
+func (fake *FakeUAAClient) UnlockUserCallCount() int {
+	fake.unlockUserMutex.RLock()
+	defer fake.unlockUserMutex.RUnlock()
+	return len(fake.unlockUserArgsForCall)
+}
+
+func (fake *FakeUAAClient) UnlockUserArgsForCall(i int) string {
+	fake.unlockUserMutex.RLock()
+	defer fake.unlockUserMutex.RUnlock()
+	return fake.unlockUserArgsForCall[i].userID
+}
+
+func (fake *FakeUAAClient) UnlockUserReturns(result1 error) {
+	fake.UnlockUserStub = nil
+	fake.unlockUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUAAClient) UnlockUserReturnsOnCall(i int, result1 error) {
+	fake.UnlockUserStub = nil
+	if fake.unlockUserReturnsOnCall == nil {
+		fake.unlockUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unlockUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addGroupMemberMutex.RLock()
+	defer fake.addGroupMemberMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	fake.getGroupsMutex.RLock()
+	defer fake.getGroupsMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
+	fake.getUsersMutex.RLock()
+	defer fake.getUsersMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.removeGroupMemberMutex.RLock()
+	defer fake.removeGroupMemberMutex.RUnlock()
+	fake.resetUserPasswordMutex.RLock()
+	defer fake.resetUserPasswordMutex.RUnlock()
+	fake.setUserActiveMutex.RLock()
+	defer fake.setUserActiveMutex.RUnlock()
+	fake.unlockUserMutex.RLock()
+	defer fake.unlockUserMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
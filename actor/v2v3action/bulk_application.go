@@ -0,0 +1,242 @@
+package v2v3action
+
+import (
+	"path"
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+)
+
+// BulkApplicationFilter selects the applications of a bulk lifecycle
+// operation.
+type BulkApplicationFilter struct {
+	// NamePattern is a shell pattern, as used by path.Match, that the
+	// application names must match. Empty matches every name.
+	NamePattern string
+
+	// LabelSelector is a label selector, such as "env=prod,tier!=web", that
+	// the application labels must match. Empty matches every application.
+	LabelSelector string
+}
+
+// BulkApplication is an application selected for a bulk lifecycle operation.
+type BulkApplication struct {
+	v3action.Application
+	SpaceName string
+}
+
+// BulkApplicationResult is the outcome of a bulk lifecycle operation on a
+// single application.
+type BulkApplicationResult struct {
+	Application BulkApplication
+
+	// Skipped is true when the application was left alone because it was
+	// already, or is meant to stay, in its current state.
+	Skipped bool
+
+	Warnings Warnings
+	Err      error
+}
+
+// GetBulkApplications returns the applications in the space of the
+// organization that match the filter, or in every space of the organization
+// when spaceName is empty. Applications are sorted by space name and then by
+// name.
+func (actor Actor) GetBulkApplications(orgName string, spaceName string, filter BulkApplicationFilter) ([]BulkApplication, Warnings, error) {
+	var allWarnings Warnings
+
+	org, v2Warnings, err := actor.V2Actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, v2Warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var spaces []v2action.Space
+	if spaceName == "" {
+		spaces, v2Warnings, err = actor.V2Actor.GetOrganizationSpaces(org.GUID)
+		allWarnings = append(allWarnings, v2Warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	} else {
+		space, warnings, spaceErr := actor.V2Actor.GetSpaceByOrganizationAndName(org.GUID, spaceName)
+		allWarnings = append(allWarnings, warnings...)
+		if spaceErr != nil {
+			return nil, allWarnings, spaceErr
+		}
+		spaces = []v2action.Space{space}
+	}
+
+	spaceNames := map[string]string{}
+	var spaceGUIDs []string
+	for _, space := range spaces {
+		spaceNames[space.GUID] = space.Name
+		spaceGUIDs = append(spaceGUIDs, space.GUID)
+	}
+
+	apps, v3Warnings, err := actor.V3Actor.GetApplicationsBySpacesAndLabelSelector(spaceGUIDs, filter.LabelSelector)
+	allWarnings = append(allWarnings, v3Warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var bulkApps []BulkApplication
+	for _, app := range apps {
+		if filter.NamePattern != "" {
+			matched, matchErr := path.Match(filter.NamePattern, app.Name)
+			if matchErr != nil {
+				return nil, allWarnings, matchErr
+			}
+			if !matched {
+				continue
+			}
+		}
+
+		bulkApps = append(bulkApps, BulkApplication{
+			Application: app,
+			SpaceName:   spaceNames[app.SpaceGUID],
+		})
+	}
+
+	sort.Slice(bulkApps, func(i, j int) bool {
+		if bulkApps[i].SpaceName != bulkApps[j].SpaceName {
+			return bulkApps[i].SpaceName < bulkApps[j].SpaceName
+		}
+		return bulkApps[i].Name < bulkApps[j].Name
+	})
+
+	return bulkApps, allWarnings, nil
+}
+
+// StopBulkApplications stops the started applications, at most maxInFlight at
+// a time. Stopped applications are skipped. The results are in the order of
+// apps.
+func (actor Actor) StopBulkApplications(apps []BulkApplication, maxInFlight int) []BulkApplicationResult {
+	return runBulkApplicationOperation(apps, maxInFlight, func(app BulkApplication) BulkApplicationResult {
+		result := BulkApplicationResult{Application: app}
+		if !app.Started() {
+			result.Skipped = true
+			return result
+		}
+
+		warnings, err := actor.V3Actor.StopApplication(app.GUID)
+		result.Warnings = append(result.Warnings, warnings...)
+		result.Err = err
+		return result
+	})
+}
+
+// StartBulkApplications starts the stopped applications and waits for them to
+// stage and run, at most maxInFlight at a time. Started applications are
+// skipped. newNOAAClient is called for every application started, since
+// starting an application closes its client. The results are in the order of
+// apps.
+func (actor Actor) StartBulkApplications(apps []BulkApplication, maxInFlight int, newNOAAClient func() v2action.NOAAClient) []BulkApplicationResult {
+	return runBulkApplicationOperation(apps, maxInFlight, func(app BulkApplication) BulkApplicationResult {
+		result := BulkApplicationResult{Application: app}
+		if app.Started() {
+			result.Skipped = true
+			return result
+		}
+
+		result.Warnings, result.Err = actor.startBulkApplication(app.GUID, newNOAAClient())
+		return result
+	})
+}
+
+// RestartBulkApplications stops and then starts the started applications,
+// waiting for them to stage and run, at most maxInFlight at a time. Stopped
+// applications are skipped so that they stay stopped. The results are in the
+// order of apps.
+func (actor Actor) RestartBulkApplications(apps []BulkApplication, maxInFlight int, newNOAAClient func() v2action.NOAAClient) []BulkApplicationResult {
+	return runBulkApplicationOperation(apps, maxInFlight, func(app BulkApplication) BulkApplicationResult {
+		result := BulkApplicationResult{Application: app}
+		if !app.Started() {
+			result.Skipped = true
+			return result
+		}
+
+		warnings, err := actor.V3Actor.StopApplication(app.GUID)
+		result.Warnings = append(result.Warnings, warnings...)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+
+		startWarnings, err := actor.startBulkApplication(app.GUID, newNOAAClient())
+		result.Warnings = append(result.Warnings, startWarnings...)
+		result.Err = err
+		return result
+	})
+}
+
+func (actor Actor) startBulkApplication(appGUID string, client v2action.NOAAClient) (Warnings, error) {
+	var allWarnings Warnings
+
+	app, v2Warnings, err := actor.V2Actor.GetApplication(appGUID)
+	allWarnings = append(allWarnings, v2Warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	// Log messages and state changes are drained but not returned, since
+	// only the outcome of every application is reported.
+	messages, logErrs, appState, apiWarnings, apiErrs := actor.V2Actor.StartApplication(app, client)
+	for appState != nil || apiWarnings != nil || apiErrs != nil {
+		select {
+		case _, ok := <-messages:
+			if !ok {
+				messages = nil
+			}
+		case _, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+			}
+		case _, ok := <-appState:
+			if !ok {
+				appState = nil
+			}
+		case warning, ok := <-apiWarnings:
+			if !ok {
+				apiWarnings = nil
+				continue
+			}
+			allWarnings = append(allWarnings, warning)
+		case startErr, ok := <-apiErrs:
+			if !ok {
+				apiErrs = nil
+				continue
+			}
+			err = startErr
+		}
+	}
+
+	return allWarnings, err
+}
+
+// runBulkApplicationOperation runs the operation on every application, at
+// most maxInFlight at a time, and returns the results in the order of apps.
+func runBulkApplicationOperation(apps []BulkApplication, maxInFlight int, operation func(BulkApplication) BulkApplicationResult) []BulkApplicationResult {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	results := make([]BulkApplicationResult, len(apps))
+	inFlight := make(chan struct{}, maxInFlight)
+	var wg sync.WaitGroup
+
+	for i, app := range apps {
+		wg.Add(1)
+		inFlight <- struct{}{}
+		go func(i int, app BulkApplication) {
+			defer wg.Done()
+			results[i] = operation(app)
+			<-inFlight
+		}(i, app)
+	}
+
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,289 @@
+package v2v3action_test
+
+import (
+	"errors"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	. "code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v2v3action/v2v3actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bulk Application Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *v2v3actionfakes.FakeV2Actor
+		fakeV3Actor *v2v3actionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(v2v3actionfakes.FakeV2Actor)
+		fakeV3Actor = new(v2v3actionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	Describe("GetBulkApplications", func() {
+		var (
+			spaceName  string
+			filter     BulkApplicationFilter
+			apps       []BulkApplication
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			spaceName = ""
+			filter = BulkApplicationFilter{}
+
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "org-guid"}, v2action.Warnings{"get-org-warning"}, nil)
+			fakeV2Actor.GetOrganizationSpacesReturns(
+				[]v2action.Space{{GUID: "space-guid-2", Name: "prod"}, {GUID: "space-guid-1", Name: "dev"}},
+				v2action.Warnings{"get-spaces-warning"},
+				nil,
+			)
+			fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "space-guid-1", Name: "dev"}, v2action.Warnings{"get-space-warning"}, nil)
+			fakeV3Actor.GetApplicationsBySpacesAndLabelSelectorReturns(
+				[]v3action.Application{
+					{GUID: "app-guid-3", Name: "worker", SpaceGUID: "space-guid-1"},
+					{GUID: "app-guid-2", Name: "web", SpaceGUID: "space-guid-2"},
+					{GUID: "app-guid-1", Name: "web", SpaceGUID: "space-guid-1"},
+				},
+				v3action.Warnings{"get-apps-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			apps, warnings, executeErr = actor.GetBulkApplications("some-org", spaceName, filter)
+		})
+
+		When("no space is given", func() {
+			It("returns the applications in every space, sorted by space and name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-spaces-warning", "get-apps-warning"))
+				Expect(apps).To(Equal([]BulkApplication{
+					{Application: v3action.Application{GUID: "app-guid-1", Name: "web", SpaceGUID: "space-guid-1"}, SpaceName: "dev"},
+					{Application: v3action.Application{GUID: "app-guid-3", Name: "worker", SpaceGUID: "space-guid-1"}, SpaceName: "dev"},
+					{Application: v3action.Application{GUID: "app-guid-2", Name: "web", SpaceGUID: "space-guid-2"}, SpaceName: "prod"},
+				}))
+
+				Expect(fakeV2Actor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+				Expect(fakeV2Actor.GetOrganizationSpacesArgsForCall(0)).To(Equal("org-guid"))
+				spaceGUIDs, labelSelector := fakeV3Actor.GetApplicationsBySpacesAndLabelSelectorArgsForCall(0)
+				Expect(spaceGUIDs).To(Equal([]string{"space-guid-2", "space-guid-1"}))
+				Expect(labelSelector).To(BeEmpty())
+			})
+		})
+
+		When("a space is given", func() {
+			BeforeEach(func() {
+				spaceName = "dev"
+			})
+
+			It("only returns the applications in that space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning", "get-apps-warning"))
+
+				orgGUID, name := fakeV2Actor.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("org-guid"))
+				Expect(name).To(Equal("dev"))
+				Expect(fakeV2Actor.GetOrganizationSpacesCallCount()).To(Equal(0))
+				spaceGUIDs, _ := fakeV3Actor.GetApplicationsBySpacesAndLabelSelectorArgsForCall(0)
+				Expect(spaceGUIDs).To(Equal([]string{"space-guid-1"}))
+			})
+		})
+
+		When("the space does not exist", func() {
+			BeforeEach(func() {
+				spaceName = "missing"
+				fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, v2action.Warnings{"get-space-warning"}, actionerror.SpaceNotFoundError{Name: "missing"})
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "missing"}))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning"))
+			})
+		})
+
+		When("filters are given", func() {
+			BeforeEach(func() {
+				filter = BulkApplicationFilter{NamePattern: "w*r", LabelSelector: "env=prod"}
+			})
+
+			It("passes the label selector and matches the name pattern", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(1))
+				Expect(apps[0].GUID).To(Equal("app-guid-3"))
+
+				_, labelSelector := fakeV3Actor.GetApplicationsBySpacesAndLabelSelectorArgsForCall(0)
+				Expect(labelSelector).To(Equal("env=prod"))
+			})
+		})
+
+		When("getting the applications fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get apps failed")
+				fakeV3Actor.GetApplicationsBySpacesAndLabelSelectorReturns(nil, v3action.Warnings{"get-apps-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-spaces-warning", "get-apps-warning"))
+			})
+		})
+	})
+
+	Describe("bulk lifecycle operations", func() {
+		var (
+			apps          []BulkApplication
+			noaaClients   int
+			newNOAAClient func() v2action.NOAAClient
+			stopErr       error
+			startErr      error
+		)
+
+		startChannels := func(warning string, err error) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appState := make(chan v2action.ApplicationStateChange)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				appState <- v2action.ApplicationStateStaging
+				warnings <- warning
+				if err != nil {
+					errs <- err
+				}
+				close(appState)
+				close(warnings)
+				close(errs)
+				close(messages)
+				close(logErrs)
+			}()
+
+			return messages, logErrs, appState, warnings, errs
+		}
+
+		BeforeEach(func() {
+			apps = []BulkApplication{
+				{Application: v3action.Application{GUID: "started-guid", Name: "started", State: constant.ApplicationStarted}, SpaceName: "dev"},
+				{Application: v3action.Application{GUID: "stopped-guid", Name: "stopped", State: constant.ApplicationStopped}, SpaceName: "dev"},
+			}
+
+			var mutex sync.Mutex
+			noaaClients = 0
+			newNOAAClient = func() v2action.NOAAClient {
+				mutex.Lock()
+				defer mutex.Unlock()
+				noaaClients++
+				return new(v2actionfakes.FakeNOAAClient)
+			}
+
+			stopErr = nil
+			startErr = nil
+			fakeV3Actor.StopApplicationStub = func(appGUID string) (v3action.Warnings, error) {
+				return v3action.Warnings{"stop-warning-" + appGUID}, stopErr
+			}
+			fakeV2Actor.GetApplicationStub = func(guid string) (v2action.Application, v2action.Warnings, error) {
+				return v2action.Application{GUID: guid}, v2action.Warnings{"get-app-warning"}, nil
+			}
+			fakeV2Actor.StartApplicationStub = func(app v2action.Application, _ v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+				return startChannels("start-warning-"+app.GUID, startErr)
+			}
+		})
+
+		Describe("StopBulkApplications", func() {
+			It("stops the started applications and skips the stopped ones", func() {
+				results := actor.StopBulkApplications(apps, 2)
+				Expect(results).To(Equal([]BulkApplicationResult{
+					{Application: apps[0], Warnings: Warnings{"stop-warning-started-guid"}},
+					{Application: apps[1], Skipped: true},
+				}))
+				Expect(fakeV3Actor.StopApplicationCallCount()).To(Equal(1))
+			})
+
+			When("stopping fails", func() {
+				BeforeEach(func() {
+					stopErr = errors.New("stop failed")
+				})
+
+				It("returns the error in the result", func() {
+					results := actor.StopBulkApplications(apps, 1)
+					Expect(results[0].Err).To(MatchError(stopErr))
+					Expect(results[0].Warnings).To(ConsistOf("stop-warning-started-guid"))
+				})
+			})
+
+			When("there are more applications than maxInFlight", func() {
+				It("stops every application", func() {
+					var many []BulkApplication
+					for i := 0; i < 10; i++ {
+						many = append(many, apps[0])
+					}
+
+					results := actor.StopBulkApplications(many, 3)
+					Expect(results).To(HaveLen(10))
+					Expect(fakeV3Actor.StopApplicationCallCount()).To(Equal(10))
+				})
+			})
+		})
+
+		Describe("StartBulkApplications", func() {
+			It("starts the stopped applications with a new NOAA client each and skips the started ones", func() {
+				results := actor.StartBulkApplications(apps, 2, newNOAAClient)
+				Expect(results).To(Equal([]BulkApplicationResult{
+					{Application: apps[0], Skipped: true},
+					{Application: apps[1], Warnings: Warnings{"get-app-warning", "start-warning-stopped-guid"}},
+				}))
+				Expect(noaaClients).To(Equal(1))
+				Expect(fakeV2Actor.GetApplicationArgsForCall(0)).To(Equal("stopped-guid"))
+			})
+
+			When("starting fails", func() {
+				BeforeEach(func() {
+					startErr = errors.New("staging failed")
+				})
+
+				It("returns the error in the result", func() {
+					results := actor.StartBulkApplications(apps, 2, newNOAAClient)
+					Expect(results[1].Err).To(MatchError(startErr))
+					Expect(results[1].Warnings).To(ConsistOf("get-app-warning", "start-warning-stopped-guid"))
+				})
+			})
+		})
+
+		Describe("RestartBulkApplications", func() {
+			It("stops and starts the started applications and skips the stopped ones", func() {
+				results := actor.RestartBulkApplications(apps, 2, newNOAAClient)
+				Expect(results).To(Equal([]BulkApplicationResult{
+					{Application: apps[0], Warnings: Warnings{"stop-warning-started-guid", "get-app-warning", "start-warning-started-guid"}},
+					{Application: apps[1], Skipped: true},
+				}))
+				Expect(fakeV3Actor.StopApplicationArgsForCall(0)).To(Equal("started-guid"))
+				Expect(noaaClients).To(Equal(1))
+			})
+
+			When("stopping fails", func() {
+				BeforeEach(func() {
+					stopErr = errors.New("stop failed")
+				})
+
+				It("does not start the application", func() {
+					results := actor.RestartBulkApplications(apps, 2, newNOAAClient)
+					Expect(results[0].Err).To(MatchError(stopErr))
+					Expect(fakeV2Actor.StartApplicationCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,190 @@
+package v2v3action
+
+import (
+	"math"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// CanaryStep is a single stage of a canary release. It describes how many
+// web instances the old and the new application run at that stage.
+type CanaryStep struct {
+	Percent      int
+	OldInstances int
+	NewInstances int
+}
+
+// CanarySteps splits totalInstances between an old and a new application in
+// increments of step percent until percent of the instances belong to the new
+// application. The new application always receives at least one instance and,
+// until 100 percent is reached, the old application keeps at least one.
+func CanarySteps(totalInstances int, percent int, step int) []CanaryStep {
+	if step <= 0 || step > percent {
+		step = percent
+	}
+
+	var steps []CanaryStep
+	for current := step; ; current += step {
+		if current > percent {
+			current = percent
+		}
+
+		newInstances := int(math.Floor(float64(totalInstances*current)/100 + 0.5))
+		if newInstances < 1 {
+			newInstances = 1
+		}
+		oldInstances := totalInstances - newInstances
+		if current < 100 && oldInstances < 1 {
+			oldInstances = 1
+		}
+
+		steps = append(steps, CanaryStep{
+			Percent:      current,
+			OldInstances: oldInstances,
+			NewInstances: newInstances,
+		})
+
+		if current == percent {
+			return steps
+		}
+	}
+}
+
+// MapCanaryRoutes maps every route of the old application to the new
+// application. It returns the routes that were not already mapped to the new
+// application so they can be unmapped if the canary is aborted.
+func (actor Actor) MapCanaryRoutes(oldAppGUID string, newAppGUID string) (v2action.Routes, Warnings, error) {
+	var allWarnings Warnings
+
+	oldRoutes, warnings, err := actor.V2Actor.GetApplicationRoutes(oldAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	newRoutes, warnings, err := actor.V2Actor.GetApplicationRoutes(newAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	alreadyMapped := map[string]bool{}
+	for _, route := range newRoutes {
+		alreadyMapped[route.GUID] = true
+	}
+
+	var mappedRoutes v2action.Routes
+	for _, route := range oldRoutes {
+		if alreadyMapped[route.GUID] {
+			continue
+		}
+
+		warnings, err = actor.V2Actor.MapRouteToApplication(route.GUID, newAppGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return mappedRoutes, allWarnings, err
+		}
+		mappedRoutes = append(mappedRoutes, route)
+	}
+
+	return mappedRoutes, allWarnings, nil
+}
+
+// ScaleCanary scales the web processes of both applications to the instance
+// counts of the provided step. The new application is scaled first so the
+// shared routes never lose capacity.
+func (actor Actor) ScaleCanary(oldAppGUID string, newAppGUID string, step CanaryStep) (Warnings, error) {
+	var allWarnings Warnings
+
+	warnings, err := actor.scaleWebProcess(newAppGUID, step.NewInstances)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.scaleWebProcess(oldAppGUID, step.OldInstances)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+// AbortCanary returns all traffic to the old application by restoring the
+// instance counts both applications had before the canary and unmapping the
+// routes that were mapped to the new application for the canary. Every step
+// is attempted even if an earlier one fails, so that as little traffic as
+// possible is left on the new application; the first error is returned.
+func (actor Actor) AbortCanary(oldAppGUID string, newAppGUID string, oldInstances int, newInstances int, mappedRoutes v2action.Routes) (Warnings, error) {
+	var (
+		allWarnings Warnings
+		firstErr    error
+	)
+
+	warnings, err := actor.scaleWebProcess(oldAppGUID, oldInstances)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil && firstErr == nil {
+		firstErr = err
+	}
+
+	for _, route := range mappedRoutes {
+		v2Warnings, unmapErr := actor.V2Actor.UnmapRouteFromApplication(route.GUID, newAppGUID)
+		allWarnings = append(allWarnings, v2Warnings...)
+		if unmapErr != nil && firstErr == nil {
+			firstErr = unmapErr
+		}
+	}
+
+	warnings, err = actor.scaleWebProcess(newAppGUID, newInstances)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil && firstErr == nil {
+		firstErr = err
+	}
+
+	return allWarnings, firstErr
+}
+
+// CompleteCanary unmaps the routes shared with the new application from the
+// old application once all traffic has been shifted.
+func (actor Actor) CompleteCanary(oldAppGUID string, newAppGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	oldRoutes, warnings, err := actor.V2Actor.GetApplicationRoutes(oldAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	newRoutes, warnings, err := actor.V2Actor.GetApplicationRoutes(newAppGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	shared := map[string]bool{}
+	for _, route := range newRoutes {
+		shared[route.GUID] = true
+	}
+
+	for _, route := range oldRoutes {
+		if !shared[route.GUID] {
+			continue
+		}
+
+		warnings, err = actor.V2Actor.UnmapRouteFromApplication(route.GUID, oldAppGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) scaleWebProcess(appGUID string, instances int) (Warnings, error) {
+	warnings, err := actor.V3Actor.ScaleProcessByApplication(appGUID, v3action.Process{
+		Type:      constant.ProcessTypeWeb,
+		Instances: types.NullInt{Value: instances, IsSet: true},
+	})
+	return Warnings(warnings), err
+}
@@ -0,0 +1,239 @@
+package v2v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v2v3action/v2v3actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Canary Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *v2v3actionfakes.FakeV2Actor
+		fakeV3Actor *v2v3actionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(v2v3actionfakes.FakeV2Actor)
+		fakeV3Actor = new(v2v3actionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	DescribeTable("CanarySteps",
+		func(total int, percent int, step int, expectedSteps []CanaryStep) {
+			Expect(CanarySteps(total, percent, step)).To(Equal(expectedSteps))
+		},
+
+		Entry("single step", 10, 20, 0, []CanaryStep{
+			{Percent: 20, OldInstances: 8, NewInstances: 2},
+		}),
+		Entry("multiple even steps", 10, 30, 10, []CanaryStep{
+			{Percent: 10, OldInstances: 9, NewInstances: 1},
+			{Percent: 20, OldInstances: 8, NewInstances: 2},
+			{Percent: 30, OldInstances: 7, NewInstances: 3},
+		}),
+		Entry("last step is capped at percent", 4, 50, 20, []CanaryStep{
+			{Percent: 20, OldInstances: 3, NewInstances: 1},
+			{Percent: 40, OldInstances: 2, NewInstances: 2},
+			{Percent: 50, OldInstances: 2, NewInstances: 2},
+		}),
+		Entry("small apps keep at least one instance of each app", 1, 10, 10, []CanaryStep{
+			{Percent: 10, OldInstances: 1, NewInstances: 1},
+		}),
+		Entry("full rollout", 2, 100, 50, []CanaryStep{
+			{Percent: 50, OldInstances: 1, NewInstances: 1},
+			{Percent: 100, OldInstances: 0, NewInstances: 2},
+		}),
+	)
+
+	Describe("MapCanaryRoutes", func() {
+		var (
+			mappedRoutes v2action.Routes
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			fakeV2Actor.GetApplicationRoutesStub = func(appGUID string) (v2action.Routes, v2action.Warnings, error) {
+				if appGUID == "old-app-guid" {
+					return v2action.Routes{{GUID: "route-1"}, {GUID: "route-2"}}, v2action.Warnings{"old-routes-warning"}, nil
+				}
+				return v2action.Routes{{GUID: "route-2"}}, v2action.Warnings{"new-routes-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			mappedRoutes, warnings, executeErr = actor.MapCanaryRoutes("old-app-guid", "new-app-guid")
+		})
+
+		When("mapping succeeds", func() {
+			BeforeEach(func() {
+				fakeV2Actor.MapRouteToApplicationReturns(v2action.Warnings{"map-warning"}, nil)
+			})
+
+			It("maps only the routes missing from the new app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("old-routes-warning", "new-routes-warning", "map-warning"))
+				Expect(mappedRoutes).To(Equal(v2action.Routes{{GUID: "route-1"}}))
+
+				Expect(fakeV2Actor.MapRouteToApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID := fakeV2Actor.MapRouteToApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("route-1"))
+				Expect(appGUID).To(Equal("new-app-guid"))
+			})
+		})
+
+		When("mapping fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("map-error")
+				fakeV2Actor.MapRouteToApplicationReturns(v2action.Warnings{"map-warning"}, expectedErr)
+			})
+
+			It("returns the error and the routes mapped so far", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("map-warning"))
+				Expect(mappedRoutes).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("ScaleCanary", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ScaleCanary("old-app-guid", "new-app-guid", CanaryStep{Percent: 25, OldInstances: 3, NewInstances: 1})
+		})
+
+		When("scaling succeeds", func() {
+			BeforeEach(func() {
+				fakeV3Actor.ScaleProcessByApplicationReturns(v3action.Warnings{"scale-warning"}, nil)
+			})
+
+			It("scales the new app before the old app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("scale-warning", "scale-warning"))
+
+				Expect(fakeV3Actor.ScaleProcessByApplicationCallCount()).To(Equal(2))
+				appGUID, process := fakeV3Actor.ScaleProcessByApplicationArgsForCall(0)
+				Expect(appGUID).To(Equal("new-app-guid"))
+				Expect(process.Type).To(Equal("web"))
+				Expect(process.Instances).To(Equal(types.NullInt{Value: 1, IsSet: true}))
+
+				appGUID, process = fakeV3Actor.ScaleProcessByApplicationArgsForCall(1)
+				Expect(appGUID).To(Equal("old-app-guid"))
+				Expect(process.Instances).To(Equal(types.NullInt{Value: 3, IsSet: true}))
+			})
+		})
+
+		When("scaling the new app fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("scale-error")
+				fakeV3Actor.ScaleProcessByApplicationReturns(v3action.Warnings{"scale-warning"}, expectedErr)
+			})
+
+			It("does not scale the old app", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("scale-warning"))
+				Expect(fakeV3Actor.ScaleProcessByApplicationCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("AbortCanary", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.AbortCanary("old-app-guid", "new-app-guid", 4, 1, v2action.Routes{{GUID: "route-1"}})
+		})
+
+		BeforeEach(func() {
+			fakeV3Actor.ScaleProcessByApplicationReturns(v3action.Warnings{"scale-warning"}, nil)
+			fakeV2Actor.UnmapRouteFromApplicationReturns(v2action.Warnings{"unmap-warning"}, nil)
+		})
+
+		It("restores the old app, unmaps the canary routes and restores the new app's instances", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("scale-warning", "unmap-warning", "scale-warning"))
+
+			Expect(fakeV3Actor.ScaleProcessByApplicationCallCount()).To(Equal(2))
+			appGUID, process := fakeV3Actor.ScaleProcessByApplicationArgsForCall(0)
+			Expect(appGUID).To(Equal("old-app-guid"))
+			Expect(process.Instances).To(Equal(types.NullInt{Value: 4, IsSet: true}))
+
+			Expect(fakeV2Actor.UnmapRouteFromApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID := fakeV2Actor.UnmapRouteFromApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-1"))
+			Expect(appGUID).To(Equal("new-app-guid"))
+
+			appGUID, process = fakeV3Actor.ScaleProcessByApplicationArgsForCall(1)
+			Expect(appGUID).To(Equal("new-app-guid"))
+			Expect(process.Instances).To(Equal(types.NullInt{Value: 1, IsSet: true}))
+		})
+
+		When("restoring the old app fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.ScaleProcessByApplicationReturnsOnCall(0, v3action.Warnings{"scale-old-warning"}, errors.New("scale-old-error"))
+				fakeV2Actor.UnmapRouteFromApplicationReturns(v2action.Warnings{"unmap-warning"}, errors.New("unmap-error"))
+			})
+
+			It("still unmaps the canary routes and scales the new app, and returns the first error with all warnings", func() {
+				Expect(executeErr).To(MatchError("scale-old-error"))
+				Expect(warnings).To(ConsistOf("scale-old-warning", "unmap-warning", "scale-warning"))
+
+				Expect(fakeV2Actor.UnmapRouteFromApplicationCallCount()).To(Equal(1))
+				Expect(fakeV3Actor.ScaleProcessByApplicationCallCount()).To(Equal(2))
+				appGUID, _ := fakeV3Actor.ScaleProcessByApplicationArgsForCall(1)
+				Expect(appGUID).To(Equal("new-app-guid"))
+			})
+		})
+	})
+
+	Describe("CompleteCanary", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeV2Actor.GetApplicationRoutesStub = func(appGUID string) (v2action.Routes, v2action.Warnings, error) {
+				if appGUID == "old-app-guid" {
+					return v2action.Routes{{GUID: "route-1"}, {GUID: "route-old-only"}}, v2action.Warnings{"old-routes-warning"}, nil
+				}
+				return v2action.Routes{{GUID: "route-1"}}, v2action.Warnings{"new-routes-warning"}, nil
+			}
+			fakeV2Actor.UnmapRouteFromApplicationReturns(v2action.Warnings{"unmap-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.CompleteCanary("old-app-guid", "new-app-guid")
+		})
+
+		It("unmaps the shared routes from the old app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("old-routes-warning", "new-routes-warning", "unmap-warning"))
+
+			Expect(fakeV2Actor.UnmapRouteFromApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID := fakeV2Actor.UnmapRouteFromApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-1"))
+			Expect(appGUID).To(Equal("old-app-guid"))
+		})
+	})
+})
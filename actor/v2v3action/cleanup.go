@@ -0,0 +1,284 @@
+package v2v3action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
+
+// CleanupResourceType is the kind of an unused resource.
+type CleanupResourceType string
+
+const (
+	CleanupRoute           CleanupResourceType = "route"
+	CleanupServiceInstance CleanupResourceType = "service-instance"
+	CleanupServiceKey      CleanupResourceType = "service-key"
+	CleanupApplication     CleanupResourceType = "app"
+	CleanupDroplet         CleanupResourceType = "droplet"
+	CleanupPackage         CleanupResourceType = "package"
+)
+
+// CleanupSettings control which resources are considered unused.
+type CleanupSettings struct {
+	// Types limits the search to the given resource types. All types except
+	// service keys are searched when it is empty; service keys are only
+	// searched when requested, because a key created before StaleBefore may
+	// still be in use.
+	Types []CleanupResourceType
+
+	// StaleBefore is the time before which stopped applications must have
+	// last been updated, and service keys must have been created, to be
+	// considered unused.
+	StaleBefore time.Time
+
+	// Retain is the number of most recent droplets and packages kept for every
+	// application.
+	Retain int
+}
+
+func (settings CleanupSettings) includes(resourceType CleanupResourceType) bool {
+	if len(settings.Types) == 0 {
+		return resourceType != CleanupServiceKey
+	}
+
+	for _, t := range settings.Types {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// CleanupResource is an unused resource that can be deleted.
+type CleanupResource struct {
+	Type CleanupResourceType
+	GUID string
+	Name string
+
+	// Owner is the name of the application or service instance the resource
+	// belongs to, if any.
+	Owner string
+
+	// Date is the time the resource was created or, for applications, last
+	// updated. It is zero for routes.
+	Date time.Time
+
+	userProvided bool
+}
+
+// GetCleanupResources returns the unused resources in the space: orphaned
+// routes, stale service keys, service instances without bindings or remaining
+// keys, stale stopped applications and droplets and packages beyond the
+// retained ones.
+func (actor Actor) GetCleanupResources(spaceGUID string, settings CleanupSettings) ([]CleanupResource, Warnings, error) {
+	var (
+		allWarnings Warnings
+		resources   []CleanupResource
+	)
+
+	if settings.includes(CleanupRoute) {
+		routes, warnings, err := actor.V2Actor.GetOrphanedRoutesBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(actionerror.OrphanedRoutesNotFoundError); err != nil && !ok {
+			return nil, allWarnings, err
+		}
+
+		for _, route := range routes {
+			resources = append(resources, CleanupResource{
+				Type: CleanupRoute,
+				GUID: route.GUID,
+				Name: route.String(),
+			})
+		}
+	}
+
+	// Service keys are listed, and so deleted, before service instances
+	// because the Cloud Controller does not delete an instance with keys.
+	var keys []v2action.ServiceKey
+	if settings.includes(CleanupServiceKey) || settings.includes(CleanupServiceInstance) {
+		var (
+			warnings v2action.Warnings
+			err      error
+		)
+		keys, warnings, err = actor.V2Actor.GetServiceKeysBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	staleKeys := map[string]bool{}
+	if settings.includes(CleanupServiceKey) {
+		for _, key := range keys {
+			if !key.CreatedAt.Before(settings.StaleBefore) {
+				continue
+			}
+
+			staleKeys[key.GUID] = true
+			resources = append(resources, CleanupResource{
+				Type:  CleanupServiceKey,
+				GUID:  key.GUID,
+				Name:  key.Name,
+				Owner: key.ServiceInstanceName,
+				Date:  key.CreatedAt,
+			})
+		}
+	}
+
+	if settings.includes(CleanupServiceInstance) {
+		// An instance with keys that are not deleted is still in use.
+		instancesWithKeys := map[string]bool{}
+		for _, key := range keys {
+			if !staleKeys[key.GUID] {
+				instancesWithKeys[key.ServiceInstanceGUID] = true
+			}
+		}
+
+		instances, warnings, err := actor.V2Actor.GetUnboundServiceInstancesBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, instance := range instances {
+			if instancesWithKeys[instance.GUID] {
+				continue
+			}
+
+			resources = append(resources, CleanupResource{
+				Type:         CleanupServiceInstance,
+				GUID:         instance.GUID,
+				Name:         instance.Name,
+				userProvided: instance.IsUserProvided(),
+			})
+		}
+	}
+
+	staleApps := map[string]bool{}
+	if settings.includes(CleanupApplication) {
+		apps, warnings, err := actor.V2Actor.GetStoppedApplicationsUpdatedBeforeBySpace(spaceGUID, settings.StaleBefore)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, app := range apps {
+			staleApps[app.GUID] = true
+			resources = append(resources, CleanupResource{
+				Type: CleanupApplication,
+				GUID: app.GUID,
+				Name: app.Name,
+				Date: app.UpdatedAt,
+			})
+		}
+	}
+
+	if settings.includes(CleanupDroplet) || settings.includes(CleanupPackage) {
+		apps, warnings, err := actor.V2Actor.GetApplicationsBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, app := range apps {
+			// Deleting an application deletes its droplets and packages.
+			if staleApps[app.GUID] {
+				continue
+			}
+
+			appResources, appWarnings, appErr := actor.getExpiredBits(app, settings)
+			allWarnings = append(allWarnings, appWarnings...)
+			if appErr != nil {
+				return nil, allWarnings, appErr
+			}
+			resources = append(resources, appResources...)
+		}
+	}
+
+	return resources, allWarnings, nil
+}
+
+// DeleteCleanupResource deletes the provided unused resource.
+func (actor Actor) DeleteCleanupResource(resource CleanupResource) (Warnings, error) {
+	switch resource.Type {
+	case CleanupRoute:
+		warnings, err := actor.V2Actor.DeleteRoute(resource.GUID)
+		return Warnings(warnings), err
+	case CleanupServiceInstance:
+		instance := v2action.ServiceInstance{GUID: resource.GUID, Name: resource.Name}
+		if resource.userProvided {
+			instance.Type = constant.ServiceInstanceTypeUserProvidedService
+		}
+		warnings, err := actor.V2Actor.DeleteServiceInstance(instance)
+		return Warnings(warnings), err
+	case CleanupServiceKey:
+		warnings, err := actor.V2Actor.DeleteServiceKey(resource.GUID)
+		return Warnings(warnings), err
+	case CleanupApplication:
+		warnings, err := actor.V3Actor.DeleteApplication(resource.GUID)
+		return Warnings(warnings), err
+	case CleanupDroplet:
+		warnings, err := actor.V3Actor.DeleteDroplet(resource.GUID)
+		return Warnings(warnings), err
+	case CleanupPackage:
+		warnings, err := actor.V3Actor.DeletePackage(resource.GUID)
+		return Warnings(warnings), err
+	default:
+		return nil, nil
+	}
+}
+
+func (actor Actor) getExpiredBits(app v2action.Application, settings CleanupSettings) ([]CleanupResource, Warnings, error) {
+	var (
+		allWarnings Warnings
+		resources   []CleanupResource
+	)
+
+	if settings.includes(CleanupDroplet) {
+		droplets, warnings, err := actor.V3Actor.GetExpiredDropletsByApplication(app.GUID, settings.Retain)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, droplet := range droplets {
+			resources = append(resources, CleanupResource{
+				Type:  CleanupDroplet,
+				GUID:  droplet.GUID,
+				Name:  droplet.GUID,
+				Owner: app.Name,
+				Date:  parseCreatedAt(droplet.CreatedAt),
+			})
+		}
+	}
+
+	if settings.includes(CleanupPackage) {
+		packages, warnings, err := actor.V3Actor.GetExpiredPackagesByApplication(app.GUID, settings.Retain)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, pkg := range packages {
+			resources = append(resources, CleanupResource{
+				Type:  CleanupPackage,
+				GUID:  pkg.GUID,
+				Name:  pkg.GUID,
+				Owner: app.Name,
+				Date:  parseCreatedAt(pkg.CreatedAt),
+			})
+		}
+	}
+
+	return resources, allWarnings, nil
+}
+
+func parseCreatedAt(createdAt string) time.Time {
+	date, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
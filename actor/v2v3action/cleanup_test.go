@@ -0,0 +1,363 @@
+package v2v3action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v2v3action/v2v3actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cleanup Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *v2v3actionfakes.FakeV2Actor
+		fakeV3Actor *v2v3actionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(v2v3actionfakes.FakeV2Actor)
+		fakeV3Actor = new(v2v3actionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	Describe("GetCleanupResources", func() {
+		var (
+			settings    CleanupSettings
+			staleBefore time.Time
+			resources   []CleanupResource
+			warnings    Warnings
+			executeErr  error
+		)
+
+		BeforeEach(func() {
+			staleBefore = time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+			settings = CleanupSettings{
+				Types:       []CleanupResourceType{CleanupRoute, CleanupServiceInstance, CleanupServiceKey, CleanupApplication, CleanupDroplet, CleanupPackage},
+				StaleBefore: staleBefore,
+				Retain:      2,
+			}
+
+			fakeV2Actor.GetOrphanedRoutesBySpaceReturns(
+				[]v2action.Route{{GUID: "route-guid", Host: "some-host", Domain: v2action.Domain{Name: "example.com"}}},
+				v2action.Warnings{"routes-warning"}, nil)
+			fakeV2Actor.GetUnboundServiceInstancesBySpaceReturns(
+				[]v2action.ServiceInstance{{GUID: "instance-guid", Name: "some-instance"}},
+				v2action.Warnings{"instances-warning"}, nil)
+			fakeV2Actor.GetServiceKeysBySpaceReturns(
+				[]v2action.ServiceKey{{
+					ServiceKey:          ccv2.ServiceKey{GUID: "key-guid", Name: "some-key", ServiceInstanceGUID: "instance-guid", CreatedAt: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+					ServiceInstanceName: "some-instance",
+				}},
+				v2action.Warnings{"keys-warning"}, nil)
+			fakeV2Actor.GetStoppedApplicationsUpdatedBeforeBySpaceReturns(
+				[]v2action.Application{{GUID: "stale-app-guid", Name: "stale-app", UpdatedAt: time.Date(2017, 2, 1, 0, 0, 0, 0, time.UTC)}},
+				v2action.Warnings{"stopped-apps-warning"}, nil)
+			fakeV2Actor.GetApplicationsBySpaceReturns(
+				[]v2action.Application{{GUID: "stale-app-guid", Name: "stale-app"}, {GUID: "app-guid", Name: "some-app"}},
+				v2action.Warnings{"apps-warning"}, nil)
+			fakeV3Actor.GetExpiredDropletsByApplicationReturns(
+				[]v3action.Droplet{{GUID: "droplet-guid", CreatedAt: "2017-03-01T00:00:00Z"}},
+				v3action.Warnings{"droplets-warning"}, nil)
+			fakeV3Actor.GetExpiredPackagesByApplicationReturns(
+				[]v3action.Package{{GUID: "package-guid", CreatedAt: "2017-04-01T00:00:00Z"}},
+				v3action.Warnings{"packages-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			resources, warnings, executeErr = actor.GetCleanupResources("space-guid", settings)
+		})
+
+		It("returns every unused resource in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("routes-warning", "keys-warning", "instances-warning", "stopped-apps-warning", "apps-warning", "droplets-warning", "packages-warning"))
+			Expect(resources).To(HaveLen(6))
+
+			Expect(resources[0].Type).To(Equal(CleanupRoute))
+			Expect(resources[0].GUID).To(Equal("route-guid"))
+			Expect(resources[0].Name).To(Equal("some-host.example.com"))
+
+			Expect(resources[1]).To(Equal(CleanupResource{
+				Type:  CleanupServiceKey,
+				GUID:  "key-guid",
+				Name:  "some-key",
+				Owner: "some-instance",
+				Date:  time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+			}))
+
+			Expect(resources[2].Type).To(Equal(CleanupServiceInstance))
+			Expect(resources[2].Name).To(Equal("some-instance"))
+			Expect(resources[3]).To(Equal(CleanupResource{
+				Type: CleanupApplication,
+				GUID: "stale-app-guid",
+				Name: "stale-app",
+				Date: time.Date(2017, 2, 1, 0, 0, 0, 0, time.UTC),
+			}))
+			Expect(resources[4]).To(Equal(CleanupResource{
+				Type:  CleanupDroplet,
+				GUID:  "droplet-guid",
+				Name:  "droplet-guid",
+				Owner: "some-app",
+				Date:  time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC),
+			}))
+			Expect(resources[5]).To(Equal(CleanupResource{
+				Type:  CleanupPackage,
+				GUID:  "package-guid",
+				Name:  "package-guid",
+				Owner: "some-app",
+				Date:  time.Date(2017, 4, 1, 0, 0, 0, 0, time.UTC),
+			}))
+
+			Expect(fakeV2Actor.GetServiceKeysBySpaceCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.GetServiceKeysBySpaceArgsForCall(0)).To(Equal("space-guid"))
+
+			Expect(fakeV3Actor.GetExpiredDropletsByApplicationCallCount()).To(Equal(1))
+			appGUID, keep := fakeV3Actor.GetExpiredDropletsByApplicationArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(keep).To(Equal(2))
+		})
+
+		When("there are no orphaned routes", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrphanedRoutesBySpaceReturns(nil, v2action.Warnings{"routes-warning"}, actionerror.OrphanedRoutesNotFoundError{})
+			})
+
+			It("continues with the other resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(HaveLen(5))
+				Expect(resources[0].Type).To(Equal(CleanupServiceKey))
+			})
+		})
+
+		When("a service instance has a stale key", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetServiceKeysBySpaceReturns(
+					[]v2action.ServiceKey{{
+						ServiceKey:          ccv2.ServiceKey{GUID: "key-guid", Name: "some-key", ServiceInstanceGUID: "instance-guid", CreatedAt: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+						ServiceInstanceName: "some-instance",
+					}},
+					nil, nil)
+				settings.Types = []CleanupResourceType{CleanupServiceInstance, CleanupServiceKey}
+			})
+
+			It("lists the key before the instance so the key is deleted first", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(HaveLen(2))
+				Expect(resources[0].Type).To(Equal(CleanupServiceKey))
+				Expect(resources[0].GUID).To(Equal("key-guid"))
+				Expect(resources[1].Type).To(Equal(CleanupServiceInstance))
+				Expect(resources[1].GUID).To(Equal("instance-guid"))
+			})
+
+			When("service keys are not cleaned up", func() {
+				BeforeEach(func() {
+					settings.Types = []CleanupResourceType{CleanupServiceInstance}
+				})
+
+				It("skips the instance because it still has a key", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(resources).To(BeEmpty())
+				})
+			})
+		})
+
+		When("a service instance has a key that is not stale", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetServiceKeysBySpaceReturns(
+					[]v2action.ServiceKey{{
+						ServiceKey: ccv2.ServiceKey{GUID: "key-guid", Name: "some-key", ServiceInstanceGUID: "instance-guid", CreatedAt: staleBefore.Add(time.Hour)},
+					}},
+					nil, nil)
+				settings.Types = []CleanupResourceType{CleanupServiceInstance, CleanupServiceKey}
+			})
+
+			It("returns neither the key nor the instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(BeEmpty())
+			})
+		})
+
+		When("no types are provided", func() {
+			BeforeEach(func() {
+				settings.Types = nil
+			})
+
+			It("does not return service keys, nor the service instances that still have keys", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(HaveLen(4))
+				for _, resource := range resources {
+					Expect(resource.Type).ToNot(Equal(CleanupServiceKey))
+					Expect(resource.Type).ToNot(Equal(CleanupServiceInstance))
+				}
+				Expect(fakeV2Actor.GetUnboundServiceInstancesBySpaceCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the types are limited", func() {
+			BeforeEach(func() {
+				settings.Types = []CleanupResourceType{CleanupServiceKey, CleanupPackage}
+			})
+
+			It("only searches for the provided types", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(resources).To(HaveLen(3))
+				Expect(resources[0].Type).To(Equal(CleanupServiceKey))
+				Expect(resources[1].Type).To(Equal(CleanupPackage))
+				Expect(resources[1].Owner).To(Equal("stale-app"))
+				Expect(resources[2].Type).To(Equal(CleanupPackage))
+				Expect(resources[2].Owner).To(Equal("some-app"))
+
+				Expect(fakeV2Actor.GetOrphanedRoutesBySpaceCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.GetUnboundServiceInstancesBySpaceCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.GetStoppedApplicationsUpdatedBeforeBySpaceCallCount()).To(Equal(0))
+				Expect(fakeV3Actor.GetExpiredDropletsByApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the unbound service instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("instances-error")
+				fakeV2Actor.GetUnboundServiceInstancesBySpaceReturns(nil, v2action.Warnings{"instances-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("routes-warning", "keys-warning", "instances-warning"))
+			})
+		})
+
+		When("getting the service keys fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("keys-error")
+				fakeV2Actor.GetServiceKeysBySpaceReturns(nil, v2action.Warnings{"keys-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("routes-warning", "keys-warning"))
+				Expect(fakeV2Actor.GetUnboundServiceInstancesBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the expired droplets fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("droplets-error")
+				fakeV3Actor.GetExpiredDropletsByApplicationReturns(nil, v3action.Warnings{"droplets-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("droplets-warning"))
+				Expect(fakeV3Actor.GetExpiredPackagesByApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteCleanupResource", func() {
+		var (
+			resource   CleanupResource
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteCleanupResource(resource)
+		})
+
+		When("the resource is a route", func() {
+			BeforeEach(func() {
+				resource = CleanupResource{Type: CleanupRoute, GUID: "route-guid"}
+				fakeV2Actor.DeleteRouteReturns(v2action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeV2Actor.DeleteRouteCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteRouteArgsForCall(0)).To(Equal("route-guid"))
+			})
+		})
+
+		When("the resource is a user provided service instance", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetUnboundServiceInstancesBySpaceReturns(
+					[]v2action.ServiceInstance{{GUID: "instance-guid", Name: "some-instance", Type: constant.ServiceInstanceTypeUserProvidedService}},
+					nil, nil)
+				resources, _, err := actor.GetCleanupResources("space-guid", CleanupSettings{Types: []CleanupResourceType{CleanupServiceInstance}})
+				Expect(err).ToNot(HaveOccurred())
+				resource = resources[0]
+
+				fakeV2Actor.DeleteServiceInstanceReturns(v2action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("deletes the service instance as user provided", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeV2Actor.DeleteServiceInstanceCallCount()).To(Equal(1))
+				instance := fakeV2Actor.DeleteServiceInstanceArgsForCall(0)
+				Expect(instance.GUID).To(Equal("instance-guid"))
+				Expect(instance.IsUserProvided()).To(BeTrue())
+			})
+		})
+
+		When("the resource is a service key", func() {
+			BeforeEach(func() {
+				resource = CleanupResource{Type: CleanupServiceKey, GUID: "key-guid"}
+			})
+
+			It("deletes the service key", func() {
+				Expect(fakeV2Actor.DeleteServiceKeyCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteServiceKeyArgsForCall(0)).To(Equal("key-guid"))
+			})
+		})
+
+		When("the resource is an application", func() {
+			BeforeEach(func() {
+				resource = CleanupResource{Type: CleanupApplication, GUID: "app-guid"}
+				fakeV3Actor.DeleteApplicationReturns(v3action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-warning"))
+				Expect(fakeV3Actor.DeleteApplicationArgsForCall(0)).To(Equal("app-guid"))
+			})
+		})
+
+		When("the resource is a droplet", func() {
+			BeforeEach(func() {
+				resource = CleanupResource{Type: CleanupDroplet, GUID: "droplet-guid"}
+			})
+
+			It("deletes the droplet", func() {
+				Expect(fakeV3Actor.DeleteDropletCallCount()).To(Equal(1))
+				Expect(fakeV3Actor.DeleteDropletArgsForCall(0)).To(Equal("droplet-guid"))
+			})
+		})
+
+		When("the resource is a package", func() {
+			BeforeEach(func() {
+				resource = CleanupResource{Type: CleanupPackage, GUID: "package-guid"}
+			})
+
+			It("deletes the package", func() {
+				Expect(fakeV3Actor.DeletePackageCallCount()).To(Equal(1))
+				Expect(fakeV3Actor.DeletePackageArgsForCall(0)).To(Equal("package-guid"))
+			})
+		})
+	})
+})
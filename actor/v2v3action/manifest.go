@@ -1,6 +1,8 @@
 package v2v3action
 
 import (
+	"sort"
+
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/versioncheck"
@@ -45,6 +47,54 @@ func (actor *Actor) CreateApplicationManifestByNameAndSpace(appName string, appS
 	return manifestApp, allWarnings, err
 }
 
+// CreateApplicationManifestsBySpace returns the manifest applications for
+// every app in the space, sorted by name.
+func (actor *Actor) CreateApplicationManifestsBySpace(spaceGUID string) ([]manifest.Application, Warnings, error) {
+	var allWarnings Warnings
+
+	apps, v2Warnings, err := actor.V2Actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, v2Warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sort.Slice(apps, func(i int, j int) bool { return apps[i].Name < apps[j].Name })
+
+	var manifestApps []manifest.Application
+	for _, app := range apps {
+		manifestApp, warnings, err := actor.CreateApplicationManifestByNameAndSpace(app.Name, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		manifestApps = append(manifestApps, manifestApp)
+	}
+
+	return manifestApps, allWarnings, nil
+}
+
 func (Actor) WriteApplicationManifest(manifestApp manifest.Application, manifestPath string) error {
 	return manifest.WriteApplicationManifest(manifestApp, manifestPath)
 }
+
+func (Actor) WriteApplicationManifests(manifestApps []manifest.Application, manifestPath string) error {
+	return manifest.WriteApplicationManifests(manifestApps, manifestPath)
+}
+
+// WriteParameterizedApplicationManifests writes the manifest applications
+// with their instance counts, and the domains of the organization used by
+// their routes, replaced by variables whose values are written to
+// varsFilePath.
+func (actor *Actor) WriteParameterizedApplicationManifests(manifestApps []manifest.Application, orgGUID string, manifestPath string, varsFilePath string) (Warnings, error) {
+	domains, warnings, err := actor.V2Actor.GetOrganizationDomains(orgGUID)
+	if err != nil {
+		return Warnings(warnings), err
+	}
+
+	var domainNames []string
+	for _, domain := range domains {
+		domainNames = append(domainNames, domain.Name)
+	}
+
+	return Warnings(warnings), manifest.WriteParameterizedApplicationManifests(manifestApps, domainNames, manifestPath, varsFilePath)
+}
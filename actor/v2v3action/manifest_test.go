@@ -2,6 +2,9 @@ package v2v3action_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -143,3 +146,140 @@ var _ = Describe("Manifest", func() {
 		})
 	})
 })
+
+var _ = Describe("Space Manifest", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *v2v3actionfakes.FakeV2Actor
+		fakeV3Actor *v2v3actionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(v2v3actionfakes.FakeV2Actor)
+		fakeV3Actor = new(v2v3actionfakes.FakeV3Actor)
+		fakeV3Actor.CloudControllerAPIVersionReturns(ccversion.MinV3ClientVersion)
+
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	Describe("CreateApplicationManifestsBySpace", func() {
+		var (
+			manifestApps []manifest.Application
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			manifestApps, warnings, executeErr = actor.CreateApplicationManifestsBySpace("some-space-guid")
+		})
+
+		When("getting the apps succeeds", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationsBySpaceReturns(
+					[]v2action.Application{{Name: "worker"}, {Name: "api"}},
+					v2action.Warnings{"apps-warning"},
+					nil)
+				fakeV2Actor.CreateApplicationManifestByNameAndSpaceStub = func(appName string, _ string) (manifest.Application, v2action.Warnings, error) {
+					return manifest.Application{Name: appName}, v2action.Warnings{appName + "-warning"}, nil
+				}
+			})
+
+			It("returns the manifest applications sorted by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("apps-warning", "api-warning", "worker-warning"))
+				Expect(manifestApps).To(Equal([]manifest.Application{{Name: "api"}, {Name: "worker"}}))
+
+				Expect(fakeV2Actor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				_, spaceGUID := fakeV2Actor.CreateApplicationManifestByNameAndSpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+
+			When("creating a manifest application fails", func() {
+				BeforeEach(func() {
+					fakeV2Actor.CreateApplicationManifestByNameAndSpaceStub = nil
+					fakeV2Actor.CreateApplicationManifestByNameAndSpaceReturns(manifest.Application{}, v2action.Warnings{"manifest-warning"}, errors.New("manifest error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("manifest error"))
+					Expect(warnings).To(ConsistOf("apps-warning", "manifest-warning"))
+				})
+			})
+		})
+
+		When("getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"apps-warning"}, errors.New("apps error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("apps error"))
+				Expect(warnings).To(ConsistOf("apps-warning"))
+			})
+		})
+	})
+
+	Describe("WriteParameterizedApplicationManifests", func() {
+		var (
+			tmpDir       string
+			manifestPath string
+			varsFilePath string
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "space-manifest")
+			Expect(err).ToNot(HaveOccurred())
+			manifestPath = filepath.Join(tmpDir, "manifest.yml")
+			varsFilePath = filepath.Join(tmpDir, "vars.yml")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.WriteParameterizedApplicationManifests(
+				[]manifest.Application{{Name: "api", Routes: []string{"api.example.com"}}},
+				"some-org-guid",
+				manifestPath,
+				varsFilePath,
+			)
+		})
+
+		When("getting the organization domains succeeds", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{{Name: "example.com"}}, v2action.Warnings{"domains-warning"}, nil)
+			})
+
+			It("parameterizes the routes on the organization domains", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("domains-warning"))
+				Expect(fakeV2Actor.GetOrganizationDomainsArgsForCall(0)).To(Equal("some-org-guid"))
+
+				manifestBytes, err := ioutil.ReadFile(manifestPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(manifestBytes)).To(ContainSubstring("route: api.((domain-1))"))
+
+				varsBytes, err := ioutil.ReadFile(varsFilePath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(varsBytes)).To(Equal("domain-1: example.com\n"))
+			})
+		})
+
+		When("getting the organization domains fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationDomainsReturns(nil, v2action.Warnings{"domains-warning"}, errors.New("domains error"))
+			})
+
+			It("returns the error and warnings without writing the manifest", func() {
+				Expect(executeErr).To(MatchError("domains error"))
+				Expect(warnings).To(ConsistOf("domains-warning"))
+				_, err := os.Stat(manifestPath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,329 @@
+package v2v3action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgfile"
+)
+
+// OrgChangeType is the kind of change needed to converge an organization to
+// an organization file.
+type OrgChangeType string
+
+const (
+	OrgChangeCreateOrg                  OrgChangeType = "create-org"
+	OrgChangeSetOrgQuota                OrgChangeType = "set-org-quota"
+	OrgChangeEntitleIsolationSegment    OrgChangeType = "entitle-isolation-segment"
+	OrgChangeSetDefaultIsolationSegment OrgChangeType = "set-default-isolation-segment"
+	OrgChangeCreatePrivateDomain        OrgChangeType = "create-private-domain"
+	OrgChangeAddOrgRole                 OrgChangeType = "add-org-role"
+	OrgChangeCreateSpaceQuota           OrgChangeType = "create-space-quota"
+	OrgChangeUpdateSpaceQuota           OrgChangeType = "update-space-quota"
+	OrgChangeCreateSpace                OrgChangeType = "create-space"
+	OrgChangeSetSpaceQuota              OrgChangeType = "set-space-quota"
+	OrgChangeSetSpaceIsolationSegment   OrgChangeType = "set-space-isolation-segment"
+	OrgChangeAddSpaceRole               OrgChangeType = "add-space-role"
+	OrgChangeBindSecurityGroup          OrgChangeType = "bind-security-group"
+)
+
+// OrgChange is a single change needed to converge an organization to an
+// organization file.
+type OrgChange struct {
+	Type OrgChangeType
+
+	// Space is the name of the space the change applies to. It is empty for
+	// organization level changes.
+	Space string
+
+	// Name is the name of the quota, isolation segment, domain, user or
+	// security group the change is about.
+	Name string
+
+	// Role is the role added by add-org-role and add-space-role changes.
+	Role constant.UserRole
+
+	// Lifecycle is the lifecycle a bind-security-group change binds to.
+	Lifecycle constant.SecurityGroupLifecycle
+
+	// SpaceQuota holds the limits of create-space-quota and update-space-quota
+	// changes.
+	SpaceQuota orgfile.SpaceQuota
+}
+
+// GetOrgChanges returns the changes needed to converge the organization to
+// the organization file, in the order they have to be applied. Only missing
+// resources, roles and bindings are added and differing assignments are
+// updated; nothing is ever deleted.
+func (actor Actor) GetOrgChanges(desired orgfile.Org) ([]OrgChange, Warnings, error) {
+	var changes []OrgChange
+
+	current, warnings, err := actor.ExportOrganization(desired.Name)
+	if _, ok := err.(actionerror.OrganizationNotFoundError); ok {
+		changes = append(changes, OrgChange{Type: OrgChangeCreateOrg, Name: desired.Name})
+		current = orgfile.Org{Name: desired.Name}
+	} else if err != nil {
+		return nil, warnings, err
+	}
+
+	if desired.Quota != "" && desired.Quota != current.Quota {
+		changes = append(changes, OrgChange{Type: OrgChangeSetOrgQuota, Name: desired.Quota})
+	}
+
+	for _, name := range missing(desired.IsolationSegments, current.IsolationSegments) {
+		changes = append(changes, OrgChange{Type: OrgChangeEntitleIsolationSegment, Name: name})
+	}
+	if desired.DefaultIsolationSegment != "" && desired.DefaultIsolationSegment != current.DefaultIsolationSegment {
+		if !contains(desired.IsolationSegments, desired.DefaultIsolationSegment) && !contains(current.IsolationSegments, desired.DefaultIsolationSegment) {
+			changes = append(changes, OrgChange{Type: OrgChangeEntitleIsolationSegment, Name: desired.DefaultIsolationSegment})
+		}
+		changes = append(changes, OrgChange{Type: OrgChangeSetDefaultIsolationSegment, Name: desired.DefaultIsolationSegment})
+	}
+
+	for _, name := range missing(desired.PrivateDomains, current.PrivateDomains) {
+		changes = append(changes, OrgChange{Type: OrgChangeCreatePrivateDomain, Name: name})
+	}
+
+	// Space roles can only be given to users of the organization.
+	desiredUsers := desired
+	desiredUsers.Users = append([]string{}, desired.Users...)
+	for _, space := range desired.Spaces {
+		for _, role := range spaceRoles {
+			desiredUsers.Users = append(desiredUsers.Users, *role.users(&space)...)
+		}
+	}
+	for _, role := range orgRoles {
+		for _, name := range missing(*role.users(&desiredUsers), *role.users(&current)) {
+			changes = append(changes, OrgChange{Type: OrgChangeAddOrgRole, Name: name, Role: role.role})
+		}
+	}
+
+	currentSpaceQuotas := map[string]orgfile.SpaceQuota{}
+	for _, spaceQuota := range current.SpaceQuotas {
+		currentSpaceQuotas[spaceQuota.Name] = spaceQuota
+	}
+	for _, spaceQuota := range desired.SpaceQuotas {
+		currentSpaceQuota, exists := currentSpaceQuotas[spaceQuota.Name]
+		switch {
+		case !exists:
+			changes = append(changes, OrgChange{Type: OrgChangeCreateSpaceQuota, Name: spaceQuota.Name, SpaceQuota: spaceQuota})
+		case currentSpaceQuota != spaceQuota:
+			changes = append(changes, OrgChange{Type: OrgChangeUpdateSpaceQuota, Name: spaceQuota.Name, SpaceQuota: spaceQuota})
+		}
+	}
+
+	currentSpaces := map[string]orgfile.Space{}
+	for _, space := range current.Spaces {
+		currentSpaces[space.Name] = space
+	}
+	for _, space := range desired.Spaces {
+		currentSpace, exists := currentSpaces[space.Name]
+		if !exists {
+			changes = append(changes, OrgChange{Type: OrgChangeCreateSpace, Space: space.Name, Name: space.Name})
+		}
+		changes = append(changes, spaceChanges(space, currentSpace)...)
+	}
+
+	return changes, warnings, nil
+}
+
+func spaceChanges(desired orgfile.Space, current orgfile.Space) []OrgChange {
+	var changes []OrgChange
+
+	if desired.Quota != "" && desired.Quota != current.Quota {
+		changes = append(changes, OrgChange{Type: OrgChangeSetSpaceQuota, Space: desired.Name, Name: desired.Quota})
+	}
+
+	if desired.IsolationSegment != "" && desired.IsolationSegment != current.IsolationSegment {
+		changes = append(changes, OrgChange{Type: OrgChangeSetSpaceIsolationSegment, Space: desired.Name, Name: desired.IsolationSegment})
+	}
+
+	for _, role := range spaceRoles {
+		for _, name := range missing(*role.users(&desired), *role.users(&current)) {
+			changes = append(changes, OrgChange{Type: OrgChangeAddSpaceRole, Space: desired.Name, Name: name, Role: role.role})
+		}
+	}
+
+	for _, name := range missing(desired.RunningSecurityGroups, current.RunningSecurityGroups) {
+		changes = append(changes, OrgChange{Type: OrgChangeBindSecurityGroup, Space: desired.Name, Name: name, Lifecycle: constant.SecurityGroupLifecycleRunning})
+	}
+	for _, name := range missing(desired.StagingSecurityGroups, current.StagingSecurityGroups) {
+		changes = append(changes, OrgChange{Type: OrgChangeBindSecurityGroup, Space: desired.Name, Name: name, Lifecycle: constant.SecurityGroupLifecycleStaging})
+	}
+
+	return changes
+}
+
+// ApplyOrgChange makes the change to the organization. Changes have to be
+// applied in the order returned by GetOrgChanges, since later changes look up
+// the resources created by earlier ones by name.
+func (actor Actor) ApplyOrgChange(orgName string, change OrgChange) (Warnings, error) {
+	switch change.Type {
+	case OrgChangeCreateOrg:
+		_, warnings, err := actor.V2Actor.CreateOrganization(orgName, "")
+		return Warnings(warnings), err
+	case OrgChangeEntitleIsolationSegment:
+		warnings, err := actor.V3Actor.EntitleIsolationSegmentToOrganizationByName(change.Name, orgName)
+		return Warnings(warnings), err
+	case OrgChangeSetDefaultIsolationSegment:
+		return actor.setDefaultIsolationSegment(orgName, change.Name)
+	}
+
+	org, v2Warnings, err := actor.V2Actor.GetOrganizationByName(orgName)
+	allWarnings := Warnings(v2Warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var warnings Warnings
+	switch change.Type {
+	case OrgChangeSetOrgQuota:
+		warnings, err = actor.setOrgQuota(org.GUID, change.Name)
+	case OrgChangeCreatePrivateDomain:
+		_, v2Warnings, err = actor.V2Actor.CreatePrivateDomain(change.Name, org.GUID)
+		warnings = Warnings(v2Warnings)
+	case OrgChangeAddOrgRole:
+		v2Warnings, err = actor.V2Actor.AddOrganizationUserRole(change.Role, org.GUID, change.Name)
+		warnings = Warnings(v2Warnings)
+	case OrgChangeCreateSpaceQuota:
+		spaceQuota := toSpaceQuota(change.SpaceQuota)
+		spaceQuota.OrganizationGUID = org.GUID
+		_, v2Warnings, err = actor.V2Actor.CreateSpaceQuota(spaceQuota)
+		warnings = Warnings(v2Warnings)
+	case OrgChangeUpdateSpaceQuota:
+		warnings, err = actor.updateSpaceQuota(org.GUID, change.SpaceQuota)
+	case OrgChangeCreateSpace:
+		_, v2Warnings, err = actor.V2Actor.CreateSpace(change.Space, org.GUID)
+		warnings = Warnings(v2Warnings)
+	default:
+		warnings, err = actor.applySpaceChange(org.GUID, change)
+	}
+
+	return append(allWarnings, warnings...), err
+}
+
+func (actor Actor) applySpaceChange(orgGUID string, change OrgChange) (Warnings, error) {
+	space, v2Warnings, err := actor.V2Actor.GetSpaceByOrganizationAndName(orgGUID, change.Space)
+	allWarnings := Warnings(v2Warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	switch change.Type {
+	case OrgChangeSetSpaceQuota:
+		spaceQuota, warnings, quotaErr := actor.getSpaceQuotaByName(orgGUID, change.Name)
+		allWarnings = append(allWarnings, warnings...)
+		if quotaErr != nil {
+			return allWarnings, quotaErr
+		}
+		v2Warnings, err = actor.V2Actor.SetSpaceQuota(space.GUID, spaceQuota.GUID)
+	case OrgChangeSetSpaceIsolationSegment:
+		v3Warnings, v3Err := actor.V3Actor.AssignIsolationSegmentToSpaceByNameAndSpace(change.Name, space.GUID)
+		return append(allWarnings, v3Warnings...), v3Err
+	case OrgChangeAddSpaceRole:
+		v2Warnings, err = actor.V2Actor.AddSpaceUserRole(change.Role, space.GUID, change.Name)
+	case OrgChangeBindSecurityGroup:
+		securityGroup, warnings, groupErr := actor.V2Actor.GetSecurityGroupByName(change.Name)
+		allWarnings = append(allWarnings, warnings...)
+		if groupErr != nil {
+			return allWarnings, groupErr
+		}
+		v2Warnings, err = actor.V2Actor.BindSecurityGroupToSpace(securityGroup.GUID, space.GUID, change.Lifecycle)
+	}
+
+	return append(allWarnings, v2Warnings...), err
+}
+
+func (actor Actor) setDefaultIsolationSegment(orgName string, isolationSegmentName string) (Warnings, error) {
+	org, v3Warnings, err := actor.V3Actor.GetOrganizationByName(orgName)
+	allWarnings := Warnings(v3Warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	isolationSegment, v3Warnings, err := actor.V3Actor.GetIsolationSegmentByName(isolationSegmentName)
+	allWarnings = append(allWarnings, v3Warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	v3Warnings, err = actor.V3Actor.SetOrganizationDefaultIsolationSegment(org.GUID, isolationSegment.GUID)
+	return append(allWarnings, v3Warnings...), err
+}
+
+func (actor Actor) setOrgQuota(orgGUID string, quotaName string) (Warnings, error) {
+	quota, v2Warnings, err := actor.V2Actor.GetOrganizationQuotaByName(quotaName)
+	allWarnings := Warnings(v2Warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	v2Warnings, err = actor.V2Actor.SetOrganizationQuota(orgGUID, quota.GUID)
+	return append(allWarnings, v2Warnings...), err
+}
+
+func (actor Actor) updateSpaceQuota(orgGUID string, limits orgfile.SpaceQuota) (Warnings, error) {
+	currentQuota, allWarnings, err := actor.getSpaceQuotaByName(orgGUID, limits.Name)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	spaceQuota := toSpaceQuota(limits)
+	spaceQuota.GUID = currentQuota.GUID
+	_, v2Warnings, err := actor.V2Actor.UpdateSpaceQuota(spaceQuota)
+	return append(allWarnings, v2Warnings...), err
+}
+
+func (actor Actor) getSpaceQuotaByName(orgGUID string, name string) (v2action.SpaceQuota, Warnings, error) {
+	spaceQuotas, warnings, err := actor.V2Actor.GetOrganizationSpaceQuotas(orgGUID)
+	if err != nil {
+		return v2action.SpaceQuota{}, Warnings(warnings), err
+	}
+
+	for _, spaceQuota := range spaceQuotas {
+		if spaceQuota.Name == name {
+			return spaceQuota, Warnings(warnings), nil
+		}
+	}
+	return v2action.SpaceQuota{}, Warnings(warnings), actionerror.SpaceQuotaNotFoundForNameError{Name: name}
+}
+
+func toSpaceQuota(spaceQuota orgfile.SpaceQuota) v2action.SpaceQuota {
+	return v2action.SpaceQuota{
+		Name:                    spaceQuota.Name,
+		MemoryLimit:             spaceQuota.MemoryLimit,
+		InstanceMemoryLimit:     spaceQuota.InstanceMemoryLimit,
+		TotalRoutes:             spaceQuota.TotalRoutes,
+		TotalServices:           spaceQuota.TotalServices,
+		AppInstanceLimit:        spaceQuota.AppInstanceLimit,
+		TotalReservedRoutePorts: spaceQuota.TotalReservedRoutePorts,
+		NonBasicServicesAllowed: spaceQuota.NonBasicServicesAllowed,
+	}
+}
+
+// missing returns the names in desired that are not in current, without
+// duplicates.
+func missing(desired []string, current []string) []string {
+	seen := map[string]bool{}
+	for _, name := range current {
+		seen[name] = true
+	}
+
+	var names []string
+	for _, name := range desired {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
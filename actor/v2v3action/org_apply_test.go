@@ -0,0 +1,319 @@
+package v2v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v2v3action/v2v3actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgfile"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Org Apply Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *v2v3actionfakes.FakeV2Actor
+		fakeV3Actor *v2v3actionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(v2v3actionfakes.FakeV2Actor)
+		fakeV3Actor = new(v2v3actionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	Describe("GetOrgChanges", func() {
+		var (
+			desired    orgfile.Org
+			changes    []OrgChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			desired = orgfile.Org{
+				Name:                    "some-org",
+				Quota:                   "default",
+				IsolationSegments:       []string{"iso-1"},
+				DefaultIsolationSegment: "iso-2",
+				PrivateDomains:          []string{"private.com"},
+				Managers:                []string{"alice"},
+				SpaceQuotas: []orgfile.SpaceQuota{
+					{Name: "small", MemoryLimit: 1024},
+				},
+				Spaces: []orgfile.Space{
+					{
+						Name:                  "dev",
+						Quota:                 "small",
+						IsolationSegment:      "iso-1",
+						RunningSecurityGroups: []string{"public"},
+						StagingSecurityGroups: []string{"dns"},
+						Developers:            []string{"bob"},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetOrgChanges(desired)
+		})
+
+		When("the organization does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"get-org-warning"}, actionerror.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns every change in the order they have to be applied", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(changes).To(Equal([]OrgChange{
+					{Type: OrgChangeCreateOrg, Name: "some-org"},
+					{Type: OrgChangeSetOrgQuota, Name: "default"},
+					{Type: OrgChangeEntitleIsolationSegment, Name: "iso-1"},
+					{Type: OrgChangeEntitleIsolationSegment, Name: "iso-2"},
+					{Type: OrgChangeSetDefaultIsolationSegment, Name: "iso-2"},
+					{Type: OrgChangeCreatePrivateDomain, Name: "private.com"},
+					{Type: OrgChangeAddOrgRole, Name: "bob", Role: constant.OrgUser},
+					{Type: OrgChangeAddOrgRole, Name: "alice", Role: constant.OrgManager},
+					{Type: OrgChangeCreateSpaceQuota, Name: "small", SpaceQuota: orgfile.SpaceQuota{Name: "small", MemoryLimit: 1024}},
+					{Type: OrgChangeCreateSpace, Space: "dev", Name: "dev"},
+					{Type: OrgChangeSetSpaceQuota, Space: "dev", Name: "small"},
+					{Type: OrgChangeSetSpaceIsolationSegment, Space: "dev", Name: "iso-1"},
+					{Type: OrgChangeAddSpaceRole, Space: "dev", Name: "bob", Role: constant.SpaceDeveloper},
+					{Type: OrgChangeBindSecurityGroup, Space: "dev", Name: "public", Lifecycle: constant.SecurityGroupLifecycleRunning},
+					{Type: OrgChangeBindSecurityGroup, Space: "dev", Name: "dns", Lifecycle: constant.SecurityGroupLifecycleStaging},
+				}))
+			})
+		})
+
+		When("the organization partially matches the file", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "quota-guid", DefaultIsolationSegmentGUID: "iso-guid-2"}, nil, nil)
+				fakeV2Actor.GetOrganizationQuotaReturns(v2action.OrganizationQuota{Name: "default"}, nil, nil)
+				fakeV3Actor.GetIsolationSegmentsByOrganizationReturns([]v3action.IsolationSegment{{GUID: "iso-guid-1", Name: "iso-1"}, {GUID: "iso-guid-2", Name: "iso-2"}}, nil, nil)
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{{Name: "private.com", Type: constant.PrivateDomain}}, nil, nil)
+				fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.UserRole, _ string) ([]v2action.User, v2action.Warnings, error) {
+					if role == constant.OrgManager {
+						return []v2action.User{{Username: "alice"}}, nil, nil
+					}
+					return []v2action.User{{Username: "alice"}, {Username: "bob"}}, nil, nil
+				}
+				fakeV2Actor.GetOrganizationSpaceQuotasReturns([]v2action.SpaceQuota{{GUID: "space-quota-guid", Name: "small", MemoryLimit: 512}}, v2action.Warnings{"get-space-quotas-warning"}, nil)
+				fakeV2Actor.GetOrganizationSpacesReturns([]v2action.Space{{GUID: "space-guid", Name: "dev", SpaceQuotaDefinitionGUID: "space-quota-guid"}}, nil, nil)
+				fakeV3Actor.GetEffectiveIsolationSegmentBySpaceReturns(v3action.IsolationSegment{Name: "iso-1"}, nil, nil)
+				fakeV2Actor.GetSpaceRunningSecurityGroupsBySpaceReturns([]v2action.SecurityGroup{{Name: "public"}}, nil, nil)
+			})
+
+			It("only returns the differences", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-space-quotas-warning"))
+				Expect(changes).To(Equal([]OrgChange{
+					{Type: OrgChangeUpdateSpaceQuota, Name: "small", SpaceQuota: orgfile.SpaceQuota{Name: "small", MemoryLimit: 1024}},
+					{Type: OrgChangeAddSpaceRole, Space: "dev", Name: "bob", Role: constant.SpaceDeveloper},
+					{Type: OrgChangeBindSecurityGroup, Space: "dev", Name: "dns", Lifecycle: constant.SecurityGroupLifecycleStaging},
+				}))
+			})
+		})
+
+		When("exporting the organization fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get org failed")
+				fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"get-org-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+			})
+		})
+	})
+
+	Describe("ApplyOrgChange", func() {
+		var (
+			change     OrgChange
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{GUID: "some-org-guid"}, v2action.Warnings{"get-org-warning"}, nil)
+			fakeV2Actor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "some-space-guid"}, v2action.Warnings{"get-space-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplyOrgChange("some-org", change)
+		})
+
+		When("creating the organization", func() {
+			BeforeEach(func() {
+				change = OrgChange{Type: OrgChangeCreateOrg, Name: "some-org"}
+				fakeV2Actor.CreateOrganizationReturns(v2action.Organization{}, v2action.Warnings{"create-org-warning"}, nil)
+			})
+
+			It("creates the organization without a quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("create-org-warning"))
+				orgName, quotaName := fakeV2Actor.CreateOrganizationArgsForCall(0)
+				Expect(orgName).To(Equal("some-org"))
+				Expect(quotaName).To(BeEmpty())
+			})
+		})
+
+		When("setting the organization quota", func() {
+			BeforeEach(func() {
+				change = OrgChange{Type: OrgChangeSetOrgQuota, Name: "default"}
+				fakeV2Actor.GetOrganizationQuotaByNameReturns(v2action.OrganizationQuota{GUID: "quota-guid"}, v2action.Warnings{"get-quota-warning"}, nil)
+				fakeV2Actor.SetOrganizationQuotaReturns(v2action.Warnings{"set-quota-warning"}, nil)
+			})
+
+			It("assigns the quota found by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-quota-warning", "set-quota-warning"))
+				Expect(fakeV2Actor.GetOrganizationQuotaByNameArgsForCall(0)).To(Equal("default"))
+				orgGUID, quotaGUID := fakeV2Actor.SetOrganizationQuotaArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(quotaGUID).To(Equal("quota-guid"))
+			})
+		})
+
+		When("setting the default isolation segment", func() {
+			BeforeEach(func() {
+				change = OrgChange{Type: OrgChangeSetDefaultIsolationSegment, Name: "iso-1"}
+				fakeV3Actor.GetOrganizationByNameReturns(v3action.Organization{GUID: "some-org-guid"}, v3action.Warnings{"get-v3-org-warning"}, nil)
+				fakeV3Actor.GetIsolationSegmentByNameReturns(v3action.IsolationSegment{GUID: "iso-guid"}, v3action.Warnings{"get-iso-warning"}, nil)
+				fakeV3Actor.SetOrganizationDefaultIsolationSegmentReturns(v3action.Warnings{"set-iso-warning"}, nil)
+			})
+
+			It("sets the isolation segment found by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-v3-org-warning", "get-iso-warning", "set-iso-warning"))
+				orgGUID, isoGUID := fakeV3Actor.SetOrganizationDefaultIsolationSegmentArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(isoGUID).To(Equal("iso-guid"))
+			})
+		})
+
+		When("creating a space quota", func() {
+			BeforeEach(func() {
+				change = OrgChange{Type: OrgChangeCreateSpaceQuota, Name: "small", SpaceQuota: orgfile.SpaceQuota{Name: "small", MemoryLimit: 1024, TotalRoutes: -1, TotalReservedRoutePorts: 2}}
+				fakeV2Actor.CreateSpaceQuotaReturns(v2action.SpaceQuota{}, v2action.Warnings{"create-quota-warning"}, nil)
+			})
+
+			It("creates the space quota in the organization", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "create-quota-warning"))
+				Expect(fakeV2Actor.CreateSpaceQuotaArgsForCall(0)).To(Equal(v2action.SpaceQuota{
+					Name:                    "small",
+					OrganizationGUID:        "some-org-guid",
+					MemoryLimit:             1024,
+					TotalRoutes:             -1,
+					TotalReservedRoutePorts: 2,
+				}))
+			})
+		})
+
+		When("updating a space quota", func() {
+			BeforeEach(func() {
+				change = OrgChange{Type: OrgChangeUpdateSpaceQuota, Name: "small", SpaceQuota: orgfile.SpaceQuota{Name: "small", MemoryLimit: 2048}}
+				fakeV2Actor.GetOrganizationSpaceQuotasReturns([]v2action.SpaceQuota{{GUID: "other-guid", Name: "large"}, {GUID: "small-guid", Name: "small"}}, v2action.Warnings{"get-quotas-warning"}, nil)
+				fakeV2Actor.UpdateSpaceQuotaReturns(v2action.SpaceQuota{}, v2action.Warnings{"update-quota-warning"}, nil)
+			})
+
+			It("updates the limits of the space quota found by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-quotas-warning", "update-quota-warning"))
+				Expect(fakeV2Actor.GetOrganizationSpaceQuotasArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeV2Actor.UpdateSpaceQuotaArgsForCall(0)).To(Equal(v2action.SpaceQuota{
+					GUID:        "small-guid",
+					Name:        "small",
+					MemoryLimit: 2048,
+				}))
+			})
+		})
+
+		When("setting the quota of a space", func() {
+			BeforeEach(func() {
+				change = OrgChange{Type: OrgChangeSetSpaceQuota, Space: "dev", Name: "small"}
+			})
+
+			When("the space quota exists", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetOrganizationSpaceQuotasReturns([]v2action.SpaceQuota{{GUID: "small-guid", Name: "small"}}, nil, nil)
+					fakeV2Actor.SetSpaceQuotaReturns(v2action.Warnings{"set-space-quota-warning"}, nil)
+				})
+
+				It("assigns the space quota to the space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning", "set-space-quota-warning"))
+					orgGUID, spaceName := fakeV2Actor.GetSpaceByOrganizationAndNameArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceName).To(Equal("dev"))
+					spaceGUID, quotaGUID := fakeV2Actor.SetSpaceQuotaArgsForCall(0)
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(quotaGUID).To(Equal("small-guid"))
+				})
+			})
+
+			When("the space quota does not exist", func() {
+				It("returns a SpaceQuotaNotFoundForNameError", func() {
+					Expect(executeErr).To(MatchError(actionerror.SpaceQuotaNotFoundForNameError{Name: "small"}))
+					Expect(fakeV2Actor.SetSpaceQuotaCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("binding a security group", func() {
+			BeforeEach(func() {
+				change = OrgChange{Type: OrgChangeBindSecurityGroup, Space: "dev", Name: "dns", Lifecycle: constant.SecurityGroupLifecycleStaging}
+				fakeV2Actor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{GUID: "dns-guid"}, v2action.Warnings{"get-group-warning"}, nil)
+				fakeV2Actor.BindSecurityGroupToSpaceReturns(v2action.Warnings{"bind-warning"}, nil)
+			})
+
+			It("binds the security group for the lifecycle", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning", "get-group-warning", "bind-warning"))
+				groupGUID, spaceGUID, lifecycle := fakeV2Actor.BindSecurityGroupToSpaceArgsForCall(0)
+				Expect(groupGUID).To(Equal("dns-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(lifecycle).To(Equal(constant.SecurityGroupLifecycleStaging))
+			})
+		})
+
+		When("adding a space role", func() {
+			BeforeEach(func() {
+				change = OrgChange{Type: OrgChangeAddSpaceRole, Space: "dev", Name: "bob", Role: constant.SpaceAuditor}
+				fakeV2Actor.AddSpaceUserRoleReturns(v2action.Warnings{"add-role-warning"}, errors.New("add role failed"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("add role failed"))
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-space-warning", "add-role-warning"))
+				role, spaceGUID, username := fakeV2Actor.AddSpaceUserRoleArgsForCall(0)
+				Expect(role).To(Equal(constant.SpaceAuditor))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(username).To(Equal("bob"))
+			})
+		})
+
+		When("the organization cannot be found", func() {
+			BeforeEach(func() {
+				change = OrgChange{Type: OrgChangeCreatePrivateDomain, Name: "private.com"}
+				fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"get-org-warning"}, actionerror.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+				Expect(fakeV2Actor.CreatePrivateDomainCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,203 @@
+package v2v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgfile"
+)
+
+// ExportOrganization returns the structure of the organization: its quota,
+// isolation segments, private domains, user roles, space quotas and spaces.
+// Name lists are sorted so that exports of the same organization can be
+// compared.
+func (actor Actor) ExportOrganization(orgName string) (orgfile.Org, Warnings, error) {
+	var allWarnings Warnings
+
+	org, v2Warnings, err := actor.V2Actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, v2Warnings...)
+	if err != nil {
+		return orgfile.Org{}, allWarnings, err
+	}
+
+	exported := orgfile.Org{Name: org.Name}
+
+	if org.QuotaDefinitionGUID != "" {
+		quota, warnings, quotaErr := actor.V2Actor.GetOrganizationQuota(org.QuotaDefinitionGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if quotaErr != nil {
+			return orgfile.Org{}, allWarnings, quotaErr
+		}
+		exported.Quota = quota.Name
+	}
+
+	isolationSegments, v3Warnings, err := actor.V3Actor.GetIsolationSegmentsByOrganization(org.GUID)
+	allWarnings = append(allWarnings, v3Warnings...)
+	if err != nil {
+		return orgfile.Org{}, allWarnings, err
+	}
+	for _, isolationSegment := range isolationSegments {
+		exported.IsolationSegments = append(exported.IsolationSegments, isolationSegment.Name)
+		if isolationSegment.GUID == org.DefaultIsolationSegmentGUID {
+			exported.DefaultIsolationSegment = isolationSegment.Name
+		}
+	}
+	sort.Strings(exported.IsolationSegments)
+
+	domains, v2Warnings, err := actor.V2Actor.GetOrganizationDomains(org.GUID)
+	allWarnings = append(allWarnings, v2Warnings...)
+	if err != nil {
+		return orgfile.Org{}, allWarnings, err
+	}
+	for _, domain := range domains {
+		if domain.IsPrivate() {
+			exported.PrivateDomains = append(exported.PrivateDomains, domain.Name)
+		}
+	}
+	sort.Strings(exported.PrivateDomains)
+
+	for _, role := range orgRoles {
+		users, warnings, roleErr := actor.V2Actor.GetOrganizationUsersByRole(role.role, org.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if roleErr != nil {
+			return orgfile.Org{}, allWarnings, roleErr
+		}
+		*role.users(&exported) = usernames(users)
+	}
+
+	spaceQuotas, v2Warnings, err := actor.V2Actor.GetOrganizationSpaceQuotas(org.GUID)
+	allWarnings = append(allWarnings, v2Warnings...)
+	if err != nil {
+		return orgfile.Org{}, allWarnings, err
+	}
+	spaceQuotaNames := map[string]string{}
+	for _, spaceQuota := range spaceQuotas {
+		spaceQuotaNames[spaceQuota.GUID] = spaceQuota.Name
+		exported.SpaceQuotas = append(exported.SpaceQuotas, orgfile.SpaceQuota{
+			Name:                    spaceQuota.Name,
+			MemoryLimit:             spaceQuota.MemoryLimit,
+			InstanceMemoryLimit:     spaceQuota.InstanceMemoryLimit,
+			TotalRoutes:             spaceQuota.TotalRoutes,
+			TotalServices:           spaceQuota.TotalServices,
+			AppInstanceLimit:        spaceQuota.AppInstanceLimit,
+			TotalReservedRoutePorts: spaceQuota.TotalReservedRoutePorts,
+			NonBasicServicesAllowed: spaceQuota.NonBasicServicesAllowed,
+		})
+	}
+	sort.Slice(exported.SpaceQuotas, func(i, j int) bool {
+		return exported.SpaceQuotas[i].Name < exported.SpaceQuotas[j].Name
+	})
+
+	spaces, v2Warnings, err := actor.V2Actor.GetOrganizationSpaces(org.GUID)
+	allWarnings = append(allWarnings, v2Warnings...)
+	if err != nil {
+		return orgfile.Org{}, allWarnings, err
+	}
+	for _, space := range spaces {
+		exportedSpace, warnings, spaceErr := actor.exportSpace(space, spaceQuotaNames)
+		allWarnings = append(allWarnings, warnings...)
+		if spaceErr != nil {
+			return orgfile.Org{}, allWarnings, spaceErr
+		}
+		exported.Spaces = append(exported.Spaces, exportedSpace)
+	}
+	sort.Slice(exported.Spaces, func(i, j int) bool {
+		return exported.Spaces[i].Name < exported.Spaces[j].Name
+	})
+
+	return exported, allWarnings, nil
+}
+
+func (actor Actor) exportSpace(space v2action.Space, spaceQuotaNames map[string]string) (orgfile.Space, Warnings, error) {
+	var allWarnings Warnings
+
+	exported := orgfile.Space{
+		Name:  space.Name,
+		Quota: spaceQuotaNames[space.SpaceQuotaDefinitionGUID],
+	}
+
+	// The organization's default is not passed in so that only an isolation
+	// segment assigned to the space itself is exported.
+	isolationSegment, v3Warnings, err := actor.V3Actor.GetEffectiveIsolationSegmentBySpace(space.GUID, "")
+	allWarnings = append(allWarnings, v3Warnings...)
+	if _, ok := err.(actionerror.NoRelationshipError); err != nil && !ok {
+		return orgfile.Space{}, allWarnings, err
+	}
+	exported.IsolationSegment = isolationSegment.Name
+
+	runningGroups, v2Warnings, err := actor.V2Actor.GetSpaceRunningSecurityGroupsBySpace(space.GUID)
+	allWarnings = append(allWarnings, v2Warnings...)
+	if err != nil {
+		return orgfile.Space{}, allWarnings, err
+	}
+	exported.RunningSecurityGroups = securityGroupNames(runningGroups)
+
+	stagingGroups, v2Warnings, err := actor.V2Actor.GetSpaceStagingSecurityGroupsBySpace(space.GUID)
+	allWarnings = append(allWarnings, v2Warnings...)
+	if err != nil {
+		return orgfile.Space{}, allWarnings, err
+	}
+	exported.StagingSecurityGroups = securityGroupNames(stagingGroups)
+
+	for _, role := range spaceRoles {
+		users, warnings, roleErr := actor.V2Actor.GetSpaceUsersByRole(role.role, space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if roleErr != nil {
+			return orgfile.Space{}, allWarnings, roleErr
+		}
+		*role.users(&exported) = usernames(users)
+	}
+
+	return exported, allWarnings, nil
+}
+
+// orgRole ties an organization role to the list of usernames holding it in
+// an organization file.
+type orgRole struct {
+	role  constant.UserRole
+	users func(*orgfile.Org) *[]string
+}
+
+var orgRoles = []orgRole{
+	{constant.OrgUser, func(org *orgfile.Org) *[]string { return &org.Users }},
+	{constant.OrgManager, func(org *orgfile.Org) *[]string { return &org.Managers }},
+	{constant.OrgBillingManager, func(org *orgfile.Org) *[]string { return &org.BillingManagers }},
+	{constant.OrgAuditor, func(org *orgfile.Org) *[]string { return &org.Auditors }},
+}
+
+// spaceRole ties a space role to the list of usernames holding it in an
+// organization file.
+type spaceRole struct {
+	role  constant.UserRole
+	users func(*orgfile.Space) *[]string
+}
+
+var spaceRoles = []spaceRole{
+	{constant.SpaceManager, func(space *orgfile.Space) *[]string { return &space.Managers }},
+	{constant.SpaceDeveloper, func(space *orgfile.Space) *[]string { return &space.Developers }},
+	{constant.SpaceAuditor, func(space *orgfile.Space) *[]string { return &space.Auditors }},
+}
+
+// usernames returns the sorted usernames of the users. Users without a
+// username, such as UAA clients, are skipped.
+func usernames(users []v2action.User) []string {
+	var names []string
+	for _, user := range users {
+		if user.Username != "" {
+			names = append(names, user.Username)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func securityGroupNames(securityGroups []v2action.SecurityGroup) []string {
+	var names []string
+	for _, securityGroup := range securityGroups {
+		names = append(names, securityGroup.Name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,171 @@
+package v2v3action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v2v3action/v2v3actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/util/orgfile"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Org Export Actions", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *v2v3actionfakes.FakeV2Actor
+		fakeV3Actor *v2v3actionfakes.FakeV3Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(v2v3actionfakes.FakeV2Actor)
+		fakeV3Actor = new(v2v3actionfakes.FakeV3Actor)
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	Describe("ExportOrganization", func() {
+		var (
+			org        orgfile.Org
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			org, warnings, executeErr = actor.ExportOrganization("some-org")
+		})
+
+		When("the organization exists", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationByNameReturns(
+					v2action.Organization{
+						GUID:                        "some-org-guid",
+						Name:                        "some-org",
+						QuotaDefinitionGUID:         "some-quota-guid",
+						DefaultIsolationSegmentGUID: "iso-guid-2",
+					},
+					v2action.Warnings{"get-org-warning"},
+					nil,
+				)
+				fakeV2Actor.GetOrganizationQuotaReturns(v2action.OrganizationQuota{Name: "default"}, v2action.Warnings{"get-quota-warning"}, nil)
+				fakeV3Actor.GetIsolationSegmentsByOrganizationReturns(
+					[]v3action.IsolationSegment{{GUID: "iso-guid-2", Name: "iso-2"}, {GUID: "iso-guid-1", Name: "iso-1"}},
+					v3action.Warnings{"get-iso-warning"},
+					nil,
+				)
+				fakeV2Actor.GetOrganizationDomainsReturns(
+					[]v2action.Domain{
+						{Name: "shared.com", Type: constant.SharedDomain},
+						{Name: "private.com", Type: constant.PrivateDomain},
+					},
+					nil,
+					nil,
+				)
+				fakeV2Actor.GetOrganizationUsersByRoleStub = func(role constant.UserRole, _ string) ([]v2action.User, v2action.Warnings, error) {
+					switch role {
+					case constant.OrgUser:
+						return []v2action.User{{Username: "bob"}, {Username: "alice"}, {GUID: "client-guid"}}, nil, nil
+					case constant.OrgManager:
+						return []v2action.User{{Username: "alice"}}, nil, nil
+					}
+					return nil, nil, nil
+				}
+				fakeV2Actor.GetOrganizationSpaceQuotasReturns(
+					[]v2action.SpaceQuota{{GUID: "space-quota-guid", Name: "small", MemoryLimit: 1024, InstanceMemoryLimit: -1, TotalReservedRoutePorts: 2}},
+					nil,
+					nil,
+				)
+				fakeV2Actor.GetOrganizationSpacesReturns(
+					[]v2action.Space{
+						{GUID: "space-guid-2", Name: "prod"},
+						{GUID: "space-guid-1", Name: "dev", SpaceQuotaDefinitionGUID: "space-quota-guid"},
+					},
+					v2action.Warnings{"get-spaces-warning"},
+					nil,
+				)
+				fakeV3Actor.GetEffectiveIsolationSegmentBySpaceStub = func(spaceGUID string, _ string) (v3action.IsolationSegment, v3action.Warnings, error) {
+					if spaceGUID == "space-guid-1" {
+						return v3action.IsolationSegment{Name: "iso-1"}, nil, nil
+					}
+					return v3action.IsolationSegment{}, nil, actionerror.NoRelationshipError{}
+				}
+				fakeV2Actor.GetSpaceRunningSecurityGroupsBySpaceReturns([]v2action.SecurityGroup{{Name: "public"}}, nil, nil)
+				fakeV2Actor.GetSpaceStagingSecurityGroupsBySpaceReturns([]v2action.SecurityGroup{{Name: "dns"}}, nil, nil)
+				fakeV2Actor.GetSpaceUsersByRoleStub = func(role constant.UserRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error) {
+					if role == constant.SpaceDeveloper && spaceGUID == "space-guid-1" {
+						return []v2action.User{{Username: "bob"}}, nil, nil
+					}
+					return nil, nil, nil
+				}
+			})
+
+			It("returns the sorted organization structure and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-org-warning", "get-quota-warning", "get-iso-warning", "get-spaces-warning"))
+				Expect(org).To(Equal(orgfile.Org{
+					Name:                    "some-org",
+					Quota:                   "default",
+					IsolationSegments:       []string{"iso-1", "iso-2"},
+					DefaultIsolationSegment: "iso-2",
+					PrivateDomains:          []string{"private.com"},
+					Users:                   []string{"alice", "bob"},
+					Managers:                []string{"alice"},
+					SpaceQuotas: []orgfile.SpaceQuota{
+						{Name: "small", MemoryLimit: 1024, InstanceMemoryLimit: -1, TotalReservedRoutePorts: 2},
+					},
+					Spaces: []orgfile.Space{
+						{
+							Name:                  "dev",
+							Quota:                 "small",
+							IsolationSegment:      "iso-1",
+							RunningSecurityGroups: []string{"public"},
+							StagingSecurityGroups: []string{"dns"},
+							Developers:            []string{"bob"},
+						},
+						{
+							Name:                  "prod",
+							RunningSecurityGroups: []string{"public"},
+							StagingSecurityGroups: []string{"dns"},
+						},
+					},
+				}))
+
+				Expect(fakeV2Actor.GetOrganizationQuotaArgsForCall(0)).To(Equal("some-quota-guid"))
+				Expect(fakeV3Actor.GetIsolationSegmentsByOrganizationArgsForCall(0)).To(Equal("some-org-guid"))
+				spaceGUID, orgDefault := fakeV3Actor.GetEffectiveIsolationSegmentBySpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("space-guid-2"))
+				Expect(orgDefault).To(BeEmpty())
+			})
+		})
+
+		When("the organization does not exist", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationByNameReturns(v2action.Organization{}, v2action.Warnings{"get-org-warning"}, actionerror.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-org-warning"))
+			})
+		})
+
+		When("getting the space isolation segment fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get isolation segment failed")
+				fakeV2Actor.GetOrganizationSpacesReturns([]v2action.Space{{GUID: "space-guid", Name: "dev"}}, nil, nil)
+				fakeV3Actor.GetEffectiveIsolationSegmentBySpaceReturns(v3action.IsolationSegment{}, v3action.Warnings{"get-iso-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-iso-warning"))
+			})
+		})
+	})
+})
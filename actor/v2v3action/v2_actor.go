@@ -1,16 +1,54 @@
 package v2v3action
 
-import "code.cloudfoundry.org/cli/actor/v2action"
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+)
 
 //go:generate counterfeiter . V2Actor
 
 type V2Actor interface {
 	ManifestV2Actor
+	AddOrganizationUserRole(role constant.UserRole, orgGUID string, username string) (v2action.Warnings, error)
+	AddSpaceUserRole(role constant.UserRole, spaceGUID string, username string) (v2action.Warnings, error)
+	BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycle constant.SecurityGroupLifecycle) (v2action.Warnings, error)
+	CreateOrganization(orgName string, quotaName string) (v2action.Organization, v2action.Warnings, error)
+	CreatePrivateDomain(domainName string, orgGUID string) (v2action.Domain, v2action.Warnings, error)
+	CreateSpace(spaceName string, orgGUID string) (v2action.Space, v2action.Warnings, error)
+	CreateSpaceQuota(spaceQuota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
+	DeleteRoute(routeGUID string) (v2action.Warnings, error)
+	DeleteServiceInstance(instance v2action.ServiceInstance) (v2action.Warnings, error)
+	DeleteServiceKey(serviceKeyGUID string) (v2action.Warnings, error)
+	GetApplication(guid string) (v2action.Application, v2action.Warnings, error)
 	GetApplicationInstancesWithStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
 	GetApplicationRoutes(appGUID string) (v2action.Routes, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
 	GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
+	GetOrganizationQuota(guid string) (v2action.OrganizationQuota, v2action.Warnings, error)
+	GetOrganizationQuotaByName(quotaName string) (v2action.OrganizationQuota, v2action.Warnings, error)
+	GetOrganizationSpaceQuotas(orgGUID string) ([]v2action.SpaceQuota, v2action.Warnings, error)
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetOrganizationUsersByRole(role constant.UserRole, orgGUID string) ([]v2action.User, v2action.Warnings, error)
+	GetOrphanedRoutesBySpace(spaceGUID string) ([]v2action.Route, v2action.Warnings, error)
+	GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
 	GetService(serviceGUID string) (v2action.Service, v2action.Warnings, error)
 	GetServiceInstanceByNameAndSpace(serviceInstanceName string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
 	GetServiceInstanceSharedTosByServiceInstance(serviceInstanceGUID string) ([]v2action.ServiceInstanceSharedTo, v2action.Warnings, error)
+	GetServiceKeysBySpace(spaceGUID string) ([]v2action.ServiceKey, v2action.Warnings, error)
 	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	GetSpaceUsersByRole(role constant.UserRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error)
+	GetStoppedApplicationsUpdatedBeforeBySpace(spaceGUID string, updatedBefore time.Time) ([]v2action.Application, v2action.Warnings, error)
+	GetUnboundServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	MapRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
+	SetOrganizationQuota(orgGUID string, quotaGUID string) (v2action.Warnings, error)
+	SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (v2action.Warnings, error)
+	StartApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+	UnmapRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
+	UpdateSpaceQuota(spaceQuota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
 }
@@ -3,9 +3,11 @@ package v2v3actionfakes
 
 import (
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/util/manifest"
 )
 
@@ -26,6 +28,168 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	AddOrganizationUserRoleStub        func(role constant.UserRole, orgGUID string, username string) (v2action.Warnings, error)
+	addOrganizationUserRoleMutex       sync.RWMutex
+	addOrganizationUserRoleArgsForCall []struct {
+		role     constant.UserRole
+		orgGUID  string
+		username string
+	}
+	addOrganizationUserRoleReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	addOrganizationUserRoleReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	AddSpaceUserRoleStub        func(role constant.UserRole, spaceGUID string, username string) (v2action.Warnings, error)
+	addSpaceUserRoleMutex       sync.RWMutex
+	addSpaceUserRoleArgsForCall []struct {
+		role      constant.UserRole
+		spaceGUID string
+		username  string
+	}
+	addSpaceUserRoleReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	addSpaceUserRoleReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	BindSecurityGroupToSpaceStub        func(securityGroupGUID string, spaceGUID string, lifecycle constant.SecurityGroupLifecycle) (v2action.Warnings, error)
+	bindSecurityGroupToSpaceMutex       sync.RWMutex
+	bindSecurityGroupToSpaceArgsForCall []struct {
+		securityGroupGUID string
+		spaceGUID         string
+		lifecycle         constant.SecurityGroupLifecycle
+	}
+	bindSecurityGroupToSpaceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	bindSecurityGroupToSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	CreateOrganizationStub        func(orgName string, quotaName string) (v2action.Organization, v2action.Warnings, error)
+	createOrganizationMutex       sync.RWMutex
+	createOrganizationArgsForCall []struct {
+		orgName   string
+		quotaName string
+	}
+	createOrganizationReturns struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	createOrganizationReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	CreatePrivateDomainStub        func(domainName string, orgGUID string) (v2action.Domain, v2action.Warnings, error)
+	createPrivateDomainMutex       sync.RWMutex
+	createPrivateDomainArgsForCall []struct {
+		domainName string
+		orgGUID    string
+	}
+	createPrivateDomainReturns struct {
+		result1 v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}
+	createPrivateDomainReturnsOnCall map[int]struct {
+		result1 v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}
+	CreateSpaceStub        func(spaceName string, orgGUID string) (v2action.Space, v2action.Warnings, error)
+	createSpaceMutex       sync.RWMutex
+	createSpaceArgsForCall []struct {
+		spaceName string
+		orgGUID   string
+	}
+	createSpaceReturns struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	createSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	CreateSpaceQuotaStub        func(spaceQuota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
+	createSpaceQuotaMutex       sync.RWMutex
+	createSpaceQuotaArgsForCall []struct {
+		spaceQuota v2action.SpaceQuota
+	}
+	createSpaceQuotaReturns struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	createSpaceQuotaReturnsOnCall map[int]struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	DeleteRouteStub        func(routeGUID string) (v2action.Warnings, error)
+	deleteRouteMutex       sync.RWMutex
+	deleteRouteArgsForCall []struct {
+		routeGUID string
+	}
+	deleteRouteReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteRouteReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	DeleteServiceInstanceStub        func(instance v2action.ServiceInstance) (v2action.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		instance v2action.ServiceInstance
+	}
+	deleteServiceInstanceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	DeleteServiceKeyStub        func(serviceKeyGUID string) (v2action.Warnings, error)
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		serviceKeyGUID string
+	}
+	deleteServiceKeyReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteServiceKeyReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	GetApplicationStub        func(guid string) (v2action.Application, v2action.Warnings, error)
+	getApplicationMutex       sync.RWMutex
+	getApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetApplicationInstancesWithStatsByApplicationStub        func(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
 	getApplicationInstancesWithStatsByApplicationMutex       sync.RWMutex
 	getApplicationInstancesWithStatsByApplicationArgsForCall []struct {
@@ -56,6 +220,21 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetFeatureFlagsStub        func() ([]v2action.FeatureFlag, v2action.Warnings, error)
 	getFeatureFlagsMutex       sync.RWMutex
 	getFeatureFlagsArgsForCall []struct{}
@@ -69,6 +248,142 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetOrganizationByNameStub        func(orgName string) (v2action.Organization, v2action.Warnings, error)
+	getOrganizationByNameMutex       sync.RWMutex
+	getOrganizationByNameArgsForCall []struct {
+		orgName string
+	}
+	getOrganizationByNameReturns struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationByNameReturnsOnCall map[int]struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationDomainsStub        func(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
+	getOrganizationDomainsMutex       sync.RWMutex
+	getOrganizationDomainsArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationDomainsReturns struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationDomainsReturnsOnCall map[int]struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationQuotaStub        func(guid string) (v2action.OrganizationQuota, v2action.Warnings, error)
+	getOrganizationQuotaMutex       sync.RWMutex
+	getOrganizationQuotaArgsForCall []struct {
+		guid string
+	}
+	getOrganizationQuotaReturns struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationQuotaByNameStub        func(quotaName string) (v2action.OrganizationQuota, v2action.Warnings, error)
+	getOrganizationQuotaByNameMutex       sync.RWMutex
+	getOrganizationQuotaByNameArgsForCall []struct {
+		quotaName string
+	}
+	getOrganizationQuotaByNameReturns struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationQuotaByNameReturnsOnCall map[int]struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationSpaceQuotasStub        func(orgGUID string) ([]v2action.SpaceQuota, v2action.Warnings, error)
+	getOrganizationSpaceQuotasMutex       sync.RWMutex
+	getOrganizationSpaceQuotasArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationSpaceQuotasReturns struct {
+		result1 []v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationSpaceQuotasReturnsOnCall map[int]struct {
+		result1 []v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationSpacesStub        func(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	getOrganizationSpacesMutex       sync.RWMutex
+	getOrganizationSpacesArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationSpacesReturns struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationSpacesReturnsOnCall map[int]struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationUsersByRoleStub        func(role constant.UserRole, orgGUID string) ([]v2action.User, v2action.Warnings, error)
+	getOrganizationUsersByRoleMutex       sync.RWMutex
+	getOrganizationUsersByRoleArgsForCall []struct {
+		role    constant.UserRole
+		orgGUID string
+	}
+	getOrganizationUsersByRoleReturns struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationUsersByRoleReturnsOnCall map[int]struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrphanedRoutesBySpaceStub        func(spaceGUID string) ([]v2action.Route, v2action.Warnings, error)
+	getOrphanedRoutesBySpaceMutex       sync.RWMutex
+	getOrphanedRoutesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getOrphanedRoutesBySpaceReturns struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrphanedRoutesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSecurityGroupByNameStub        func(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
+	getSecurityGroupByNameMutex       sync.RWMutex
+	getSecurityGroupByNameArgsForCall []struct {
+		securityGroupName string
+	}
+	getSecurityGroupByNameReturns struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSecurityGroupByNameReturnsOnCall map[int]struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetServiceStub        func(serviceGUID string) (v2action.Service, v2action.Warnings, error)
 	getServiceMutex       sync.RWMutex
 	getServiceArgsForCall []struct {
@@ -115,6 +430,21 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetServiceKeysBySpaceStub        func(spaceGUID string) ([]v2action.ServiceKey, v2action.Warnings, error)
+	getServiceKeysBySpaceMutex       sync.RWMutex
+	getServiceKeysBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getServiceKeysBySpaceReturns struct {
+		result1 []v2action.ServiceKey
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceKeysBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceKey
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetSpaceByOrganizationAndNameStub        func(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
 	getSpaceByOrganizationAndNameMutex       sync.RWMutex
 	getSpaceByOrganizationAndNameArgsForCall []struct {
@@ -131,6 +461,174 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetSpaceRunningSecurityGroupsBySpaceStub        func(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	getSpaceRunningSecurityGroupsBySpaceMutex       sync.RWMutex
+	getSpaceRunningSecurityGroupsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceRunningSecurityGroupsBySpaceReturns struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceRunningSecurityGroupsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceStagingSecurityGroupsBySpaceStub        func(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	getSpaceStagingSecurityGroupsBySpaceMutex       sync.RWMutex
+	getSpaceStagingSecurityGroupsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceStagingSecurityGroupsBySpaceReturns struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceStagingSecurityGroupsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceUsersByRoleStub        func(role constant.UserRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error)
+	getSpaceUsersByRoleMutex       sync.RWMutex
+	getSpaceUsersByRoleArgsForCall []struct {
+		role      constant.UserRole
+		spaceGUID string
+	}
+	getSpaceUsersByRoleReturns struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceUsersByRoleReturnsOnCall map[int]struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStoppedApplicationsUpdatedBeforeBySpaceStub        func(spaceGUID string, updatedBefore time.Time) ([]v2action.Application, v2action.Warnings, error)
+	getStoppedApplicationsUpdatedBeforeBySpaceMutex       sync.RWMutex
+	getStoppedApplicationsUpdatedBeforeBySpaceArgsForCall []struct {
+		spaceGUID     string
+		updatedBefore time.Time
+	}
+	getStoppedApplicationsUpdatedBeforeBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStoppedApplicationsUpdatedBeforeBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetUnboundServiceInstancesBySpaceStub        func(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	getUnboundServiceInstancesBySpaceMutex       sync.RWMutex
+	getUnboundServiceInstancesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getUnboundServiceInstancesBySpaceReturns struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getUnboundServiceInstancesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	MapRouteToApplicationStub        func(routeGUID string, appGUID string) (v2action.Warnings, error)
+	mapRouteToApplicationMutex       sync.RWMutex
+	mapRouteToApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	mapRouteToApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	mapRouteToApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	SetOrganizationQuotaStub        func(orgGUID string, quotaGUID string) (v2action.Warnings, error)
+	setOrganizationQuotaMutex       sync.RWMutex
+	setOrganizationQuotaArgsForCall []struct {
+		orgGUID   string
+		quotaGUID string
+	}
+	setOrganizationQuotaReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	setOrganizationQuotaReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	SetSpaceQuotaStub        func(spaceGUID string, spaceQuotaGUID string) (v2action.Warnings, error)
+	setSpaceQuotaMutex       sync.RWMutex
+	setSpaceQuotaArgsForCall []struct {
+		spaceGUID      string
+		spaceQuotaGUID string
+	}
+	setSpaceQuotaReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	setSpaceQuotaReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	StartApplicationStub        func(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error)
+	startApplicationMutex       sync.RWMutex
+	startApplicationArgsForCall []struct {
+		app    v2action.Application
+		client v2action.NOAAClient
+	}
+	startApplicationReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}
+	startApplicationReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}
+	UnmapRouteFromApplicationStub        func(routeGUID string, appGUID string) (v2action.Warnings, error)
+	unmapRouteFromApplicationMutex       sync.RWMutex
+	unmapRouteFromApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	unmapRouteFromApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	unmapRouteFromApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	UpdateSpaceQuotaStub        func(spaceQuota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error)
+	updateSpaceQuotaMutex       sync.RWMutex
+	updateSpaceQuotaArgsForCall []struct {
+		spaceQuota v2action.SpaceQuota
+	}
+	updateSpaceQuotaReturns struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	updateSpaceQuotaReturnsOnCall map[int]struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -190,92 +688,677 @@ func (fake *FakeV2Actor) CreateApplicationManifestByNameAndSpaceReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error) {
-	fake.getApplicationInstancesWithStatsByApplicationMutex.Lock()
-	ret, specificReturn := fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall[len(fake.getApplicationInstancesWithStatsByApplicationArgsForCall)]
-	fake.getApplicationInstancesWithStatsByApplicationArgsForCall = append(fake.getApplicationInstancesWithStatsByApplicationArgsForCall, struct {
-		guid string
-	}{guid})
-	fake.recordInvocation("GetApplicationInstancesWithStatsByApplication", []interface{}{guid})
-	fake.getApplicationInstancesWithStatsByApplicationMutex.Unlock()
-	if fake.GetApplicationInstancesWithStatsByApplicationStub != nil {
-		return fake.GetApplicationInstancesWithStatsByApplicationStub(guid)
+func (fake *FakeV2Actor) AddOrganizationUserRole(role constant.UserRole, orgGUID string, username string) (v2action.Warnings, error) {
+	fake.addOrganizationUserRoleMutex.Lock()
+	ret, specificReturn := fake.addOrganizationUserRoleReturnsOnCall[len(fake.addOrganizationUserRoleArgsForCall)]
+	fake.addOrganizationUserRoleArgsForCall = append(fake.addOrganizationUserRoleArgsForCall, struct {
+		role     constant.UserRole
+		orgGUID  string
+		username string
+	}{role, orgGUID, username})
+	fake.recordInvocation("AddOrganizationUserRole", []interface{}{role, orgGUID, username})
+	fake.addOrganizationUserRoleMutex.Unlock()
+	if fake.AddOrganizationUserRoleStub != nil {
+		return fake.AddOrganizationUserRoleStub(role, orgGUID, username)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getApplicationInstancesWithStatsByApplicationReturns.result1, fake.getApplicationInstancesWithStatsByApplicationReturns.result2, fake.getApplicationInstancesWithStatsByApplicationReturns.result3
+	return fake.addOrganizationUserRoleReturns.result1, fake.addOrganizationUserRoleReturns.result2
 }
 
-func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationCallCount() int {
-	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
-	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
-	return len(fake.getApplicationInstancesWithStatsByApplicationArgsForCall)
+func (fake *FakeV2Actor) AddOrganizationUserRoleCallCount() int {
+	fake.addOrganizationUserRoleMutex.RLock()
+	defer fake.addOrganizationUserRoleMutex.RUnlock()
+	return len(fake.addOrganizationUserRoleArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationArgsForCall(i int) string {
-	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
-	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
-	return fake.getApplicationInstancesWithStatsByApplicationArgsForCall[i].guid
+func (fake *FakeV2Actor) AddOrganizationUserRoleArgsForCall(i int) (constant.UserRole, string, string) {
+	fake.addOrganizationUserRoleMutex.RLock()
+	defer fake.addOrganizationUserRoleMutex.RUnlock()
+	return fake.addOrganizationUserRoleArgsForCall[i].role, fake.addOrganizationUserRoleArgsForCall[i].orgGUID, fake.addOrganizationUserRoleArgsForCall[i].username
 }
 
-func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationReturns(result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
-	fake.GetApplicationInstancesWithStatsByApplicationStub = nil
-	fake.getApplicationInstancesWithStatsByApplicationReturns = struct {
-		result1 []v2action.ApplicationInstanceWithStats
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeV2Actor) AddOrganizationUserRoleReturns(result1 v2action.Warnings, result2 error) {
+	fake.AddOrganizationUserRoleStub = nil
+	fake.addOrganizationUserRoleReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationReturnsOnCall(i int, result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
-	fake.GetApplicationInstancesWithStatsByApplicationStub = nil
-	if fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall == nil {
-		fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall = make(map[int]struct {
-			result1 []v2action.ApplicationInstanceWithStats
-			result2 v2action.Warnings
-			result3 error
+func (fake *FakeV2Actor) AddOrganizationUserRoleReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.AddOrganizationUserRoleStub = nil
+	if fake.addOrganizationUserRoleReturnsOnCall == nil {
+		fake.addOrganizationUserRoleReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
 		})
 	}
-	fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall[i] = struct {
-		result1 []v2action.ApplicationInstanceWithStats
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.addOrganizationUserRoleReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutes(appGUID string) (v2action.Routes, v2action.Warnings, error) {
-	fake.getApplicationRoutesMutex.Lock()
-	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
-	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
-		appGUID string
-	}{appGUID})
-	fake.recordInvocation("GetApplicationRoutes", []interface{}{appGUID})
-	fake.getApplicationRoutesMutex.Unlock()
-	if fake.GetApplicationRoutesStub != nil {
-		return fake.GetApplicationRoutesStub(appGUID)
+func (fake *FakeV2Actor) AddSpaceUserRole(role constant.UserRole, spaceGUID string, username string) (v2action.Warnings, error) {
+	fake.addSpaceUserRoleMutex.Lock()
+	ret, specificReturn := fake.addSpaceUserRoleReturnsOnCall[len(fake.addSpaceUserRoleArgsForCall)]
+	fake.addSpaceUserRoleArgsForCall = append(fake.addSpaceUserRoleArgsForCall, struct {
+		role      constant.UserRole
+		spaceGUID string
+		username  string
+	}{role, spaceGUID, username})
+	fake.recordInvocation("AddSpaceUserRole", []interface{}{role, spaceGUID, username})
+	fake.addSpaceUserRoleMutex.Unlock()
+	if fake.AddSpaceUserRoleStub != nil {
+		return fake.AddSpaceUserRoleStub(role, spaceGUID, username)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+	return fake.addSpaceUserRoleReturns.result1, fake.addSpaceUserRoleReturns.result2
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesCallCount() int {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return len(fake.getApplicationRoutesArgsForCall)
+func (fake *FakeV2Actor) AddSpaceUserRoleCallCount() int {
+	fake.addSpaceUserRoleMutex.RLock()
+	defer fake.addSpaceUserRoleMutex.RUnlock()
+	return len(fake.addSpaceUserRoleArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesArgsForCall(i int) string {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return fake.getApplicationRoutesArgsForCall[i].appGUID
+func (fake *FakeV2Actor) AddSpaceUserRoleArgsForCall(i int) (constant.UserRole, string, string) {
+	fake.addSpaceUserRoleMutex.RLock()
+	defer fake.addSpaceUserRoleMutex.RUnlock()
+	return fake.addSpaceUserRoleArgsForCall[i].role, fake.addSpaceUserRoleArgsForCall[i].spaceGUID, fake.addSpaceUserRoleArgsForCall[i].username
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesReturns(result1 v2action.Routes, result2 v2action.Warnings, result3 error) {
-	fake.GetApplicationRoutesStub = nil
-	fake.getApplicationRoutesReturns = struct {
+func (fake *FakeV2Actor) AddSpaceUserRoleReturns(result1 v2action.Warnings, result2 error) {
+	fake.AddSpaceUserRoleStub = nil
+	fake.addSpaceUserRoleReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) AddSpaceUserRoleReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.AddSpaceUserRoleStub = nil
+	if fake.addSpaceUserRoleReturnsOnCall == nil {
+		fake.addSpaceUserRoleReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.addSpaceUserRoleReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string, lifecycle constant.SecurityGroupLifecycle) (v2action.Warnings, error) {
+	fake.bindSecurityGroupToSpaceMutex.Lock()
+	ret, specificReturn := fake.bindSecurityGroupToSpaceReturnsOnCall[len(fake.bindSecurityGroupToSpaceArgsForCall)]
+	fake.bindSecurityGroupToSpaceArgsForCall = append(fake.bindSecurityGroupToSpaceArgsForCall, struct {
+		securityGroupGUID string
+		spaceGUID         string
+		lifecycle         constant.SecurityGroupLifecycle
+	}{securityGroupGUID, spaceGUID, lifecycle})
+	fake.recordInvocation("BindSecurityGroupToSpace", []interface{}{securityGroupGUID, spaceGUID, lifecycle})
+	fake.bindSecurityGroupToSpaceMutex.Unlock()
+	if fake.BindSecurityGroupToSpaceStub != nil {
+		return fake.BindSecurityGroupToSpaceStub(securityGroupGUID, spaceGUID, lifecycle)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.bindSecurityGroupToSpaceReturns.result1, fake.bindSecurityGroupToSpaceReturns.result2
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceCallCount() int {
+	fake.bindSecurityGroupToSpaceMutex.RLock()
+	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	return len(fake.bindSecurityGroupToSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceArgsForCall(i int) (string, string, constant.SecurityGroupLifecycle) {
+	fake.bindSecurityGroupToSpaceMutex.RLock()
+	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	return fake.bindSecurityGroupToSpaceArgsForCall[i].securityGroupGUID, fake.bindSecurityGroupToSpaceArgsForCall[i].spaceGUID, fake.bindSecurityGroupToSpaceArgsForCall[i].lifecycle
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceReturns(result1 v2action.Warnings, result2 error) {
+	fake.BindSecurityGroupToSpaceStub = nil
+	fake.bindSecurityGroupToSpaceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.BindSecurityGroupToSpaceStub = nil
+	if fake.bindSecurityGroupToSpaceReturnsOnCall == nil {
+		fake.bindSecurityGroupToSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.bindSecurityGroupToSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) CreateOrganization(orgName string, quotaName string) (v2action.Organization, v2action.Warnings, error) {
+	fake.createOrganizationMutex.Lock()
+	ret, specificReturn := fake.createOrganizationReturnsOnCall[len(fake.createOrganizationArgsForCall)]
+	fake.createOrganizationArgsForCall = append(fake.createOrganizationArgsForCall, struct {
+		orgName   string
+		quotaName string
+	}{orgName, quotaName})
+	fake.recordInvocation("CreateOrganization", []interface{}{orgName, quotaName})
+	fake.createOrganizationMutex.Unlock()
+	if fake.CreateOrganizationStub != nil {
+		return fake.CreateOrganizationStub(orgName, quotaName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrganizationReturns.result1, fake.createOrganizationReturns.result2, fake.createOrganizationReturns.result3
+}
+
+func (fake *FakeV2Actor) CreateOrganizationCallCount() int {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return len(fake.createOrganizationArgsForCall)
+}
+
+func (fake *FakeV2Actor) CreateOrganizationArgsForCall(i int) (string, string) {
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	return fake.createOrganizationArgsForCall[i].orgName, fake.createOrganizationArgsForCall[i].quotaName
+}
+
+func (fake *FakeV2Actor) CreateOrganizationReturns(result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	fake.createOrganizationReturns = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateOrganizationReturnsOnCall(i int, result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.CreateOrganizationStub = nil
+	if fake.createOrganizationReturnsOnCall == nil {
+		fake.createOrganizationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createOrganizationReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreatePrivateDomain(domainName string, orgGUID string) (v2action.Domain, v2action.Warnings, error) {
+	fake.createPrivateDomainMutex.Lock()
+	ret, specificReturn := fake.createPrivateDomainReturnsOnCall[len(fake.createPrivateDomainArgsForCall)]
+	fake.createPrivateDomainArgsForCall = append(fake.createPrivateDomainArgsForCall, struct {
+		domainName string
+		orgGUID    string
+	}{domainName, orgGUID})
+	fake.recordInvocation("CreatePrivateDomain", []interface{}{domainName, orgGUID})
+	fake.createPrivateDomainMutex.Unlock()
+	if fake.CreatePrivateDomainStub != nil {
+		return fake.CreatePrivateDomainStub(domainName, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createPrivateDomainReturns.result1, fake.createPrivateDomainReturns.result2, fake.createPrivateDomainReturns.result3
+}
+
+func (fake *FakeV2Actor) CreatePrivateDomainCallCount() int {
+	fake.createPrivateDomainMutex.RLock()
+	defer fake.createPrivateDomainMutex.RUnlock()
+	return len(fake.createPrivateDomainArgsForCall)
+}
+
+func (fake *FakeV2Actor) CreatePrivateDomainArgsForCall(i int) (string, string) {
+	fake.createPrivateDomainMutex.RLock()
+	defer fake.createPrivateDomainMutex.RUnlock()
+	return fake.createPrivateDomainArgsForCall[i].domainName, fake.createPrivateDomainArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV2Actor) CreatePrivateDomainReturns(result1 v2action.Domain, result2 v2action.Warnings, result3 error) {
+	fake.CreatePrivateDomainStub = nil
+	fake.createPrivateDomainReturns = struct {
+		result1 v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreatePrivateDomainReturnsOnCall(i int, result1 v2action.Domain, result2 v2action.Warnings, result3 error) {
+	fake.CreatePrivateDomainStub = nil
+	if fake.createPrivateDomainReturnsOnCall == nil {
+		fake.createPrivateDomainReturnsOnCall = make(map[int]struct {
+			result1 v2action.Domain
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createPrivateDomainReturnsOnCall[i] = struct {
+		result1 v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateSpace(spaceName string, orgGUID string) (v2action.Space, v2action.Warnings, error) {
+	fake.createSpaceMutex.Lock()
+	ret, specificReturn := fake.createSpaceReturnsOnCall[len(fake.createSpaceArgsForCall)]
+	fake.createSpaceArgsForCall = append(fake.createSpaceArgsForCall, struct {
+		spaceName string
+		orgGUID   string
+	}{spaceName, orgGUID})
+	fake.recordInvocation("CreateSpace", []interface{}{spaceName, orgGUID})
+	fake.createSpaceMutex.Unlock()
+	if fake.CreateSpaceStub != nil {
+		return fake.CreateSpaceStub(spaceName, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceReturns.result1, fake.createSpaceReturns.result2, fake.createSpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) CreateSpaceCallCount() int {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return len(fake.createSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) CreateSpaceArgsForCall(i int) (string, string) {
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	return fake.createSpaceArgsForCall[i].spaceName, fake.createSpaceArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV2Actor) CreateSpaceReturns(result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	fake.createSpaceReturns = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateSpaceReturnsOnCall(i int, result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.CreateSpaceStub = nil
+	if fake.createSpaceReturnsOnCall == nil {
+		fake.createSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateSpaceQuota(spaceQuota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error) {
+	fake.createSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.createSpaceQuotaReturnsOnCall[len(fake.createSpaceQuotaArgsForCall)]
+	fake.createSpaceQuotaArgsForCall = append(fake.createSpaceQuotaArgsForCall, struct {
+		spaceQuota v2action.SpaceQuota
+	}{spaceQuota})
+	fake.recordInvocation("CreateSpaceQuota", []interface{}{spaceQuota})
+	fake.createSpaceQuotaMutex.Unlock()
+	if fake.CreateSpaceQuotaStub != nil {
+		return fake.CreateSpaceQuotaStub(spaceQuota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createSpaceQuotaReturns.result1, fake.createSpaceQuotaReturns.result2, fake.createSpaceQuotaReturns.result3
+}
+
+func (fake *FakeV2Actor) CreateSpaceQuotaCallCount() int {
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
+	return len(fake.createSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeV2Actor) CreateSpaceQuotaArgsForCall(i int) v2action.SpaceQuota {
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
+	return fake.createSpaceQuotaArgsForCall[i].spaceQuota
+}
+
+func (fake *FakeV2Actor) CreateSpaceQuotaReturns(result1 v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.CreateSpaceQuotaStub = nil
+	fake.createSpaceQuotaReturns = struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) CreateSpaceQuotaReturnsOnCall(i int, result1 v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.CreateSpaceQuotaStub = nil
+	if fake.createSpaceQuotaReturnsOnCall == nil {
+		fake.createSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 v2action.SpaceQuota
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createSpaceQuotaReturnsOnCall[i] = struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) DeleteRoute(routeGUID string) (v2action.Warnings, error) {
+	fake.deleteRouteMutex.Lock()
+	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
+	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
+		routeGUID string
+	}{routeGUID})
+	fake.recordInvocation("DeleteRoute", []interface{}{routeGUID})
+	fake.deleteRouteMutex.Unlock()
+	if fake.DeleteRouteStub != nil {
+		return fake.DeleteRouteStub(routeGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteRouteReturns.result1, fake.deleteRouteReturns.result2
+}
+
+func (fake *FakeV2Actor) DeleteRouteCallCount() int {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return len(fake.deleteRouteArgsForCall)
+}
+
+func (fake *FakeV2Actor) DeleteRouteArgsForCall(i int) string {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return fake.deleteRouteArgsForCall[i].routeGUID
+}
+
+func (fake *FakeV2Actor) DeleteRouteReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	fake.deleteRouteReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteRouteReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	if fake.deleteRouteReturnsOnCall == nil {
+		fake.deleteRouteReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteRouteReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteServiceInstance(instance v2action.ServiceInstance) (v2action.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		instance v2action.ServiceInstance
+	}{instance})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{instance})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(instance)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceInstanceReturns.result1, fake.deleteServiceInstanceReturns.result2
+}
+
+func (fake *FakeV2Actor) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) DeleteServiceInstanceArgsForCall(i int) v2action.ServiceInstance {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return fake.deleteServiceInstanceArgsForCall[i].instance
+}
+
+func (fake *FakeV2Actor) DeleteServiceInstanceReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteServiceInstanceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteServiceKey(serviceKeyGUID string) (v2action.Warnings, error) {
+	fake.deleteServiceKeyMutex.Lock()
+	ret, specificReturn := fake.deleteServiceKeyReturnsOnCall[len(fake.deleteServiceKeyArgsForCall)]
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		serviceKeyGUID string
+	}{serviceKeyGUID})
+	fake.recordInvocation("DeleteServiceKey", []interface{}{serviceKeyGUID})
+	fake.deleteServiceKeyMutex.Unlock()
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(serviceKeyGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteServiceKeyReturns.result1, fake.deleteServiceKeyReturns.result2
+}
+
+func (fake *FakeV2Actor) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeV2Actor) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return fake.deleteServiceKeyArgsForCall[i].serviceKeyGUID
+}
+
+func (fake *FakeV2Actor) DeleteServiceKeyReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	fake.deleteServiceKeyReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) DeleteServiceKeyReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceKeyStub = nil
+	if fake.deleteServiceKeyReturnsOnCall == nil {
+		fake.deleteServiceKeyReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteServiceKeyReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) GetApplication(guid string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
+	fake.getApplicationArgsForCall = append(fake.getApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplication", []interface{}{guid})
+	fake.getApplicationMutex.Unlock()
+	if fake.GetApplicationStub != nil {
+		return fake.GetApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationReturns.result1, fake.getApplicationReturns.result2, fake.getApplicationReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationCallCount() int {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return len(fake.getApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationArgsForCall(i int) string {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return fake.getApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) GetApplicationReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	fake.getApplicationReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	if fake.getApplicationReturnsOnCall == nil {
+		fake.getApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error) {
+	fake.getApplicationInstancesWithStatsByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall[len(fake.getApplicationInstancesWithStatsByApplicationArgsForCall)]
+	fake.getApplicationInstancesWithStatsByApplicationArgsForCall = append(fake.getApplicationInstancesWithStatsByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstancesWithStatsByApplication", []interface{}{guid})
+	fake.getApplicationInstancesWithStatsByApplicationMutex.Unlock()
+	if fake.GetApplicationInstancesWithStatsByApplicationStub != nil {
+		return fake.GetApplicationInstancesWithStatsByApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationInstancesWithStatsByApplicationReturns.result1, fake.getApplicationInstancesWithStatsByApplicationReturns.result2, fake.getApplicationInstancesWithStatsByApplicationReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationCallCount() int {
+	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstancesWithStatsByApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
+	return fake.getApplicationInstancesWithStatsByApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationReturns(result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstancesWithStatsByApplicationStub = nil
+	fake.getApplicationInstancesWithStatsByApplicationReturns = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesWithStatsByApplicationReturnsOnCall(i int, result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstancesWithStatsByApplicationStub = nil
+	if fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ApplicationInstanceWithStats
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationInstancesWithStatsByApplicationReturnsOnCall[i] = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutes(appGUID string) (v2action.Routes, v2action.Warnings, error) {
+	fake.getApplicationRoutesMutex.Lock()
+	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
+	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationRoutes", []interface{}{appGUID})
+	fake.getApplicationRoutesMutex.Unlock()
+	if fake.GetApplicationRoutesStub != nil {
+		return fake.GetApplicationRoutesStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesCallCount() int {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return len(fake.getApplicationRoutesArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesArgsForCall(i int) string {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return fake.getApplicationRoutesArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesReturns(result1 v2action.Routes, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationRoutesStub = nil
+	fake.getApplicationRoutesReturns = struct {
 		result1 v2action.Routes
 		result2 v2action.Warnings
 		result3 error
@@ -298,6 +1381,60 @@ func (fake *FakeV2Actor) GetApplicationRoutesReturnsOnCall(i int, result1 v2acti
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsBySpaceReturns.result1, fake.getApplicationsBySpaceReturns.result2, fake.getApplicationsBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetFeatureFlags() ([]v2action.FeatureFlag, v2action.Warnings, error) {
 	fake.getFeatureFlagsMutex.Lock()
 	ret, specificReturn := fake.getFeatureFlagsReturnsOnCall[len(fake.getFeatureFlagsArgsForCall)]
@@ -319,26 +1456,513 @@ func (fake *FakeV2Actor) GetFeatureFlagsCallCount() int {
 	return len(fake.getFeatureFlagsArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetFeatureFlagsReturns(result1 []v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
-	fake.GetFeatureFlagsStub = nil
-	fake.getFeatureFlagsReturns = struct {
-		result1 []v2action.FeatureFlag
+func (fake *FakeV2Actor) GetFeatureFlagsReturns(result1 []v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.GetFeatureFlagsStub = nil
+	fake.getFeatureFlagsReturns = struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetFeatureFlagsReturnsOnCall(i int, result1 []v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
+	fake.GetFeatureFlagsStub = nil
+	if fake.getFeatureFlagsReturnsOnCall == nil {
+		fake.getFeatureFlagsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.FeatureFlag
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getFeatureFlagsReturnsOnCall[i] = struct {
+		result1 []v2action.FeatureFlag
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error) {
+	fake.getOrganizationByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
+	fake.getOrganizationByNameArgsForCall = append(fake.getOrganizationByNameArgsForCall, struct {
+		orgName string
+	}{orgName})
+	fake.recordInvocation("GetOrganizationByName", []interface{}{orgName})
+	fake.getOrganizationByNameMutex.Unlock()
+	if fake.GetOrganizationByNameStub != nil {
+		return fake.GetOrganizationByNameStub(orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationByNameReturns.result1, fake.getOrganizationByNameReturns.result2, fake.getOrganizationByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationByNameCallCount() int {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return len(fake.getOrganizationByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationByNameArgsForCall(i int) string {
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	return fake.getOrganizationByNameArgsForCall[i].orgName
+}
+
+func (fake *FakeV2Actor) GetOrganizationByNameReturns(result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationByNameStub = nil
+	fake.getOrganizationByNameReturns = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationByNameReturnsOnCall(i int, result1 v2action.Organization, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationByNameStub = nil
+	if fake.getOrganizationByNameReturnsOnCall == nil {
+		fake.getOrganizationByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Organization
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationByNameReturnsOnCall[i] = struct {
+		result1 v2action.Organization
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error) {
+	fake.getOrganizationDomainsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationDomainsReturnsOnCall[len(fake.getOrganizationDomainsArgsForCall)]
+	fake.getOrganizationDomainsArgsForCall = append(fake.getOrganizationDomainsArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationDomains", []interface{}{orgGUID})
+	fake.getOrganizationDomainsMutex.Unlock()
+	if fake.GetOrganizationDomainsStub != nil {
+		return fake.GetOrganizationDomainsStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationDomainsReturns.result1, fake.getOrganizationDomainsReturns.result2, fake.getOrganizationDomainsReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomainsCallCount() int {
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	return len(fake.getOrganizationDomainsArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomainsArgsForCall(i int) string {
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	return fake.getOrganizationDomainsArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomainsReturns(result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationDomainsStub = nil
+	fake.getOrganizationDomainsReturns = struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomainsReturnsOnCall(i int, result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationDomainsStub = nil
+	if fake.getOrganizationDomainsReturnsOnCall == nil {
+		fake.getOrganizationDomainsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Domain
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationDomainsReturnsOnCall[i] = struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuota(guid string) (v2action.OrganizationQuota, v2action.Warnings, error) {
+	fake.getOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotaReturnsOnCall[len(fake.getOrganizationQuotaArgsForCall)]
+	fake.getOrganizationQuotaArgsForCall = append(fake.getOrganizationQuotaArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetOrganizationQuota", []interface{}{guid})
+	fake.getOrganizationQuotaMutex.Unlock()
+	if fake.GetOrganizationQuotaStub != nil {
+		return fake.GetOrganizationQuotaStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotaReturns.result1, fake.getOrganizationQuotaReturns.result2, fake.getOrganizationQuotaReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaCallCount() int {
+	fake.getOrganizationQuotaMutex.RLock()
+	defer fake.getOrganizationQuotaMutex.RUnlock()
+	return len(fake.getOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaArgsForCall(i int) string {
+	fake.getOrganizationQuotaMutex.RLock()
+	defer fake.getOrganizationQuotaMutex.RUnlock()
+	return fake.getOrganizationQuotaArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaReturns(result1 v2action.OrganizationQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationQuotaStub = nil
+	fake.getOrganizationQuotaReturns = struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaReturnsOnCall(i int, result1 v2action.OrganizationQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationQuotaStub = nil
+	if fake.getOrganizationQuotaReturnsOnCall == nil {
+		fake.getOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 v2action.OrganizationQuota
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByName(quotaName string) (v2action.OrganizationQuota, v2action.Warnings, error) {
+	fake.getOrganizationQuotaByNameMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotaByNameReturnsOnCall[len(fake.getOrganizationQuotaByNameArgsForCall)]
+	fake.getOrganizationQuotaByNameArgsForCall = append(fake.getOrganizationQuotaByNameArgsForCall, struct {
+		quotaName string
+	}{quotaName})
+	fake.recordInvocation("GetOrganizationQuotaByName", []interface{}{quotaName})
+	fake.getOrganizationQuotaByNameMutex.Unlock()
+	if fake.GetOrganizationQuotaByNameStub != nil {
+		return fake.GetOrganizationQuotaByNameStub(quotaName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationQuotaByNameReturns.result1, fake.getOrganizationQuotaByNameReturns.result2, fake.getOrganizationQuotaByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByNameCallCount() int {
+	fake.getOrganizationQuotaByNameMutex.RLock()
+	defer fake.getOrganizationQuotaByNameMutex.RUnlock()
+	return len(fake.getOrganizationQuotaByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByNameArgsForCall(i int) string {
+	fake.getOrganizationQuotaByNameMutex.RLock()
+	defer fake.getOrganizationQuotaByNameMutex.RUnlock()
+	return fake.getOrganizationQuotaByNameArgsForCall[i].quotaName
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByNameReturns(result1 v2action.OrganizationQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationQuotaByNameStub = nil
+	fake.getOrganizationQuotaByNameReturns = struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationQuotaByNameReturnsOnCall(i int, result1 v2action.OrganizationQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationQuotaByNameStub = nil
+	if fake.getOrganizationQuotaByNameReturnsOnCall == nil {
+		fake.getOrganizationQuotaByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.OrganizationQuota
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotaByNameReturnsOnCall[i] = struct {
+		result1 v2action.OrganizationQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpaceQuotas(orgGUID string) ([]v2action.SpaceQuota, v2action.Warnings, error) {
+	fake.getOrganizationSpaceQuotasMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpaceQuotasReturnsOnCall[len(fake.getOrganizationSpaceQuotasArgsForCall)]
+	fake.getOrganizationSpaceQuotasArgsForCall = append(fake.getOrganizationSpaceQuotasArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationSpaceQuotas", []interface{}{orgGUID})
+	fake.getOrganizationSpaceQuotasMutex.Unlock()
+	if fake.GetOrganizationSpaceQuotasStub != nil {
+		return fake.GetOrganizationSpaceQuotasStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationSpaceQuotasReturns.result1, fake.getOrganizationSpaceQuotasReturns.result2, fake.getOrganizationSpaceQuotasReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpaceQuotasCallCount() int {
+	fake.getOrganizationSpaceQuotasMutex.RLock()
+	defer fake.getOrganizationSpaceQuotasMutex.RUnlock()
+	return len(fake.getOrganizationSpaceQuotasArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpaceQuotasArgsForCall(i int) string {
+	fake.getOrganizationSpaceQuotasMutex.RLock()
+	defer fake.getOrganizationSpaceQuotasMutex.RUnlock()
+	return fake.getOrganizationSpaceQuotasArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpaceQuotasReturns(result1 []v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationSpaceQuotasStub = nil
+	fake.getOrganizationSpaceQuotasReturns = struct {
+		result1 []v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpaceQuotasReturnsOnCall(i int, result1 []v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationSpaceQuotasStub = nil
+	if fake.getOrganizationSpaceQuotasReturnsOnCall == nil {
+		fake.getOrganizationSpaceQuotasReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SpaceQuota
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpaceQuotasReturnsOnCall[i] = struct {
+		result1 []v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpacesReturnsOnCall[len(fake.getOrganizationSpacesArgsForCall)]
+	fake.getOrganizationSpacesArgsForCall = append(fake.getOrganizationSpacesArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationSpaces", []interface{}{orgGUID})
+	fake.getOrganizationSpacesMutex.Unlock()
+	if fake.GetOrganizationSpacesStub != nil {
+		return fake.GetOrganizationSpacesStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationSpacesReturns.result1, fake.getOrganizationSpacesReturns.result2, fake.getOrganizationSpacesReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpacesCallCount() int {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	return len(fake.getOrganizationSpacesArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpacesArgsForCall(i int) string {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	return fake.getOrganizationSpacesArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpacesReturns(result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationSpacesStub = nil
+	fake.getOrganizationSpacesReturns = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationSpacesReturnsOnCall(i int, result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationSpacesStub = nil
+	if fake.getOrganizationSpacesReturnsOnCall == nil {
+		fake.getOrganizationSpacesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpacesReturnsOnCall[i] = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRole(role constant.UserRole, orgGUID string) ([]v2action.User, v2action.Warnings, error) {
+	fake.getOrganizationUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsersByRoleReturnsOnCall[len(fake.getOrganizationUsersByRoleArgsForCall)]
+	fake.getOrganizationUsersByRoleArgsForCall = append(fake.getOrganizationUsersByRoleArgsForCall, struct {
+		role    constant.UserRole
+		orgGUID string
+	}{role, orgGUID})
+	fake.recordInvocation("GetOrganizationUsersByRole", []interface{}{role, orgGUID})
+	fake.getOrganizationUsersByRoleMutex.Unlock()
+	if fake.GetOrganizationUsersByRoleStub != nil {
+		return fake.GetOrganizationUsersByRoleStub(role, orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationUsersByRoleReturns.result1, fake.getOrganizationUsersByRoleReturns.result2, fake.getOrganizationUsersByRoleReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRoleCallCount() int {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return len(fake.getOrganizationUsersByRoleArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRoleArgsForCall(i int) (constant.UserRole, string) {
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	return fake.getOrganizationUsersByRoleArgsForCall[i].role, fake.getOrganizationUsersByRoleArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRoleReturns(result1 []v2action.User, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	fake.getOrganizationUsersByRoleReturns = struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationUsersByRoleReturnsOnCall(i int, result1 []v2action.User, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationUsersByRoleStub = nil
+	if fake.getOrganizationUsersByRoleReturnsOnCall == nil {
+		fake.getOrganizationUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []v2action.User
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsersByRoleReturnsOnCall[i] = struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpace(spaceGUID string) ([]v2action.Route, v2action.Warnings, error) {
+	fake.getOrphanedRoutesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getOrphanedRoutesBySpaceReturnsOnCall[len(fake.getOrphanedRoutesBySpaceArgsForCall)]
+	fake.getOrphanedRoutesBySpaceArgsForCall = append(fake.getOrphanedRoutesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetOrphanedRoutesBySpace", []interface{}{spaceGUID})
+	fake.getOrphanedRoutesBySpaceMutex.Unlock()
+	if fake.GetOrphanedRoutesBySpaceStub != nil {
+		return fake.GetOrphanedRoutesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrphanedRoutesBySpaceReturns.result1, fake.getOrphanedRoutesBySpaceReturns.result2, fake.getOrphanedRoutesBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpaceCallCount() int {
+	fake.getOrphanedRoutesBySpaceMutex.RLock()
+	defer fake.getOrphanedRoutesBySpaceMutex.RUnlock()
+	return len(fake.getOrphanedRoutesBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpaceArgsForCall(i int) string {
+	fake.getOrphanedRoutesBySpaceMutex.RLock()
+	defer fake.getOrphanedRoutesBySpaceMutex.RUnlock()
+	return fake.getOrphanedRoutesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpaceReturns(result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetOrphanedRoutesBySpaceStub = nil
+	fake.getOrphanedRoutesBySpaceReturns = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpaceReturnsOnCall(i int, result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetOrphanedRoutesBySpaceStub = nil
+	if fake.getOrphanedRoutesBySpaceReturnsOnCall == nil {
+		fake.getOrphanedRoutesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Route
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrphanedRoutesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error) {
+	fake.getSecurityGroupByNameMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupByNameReturnsOnCall[len(fake.getSecurityGroupByNameArgsForCall)]
+	fake.getSecurityGroupByNameArgsForCall = append(fake.getSecurityGroupByNameArgsForCall, struct {
+		securityGroupName string
+	}{securityGroupName})
+	fake.recordInvocation("GetSecurityGroupByName", []interface{}{securityGroupName})
+	fake.getSecurityGroupByNameMutex.Unlock()
+	if fake.GetSecurityGroupByNameStub != nil {
+		return fake.GetSecurityGroupByNameStub(securityGroupName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecurityGroupByNameReturns.result1, fake.getSecurityGroupByNameReturns.result2, fake.getSecurityGroupByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameCallCount() int {
+	fake.getSecurityGroupByNameMutex.RLock()
+	defer fake.getSecurityGroupByNameMutex.RUnlock()
+	return len(fake.getSecurityGroupByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameArgsForCall(i int) string {
+	fake.getSecurityGroupByNameMutex.RLock()
+	defer fake.getSecurityGroupByNameMutex.RUnlock()
+	return fake.getSecurityGroupByNameArgsForCall[i].securityGroupName
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameReturns(result1 v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupByNameStub = nil
+	fake.getSecurityGroupByNameReturns = struct {
+		result1 v2action.SecurityGroup
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetFeatureFlagsReturnsOnCall(i int, result1 []v2action.FeatureFlag, result2 v2action.Warnings, result3 error) {
-	fake.GetFeatureFlagsStub = nil
-	if fake.getFeatureFlagsReturnsOnCall == nil {
-		fake.getFeatureFlagsReturnsOnCall = make(map[int]struct {
-			result1 []v2action.FeatureFlag
+func (fake *FakeV2Actor) GetSecurityGroupByNameReturnsOnCall(i int, result1 v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupByNameStub = nil
+	if fake.getSecurityGroupByNameReturnsOnCall == nil {
+		fake.getSecurityGroupByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.SecurityGroup
 			result2 v2action.Warnings
 			result3 error
 		})
 	}
-	fake.getFeatureFlagsReturnsOnCall[i] = struct {
-		result1 []v2action.FeatureFlag
+	fake.getSecurityGroupByNameReturnsOnCall[i] = struct {
+		result1 v2action.SecurityGroup
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -507,6 +2131,60 @@ func (fake *FakeV2Actor) GetServiceInstanceSharedTosByServiceInstanceReturnsOnCa
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetServiceKeysBySpace(spaceGUID string) ([]v2action.ServiceKey, v2action.Warnings, error) {
+	fake.getServiceKeysBySpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceKeysBySpaceReturnsOnCall[len(fake.getServiceKeysBySpaceArgsForCall)]
+	fake.getServiceKeysBySpaceArgsForCall = append(fake.getServiceKeysBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetServiceKeysBySpace", []interface{}{spaceGUID})
+	fake.getServiceKeysBySpaceMutex.Unlock()
+	if fake.GetServiceKeysBySpaceStub != nil {
+		return fake.GetServiceKeysBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceKeysBySpaceReturns.result1, fake.getServiceKeysBySpaceReturns.result2, fake.getServiceKeysBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceKeysBySpaceCallCount() int {
+	fake.getServiceKeysBySpaceMutex.RLock()
+	defer fake.getServiceKeysBySpaceMutex.RUnlock()
+	return len(fake.getServiceKeysBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceKeysBySpaceArgsForCall(i int) string {
+	fake.getServiceKeysBySpaceMutex.RLock()
+	defer fake.getServiceKeysBySpaceMutex.RUnlock()
+	return fake.getServiceKeysBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceKeysBySpaceReturns(result1 []v2action.ServiceKey, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceKeysBySpaceStub = nil
+	fake.getServiceKeysBySpaceReturns = struct {
+		result1 []v2action.ServiceKey
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceKeysBySpaceReturnsOnCall(i int, result1 []v2action.ServiceKey, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceKeysBySpaceStub = nil
+	if fake.getServiceKeysBySpaceReturnsOnCall == nil {
+		fake.getServiceKeysBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceKey
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceKeysBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceKey
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error) {
 	fake.getSpaceByOrganizationAndNameMutex.Lock()
 	ret, specificReturn := fake.getSpaceByOrganizationAndNameReturnsOnCall[len(fake.getSpaceByOrganizationAndNameArgsForCall)]
@@ -562,25 +2240,686 @@ func (fake *FakeV2Actor) GetSpaceByOrganizationAndNameReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error) {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)]
+	fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceRunningSecurityGroupsBySpace", []interface{}{spaceGUID})
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Unlock()
+	if fake.GetSpaceRunningSecurityGroupsBySpaceStub != nil {
+		return fake.GetSpaceRunningSecurityGroupsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceRunningSecurityGroupsBySpaceReturns.result1, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result2, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceCallCount() int {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceArgsForCall(i int) string {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceReturns(result1 []v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	fake.getSpaceRunningSecurityGroupsBySpaceReturns = struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceReturnsOnCall(i int, result1 []v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	if fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall == nil {
+		fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SecurityGroup
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceStagingSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error) {
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)]
+	fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceStagingSecurityGroupsBySpace", []interface{}{spaceGUID})
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.Unlock()
+	if fake.GetSpaceStagingSecurityGroupsBySpaceStub != nil {
+		return fake.GetSpaceStagingSecurityGroupsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceStagingSecurityGroupsBySpaceReturns.result1, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result2, fake.getSpaceStagingSecurityGroupsBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceStagingSecurityGroupsBySpaceCallCount() int {
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
+	return len(fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceStagingSecurityGroupsBySpaceArgsForCall(i int) string {
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
+	return fake.getSpaceStagingSecurityGroupsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetSpaceStagingSecurityGroupsBySpaceReturns(result1 []v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
+	fake.getSpaceStagingSecurityGroupsBySpaceReturns = struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceStagingSecurityGroupsBySpaceReturnsOnCall(i int, result1 []v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceStagingSecurityGroupsBySpaceStub = nil
+	if fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall == nil {
+		fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SecurityGroup
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceStagingSecurityGroupsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRole(role constant.UserRole, spaceGUID string) ([]v2action.User, v2action.Warnings, error) {
+	fake.getSpaceUsersByRoleMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsersByRoleReturnsOnCall[len(fake.getSpaceUsersByRoleArgsForCall)]
+	fake.getSpaceUsersByRoleArgsForCall = append(fake.getSpaceUsersByRoleArgsForCall, struct {
+		role      constant.UserRole
+		spaceGUID string
+	}{role, spaceGUID})
+	fake.recordInvocation("GetSpaceUsersByRole", []interface{}{role, spaceGUID})
+	fake.getSpaceUsersByRoleMutex.Unlock()
+	if fake.GetSpaceUsersByRoleStub != nil {
+		return fake.GetSpaceUsersByRoleStub(role, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceUsersByRoleReturns.result1, fake.getSpaceUsersByRoleReturns.result2, fake.getSpaceUsersByRoleReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRoleCallCount() int {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return len(fake.getSpaceUsersByRoleArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRoleArgsForCall(i int) (constant.UserRole, string) {
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	return fake.getSpaceUsersByRoleArgsForCall[i].role, fake.getSpaceUsersByRoleArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRoleReturns(result1 []v2action.User, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	fake.getSpaceUsersByRoleReturns = struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceUsersByRoleReturnsOnCall(i int, result1 []v2action.User, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceUsersByRoleStub = nil
+	if fake.getSpaceUsersByRoleReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleReturnsOnCall = make(map[int]struct {
+			result1 []v2action.User
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsersByRoleReturnsOnCall[i] = struct {
+		result1 []v2action.User
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetStoppedApplicationsUpdatedBeforeBySpace(spaceGUID string, updatedBefore time.Time) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getStoppedApplicationsUpdatedBeforeBySpaceMutex.Lock()
+	ret, specificReturn := fake.getStoppedApplicationsUpdatedBeforeBySpaceReturnsOnCall[len(fake.getStoppedApplicationsUpdatedBeforeBySpaceArgsForCall)]
+	fake.getStoppedApplicationsUpdatedBeforeBySpaceArgsForCall = append(fake.getStoppedApplicationsUpdatedBeforeBySpaceArgsForCall, struct {
+		spaceGUID     string
+		updatedBefore time.Time
+	}{spaceGUID, updatedBefore})
+	fake.recordInvocation("GetStoppedApplicationsUpdatedBeforeBySpace", []interface{}{spaceGUID, updatedBefore})
+	fake.getStoppedApplicationsUpdatedBeforeBySpaceMutex.Unlock()
+	if fake.GetStoppedApplicationsUpdatedBeforeBySpaceStub != nil {
+		return fake.GetStoppedApplicationsUpdatedBeforeBySpaceStub(spaceGUID, updatedBefore)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStoppedApplicationsUpdatedBeforeBySpaceReturns.result1, fake.getStoppedApplicationsUpdatedBeforeBySpaceReturns.result2, fake.getStoppedApplicationsUpdatedBeforeBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetStoppedApplicationsUpdatedBeforeBySpaceCallCount() int {
+	fake.getStoppedApplicationsUpdatedBeforeBySpaceMutex.RLock()
+	defer fake.getStoppedApplicationsUpdatedBeforeBySpaceMutex.RUnlock()
+	return len(fake.getStoppedApplicationsUpdatedBeforeBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetStoppedApplicationsUpdatedBeforeBySpaceArgsForCall(i int) (string, time.Time) {
+	fake.getStoppedApplicationsUpdatedBeforeBySpaceMutex.RLock()
+	defer fake.getStoppedApplicationsUpdatedBeforeBySpaceMutex.RUnlock()
+	return fake.getStoppedApplicationsUpdatedBeforeBySpaceArgsForCall[i].spaceGUID, fake.getStoppedApplicationsUpdatedBeforeBySpaceArgsForCall[i].updatedBefore
+}
+
+func (fake *FakeV2Actor) GetStoppedApplicationsUpdatedBeforeBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetStoppedApplicationsUpdatedBeforeBySpaceStub = nil
+	fake.getStoppedApplicationsUpdatedBeforeBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetStoppedApplicationsUpdatedBeforeBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetStoppedApplicationsUpdatedBeforeBySpaceStub = nil
+	if fake.getStoppedApplicationsUpdatedBeforeBySpaceReturnsOnCall == nil {
+		fake.getStoppedApplicationsUpdatedBeforeBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStoppedApplicationsUpdatedBeforeBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetUnboundServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.getUnboundServiceInstancesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getUnboundServiceInstancesBySpaceReturnsOnCall[len(fake.getUnboundServiceInstancesBySpaceArgsForCall)]
+	fake.getUnboundServiceInstancesBySpaceArgsForCall = append(fake.getUnboundServiceInstancesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetUnboundServiceInstancesBySpace", []interface{}{spaceGUID})
+	fake.getUnboundServiceInstancesBySpaceMutex.Unlock()
+	if fake.GetUnboundServiceInstancesBySpaceStub != nil {
+		return fake.GetUnboundServiceInstancesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getUnboundServiceInstancesBySpaceReturns.result1, fake.getUnboundServiceInstancesBySpaceReturns.result2, fake.getUnboundServiceInstancesBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetUnboundServiceInstancesBySpaceCallCount() int {
+	fake.getUnboundServiceInstancesBySpaceMutex.RLock()
+	defer fake.getUnboundServiceInstancesBySpaceMutex.RUnlock()
+	return len(fake.getUnboundServiceInstancesBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetUnboundServiceInstancesBySpaceArgsForCall(i int) string {
+	fake.getUnboundServiceInstancesBySpaceMutex.RLock()
+	defer fake.getUnboundServiceInstancesBySpaceMutex.RUnlock()
+	return fake.getUnboundServiceInstancesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetUnboundServiceInstancesBySpaceReturns(result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetUnboundServiceInstancesBySpaceStub = nil
+	fake.getUnboundServiceInstancesBySpaceReturns = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetUnboundServiceInstancesBySpaceReturnsOnCall(i int, result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetUnboundServiceInstancesBySpaceStub = nil
+	if fake.getUnboundServiceInstancesBySpaceReturnsOnCall == nil {
+		fake.getUnboundServiceInstancesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getUnboundServiceInstancesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) MapRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error) {
+	fake.mapRouteToApplicationMutex.Lock()
+	ret, specificReturn := fake.mapRouteToApplicationReturnsOnCall[len(fake.mapRouteToApplicationArgsForCall)]
+	fake.mapRouteToApplicationArgsForCall = append(fake.mapRouteToApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("MapRouteToApplication", []interface{}{routeGUID, appGUID})
+	fake.mapRouteToApplicationMutex.Unlock()
+	if fake.MapRouteToApplicationStub != nil {
+		return fake.MapRouteToApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.mapRouteToApplicationReturns.result1, fake.mapRouteToApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) MapRouteToApplicationCallCount() int {
+	fake.mapRouteToApplicationMutex.RLock()
+	defer fake.mapRouteToApplicationMutex.RUnlock()
+	return len(fake.mapRouteToApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) MapRouteToApplicationArgsForCall(i int) (string, string) {
+	fake.mapRouteToApplicationMutex.RLock()
+	defer fake.mapRouteToApplicationMutex.RUnlock()
+	return fake.mapRouteToApplicationArgsForCall[i].routeGUID, fake.mapRouteToApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) MapRouteToApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.MapRouteToApplicationStub = nil
+	fake.mapRouteToApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) MapRouteToApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.MapRouteToApplicationStub = nil
+	if fake.mapRouteToApplicationReturnsOnCall == nil {
+		fake.mapRouteToApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.mapRouteToApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) SetOrganizationQuota(orgGUID string, quotaGUID string) (v2action.Warnings, error) {
+	fake.setOrganizationQuotaMutex.Lock()
+	ret, specificReturn := fake.setOrganizationQuotaReturnsOnCall[len(fake.setOrganizationQuotaArgsForCall)]
+	fake.setOrganizationQuotaArgsForCall = append(fake.setOrganizationQuotaArgsForCall, struct {
+		orgGUID   string
+		quotaGUID string
+	}{orgGUID, quotaGUID})
+	fake.recordInvocation("SetOrganizationQuota", []interface{}{orgGUID, quotaGUID})
+	fake.setOrganizationQuotaMutex.Unlock()
+	if fake.SetOrganizationQuotaStub != nil {
+		return fake.SetOrganizationQuotaStub(orgGUID, quotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setOrganizationQuotaReturns.result1, fake.setOrganizationQuotaReturns.result2
+}
+
+func (fake *FakeV2Actor) SetOrganizationQuotaCallCount() int {
+	fake.setOrganizationQuotaMutex.RLock()
+	defer fake.setOrganizationQuotaMutex.RUnlock()
+	return len(fake.setOrganizationQuotaArgsForCall)
+}
+
+func (fake *FakeV2Actor) SetOrganizationQuotaArgsForCall(i int) (string, string) {
+	fake.setOrganizationQuotaMutex.RLock()
+	defer fake.setOrganizationQuotaMutex.RUnlock()
+	return fake.setOrganizationQuotaArgsForCall[i].orgGUID, fake.setOrganizationQuotaArgsForCall[i].quotaGUID
+}
+
+func (fake *FakeV2Actor) SetOrganizationQuotaReturns(result1 v2action.Warnings, result2 error) {
+	fake.SetOrganizationQuotaStub = nil
+	fake.setOrganizationQuotaReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) SetOrganizationQuotaReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.SetOrganizationQuotaStub = nil
+	if fake.setOrganizationQuotaReturnsOnCall == nil {
+		fake.setOrganizationQuotaReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.setOrganizationQuotaReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (v2action.Warnings, error) {
+	fake.setSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.setSpaceQuotaReturnsOnCall[len(fake.setSpaceQuotaArgsForCall)]
+	fake.setSpaceQuotaArgsForCall = append(fake.setSpaceQuotaArgsForCall, struct {
+		spaceGUID      string
+		spaceQuotaGUID string
+	}{spaceGUID, spaceQuotaGUID})
+	fake.recordInvocation("SetSpaceQuota", []interface{}{spaceGUID, spaceQuotaGUID})
+	fake.setSpaceQuotaMutex.Unlock()
+	if fake.SetSpaceQuotaStub != nil {
+		return fake.SetSpaceQuotaStub(spaceGUID, spaceQuotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setSpaceQuotaReturns.result1, fake.setSpaceQuotaReturns.result2
+}
+
+func (fake *FakeV2Actor) SetSpaceQuotaCallCount() int {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return len(fake.setSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeV2Actor) SetSpaceQuotaArgsForCall(i int) (string, string) {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return fake.setSpaceQuotaArgsForCall[i].spaceGUID, fake.setSpaceQuotaArgsForCall[i].spaceQuotaGUID
+}
+
+func (fake *FakeV2Actor) SetSpaceQuotaReturns(result1 v2action.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	fake.setSpaceQuotaReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) SetSpaceQuotaReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	if fake.setSpaceQuotaReturnsOnCall == nil {
+		fake.setSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.setSpaceQuotaReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) StartApplication(app v2action.Application, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, <-chan v2action.ApplicationStateChange, <-chan string, <-chan error) {
+	fake.startApplicationMutex.Lock()
+	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
+	fake.startApplicationArgsForCall = append(fake.startApplicationArgsForCall, struct {
+		app    v2action.Application
+		client v2action.NOAAClient
+	}{app, client})
+	fake.recordInvocation("StartApplication", []interface{}{app, client})
+	fake.startApplicationMutex.Unlock()
+	if fake.StartApplicationStub != nil {
+		return fake.StartApplicationStub(app, client)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fake.startApplicationReturns.result1, fake.startApplicationReturns.result2, fake.startApplicationReturns.result3, fake.startApplicationReturns.result4, fake.startApplicationReturns.result5
+}
+
+func (fake *FakeV2Actor) StartApplicationCallCount() int {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return len(fake.startApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) StartApplicationArgsForCall(i int) (v2action.Application, v2action.NOAAClient) {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return fake.startApplicationArgsForCall[i].app, fake.startApplicationArgsForCall[i].client
+}
+
+func (fake *FakeV2Actor) StartApplicationReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan v2action.ApplicationStateChange, result4 <-chan string, result5 <-chan error) {
+	fake.StartApplicationStub = nil
+	fake.startApplicationReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeV2Actor) StartApplicationReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan v2action.ApplicationStateChange, result4 <-chan string, result5 <-chan error) {
+	fake.StartApplicationStub = nil
+	if fake.startApplicationReturnsOnCall == nil {
+		fake.startApplicationReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 <-chan v2action.ApplicationStateChange
+			result4 <-chan string
+			result5 <-chan error
+		})
+	}
+	fake.startApplicationReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan v2action.ApplicationStateChange
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeV2Actor) UnmapRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error) {
+	fake.unmapRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unmapRouteFromApplicationReturnsOnCall[len(fake.unmapRouteFromApplicationArgsForCall)]
+	fake.unmapRouteFromApplicationArgsForCall = append(fake.unmapRouteFromApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UnmapRouteFromApplication", []interface{}{routeGUID, appGUID})
+	fake.unmapRouteFromApplicationMutex.Unlock()
+	if fake.UnmapRouteFromApplicationStub != nil {
+		return fake.UnmapRouteFromApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unmapRouteFromApplicationReturns.result1, fake.unmapRouteFromApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) UnmapRouteFromApplicationCallCount() int {
+	fake.unmapRouteFromApplicationMutex.RLock()
+	defer fake.unmapRouteFromApplicationMutex.RUnlock()
+	return len(fake.unmapRouteFromApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) UnmapRouteFromApplicationArgsForCall(i int) (string, string) {
+	fake.unmapRouteFromApplicationMutex.RLock()
+	defer fake.unmapRouteFromApplicationMutex.RUnlock()
+	return fake.unmapRouteFromApplicationArgsForCall[i].routeGUID, fake.unmapRouteFromApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) UnmapRouteFromApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.UnmapRouteFromApplicationStub = nil
+	fake.unmapRouteFromApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UnmapRouteFromApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UnmapRouteFromApplicationStub = nil
+	if fake.unmapRouteFromApplicationReturnsOnCall == nil {
+		fake.unmapRouteFromApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.unmapRouteFromApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UpdateSpaceQuota(spaceQuota v2action.SpaceQuota) (v2action.SpaceQuota, v2action.Warnings, error) {
+	fake.updateSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.updateSpaceQuotaReturnsOnCall[len(fake.updateSpaceQuotaArgsForCall)]
+	fake.updateSpaceQuotaArgsForCall = append(fake.updateSpaceQuotaArgsForCall, struct {
+		spaceQuota v2action.SpaceQuota
+	}{spaceQuota})
+	fake.recordInvocation("UpdateSpaceQuota", []interface{}{spaceQuota})
+	fake.updateSpaceQuotaMutex.Unlock()
+	if fake.UpdateSpaceQuotaStub != nil {
+		return fake.UpdateSpaceQuotaStub(spaceQuota)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateSpaceQuotaReturns.result1, fake.updateSpaceQuotaReturns.result2, fake.updateSpaceQuotaReturns.result3
+}
+
+func (fake *FakeV2Actor) UpdateSpaceQuotaCallCount() int {
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
+	return len(fake.updateSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeV2Actor) UpdateSpaceQuotaArgsForCall(i int) v2action.SpaceQuota {
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
+	return fake.updateSpaceQuotaArgsForCall[i].spaceQuota
+}
+
+func (fake *FakeV2Actor) UpdateSpaceQuotaReturns(result1 v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.UpdateSpaceQuotaStub = nil
+	fake.updateSpaceQuotaReturns = struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UpdateSpaceQuotaReturnsOnCall(i int, result1 v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.UpdateSpaceQuotaStub = nil
+	if fake.updateSpaceQuotaReturnsOnCall == nil {
+		fake.updateSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 v2action.SpaceQuota
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.updateSpaceQuotaReturnsOnCall[i] = struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.createApplicationManifestByNameAndSpaceMutex.RLock()
 	defer fake.createApplicationManifestByNameAndSpaceMutex.RUnlock()
+	fake.addOrganizationUserRoleMutex.RLock()
+	defer fake.addOrganizationUserRoleMutex.RUnlock()
+	fake.addSpaceUserRoleMutex.RLock()
+	defer fake.addSpaceUserRoleMutex.RUnlock()
+	fake.bindSecurityGroupToSpaceMutex.RLock()
+	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	fake.createOrganizationMutex.RLock()
+	defer fake.createOrganizationMutex.RUnlock()
+	fake.createPrivateDomainMutex.RLock()
+	defer fake.createPrivateDomainMutex.RUnlock()
+	fake.createSpaceMutex.RLock()
+	defer fake.createSpaceMutex.RUnlock()
+	fake.createSpaceQuotaMutex.RLock()
+	defer fake.createSpaceQuotaMutex.RUnlock()
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
 	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
 	fake.getFeatureFlagsMutex.RLock()
 	defer fake.getFeatureFlagsMutex.RUnlock()
+	fake.getOrganizationByNameMutex.RLock()
+	defer fake.getOrganizationByNameMutex.RUnlock()
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	fake.getOrganizationQuotaMutex.RLock()
+	defer fake.getOrganizationQuotaMutex.RUnlock()
+	fake.getOrganizationQuotaByNameMutex.RLock()
+	defer fake.getOrganizationQuotaByNameMutex.RUnlock()
+	fake.getOrganizationSpaceQuotasMutex.RLock()
+	defer fake.getOrganizationSpaceQuotasMutex.RUnlock()
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	fake.getOrganizationUsersByRoleMutex.RLock()
+	defer fake.getOrganizationUsersByRoleMutex.RUnlock()
+	fake.getOrphanedRoutesBySpaceMutex.RLock()
+	defer fake.getOrphanedRoutesBySpaceMutex.RUnlock()
+	fake.getSecurityGroupByNameMutex.RLock()
+	defer fake.getSecurityGroupByNameMutex.RUnlock()
 	fake.getServiceMutex.RLock()
 	defer fake.getServiceMutex.RUnlock()
 	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
 	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
 	fake.getServiceInstanceSharedTosByServiceInstanceMutex.RLock()
 	defer fake.getServiceInstanceSharedTosByServiceInstanceMutex.RUnlock()
+	fake.getServiceKeysBySpaceMutex.RLock()
+	defer fake.getServiceKeysBySpaceMutex.RUnlock()
 	fake.getSpaceByOrganizationAndNameMutex.RLock()
 	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	fake.getSpaceStagingSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
+	fake.getSpaceUsersByRoleMutex.RLock()
+	defer fake.getSpaceUsersByRoleMutex.RUnlock()
+	fake.getStoppedApplicationsUpdatedBeforeBySpaceMutex.RLock()
+	defer fake.getStoppedApplicationsUpdatedBeforeBySpaceMutex.RUnlock()
+	fake.getUnboundServiceInstancesBySpaceMutex.RLock()
+	defer fake.getUnboundServiceInstancesBySpaceMutex.RUnlock()
+	fake.mapRouteToApplicationMutex.RLock()
+	defer fake.mapRouteToApplicationMutex.RUnlock()
+	fake.setOrganizationQuotaMutex.RLock()
+	defer fake.setOrganizationQuotaMutex.RUnlock()
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	fake.unmapRouteFromApplicationMutex.RLock()
+	defer fake.unmapRouteFromApplicationMutex.RUnlock()
+	fake.updateSpaceQuotaMutex.RLock()
+	defer fake.updateSpaceQuotaMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -25,6 +25,73 @@ type FakeV3Actor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	AssignIsolationSegmentToSpaceByNameAndSpaceStub        func(isolationSegmentName string, spaceGUID string) (v3action.Warnings, error)
+	assignIsolationSegmentToSpaceByNameAndSpaceMutex       sync.RWMutex
+	assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall []struct {
+		isolationSegmentName string
+		spaceGUID            string
+	}
+	assignIsolationSegmentToSpaceByNameAndSpaceReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	DeleteApplicationStub        func(appGUID string) (v3action.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		appGUID string
+	}
+	deleteApplicationReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	DeleteDropletStub        func(dropletGUID string) (v3action.Warnings, error)
+	deleteDropletMutex       sync.RWMutex
+	deleteDropletArgsForCall []struct {
+		dropletGUID string
+	}
+	deleteDropletReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	deleteDropletReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	DeletePackageStub        func(packageGUID string) (v3action.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		packageGUID string
+	}
+	deletePackageReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	EntitleIsolationSegmentToOrganizationByNameStub        func(isolationSegmentName string, orgName string) (v3action.Warnings, error)
+	entitleIsolationSegmentToOrganizationByNameMutex       sync.RWMutex
+	entitleIsolationSegmentToOrganizationByNameArgsForCall []struct {
+		isolationSegmentName string
+		orgName              string
+	}
+	entitleIsolationSegmentToOrganizationByNameReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	entitleIsolationSegmentToOrganizationByNameReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	GetApplicationSummaryByNameAndSpaceStub        func(appName string, spaceGUID string, withObfuscatedValues bool) (v3action.ApplicationSummary, v3action.Warnings, error)
 	getApplicationSummaryByNameAndSpaceMutex       sync.RWMutex
 	getApplicationSummaryByNameAndSpaceArgsForCall []struct {
@@ -42,6 +109,100 @@ type FakeV3Actor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetApplicationsBySpacesAndLabelSelectorStub        func(spaceGUIDs []string, labelSelector string) ([]v3action.Application, v3action.Warnings, error)
+	getApplicationsBySpacesAndLabelSelectorMutex       sync.RWMutex
+	getApplicationsBySpacesAndLabelSelectorArgsForCall []struct {
+		spaceGUIDs    []string
+		labelSelector string
+	}
+	getApplicationsBySpacesAndLabelSelectorReturns struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationsBySpacesAndLabelSelectorReturnsOnCall map[int]struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetEffectiveIsolationSegmentBySpaceStub        func(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getEffectiveIsolationSegmentBySpaceMutex       sync.RWMutex
+	getEffectiveIsolationSegmentBySpaceArgsForCall []struct {
+		spaceGUID                      string
+		orgDefaultIsolationSegmentGUID string
+	}
+	getEffectiveIsolationSegmentBySpaceReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getEffectiveIsolationSegmentBySpaceReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetExpiredDropletsByApplicationStub        func(appGUID string, keep int) ([]v3action.Droplet, v3action.Warnings, error)
+	getExpiredDropletsByApplicationMutex       sync.RWMutex
+	getExpiredDropletsByApplicationArgsForCall []struct {
+		appGUID string
+		keep    int
+	}
+	getExpiredDropletsByApplicationReturns struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	getExpiredDropletsByApplicationReturnsOnCall map[int]struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetExpiredPackagesByApplicationStub        func(appGUID string, keep int) ([]v3action.Package, v3action.Warnings, error)
+	getExpiredPackagesByApplicationMutex       sync.RWMutex
+	getExpiredPackagesByApplicationArgsForCall []struct {
+		appGUID string
+		keep    int
+	}
+	getExpiredPackagesByApplicationReturns struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	getExpiredPackagesByApplicationReturnsOnCall map[int]struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentByNameStub        func(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentByNameMutex       sync.RWMutex
+	getIsolationSegmentByNameArgsForCall []struct {
+		name string
+	}
+	getIsolationSegmentByNameReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentByNameReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentsByOrganizationStub        func(orgGUID string) ([]v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentsByOrganizationMutex       sync.RWMutex
+	getIsolationSegmentsByOrganizationArgsForCall []struct {
+		orgGUID string
+	}
+	getIsolationSegmentsByOrganizationReturns struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentsByOrganizationReturnsOnCall map[int]struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
 	GetOrganizationByNameStub        func(orgName string) (v3action.Organization, v3action.Warnings, error)
 	getOrganizationByNameMutex       sync.RWMutex
 	getOrganizationByNameArgsForCall []struct {
@@ -57,6 +218,34 @@ type FakeV3Actor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	ScaleProcessByApplicationStub        func(appGUID string, process v3action.Process) (v3action.Warnings, error)
+	scaleProcessByApplicationMutex       sync.RWMutex
+	scaleProcessByApplicationArgsForCall []struct {
+		appGUID string
+		process v3action.Process
+	}
+	scaleProcessByApplicationReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	scaleProcessByApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	SetOrganizationDefaultIsolationSegmentStub        func(orgGUID string, isoSegGUID string) (v3action.Warnings, error)
+	setOrganizationDefaultIsolationSegmentMutex       sync.RWMutex
+	setOrganizationDefaultIsolationSegmentArgsForCall []struct {
+		orgGUID    string
+		isoSegGUID string
+	}
+	setOrganizationDefaultIsolationSegmentReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	setOrganizationDefaultIsolationSegmentReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	ShareServiceInstanceToSpacesStub        func(serviceInstanceGUID string, spaceGUIDs []string) (v3action.RelationshipList, v3action.Warnings, error)
 	shareServiceInstanceToSpacesMutex       sync.RWMutex
 	shareServiceInstanceToSpacesArgsForCall []struct {
@@ -73,6 +262,19 @@ type FakeV3Actor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	StopApplicationStub        func(appGUID string) (v3action.Warnings, error)
+	stopApplicationMutex       sync.RWMutex
+	stopApplicationArgsForCall []struct {
+		appGUID string
+	}
+	stopApplicationReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	stopApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
 	UnshareServiceInstanceByServiceInstanceAndSpaceStub        func(serviceInstanceGUID string, spaceGUID string) (v3action.Warnings, error)
 	unshareServiceInstanceByServiceInstanceAndSpaceMutex       sync.RWMutex
 	unshareServiceInstanceByServiceInstanceAndSpaceArgsForCall []struct {
@@ -155,6 +357,263 @@ func (fake *FakeV3Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v3action.Warnings, error) {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall[len(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall)]
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall = append(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall, struct {
+		isolationSegmentName string
+		spaceGUID            string
+	}{isolationSegmentName, spaceGUID})
+	fake.recordInvocation("AssignIsolationSegmentToSpaceByNameAndSpace", []interface{}{isolationSegmentName, spaceGUID})
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.Unlock()
+	if fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub != nil {
+		return fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub(isolationSegmentName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.assignIsolationSegmentToSpaceByNameAndSpaceReturns.result1, fake.assignIsolationSegmentToSpaceByNameAndSpaceReturns.result2
+}
+
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpaceCallCount() int {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
+	return len(fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
+	return fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall[i].isolationSegmentName, fake.assignIsolationSegmentToSpaceByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpaceReturns(result1 v3action.Warnings, result2 error) {
+	fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub = nil
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) AssignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.AssignIsolationSegmentToSpaceByNameAndSpaceStub = nil
+	if fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall == nil {
+		fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) DeleteApplication(appGUID string) (v3action.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("DeleteApplication", []interface{}{appGUID})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
+}
+
+func (fake *FakeV3Actor) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
+}
+
+func (fake *FakeV3Actor) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3Actor) DeleteApplicationReturns(result1 v3action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) DeleteApplicationReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) DeleteDroplet(dropletGUID string) (v3action.Warnings, error) {
+	fake.deleteDropletMutex.Lock()
+	ret, specificReturn := fake.deleteDropletReturnsOnCall[len(fake.deleteDropletArgsForCall)]
+	fake.deleteDropletArgsForCall = append(fake.deleteDropletArgsForCall, struct {
+		dropletGUID string
+	}{dropletGUID})
+	fake.recordInvocation("DeleteDroplet", []interface{}{dropletGUID})
+	fake.deleteDropletMutex.Unlock()
+	if fake.DeleteDropletStub != nil {
+		return fake.DeleteDropletStub(dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteDropletReturns.result1, fake.deleteDropletReturns.result2
+}
+
+func (fake *FakeV3Actor) DeleteDropletCallCount() int {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return len(fake.deleteDropletArgsForCall)
+}
+
+func (fake *FakeV3Actor) DeleteDropletArgsForCall(i int) string {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return fake.deleteDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeV3Actor) DeleteDropletReturns(result1 v3action.Warnings, result2 error) {
+	fake.DeleteDropletStub = nil
+	fake.deleteDropletReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) DeleteDropletReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.DeleteDropletStub = nil
+	if fake.deleteDropletReturnsOnCall == nil {
+		fake.deleteDropletReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteDropletReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) DeletePackage(packageGUID string) (v3action.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.recordInvocation("DeletePackage", []interface{}{packageGUID})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(packageGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deletePackageReturns.result1, fake.deletePackageReturns.result2
+}
+
+func (fake *FakeV3Actor) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeV3Actor) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return fake.deletePackageArgsForCall[i].packageGUID
+}
+
+func (fake *FakeV3Actor) DeletePackageReturns(result1 v3action.Warnings, result2 error) {
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) DeletePackageReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v3action.Warnings, error) {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.Lock()
+	ret, specificReturn := fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall[len(fake.entitleIsolationSegmentToOrganizationByNameArgsForCall)]
+	fake.entitleIsolationSegmentToOrganizationByNameArgsForCall = append(fake.entitleIsolationSegmentToOrganizationByNameArgsForCall, struct {
+		isolationSegmentName string
+		orgName              string
+	}{isolationSegmentName, orgName})
+	fake.recordInvocation("EntitleIsolationSegmentToOrganizationByName", []interface{}{isolationSegmentName, orgName})
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.Unlock()
+	if fake.EntitleIsolationSegmentToOrganizationByNameStub != nil {
+		return fake.EntitleIsolationSegmentToOrganizationByNameStub(isolationSegmentName, orgName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.entitleIsolationSegmentToOrganizationByNameReturns.result1, fake.entitleIsolationSegmentToOrganizationByNameReturns.result2
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByNameCallCount() int {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	return len(fake.entitleIsolationSegmentToOrganizationByNameArgsForCall)
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByNameArgsForCall(i int) (string, string) {
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	return fake.entitleIsolationSegmentToOrganizationByNameArgsForCall[i].isolationSegmentName, fake.entitleIsolationSegmentToOrganizationByNameArgsForCall[i].orgName
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByNameReturns(result1 v3action.Warnings, result2 error) {
+	fake.EntitleIsolationSegmentToOrganizationByNameStub = nil
+	fake.entitleIsolationSegmentToOrganizationByNameReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) EntitleIsolationSegmentToOrganizationByNameReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.EntitleIsolationSegmentToOrganizationByNameStub = nil
+	if fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall == nil {
+		fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.entitleIsolationSegmentToOrganizationByNameReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string, withObfuscatedValues bool) (v3action.ApplicationSummary, v3action.Warnings, error) {
 	fake.getApplicationSummaryByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationSummaryByNameAndSpaceReturnsOnCall[len(fake.getApplicationSummaryByNameAndSpaceArgsForCall)]
@@ -211,6 +670,339 @@ func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3Actor) GetApplicationsBySpacesAndLabelSelector(spaceGUIDs []string, labelSelector string) ([]v3action.Application, v3action.Warnings, error) {
+	var spaceGUIDsCopy []string
+	if spaceGUIDs != nil {
+		spaceGUIDsCopy = make([]string, len(spaceGUIDs))
+		copy(spaceGUIDsCopy, spaceGUIDs)
+	}
+	fake.getApplicationsBySpacesAndLabelSelectorMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpacesAndLabelSelectorReturnsOnCall[len(fake.getApplicationsBySpacesAndLabelSelectorArgsForCall)]
+	fake.getApplicationsBySpacesAndLabelSelectorArgsForCall = append(fake.getApplicationsBySpacesAndLabelSelectorArgsForCall, struct {
+		spaceGUIDs    []string
+		labelSelector string
+	}{spaceGUIDsCopy, labelSelector})
+	fake.recordInvocation("GetApplicationsBySpacesAndLabelSelector", []interface{}{spaceGUIDsCopy, labelSelector})
+	fake.getApplicationsBySpacesAndLabelSelectorMutex.Unlock()
+	if fake.GetApplicationsBySpacesAndLabelSelectorStub != nil {
+		return fake.GetApplicationsBySpacesAndLabelSelectorStub(spaceGUIDs, labelSelector)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsBySpacesAndLabelSelectorReturns.result1, fake.getApplicationsBySpacesAndLabelSelectorReturns.result2, fake.getApplicationsBySpacesAndLabelSelectorReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpacesAndLabelSelectorCallCount() int {
+	fake.getApplicationsBySpacesAndLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpacesAndLabelSelectorMutex.RUnlock()
+	return len(fake.getApplicationsBySpacesAndLabelSelectorArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpacesAndLabelSelectorArgsForCall(i int) ([]string, string) {
+	fake.getApplicationsBySpacesAndLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpacesAndLabelSelectorMutex.RUnlock()
+	return fake.getApplicationsBySpacesAndLabelSelectorArgsForCall[i].spaceGUIDs, fake.getApplicationsBySpacesAndLabelSelectorArgsForCall[i].labelSelector
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpacesAndLabelSelectorReturns(result1 []v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationsBySpacesAndLabelSelectorStub = nil
+	fake.getApplicationsBySpacesAndLabelSelectorReturns = struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationsBySpacesAndLabelSelectorReturnsOnCall(i int, result1 []v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationsBySpacesAndLabelSelectorStub = nil
+	if fake.getApplicationsBySpacesAndLabelSelectorReturnsOnCall == nil {
+		fake.getApplicationsBySpacesAndLabelSelectorReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpacesAndLabelSelectorReturnsOnCall[i] = struct {
+		result1 []v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Lock()
+	ret, specificReturn := fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)]
+	fake.getEffectiveIsolationSegmentBySpaceArgsForCall = append(fake.getEffectiveIsolationSegmentBySpaceArgsForCall, struct {
+		spaceGUID                      string
+		orgDefaultIsolationSegmentGUID string
+	}{spaceGUID, orgDefaultIsolationSegmentGUID})
+	fake.recordInvocation("GetEffectiveIsolationSegmentBySpace", []interface{}{spaceGUID, orgDefaultIsolationSegmentGUID})
+	fake.getEffectiveIsolationSegmentBySpaceMutex.Unlock()
+	if fake.GetEffectiveIsolationSegmentBySpaceStub != nil {
+		return fake.GetEffectiveIsolationSegmentBySpaceStub(spaceGUID, orgDefaultIsolationSegmentGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getEffectiveIsolationSegmentBySpaceReturns.result1, fake.getEffectiveIsolationSegmentBySpaceReturns.result2, fake.getEffectiveIsolationSegmentBySpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpaceCallCount() int {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	return len(fake.getEffectiveIsolationSegmentBySpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpaceArgsForCall(i int) (string, string) {
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	return fake.getEffectiveIsolationSegmentBySpaceArgsForCall[i].spaceGUID, fake.getEffectiveIsolationSegmentBySpaceArgsForCall[i].orgDefaultIsolationSegmentGUID
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpaceReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
+	fake.getEffectiveIsolationSegmentBySpaceReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetEffectiveIsolationSegmentBySpaceReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetEffectiveIsolationSegmentBySpaceStub = nil
+	if fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall == nil {
+		fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getEffectiveIsolationSegmentBySpaceReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetExpiredDropletsByApplication(appGUID string, keep int) ([]v3action.Droplet, v3action.Warnings, error) {
+	fake.getExpiredDropletsByApplicationMutex.Lock()
+	ret, specificReturn := fake.getExpiredDropletsByApplicationReturnsOnCall[len(fake.getExpiredDropletsByApplicationArgsForCall)]
+	fake.getExpiredDropletsByApplicationArgsForCall = append(fake.getExpiredDropletsByApplicationArgsForCall, struct {
+		appGUID string
+		keep    int
+	}{appGUID, keep})
+	fake.recordInvocation("GetExpiredDropletsByApplication", []interface{}{appGUID, keep})
+	fake.getExpiredDropletsByApplicationMutex.Unlock()
+	if fake.GetExpiredDropletsByApplicationStub != nil {
+		return fake.GetExpiredDropletsByApplicationStub(appGUID, keep)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getExpiredDropletsByApplicationReturns.result1, fake.getExpiredDropletsByApplicationReturns.result2, fake.getExpiredDropletsByApplicationReturns.result3
+}
+
+func (fake *FakeV3Actor) GetExpiredDropletsByApplicationCallCount() int {
+	fake.getExpiredDropletsByApplicationMutex.RLock()
+	defer fake.getExpiredDropletsByApplicationMutex.RUnlock()
+	return len(fake.getExpiredDropletsByApplicationArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetExpiredDropletsByApplicationArgsForCall(i int) (string, int) {
+	fake.getExpiredDropletsByApplicationMutex.RLock()
+	defer fake.getExpiredDropletsByApplicationMutex.RUnlock()
+	return fake.getExpiredDropletsByApplicationArgsForCall[i].appGUID, fake.getExpiredDropletsByApplicationArgsForCall[i].keep
+}
+
+func (fake *FakeV3Actor) GetExpiredDropletsByApplicationReturns(result1 []v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.GetExpiredDropletsByApplicationStub = nil
+	fake.getExpiredDropletsByApplicationReturns = struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetExpiredDropletsByApplicationReturnsOnCall(i int, result1 []v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.GetExpiredDropletsByApplicationStub = nil
+	if fake.getExpiredDropletsByApplicationReturnsOnCall == nil {
+		fake.getExpiredDropletsByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Droplet
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getExpiredDropletsByApplicationReturnsOnCall[i] = struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetExpiredPackagesByApplication(appGUID string, keep int) ([]v3action.Package, v3action.Warnings, error) {
+	fake.getExpiredPackagesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getExpiredPackagesByApplicationReturnsOnCall[len(fake.getExpiredPackagesByApplicationArgsForCall)]
+	fake.getExpiredPackagesByApplicationArgsForCall = append(fake.getExpiredPackagesByApplicationArgsForCall, struct {
+		appGUID string
+		keep    int
+	}{appGUID, keep})
+	fake.recordInvocation("GetExpiredPackagesByApplication", []interface{}{appGUID, keep})
+	fake.getExpiredPackagesByApplicationMutex.Unlock()
+	if fake.GetExpiredPackagesByApplicationStub != nil {
+		return fake.GetExpiredPackagesByApplicationStub(appGUID, keep)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getExpiredPackagesByApplicationReturns.result1, fake.getExpiredPackagesByApplicationReturns.result2, fake.getExpiredPackagesByApplicationReturns.result3
+}
+
+func (fake *FakeV3Actor) GetExpiredPackagesByApplicationCallCount() int {
+	fake.getExpiredPackagesByApplicationMutex.RLock()
+	defer fake.getExpiredPackagesByApplicationMutex.RUnlock()
+	return len(fake.getExpiredPackagesByApplicationArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetExpiredPackagesByApplicationArgsForCall(i int) (string, int) {
+	fake.getExpiredPackagesByApplicationMutex.RLock()
+	defer fake.getExpiredPackagesByApplicationMutex.RUnlock()
+	return fake.getExpiredPackagesByApplicationArgsForCall[i].appGUID, fake.getExpiredPackagesByApplicationArgsForCall[i].keep
+}
+
+func (fake *FakeV3Actor) GetExpiredPackagesByApplicationReturns(result1 []v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.GetExpiredPackagesByApplicationStub = nil
+	fake.getExpiredPackagesByApplicationReturns = struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetExpiredPackagesByApplicationReturnsOnCall(i int, result1 []v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.GetExpiredPackagesByApplicationStub = nil
+	if fake.getExpiredPackagesByApplicationReturnsOnCall == nil {
+		fake.getExpiredPackagesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Package
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getExpiredPackagesByApplicationReturnsOnCall[i] = struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
+	fake.getIsolationSegmentByNameArgsForCall = append(fake.getIsolationSegmentByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetIsolationSegmentByName", []interface{}{name})
+	fake.getIsolationSegmentByNameMutex.Unlock()
+	if fake.GetIsolationSegmentByNameStub != nil {
+		return fake.GetIsolationSegmentByNameStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentByNameReturns.result1, fake.getIsolationSegmentByNameReturns.result2, fake.getIsolationSegmentByNameReturns.result3
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameCallCount() int {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return len(fake.getIsolationSegmentByNameArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameArgsForCall(i int) string {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return fake.getIsolationSegmentByNameArgsForCall[i].name
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	fake.getIsolationSegmentByNameReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	if fake.getIsolationSegmentByNameReturnsOnCall == nil {
+		fake.getIsolationSegmentByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentByNameReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganization(orgGUID string) ([]v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentsByOrganizationMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentsByOrganizationReturnsOnCall[len(fake.getIsolationSegmentsByOrganizationArgsForCall)]
+	fake.getIsolationSegmentsByOrganizationArgsForCall = append(fake.getIsolationSegmentsByOrganizationArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetIsolationSegmentsByOrganization", []interface{}{orgGUID})
+	fake.getIsolationSegmentsByOrganizationMutex.Unlock()
+	if fake.GetIsolationSegmentsByOrganizationStub != nil {
+		return fake.GetIsolationSegmentsByOrganizationStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentsByOrganizationReturns.result1, fake.getIsolationSegmentsByOrganizationReturns.result2, fake.getIsolationSegmentsByOrganizationReturns.result3
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganizationCallCount() int {
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	return len(fake.getIsolationSegmentsByOrganizationArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganizationArgsForCall(i int) string {
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
+	return fake.getIsolationSegmentsByOrganizationArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganizationReturns(result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentsByOrganizationStub = nil
+	fake.getIsolationSegmentsByOrganizationReturns = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentsByOrganizationReturnsOnCall(i int, result1 []v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentsByOrganizationStub = nil
+	if fake.getIsolationSegmentsByOrganizationReturnsOnCall == nil {
+		fake.getIsolationSegmentsByOrganizationReturnsOnCall = make(map[int]struct {
+			result1 []v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentsByOrganizationReturnsOnCall[i] = struct {
+		result1 []v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV3Actor) GetOrganizationByName(orgName string) (v3action.Organization, v3action.Warnings, error) {
 	fake.getOrganizationByNameMutex.Lock()
 	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
@@ -265,6 +1057,110 @@ func (fake *FakeV3Actor) GetOrganizationByNameReturnsOnCall(i int, result1 v3act
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3Actor) ScaleProcessByApplication(appGUID string, process v3action.Process) (v3action.Warnings, error) {
+	fake.scaleProcessByApplicationMutex.Lock()
+	ret, specificReturn := fake.scaleProcessByApplicationReturnsOnCall[len(fake.scaleProcessByApplicationArgsForCall)]
+	fake.scaleProcessByApplicationArgsForCall = append(fake.scaleProcessByApplicationArgsForCall, struct {
+		appGUID string
+		process v3action.Process
+	}{appGUID, process})
+	fake.recordInvocation("ScaleProcessByApplication", []interface{}{appGUID, process})
+	fake.scaleProcessByApplicationMutex.Unlock()
+	if fake.ScaleProcessByApplicationStub != nil {
+		return fake.ScaleProcessByApplicationStub(appGUID, process)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.scaleProcessByApplicationReturns.result1, fake.scaleProcessByApplicationReturns.result2
+}
+
+func (fake *FakeV3Actor) ScaleProcessByApplicationCallCount() int {
+	fake.scaleProcessByApplicationMutex.RLock()
+	defer fake.scaleProcessByApplicationMutex.RUnlock()
+	return len(fake.scaleProcessByApplicationArgsForCall)
+}
+
+func (fake *FakeV3Actor) ScaleProcessByApplicationArgsForCall(i int) (string, v3action.Process) {
+	fake.scaleProcessByApplicationMutex.RLock()
+	defer fake.scaleProcessByApplicationMutex.RUnlock()
+	return fake.scaleProcessByApplicationArgsForCall[i].appGUID, fake.scaleProcessByApplicationArgsForCall[i].process
+}
+
+func (fake *FakeV3Actor) ScaleProcessByApplicationReturns(result1 v3action.Warnings, result2 error) {
+	fake.ScaleProcessByApplicationStub = nil
+	fake.scaleProcessByApplicationReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) ScaleProcessByApplicationReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.ScaleProcessByApplicationStub = nil
+	if fake.scaleProcessByApplicationReturnsOnCall == nil {
+		fake.scaleProcessByApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.scaleProcessByApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) SetOrganizationDefaultIsolationSegment(orgGUID string, isoSegGUID string) (v3action.Warnings, error) {
+	fake.setOrganizationDefaultIsolationSegmentMutex.Lock()
+	ret, specificReturn := fake.setOrganizationDefaultIsolationSegmentReturnsOnCall[len(fake.setOrganizationDefaultIsolationSegmentArgsForCall)]
+	fake.setOrganizationDefaultIsolationSegmentArgsForCall = append(fake.setOrganizationDefaultIsolationSegmentArgsForCall, struct {
+		orgGUID    string
+		isoSegGUID string
+	}{orgGUID, isoSegGUID})
+	fake.recordInvocation("SetOrganizationDefaultIsolationSegment", []interface{}{orgGUID, isoSegGUID})
+	fake.setOrganizationDefaultIsolationSegmentMutex.Unlock()
+	if fake.SetOrganizationDefaultIsolationSegmentStub != nil {
+		return fake.SetOrganizationDefaultIsolationSegmentStub(orgGUID, isoSegGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setOrganizationDefaultIsolationSegmentReturns.result1, fake.setOrganizationDefaultIsolationSegmentReturns.result2
+}
+
+func (fake *FakeV3Actor) SetOrganizationDefaultIsolationSegmentCallCount() int {
+	fake.setOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.setOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	return len(fake.setOrganizationDefaultIsolationSegmentArgsForCall)
+}
+
+func (fake *FakeV3Actor) SetOrganizationDefaultIsolationSegmentArgsForCall(i int) (string, string) {
+	fake.setOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.setOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	return fake.setOrganizationDefaultIsolationSegmentArgsForCall[i].orgGUID, fake.setOrganizationDefaultIsolationSegmentArgsForCall[i].isoSegGUID
+}
+
+func (fake *FakeV3Actor) SetOrganizationDefaultIsolationSegmentReturns(result1 v3action.Warnings, result2 error) {
+	fake.SetOrganizationDefaultIsolationSegmentStub = nil
+	fake.setOrganizationDefaultIsolationSegmentReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) SetOrganizationDefaultIsolationSegmentReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.SetOrganizationDefaultIsolationSegmentStub = nil
+	if fake.setOrganizationDefaultIsolationSegmentReturnsOnCall == nil {
+		fake.setOrganizationDefaultIsolationSegmentReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.setOrganizationDefaultIsolationSegmentReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3Actor) ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (v3action.RelationshipList, v3action.Warnings, error) {
 	var spaceGUIDsCopy []string
 	if spaceGUIDs != nil {
@@ -325,6 +1221,57 @@ func (fake *FakeV3Actor) ShareServiceInstanceToSpacesReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV3Actor) StopApplication(appGUID string) (v3action.Warnings, error) {
+	fake.stopApplicationMutex.Lock()
+	ret, specificReturn := fake.stopApplicationReturnsOnCall[len(fake.stopApplicationArgsForCall)]
+	fake.stopApplicationArgsForCall = append(fake.stopApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("StopApplication", []interface{}{appGUID})
+	fake.stopApplicationMutex.Unlock()
+	if fake.StopApplicationStub != nil {
+		return fake.StopApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.stopApplicationReturns.result1, fake.stopApplicationReturns.result2
+}
+
+func (fake *FakeV3Actor) StopApplicationCallCount() int {
+	fake.stopApplicationMutex.RLock()
+	defer fake.stopApplicationMutex.RUnlock()
+	return len(fake.stopApplicationArgsForCall)
+}
+
+func (fake *FakeV3Actor) StopApplicationArgsForCall(i int) string {
+	fake.stopApplicationMutex.RLock()
+	defer fake.stopApplicationMutex.RUnlock()
+	return fake.stopApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3Actor) StopApplicationReturns(result1 v3action.Warnings, result2 error) {
+	fake.StopApplicationStub = nil
+	fake.stopApplicationReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3Actor) StopApplicationReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.StopApplicationStub = nil
+	if fake.stopApplicationReturnsOnCall == nil {
+		fake.stopApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.stopApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV3Actor) UnshareServiceInstanceByServiceInstanceAndSpace(serviceInstanceGUID string, spaceGUID string) (v3action.Warnings, error) {
 	fake.unshareServiceInstanceByServiceInstanceAndSpaceMutex.Lock()
 	ret, specificReturn := fake.unshareServiceInstanceByServiceInstanceAndSpaceReturnsOnCall[len(fake.unshareServiceInstanceByServiceInstanceAndSpaceArgsForCall)]
@@ -422,12 +1369,40 @@ func (fake *FakeV3Actor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RLock()
+	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
+	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
 	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationsBySpacesAndLabelSelectorMutex.RLock()
+	defer fake.getApplicationsBySpacesAndLabelSelectorMutex.RUnlock()
+	fake.getEffectiveIsolationSegmentBySpaceMutex.RLock()
+	defer fake.getEffectiveIsolationSegmentBySpaceMutex.RUnlock()
+	fake.getExpiredDropletsByApplicationMutex.RLock()
+	defer fake.getExpiredDropletsByApplicationMutex.RUnlock()
+	fake.getExpiredPackagesByApplicationMutex.RLock()
+	defer fake.getExpiredPackagesByApplicationMutex.RUnlock()
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	fake.getIsolationSegmentsByOrganizationMutex.RLock()
+	defer fake.getIsolationSegmentsByOrganizationMutex.RUnlock()
 	fake.getOrganizationByNameMutex.RLock()
 	defer fake.getOrganizationByNameMutex.RUnlock()
+	fake.scaleProcessByApplicationMutex.RLock()
+	defer fake.scaleProcessByApplicationMutex.RUnlock()
+	fake.setOrganizationDefaultIsolationSegmentMutex.RLock()
+	defer fake.setOrganizationDefaultIsolationSegmentMutex.RUnlock()
 	fake.shareServiceInstanceToSpacesMutex.RLock()
 	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	fake.stopApplicationMutex.RLock()
+	defer fake.stopApplicationMutex.RUnlock()
 	fake.unshareServiceInstanceByServiceInstanceAndSpaceMutex.RLock()
 	defer fake.unshareServiceInstanceByServiceInstanceAndSpaceMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
@@ -8,9 +8,23 @@ import (
 
 type V3Actor interface {
 	ManifestV3Actor
+	AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v3action.Warnings, error)
+	DeleteApplication(appGUID string) (v3action.Warnings, error)
+	DeleteDroplet(dropletGUID string) (v3action.Warnings, error)
+	DeletePackage(packageGUID string) (v3action.Warnings, error)
+	EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v3action.Warnings, error)
 	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string, withObfuscatedValues bool) (v3action.ApplicationSummary, v3action.Warnings, error)
+	GetApplicationsBySpacesAndLabelSelector(spaceGUIDs []string, labelSelector string) ([]v3action.Application, v3action.Warnings, error)
+	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetExpiredDropletsByApplication(appGUID string, keep int) ([]v3action.Droplet, v3action.Warnings, error)
+	GetExpiredPackagesByApplication(appGUID string, keep int) ([]v3action.Package, v3action.Warnings, error)
+	GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetIsolationSegmentsByOrganization(orgGUID string) ([]v3action.IsolationSegment, v3action.Warnings, error)
 	GetOrganizationByName(orgName string) (v3action.Organization, v3action.Warnings, error)
+	ScaleProcessByApplication(appGUID string, process v3action.Process) (v3action.Warnings, error)
+	SetOrganizationDefaultIsolationSegment(orgGUID string, isoSegGUID string) (v3action.Warnings, error)
 	ShareServiceInstanceToSpaces(serviceInstanceGUID string, spaceGUIDs []string) (v3action.RelationshipList, v3action.Warnings, error)
+	StopApplication(appGUID string) (v3action.Warnings, error)
 	UnshareServiceInstanceByServiceInstanceAndSpace(serviceInstanceGUID string, spaceGUID string) (v3action.Warnings, error)
 
 	CloudControllerAPIVersion() string
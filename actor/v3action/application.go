@@ -16,6 +16,7 @@ type Application struct {
 	State               constant.ApplicationState
 	LifecycleType       constant.AppLifecycleType
 	LifecycleBuildpacks []string
+	SpaceGUID           string
 }
 
 func (app Application) Started() bool {
@@ -31,7 +32,17 @@ func (actor Actor) DeleteApplicationByNameAndSpace(name string, spaceGUID string
 		return allWarnings, err
 	}
 
-	jobURL, deleteAppWarnings, err := actor.CloudControllerClient.DeleteApplication(app.GUID)
+	deleteAppWarnings, err := actor.DeleteApplication(app.GUID)
+	allWarnings = append(allWarnings, deleteAppWarnings...)
+	return allWarnings, err
+}
+
+// DeleteApplication deletes the application with the given GUID and waits for
+// the deletion to complete.
+func (actor Actor) DeleteApplication(appGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	jobURL, deleteAppWarnings, err := actor.CloudControllerClient.DeleteApplication(appGUID)
 	allWarnings = append(allWarnings, deleteAppWarnings...)
 	if err != nil {
 		return allWarnings, err
@@ -77,6 +88,52 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 	return apps, Warnings(warnings), nil
 }
 
+// GetApplicationsBySpacesAndLabelSelector returns the applications in any of
+// the spaces. When labelSelector is not empty, only the applications whose
+// labels match it are returned.
+func (actor Actor) GetApplicationsBySpacesAndLabelSelector(spaceGUIDs []string, labelSelector string) ([]Application, Warnings, error) {
+	if len(spaceGUIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	queries := []ccv3.Query{{Key: ccv3.SpaceGUIDFilter, Values: spaceGUIDs}}
+	if labelSelector != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}})
+	}
+
+	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(queries...)
+	if err != nil {
+		return []Application{}, Warnings(warnings), err
+	}
+
+	var apps []Application
+	for _, ccApp := range ccApps {
+		apps = append(apps, actor.convertCCToActorApplication(ccApp))
+	}
+	return apps, Warnings(warnings), nil
+}
+
+// GetApplicationsByGUIDs returns the applications with the given GUIDs,
+// regardless of the space they are in.
+func (actor Actor) GetApplicationsByGUIDs(appGUIDs ...string) ([]Application, Warnings, error) {
+	if len(appGUIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: appGUIDs},
+	)
+	if err != nil {
+		return []Application{}, Warnings(warnings), err
+	}
+
+	var apps []Application
+	for _, ccApp := range ccApps {
+		apps = append(apps, actor.convertCCToActorApplication(ccApp))
+	}
+	return apps, Warnings(warnings), nil
+}
+
 // CreateApplicationInSpace creates and returns the application with the given
 // name in the given space.
 func (actor Actor) CreateApplicationInSpace(app Application, spaceGUID string) (Application, Warnings, error) {
@@ -176,6 +233,7 @@ func (Actor) convertCCToActorApplication(app ccv3.Application) Application {
 		LifecycleType:       app.LifecycleType,
 		LifecycleBuildpacks: app.LifecycleBuildpacks,
 		Name:                app.Name,
+		SpaceGUID:           app.Relationships[constant.RelationshipTypeSpace].GUID,
 		State:               app.State,
 	}
 }
@@ -97,6 +97,44 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("DeleteApplication", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteApplication("some-app-guid")
+		})
+
+		When("sending the delete fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns("", ccv3.Warnings{"some-delete-warning"}, errors.New("some-delete-error"))
+			})
+
+			It("returns the warnings and error", func() {
+				Expect(warnings).To(ConsistOf("some-delete-warning"))
+				Expect(executeErr).To(MatchError("some-delete-error"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		When("sending the delete succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns("/some-job-url", ccv3.Warnings{"some-delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"some-poll-warning"}, nil)
+			})
+
+			It("polls the job and returns all the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-delete-warning", "some-poll-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("/some-job-url")))
+			})
+		})
+	})
+
 	Describe("GetApplicationByNameAndSpace", func() {
 		When("the app exists", func() {
 			BeforeEach(func() {
@@ -224,6 +262,169 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsBySpacesAndLabelSelector", func() {
+		var (
+			apps          []Application
+			warnings      Warnings
+			executeErr    error
+			spaceGUIDs    []string
+			labelSelector string
+		)
+
+		BeforeEach(func() {
+			spaceGUIDs = []string{"space-guid-1", "space-guid-2"}
+			labelSelector = ""
+		})
+
+		JustBeforeEach(func() {
+			apps, warnings, executeErr = actor.GetApplicationsBySpacesAndLabelSelector(spaceGUIDs, labelSelector)
+		})
+
+		When("there are applications in the spaces", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{GUID: "some-app-guid-1", Name: "some-app-1", Relationships: ccv3.Relationships{constant.RelationshipTypeSpace: ccv3.Relationship{GUID: "space-guid-1"}}},
+						{GUID: "some-app-guid-2", Name: "some-app-2", Relationships: ccv3.Relationships{constant.RelationshipTypeSpace: ccv3.Relationship{GUID: "space-guid-2"}}},
+					},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the applications and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(apps).To(ConsistOf(
+					Application{GUID: "some-app-guid-1", Name: "some-app-1", SpaceGUID: "space-guid-1"},
+					Application{GUID: "some-app-guid-2", Name: "some-app-2", SpaceGUID: "space-guid-2"},
+				))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid-1", "space-guid-2"}},
+				))
+			})
+
+			When("a label selector is given", func() {
+				BeforeEach(func() {
+					labelSelector = "env=prod,tier!=web"
+				})
+
+				It("filters the applications by the label selector", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid-1", "space-guid-2"}},
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod,tier!=web"}},
+					))
+				})
+			})
+		})
+
+		When("no spaces are given", func() {
+			BeforeEach(func() {
+				spaceGUIDs = nil
+			})
+
+			It("does not request any applications", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get applications failed")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("GetApplicationsByGUIDs", func() {
+		When("the applications exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{
+							GUID: "some-app-guid-1",
+							Name: "some-app-1",
+							Relationships: ccv3.Relationships{
+								constant.RelationshipTypeSpace: ccv3.Relationship{GUID: "some-space-guid-1"},
+							},
+						},
+						{
+							GUID: "some-app-guid-2",
+							Name: "some-app-2",
+							Relationships: ccv3.Relationships{
+								constant.RelationshipTypeSpace: ccv3.Relationship{GUID: "some-space-guid-2"},
+							},
+						},
+					},
+					ccv3.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("returns the applications with their spaces and warnings", func() {
+				apps, warnings, err := actor.GetApplicationsByGUIDs("some-app-guid-1", "some-app-guid-2")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(ConsistOf(
+					Application{
+						GUID:      "some-app-guid-1",
+						Name:      "some-app-1",
+						SpaceGUID: "some-space-guid-1",
+					},
+					Application{
+						GUID:      "some-app-guid-2",
+						Name:      "some-app-2",
+						SpaceGUID: "some-space-guid-2",
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-app-guid-1", "some-app-guid-2"}},
+				))
+			})
+		})
+
+		When("no GUIDs are given", func() {
+			It("does not request any applications", func() {
+				apps, warnings, err := actor.GetApplicationsByGUIDs()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(BeEmpty())
+				Expect(warnings).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{},
+					ccv3.Warnings{"some-warning"},
+					expectedError)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationsByGUIDs("some-app-guid")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError(expectedError))
+			})
+		})
+	})
+
 	Describe("CreateApplicationInSpace", func() {
 		var (
 			application Application
@@ -1,6 +1,8 @@
 package v3action
 
 import (
+	"sort"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -9,12 +11,13 @@ import (
 
 // Droplet represents a Cloud Controller droplet.
 type Droplet struct {
-	GUID       string
-	State      constant.DropletState
-	CreatedAt  string
-	Stack      string
-	Image      string
-	Buildpacks []Buildpack
+	GUID        string
+	State       constant.DropletState
+	CreatedAt   string
+	Stack       string
+	Image       string
+	Buildpacks  []Buildpack
+	PackageGUID string
 }
 
 type Buildpack ccv3.DropletBuildpack
@@ -85,6 +88,64 @@ func (actor Actor) GetCurrentDropletByApplication(appGUID string) (Droplet, Warn
 	return actor.convertCCToActorDroplet(droplet), Warnings(warnings), err
 }
 
+// GetExpiredDropletsByApplication returns the droplets of the application
+// that are older than its keep most recent droplets. The current droplet of
+// the application is never returned.
+func (actor Actor) GetExpiredDropletsByApplication(appGUID string, keep int) ([]Droplet, Warnings, error) {
+	var allWarnings Warnings
+
+	ccv3Droplets, apiWarnings, err := actor.CloudControllerClient.GetDroplets(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+	)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	currentDroplet, warnings, err := actor.GetCurrentDropletByApplication(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(actionerror.DropletNotFoundError); err != nil && !ok {
+		return nil, allWarnings, err
+	}
+
+	sort.Slice(ccv3Droplets, func(i int, j int) bool {
+		return ccv3Droplets[i].CreatedAt > ccv3Droplets[j].CreatedAt
+	})
+
+	var (
+		kept     int
+		droplets []Droplet
+	)
+	for _, ccv3Droplet := range ccv3Droplets {
+		if ccv3Droplet.GUID == currentDroplet.GUID {
+			continue
+		}
+		if kept < keep {
+			kept++
+			continue
+		}
+		droplets = append(droplets, actor.convertCCToActorDroplet(ccv3Droplet))
+	}
+
+	return droplets, allWarnings, nil
+}
+
+// DeleteDroplet deletes the droplet with the given GUID and waits for the
+// deletion to complete.
+func (actor Actor) DeleteDroplet(dropletGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	jobURL, warnings, err := actor.CloudControllerClient.DeleteDroplet(dropletGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
 func (actor Actor) convertCCToActorDroplet(ccDroplet ccv3.Droplet) Droplet {
 	var buildpacks []Buildpack
 	for _, ccBuildpack := range ccDroplet.Buildpacks {
@@ -92,11 +153,12 @@ func (actor Actor) convertCCToActorDroplet(ccDroplet ccv3.Droplet) Droplet {
 	}
 
 	return Droplet{
-		GUID:       ccDroplet.GUID,
-		State:      constant.DropletState(ccDroplet.State),
-		CreatedAt:  ccDroplet.CreatedAt,
-		Stack:      ccDroplet.Stack,
-		Buildpacks: buildpacks,
-		Image:      ccDroplet.Image,
+		GUID:        ccDroplet.GUID,
+		State:       constant.DropletState(ccDroplet.State),
+		CreatedAt:   ccDroplet.CreatedAt,
+		Stack:       ccDroplet.Stack,
+		Buildpacks:  buildpacks,
+		Image:       ccDroplet.Image,
+		PackageGUID: ccDroplet.PackageGUID,
 	}
 }
@@ -392,4 +392,112 @@ var _ = Describe("Droplet Actions", func() {
 			})
 		})
 	})
+	Describe("GetExpiredDropletsByApplication", func() {
+		var (
+			droplets   []Droplet
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			droplets, warnings, executeErr = actor.GetExpiredDropletsByApplication("some-app-guid", 1)
+		})
+
+		When("the application has more droplets than are kept", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletsReturns(
+					[]ccv3.Droplet{
+						{GUID: "oldest-guid", CreatedAt: "2017-01-01T00:00:00Z"},
+						{GUID: "current-guid", CreatedAt: "2017-01-02T00:00:00Z"},
+						{GUID: "newest-guid", CreatedAt: "2017-01-04T00:00:00Z"},
+						{GUID: "older-guid", CreatedAt: "2017-01-03T00:00:00Z"},
+					},
+					ccv3.Warnings{"get-droplets-warning"},
+					nil)
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{GUID: "current-guid"}, ccv3.Warnings{"get-current-warning"}, nil)
+			})
+
+			It("returns the droplets beyond the most recent ones, skipping the current droplet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-droplets-warning", "get-current-warning"))
+				Expect(droplets).To(Equal([]Droplet{
+					{GUID: "older-guid", CreatedAt: "2017-01-03T00:00:00Z"},
+					{GUID: "oldest-guid", CreatedAt: "2017-01-01T00:00:00Z"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				))
+			})
+		})
+
+		When("the application has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletsReturns(
+					[]ccv3.Droplet{
+						{GUID: "newest-guid", CreatedAt: "2017-01-02T00:00:00Z"},
+						{GUID: "oldest-guid", CreatedAt: "2017-01-01T00:00:00Z"},
+					},
+					nil,
+					nil)
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, nil, ccerror.DropletNotFoundError{})
+			})
+
+			It("keeps the most recent droplets", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(droplets).To(Equal([]Droplet{
+					{GUID: "oldest-guid", CreatedAt: "2017-01-01T00:00:00Z"},
+				}))
+			})
+		})
+
+		When("getting the current droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, ccv3.Warnings{"get-current-warning"}, errors.New("get-current-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-current-error"))
+				Expect(warnings).To(ConsistOf("get-current-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteDroplet", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteDroplet("some-droplet-guid")
+		})
+
+		When("sending the delete fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteDropletReturns("", ccv3.Warnings{"some-delete-warning"}, errors.New("some-delete-error"))
+			})
+
+			It("returns the warnings and error", func() {
+				Expect(warnings).To(ConsistOf("some-delete-warning"))
+				Expect(executeErr).To(MatchError("some-delete-error"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		When("sending the delete succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteDropletReturns("/some-job-url", ccv3.Warnings{"some-delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"some-poll-warning"}, nil)
+			})
+
+			It("polls the job and returns all the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-delete-warning", "some-poll-warning"))
+
+				Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("/some-job-url")))
+			})
+		})
+	})
 })
@@ -73,6 +73,63 @@ var _ = Describe("Organization Actions", func() {
 		})
 	})
 
+	Describe("GetOrganizationsByGUIDs", func() {
+		When("the orgs exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{
+						{Name: "some-org-name-1", GUID: "some-org-guid-1"},
+						{Name: "some-org-name-2", GUID: "some-org-guid-2"},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the organizations and warnings", func() {
+				orgs, warnings, err := actor.GetOrganizationsByGUIDs("some-org-guid-1", "some-org-guid-2")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgs).To(ConsistOf(
+					Organization{Name: "some-org-name-1", GUID: "some-org-guid-1"},
+					Organization{Name: "some-org-name-2", GUID: "some-org-guid-2"},
+				))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-org-guid-1", "some-org-guid-2"}},
+				))
+			})
+		})
+
+		When("no GUIDs are given", func() {
+			It("does not request any organizations", func() {
+				orgs, _, err := actor.GetOrganizationsByGUIDs()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgs).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{},
+					ccv3.Warnings{"some-warning"},
+					expectedError)
+			})
+
+			It("returns the warnings and the error", func() {
+				_, warnings, err := actor.GetOrganizationsByGUIDs("some-org-guid")
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(err).To(MatchError(expectedError))
+			})
+		})
+	})
+
 	When("the org does not exist", func() {
 		BeforeEach(func() {
 			fakeCloudControllerClient.GetOrganizationsReturns(
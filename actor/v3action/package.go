@@ -3,6 +3,7 @@ package v3action
 import (
 	"io"
 	"os"
+	"sort"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -152,6 +153,64 @@ func (actor *Actor) GetApplicationPackages(appName string, spaceGUID string) ([]
 	return packages, allWarnings, nil
 }
 
+// GetExpiredPackagesByApplication returns the packages of the application
+// that are older than its keep most recent packages. The package of the
+// current droplet of the application is never returned.
+func (actor Actor) GetExpiredPackagesByApplication(appGUID string, keep int) ([]Package, Warnings, error) {
+	var allWarnings Warnings
+
+	ccv3Packages, apiWarnings, err := actor.CloudControllerClient.GetPackages(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+	)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	currentDroplet, warnings, err := actor.GetCurrentDropletByApplication(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(actionerror.DropletNotFoundError); err != nil && !ok {
+		return nil, allWarnings, err
+	}
+
+	sort.Slice(ccv3Packages, func(i int, j int) bool {
+		return ccv3Packages[i].CreatedAt > ccv3Packages[j].CreatedAt
+	})
+
+	var (
+		kept     int
+		packages []Package
+	)
+	for _, ccv3Package := range ccv3Packages {
+		if currentDroplet.PackageGUID != "" && ccv3Package.GUID == currentDroplet.PackageGUID {
+			continue
+		}
+		if kept < keep {
+			kept++
+			continue
+		}
+		packages = append(packages, Package(ccv3Package))
+	}
+
+	return packages, allWarnings, nil
+}
+
+// DeletePackage deletes the package with the given GUID and waits for the
+// deletion to complete.
+func (actor Actor) DeletePackage(packageGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	jobURL, warnings, err := actor.CloudControllerClient.DeletePackage(packageGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
 func (actor Actor) CreateBitsPackageByApplication(appGUID string) (Package, Warnings, error) {
 	inputPackage := ccv3.Package{
 		Type: constant.PackageTypeBits,
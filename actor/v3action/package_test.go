@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 
@@ -146,6 +147,150 @@ var _ = Describe("Package Actions", func() {
 		})
 	})
 
+	Describe("GetExpiredPackagesByApplication", func() {
+		When("the application has more packages than are kept", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]ccv3.Package{
+						{GUID: "oldest-guid", CreatedAt: "2017-01-01T00:00:00Z"},
+						{GUID: "newest-guid", CreatedAt: "2017-01-03T00:00:00Z"},
+						{GUID: "older-guid", CreatedAt: "2017-01-02T00:00:00Z"},
+					},
+					ccv3.Warnings{"get-packages-warning"},
+					nil)
+			})
+
+			It("returns the packages beyond the most recent ones", func() {
+				packages, warnings, err := actor.GetExpiredPackagesByApplication("some-app-guid", 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+				Expect(packages).To(Equal([]Package{
+					{GUID: "older-guid", CreatedAt: "2017-01-02T00:00:00Z"},
+					{GUID: "oldest-guid", CreatedAt: "2017-01-01T00:00:00Z"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetPackagesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				))
+			})
+		})
+
+		When("the current droplet was staged from one of the packages", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]ccv3.Package{
+						{GUID: "oldest-guid", CreatedAt: "2017-01-01T00:00:00Z"},
+						{GUID: "newest-guid", CreatedAt: "2017-01-03T00:00:00Z"},
+						{GUID: "current-guid", CreatedAt: "2017-01-02T00:00:00Z"},
+					},
+					ccv3.Warnings{"get-packages-warning"},
+					nil)
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+					ccv3.Droplet{GUID: "current-droplet-guid", PackageGUID: "current-guid"},
+					ccv3.Warnings{"get-current-droplet-warning"},
+					nil)
+			})
+
+			It("never returns the package of the current droplet", func() {
+				packages, warnings, err := actor.GetExpiredPackagesByApplication("some-app-guid", 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-packages-warning", "get-current-droplet-warning"))
+				Expect(packages).To(Equal([]Package{
+					{GUID: "newest-guid", CreatedAt: "2017-01-03T00:00:00Z"},
+					{GUID: "oldest-guid", CreatedAt: "2017-01-01T00:00:00Z"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+
+			It("does not count the package of the current droplet towards the kept packages", func() {
+				packages, _, err := actor.GetExpiredPackagesByApplication("some-app-guid", 1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(packages).To(Equal([]Package{
+					{GUID: "oldest-guid", CreatedAt: "2017-01-01T00:00:00Z"},
+				}))
+			})
+		})
+
+		When("the application has no current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]ccv3.Package{{GUID: "some-guid", CreatedAt: "2017-01-01T00:00:00Z"}},
+					nil,
+					nil)
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, nil, ccerror.DropletNotFoundError{})
+			})
+
+			It("returns the packages beyond the most recent ones", func() {
+				packages, _, err := actor.GetExpiredPackagesByApplication("some-app-guid", 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(packages).To(Equal([]Package{{GUID: "some-guid", CreatedAt: "2017-01-01T00:00:00Z"}}))
+			})
+		})
+
+		When("getting the current droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(ccv3.Droplet{}, ccv3.Warnings{"get-current-droplet-warning"}, errors.New("get-droplet-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetExpiredPackagesByApplication("some-app-guid", 1)
+				Expect(err).To(MatchError("get-droplet-error"))
+				Expect(warnings).To(ConsistOf("get-current-droplet-warning"))
+			})
+		})
+
+		When("getting the packages fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackagesReturns(nil, ccv3.Warnings{"get-packages-warning"}, errors.New("get-packages-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetExpiredPackagesByApplication("some-app-guid", 1)
+				Expect(err).To(MatchError("get-packages-error"))
+				Expect(warnings).To(ConsistOf("get-packages-warning"))
+			})
+		})
+	})
+
+	Describe("DeletePackage", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeletePackage("some-package-guid")
+		})
+
+		When("sending the delete fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeletePackageReturns("", ccv3.Warnings{"some-delete-warning"}, errors.New("some-delete-error"))
+			})
+
+			It("returns the warnings and error", func() {
+				Expect(warnings).To(ConsistOf("some-delete-warning"))
+				Expect(executeErr).To(MatchError("some-delete-error"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		When("sending the delete succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeletePackageReturns("/some-job-url", ccv3.Warnings{"some-delete-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"some-poll-warning"}, nil)
+			})
+
+			It("polls the job and returns all the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-delete-warning", "some-poll-warning"))
+
+				Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("some-package-guid"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("/some-job-url")))
+			})
+		})
+	})
+
 	Describe("CreateDockerPackageByApplicationNameAndSpace", func() {
 		var (
 			dockerPackage Package
@@ -21,3 +21,20 @@ func (actor Actor) ScaleProcessByApplication(appGUID string, process Process) (W
 
 	return allWarnings, nil
 }
+
+// GetProcessSummaryByTypeAndApplication returns the process of the given type
+// for the provided application along with its instances.
+func (actor Actor) GetProcessSummaryByTypeAndApplication(processType string, appGUID string) (ProcessSummary, Warnings, error) {
+	process, warnings, err := actor.CloudControllerClient.GetApplicationProcessByType(appGUID, processType)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+			return ProcessSummary{}, allWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+		}
+		return ProcessSummary{}, allWarnings, err
+	}
+
+	processSummary, summaryWarnings, err := actor.getProcessSummary(Process(process))
+	allWarnings = append(allWarnings, summaryWarnings...)
+	return processSummary, allWarnings, err
+}
@@ -32,6 +32,16 @@ func (p ProcessSummary) HealthyInstanceCount() int {
 	return count
 }
 
+func (p ProcessSummary) CrashedInstanceCount() int {
+	count := 0
+	for _, instance := range p.InstanceDetails {
+		if instance.State == constant.ProcessInstanceCrashed {
+			count++
+		}
+	}
+	return count
+}
+
 func (ps ProcessSummaries) Sort() {
 	sort.Slice(ps, func(i int, j int) bool {
 		var iScore int
@@ -32,6 +32,25 @@ var _ = Describe("Process Actions", func() {
 				Expect(summary.HealthyInstanceCount()).To(Equal(2))
 			})
 		})
+
+		Describe("CrashedInstanceCount", func() {
+			It("returns 0 when no instances are CRASHED", func() {
+				Expect(summary.CrashedInstanceCount()).To(Equal(0))
+			})
+
+			When("some instances are CRASHED", func() {
+				BeforeEach(func() {
+					summary.InstanceDetails = append(summary.InstanceDetails,
+						ProcessInstance{State: constant.ProcessInstanceCrashed},
+						ProcessInstance{State: constant.ProcessInstanceCrashed},
+					)
+				})
+
+				It("returns the total number of CRASHED instances", func() {
+					Expect(summary.CrashedInstanceCount()).To(Equal(2))
+				})
+			})
+		})
 	})
 
 	Describe("ProcessSummaries", func() {
@@ -98,4 +98,76 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetProcessSummaryByTypeAndApplication", func() {
+		var (
+			summary    ProcessSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetProcessSummaryByTypeAndApplication("web", "some-app-guid")
+		})
+
+		When("getting the process and its instances succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					ccv3.Process{GUID: "some-process-guid", Type: "web", Instances: types.NullInt{Value: 2, IsSet: true}},
+					ccv3.Warnings{"get-process-warning"},
+					nil)
+				fakeCloudControllerClient.GetProcessInstancesReturns(
+					[]ccv3.ProcessInstance{
+						{State: constant.ProcessInstanceRunning},
+						{State: constant.ProcessInstanceCrashed},
+					},
+					ccv3.Warnings{"get-instances-warning"},
+					nil)
+			})
+
+			It("returns the process summary and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-process-warning", "get-instances-warning"))
+				Expect(summary.GUID).To(Equal("some-process-guid"))
+				Expect(summary.Instances).To(Equal(types.NullInt{Value: 2, IsSet: true}))
+				Expect(summary.CrashedInstanceCount()).To(Equal(1))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(1))
+				appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("web"))
+
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
+			})
+		})
+
+		When("the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					ccv3.Process{},
+					ccv3.Warnings{"get-process-warning"},
+					ccerror.ProcessNotFoundError{})
+			})
+
+			It("returns a ProcessNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessNotFoundError{ProcessType: "web"}))
+				Expect(warnings).To(ConsistOf("get-process-warning"))
+			})
+		})
+
+		When("getting the instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get-instances-error")
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-instances-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,14 @@
+package v3action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+// CloudControllerResponse represents an unprocessed Cloud Controller
+// response.
+type CloudControllerResponse ccv3.RawResponse
+
+// MakeCloudControllerRequest sends a request with the given method and body to
+// path on the Cloud Controller and returns the unprocessed response.
+func (actor Actor) MakeCloudControllerRequest(method string, path string, body []byte) (CloudControllerResponse, Warnings, error) {
+	response, warnings, err := actor.CloudControllerClient.MakeRequest(method, path, body)
+	return CloudControllerResponse(response), Warnings(warnings), err
+}
@@ -0,0 +1,69 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Raw Request Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("MakeCloudControllerRequest", func() {
+		var (
+			response   CloudControllerResponse
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			response, warnings, executeErr = actor.MakeCloudControllerRequest("POST", "/v3/spaces", []byte(`{"name":"some-space"}`))
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRequestReturns(
+					ccv3.RawResponse{StatusCode: http.StatusCreated, Body: []byte(`{"guid":"some-guid"}`)},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the response and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(response).To(Equal(CloudControllerResponse{StatusCode: http.StatusCreated, Body: []byte(`{"guid":"some-guid"}`)}))
+
+				Expect(fakeCloudControllerClient.MakeRequestCallCount()).To(Equal(1))
+				method, path, body := fakeCloudControllerClient.MakeRequestArgsForCall(0)
+				Expect(method).To(Equal("POST"))
+				Expect(path).To(Equal("/v3/spaces"))
+				Expect(body).To(Equal([]byte(`{"name":"some-space"}`)))
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRequestReturns(ccv3.RawResponse{}, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
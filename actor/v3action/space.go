@@ -55,3 +55,23 @@ func (actor Actor) GetSpaceByNameAndOrganization(spaceName string, orgGUID strin
 
 	return Space(spaces[0]), Warnings(warnings), nil
 }
+
+// GetSpacesByGUIDs returns the spaces with the given GUIDs.
+func (actor Actor) GetSpacesByGUIDs(spaceGUIDs ...string) ([]Space, Warnings, error) {
+	if len(spaceGUIDs) == 0 {
+		return nil, nil, nil
+	}
+
+	ccSpaces, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: spaceGUIDs},
+	)
+	if err != nil {
+		return []Space{}, Warnings(warnings), err
+	}
+
+	var spaces []Space
+	for _, ccSpace := range ccSpaces {
+		spaces = append(spaces, Space(ccSpace))
+	}
+	return spaces, Warnings(warnings), nil
+}
@@ -7,6 +7,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -220,4 +221,64 @@ var _ = Describe("Space", func() {
 		})
 
 	})
+
+	Describe("GetSpacesByGUIDs", func() {
+		When("the spaces exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{
+						{
+							GUID: "some-space-guid",
+							Name: "some-space",
+							Relationships: ccv3.Relationships{
+								constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "some-org-guid"},
+							},
+						},
+					},
+					ccv3.Warnings{"some-space-warning"}, nil)
+			})
+
+			It("returns the spaces with their organizations and warnings", func() {
+				spaces, warnings, err := actor.GetSpacesByGUIDs("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spaces).To(ConsistOf(Space{
+					GUID: "some-space-guid",
+					Name: "some-space",
+					Relationships: ccv3.Relationships{
+						constant.RelationshipTypeOrganization: ccv3.Relationship{GUID: "some-org-guid"},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("some-space-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		When("no GUIDs are given", func() {
+			It("does not request any spaces", func() {
+				spaces, _, err := actor.GetSpacesByGUIDs()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spaces).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the cloud controller client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					nil,
+					ccv3.Warnings{"some-space-warning"},
+					errors.New("cannot get spaces"))
+			})
+
+			It("returns an error and warnings", func() {
+				_, warnings, err := actor.GetSpacesByGUIDs("some-space-guid")
+				Expect(err).To(MatchError("cannot get spaces"))
+				Expect(warnings).To(ConsistOf("some-space-warning"))
+			})
+		})
+	})
 })
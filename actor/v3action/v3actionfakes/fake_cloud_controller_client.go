@@ -159,6 +159,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteDropletStub        func(dropletGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteDropletMutex       sync.RWMutex
+	deleteDropletArgsForCall []struct {
+		dropletGUID string
+	}
+	deleteDropletReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteDropletReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteIsolationSegmentStub        func(guid string) (ccv3.Warnings, error)
 	deleteIsolationSegmentMutex       sync.RWMutex
 	deleteIsolationSegmentArgsForCall []struct {
@@ -186,6 +201,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeletePackageStub        func(packageGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		packageGUID string
+	}
+	deletePackageReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteServiceInstanceRelationshipsSharedSpaceStub        func(serviceInstanceGUID string, sharedToSpaceGUID string) (ccv3.Warnings, error)
 	deleteServiceInstanceRelationshipsSharedSpaceMutex       sync.RWMutex
 	deleteServiceInstanceRelationshipsSharedSpaceArgsForCall []struct {
@@ -518,6 +548,23 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	MakeRequestStub        func(method string, path string, body []byte) (ccv3.RawResponse, ccv3.Warnings, error)
+	makeRequestMutex       sync.RWMutex
+	makeRequestArgsForCall []struct {
+		method string
+		path   string
+		body   []byte
+	}
+	makeRequestReturns struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}
+	makeRequestReturnsOnCall map[int]struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}
 	PatchApplicationProcessHealthCheckStub        func(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processHealthCheckInvocationTimeout int) (ccv3.Process, ccv3.Warnings, error)
 	patchApplicationProcessHealthCheckMutex       sync.RWMutex
 	patchApplicationProcessHealthCheckArgsForCall []struct {
@@ -1311,6 +1358,60 @@ func (fake *FakeCloudControllerClient) DeleteApplicationProcessInstanceReturnsOn
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteDroplet(dropletGUID string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteDropletMutex.Lock()
+	ret, specificReturn := fake.deleteDropletReturnsOnCall[len(fake.deleteDropletArgsForCall)]
+	fake.deleteDropletArgsForCall = append(fake.deleteDropletArgsForCall, struct {
+		dropletGUID string
+	}{dropletGUID})
+	fake.recordInvocation("DeleteDroplet", []interface{}{dropletGUID})
+	fake.deleteDropletMutex.Unlock()
+	if fake.DeleteDropletStub != nil {
+		return fake.DeleteDropletStub(dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deleteDropletReturns.result1, fake.deleteDropletReturns.result2, fake.deleteDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCallCount() int {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return len(fake.deleteDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletArgsForCall(i int) string {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return fake.deleteDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	fake.deleteDropletReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeleteDropletStub = nil
+	if fake.deleteDropletReturnsOnCall == nil {
+		fake.deleteDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteDropletReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteIsolationSegment(guid string) (ccv3.Warnings, error) {
 	fake.deleteIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.deleteIsolationSegmentReturnsOnCall[len(fake.deleteIsolationSegmentArgsForCall)]
@@ -1414,6 +1515,60 @@ func (fake *FakeCloudControllerClient) DeleteIsolationSegmentOrganizationReturns
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeletePackage(packageGUID string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.recordInvocation("DeletePackage", []interface{}{packageGUID})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(packageGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.deletePackageReturns.result1, fake.deletePackageReturns.result2, fake.deletePackageReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return fake.deletePackageArgsForCall[i].packageGUID
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpace(serviceInstanceGUID string, sharedToSpaceGUID string) (ccv3.Warnings, error) {
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall[len(fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall)]
@@ -2608,6 +2763,67 @@ func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) MakeRequest(method string, path string, body []byte) (ccv3.RawResponse, ccv3.Warnings, error) {
+	var bodyCopy []byte
+	if body != nil {
+		bodyCopy = make([]byte, len(body))
+		copy(bodyCopy, body)
+	}
+	fake.makeRequestMutex.Lock()
+	ret, specificReturn := fake.makeRequestReturnsOnCall[len(fake.makeRequestArgsForCall)]
+	fake.makeRequestArgsForCall = append(fake.makeRequestArgsForCall, struct {
+		method string
+		path   string
+		body   []byte
+	}{method, path, bodyCopy})
+	fake.recordInvocation("MakeRequest", []interface{}{method, path, bodyCopy})
+	fake.makeRequestMutex.Unlock()
+	if fake.MakeRequestStub != nil {
+		return fake.MakeRequestStub(method, path, body)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.makeRequestReturns.result1, fake.makeRequestReturns.result2, fake.makeRequestReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) MakeRequestCallCount() int {
+	fake.makeRequestMutex.RLock()
+	defer fake.makeRequestMutex.RUnlock()
+	return len(fake.makeRequestArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MakeRequestArgsForCall(i int) (string, string, []byte) {
+	fake.makeRequestMutex.RLock()
+	defer fake.makeRequestMutex.RUnlock()
+	return fake.makeRequestArgsForCall[i].method, fake.makeRequestArgsForCall[i].path, fake.makeRequestArgsForCall[i].body
+}
+
+func (fake *FakeCloudControllerClient) MakeRequestReturns(result1 ccv3.RawResponse, result2 ccv3.Warnings, result3 error) {
+	fake.MakeRequestStub = nil
+	fake.makeRequestReturns = struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) MakeRequestReturnsOnCall(i int, result1 ccv3.RawResponse, result2 ccv3.Warnings, result3 error) {
+	fake.MakeRequestStub = nil
+	if fake.makeRequestReturnsOnCall == nil {
+		fake.makeRequestReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RawResponse
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.makeRequestReturnsOnCall[i] = struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheck(processGUID string, processHealthCheckType string, processHealthCheckEndpoint string, processHealthCheckInvocationTimeout int) (ccv3.Process, ccv3.Warnings, error) {
 	fake.patchApplicationProcessHealthCheckMutex.Lock()
 	ret, specificReturn := fake.patchApplicationProcessHealthCheckReturnsOnCall[len(fake.patchApplicationProcessHealthCheckArgsForCall)]
@@ -3468,10 +3684,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteApplicationProcessInstanceMutex.RLock()
 	defer fake.deleteApplicationProcessInstanceMutex.RUnlock()
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
 	fake.deleteIsolationSegmentOrganizationMutex.RLock()
 	defer fake.deleteIsolationSegmentOrganizationMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
 	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
@@ -3516,6 +3736,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
+	fake.makeRequestMutex.RLock()
+	defer fake.makeRequestMutex.RUnlock()
 	fake.patchApplicationProcessHealthCheckMutex.RLock()
 	defer fake.patchApplicationProcessHealthCheckMutex.RUnlock()
 	fake.pollJobMutex.RLock()
@@ -0,0 +1,14 @@
+package ccerror
+
+import "fmt"
+
+// RecordingNotFoundError is returned when a replayed session has no recorded
+// response for a request.
+type RecordingNotFoundError struct {
+	Method string
+	URL    string
+}
+
+func (e RecordingNotFoundError) Error() string {
+	return fmt.Sprintf("No recorded response for %s %s", e.Method, e.URL)
+}
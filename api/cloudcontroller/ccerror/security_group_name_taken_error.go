@@ -0,0 +1,11 @@
+package ccerror
+
+// SecurityGroupNameTakenError is returned when a security group with the
+// requested name already exists in the Cloud Controller.
+type SecurityGroupNameTakenError struct {
+	Message string
+}
+
+func (e SecurityGroupNameTakenError) Error() string {
+	return e.Message
+}
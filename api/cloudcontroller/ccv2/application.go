@@ -83,6 +83,10 @@ type Application struct {
 
 	// State is the desired state of the application.
 	State constant.ApplicationState
+
+	// UpdatedAt is the last time the application was updated. If the
+	// application has never been updated, it is the creation time.
+	UpdatedAt time.Time
 }
 
 // MarshalJSON converts an application into a Cloud Controller Application.
@@ -219,6 +223,12 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	if ccApp.Entity.PackageUpdatedAt != nil {
 		application.PackageUpdatedAt = *ccApp.Entity.PackageUpdatedAt
 	}
+
+	if ccApp.Metadata.UpdatedAt != nil {
+		application.UpdatedAt = *ccApp.Metadata.UpdatedAt
+	} else {
+		application.UpdatedAt = ccApp.Metadata.CreatedAt
+	}
 	return nil
 }
 
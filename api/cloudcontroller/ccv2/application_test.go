@@ -179,6 +179,32 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("GetApplication with an updated_at timestamp", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "app-guid-1",
+					"updated_at": "2015-03-11T10:00:00Z"
+				},
+				"entity": {
+					"name": "app-name-1"
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/apps/app-guid-1"),
+					RespondWith(http.StatusOK, response),
+				),
+			)
+		})
+
+		It("returns the time the app was last updated", func() {
+			app, _, err := client.GetApplication("app-guid-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(app.UpdatedAt).To(Equal(time.Date(2015, 3, 11, 10, 0, 0, 0, time.UTC)))
+		})
+	})
+
 	Describe("GetApplications", func() {
 		BeforeEach(func() {
 			response1 := `{
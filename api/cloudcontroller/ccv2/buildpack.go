@@ -21,6 +21,10 @@ type Buildpack struct {
 	Name     string
 	Position types.NullInt
 	Stack    string
+
+	// Filename is the name of the file last uploaded as the buildpack's bits.
+	// It is ignored when creating or updating a buildpack.
+	Filename string
 }
 
 func (buildpack Buildpack) MarshalJSON() ([]byte, error) {
@@ -57,6 +61,7 @@ func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
 			Name     string         `json:"name"`
 			Position types.NullInt  `json:"position"`
 			Stack    string         `json:"stack"`
+			Filename string         `json:"filename"`
 		} `json:"entity"`
 	}
 
@@ -71,6 +76,7 @@ func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
 	buildpack.Name = alias.Entity.Name
 	buildpack.Position = alias.Entity.Position
 	buildpack.Stack = alias.Entity.Stack
+	buildpack.Filename = alias.Entity.Filename
 	return nil
 }
 
@@ -98,6 +104,21 @@ func (client *Client) CreateBuildpack(buildpack Buildpack) (Buildpack, Warnings,
 	return createdBuildpack, response.Warnings, err
 }
 
+// DeleteBuildpack deletes the buildpack with the provided GUID.
+func (client *Client) DeleteBuildpack(buildpackGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteBuildpackRequest,
+		URIParams:   Params{"buildpack_guid": buildpackGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetBuildpacks searches for a buildpack with the given name and returns it if it exists.
 func (client *Client) GetBuildpacks(filters ...Filter) ([]Buildpack, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -164,6 +164,47 @@ var _ = Describe("Buildpack", func() {
 		})
 	})
 
+	Describe("DeleteBuildpack", func() {
+		When("the buildpack exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/buildpacks/some-bp-guid"),
+						RespondWith(http.StatusNoContent, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the buildpack and returns all warnings", func() {
+				warnings, err := client.DeleteBuildpack("some-bp-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		When("the buildpack does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10000,
+					"description": "Unknown request",
+					"error_code": "CF-NotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/buildpacks/some-bp-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.DeleteBuildpack("some-bp-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Unknown request"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetBuildpacks", func() {
 		var (
 			buildpacks []Buildpack
@@ -221,7 +262,8 @@ var _ = Describe("Buildpack", func() {
 													"name": "some-bp-name3",
 													"stack": "cflinuxfs2",
 													"position": 4,
-													"enabled": true
+													"enabled": true,
+													"filename": "some-bp-name3.zip"
 												}
 											}
 										]
@@ -263,6 +305,7 @@ var _ = Describe("Buildpack", func() {
 						Enabled:  types.NullBool{IsSet: true, Value: true},
 						Position: types.NullInt{IsSet: true, Value: 4},
 						Stack:    "cflinuxfs2",
+						Filename: "some-bp-name3.zip",
 					},
 				}))
 
@@ -0,0 +1,24 @@
+package constant
+
+// UserRole represents a role a user has in an organization or space. The
+// value is the name of the organization or space endpoint listing the users
+// with the role.
+type UserRole string
+
+const (
+	// OrgUser is a member of an organization.
+	OrgUser UserRole = "users"
+	// OrgManager is an organization manager.
+	OrgManager UserRole = "managers"
+	// OrgBillingManager is an organization billing manager.
+	OrgBillingManager UserRole = "billing_managers"
+	// OrgAuditor is an organization auditor.
+	OrgAuditor UserRole = "auditors"
+
+	// SpaceManager is a space manager.
+	SpaceManager UserRole = "managers"
+	// SpaceDeveloper is a space developer.
+	SpaceDeveloper UserRole = "developers"
+	// SpaceAuditor is a space auditor.
+	SpaceAuditor UserRole = "auditors"
+)
@@ -1,6 +1,9 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
@@ -50,6 +53,37 @@ func (domain *Domain) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// CreatePrivateDomain creates a private domain owned by the organization.
+func (client *Client) CreatePrivateDomain(domainName string, orgGUID string) (Domain, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Name                   string `json:"name"`
+		OwningOrganizationGUID string `json:"owning_organization_guid"`
+	}{
+		Name:                   domainName,
+		OwningOrganizationGUID: orgGUID,
+	})
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostPrivateDomainRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	var domain Domain
+	response := cloudcontroller.Response{
+		Result: &domain,
+	}
+
+	err = client.connection.Make(request, &response)
+	domain.Type = constant.PrivateDomain
+	return domain, response.Warnings, err
+}
+
 // GetOrganizationPrivateDomains returns the private domains associated with an organization.
 func (client *Client) GetOrganizationPrivateDomains(orgGUID string, filters ...Filter) ([]Domain, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -528,4 +528,29 @@ var _ = Describe("Domain", func() {
 			})
 		})
 	})
+
+	Describe("CreatePrivateDomain", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {"guid": "some-domain-guid"},
+					"entity": {"name": "apps.example.com", "owning_organization_guid": "some-org-guid"}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/private_domains"),
+						VerifyJSON(`{"name":"apps.example.com","owning_organization_guid":"some-org-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("creates the private domain and returns it with warnings", func() {
+				domain, warnings, err := client.CreatePrivateDomain("apps.example.com", "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(domain).To(Equal(Domain{GUID: "some-domain-guid", Name: "apps.example.com", Type: constant.PrivateDomain}))
+			})
+		})
+	})
 })
@@ -14,7 +14,9 @@ type errorWrapper struct {
 	connection cloudcontroller.Connection
 }
 
-func newErrorWrapper() *errorWrapper {
+// NewErrorWrapper returns a new error wrapper. The client already converts the
+// errors of its connection; this is for wrappers that replace the connection.
+func NewErrorWrapper() ConnectionWrapper {
 	return new(errorWrapper)
 }
 
@@ -97,6 +99,8 @@ func handleBadRequest(errorResponse ccerror.V2ErrorResponse) error {
 		return ccerror.ServiceBindingTakenError{Message: errorResponse.Description}
 	case "CF-OrganizationNameTaken":
 		return ccerror.OrganizationNameTakenError{Message: errorResponse.Description}
+	case "CF-SecurityGroupNameTaken":
+		return ccerror.SecurityGroupNameTakenError{Message: errorResponse.Description}
 	default:
 		return ccerror.BadRequestError{Message: errorResponse.Description}
 	}
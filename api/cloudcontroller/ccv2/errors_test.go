@@ -196,6 +196,23 @@ var _ = Describe("Error Wrapper", func() {
 							}))
 						})
 					})
+
+					When("creating a security group fails because the name is taken", func() {
+						BeforeEach(func() {
+							serverResponse = `{
+								"code": 300005,
+								"description": "The security group name is taken: potato",
+								"error_code": "CF-SecurityGroupNameTaken"
+							  }`
+						})
+
+						It("returns a SecurityGroupNameTakenError", func() {
+							_, _, err := client.GetApplications()
+							Expect(err).To(MatchError(ccerror.SecurityGroupNameTakenError{
+								Message: "The security group name is taken: potato",
+							}))
+						})
+					})
 				})
 
 				Context("(401) Unauthorized", func() {
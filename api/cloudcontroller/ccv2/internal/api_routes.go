@@ -15,13 +15,18 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
+	DeleteBuildpackRequest                               = "DeleteBuildpack"
 	DeleteOrganizationRequest                            = "DeleteOrganization"
 	DeleteRouteAppRequest                                = "DeleteRouteApp"
 	DeleteRouteRequest                                   = "DeleteRoute"
 	DeleteSecurityGroupSpaceRequest                      = "DeleteSecurityGroupSpace"
 	DeleteSecurityGroupStagingSpaceRequest               = "DeleteSecurityGroupStagingSpace"
 	DeleteServiceBindingRequest                          = "DeleteServiceBinding"
+	DeleteServiceInstanceRequest                         = "DeleteServiceInstance"
+	DeleteServiceKeyRequest                              = "DeleteServiceKey"
 	DeleteSpaceRequest                                   = "DeleteSpace"
+	DeleteUserProvidedServiceInstanceRequest             = "DeleteUserProvidedServiceInstance"
+	DeleteUserRequest                                    = "DeleteUser"
 	GetAppInstancesRequest                               = "GetAppInstances"
 	GetAppRequest                                        = "GetApp"
 	GetAppRoutesRequest                                  = "GetAppRoutes"
@@ -29,14 +34,21 @@ const (
 	GetAppStatsRequest                                   = "GetAppStats"
 	GetBuildpacksRequest                                 = "GetBuildpacks"
 	GetConfigFeatureFlagsRequest                         = "GetConfigFeatureFlags"
+	GetConfigRunningSecurityGroupsRequest                = "GetConfigRunningSecurityGroups"
+	GetConfigStagingSecurityGroupsRequest                = "GetConfigStagingSecurityGroups"
 	GetEventsRequest                                     = "GetEvents"
 	GetInfoRequest                                       = "GetInfo"
 	GetJobRequest                                        = "GetJob"
+	GetOrganizationAuditorsRequest                       = "GetOrganizationAuditors"
+	GetOrganizationBillingManagersRequest                = "GetOrganizationBillingManagers"
+	GetOrganizationManagersRequest                       = "GetOrganizationManagers"
 	GetOrganizationPrivateDomainsRequest                 = "GetOrganizationPrivateDomains"
 	GetOrganizationQuotaDefinitionsRequest               = "GetOrganizationQuotaDefinitions"
 	GetOrganizationQuotaDefinitionRequest                = "GetOrganizationQuotaDefinition"
 	GetOrganizationRequest                               = "GetOrganization"
+	GetOrganizationSpaceQuotaDefinitionsRequest          = "GetOrganizationSpaceQuotaDefinitions"
 	GetOrganizationsRequest                              = "GetOrganizations"
+	GetOrganizationUsersRequest                          = "GetOrganizationUsers"
 	GetPrivateDomainRequest                              = "GetPrivateDomain"
 	GetPrivateDomainsRequest                             = "GetPrivateDomains"
 	GetRouteAppsRequest                                  = "GetRouteApps"
@@ -58,6 +70,7 @@ const (
 	GetServiceInstanceSharedFromRequest                  = "GetServiceInstanceSharedFrom"
 	GetServiceInstanceSharedToRequest                    = "GetServiceInstanceSharedTo"
 	GetServiceInstancesRequest                           = "GetServiceInstances"
+	GetServiceKeysRequest                                = "GetServiceKeys"
 	GetServicePlanRequest                                = "GetServicePlan"
 	GetServicePlansRequest                               = "GetServicePlans"
 	GetServicePlanVisibilitiesRequest                    = "GetServicePlanVisibilities"
@@ -65,6 +78,9 @@ const (
 	GetServicesRequest                                   = "GetServices"
 	GetSharedDomainRequest                               = "GetSharedDomain"
 	GetSharedDomainsRequest                              = "GetSharedDomains"
+	GetSpaceAuditorsRequest                              = "GetSpaceAuditors"
+	GetSpaceDevelopersRequest                            = "GetSpaceDevelopers"
+	GetSpaceManagersRequest                              = "GetSpaceManagers"
 	GetSpaceQuotaDefinitionRequest                       = "GetSpaceQuotaDefinition"
 	GetSpaceRoutesRequest                                = "GetSpaceRoutes"
 	GetSpaceSecurityGroupsRequest                        = "GetSpaceSecurityGroups"
@@ -80,19 +96,33 @@ const (
 	PostAppRestageRequest                                = "PostAppRestage"
 	PostBuildpackRequest                                 = "PostBuildpack"
 	PostOrganizationRequest                              = "PostOrganization"
+	PostPrivateDomainRequest                             = "PostPrivateDomain"
 	PostRouteRequest                                     = "PostRoute"
+	PostSecurityGroupRequest                             = "PostSecurityGroup"
 	PostServiceBindingRequest                            = "PostServiceBinding"
+	PostSpaceQuotaDefinitionRequest                      = "PostSpaceQuotaDefinition"
+	PostSpaceRequest                                     = "PostSpace"
 	PostUserRequest                                      = "PostUser"
 	PutAppBitsRequest                                    = "PutAppBits"
 	PutAppRequest                                        = "PutApp"
 	PutBuildpackRequest                                  = "PutBuildpack"
 	PutBuildpackBitsRequest                              = "PutBuildpackBits"
 	PutDropletRequest                                    = "PutDroplet"
+	PutOrganizationAuditorRequest                        = "PutOrganizationAuditor"
+	PutOrganizationBillingManagerRequest                 = "PutOrganizationBillingManager"
 	PutOrganizationManagerRequest                        = "PutOrganizationManager"
+	PutOrganizationRequest                               = "PutOrganization"
+	PutOrganizationUserRequest                           = "PutOrganizationUser"
 	PutResourceMatchRequest                              = "PutResourceMatch"
 	PutRouteAppRequest                                   = "PutRouteApp"
+	PutSecurityGroupRequest                              = "PutSecurityGroup"
 	PutSecurityGroupSpaceRequest                         = "PutSecurityGroupSpace"
 	PutSecurityGroupStagingSpaceRequest                  = "PutSecurityGroupStagingSpace"
+	PutSpaceAuditorRequest                               = "PutSpaceAuditor"
+	PutSpaceDeveloperRequest                             = "PutSpaceDeveloper"
+	PutSpaceManagerRequest                               = "PutSpaceManager"
+	PutSpaceQuotaDefinitionRequest                       = "PutSpaceQuotaDefinition"
+	PutSpaceQuotaDefinitionSpaceRequest                  = "PutSpaceQuotaDefinitionSpace"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
@@ -110,9 +140,12 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
 	{Path: "/v2/buildpacks", Method: http.MethodPost, Name: PostBuildpackRequest},
 	{Path: "/v2/buildpacks", Method: http.MethodGet, Name: GetBuildpacksRequest},
+	{Path: "/v2/buildpacks/:buildpack_guid", Method: http.MethodDelete, Name: DeleteBuildpackRequest},
 	{Path: "/v2/buildpacks/:buildpack_guid", Method: http.MethodPut, Name: PutBuildpackRequest},
 	{Path: "/v2/buildpacks/:buildpack_guid/bits", Method: http.MethodPut, Name: PutBuildpackBitsRequest},
 	{Path: "/v2/config/feature_flags", Method: http.MethodGet, Name: GetConfigFeatureFlagsRequest},
+	{Path: "/v2/config/running_security_groups", Method: http.MethodGet, Name: GetConfigRunningSecurityGroupsRequest},
+	{Path: "/v2/config/staging_security_groups", Method: http.MethodGet, Name: GetConfigStagingSecurityGroupsRequest},
 	{Path: "/v2/events", Method: http.MethodGet, Name: GetEventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
@@ -120,9 +153,19 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/organizations", Method: http.MethodPost, Name: PostOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodDelete, Name: DeleteOrganizationRequest},
 	{Path: "/v2/organizations/:organization_guid", Method: http.MethodGet, Name: GetOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid", Method: http.MethodPut, Name: PutOrganizationRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodGet, Name: GetOrganizationAuditorsRequest},
+	{Path: "/v2/organizations/:organization_guid/auditors", Method: http.MethodPut, Name: PutOrganizationAuditorRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodGet, Name: GetOrganizationBillingManagersRequest},
+	{Path: "/v2/organizations/:organization_guid/billing_managers", Method: http.MethodPut, Name: PutOrganizationBillingManagerRequest},
+	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodGet, Name: GetOrganizationManagersRequest},
 	{Path: "/v2/organizations/:organization_guid/managers", Method: http.MethodPut, Name: PutOrganizationManagerRequest},
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
+	{Path: "/v2/organizations/:organization_guid/space_quota_definitions", Method: http.MethodGet, Name: GetOrganizationSpaceQuotaDefinitionsRequest},
+	{Path: "/v2/organizations/:organization_guid/users", Method: http.MethodGet, Name: GetOrganizationUsersRequest},
+	{Path: "/v2/organizations/:organization_guid/users", Method: http.MethodPut, Name: PutOrganizationUserRequest},
 	{Path: "/v2/private_domains", Method: http.MethodGet, Name: GetPrivateDomainsRequest},
+	{Path: "/v2/private_domains", Method: http.MethodPost, Name: PostPrivateDomainRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
 	{Path: "/v2/quota_definitions", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionsRequest},
@@ -140,6 +183,8 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/routes/reserved/domain/:domain_guid", Method: http.MethodGet, Name: GetRouteReservedRequest},
 	{Path: "/v2/routes/reserved/domain/:domain_guid/host/:host", Method: http.MethodGet, Name: GetRouteReservedDeprecatedRequest},
 	{Path: "/v2/security_groups", Method: http.MethodGet, Name: GetSecurityGroupsRequest},
+	{Path: "/v2/security_groups", Method: http.MethodPost, Name: PostSecurityGroupRequest},
+	{Path: "/v2/security_groups/:security_group_guid", Method: http.MethodPut, Name: PutSecurityGroupRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces", Method: http.MethodGet, Name: GetSecurityGroupSpacesRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSecurityGroupSpaceRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSecurityGroupSpaceRequest},
@@ -152,10 +197,13 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodGet, Name: GetServiceBindingRequest},
 	{Path: "/v2/service_brokers", Method: http.MethodGet, Name: GetServiceBrokersRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
+	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodDelete, Name: DeleteServiceInstanceRequest},
 	{Path: "/v2/service_instances/:service_instance_guid", Method: http.MethodGet, Name: GetServiceInstanceRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetServiceInstanceServiceBindingsRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_from", Method: http.MethodGet, Name: GetServiceInstanceSharedFromRequest},
 	{Path: "/v2/service_instances/:service_instance_guid/shared_to", Method: http.MethodGet, Name: GetServiceInstanceSharedToRequest},
+	{Path: "/v2/service_keys", Method: http.MethodGet, Name: GetServiceKeysRequest},
+	{Path: "/v2/service_keys/:service_key_guid", Method: http.MethodDelete, Name: DeleteServiceKeyRequest},
 	{Path: "/v2/service_plan_visibilities", Method: http.MethodGet, Name: GetServicePlanVisibilitiesRequest},
 	{Path: "/v2/service_plans", Method: http.MethodGet, Name: GetServicePlansRequest},
 	{Path: "/v2/service_plans/:service_plan_guid", Method: http.MethodGet, Name: GetServicePlanRequest},
@@ -163,16 +211,28 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/services/:service_guid", Method: http.MethodGet, Name: GetServiceRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
 	{Path: "/v2/shared_domains/:shared_domain_guid", Method: http.MethodGet, Name: GetSharedDomainRequest},
+	{Path: "/v2/space_quota_definitions", Method: http.MethodPost, Name: PostSpaceQuotaDefinitionRequest},
 	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodGet, Name: GetSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid", Method: http.MethodPut, Name: PutSpaceQuotaDefinitionRequest},
+	{Path: "/v2/space_quota_definitions/:space_quota_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSpaceQuotaDefinitionSpaceRequest},
 	{Path: "/v2/spaces", Method: http.MethodGet, Name: GetSpacesRequest},
+	{Path: "/v2/spaces", Method: http.MethodPost, Name: PostSpaceRequest},
 	{Path: "/v2/spaces/:guid/service_instances", Method: http.MethodGet, Name: GetSpaceServiceInstancesRequest},
 	{Path: "/v2/spaces/:space_guid", Method: http.MethodDelete, Name: DeleteSpaceRequest},
+	{Path: "/v2/spaces/:space_guid/auditors", Method: http.MethodGet, Name: GetSpaceAuditorsRequest},
+	{Path: "/v2/spaces/:space_guid/auditors", Method: http.MethodPut, Name: PutSpaceAuditorRequest},
+	{Path: "/v2/spaces/:space_guid/developers", Method: http.MethodGet, Name: GetSpaceDevelopersRequest},
+	{Path: "/v2/spaces/:space_guid/developers", Method: http.MethodPut, Name: PutSpaceDeveloperRequest},
+	{Path: "/v2/spaces/:space_guid/managers", Method: http.MethodGet, Name: GetSpaceManagersRequest},
+	{Path: "/v2/spaces/:space_guid/managers", Method: http.MethodPut, Name: PutSpaceManagerRequest},
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
 	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
 	{Path: "/v2/user_provided_service_instances", Method: http.MethodGet, Name: GetUserProvidedServiceInstancesRequest},
+	{Path: "/v2/user_provided_service_instances/:user_provided_service_instance_guid", Method: http.MethodDelete, Name: DeleteUserProvidedServiceInstanceRequest},
 	{Path: "/v2/user_provided_service_instances/:user_provided_service_instance_guid/service_bindings", Method: http.MethodGet, Name: GetUserProvidedServiceInstanceServiceBindingsRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: PostUserRequest},
+	{Path: "/v2/users/:user_guid", Method: http.MethodDelete, Name: DeleteUserRequest},
 }
@@ -186,3 +186,32 @@ func (client *Client) UpdateOrganizationManagerByUsername(guid string, username
 
 	return response.Warnings, err
 }
+
+// UpdateOrganizationQuota assigns the organization quota to the organization.
+func (client *Client) UpdateOrganizationQuota(orgGUID string, quotaGUID string) (Organization, Warnings, error) {
+	body, err := json.Marshal(struct {
+		QuotaDefinitionGUID string `json:"quota_definition_guid"`
+	}{
+		QuotaDefinitionGUID: quotaGUID,
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutOrganizationRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return Organization{}, nil, err
+	}
+
+	var org Organization
+	response := cloudcontroller.Response{
+		Result: &org,
+	}
+
+	err = client.connection.Make(request, &response)
+	return org, response.Warnings, err
+}
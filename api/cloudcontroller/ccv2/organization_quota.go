@@ -14,6 +14,30 @@ type OrganizationQuota struct {
 
 	// Name is the name of the OrganizationQuota.
 	Name string
+
+	// MemoryLimit is the total memory in megabytes the apps in the organization
+	// can use.
+	MemoryLimit int
+
+	// InstanceMemoryLimit is the memory in megabytes a single app instance can
+	// use. -1 is unlimited.
+	InstanceMemoryLimit int
+
+	// TotalRoutes is the number of routes the organization can have. -1 is
+	// unlimited.
+	TotalRoutes int
+
+	// TotalServices is the number of service instances the organization can
+	// have. -1 is unlimited.
+	TotalServices int
+
+	// AppInstanceLimit is the number of app instances the organization can run.
+	// -1 is unlimited.
+	AppInstanceLimit int
+
+	// TotalReservedRoutePorts is the number of TCP route ports the organization
+	// can reserve. -1 is unlimited.
+	TotalReservedRoutePorts int
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller organization quota response.
@@ -21,7 +45,13 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 	var ccOrgQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                    string `json:"name"`
+			MemoryLimit             int    `json:"memory_limit"`
+			InstanceMemoryLimit     int    `json:"instance_memory_limit"`
+			TotalRoutes             int    `json:"total_routes"`
+			TotalServices           int    `json:"total_services"`
+			AppInstanceLimit        int    `json:"app_instance_limit"`
+			TotalReservedRoutePorts int    `json:"total_reserved_route_ports"`
 		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccOrgQuota)
@@ -31,6 +61,12 @@ func (application *OrganizationQuota) UnmarshalJSON(data []byte) error {
 
 	application.GUID = ccOrgQuota.Metadata.GUID
 	application.Name = ccOrgQuota.Entity.Name
+	application.MemoryLimit = ccOrgQuota.Entity.MemoryLimit
+	application.InstanceMemoryLimit = ccOrgQuota.Entity.InstanceMemoryLimit
+	application.TotalRoutes = ccOrgQuota.Entity.TotalRoutes
+	application.TotalServices = ccOrgQuota.Entity.TotalServices
+	application.AppInstanceLimit = ccOrgQuota.Entity.AppInstanceLimit
+	application.TotalReservedRoutePorts = ccOrgQuota.Entity.TotalReservedRoutePorts
 
 	return nil
 }
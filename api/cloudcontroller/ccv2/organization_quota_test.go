@@ -27,7 +27,13 @@ var _ = Describe("OrganizationQuota", func() {
 					"guid": "some-org-quota-guid"
 				},
 				"entity": {
-					"name": "some-org-quota"
+					"name": "some-org-quota",
+					"memory_limit": 10240,
+					"instance_memory_limit": -1,
+					"total_routes": 1000,
+					"total_services": 100,
+					"app_instance_limit": -1,
+					"total_reserved_route_ports": 5
 				}
 			}`
 				server.AppendHandlers(
@@ -43,8 +49,14 @@ var _ = Describe("OrganizationQuota", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(Equal(Warnings{"warning-1"}))
 				Expect(orgQuota).To(Equal(OrganizationQuota{
-					GUID: "some-org-quota-guid",
-					Name: "some-org-quota",
+					GUID:                    "some-org-quota-guid",
+					Name:                    "some-org-quota",
+					MemoryLimit:             10240,
+					InstanceMemoryLimit:     -1,
+					TotalRoutes:             1000,
+					TotalServices:           100,
+					AppInstanceLimit:        -1,
+					TotalReservedRoutePorts: 5,
 				}))
 			})
 		})
@@ -361,4 +361,28 @@ var _ = Describe("Organization", func() {
 		})
 	})
 
+	Describe("UpdateOrganizationQuota", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {"guid": "some-org-guid"},
+					"entity": {"name": "some-org", "quota_definition_guid": "some-quota-guid"}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid"),
+						VerifyJSON(`{"quota_definition_guid":"some-quota-guid"}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("assigns the quota and returns the organization with warnings", func() {
+				org, warnings, err := client.UpdateOrganizationQuota("some-org-guid", "some-quota-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(org).To(Equal(Organization{GUID: "some-org-guid", Name: "some-org", QuotaDefinitionGUID: "some-quota-guid"}))
+			})
+		})
+	})
 })
@@ -35,6 +35,11 @@ type requestOptions struct {
 
 // newHTTPRequest returns a constructed HTTP.Request with some defaults.
 // Defaults are applied when Request fields are not filled in.
+//
+// V2 requests are never marked as idempotent. Every V2 POST creates a resource
+// or restages an app, so repeating it after a lost response would create a
+// duplicate or restage twice. Resource matching only reads and is a PUT, so it
+// is retried like the other non-POST requests.
 func (client Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontroller.Request, error) {
 	var request *http.Request
 	var err error
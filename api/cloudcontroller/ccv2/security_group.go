@@ -1,9 +1,13 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // SecurityGroup represents a Cloud Controller Security Group.
@@ -22,6 +26,11 @@ type SecurityGroup struct {
 	StagingDefault bool
 }
 
+type securityGroupRequestBody struct {
+	Name  string              `json:"name,omitempty"`
+	Rules []SecurityGroupRule `json:"rules"`
+}
+
 // UnmarshalJSON helps unmarshal a Cloud Controller Security Group response
 func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 	var ccSecurityGroup struct {
@@ -30,10 +39,13 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 			GUID  string `json:"guid"`
 			Name  string `json:"name"`
 			Rules []struct {
-				Description string `json:"description"`
-				Destination string `json:"destination"`
-				Ports       string `json:"ports"`
-				Protocol    string `json:"protocol"`
+				Description string         `json:"description"`
+				Destination string         `json:"destination"`
+				Ports       string         `json:"ports"`
+				Protocol    string         `json:"protocol"`
+				Type        types.NullInt  `json:"type"`
+				Code        types.NullInt  `json:"code"`
+				Log         types.NullBool `json:"log"`
 			} `json:"rules"`
 			RunningDefault bool `json:"running_default"`
 			StagingDefault bool `json:"staging_default"`
@@ -52,12 +64,43 @@ func (securityGroup *SecurityGroup) UnmarshalJSON(data []byte) error {
 		securityGroup.Rules[i].Destination = ccRule.Destination
 		securityGroup.Rules[i].Ports = ccRule.Ports
 		securityGroup.Rules[i].Protocol = ccRule.Protocol
+		securityGroup.Rules[i].Type = ccRule.Type
+		securityGroup.Rules[i].Code = ccRule.Code
+		securityGroup.Rules[i].Log = ccRule.Log
 	}
 	securityGroup.RunningDefault = ccSecurityGroup.Entity.RunningDefault
 	securityGroup.StagingDefault = ccSecurityGroup.Entity.StagingDefault
 	return nil
 }
 
+// CreateSecurityGroup creates a Security Group with the provided name and
+// rules.
+func (client *Client) CreateSecurityGroup(name string, rules []SecurityGroupRule) (SecurityGroup, Warnings, error) {
+	bodyBytes, err := json.Marshal(securityGroupRequestBody{
+		Name:  name,
+		Rules: rules,
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSecurityGroupRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	var securityGroup SecurityGroup
+	response := cloudcontroller.Response{
+		Result: &securityGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return securityGroup, response.Warnings, err
+}
+
 // DeleteSecurityGroupSpace disassociates a security group in the running phase
 // for the lifecycle, specified by its GUID, from a space, which is also
 // specified by its GUID.
@@ -102,6 +145,18 @@ func (client *Client) DeleteSecurityGroupStagingSpace(securityGroupGUID string,
 	return response.Warnings, err
 }
 
+// GetConfigRunningSecurityGroups returns the Security Groups that are applied
+// to all running apps in the CF instance.
+func (client *Client) GetConfigRunningSecurityGroups() ([]SecurityGroup, Warnings, error) {
+	return client.getConfigSecurityGroupsByLifecycle(internal.GetConfigRunningSecurityGroupsRequest)
+}
+
+// GetConfigStagingSecurityGroups returns the Security Groups that are applied
+// to all staging apps in the CF instance.
+func (client *Client) GetConfigStagingSecurityGroups() ([]SecurityGroup, Warnings, error) {
+	return client.getConfigSecurityGroupsByLifecycle(internal.GetConfigStagingSecurityGroupsRequest)
+}
+
 // GetSecurityGroups returns a list of Security Groups based off the provided
 // filters.
 func (client *Client) GetSecurityGroups(filters ...Filter) ([]SecurityGroup, Warnings, error) {
@@ -142,6 +197,34 @@ func (client *Client) GetSpaceStagingSecurityGroups(spaceGUID string, filters ..
 	return client.getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID, internal.GetSpaceStagingSecurityGroupsRequest, filters)
 }
 
+// UpdateSecurityGroup replaces the rules of the Security Group with the
+// provided GUID.
+func (client *Client) UpdateSecurityGroup(guid string, rules []SecurityGroupRule) (SecurityGroup, Warnings, error) {
+	bodyBytes, err := json.Marshal(securityGroupRequestBody{
+		Rules: rules,
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSecurityGroupRequest,
+		URIParams:   Params{"security_group_guid": guid},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return SecurityGroup{}, nil, err
+	}
+
+	var securityGroup SecurityGroup
+	response := cloudcontroller.Response{
+		Result: &securityGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	return securityGroup, response.Warnings, err
+}
+
 // UpdateSecurityGroupSpace associates a security group in the running phase
 // for the lifecycle, specified by its GUID, from a space, which is also
 // specified by its GUID.
@@ -211,3 +294,27 @@ func (client *Client) getSpaceSecurityGroupsBySpaceAndLifecycle(spaceGUID string
 
 	return securityGroupsList, warnings, err
 }
+
+func (client *Client) getConfigSecurityGroupsByLifecycle(lifecycle string) ([]SecurityGroup, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: lifecycle,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var securityGroupsList []SecurityGroup
+	warnings, err := client.paginate(request, SecurityGroup{}, func(item interface{}) error {
+		if securityGroup, ok := item.(SecurityGroup); ok {
+			securityGroupsList = append(securityGroupsList, securityGroup)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SecurityGroup{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return securityGroupsList, warnings, err
+}
@@ -1,5 +1,11 @@
 package ccv2
 
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/types"
+)
+
 // SecurityGroupRule represents a Cloud Controller Security Group Role.
 type SecurityGroupRule struct {
 	// Description is a short message discribing the rule.
@@ -13,4 +19,44 @@ type SecurityGroupRule struct {
 
 	// Protocol can be tcp, icmp, udp, all.
 	Protocol string
+
+	// Type is the ICMP type, only used by the icmp protocol.
+	Type types.NullInt
+
+	// Code is the ICMP code, only used by the icmp protocol.
+	Code types.NullInt
+
+	// Log enables logging of the packets that match the rule.
+	Log types.NullBool
+}
+
+// MarshalJSON converts a security group rule into a Cloud Controller security
+// group rule.
+func (rule SecurityGroupRule) MarshalJSON() ([]byte, error) {
+	ccRule := struct {
+		Description string `json:"description,omitempty"`
+		Destination string `json:"destination"`
+		Ports       string `json:"ports,omitempty"`
+		Protocol    string `json:"protocol"`
+		Type        *int   `json:"type,omitempty"`
+		Code        *int   `json:"code,omitempty"`
+		Log         *bool  `json:"log,omitempty"`
+	}{
+		Description: rule.Description,
+		Destination: rule.Destination,
+		Ports:       rule.Ports,
+		Protocol:    rule.Protocol,
+	}
+
+	if rule.Type.IsSet {
+		ccRule.Type = &rule.Type.Value
+	}
+	if rule.Code.IsSet {
+		ccRule.Code = &rule.Code.Value
+	}
+	if rule.Log.IsSet {
+		ccRule.Log = &rule.Log.Value
+	}
+
+	return json.Marshal(ccRule)
 }
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -18,6 +19,92 @@ var _ = Describe("Security Groups", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CreateSecurityGroup", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"name": "some-security-group",
+					"rules": []map[string]interface{}{
+						{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "80,443", "description": "web"},
+						{"protocol": "icmp", "destination": "10.0.0.1", "type": 0, "code": -1, "log": true},
+					},
+				}
+				response := `{
+					"metadata": {
+						"guid": "some-security-group-guid"
+					},
+					"entity": {
+						"name": "some-security-group",
+						"rules": [
+							{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "80,443", "description": "web"},
+							{"protocol": "icmp", "destination": "10.0.0.1", "type": 0, "code": -1, "log": true}
+						]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("creates the security group and returns it with all warnings", func() {
+				securityGroup, warnings, err := client.CreateSecurityGroup("some-security-group", []SecurityGroupRule{
+					{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80,443", Description: "web"},
+					{
+						Protocol:    "icmp",
+						Destination: "10.0.0.1",
+						Type:        types.NullInt{IsSet: true, Value: 0},
+						Code:        types.NullInt{IsSet: true, Value: -1},
+						Log:         types.NullBool{IsSet: true, Value: true},
+					},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID: "some-security-group-guid",
+					Name: "some-security-group",
+					Rules: []SecurityGroupRule{
+						{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80,443", Description: "web"},
+						{
+							Protocol:    "icmp",
+							Destination: "10.0.0.1",
+							Type:        types.NullInt{IsSet: true, Value: 0},
+							Code:        types.NullInt{IsSet: true, Value: -1},
+							Log:         types.NullBool{IsSet: true, Value: true},
+						},
+					},
+				}))
+			})
+		})
+
+		When("the name is taken", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 300005,
+					"description": "The security group name is taken: some-security-group",
+					"error_code": "CF-SecurityGroupNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/security_groups"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns a SecurityGroupNameTakenError and all warnings", func() {
+				_, warnings, err := client.CreateSecurityGroup("some-security-group", nil)
+
+				Expect(err).To(MatchError(ccerror.SecurityGroupNameTakenError{
+					Message: "The security group name is taken: some-security-group",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("DeleteSecurityGroupSpace", func() {
 		var (
 			warnings Warnings
@@ -124,6 +211,216 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("GetConfigRunningSecurityGroups", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/config/running_security_groups?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "security-group-guid-1"
+							},
+							"entity": {
+								"name": "security-group-1",
+								"rules": [
+									{
+										"protocol": "tcp",
+										"ports": "53",
+										"destination": "10.0.0.0/8"
+									}
+								],
+								"running_default": true
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "security-group-guid-2"
+							},
+							"entity": {
+								"name": "security-group-2",
+								"rules": [],
+								"running_default": true
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/running_security_groups"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/running_security_groups", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					))
+			})
+
+			It("returns all the security groups and all warnings", func() {
+				securityGroups, warnings, err := client.GetConfigRunningSecurityGroups()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(securityGroups).To(Equal([]SecurityGroup{
+					{
+						GUID: "security-group-guid-1",
+						Name: "security-group-1",
+						Rules: []SecurityGroupRule{
+							{Protocol: "tcp", Ports: "53", Destination: "10.0.0.0/8"},
+						},
+						RunningDefault: true,
+					},
+					{
+						GUID:           "security-group-guid-2",
+						Name:           "security-group-2",
+						Rules:          []SecurityGroupRule{},
+						RunningDefault: true,
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		When("an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/running_security_groups"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.GetConfigRunningSecurityGroups()
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
+	Describe("GetConfigStagingSecurityGroups", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/config/staging_security_groups?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "security-group-guid-1"
+							},
+							"entity": {
+								"name": "security-group-1",
+								"rules": [
+									{
+										"protocol": "tcp",
+										"ports": "53",
+										"destination": "10.0.0.0/8"
+									}
+								],
+								"staging_default": true
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "security-group-guid-2"
+							},
+							"entity": {
+								"name": "security-group-2",
+								"rules": [],
+								"staging_default": true
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/staging_security_groups"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/staging_security_groups", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					))
+			})
+
+			It("returns all the security groups and all warnings", func() {
+				securityGroups, warnings, err := client.GetConfigStagingSecurityGroups()
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(securityGroups).To(Equal([]SecurityGroup{
+					{
+						GUID: "security-group-guid-1",
+						Name: "security-group-1",
+						Rules: []SecurityGroupRule{
+							{Protocol: "tcp", Ports: "53", Destination: "10.0.0.0/8"},
+						},
+						StagingDefault: true,
+					},
+					{
+						GUID:           "security-group-guid-2",
+						Name:           "security-group-2",
+						Rules:          []SecurityGroupRule{},
+						StagingDefault: true,
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		When("an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/config/staging_security_groups"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.GetConfigStagingSecurityGroups()
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("GetSecurityGroups", func() {
 		When("no errors are encountered", func() {
 			When("results are paginated", func() {
@@ -615,6 +912,80 @@ var _ = Describe("Security Groups", func() {
 		})
 	})
 
+	Describe("UpdateSecurityGroup", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				expectedBody := map[string]interface{}{
+					"rules": []map[string]interface{}{
+						{"protocol": "udp", "destination": "10.0.0.1-10.0.0.9", "ports": "53"},
+					},
+				}
+				response := `{
+					"metadata": {
+						"guid": "some-security-group-guid"
+					},
+					"entity": {
+						"name": "some-security-group",
+						"rules": [
+							{"protocol": "udp", "destination": "10.0.0.1-10.0.0.9", "ports": "53"}
+						]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/security_groups/some-security-group-guid"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					))
+			})
+
+			It("replaces the rules and returns the security group with all warnings", func() {
+				securityGroup, warnings, err := client.UpdateSecurityGroup("some-security-group-guid", []SecurityGroupRule{
+					{Protocol: "udp", Destination: "10.0.0.1-10.0.0.9", Ports: "53"},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(securityGroup).To(Equal(SecurityGroup{
+					GUID: "some-security-group-guid",
+					Name: "some-security-group",
+					Rules: []SecurityGroupRule{
+						{Protocol: "udp", Destination: "10.0.0.1-10.0.0.9", Ports: "53"},
+					},
+				}))
+			})
+		})
+
+		When("an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/security_groups/some-security-group-guid"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.UpdateSecurityGroup("some-security-group-guid", nil)
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("UpdateSecurityGroupSpace", func() {
 		When("no errors are encountered", func() {
 			BeforeEach(func() {
@@ -1,6 +1,8 @@
 package ccv2
 
 import (
+	"net/url"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
@@ -85,6 +87,39 @@ func (serviceInstance ServiceInstance) UserProvided() bool {
 	return serviceInstance.Type == constant.ServiceInstanceTypeUserProvidedService
 }
 
+// DeleteServiceInstance deletes the managed service instance with the given
+// GUID. The broker is allowed to perform the deletion asynchronously.
+func (client *Client) DeleteServiceInstance(serviceInstanceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": serviceInstanceGUID},
+		Query:       url.Values{"accepts_incomplete": {"true"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// DeleteUserProvidedServiceInstance deletes the user provided service instance
+// with the given GUID.
+func (client *Client) DeleteUserProvidedServiceInstance(userProvidedServiceInstanceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteUserProvidedServiceInstanceRequest,
+		URIParams:   Params{"user_provided_service_instance_guid": userProvidedServiceInstanceGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetServiceInstance returns the service instance with the given GUID. This
 // service can be either a managed or user provided.
 func (client *Client) GetServiceInstance(serviceInstanceGUID string) (ServiceInstance, Warnings, error) {
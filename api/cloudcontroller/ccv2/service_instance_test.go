@@ -121,6 +121,40 @@ var _ = Describe("Service Instance", func() {
 		})
 	})
 
+	Describe("DeleteServiceInstance", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-guid", "accepts_incomplete=true"),
+					RespondWith(http.StatusAccepted, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the service instance and returns all warnings", func() {
+			warnings, err := client.DeleteServiceInstance("some-service-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("DeleteUserProvidedServiceInstance", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/user_provided_service_instances/some-ups-guid"),
+					RespondWith(http.StatusNoContent, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the user provided service instance and returns all warnings", func() {
+			warnings, err := client.DeleteUserProvidedServiceInstance("some-ups-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
 	Describe("GetServiceInstance", func() {
 		BeforeEach(func() {
 			response := `{
@@ -0,0 +1,132 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Key", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("DeleteServiceKey", func() {
+		When("the service key exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+						RespondWith(http.StatusNoContent, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the service key and returns all warnings", func() {
+				warnings, err := client.DeleteServiceKey("some-service-key-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		When("the service key does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 360003,
+					"description": "The service key could not be found: some-service-key-guid",
+					"error_code": "CF-ServiceKeyNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/service_keys/some-service-key-guid"),
+						RespondWith(http.StatusNotFound, response),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := client.DeleteServiceKey("some-service-key-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{
+					Message: "The service key could not be found: some-service-key-guid",
+				}))
+			})
+		})
+	})
+
+	Describe("GetServiceKeys", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_keys?q=service_instance_guid:some-instance-guid&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "service-key-guid-1",
+							"created_at": "2017-01-01T00:00:00Z"
+						},
+						"entity": {
+							"name": "key-1",
+							"service_instance_guid": "some-instance-guid"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "service-key-guid-2",
+							"created_at": "2018-01-01T00:00:00Z"
+						},
+						"entity": {
+							"name": "key-2",
+							"service_instance_guid": "some-instance-guid"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-instance-guid"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_keys", "q=service_instance_guid:some-instance-guid&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried service keys", func() {
+			serviceKeys, warnings, err := client.GetServiceKeys(Filter{
+				Type:     constant.ServiceInstanceGUIDFilter,
+				Operator: constant.EqualOperator,
+				Values:   []string{"some-instance-guid"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceKeys).To(ConsistOf(
+				ServiceKey{
+					GUID:                "service-key-guid-1",
+					Name:                "key-1",
+					ServiceInstanceGUID: "some-instance-guid",
+					CreatedAt:           time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+				ServiceKey{
+					GUID:                "service-key-guid-2",
+					Name:                "key-2",
+					ServiceInstanceGUID: "some-instance-guid",
+					CreatedAt:           time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+})
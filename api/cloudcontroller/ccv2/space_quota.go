@@ -1,7 +1,11 @@
 package ccv2
 
 import (
+	"bytes"
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -14,6 +18,66 @@ type SpaceQuota struct {
 
 	// Name is the name given to the space quota.
 	Name string
+
+	// OrganizationGUID is the unique identifier of the organization the space
+	// quota is defined in.
+	OrganizationGUID string
+
+	// MemoryLimit is the total memory in megabytes the apps in the space can
+	// use.
+	MemoryLimit int
+
+	// InstanceMemoryLimit is the memory in megabytes a single app instance can
+	// use. -1 is unlimited.
+	InstanceMemoryLimit int
+
+	// TotalRoutes is the number of routes the space can have. -1 is unlimited.
+	TotalRoutes int
+
+	// TotalServices is the number of service instances the space can have. -1
+	// is unlimited.
+	TotalServices int
+
+	// AppInstanceLimit is the number of app instances the space can run. -1 is
+	// unlimited.
+	AppInstanceLimit int
+
+	// TotalReservedRoutePorts is the number of TCP route ports the space can
+	// reserve. -1 is unlimited. It is read only, so that creating and updating
+	// a space quota keeps the Cloud Controller's value.
+	TotalReservedRoutePorts int
+
+	// NonBasicServicesAllowed specifies whether service instances of paid plans
+	// can be created in the space.
+	NonBasicServicesAllowed bool
+}
+
+// MarshalJSON converts a space quota into a Cloud Controller space quota
+// definition.
+func (spaceQuota SpaceQuota) MarshalJSON() ([]byte, error) {
+	ccSpaceQuota := struct {
+		Name                    string `json:"name"`
+		OrganizationGUID        string `json:"organization_guid,omitempty"`
+		MemoryLimit             int    `json:"memory_limit"`
+		InstanceMemoryLimit     int    `json:"instance_memory_limit"`
+		TotalRoutes             int    `json:"total_routes"`
+		TotalServices           int    `json:"total_services"`
+		AppInstanceLimit        int    `json:"app_instance_limit"`
+		TotalReservedRoutePorts int    `json:"total_reserved_route_ports"`
+		NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
+	}{
+		Name:                    spaceQuota.Name,
+		OrganizationGUID:        spaceQuota.OrganizationGUID,
+		MemoryLimit:             spaceQuota.MemoryLimit,
+		InstanceMemoryLimit:     spaceQuota.InstanceMemoryLimit,
+		TotalRoutes:             spaceQuota.TotalRoutes,
+		TotalServices:           spaceQuota.TotalServices,
+		AppInstanceLimit:        spaceQuota.AppInstanceLimit,
+		TotalReservedRoutePorts: spaceQuota.TotalReservedRoutePorts,
+		NonBasicServicesAllowed: spaceQuota.NonBasicServicesAllowed,
+	}
+
+	return json.Marshal(ccSpaceQuota)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Space Quota response.
@@ -21,7 +85,15 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 	var ccSpaceQuota struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Name string `json:"name"`
+			Name                    string `json:"name"`
+			OrganizationGUID        string `json:"organization_guid"`
+			MemoryLimit             int    `json:"memory_limit"`
+			InstanceMemoryLimit     int    `json:"instance_memory_limit"`
+			TotalRoutes             int    `json:"total_routes"`
+			TotalServices           int    `json:"total_services"`
+			AppInstanceLimit        int    `json:"app_instance_limit"`
+			TotalReservedRoutePorts int    `json:"total_reserved_route_ports"`
+			NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
 		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccSpaceQuota)
@@ -31,9 +103,69 @@ func (spaceQuota *SpaceQuota) UnmarshalJSON(data []byte) error {
 
 	spaceQuota.GUID = ccSpaceQuota.Metadata.GUID
 	spaceQuota.Name = ccSpaceQuota.Entity.Name
+	spaceQuota.OrganizationGUID = ccSpaceQuota.Entity.OrganizationGUID
+	spaceQuota.MemoryLimit = ccSpaceQuota.Entity.MemoryLimit
+	spaceQuota.InstanceMemoryLimit = ccSpaceQuota.Entity.InstanceMemoryLimit
+	spaceQuota.TotalRoutes = ccSpaceQuota.Entity.TotalRoutes
+	spaceQuota.TotalServices = ccSpaceQuota.Entity.TotalServices
+	spaceQuota.AppInstanceLimit = ccSpaceQuota.Entity.AppInstanceLimit
+	spaceQuota.TotalReservedRoutePorts = ccSpaceQuota.Entity.TotalReservedRoutePorts
+	spaceQuota.NonBasicServicesAllowed = ccSpaceQuota.Entity.NonBasicServicesAllowed
 	return nil
 }
 
+// CreateSpaceQuotaDefinition creates a space quota in the space quota's
+// organization.
+func (client *Client) CreateSpaceQuotaDefinition(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	body, err := json.Marshal(spaceQuota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostSpaceQuotaDefinitionRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var createdSpaceQuota SpaceQuota
+	response := cloudcontroller.Response{
+		Result: &createdSpaceQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdSpaceQuota, response.Warnings, err
+}
+
+// GetOrganizationSpaceQuotaDefinitions returns the space quotas defined in
+// the organization.
+func (client *Client) GetOrganizationSpaceQuotaDefinitions(orgGUID string) ([]SpaceQuota, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationSpaceQuotaDefinitionsRequest,
+		URIParams:   Params{"organization_guid": orgGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var spaceQuotas []SpaceQuota
+	warnings, err := client.paginate(request, SpaceQuota{}, func(item interface{}) error {
+		if spaceQuota, ok := item.(SpaceQuota); ok {
+			spaceQuotas = append(spaceQuotas, spaceQuota)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   SpaceQuota{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return spaceQuotas, warnings, err
+}
+
 // GetSpaceQuotaDefinition returns a Space Quota.
 func (client *Client) GetSpaceQuotaDefinition(guid string) (SpaceQuota, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -52,3 +184,50 @@ func (client *Client) GetSpaceQuotaDefinition(guid string) (SpaceQuota, Warnings
 	err = client.connection.Make(request, &response)
 	return spaceQuota, response.Warnings, err
 }
+
+// UpdateSpaceQuotaDefinition updates the limits of the space quota with the
+// space quota's GUID.
+func (client *Client) UpdateSpaceQuotaDefinition(spaceQuota SpaceQuota) (SpaceQuota, Warnings, error) {
+	spaceQuotaGUID := spaceQuota.GUID
+	spaceQuota.OrganizationGUID = ""
+
+	body, err := json.Marshal(spaceQuota)
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceQuotaDefinitionRequest,
+		Body:        bytes.NewReader(body),
+		URIParams:   Params{"space_quota_guid": spaceQuotaGUID},
+	})
+	if err != nil {
+		return SpaceQuota{}, nil, err
+	}
+
+	var updatedSpaceQuota SpaceQuota
+	response := cloudcontroller.Response{
+		Result: &updatedSpaceQuota,
+	}
+
+	err = client.connection.Make(request, &response)
+	return updatedSpaceQuota, response.Warnings, err
+}
+
+// UpdateSpaceQuotaDefinitionSpace assigns the space quota to the space.
+func (client *Client) UpdateSpaceQuotaDefinitionSpace(spaceQuotaGUID string, spaceGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutSpaceQuotaDefinitionSpaceRequest,
+		URIParams: Params{
+			"space_quota_guid": spaceQuotaGUID,
+			"space_guid":       spaceGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
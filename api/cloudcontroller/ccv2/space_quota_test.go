@@ -27,7 +27,10 @@ var _ = Describe("Space Quotas", func() {
 						"updated_at": null
 					},
 					"entity": {
-						"name": "space-quota"
+						"name": "space-quota",
+						"memory_limit": 2048,
+						"total_routes": -1,
+						"total_reserved_route_ports": 2
 					}
 				}`
 				server.AppendHandlers(
@@ -43,8 +46,11 @@ var _ = Describe("Space Quotas", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				Expect(spaceQuota).To(Equal(SpaceQuota{
-					Name: "space-quota",
-					GUID: "space-quota-guid",
+					Name:                    "space-quota",
+					GUID:                    "space-quota-guid",
+					MemoryLimit:             2048,
+					TotalRoutes:             -1,
+					TotalReservedRoutePorts: 2,
 				}))
 			})
 		})
@@ -71,4 +77,223 @@ var _ = Describe("Space Quotas", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationSpaceQuotaDefinitions", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/organizations/some-org-guid/space_quota_definitions?page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "space-quota-guid-1"},
+							"entity": {
+								"name": "small",
+								"organization_guid": "some-org-guid",
+								"memory_limit": 1024,
+								"instance_memory_limit": -1,
+								"total_routes": 10,
+								"total_services": -1,
+								"app_instance_limit": 5,
+								"non_basic_services_allowed": true
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "space-quota-guid-2"},
+							"entity": {"name": "large", "organization_guid": "some-org-guid"}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the space quotas and all warnings", func() {
+				spaceQuotas, warnings, err := client.GetOrganizationSpaceQuotaDefinitions("some-org-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(spaceQuotas).To(Equal([]SpaceQuota{
+					{
+						GUID:                    "space-quota-guid-1",
+						Name:                    "small",
+						OrganizationGUID:        "some-org-guid",
+						MemoryLimit:             1024,
+						InstanceMemoryLimit:     -1,
+						TotalRoutes:             10,
+						TotalServices:           -1,
+						AppInstanceLimit:        5,
+						NonBasicServicesAllowed: true,
+					},
+					{
+						GUID:             "space-quota-guid-2",
+						Name:             "large",
+						OrganizationGUID: "some-org-guid",
+					},
+				}))
+			})
+		})
+
+		When("the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 30003,
+					"description": "The organization could not be found: some-org-guid",
+					"error_code": "CF-OrganizationNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetOrganizationSpaceQuotaDefinitions("some-org-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The organization could not be found: some-org-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("CreateSpaceQuotaDefinition", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {"guid": "space-quota-guid"},
+					"entity": {"name": "small", "organization_guid": "some-org-guid", "memory_limit": 1024}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/space_quota_definitions"),
+						VerifyJSON(`{
+							"name": "small",
+							"organization_guid": "some-org-guid",
+							"memory_limit": 1024,
+							"instance_memory_limit": -1,
+							"total_routes": 10,
+							"total_services": -1,
+							"app_instance_limit": -1,
+							"total_reserved_route_ports": 0,
+							"non_basic_services_allowed": false
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("creates the space quota and returns it with warnings", func() {
+				spaceQuota, warnings, err := client.CreateSpaceQuotaDefinition(SpaceQuota{
+					Name:                "small",
+					OrganizationGUID:    "some-org-guid",
+					MemoryLimit:         1024,
+					InstanceMemoryLimit: -1,
+					TotalRoutes:         10,
+					TotalServices:       -1,
+					AppInstanceLimit:    -1,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(spaceQuota.GUID).To(Equal("space-quota-guid"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceQuotaDefinition", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {"guid": "space-quota-guid"},
+					"entity": {"name": "small", "memory_limit": 2048}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/space-quota-guid"),
+						VerifyJSON(`{
+							"name": "small",
+							"memory_limit": 2048,
+							"instance_memory_limit": 512,
+							"total_routes": 10,
+							"total_services": 5,
+							"app_instance_limit": 20,
+							"total_reserved_route_ports": 4,
+							"non_basic_services_allowed": true
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("updates the space quota without changing its organization", func() {
+				spaceQuota, warnings, err := client.UpdateSpaceQuotaDefinition(SpaceQuota{
+					GUID:                    "space-quota-guid",
+					Name:                    "small",
+					OrganizationGUID:        "some-org-guid",
+					MemoryLimit:             2048,
+					InstanceMemoryLimit:     512,
+					TotalRoutes:             10,
+					TotalServices:           5,
+					AppInstanceLimit:        20,
+					TotalReservedRoutePorts: 4,
+					NonBasicServicesAllowed: true,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(spaceQuota.MemoryLimit).To(Equal(2048))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceQuotaDefinitionSpace", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/space-quota-guid/spaces/some-space-guid"),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("assigns the space quota to the space", func() {
+				warnings, err := client.UpdateSpaceQuotaDefinitionSpace("space-quota-guid", "some-space-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the request returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/space-quota-guid/spaces/some-space-guid"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UpdateSpaceQuotaDefinitionSpace("space-quota-guid", "some-space-guid")
+				Expect(err).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -3,8 +3,11 @@ package ccv2
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
 
@@ -12,12 +15,19 @@ import (
 type User struct {
 	// GUID is the unique user identifier.
 	GUID string
+
+	// Username is the name of the user in UAA. It is only returned when
+	// listing the users of an organization or space.
+	Username string
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller User response.
 func (user *User) UnmarshalJSON(data []byte) error {
 	var ccUser struct {
 		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Username string `json:"username"`
+		} `json:"entity"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccUser)
 	if err != nil {
@@ -25,6 +35,7 @@ func (user *User) UnmarshalJSON(data []byte) error {
 	}
 
 	user.GUID = ccUser.Metadata.GUID
+	user.Username = ccUser.Entity.Username
 	return nil
 }
 
@@ -62,3 +73,135 @@ func (client *Client) CreateUser(uaaUserID string) (User, Warnings, error) {
 
 	return user, response.Warnings, nil
 }
+
+// DeleteUser deletes the Cloud Controller User with the provided GUID.
+func (client *Client) DeleteUser(userGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteUserRequest,
+		URIParams:   Params{"user_guid": userGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+var (
+	getOrganizationUsersRequests = map[constant.UserRole]string{
+		constant.OrgUser:           internal.GetOrganizationUsersRequest,
+		constant.OrgManager:        internal.GetOrganizationManagersRequest,
+		constant.OrgBillingManager: internal.GetOrganizationBillingManagersRequest,
+		constant.OrgAuditor:        internal.GetOrganizationAuditorsRequest,
+	}
+	putOrganizationUserRequests = map[constant.UserRole]string{
+		constant.OrgUser:           internal.PutOrganizationUserRequest,
+		constant.OrgManager:        internal.PutOrganizationManagerRequest,
+		constant.OrgBillingManager: internal.PutOrganizationBillingManagerRequest,
+		constant.OrgAuditor:        internal.PutOrganizationAuditorRequest,
+	}
+	getSpaceUsersRequests = map[constant.UserRole]string{
+		constant.SpaceManager:   internal.GetSpaceManagersRequest,
+		constant.SpaceDeveloper: internal.GetSpaceDevelopersRequest,
+		constant.SpaceAuditor:   internal.GetSpaceAuditorsRequest,
+	}
+	putSpaceUserRequests = map[constant.UserRole]string{
+		constant.SpaceManager:   internal.PutSpaceManagerRequest,
+		constant.SpaceDeveloper: internal.PutSpaceDeveloperRequest,
+		constant.SpaceAuditor:   internal.PutSpaceAuditorRequest,
+	}
+)
+
+// GetOrganizationUsersByRole returns the users with the provided role in the
+// organization.
+func (client *Client) GetOrganizationUsersByRole(role constant.UserRole, orgGUID string) ([]User, Warnings, error) {
+	requestName, ok := getOrganizationUsersRequests[role]
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid organization role: %s", role)
+	}
+
+	return client.getUsers(requestName, Params{"organization_guid": orgGUID})
+}
+
+// GetSpaceUsersByRole returns the users with the provided role in the space.
+func (client *Client) GetSpaceUsersByRole(role constant.UserRole, spaceGUID string) ([]User, Warnings, error) {
+	requestName, ok := getSpaceUsersRequests[role]
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid space role: %s", role)
+	}
+
+	return client.getUsers(requestName, Params{"space_guid": spaceGUID})
+}
+
+// UpdateOrganizationUserByRole gives the user with the provided username the
+// role in the organization.
+func (client *Client) UpdateOrganizationUserByRole(role constant.UserRole, orgGUID string, username string) (Warnings, error) {
+	requestName, ok := putOrganizationUserRequests[role]
+	if !ok {
+		return nil, fmt.Errorf("invalid organization role: %s", role)
+	}
+
+	return client.updateUserByUsername(requestName, Params{"organization_guid": orgGUID}, username)
+}
+
+// UpdateSpaceUserByRole gives the user with the provided username the role in
+// the space. The user must be a member of the space's organization.
+func (client *Client) UpdateSpaceUserByRole(role constant.UserRole, spaceGUID string, username string) (Warnings, error) {
+	requestName, ok := putSpaceUserRequests[role]
+	if !ok {
+		return nil, fmt.Errorf("invalid space role: %s", role)
+	}
+
+	return client.updateUserByUsername(requestName, Params{"space_guid": spaceGUID}, username)
+}
+
+func (client *Client) getUsers(requestName string, uriParams Params) ([]User, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullUsersList []User
+	warnings, err := client.paginate(request, User{}, func(item interface{}) error {
+		if user, ok := item.(User); ok {
+			fullUsersList = append(fullUsersList, user)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   User{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullUsersList, warnings, err
+}
+
+func (client *Client) updateUserByUsername(requestName string, uriParams Params, username string) (Warnings, error) {
+	body, err := json.Marshal(struct {
+		Username string `json:"username"`
+	}{
+		Username: username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		Body:        bytes.NewReader(body),
+		URIParams:   uriParams,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -87,4 +88,186 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("DeleteUser", func() {
+		When("an error does not occur", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/users/some-user-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("deletes the user and returns all warnings", func() {
+				warnings, err := client.DeleteUser("some-user-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		When("the user does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 20003,
+					"description": "The user could not be found: some-user-guid",
+					"error_code": "CF-UserNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/users/some-user-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				warnings, err := client.DeleteUser("some-user-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The user could not be found: some-user-guid"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationUsersByRole", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/organizations/some-org-guid/managers?page=2",
+					"resources": [
+						{"metadata": {"guid": "user-guid-1"}, "entity": {"username": "user-1"}}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{"metadata": {"guid": "user-guid-2"}, "entity": {"username": "user-2"}}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/managers", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the users with the role and all warnings", func() {
+				users, warnings, err := client.GetOrganizationUsersByRole(constant.OrgManager, "some-org-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(users).To(Equal([]User{
+					{GUID: "user-guid-1", Username: "user-1"},
+					{GUID: "user-guid-2", Username: "user-2"},
+				}))
+			})
+		})
+
+		When("the role is not an organization role", func() {
+			It("returns an error", func() {
+				_, _, err := client.GetOrganizationUsersByRole(constant.SpaceDeveloper, "some-org-guid")
+				Expect(err).To(MatchError("invalid organization role: developers"))
+			})
+		})
+	})
+
+	Describe("GetSpaceUsersByRole", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{"metadata": {"guid": "user-guid-1"}, "entity": {"username": "user-1"}}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/developers"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the users with the role and all warnings", func() {
+				users, warnings, err := client.GetSpaceUsersByRole(constant.SpaceDeveloper, "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(users).To(Equal([]User{{GUID: "user-guid-1", Username: "user-1"}}))
+			})
+		})
+
+		When("the role is not a space role", func() {
+			It("returns an error", func() {
+				_, _, err := client.GetSpaceUsersByRole(constant.OrgBillingManager, "some-space-guid")
+				Expect(err).To(MatchError("invalid space role: billing_managers"))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationUserByRole", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/billing_managers"),
+						VerifyJSON(`{"username":"some-user"}`),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("gives the user the role and returns warnings", func() {
+				warnings, err := client.UpdateOrganizationUserByRole(constant.OrgBillingManager, "some-org-guid", "some-user")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the user does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 20003,
+					"description": "The user could not be found: some-user",
+					"error_code": "CF-UserNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/organizations/some-org-guid/users"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.UpdateOrganizationUserByRole(constant.OrgUser, "some-org-guid", "some-user")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The user could not be found: some-user"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UpdateSpaceUserByRole", func() {
+		When("no errors are encountered", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/spaces/some-space-guid/auditors"),
+						VerifyJSON(`{"username":"some-user"}`),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("gives the user the role and returns warnings", func() {
+				warnings, err := client.UpdateSpaceUserByRole(constant.SpaceAuditor, "some-space-guid", "some-user")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
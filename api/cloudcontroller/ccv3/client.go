@@ -124,6 +124,6 @@ func NewClient(config Config) *Client {
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
 		jobPollingTimeout:  config.JobPollingTimeout,
-		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		wrappers:           append([]ConnectionWrapper{NewErrorWrapper()}, config.Wrappers...),
 	}
 }
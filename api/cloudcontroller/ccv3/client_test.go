@@ -39,6 +39,32 @@ var _ = Describe("Cloud Controller Client", func() {
 		})
 	})
 
+	Describe("Idempotent requests", func() {
+		var fakeConnectionWrapper *ccv3fakes.FakeConnectionWrapper
+
+		BeforeEach(func() {
+			fakeConnectionWrapper = new(ccv3fakes.FakeConnectionWrapper)
+			fakeConnectionWrapper.WrapReturns(fakeConnectionWrapper)
+			client.WrapConnection(fakeConnectionWrapper)
+		})
+
+		It("marks idempotent POST requests so they can be retried", func() {
+			client.UpdateApplicationStart("some-app-guid")
+			client.UpdateApplicationRestart("some-app-guid")
+			client.UpdateApplicationApplyManifest("some-app-guid", []byte("some-manifest"))
+			Expect(fakeConnectionWrapper.MakeCallCount()).To(Equal(3))
+
+			startRequest, _ := fakeConnectionWrapper.MakeArgsForCall(0)
+			Expect(startRequest.Idempotent).To(BeTrue())
+
+			restartRequest, _ := fakeConnectionWrapper.MakeArgsForCall(1)
+			Expect(restartRequest.Idempotent).To(BeFalse())
+
+			applyManifestRequest, _ := fakeConnectionWrapper.MakeArgsForCall(2)
+			Expect(applyManifestRequest.Idempotent).To(BeTrue())
+		})
+	})
+
 	Describe("User Agent", func() {
 		BeforeEach(func() {
 			expectedUserAgent := fmt.Sprintf("CF CLI API V3 Test/Unknown (%s; %s %s)", runtime.Version(), runtime.GOARCH, runtime.GOOS)
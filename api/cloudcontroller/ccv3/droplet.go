@@ -1,6 +1,9 @@
 package ccv3
 
 import (
+	"encoding/json"
+	"path"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
@@ -18,12 +21,42 @@ type Droplet struct {
 	GUID string `json:"guid"`
 	// Image is the Docker image name.
 	Image string `json:"image"`
+	// PackageGUID is the unique identifier of the package the droplet was
+	// staged from.
+	PackageGUID string `json:"-"`
 	// Stack is the root filesystem to use with the buildpack.
 	Stack string `json:"stack,omitempty"`
 	// State is the current state of the droplet.
 	State constant.DropletState `json:"state"`
 }
 
+// UnmarshalJSON helps unmarshal a Cloud Controller Droplet response.
+func (d *Droplet) UnmarshalJSON(data []byte) error {
+	type alias Droplet
+	var ccDroplet struct {
+		alias
+		Links struct {
+			Package json.RawMessage `json:"package"`
+		} `json:"links"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccDroplet)
+	if err != nil {
+		return err
+	}
+
+	*d = Droplet(ccDroplet.alias)
+
+	// The package link is informational, so a link that is not in the
+	// expected format leaves PackageGUID empty instead of failing.
+	var packageLink APILink
+	if json.Unmarshal(ccDroplet.Links.Package, &packageLink) == nil && packageLink.HREF != "" {
+		d.PackageGUID = path.Base(packageLink.HREF)
+	}
+
+	return nil
+}
+
 // DropletBuildpack is the name and output of a buildpack used to create a
 // droplet.
 type DropletBuildpack struct {
@@ -165,6 +165,35 @@ var _ = Describe("Droplet", func() {
 			})
 		})
 
+		When("the droplet links to its package", func() {
+			BeforeEach(func() {
+				response := fmt.Sprintf(`{
+					"guid": "some-guid",
+					"state": "STAGED",
+					"links": {
+						"package": {
+							"href": "%s/v3/packages/some-package-guid"
+						}
+					}
+				}`, server.URL())
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-guid"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the package GUID", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{
+					GUID:        "some-guid",
+					State:       constant.DropletStaged,
+					PackageGUID: "some-package-guid",
+				}))
+			})
+		})
+
 		When("cloud controller returns an error", func() {
 			BeforeEach(func() {
 				response := `{
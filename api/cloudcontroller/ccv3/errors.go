@@ -14,7 +14,9 @@ type errorWrapper struct {
 	connection cloudcontroller.Connection
 }
 
-func newErrorWrapper() *errorWrapper {
+// NewErrorWrapper returns a new error wrapper. The client already converts the
+// errors of its connection; this is for wrappers that replace the connection.
+func NewErrorWrapper() ConnectionWrapper {
 	return new(errorWrapper)
 }
 
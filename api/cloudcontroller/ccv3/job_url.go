@@ -27,6 +27,40 @@ func (client *Client) DeleteApplication(appGUID string) (JobURL, Warnings, error
 	return JobURL(response.ResourceLocationURL), response.Warnings, err
 }
 
+// DeleteDroplet deletes the droplet with the given droplet GUID. Returns back
+// a resulting job URL to poll.
+func (client *Client) DeleteDroplet(dropletGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteDropletRequest,
+		URIParams:   internal.Params{"droplet_guid": dropletGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
+// DeletePackage deletes the package with the given package GUID. Returns back
+// a resulting job URL to poll.
+func (client *Client) DeletePackage(packageGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeletePackageRequest,
+		URIParams:   internal.Params{"package_guid": packageGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
 // UpdateApplicationApplyManifest applies the manifest to the given
 // application. Returns back a resulting job URL to poll.
 func (client *Client) UpdateApplicationApplyManifest(appGUID string, rawManifest []byte) (JobURL, Warnings, error) {
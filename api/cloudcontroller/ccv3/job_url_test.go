@@ -95,6 +95,124 @@ var _ = Describe("Job URL", func() {
 		})
 	})
 
+	Describe("DeleteDroplet", func() {
+		var (
+			jobLocation JobURL
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			jobLocation, warnings, executeErr = client.DeleteDroplet("some-droplet-guid")
+		})
+
+		When("the droplet is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobLocation).To(Equal(JobURL("/v3/jobs/some-location")))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		When("the droplet does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Droplet not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/droplets/some-droplet-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.DropletNotFoundError{}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("DeletePackage", func() {
+		var (
+			jobLocation JobURL
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			jobLocation, warnings, executeErr = client.DeletePackage("some-package-guid")
+		})
+
+		When("the package is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobLocation).To(Equal(JobURL("/v3/jobs/some-location")))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		When("the package does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Package not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-package-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Package not found"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
 	Describe("UpdateApplicationApplyManifest", func() {
 		var (
 			manifestBody []byte
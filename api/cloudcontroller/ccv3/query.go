@@ -13,6 +13,9 @@ const (
 	AppGUIDFilter QueryKey = "app_guids"
 	// GUIDFilter is a query parameter for listing objects by GUID.
 	GUIDFilter QueryKey = "guids"
+	// LabelSelectorFilter is a query parameter for listing objects by a label
+	// selector, such as "env=prod,tier!=web".
+	LabelSelectorFilter QueryKey = "label_selector"
 	// NameFilter is a query parameter for listing objects by name.
 	NameFilter QueryKey = "names"
 	// OrganizationGUIDFilter is a query parameter for listing objects by Organization GUID.
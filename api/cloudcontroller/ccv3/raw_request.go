@@ -0,0 +1,87 @@
+package ccv3
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+// RawResponse is the unprocessed response to a request made with
+// MakeRequest.
+type RawResponse struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Header contains the headers of the response.
+	Header http.Header
+
+	// Body is the body of the response.
+	Body []byte
+}
+
+// MakeRequest sends a request with the given method and body to path on the
+// Cloud Controller. Path is relative to the Cloud Controller URL, e.g.
+// "/v3/apps?names=some-app". The request goes through all of the client's
+// connection wrappers, so the client's authentication and retry logic apply.
+// Responses with an error status code are returned instead of an error; any
+// other failure, such as being unable to refresh the access token, is returned
+// as an error.
+func (client *Client) MakeRequest(method string, path string, body []byte) (RawResponse, Warnings, error) {
+	var requestBody io.ReadSeeker
+	if len(body) > 0 {
+		requestBody = bytes.NewReader(body)
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		Method: strings.ToUpper(method),
+		URL:    client.cloudControllerURL + "/" + strings.TrimPrefix(path, "/"),
+		Body:   requestBody,
+	})
+	if err != nil {
+		return RawResponse{}, nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	if err != nil && (response.HTTPResponse == nil || !isStatusError(err)) {
+		return RawResponse{}, response.Warnings, err
+	}
+
+	return RawResponse{
+		StatusCode: response.HTTPResponse.StatusCode,
+		Header:     response.HTTPResponse.Header,
+		Body:       response.RawResponse,
+	}, response.Warnings, nil
+}
+
+// isStatusError returns true if err is the error the client returns for a
+// response with a 4xx or 5xx status code.
+func isStatusError(err error) bool {
+	switch err.(type) {
+	case ccerror.RawHTTPStatusError,
+		ccerror.UnknownHTTPSourceError,
+		ccerror.MultiError,
+		ccerror.V3UnexpectedResponseError,
+		ccerror.InvalidAuthTokenError,
+		ccerror.UnauthorizedError,
+		ccerror.ForbiddenError,
+		ccerror.ApplicationNotFoundError,
+		ccerror.DropletNotFoundError,
+		ccerror.InstanceNotFoundError,
+		ccerror.ProcessNotFoundError,
+		ccerror.APINotFoundError,
+		ccerror.ResourceNotFoundError,
+		ccerror.NameNotUniqueInSpaceError,
+		ccerror.InvalidBuildpackError,
+		ccerror.UnprocessableEntityError,
+		ccerror.TaskWorkersUnavailableError,
+		ccerror.ServiceUnavailableError:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,122 @@
+package ccv3_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/ccv3fakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("MakeRequest", func() {
+	var (
+		client *Client
+
+		method string
+		path   string
+		body   []byte
+
+		response   RawResponse
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient()
+		method = "get"
+		path = "/v3/apps?names=some-app"
+		body = nil
+	})
+
+	JustBeforeEach(func() {
+		response, warnings, executeErr = client.MakeRequest(method, path, body)
+	})
+
+	When("the request succeeds", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/apps", "names=some-app"),
+					RespondWith(http.StatusOK, `{"resources":[]}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the raw response and warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Body).To(MatchJSON(`{"resources":[]}`))
+			Expect(response.Header.Get("X-Cf-Warnings")).To(Equal("this is a warning"))
+		})
+	})
+
+	When("a body is provided", func() {
+		BeforeEach(func() {
+			method = "post"
+			path = "v3/spaces"
+			body = []byte(`{"name":"some-space"}`)
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/spaces"),
+					VerifyJSON(`{"name":"some-space"}`),
+					RespondWith(http.StatusCreated, `{"guid":"some-space-guid"}`),
+				),
+			)
+		})
+
+		It("sends the body", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(response.Body).To(MatchJSON(`{"guid":"some-space-guid"}`))
+		})
+	})
+
+	When("the cloud controller returns an error status code", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/apps", "names=some-app"),
+					RespondWith(http.StatusNotFound, `{"errors":[{"code":10010,"detail":"App not found","title":"CF-ResourceNotFound"}]}`),
+				),
+			)
+		})
+
+		It("returns the response instead of an error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			Expect(response.Body).To(MatchJSON(`{"errors":[{"code":10010,"detail":"App not found","title":"CF-ResourceNotFound"}]}`))
+		})
+	})
+	When("a wrapper fails after the cloud controller responds", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/apps", "names=some-app"),
+					RespondWith(http.StatusUnauthorized, `{"errors":[{"code":1000,"detail":"Invalid Auth Token","title":"CF-InvalidAuthToken"}]}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+
+			fakeConnectionWrapper := new(ccv3fakes.FakeConnectionWrapper)
+			fakeConnectionWrapper.WrapStub = func(connection cloudcontroller.Connection) cloudcontroller.Connection {
+				fakeConnectionWrapper.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+					_ = connection.Make(request, response)
+					return errors.New("refresh-token-error")
+				}
+				return fakeConnectionWrapper
+			}
+			client.WrapConnection(fakeConnectionWrapper)
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("refresh-token-error"))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(response).To(Equal(RawResponse{}))
+		})
+	})
+})
@@ -29,6 +29,18 @@ type requestOptions struct {
 	Body io.ReadSeeker
 }
 
+// idempotentPostRequests are the POST requests that have the same effect when
+// they are made more than once, so they are retried like other requests.
+// Applying a manifest is declarative, and the actions and relationships only
+// move the resource into the requested state.
+var idempotentPostRequests = map[string]bool{
+	internal.PostApplicationActionApplyManifest:                   true,
+	internal.PostApplicationActionStartRequest:                    true,
+	internal.PostApplicationActionStopRequest:                     true,
+	internal.PostApplicationProcessActionScaleRequest:             true,
+	internal.PostIsolationSegmentRelationshipOrganizationsRequest: true,
+}
+
 // newHTTPRequest returns a constructed HTTP.Request with some defaults.
 // Defaults are applied when Request options are not filled in.
 func (client *Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontroller.Request, error) {
@@ -64,5 +76,7 @@ func (client *Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontro
 		request.Header.Set("Content-Type", "application/json")
 	}
 
-	return cloudcontroller.NewRequest(request, passedRequest.Body), nil
+	ccRequest := cloudcontroller.NewRequest(request, passedRequest.Body)
+	ccRequest.Idempotent = idempotentPostRequests[passedRequest.RequestName]
+	return ccRequest, nil
 }
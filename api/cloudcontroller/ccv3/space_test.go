@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -42,7 +43,12 @@ var _ = Describe("Spaces", func() {
   "resources": [
     {
       "name": "space-name-1",
-      "guid": "space-guid-1"
+      "guid": "space-guid-1",
+      "relationships": {
+        "organization": {
+          "data": { "guid": "org-guid-1" }
+        }
+      }
     },
     {
       "name": "space-name-2",
@@ -85,7 +91,13 @@ var _ = Describe("Spaces", func() {
 				Expect(executeErr).NotTo(HaveOccurred())
 
 				Expect(spaces).To(ConsistOf(
-					Space{Name: "space-name-1", GUID: "space-guid-1"},
+					Space{
+						Name: "space-name-1",
+						GUID: "space-guid-1",
+						Relationships: Relationships{
+							constant.RelationshipTypeOrganization: Relationship{GUID: "org-guid-1"},
+						},
+					},
 					Space{Name: "space-name-2", GUID: "space-guid-2"},
 					Space{Name: "space-name-3", GUID: "space-guid-3"},
 				))
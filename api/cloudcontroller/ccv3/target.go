@@ -1,6 +1,8 @@
 package ccv3
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -23,6 +25,14 @@ type TargetSettings struct {
 	// be used only for testing.
 	SkipSSLValidation bool
 
+	// RootCAs is the certificate pool used to verify the Cloud Controller. The
+	// system pool is used when it is nil.
+	RootCAs *x509.CertPool
+
+	// Certificates are presented to a Cloud Controller that requests a client
+	// certificate.
+	Certificates []tls.Certificate
+
 	// URL is a fully qualified URL to the Cloud Controller API.
 	URL string
 }
@@ -35,6 +45,8 @@ func (client *Client) TargetCF(settings TargetSettings) (Warnings, error) {
 	client.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
 		DialTimeout:       settings.DialTimeout,
 		SkipSSLValidation: settings.SkipSSLValidation,
+		RootCAs:           settings.RootCAs,
+		Certificates:      settings.Certificates,
 	})
 
 	for _, wrapper := range client.wrappers {
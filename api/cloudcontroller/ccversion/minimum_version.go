@@ -15,6 +15,7 @@ const (
 
 	MinVersionApplicationFlowV3    = "3.27.0"
 	MinVersionIsolationSegmentV3   = "3.11.0"
+	MinVersionLabelsV3             = "3.63.0"
 	MinVersionManifestBuildpacksV3 = "3.25.0"
 	MinVersionNetworkingV3         = "3.19.0"
 	MinVersionRoutingV3            = "3.16.0"
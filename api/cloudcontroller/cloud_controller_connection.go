@@ -17,6 +17,14 @@ import (
 type Config struct {
 	DialTimeout       time.Duration
 	SkipSSLValidation bool
+
+	// RootCAs is the certificate pool used to verify the server. The system
+	// pool is used when it is nil.
+	RootCAs *x509.CertPool
+
+	// Certificates are presented to a server that requests a client
+	// certificate.
+	Certificates []tls.Certificate
 }
 
 // CloudControllerConnection represents a connection to the Cloud Controller
@@ -32,6 +40,8 @@ func NewConnection(config Config) *CloudControllerConnection {
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: config.SkipSSLValidation,
+			RootCAs:            config.RootCAs,
+			Certificates:       config.Certificates,
 		},
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -105,6 +115,16 @@ func (*CloudControllerConnection) handleWarnings(response *http.Response) ([]str
 	return warnings, nil
 }
 
+// PopulateResponse injects the HTTP response into passedResponse the same way
+// the connection does, returning a RawHTTPStatusError for 4xx and 5xx status
+// codes. It allows connection wrappers to provide responses themselves.
+func PopulateResponse(response *http.Response, passedResponse *Response) error {
+	passedResponse.reset()
+
+	var connection *CloudControllerConnection
+	return connection.populateResponse(response, passedResponse)
+}
+
 func (connection *CloudControllerConnection) populateResponse(response *http.Response, passedResponse *Response) error {
 	passedResponse.HTTPResponse = response
 
@@ -1,6 +1,7 @@
 package cloudcontroller_test
 
 import (
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"runtime"
@@ -239,6 +240,32 @@ var _ = Describe("Cloud Controller Connection", func() {
 				})
 			})
 
+			When("the server's certificate is signed by a CA in RootCAs", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusOK, "{}"),
+						),
+					)
+
+					rootCAs := x509.NewCertPool()
+					rootCAs.AddCert(server.HTTPTestServer.Certificate())
+					connection = NewConnection(Config{RootCAs: rootCAs})
+				})
+
+				It("verifies the server", func() {
+					req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+					Expect(err).ToNot(HaveOccurred())
+					request := &Request{Request: req}
+
+					var response Response
+					err = connection.Make(request, &response)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(server.ReceivedRequests()).To(HaveLen(1))
+				})
+			})
+
 			When("the server's certificate does not match the hostname", func() {
 				Context("skipSSLValidation is false", func() {
 					BeforeEach(func() {
@@ -9,6 +9,10 @@ import (
 type Request struct {
 	*http.Request
 
+	// Idempotent marks a POST request that has the same effect when it is made
+	// more than once, so it can be retried safely.
+	Idempotent bool
+
 	body io.ReadSeeker
 }
 
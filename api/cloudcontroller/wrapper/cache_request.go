@@ -0,0 +1,227 @@
+package wrapper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// uncachedPaths match the resources whose state is polled while it changes,
+// such as jobs, staging apps and builds, and starting instances. They are never
+// served from the cache.
+var uncachedPaths = []*regexp.Regexp{
+	regexp.MustCompile(`^/v[23]/jobs/`),
+	regexp.MustCompile(`^/v2/apps/[^/]+(/instances|/stats)?$`),
+	regexp.MustCompile(`^/v3/(builds|droplets|packages)/[^/]+$`),
+	regexp.MustCompile(`^/v3/processes/[^/]+/stats$`),
+}
+
+//go:generate counterfeiter . CacheConfig
+
+// CacheConfig provides the target and user that cached responses belong to.
+type CacheConfig interface {
+	Target() string
+	CurrentUserName() (string, error)
+}
+
+// cachedResponse is a successful response to a GET request as it is stored
+// by CacheRequest.
+type cachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	ETag       string      `json:"etag,omitempty"`
+	StoredAt   time.Time   `json:"stored_at"`
+}
+
+// CacheRequest is a wrapper that caches the responses to GET requests on disk,
+// separately for every target and user. Cached responses are used without
+// contacting the Cloud Controller until they are older than the TTL, after
+// which they are revalidated with If-None-Match when the Cloud Controller
+// provided an ETag. Any POST, PUT, PATCH or DELETE request clears the user's
+// cached responses, since a change to one resource also changes the
+// collections and summaries that contain it.
+type CacheRequest struct {
+	connection cloudcontroller.Connection
+	directory  string
+	ttl        time.Duration
+	config     CacheConfig
+
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewCacheRequest returns a pointer to a CacheRequest wrapper that caches
+// responses in the directory for the TTL.
+func NewCacheRequest(directory string, ttl time.Duration, config CacheConfig) *CacheRequest {
+	return &CacheRequest{
+		directory: directory,
+		ttl:       ttl,
+		config:    config,
+		Now:       time.Now,
+	}
+}
+
+// Make serves GET requests from the cache when possible, and clears the cache
+// for all other requests. Requests are sent uncached when there is no logged
+// in user, or when they are for a resource that is polled.
+func (cache *CacheRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	partition := cachePartition(cache.directory, cache.config)
+	if partition == "" {
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	if request.Method != http.MethodGet {
+		err := os.RemoveAll(partition)
+		if err != nil {
+			return err
+		}
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	if isPolled(request.URL.Path) {
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	entryPath := cache.entryPath(partition, request)
+	entry, found := cache.read(entryPath)
+	if found && cache.Now().Sub(entry.StoredAt) < cache.ttl {
+		return cache.populate(request, entry, passedResponse)
+	}
+
+	if found && entry.ETag != "" {
+		request.Header.Set("If-None-Match", entry.ETag)
+	}
+
+	// The response is received without a result, since a response that has not
+	// been modified has no body to decode.
+	response := cloudcontroller.Response{}
+	err := cache.connection.Make(request, &response)
+	if err != nil || response.HTTPResponse == nil {
+		passedResponse.RawResponse = response.RawResponse
+		passedResponse.Warnings = response.Warnings
+		passedResponse.HTTPResponse = response.HTTPResponse
+		passedResponse.ResourceLocationURL = response.ResourceLocationURL
+		return err
+	}
+
+	switch {
+	case response.HTTPResponse.StatusCode == http.StatusNotModified && found:
+		entry.StoredAt = cache.Now()
+	case response.HTTPResponse.StatusCode == http.StatusOK:
+		entry = cachedResponse{
+			StatusCode: response.HTTPResponse.StatusCode,
+			Header:     response.HTTPResponse.Header,
+			Body:       response.RawResponse,
+			ETag:       response.HTTPResponse.Header.Get("ETag"),
+			StoredAt:   cache.Now(),
+		}
+	default:
+		response.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(response.RawResponse))
+		return cloudcontroller.PopulateResponse(response.HTTPResponse, passedResponse)
+	}
+
+	err = cache.write(entryPath, entry)
+	if err != nil {
+		return err
+	}
+	return cache.populate(request, entry, passedResponse)
+}
+
+// Wrap sets the connection in the CacheRequest and returns itself.
+func (cache *CacheRequest) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	cache.connection = innerconnection
+	return cache
+}
+
+// ClearCache removes the current target and user's responses cached in the
+// directory by CacheRequest. It is used by clients that change resources
+// without going through CacheRequest.
+func ClearCache(directory string, config CacheConfig) error {
+	partition := cachePartition(directory, config)
+	if partition == "" {
+		return nil
+	}
+	return os.RemoveAll(partition)
+}
+
+func (*CacheRequest) entryPath(partition string, request *cloudcontroller.Request) string {
+	hash := sha256.Sum256([]byte(request.URL.RequestURI()))
+	return filepath.Join(partition, hex.EncodeToString(hash[:])+".json")
+}
+
+func (*CacheRequest) read(entryPath string) (cachedResponse, bool) {
+	raw, err := ioutil.ReadFile(entryPath)
+	if err != nil {
+		return cachedResponse{}, false
+	}
+
+	var entry cachedResponse
+	if json.Unmarshal(raw, &entry) != nil {
+		return cachedResponse{}, false
+	}
+	return entry, true
+}
+
+func (*CacheRequest) write(entryPath string, entry cachedResponse) error {
+	err := os.MkdirAll(filepath.Dir(entryPath), 0700)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(entryPath, raw, 0600)
+}
+
+func (*CacheRequest) populate(request *cloudcontroller.Request, entry cachedResponse, passedResponse *cloudcontroller.Response) error {
+	header := entry.Header
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return cloudcontroller.PopulateResponse(&http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode:    entry.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       request.Request,
+	}, passedResponse)
+}
+
+// cachePartition returns the directory that contains the current target and
+// user's cached responses, or an empty string when there is no user.
+func cachePartition(directory string, config CacheConfig) string {
+	user, err := config.CurrentUserName()
+	if err != nil || user == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(config.Target() + "\n" + user))
+	return filepath.Join(directory, hex.EncodeToString(hash[:8]))
+}
+
+func isPolled(path string) bool {
+	for _, uncachedPath := range uncachedPaths {
+		if uncachedPath.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,282 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CacheRequest", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeConfig     *wrapperfakes.FakeCacheConfig
+		directory      string
+		now            time.Time
+
+		cache   *CacheRequest
+		wrapper cloudcontroller.Connection
+
+		statusCode int
+		body       string
+	)
+
+	makeRequest := func(method string, url string) (map[string]string, *cloudcontroller.Response, error) {
+		req, err := http.NewRequest(method, url, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		result := map[string]string{}
+		response := &cloudcontroller.Response{Result: &result}
+		err = wrapper.Make(cloudcontroller.NewRequest(req, nil), response)
+		return result, response, err
+	}
+
+	BeforeEach(func() {
+		var err error
+		directory, err = ioutil.TempDir("", "cc-http-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		statusCode = http.StatusOK
+		body = `{"name":"some-app"}`
+
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			response := &http.Response{
+				StatusCode: statusCode,
+				Header: http.Header{
+					"Etag":          {`"some-etag"`},
+					"X-Cf-Warnings": {"some-warning"},
+				},
+				Body: ioutil.NopCloser(strings.NewReader("")),
+			}
+			if statusCode == http.StatusOK {
+				response.Body = ioutil.NopCloser(strings.NewReader(body))
+			}
+			return cloudcontroller.PopulateResponse(response, passedResponse)
+		}
+
+		fakeConfig = new(wrapperfakes.FakeCacheConfig)
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeConfig.CurrentUserNameReturns("some-user", nil)
+
+		now = time.Now()
+		cache = NewCacheRequest(directory, 30*time.Second, fakeConfig)
+		cache.Now = func() time.Time { return now }
+		wrapper = cache.Wrap(fakeConnection)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(directory)
+	})
+
+	When("a GET request has not been cached", func() {
+		It("makes the request and returns the response", func() {
+			result, response, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			Expect(result).To(Equal(map[string]string{"name": "some-app"}))
+			Expect(response.RawResponse).To(MatchJSON(body))
+			Expect(response.Warnings).To(ConsistOf("some-warning"))
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+
+	When("a GET request has been cached", func() {
+		BeforeEach(func() {
+			_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+			Expect(err).ToNot(HaveOccurred())
+			body = `{"name":"some-other-app"}`
+		})
+
+		When("the cached response is within the TTL", func() {
+			It("returns the cached response without making the request", func() {
+				result, response, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				Expect(result).To(Equal(map[string]string{"name": "some-app"}))
+				Expect(response.Warnings).To(ConsistOf("some-warning"))
+			})
+
+			It("does not share the response with other URLs", func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid?include=space")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+
+			When("the user changes", func() {
+				BeforeEach(func() {
+					fakeConfig.CurrentUserNameReturns("some-other-user", nil)
+				})
+
+				It("makes the request", func() {
+					result, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+					Expect(result).To(Equal(map[string]string{"name": "some-other-app"}))
+				})
+			})
+
+			When("the target changes", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetReturns("https://api.other.example.com")
+				})
+
+				It("makes the request", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				})
+			})
+
+			When("a request modifies the same resource", func() {
+				BeforeEach(func() {
+					_, _, err := makeRequest(http.MethodPatch, "https://api.example.com/v2/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("makes the request", func() {
+					result, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+					Expect(result).To(Equal(map[string]string{"name": "some-other-app"}))
+				})
+			})
+
+			When("a request modifies another resource", func() {
+				BeforeEach(func() {
+					_, _, err := makeRequest(http.MethodPost, "https://api.example.com/v3/spaces")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("makes the request, since the response can contain the resource", func() {
+					result, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+					Expect(result).To(Equal(map[string]string{"name": "some-other-app"}))
+				})
+			})
+
+			When("the cache is cleared", func() {
+				BeforeEach(func() {
+					Expect(ClearCache(directory, fakeConfig)).To(Succeed())
+				})
+
+				It("makes the request", func() {
+					_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				})
+			})
+		})
+
+		When("the cached response is older than the TTL", func() {
+			BeforeEach(func() {
+				now = now.Add(time.Minute)
+			})
+
+			It("revalidates the response with its ETag", func() {
+				_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("If-None-Match")).To(Equal(`"some-etag"`))
+			})
+
+			When("the response has not been modified", func() {
+				BeforeEach(func() {
+					statusCode = http.StatusNotModified
+				})
+
+				It("returns the cached response and uses it for the TTL", func() {
+					result, response, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal(map[string]string{"name": "some-app"}))
+					Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+
+					_, _, err = makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				})
+			})
+
+			When("the response has been modified", func() {
+				It("returns and caches the new response", func() {
+					result, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(Equal(map[string]string{"name": "some-other-app"}))
+
+					result, _, err = makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+					Expect(result).To(Equal(map[string]string{"name": "some-other-app"}))
+				})
+			})
+		})
+	})
+
+	DescribeTable("GET requests for polled resources",
+		func(url string) {
+			_, _, err := makeRequest(http.MethodGet, url)
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = makeRequest(http.MethodGet, url)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		},
+		Entry("V2 jobs", "https://api.example.com/v2/jobs/some-guid"),
+		Entry("V2 apps", "https://api.example.com/v2/apps/some-guid"),
+		Entry("V2 app instances", "https://api.example.com/v2/apps/some-guid/instances"),
+		Entry("V2 app stats", "https://api.example.com/v2/apps/some-guid/stats"),
+		Entry("V3 jobs", "https://api.example.com/v3/jobs/some-guid"),
+		Entry("V3 builds", "https://api.example.com/v3/builds/some-guid"),
+		Entry("V3 packages", "https://api.example.com/v3/packages/some-guid"),
+		Entry("V3 process stats", "https://api.example.com/v3/processes/some-guid/stats"),
+	)
+
+	When("the request fails", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusNotFound}
+				return errors.New("some-error")
+			}
+		})
+
+		It("returns the error and response without caching it", func() {
+			_, response, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+			Expect(err).To(MatchError("some-error"))
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusNotFound))
+
+			_, _, err = makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+			Expect(err).To(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+	})
+
+	When("there is no current user", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserNameReturns("", nil)
+		})
+
+		It("does not cache responses", func() {
+			_, _, err := makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = makeRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+			entries, err := ioutil.ReadDir(directory)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,143 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RecordRequest", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		directory      string
+
+		wrapper cloudcontroller.Connection
+
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		var err error
+		directory, err = ioutil.TempDir("", "cc-recordings")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(req *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			body, readErr := ioutil.ReadAll(req.Body)
+			Expect(readErr).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal(`{"name": "some-app"}`))
+
+			passedResponse.RawResponse = []byte(`{"guid":"some-guid"}`)
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: http.StatusCreated,
+				Header:     http.Header{"X-Cf-Warnings": {"some-warning"}},
+			}
+			return nil
+		}
+
+		wrapper = NewRecordRequest(directory).Wrap(fakeConnection)
+
+		body := bytes.NewReader([]byte(`{"name": "some-app"}`))
+		req, err := http.NewRequest(http.MethodPost, "https://api.example.com/v3/apps?b=2&a=1", body)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		request = cloudcontroller.NewRequest(req, body)
+
+		response = new(cloudcontroller.Response)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(directory)
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	It("makes the request with its body intact", func() {
+		Expect(makeErr).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+
+	It("records the request and response", func() {
+		recordings, err := filepath.Glob(filepath.Join(directory, "cc-post-*-1.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recordings).To(HaveLen(1))
+
+		raw, err := ioutil.ReadFile(recordings[0])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(raw).To(MatchJSON(`{
+			"request": {
+				"method": "POST",
+				"path": "/v3/apps",
+				"query": "a=1&b=2",
+				"body": "{\"name\":\"some-app\"}"
+			},
+			"response": {
+				"status_code": 201,
+				"header": {"X-Cf-Warnings": ["some-warning"]},
+				"body": "{\"guid\":\"some-guid\"}"
+			}
+		}`))
+	})
+
+	When("the same request is made again", func() {
+		JustBeforeEach(func() {
+			Expect(request.ResetBody()).To(Succeed())
+			Expect(wrapper.Make(request, response)).To(Succeed())
+		})
+
+		It("records the requests in order", func() {
+			recordings, err := filepath.Glob(filepath.Join(directory, "cc-post-*"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recordings).To(HaveLen(2))
+			Expect(recordings[0]).To(HaveSuffix("-1.json"))
+			Expect(recordings[1]).To(HaveSuffix("-2.json"))
+		})
+	})
+
+	When("the response is an error", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.RawResponse = []byte(`{"errors":[]}`)
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusNotFound}
+				return ccerror.ResourceNotFoundError{}
+			}
+		})
+
+		It("records the response and returns the error", func() {
+			Expect(makeErr).To(MatchError(ccerror.ResourceNotFoundError{}))
+
+			recordings, err := filepath.Glob(filepath.Join(directory, "*.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recordings).To(HaveLen(1))
+		})
+	})
+
+	When("no response is received", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(errors.New("some-error"))
+		})
+
+		It("does not record the request", func() {
+			Expect(makeErr).To(MatchError("some-error"))
+
+			recordings, err := filepath.Glob(filepath.Join(directory, "*.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recordings).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package wrapper
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/internal/recording"
+)
+
+// ReplayRequest is a wrapper that responds to requests with the responses
+// recorded by RecordRequest, instead of sending them to the Cloud Controller.
+// Requests are matched on their method, path, query and body. Repeated
+// requests receive their recorded responses in order, and the last one once
+// they run out.
+//
+// The Cloud Controller errors are converted by the client's connection, so
+// this wrapper needs to be wrapped in the client's error wrapper.
+type ReplayRequest struct {
+	replayer *recording.Replayer
+}
+
+// NewReplayRequest returns a pointer to a ReplayRequest wrapper that replays
+// the recordings in the directory.
+func NewReplayRequest(directory string) *ReplayRequest {
+	return &ReplayRequest{
+		replayer: recording.NewReplayer(directory, recordingPrefix),
+	}
+}
+
+// Make populates the response with the recorded response to the request. It
+// returns a RecordingNotFoundError if the request was not recorded.
+func (replay *ReplayRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	recordedRequest, err := newRecordedRequest(request)
+	if err != nil {
+		return err
+	}
+
+	interaction, err := replay.replayer.Next(recordedRequest)
+	if os.IsNotExist(err) {
+		return ccerror.RecordingNotFoundError{Method: request.Method, URL: request.URL.String()}
+	}
+	if err != nil {
+		return err
+	}
+
+	response, err := interaction.Response.HTTPResponse(request.Request)
+	if err != nil {
+		return err
+	}
+
+	return cloudcontroller.PopulateResponse(response, passedResponse)
+}
+
+// Wrap returns the ReplayRequest without keeping the inner connection, since
+// replayed requests are never sent.
+func (replay *ReplayRequest) Wrap(cloudcontroller.Connection) cloudcontroller.Connection {
+	return replay
+}
@@ -0,0 +1,118 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReplayRequest", func() {
+	var (
+		directory string
+		recorder  *RecordRequest
+		replay    cloudcontroller.Connection
+	)
+
+	newRequest := func(method string, rawURL string, body string) *cloudcontroller.Request {
+		reader := bytes.NewReader([]byte(body))
+		req, err := http.NewRequest(method, rawURL, reader)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		return cloudcontroller.NewRequest(req, reader)
+	}
+
+	record := func(request *cloudcontroller.Request, statusCode int, body string) {
+		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.RawResponse = []byte(body)
+			passedResponse.HTTPResponse = &http.Response{
+				StatusCode: statusCode,
+				Header:     http.Header{"X-Cf-Warnings": {"some-warning"}},
+			}
+			return nil
+		}
+
+		Expect(recorder.Wrap(fakeConnection).Make(request, new(cloudcontroller.Response))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		directory, err = ioutil.TempDir("", "cc-recordings")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder = NewRecordRequest(directory)
+		replay = NewReplayRequest(directory).Wrap(new(cloudcontrollerfakes.FakeConnection))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(directory)
+	})
+
+	When("the request was recorded", func() {
+		BeforeEach(func() {
+			record(newRequest(http.MethodPost, "https://api.example.com/v3/apps?a=1&b=2", `{"name":"some-app","lifecycle":{}}`), http.StatusCreated, `{"guid":"some-guid"}`)
+		})
+
+		It("populates the response from the recording, ignoring the order of the query and body", func() {
+			var result struct {
+				GUID string `json:"guid"`
+			}
+			response := cloudcontroller.Response{Result: &result}
+
+			err := replay.Make(newRequest(http.MethodPost, "https://other.example.com/v3/apps?b=2&a=1", `{"lifecycle":{},"name":"some-app"}`), &response)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusCreated))
+			Expect(response.Warnings).To(ConsistOf("some-warning"))
+			Expect(result.GUID).To(Equal("some-guid"))
+		})
+
+		When("the body is different", func() {
+			It("returns a RecordingNotFoundError", func() {
+				err := replay.Make(newRequest(http.MethodPost, "https://api.example.com/v3/apps?a=1&b=2", `{"name":"other-app"}`), new(cloudcontroller.Response))
+				Expect(err).To(MatchError(ccerror.RecordingNotFoundError{
+					Method: http.MethodPost,
+					URL:    "https://api.example.com/v3/apps?a=1&b=2",
+				}))
+			})
+		})
+	})
+
+	When("the request was recorded more than once", func() {
+		BeforeEach(func() {
+			record(newRequest(http.MethodGet, "https://api.example.com/v3/jobs/some-job", ""), http.StatusOK, `{"state":"PROCESSING"}`)
+			record(newRequest(http.MethodGet, "https://api.example.com/v3/jobs/some-job", ""), http.StatusOK, `{"state":"COMPLETE"}`)
+		})
+
+		It("replays the responses in order and then repeats the last one", func() {
+			for _, expected := range []string{"PROCESSING", "COMPLETE", "COMPLETE"} {
+				response := new(cloudcontroller.Response)
+				Expect(replay.Make(newRequest(http.MethodGet, "https://api.example.com/v3/jobs/some-job", ""), response)).To(Succeed())
+				Expect(string(response.RawResponse)).To(ContainSubstring(expected))
+			}
+		})
+	})
+
+	When("the recorded response is an error", func() {
+		BeforeEach(func() {
+			record(newRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid", ""), http.StatusNotFound, `{"errors":[]}`)
+		})
+
+		It("returns a RawHTTPStatusError for the error wrapper to convert", func() {
+			err := replay.Make(newRequest(http.MethodGet, "https://api.example.com/v3/apps/some-guid", ""), new(cloudcontroller.Response))
+			Expect(err).To(MatchError(ccerror.RawHTTPStatusError{
+				StatusCode:  http.StatusNotFound,
+				RawResponse: []byte(`{"errors":[]}`),
+			}))
+		})
+	})
+})
@@ -5,10 +5,13 @@ import (
 	"io/ioutil"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/internal/retry"
 )
 
 //go:generate counterfeiter . RequestLoggerOutput
@@ -20,6 +23,7 @@ type RequestLoggerOutput interface {
 	DisplayJSONBody(body []byte) error
 	DisplayMessage(msg string) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
+	DisplayRequestID(id string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
 	HandleInternalError(err error)
@@ -32,6 +36,10 @@ type RequestLoggerOutput interface {
 type RequestLogger struct {
 	connection cloudcontroller.Connection
 	output     RequestLoggerOutput
+
+	// requests counts the requests made, to identify the request each
+	// response belongs to.
+	requests uint64
 }
 
 // NewRequestLogger returns a pointer to a RequestLogger wrapper
@@ -43,7 +51,8 @@ func NewRequestLogger(output RequestLoggerOutput) *RequestLogger {
 
 // Make records the request and the response to UI
 func (logger *RequestLogger) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
-	err := logger.displayRequest(request)
+	id := strconv.FormatUint(atomic.AddUint64(&logger.requests, 1), 10)
+	err := logger.displayRequest(request, id)
 	if err != nil {
 		logger.output.HandleInternalError(err)
 	}
@@ -51,7 +60,7 @@ func (logger *RequestLogger) Make(request *cloudcontroller.Request, passedRespon
 	err = logger.connection.Make(request, passedResponse)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(passedResponse, id)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -66,13 +75,17 @@ func (logger *RequestLogger) Wrap(innerconnection cloudcontroller.Connection) cl
 	return logger
 }
 
-func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) error {
+func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request, id string) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
 	}
 	defer logger.output.Stop()
 
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayType("REQUEST", time.Now())
 	if err != nil {
 		return err
@@ -118,13 +131,17 @@ func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) er
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Response) error {
+func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Response, id string) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
 	}
 	defer logger.output.Stop()
 
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayType("RESPONSE", time.Now())
 	if err != nil {
 		return err
@@ -137,6 +154,12 @@ func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Res
 	if err != nil {
 		return err
 	}
+	if rateLimit := retry.RateLimitMessage(passedResponse.HTTPResponse.Header); rateLimit != "" {
+		err = logger.output.DisplayMessage(rateLimit)
+		if err != nil {
+			return err
+		}
+	}
 	return logger.output.DisplayJSONBody(passedResponse.RawResponse)
 }
 
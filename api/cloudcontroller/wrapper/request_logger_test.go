@@ -224,6 +224,46 @@ var _ = Describe("Request Logger", func() {
 				Expect(fakeOutput.DisplayJSONBodyCallCount()).To(BeNumerically(">=", 1))
 				Expect(fakeOutput.DisplayJSONBodyArgsForCall(0)).To(Equal([]byte("some-response-body")))
 			})
+
+			It("does not output a rate limit summary", func() {
+				Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(0))
+			})
+
+			It("identifies the response with its request", func() {
+				Expect(fakeOutput.DisplayRequestIDCallCount()).To(Equal(2))
+				Expect(fakeOutput.DisplayRequestIDArgsForCall(0)).ToNot(BeEmpty())
+				Expect(fakeOutput.DisplayRequestIDArgsForCall(1)).To(Equal(fakeOutput.DisplayRequestIDArgsForCall(0)))
+
+				Expect(wrapper.Make(request, response)).To(Succeed())
+				Expect(fakeOutput.DisplayRequestIDCallCount()).To(Equal(4))
+				Expect(fakeOutput.DisplayRequestIDArgsForCall(2)).ToNot(Equal(fakeOutput.DisplayRequestIDArgsForCall(0)))
+				Expect(fakeOutput.DisplayRequestIDArgsForCall(3)).To(Equal(fakeOutput.DisplayRequestIDArgsForCall(2)))
+			})
+
+			When("the response has rate limit headers", func() {
+				BeforeEach(func() {
+					response.HTTPResponse.Header.Set("X-RateLimit-Limit", "100")
+					response.HTTPResponse.Header.Set("X-RateLimit-Remaining", "95")
+					response.HTTPResponse.Header.Set("X-RateLimit-Reset", "1500000000")
+				})
+
+				It("outputs a rate limit summary", func() {
+					Expect(makeErr).NotTo(HaveOccurred())
+					Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+					Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Rate limit: 95 of 100 requests remaining, resets at 2017-07-14T02:40:00Z]"))
+				})
+
+				When("only the remaining requests are provided", func() {
+					BeforeEach(func() {
+						response.HTTPResponse.Header.Del("X-RateLimit-Limit")
+						response.HTTPResponse.Header.Del("X-RateLimit-Reset")
+					})
+
+					It("outputs the remaining requests", func() {
+						Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Rate limit: 95 requests remaining]"))
+					})
+				})
+			})
 		})
 
 		When("the request is unsuccessful", func() {
@@ -2,26 +2,34 @@ package wrapper
 
 import (
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/internal/retry"
 )
 
 // RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// status code, or a 429 status code when the server is rate limiting requests.
 type RetryRequest struct {
 	maxRetries int
 	connection cloudcontroller.Connection
+
+	// Sleep waits before each retry. It defaults to time.Sleep.
+	Sleep func(time.Duration)
 }
 
 // NewRetryRequest returns a pointer to a RetryRequest wrapper.
 func NewRetryRequest(maxRetries int) *RetryRequest {
 	return &RetryRequest{
 		maxRetries: maxRetries,
+		Sleep:      time.Sleep,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 5XX or 429 status code.
+// Retries are delayed by the Retry-After response header when it is provided,
+// and by a jittered exponential backoff otherwise.
 func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	var err error
 
@@ -31,7 +39,8 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		delay, shouldRetry := retry.retryDelay(request, passedResponse.HTTPResponse, i)
+		if !shouldRetry || i == retry.maxRetries {
 			break
 		}
 
@@ -43,6 +52,8 @@ func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse
 			}
 			return resetErr
 		}
+
+		retry.Sleep(delay)
 	}
 	return err
 }
@@ -53,13 +64,14 @@ func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) clou
 	return retry
 }
 
-// skipRetry will skip retry if the request method is POST or contains a status
-// code that is not one of following http status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
+// retryDelay returns how long to wait before retrying the request, and
+// whether it should be retried at all. A 429 status code means the request was
+// not processed, so it is retried regardless of the method. Other requests are
+// retried on a 500, 502, 503 or 504 status code, or when no response was
+// received, unless they are POST requests that are not idempotent.
+func (*RetryRequest) retryDelay(request *cloudcontroller.Request, response *http.Response, attempt int) (time.Duration, bool) {
+	if !retry.IsRateLimited(response) && request.Method == http.MethodPost && !request.Idempotent {
+		return 0, false
+	}
+	return retry.Delay(response, attempt)
 }
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -17,13 +18,14 @@ import (
 
 var _ = Describe("Retry Request", func() {
 	DescribeTable("number of retries",
-		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
+		func(requestMethod string, idempotent bool, responseStatusCode int, expectedNumberOfRetries int) {
 			rawRequestBody := "banana pants"
 			body := strings.NewReader(rawRequestBody)
 
 			req, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", body)
 			Expect(err).NotTo(HaveOccurred())
 			request := cloudcontroller.NewRequest(req, body)
+			request.Idempotent = idempotent
 
 			response := &cloudcontroller.Response{
 				HTTPResponse: &http.Response{
@@ -43,25 +45,112 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			retryWrapper := NewRetryRequest(2)
+			retryWrapper.Sleep = func(time.Duration) {}
+			wrapper := retryWrapper.Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
 		},
 
-		Entry("maxRetries for Non-Post (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, 3),
-		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
-		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
-		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
+		Entry("maxRetries for Non-Post (500) Internal Server Error", http.MethodGet, false, http.StatusInternalServerError, 3),
+		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, false, http.StatusBadGateway, 3),
+		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, false, http.StatusServiceUnavailable, 3),
+		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, false, http.StatusGatewayTimeout, 3),
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, false, http.StatusTooManyRequests, 3),
 
-		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
-		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
-		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
-		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
+		Entry("1 for Post (500) Internal Server Error", http.MethodPost, false, http.StatusInternalServerError, 1),
+		Entry("1 for Post (502) Bad Gateway", http.MethodPost, false, http.StatusBadGateway, 1),
+		Entry("1 for Post (503) Service Unavailable", http.MethodPost, false, http.StatusServiceUnavailable, 1),
+		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, false, http.StatusGatewayTimeout, 1),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, false, http.StatusTooManyRequests, 3),
 
-		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
+		Entry("maxRetries for idempotent Post (500) Internal Server Error", http.MethodPost, true, http.StatusInternalServerError, 3),
+		Entry("maxRetries for idempotent Post (503) Service Unavailable", http.MethodPost, true, http.StatusServiceUnavailable, 3),
+
+		Entry("1 for Get 4XX Errors", http.MethodGet, false, http.StatusNotFound, 1),
 	)
 
+	Describe("delays between retries", func() {
+		var (
+			request        *cloudcontroller.Request
+			response       *cloudcontroller.Response
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+			delays         []time.Duration
+			wrapper        cloudcontroller.Connection
+		)
+
+		BeforeEach(func() {
+			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+			response = &cloudcontroller.Response{
+				HTTPResponse: &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{},
+				},
+			}
+
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeReturns(ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests})
+
+			delays = nil
+			retryWrapper := NewRetryRequest(3)
+			retryWrapper.Sleep = func(delay time.Duration) {
+				delays = append(delays, delay)
+			}
+			wrapper = retryWrapper.Wrap(fakeConnection)
+		})
+
+		When("the response has no Retry-After header", func() {
+			It("backs off exponentially with jitter", func() {
+				Expect(wrapper.Make(request, response)).To(HaveOccurred())
+				Expect(delays).To(HaveLen(3))
+				Expect(delays[0]).To(BeNumerically(">=", 250*time.Millisecond))
+				Expect(delays[0]).To(BeNumerically("<=", 500*time.Millisecond))
+				Expect(delays[1]).To(BeNumerically(">=", 500*time.Millisecond))
+				Expect(delays[1]).To(BeNumerically("<=", time.Second))
+				Expect(delays[2]).To(BeNumerically(">=", time.Second))
+				Expect(delays[2]).To(BeNumerically("<=", 2*time.Second))
+			})
+		})
+
+		When("the response has a Retry-After header in seconds", func() {
+			BeforeEach(func() {
+				response.HTTPResponse.Header.Set("Retry-After", "7")
+			})
+
+			It("waits for the requested number of seconds", func() {
+				Expect(wrapper.Make(request, response)).To(HaveOccurred())
+				Expect(delays).To(Equal([]time.Duration{7 * time.Second, 7 * time.Second, 7 * time.Second}))
+			})
+		})
+
+		When("the response has a Retry-After header with a date", func() {
+			BeforeEach(func() {
+				response.HTTPResponse.Header.Set("Retry-After", time.Now().Add(10*time.Second).UTC().Format(http.TimeFormat))
+			})
+
+			It("waits until the date", func() {
+				Expect(wrapper.Make(request, response)).To(HaveOccurred())
+				Expect(delays).To(HaveLen(3))
+				Expect(delays[0]).To(BeNumerically("~", 10*time.Second, 2*time.Second))
+			})
+		})
+
+		When("the Retry-After header asks to wait too long", func() {
+			BeforeEach(func() {
+				response.HTTPResponse.Header.Set("Retry-After", "3600")
+			})
+
+			It("does not retry", func() {
+				Expect(wrapper.Make(request, response)).To(MatchError(ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				Expect(delays).To(BeEmpty())
+			})
+		})
+	})
+
 	It("does not retry on success", func() {
 		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
 		Expect(err).NotTo(HaveOccurred())
@@ -67,6 +67,17 @@ type FakeRequestLoggerOutput struct {
 	displayRequestHeaderReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisplayRequestIDStub        func(id string) error
+	displayRequestIDMutex       sync.RWMutex
+	displayRequestIDArgsForCall []struct {
+		id string
+	}
+	displayRequestIDReturns struct {
+		result1 error
+	}
+	displayRequestIDReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayResponseHeaderStub        func(httpProtocol string, status string) error
 	displayResponseHeaderMutex       sync.RWMutex
 	displayResponseHeaderArgsForCall []struct {
@@ -366,6 +377,54 @@ func (fake *FakeRequestLoggerOutput) DisplayRequestHeaderReturnsOnCall(i int, re
 	}{result1}
 }
 
+func (fake *FakeRequestLoggerOutput) DisplayRequestID(id string) error {
+	fake.displayRequestIDMutex.Lock()
+	ret, specificReturn := fake.displayRequestIDReturnsOnCall[len(fake.displayRequestIDArgsForCall)]
+	fake.displayRequestIDArgsForCall = append(fake.displayRequestIDArgsForCall, struct {
+		id string
+	}{id})
+	fake.recordInvocation("DisplayRequestID", []interface{}{id})
+	fake.displayRequestIDMutex.Unlock()
+	if fake.DisplayRequestIDStub != nil {
+		return fake.DisplayRequestIDStub(id)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.displayRequestIDReturns.result1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestIDCallCount() int {
+	fake.displayRequestIDMutex.RLock()
+	defer fake.displayRequestIDMutex.RUnlock()
+	return len(fake.displayRequestIDArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestIDArgsForCall(i int) string {
+	fake.displayRequestIDMutex.RLock()
+	defer fake.displayRequestIDMutex.RUnlock()
+	return fake.displayRequestIDArgsForCall[i].id
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestIDReturns(result1 error) {
+	fake.DisplayRequestIDStub = nil
+	fake.displayRequestIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestIDReturnsOnCall(i int, result1 error) {
+	fake.DisplayRequestIDStub = nil
+	if fake.displayRequestIDReturnsOnCall == nil {
+		fake.displayRequestIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayRequestIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRequestLoggerOutput) DisplayResponseHeader(httpProtocol string, status string) error {
 	fake.displayResponseHeaderMutex.Lock()
 	ret, specificReturn := fake.displayResponseHeaderReturnsOnCall[len(fake.displayResponseHeaderArgsForCall)]
@@ -581,6 +640,8 @@ func (fake *FakeRequestLoggerOutput) Invocations() map[string][][]interface{} {
 	defer fake.displayMessageMutex.RUnlock()
 	fake.displayRequestHeaderMutex.RLock()
 	defer fake.displayRequestHeaderMutex.RUnlock()
+	fake.displayRequestIDMutex.RLock()
+	defer fake.displayRequestIDMutex.RUnlock()
 	fake.displayResponseHeaderMutex.RLock()
 	defer fake.displayResponseHeaderMutex.RUnlock()
 	fake.displayTypeMutex.RLock()
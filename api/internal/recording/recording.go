@@ -0,0 +1,230 @@
+// Package recording holds the format of the requests and responses that are
+// recorded and replayed by the Cloud Controller and UAA connection wrappers.
+package recording
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Interaction is a request and its response as they are written by a
+// Recorder and read by a Replayer.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request contains the parts of a request that are matched when it is
+// replayed.
+type Request struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded response. Bodies that are not valid UTF-8 are
+// recorded in base64.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"body_base64,omitempty"`
+}
+
+// NewRequest returns the recorded form of the request. The body is only read,
+// with readBody, when it is JSON or form data, and the query and body are
+// normalized so that the order of their keys does not matter when matching.
+func NewRequest(request *http.Request, readBody func() ([]byte, error)) (Request, error) {
+	recorded := Request{
+		Method: request.Method,
+		Path:   request.URL.Path,
+		Query:  request.URL.Query().Encode(),
+	}
+
+	contentType := request.Header.Get("Content-Type")
+	if request.Body == nil {
+		return recorded, nil
+	}
+
+	switch {
+	case strings.Contains(contentType, "json"):
+		rawBody, err := readBody()
+		if err != nil {
+			return Request{}, err
+		}
+
+		var body interface{}
+		if json.Unmarshal(rawBody, &body) == nil {
+			rawBody, _ = json.Marshal(body)
+		}
+		recorded.Body = string(rawBody)
+	case strings.Contains(contentType, "x-www-form-urlencoded"):
+		rawBody, err := readBody()
+		if err != nil {
+			return Request{}, err
+		}
+
+		body, err := url.ParseQuery(string(rawBody))
+		if err != nil {
+			recorded.Body = string(rawBody)
+		} else {
+			recorded.Body = body.Encode()
+		}
+	}
+
+	return recorded, nil
+}
+
+// key identifies the request among the recordings with the prefix.
+func (recorded Request) key(prefix string) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{recorded.Method, recorded.Path, recorded.Query, recorded.Body}, "\n")))
+	return fmt.Sprintf("%s-%s-%s", prefix, strings.ToLower(recorded.Method), hex.EncodeToString(hash[:8]))
+}
+
+// NewResponse returns the recorded form of the response with the raw body.
+func NewResponse(response *http.Response, rawBody []byte) Response {
+	recorded := Response{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+	}
+
+	if utf8.Valid(rawBody) {
+		recorded.Body = string(rawBody)
+	} else {
+		recorded.BodyBase64 = base64.StdEncoding.EncodeToString(rawBody)
+	}
+	return recorded
+}
+
+// HTTPResponse returns the recorded response as if it was sent in response
+// to the request.
+func (recorded Response) HTTPResponse(request *http.Request) (*http.Response, error) {
+	body := []byte(recorded.Body)
+	if recorded.BodyBase64 != "" {
+		var err error
+		body, err = base64.StdEncoding.DecodeString(recorded.BodyBase64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	header := recorded.Header
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
+
+// Recorder writes interactions to a directory. Repeated requests are recorded
+// in order, since their responses can change (e.g. when polling).
+type Recorder struct {
+	directory string
+	prefix    string
+
+	lock   sync.Mutex
+	counts map[string]int
+}
+
+// NewRecorder returns a Recorder that writes to the directory. The prefix
+// distinguishes the recordings of different servers in the same directory.
+func NewRecorder(directory string, prefix string) *Recorder {
+	return &Recorder{
+		directory: directory,
+		prefix:    prefix,
+		counts:    map[string]int{},
+	}
+}
+
+// Record writes the interaction as the next recording of its request.
+func (recorder *Recorder) Record(interaction Interaction) error {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	err := os.MkdirAll(recorder.directory, 0700)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	key := interaction.Request.key(recorder.prefix)
+	recorder.counts[key]++
+	return ioutil.WriteFile(path(recorder.directory, key, recorder.counts[key]), raw, 0600)
+}
+
+// Replayer reads the interactions written by a Recorder. Repeated requests
+// receive their recorded responses in order, and the last one once they run
+// out.
+type Replayer struct {
+	directory string
+	prefix    string
+
+	lock   sync.Mutex
+	counts map[string]int
+}
+
+// NewReplayer returns a Replayer that reads the recordings with the prefix in
+// the directory.
+func NewReplayer(directory string, prefix string) *Replayer {
+	return &Replayer{
+		directory: directory,
+		prefix:    prefix,
+		counts:    map[string]int{},
+	}
+}
+
+// Next returns the next recorded interaction of the request. The error
+// satisfies os.IsNotExist when the request was not recorded.
+func (replayer *Replayer) Next(request Request) (Interaction, error) {
+	replayer.lock.Lock()
+	defer replayer.lock.Unlock()
+
+	key := request.key(replayer.prefix)
+	n := replayer.counts[key] + 1
+
+	raw, err := ioutil.ReadFile(path(replayer.directory, key, n))
+	if os.IsNotExist(err) && n > 1 {
+		n--
+		raw, err = ioutil.ReadFile(path(replayer.directory, key, n))
+	}
+	if err != nil {
+		return Interaction{}, err
+	}
+	replayer.counts[key] = n
+
+	var interaction Interaction
+	err = json.Unmarshal(raw, &interaction)
+	return interaction, err
+}
+
+// path returns the path of the nth recording of the request with the key.
+func path(directory string, key string, n int) string {
+	return filepath.Join(directory, fmt.Sprintf("%s-%d.json", key, n))
+}
@@ -0,0 +1,109 @@
+// Package retry holds the retry and rate limit handling shared by the Cloud
+// Controller and UAA connection wrappers.
+package retry
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// baseDelay is the delay before the first retry. It doubles with every
+	// further retry, up to maxDelay.
+	baseDelay = 500 * time.Millisecond
+	maxDelay  = 30 * time.Second
+
+	// maxRetryAfter is the longest Retry-After that is waited for. The request
+	// fails instead when the server asks to wait longer.
+	maxRetryAfter = time.Minute
+)
+
+// Delay returns how long to wait before retrying a request that failed with
+// the given response, and whether it should be retried at all. Requests are
+// retried on a 429, 500, 502, 503 or 504 status code, or when no response was
+// received. The caller decides whether the request is safe to retry.
+func Delay(response *http.Response, attempt int) (time.Duration, bool) {
+	if response == nil {
+		return Backoff(attempt), true
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return delayFromResponse(response, attempt)
+	default:
+		return 0, false
+	}
+}
+
+// IsRateLimited returns true if the server refused to process the request
+// because too many requests were made.
+func IsRateLimited(response *http.Response) bool {
+	return response != nil && response.StatusCode == http.StatusTooManyRequests
+}
+
+// Backoff returns a random delay between half and all of the exponential
+// delay for the attempt, so that clients that failed together do not retry
+// together.
+func Backoff(attempt int) time.Duration {
+	delay := maxDelay
+	if attempt < 16 {
+		delay = baseDelay << uint(attempt)
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// RateLimitMessage summarizes the X-RateLimit-* response headers, so that
+// traces show how close the CLI is to being rate limited. It is empty when the
+// server does not rate limit requests.
+func RateLimitMessage(headers http.Header) string {
+	remaining := headers.Get("X-RateLimit-Remaining")
+	if remaining == "" {
+		return ""
+	}
+
+	message := fmt.Sprintf("[Rate limit: %s requests remaining", remaining)
+	if limit := headers.Get("X-RateLimit-Limit"); limit != "" {
+		message = fmt.Sprintf("[Rate limit: %s of %s requests remaining", remaining, limit)
+	}
+	if reset, err := strconv.ParseInt(headers.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		message += fmt.Sprintf(", resets at %s", time.Unix(reset, 0).UTC().Format(time.RFC3339))
+	}
+	return message + "]"
+}
+
+// delayFromResponse returns the delay requested by the Retry-After header,
+// which is either a number of seconds or an HTTP date, falling back to the
+// exponential backoff when the header is missing or invalid.
+func delayFromResponse(response *http.Response, attempt int) (time.Duration, bool) {
+	retryAfter := response.Header.Get("Retry-After")
+	if retryAfter == "" {
+		return Backoff(attempt), true
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(retryAfter); err == nil {
+		delay = time.Until(date)
+	} else {
+		return Backoff(attempt), true
+	}
+
+	if delay > maxRetryAfter {
+		return 0, false
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, true
+}
@@ -0,0 +1,100 @@
+package retry_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/internal/retry"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Retry", func() {
+	Describe("Delay", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			response = &http.Response{Header: http.Header{}}
+		})
+
+		When("no response was received", func() {
+			It("retries after a backoff", func() {
+				delay, shouldRetry := Delay(nil, 1)
+				Expect(shouldRetry).To(BeTrue())
+				Expect(delay).To(BeNumerically(">=", 500*time.Millisecond))
+				Expect(delay).To(BeNumerically("<=", time.Second))
+			})
+		})
+
+		DescribeTable("retries on the status code",
+			func(statusCode int, expectedRetry bool) {
+				response.StatusCode = statusCode
+				_, shouldRetry := Delay(response, 0)
+				Expect(shouldRetry).To(Equal(expectedRetry))
+			},
+			Entry("429", http.StatusTooManyRequests, true),
+			Entry("500", http.StatusInternalServerError, true),
+			Entry("502", http.StatusBadGateway, true),
+			Entry("503", http.StatusServiceUnavailable, true),
+			Entry("504", http.StatusGatewayTimeout, true),
+			Entry("400", http.StatusBadRequest, false),
+			Entry("404", http.StatusNotFound, false),
+		)
+
+		When("the response has a Retry-After header", func() {
+			BeforeEach(func() {
+				response.StatusCode = http.StatusTooManyRequests
+			})
+
+			It("waits the number of seconds", func() {
+				response.Header.Set("Retry-After", "7")
+				delay, shouldRetry := Delay(response, 0)
+				Expect(shouldRetry).To(BeTrue())
+				Expect(delay).To(Equal(7 * time.Second))
+			})
+
+			It("waits until a date in the future", func() {
+				response.Header.Set("Retry-After", time.Now().Add(10*time.Second).UTC().Format(http.TimeFormat))
+				delay, shouldRetry := Delay(response, 0)
+				Expect(shouldRetry).To(BeTrue())
+				Expect(delay).To(BeNumerically("~", 10*time.Second, 2*time.Second))
+			})
+
+			It("does not wait for a date in the past", func() {
+				response.Header.Set("Retry-After", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+				delay, shouldRetry := Delay(response, 0)
+				Expect(shouldRetry).To(BeTrue())
+				Expect(delay).To(BeZero())
+			})
+
+			It("does not retry when the wait is longer than a minute", func() {
+				response.Header.Set("Retry-After", "61")
+				_, shouldRetry := Delay(response, 0)
+				Expect(shouldRetry).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Backoff", func() {
+		It("doubles with every attempt up to 30 seconds", func() {
+			Expect(Backoff(2)).To(BeNumerically("~", 1500*time.Millisecond, 500*time.Millisecond))
+			Expect(Backoff(20)).To(BeNumerically("~", 22500*time.Millisecond, 7500*time.Millisecond))
+		})
+	})
+
+	Describe("RateLimitMessage", func() {
+		It("summarizes the rate limit headers", func() {
+			headers := http.Header{}
+			headers.Set("X-RateLimit-Limit", "100")
+			headers.Set("X-RateLimit-Remaining", "95")
+			headers.Set("X-RateLimit-Reset", "1500000000")
+			Expect(RateLimitMessage(headers)).To(Equal("[Rate limit: 95 of 100 requests remaining, resets at 2017-07-14T02:40:00Z]"))
+		})
+
+		It("is empty when the server does not rate limit requests", func() {
+			Expect(RateLimitMessage(http.Header{})).To(BeEmpty())
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"runtime"
 	"time"
@@ -33,6 +35,14 @@ type Config struct {
 	// In this mode, TLS is susceptible to man-in-the-middle attacks. This should
 	// be used only for testing.
 	SkipSSLValidation bool
+
+	// RootCAs is the certificate pool used to verify plugin repositories. The
+	// system pool is used when it is nil.
+	RootCAs *x509.CertPool
+
+	// Certificates are presented to a plugin repository that requests a client
+	// certificate.
+	Certificates []tls.Certificate
 }
 
 // NewClient returns a new plugin Client.
@@ -46,7 +56,7 @@ func NewClient(config Config) *Client {
 	)
 	client := Client{
 		userAgent:  userAgent,
-		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
+		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout, config.RootCAs, config.Certificates),
 	}
 
 	return &client
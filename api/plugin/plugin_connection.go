@@ -21,11 +21,14 @@ type PluginConnection struct {
 	proxyReader ProxyReader
 }
 
-// NewConnection returns a new PluginConnection
-func NewConnection(skipSSLValidation bool, dialTimeout time.Duration) *PluginConnection {
+// NewConnection returns a new PluginConnection. The system certificate pool
+// is used to verify servers when rootCAs is nil.
+func NewConnection(skipSSLValidation bool, dialTimeout time.Duration, rootCAs *x509.CertPool, certificates []tls.Certificate) *PluginConnection {
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: skipSSLValidation,
+			RootCAs:            rootCAs,
+			Certificates:       certificates,
 		},
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
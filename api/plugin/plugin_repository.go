@@ -11,10 +11,15 @@ type PluginRepository struct {
 	Plugins []Plugin `json:"plugins"`
 }
 
+// PluginBinary is a plugin executable built for a single platform. Checksum
+// is the SHA1 digest of the binary; newer repositories also provide the
+// SHA-256 digest and a base64-encoded Ed25519 signature of that digest.
 type PluginBinary struct {
-	Platform string `json:"platform"`
-	URL      string `json:"url"`
-	Checksum string `json:"checksum"`
+	Platform  string `json:"platform"`
+	URL       string `json:"url"`
+	Checksum  string `json:"checksum"`
+	SHA256    string `json:"sha256"`
+	Signature string `json:"signature"`
 }
 
 type Plugin struct {
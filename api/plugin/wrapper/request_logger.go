@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"sort"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/plugin"
@@ -19,6 +21,7 @@ type RequestLoggerOutput interface {
 	DisplayHost(name string) error
 	DisplayJSONBody(body []byte) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
+	DisplayRequestID(id string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
 	HandleInternalError(err error)
@@ -31,6 +34,10 @@ type RequestLoggerOutput interface {
 type RequestLogger struct {
 	connection plugin.Connection
 	output     RequestLoggerOutput
+
+	// requests counts the requests made, to identify the request each
+	// response belongs to.
+	requests uint64
 }
 
 // NewRequestLogger returns a pointer to a RequestLogger wrapper
@@ -42,7 +49,8 @@ func NewRequestLogger(output RequestLoggerOutput) *RequestLogger {
 
 // Make records the request and the response to UI
 func (logger *RequestLogger) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
-	err := logger.displayRequest(request)
+	id := strconv.FormatUint(atomic.AddUint64(&logger.requests, 1), 10)
+	err := logger.displayRequest(request, id)
 	if err != nil {
 		logger.output.HandleInternalError(err)
 	}
@@ -50,7 +58,7 @@ func (logger *RequestLogger) Make(request *http.Request, passedResponse *plugin.
 	err = logger.connection.Make(request, passedResponse, proxyReader)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(passedResponse, id)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -65,13 +73,17 @@ func (logger *RequestLogger) Wrap(innerconnection plugin.Connection) plugin.Conn
 	return logger
 }
 
-func (logger *RequestLogger) displayRequest(request *http.Request) error {
+func (logger *RequestLogger) displayRequest(request *http.Request, id string) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
 	}
 	defer logger.output.Stop()
 
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayType("REQUEST", time.Now())
 	if err != nil {
 		return err
@@ -106,13 +118,17 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *plugin.Response) error {
+func (logger *RequestLogger) displayResponse(passedResponse *plugin.Response, id string) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
 	}
 	defer logger.output.Stop()
 
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayType("RESPONSE", time.Now())
 	if err != nil {
 		return err
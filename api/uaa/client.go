@@ -23,8 +23,9 @@ type Client struct {
 	userAgent  string
 }
 
-// NewClient returns a new UAA Client with the provided configuration
-func NewClient(config Config) *Client {
+// NewClient returns a new UAA Client with the provided configuration. It
+// returns an error if the configured certificates cannot be loaded.
+func NewClient(config Config) (*Client, error) {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)",
 		config.BinaryName(),
 		config.BinaryVersion(),
@@ -33,13 +34,18 @@ func NewClient(config Config) *Client {
 		runtime.GOOS,
 	)
 
+	rootCAs, certificates, err := config.TLSCertificates()
+	if err != nil {
+		return nil, err
+	}
+
 	client := Client{
 		config: config,
 
-		connection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout()),
+		connection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout(), rootCAs, certificates),
 		userAgent:  userAgent,
 	}
 	client.WrapConnection(NewErrorWrapper())
 
-	return &client
+	return &client, nil
 }
@@ -1,6 +1,7 @@
 package uaa_test
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"runtime"
@@ -26,6 +27,19 @@ var _ = Describe("UAA Client", func() {
 		client = NewTestUAAClientAndStore(fakeConfig)
 	})
 
+	Describe("NewClient", func() {
+		When("the configured certificates cannot be loaded", func() {
+			BeforeEach(func() {
+				fakeConfig.TLSCertificatesReturns(nil, nil, errors.New("some-cert-error"))
+			})
+
+			It("returns the error", func() {
+				_, err := NewClient(fakeConfig)
+				Expect(err).To(MatchError("some-cert-error"))
+			})
+		})
+	})
+
 	Describe("Request Headers", func() {
 		Describe("User-Agent", func() {
 			var userAgent string
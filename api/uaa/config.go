@@ -1,6 +1,10 @@
 package uaa
 
-import "time"
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"time"
+)
 
 //go:generate counterfeiter . Config
 
@@ -16,6 +20,11 @@ type Config interface {
 	// infinite.
 	DialTimeout() time.Duration
 
+	// TLSCertificates returns the certificate pool used to verify UAA and the
+	// certificates presented to UAA when it requests a client certificate. The
+	// system pool is used when the pool is nil.
+	TLSCertificates() (*x509.CertPool, []tls.Certificate, error)
+
 	// SetUAAEndpoint sets the UAA endpoint that is obtained from hitting
 	// <AuthorizationEndpoint>/login.
 	SetUAAEndpoint(uaaEndpoint string)
@@ -42,6 +42,17 @@ func (e UnverifiedServerError) Error() string {
 	return "x509: certificate signed by unknown authority"
 }
 
+// RecordingNotFoundError is returned when a replayed session has no recorded
+// response for a request.
+type RecordingNotFoundError struct {
+	Method string
+	URL    string
+}
+
+func (e RecordingNotFoundError) Error() string {
+	return fmt.Sprintf("No recorded response for %s %s", e.Method, e.URL)
+}
+
 // RequestError represents a generic error encountered while performing the
 // HTTP request. This generic error occurs before a HTTP response is obtained.
 type RequestError struct {
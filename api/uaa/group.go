@@ -0,0 +1,83 @@
+package uaa
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// Group represents a UAA group, which grants its members the scope of the
+// same name.
+type Group struct {
+	// ID is the unique identifier of the group.
+	ID string
+
+	// DisplayName is the name of the group.
+	DisplayName string
+
+	// MemberIDs is the list of IDs of the group's members.
+	MemberIDs []string
+}
+
+// UnmarshalJSON helps unmarshal a UAA SCIM group response.
+func (group *Group) UnmarshalJSON(data []byte) error {
+	var uaaGroup struct {
+		ID          string `json:"id"`
+		DisplayName string `json:"displayName"`
+		Members     []struct {
+			Value string `json:"value"`
+		} `json:"members"`
+	}
+	err := json.Unmarshal(data, &uaaGroup)
+	if err != nil {
+		return err
+	}
+
+	group.ID = uaaGroup.ID
+	group.DisplayName = uaaGroup.DisplayName
+	group.MemberIDs = nil
+	for _, member := range uaaGroup.Members {
+		group.MemberIDs = append(group.MemberIDs, member.Value)
+	}
+	return nil
+}
+
+// AddGroupMember adds the user with the provided ID to the group.
+func (client *Client) AddGroupMember(groupID string, userID string) error {
+	return client.sendJSON(internal.PostGroupMemberRequest, internal.Params{"group_id": groupID}, nil, struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}{
+		Type:  "USER",
+		Value: userID,
+	})
+}
+
+// GetGroups returns the UAA groups matching the SCIM filter, or all groups
+// when the filter is empty.
+func (client *Client) GetGroups(filter string) ([]Group, error) {
+	var groups []Group
+	err := client.getSCIMResources(internal.GetGroupsRequest, filter, func(resources json.RawMessage) (int, error) {
+		var page []Group
+		err := json.Unmarshal(resources, &page)
+		groups = append(groups, page...)
+		return len(page), err
+	})
+	return groups, err
+}
+
+// RemoveGroupMember removes the user with the provided ID from the group.
+func (client *Client) RemoveGroupMember(groupID string, userID string) error {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.DeleteGroupMemberRequest,
+		URIParams: internal.Params{
+			"group_id":  groupID,
+			"member_id": userID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &Response{})
+}
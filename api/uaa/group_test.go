@@ -0,0 +1,113 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Group", func() {
+	var (
+		client *Client
+
+		fakeConfig *uaafakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = NewTestConfig()
+
+		client = NewTestUAAClientAndStore(fakeConfig)
+	})
+
+	Describe("GetGroups", func() {
+		BeforeEach(func() {
+			response := `{
+				"resources": [
+					{
+						"id": "some-group-id",
+						"displayName": "cloud_controller.admin",
+						"members": [
+							{"value": "user-id-1", "type": "USER", "origin": "uaa"},
+							{"value": "user-id-2", "type": "USER", "origin": "uaa"}
+						]
+					}
+				],
+				"startIndex": 1,
+				"itemsPerPage": 1,
+				"totalResults": 1
+			}`
+			uaaServer.AppendHandlers(
+				CombineHandlers(
+					verifyRequestHost(TestUAAResource),
+					VerifyRequest(http.MethodGet, "/Groups", `filter=displayName+eq+%22cloud_controller.admin%22&startIndex=1`),
+					RespondWith(http.StatusOK, response),
+				))
+		})
+
+		It("returns the groups and their members", func() {
+			groups, err := client.GetGroups(`displayName eq "cloud_controller.admin"`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(Equal([]Group{
+				{
+					ID:          "some-group-id",
+					DisplayName: "cloud_controller.admin",
+					MemberIDs:   []string{"user-id-1", "user-id-2"},
+				},
+			}))
+		})
+	})
+
+	Describe("AddGroupMember", func() {
+		When("no errors occur", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodPost, "/Groups/some-group-id/members"),
+						VerifyHeaderKV("Content-Type", "application/json"),
+						VerifyBody([]byte(`{"type":"USER","value":"some-user-id"}`)),
+						RespondWith(http.StatusCreated, `{"value": "some-user-id"}`),
+					))
+			})
+
+			It("adds the user to the group", func() {
+				Expect(client.AddGroupMember("some-group-id", "some-user-id")).To(Succeed())
+			})
+		})
+
+		When("the user is already a member", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodPost, "/Groups/some-group-id/members"),
+						RespondWith(http.StatusConflict, `{"error": "member_already_exists", "error_description": "Member some-user-id already exists in group some-group-id"}`),
+					))
+			})
+
+			It("returns a ConflictError", func() {
+				err := client.AddGroupMember("some-group-id", "some-user-id")
+				Expect(err).To(MatchError(ConflictError{Message: "Member some-user-id already exists in group some-group-id"}))
+			})
+		})
+	})
+
+	Describe("RemoveGroupMember", func() {
+		BeforeEach(func() {
+			uaaServer.AppendHandlers(
+				CombineHandlers(
+					verifyRequestHost(TestUAAResource),
+					VerifyRequest(http.MethodDelete, "/Groups/some-group-id/members/some-user-id"),
+					RespondWith(http.StatusOK, `{"value": "some-user-id"}`),
+				))
+		})
+
+		It("removes the user from the group", func() {
+			Expect(client.RemoveGroupMember("some-group-id", "some-user-id")).To(Succeed())
+		})
+	})
+})
@@ -5,14 +5,30 @@ import (
 )
 
 const (
-	GetSSHPasscodeRequest = "GetSSHPasscode"
-	PostOAuthTokenRequest = "PostOAuthToken"
-	PostUserRequest       = "PostUser"
+	DeleteGroupMemberRequest = "DeleteGroupMember"
+	DeleteUserRequest        = "DeleteUser"
+	GetGroupsRequest         = "GetGroups"
+	GetSSHPasscodeRequest    = "GetSSHPasscode"
+	GetUsersRequest          = "GetUsers"
+	PatchUserRequest         = "PatchUser"
+	PatchUserStatusRequest   = "PatchUserStatus"
+	PostGroupMemberRequest   = "PostGroupMember"
+	PostOAuthTokenRequest    = "PostOAuthToken"
+	PostUserRequest          = "PostUser"
+	PutUserPasswordRequest   = "PutUserPassword"
 )
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = []Route{
+	{Path: "/Groups", Method: http.MethodGet, Name: GetGroupsRequest, Resource: UAAResource},
+	{Path: "/Groups/:group_id/members", Method: http.MethodPost, Name: PostGroupMemberRequest, Resource: UAAResource},
+	{Path: "/Groups/:group_id/members/:member_id", Method: http.MethodDelete, Name: DeleteGroupMemberRequest, Resource: UAAResource},
+	{Path: "/Users", Method: http.MethodGet, Name: GetUsersRequest, Resource: UAAResource},
 	{Path: "/Users", Method: http.MethodPost, Name: PostUserRequest, Resource: UAAResource},
+	{Path: "/Users/:user_id", Method: http.MethodDelete, Name: DeleteUserRequest, Resource: UAAResource},
+	{Path: "/Users/:user_id", Method: http.MethodPatch, Name: PatchUserRequest, Resource: UAAResource},
+	{Path: "/Users/:user_id/password", Method: http.MethodPut, Name: PutUserPasswordRequest, Resource: UAAResource},
+	{Path: "/Users/:user_id/status", Method: http.MethodPatch, Name: PatchUserStatusRequest, Resource: UAAResource},
 	{Path: "/oauth/authorize", Method: http.MethodGet, Name: GetSSHPasscodeRequest, Resource: UAAResource},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: PostOAuthTokenRequest, Resource: AuthorizationResource},
 }
@@ -20,7 +20,9 @@ var _ = Describe("SetupResources", func() {
 
 	BeforeEach(func() {
 		fakeConfig = NewTestConfig()
-		client = NewClient(fakeConfig)
+		var err error
+		client, err = NewClient(fakeConfig)
+		Expect(err).ToNot(HaveOccurred())
 	})
 
 	JustBeforeEach(func() {
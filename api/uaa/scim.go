@@ -0,0 +1,86 @@
+package uaa
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// scimPage represents a page of SCIM resources (users or groups) returned by
+// the UAA.
+type scimPage struct {
+	Resources    json.RawMessage `json:"resources"`
+	StartIndex   int             `json:"startIndex"`
+	ItemsPerPage int             `json:"itemsPerPage"`
+	TotalResults int             `json:"totalResults"`
+}
+
+// getSCIMResources requests every page of the resources matching the SCIM
+// filter. handlePage is called with the resources of each page, and returns
+// how many resources it contained.
+func (client *Client) getSCIMResources(requestName string, filter string, handlePage func(json.RawMessage) (int, error)) error {
+	startIndex := 1
+	for {
+		query := url.Values{}
+		query.Set("startIndex", strconv.Itoa(startIndex))
+		if filter != "" {
+			query.Set("filter", filter)
+		}
+
+		request, err := client.newRequest(requestOptions{
+			RequestName: requestName,
+			Query:       query,
+		})
+		if err != nil {
+			return err
+		}
+
+		var page scimPage
+		err = client.connection.Make(request, &Response{Result: &page})
+		if err != nil {
+			return err
+		}
+
+		count := 0
+		if len(page.Resources) > 0 {
+			count, err = handlePage(page.Resources)
+			if err != nil {
+				return err
+			}
+		}
+
+		startIndex += count
+		if count == 0 || startIndex > page.TotalResults {
+			return nil
+		}
+	}
+}
+
+// sendJSON sends the body as JSON to the route, and ignores the response.
+func (client *Client) sendJSON(requestName string, uriParams internal.Params, header http.Header, body interface{}) error {
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Type", "application/json")
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Header:      header,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &Response{})
+}
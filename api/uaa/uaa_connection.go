@@ -17,8 +17,9 @@ type UAAConnection struct {
 	HTTPClient *http.Client
 }
 
-// NewConnection returns a pointer to a new UAA Connection
-func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout time.Duration) *UAAConnection {
+// NewConnection returns a pointer to a new UAA Connection. The system
+// certificate pool is used to verify UAA when rootCAs is nil.
+func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout time.Duration, rootCAs *x509.CertPool, certificates []tls.Certificate) *UAAConnection {
 	tr := &http.Transport{
 		DialContext: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
@@ -28,6 +29,8 @@ func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout t
 		Proxy:             http.ProxyFromEnvironment,
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: skipSSLValidation,
+			RootCAs:            rootCAs,
+			Certificates:       certificates,
 		},
 	}
 
@@ -71,6 +74,16 @@ func (*UAAConnection) handleStatusCodes(response *http.Response, passedResponse
 	return nil
 }
 
+// PopulateResponse injects the HTTP response into passedResponse the same way
+// the connection does, returning a RawHTTPStatusError for 4xx and 5xx status
+// codes. It allows connection wrappers to provide responses themselves.
+func PopulateResponse(response *http.Response, passedResponse *Response) error {
+	passedResponse.reset()
+
+	var connection *UAAConnection
+	return connection.populateResponse(response, passedResponse)
+}
+
 func (connection *UAAConnection) populateResponse(response *http.Response, passedResponse *Response) error {
 	passedResponse.HTTPResponse = response
 
@@ -22,7 +22,7 @@ var _ = Describe("UAA Connection", func() {
 	)
 
 	BeforeEach(func() {
-		connection = NewConnection(true, true, 0)
+		connection = NewConnection(true, true, 0, nil, nil)
 	})
 
 	Describe("Make", func() {
@@ -100,7 +100,7 @@ var _ = Describe("UAA Connection", func() {
 		Describe("Errors", func() {
 			When("the server does not exist", func() {
 				BeforeEach(func() {
-					connection = NewConnection(false, true, 0)
+					connection = NewConnection(false, true, 0, nil, nil)
 				})
 
 				It("returns a RequestError", func() {
@@ -126,7 +126,7 @@ var _ = Describe("UAA Connection", func() {
 							),
 						)
 
-						connection = NewConnection(false, true, 0)
+						connection = NewConnection(false, true, 0, nil, nil)
 					})
 
 					It("returns a UnverifiedServerError", func() {
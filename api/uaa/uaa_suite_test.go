@@ -69,11 +69,12 @@ func NewTestConfig() *uaafakes.FakeConfig {
 func NewTestUAAClientAndStore(config Config) *Client {
 	SetupBootstrapResponse()
 
-	client := NewClient(config)
+	client, err := NewClient(config)
+	Expect(err).ToNot(HaveOccurred())
 
 	// the 'uaaServer' is discovered via the bootstrapping when we hit the /login
 	// endpoint on 'server'
-	err := client.SetupResources(server.URL())
+	err = client.SetupResources(server.URL())
 	Expect(err).ToNot(HaveOccurred())
 
 	return client
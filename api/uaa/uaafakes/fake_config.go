@@ -2,6 +2,8 @@
 package uaafakes
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"sync"
 	"time"
 
@@ -36,6 +38,19 @@ type FakeConfig struct {
 	dialTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	TLSCertificatesStub        func() (*x509.CertPool, []tls.Certificate, error)
+	tLSCertificatesMutex       sync.RWMutex
+	tLSCertificatesArgsForCall []struct{}
+	tLSCertificatesReturns     struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}
+	tLSCertificatesReturnsOnCall map[int]struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}
 	SetUAAEndpointStub        func(uaaEndpoint string)
 	setUAAEndpointMutex       sync.RWMutex
 	setUAAEndpointArgsForCall []struct {
@@ -210,6 +225,52 @@ func (fake *FakeConfig) DialTimeoutReturnsOnCall(i int, result1 time.Duration) {
 	}{result1}
 }
 
+func (fake *FakeConfig) TLSCertificates() (*x509.CertPool, []tls.Certificate, error) {
+	fake.tLSCertificatesMutex.Lock()
+	ret, specificReturn := fake.tLSCertificatesReturnsOnCall[len(fake.tLSCertificatesArgsForCall)]
+	fake.tLSCertificatesArgsForCall = append(fake.tLSCertificatesArgsForCall, struct{}{})
+	fake.recordInvocation("TLSCertificates", []interface{}{})
+	fake.tLSCertificatesMutex.Unlock()
+	if fake.TLSCertificatesStub != nil {
+		return fake.TLSCertificatesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.tLSCertificatesReturns.result1, fake.tLSCertificatesReturns.result2, fake.tLSCertificatesReturns.result3
+}
+
+func (fake *FakeConfig) TLSCertificatesCallCount() int {
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
+	return len(fake.tLSCertificatesArgsForCall)
+}
+
+func (fake *FakeConfig) TLSCertificatesReturns(result1 *x509.CertPool, result2 []tls.Certificate, result3 error) {
+	fake.TLSCertificatesStub = nil
+	fake.tLSCertificatesReturns = struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeConfig) TLSCertificatesReturnsOnCall(i int, result1 *x509.CertPool, result2 []tls.Certificate, result3 error) {
+	fake.TLSCertificatesStub = nil
+	if fake.tLSCertificatesReturnsOnCall == nil {
+		fake.tLSCertificatesReturnsOnCall = make(map[int]struct {
+			result1 *x509.CertPool
+			result2 []tls.Certificate
+			result3 error
+		})
+	}
+	fake.tLSCertificatesReturnsOnCall[i] = struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeConfig) SetUAAEndpoint(uaaEndpoint string) {
 	fake.setUAAEndpointMutex.Lock()
 	fake.setUAAEndpointArgsForCall = append(fake.setUAAEndpointArgsForCall, struct {
@@ -443,6 +504,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryVersionMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
 	fake.setUAAEndpointMutex.RLock()
 	defer fake.setUAAEndpointMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
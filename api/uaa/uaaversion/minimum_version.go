@@ -5,4 +5,7 @@ const (
 	// MinVersionOrigin is the minimum version that the 'origin' field is avalible
 	// for token creation.
 	MinVersionOrigin = "4.19.0"
+	// MinVersionUserAccountStatus is the minimum version that user accounts can
+	// be deactivated and unlocked.
+	MinVersionUserAccountStatus = "4.5.0"
 )
@@ -10,7 +10,52 @@ import (
 
 // User represents an UAA user account.
 type User struct {
+	// ID is the unique identifier of the user.
 	ID string
+
+	// Username is the name the user logs in with.
+	Username string
+
+	// Origin is the identity provider the user belongs to.
+	Origin string
+
+	// Active is false when the user has been deactivated and cannot log in.
+	Active bool
+
+	// Verified is true when the user has verified their email address.
+	Verified bool
+
+	// Groups is the list of names of the groups the user is a member of.
+	Groups []string
+}
+
+// UnmarshalJSON helps unmarshal a UAA SCIM user response.
+func (user *User) UnmarshalJSON(data []byte) error {
+	var uaaUser struct {
+		ID       string `json:"id"`
+		Username string `json:"userName"`
+		Origin   string `json:"origin"`
+		Active   bool   `json:"active"`
+		Verified bool   `json:"verified"`
+		Groups   []struct {
+			Display string `json:"display"`
+		} `json:"groups"`
+	}
+	err := json.Unmarshal(data, &uaaUser)
+	if err != nil {
+		return err
+	}
+
+	user.ID = uaaUser.ID
+	user.Username = uaaUser.Username
+	user.Origin = uaaUser.Origin
+	user.Active = uaaUser.Active
+	user.Verified = uaaUser.Verified
+	user.Groups = nil
+	for _, group := range uaaUser.Groups {
+		user.Groups = append(user.Groups, group.Display)
+	}
+	return nil
 }
 
 // newUserRequestBody represents the body of the request.
@@ -83,3 +128,62 @@ func (client *Client) CreateUser(user string, password string, origin string) (U
 
 	return User{ID: userResponse.ID}, nil
 }
+
+// DeleteUser deletes the UAA user account with the provided ID.
+func (client *Client) DeleteUser(userID string) error {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.DeleteUserRequest,
+		URIParams:   internal.Params{"user_id": userID},
+		Header: http.Header{
+			"If-Match": {"*"},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Make(request, &Response{})
+}
+
+// GetUsers returns the UAA user accounts matching the SCIM filter, or all
+// user accounts when the filter is empty.
+func (client *Client) GetUsers(filter string) ([]User, error) {
+	var users []User
+	err := client.getSCIMResources(internal.GetUsersRequest, filter, func(resources json.RawMessage) (int, error) {
+		var page []User
+		err := json.Unmarshal(resources, &page)
+		users = append(users, page...)
+		return len(page), err
+	})
+	return users, err
+}
+
+// ResetUserPassword sets the password of the UAA user account with the
+// provided ID, without requiring the user's current password.
+func (client *Client) ResetUserPassword(userID string, password string) error {
+	return client.sendJSON(internal.PutUserPasswordRequest, internal.Params{"user_id": userID}, nil, struct {
+		Password string `json:"password"`
+	}{
+		Password: password,
+	})
+}
+
+// SetUserActive activates or deactivates the UAA user account with the
+// provided ID. Deactivated users cannot log in.
+func (client *Client) SetUserActive(userID string, active bool) error {
+	return client.sendJSON(internal.PatchUserRequest, internal.Params{"user_id": userID}, http.Header{"If-Match": {"*"}}, struct {
+		Active bool `json:"active"`
+	}{
+		Active: active,
+	})
+}
+
+// UnlockUser unlocks the UAA user account with the provided ID after it has
+// been locked by too many failed login attempts.
+func (client *Client) UnlockUser(userID string) error {
+	return client.sendJSON(internal.PatchUserStatusRequest, internal.Params{"user_id": userID}, nil, struct {
+		Locked bool `json:"locked"`
+	}{
+		Locked: false,
+	})
+}
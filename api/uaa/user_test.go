@@ -100,4 +100,189 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("GetUsers", func() {
+		When("the users span multiple pages", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"resources": [
+						{
+							"id": "user-id-1",
+							"userName": "some-user",
+							"origin": "uaa",
+							"active": true,
+							"verified": true,
+							"groups": [{"value": "group-id-1", "display": "cloud_controller.admin"}]
+						}
+					],
+					"startIndex": 1,
+					"itemsPerPage": 1,
+					"totalResults": 2
+				}`
+				response2 := `{
+					"resources": [
+						{
+							"id": "user-id-2",
+							"userName": "some-user",
+							"origin": "ldap",
+							"active": false
+						}
+					],
+					"startIndex": 2,
+					"itemsPerPage": 1,
+					"totalResults": 2
+				}`
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users", `filter=userName+eq+%22some-user%22&startIndex=1`),
+						RespondWith(http.StatusOK, response1),
+					),
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users", `filter=userName+eq+%22some-user%22&startIndex=2`),
+						RespondWith(http.StatusOK, response2),
+					),
+				)
+			})
+
+			It("returns the users from all pages", func() {
+				users, err := client.GetUsers(`userName eq "some-user"`)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(users).To(Equal([]User{
+					{
+						ID:       "user-id-1",
+						Username: "some-user",
+						Origin:   "uaa",
+						Active:   true,
+						Verified: true,
+						Groups:   []string{"cloud_controller.admin"},
+					},
+					{
+						ID:       "user-id-2",
+						Username: "some-user",
+						Origin:   "ldap",
+					},
+				}))
+			})
+		})
+
+		When("no users match", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users", "startIndex=1"),
+						RespondWith(http.StatusOK, `{"resources": [], "startIndex": 1, "itemsPerPage": 0, "totalResults": 0}`),
+					))
+			})
+
+			It("returns no users", func() {
+				users, err := client.GetUsers("")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(users).To(BeEmpty())
+			})
+		})
+
+		When("an error occurs", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/Users"),
+						RespondWith(http.StatusForbidden, `{"error": "insufficient_scope", "error_description": "some-description"}`),
+					))
+			})
+
+			It("returns the error", func() {
+				_, err := client.GetUsers("")
+				Expect(err).To(MatchError(InsufficientScopeError{Message: "some-description"}))
+			})
+		})
+	})
+
+	Describe("DeleteUser", func() {
+		BeforeEach(func() {
+			uaaServer.AppendHandlers(
+				CombineHandlers(
+					verifyRequestHost(TestUAAResource),
+					VerifyRequest(http.MethodDelete, "/Users/some-user-id"),
+					VerifyHeaderKV("If-Match", "*"),
+					RespondWith(http.StatusOK, `{"id": "some-user-id"}`),
+				))
+		})
+
+		It("deletes the user", func() {
+			Expect(client.DeleteUser("some-user-id")).To(Succeed())
+		})
+	})
+
+	Describe("SetUserActive", func() {
+		BeforeEach(func() {
+			uaaServer.AppendHandlers(
+				CombineHandlers(
+					verifyRequestHost(TestUAAResource),
+					VerifyRequest(http.MethodPatch, "/Users/some-user-id"),
+					VerifyHeaderKV("If-Match", "*"),
+					VerifyHeaderKV("Content-Type", "application/json"),
+					VerifyBody([]byte(`{"active":false}`)),
+					RespondWith(http.StatusOK, `{"id": "some-user-id"}`),
+				))
+		})
+
+		It("deactivates the user", func() {
+			Expect(client.SetUserActive("some-user-id", false)).To(Succeed())
+		})
+	})
+
+	Describe("UnlockUser", func() {
+		BeforeEach(func() {
+			uaaServer.AppendHandlers(
+				CombineHandlers(
+					verifyRequestHost(TestUAAResource),
+					VerifyRequest(http.MethodPatch, "/Users/some-user-id/status"),
+					VerifyBody([]byte(`{"locked":false}`)),
+					RespondWith(http.StatusOK, `{"locked": false}`),
+				))
+		})
+
+		It("unlocks the user", func() {
+			Expect(client.UnlockUser("some-user-id")).To(Succeed())
+		})
+	})
+
+	Describe("ResetUserPassword", func() {
+		When("no errors occur", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodPut, "/Users/some-user-id/password"),
+						VerifyBody([]byte(`{"password":"some-password"}`)),
+						RespondWith(http.StatusOK, `{"status": "ok"}`),
+					))
+			})
+
+			It("sets the password", func() {
+				Expect(client.ResetUserPassword("some-user-id", "some-password")).To(Succeed())
+			})
+		})
+
+		When("the password is rejected", func() {
+			BeforeEach(func() {
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodPut, "/Users/some-user-id/password"),
+						RespondWith(http.StatusBadRequest, `{"error": "invalid_password", "error_description": "Password must be at least 8 characters"}`),
+					))
+			})
+
+			It("returns the error", func() {
+				err := client.ResetUserPassword("some-user-id", "short")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
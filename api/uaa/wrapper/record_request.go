@@ -0,0 +1,76 @@
+package wrapper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/internal/recording"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// recordingPrefix distinguishes the UAA's recordings from the Cloud
+// Controller's when both are recorded to the same directory.
+const recordingPrefix = "uaa"
+
+// RecordRequest is a wrapper that records requests and their responses to a
+// directory, so that they can be replayed by ReplayRequest. Repeated requests
+// are recorded in order, since their responses can change (e.g. when polling).
+//
+// The recordings include the responses' tokens and other secrets.
+type RecordRequest struct {
+	connection uaa.Connection
+	recorder   *recording.Recorder
+}
+
+// NewRecordRequest returns a pointer to a RecordRequest wrapper that records
+// to the directory.
+func NewRecordRequest(directory string) *RecordRequest {
+	return &RecordRequest{
+		recorder: recording.NewRecorder(directory, recordingPrefix),
+	}
+}
+
+// Make records the request and the response it receives. Requests that do
+// not receive a response are not recorded.
+func (recorder *RecordRequest) Make(request *http.Request, passedResponse *uaa.Response) error {
+	recordedRequest, err := newRecordedRequest(request)
+	if err != nil {
+		return err
+	}
+
+	err = recorder.connection.Make(request, passedResponse)
+
+	if passedResponse.HTTPResponse != nil {
+		recordErr := recorder.recorder.Record(recording.Interaction{
+			Request:  recordedRequest,
+			Response: recording.NewResponse(passedResponse.HTTPResponse, passedResponse.RawResponse),
+		})
+		if recordErr != nil {
+			return recordErr
+		}
+	}
+
+	return err
+}
+
+// Wrap sets the connection in the RecordRequest and returns itself.
+func (recorder *RecordRequest) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	recorder.connection = innerconnection
+	return recorder
+}
+
+// newRecordedRequest returns the recorded form of the request, replacing its
+// body once it is read.
+func newRecordedRequest(request *http.Request) (recording.Request, error) {
+	return recording.NewRequest(request, func() ([]byte, error) {
+		rawBody, err := ioutil.ReadAll(request.Body)
+		defer request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		request.Body = ioutil.NopCloser(bytes.NewBuffer(rawBody))
+		return rawBody, nil
+	})
+}
@@ -0,0 +1,102 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RecordRequest", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		directory      string
+
+		wrapper uaa.Connection
+
+		request  *http.Request
+		response *uaa.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		var err error
+		directory, err = ioutil.TempDir("", "uaa-recordings")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeConnection.MakeStub = func(req *http.Request, passedResponse *uaa.Response) error {
+			body, readErr := ioutil.ReadAll(req.Body)
+			Expect(readErr).ToNot(HaveOccurred())
+			Expect(string(body)).To(Equal("username=some-user&grant_type=password"))
+
+			passedResponse.RawResponse = []byte(`{"access_token":"some-token"}`)
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+			return nil
+		}
+
+		wrapper = NewRecordRequest(directory).Wrap(fakeConnection)
+
+		request, err = http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", strings.NewReader("username=some-user&grant_type=password"))
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		response = new(uaa.Response)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(directory)
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	It("records the request and response", func() {
+		Expect(makeErr).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+		recordings, err := filepath.Glob(filepath.Join(directory, "uaa-post-*-1.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recordings).To(HaveLen(1))
+
+		raw, err := ioutil.ReadFile(recordings[0])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(raw).To(MatchJSON(`{
+			"request": {
+				"method": "POST",
+				"path": "/oauth/token",
+				"body": "grant_type=password&username=some-user"
+			},
+			"response": {
+				"status_code": 200,
+				"header": null,
+				"body": "{\"access_token\":\"some-token\"}"
+			}
+		}`))
+	})
+
+	When("no response is received", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = nil
+			fakeConnection.MakeReturns(errors.New("some-error"))
+		})
+
+		It("does not record the request", func() {
+			Expect(makeErr).To(MatchError("some-error"))
+
+			recordings, err := filepath.Glob(filepath.Join(directory, "*.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recordings).To(BeEmpty())
+		})
+	})
+})
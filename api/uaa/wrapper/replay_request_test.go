@@ -0,0 +1,74 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReplayRequest", func() {
+	var (
+		directory string
+		recorder  *RecordRequest
+		replay    uaa.Connection
+	)
+
+	newRequest := func(body string) *http.Request {
+		request, err := http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return request
+	}
+
+	BeforeEach(func() {
+		var err error
+		directory, err = ioutil.TempDir("", "uaa-recordings")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder = NewRecordRequest(directory)
+		replay = NewReplayRequest(directory).Wrap(new(uaafakes.FakeConnection))
+
+		fakeConnection := new(uaafakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+			passedResponse.RawResponse = []byte(`{"access_token":"some-token"}`)
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+			return nil
+		}
+		Expect(recorder.Wrap(fakeConnection).Make(newRequest("username=some-user&grant_type=password"), new(uaa.Response))).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(directory)
+	})
+
+	When("the request was recorded", func() {
+		It("populates the response from the recording", func() {
+			var result struct {
+				AccessToken string `json:"access_token"`
+			}
+			response := uaa.Response{Result: &result}
+
+			Expect(replay.Make(newRequest("grant_type=password&username=some-user"), &response)).To(Succeed())
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+			Expect(result.AccessToken).To(Equal("some-token"))
+		})
+	})
+
+	When("the request was not recorded", func() {
+		It("returns a RecordingNotFoundError", func() {
+			err := replay.Make(newRequest("grant_type=refresh_token"), new(uaa.Response))
+			Expect(err).To(MatchError(uaa.RecordingNotFoundError{
+				Method: http.MethodPost,
+				URL:    "https://uaa.example.com/oauth/token",
+			}))
+		})
+	})
+})
@@ -5,8 +5,11 @@ import (
 	"io/ioutil"
 	"net/http"
 	"sort"
+	"strconv"
+	"sync/atomic"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/internal/retry"
 	"code.cloudfoundry.org/cli/api/uaa"
 )
 
@@ -18,7 +21,9 @@ type RequestLoggerOutput interface {
 	DisplayJSONBody(body []byte) error
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
+	DisplayMessage(msg string) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
+	DisplayRequestID(id string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
 	HandleInternalError(err error)
@@ -31,6 +36,10 @@ type RequestLoggerOutput interface {
 type RequestLogger struct {
 	connection uaa.Connection
 	output     RequestLoggerOutput
+
+	// requests counts the requests made, to identify the request each
+	// response belongs to.
+	requests uint64
 }
 
 // NewRequestLogger returns a pointer to a RequestLogger wrapper
@@ -42,7 +51,8 @@ func NewRequestLogger(output RequestLoggerOutput) *RequestLogger {
 
 // Make records the request and the response to UI
 func (logger *RequestLogger) Make(request *http.Request, passedResponse *uaa.Response) error {
-	err := logger.displayRequest(request)
+	id := strconv.FormatUint(atomic.AddUint64(&logger.requests, 1), 10)
+	err := logger.displayRequest(request, id)
 	if err != nil {
 		logger.output.HandleInternalError(err)
 	}
@@ -50,7 +60,7 @@ func (logger *RequestLogger) Make(request *http.Request, passedResponse *uaa.Res
 	err = logger.connection.Make(request, passedResponse)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(passedResponse, id)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -65,13 +75,17 @@ func (logger *RequestLogger) Wrap(innerconnection uaa.Connection) uaa.Connection
 	return logger
 }
 
-func (logger *RequestLogger) displayRequest(request *http.Request) error {
+func (logger *RequestLogger) displayRequest(request *http.Request, id string) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
 	}
 	defer logger.output.Stop()
 
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayType("REQUEST", time.Now())
 	if err != nil {
 		return err
@@ -110,13 +124,17 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *uaa.Response) error {
+func (logger *RequestLogger) displayResponse(passedResponse *uaa.Response, id string) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
 	}
 	defer logger.output.Stop()
 
+	err = logger.output.DisplayRequestID(id)
+	if err != nil {
+		return err
+	}
 	err = logger.output.DisplayType("RESPONSE", time.Now())
 	if err != nil {
 		return err
@@ -129,6 +147,12 @@ func (logger *RequestLogger) displayResponse(passedResponse *uaa.Response) error
 	if err != nil {
 		return err
 	}
+	if rateLimit := retry.RateLimitMessage(passedResponse.HTTPResponse.Header); rateLimit != "" {
+		err = logger.output.DisplayMessage(rateLimit)
+		if err != nil {
+			return err
+		}
+	}
 	return logger.output.DisplayJSONBody(passedResponse.RawResponse)
 }
 
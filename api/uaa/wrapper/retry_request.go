@@ -4,25 +4,33 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"time"
 
+	"code.cloudfoundry.org/cli/api/internal/retry"
 	"code.cloudfoundry.org/cli/api/uaa"
 )
 
 // RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// status code, or a 429 status code when UAA is rate limiting requests.
 type RetryRequest struct {
 	maxRetries int
 	connection uaa.Connection
+
+	// Sleep waits before each retry. It defaults to time.Sleep.
+	Sleep func(time.Duration)
 }
 
 // NewRetryRequest returns a pointer to a RetryRequest wrapper.
 func NewRetryRequest(maxRetries int) *RetryRequest {
 	return &RetryRequest{
 		maxRetries: maxRetries,
+		Sleep:      time.Sleep,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 5XX or 429 status code.
+// Retries are delayed by the Retry-After response header when it is provided,
+// and by a jittered exponential backoff otherwise.
 func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Response) error {
 	var err error
 	var rawRequestBody []byte
@@ -44,9 +52,11 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Respo
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		delay, shouldRetry := retry.retryDelay(request.Method, passedResponse.HTTPResponse, i)
+		if !shouldRetry || i == retry.maxRetries {
 			break
 		}
+		retry.Sleep(delay)
 	}
 	return err
 }
@@ -57,13 +67,15 @@ func (retry *RetryRequest) Wrap(innerconnection uaa.Connection) uaa.Connection {
 	return retry
 }
 
-// skipRetry will skip retry if the request method is POST or contains a status
-// code that is not one of following http status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
+// retryDelay returns how long to wait before retrying the request, and
+// whether it should be retried at all. A 429 status code means the request was
+// not processed, so it is retried regardless of the method. Other requests are
+// retried on a 500, 502, 503 or 504 status code, or when no response was
+// received, unless they are POST requests. UAA POST requests grant tokens or
+// create resources, so they are never assumed to be idempotent.
+func (*RetryRequest) retryDelay(httpMethod string, response *http.Response, attempt int) (time.Duration, bool) {
+	if !retry.IsRateLimited(response) && httpMethod == http.MethodPost {
+		return 0, false
+	}
+	return retry.Delay(response, attempt)
 }
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -40,7 +41,9 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			retryWrapper := NewRetryRequest(2)
+			retryWrapper.Sleep = func(time.Duration) {}
+			wrapper := retryWrapper.Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -50,11 +53,13 @@ var _ = Describe("Retry Request", func() {
 		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
 		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
 		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
 
 		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
 		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
 
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
@@ -75,4 +80,69 @@ var _ = Describe("Retry Request", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	Describe("delays between retries", func() {
+		var (
+			request        *http.Request
+			response       *uaa.Response
+			fakeConnection *uaafakes.FakeConnection
+			delays         []time.Duration
+			wrapper        uaa.Connection
+		)
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodPost, "https://foo.bar.com/oauth/token", nil)
+			Expect(err).NotTo(HaveOccurred())
+			response = &uaa.Response{
+				HTTPResponse: &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{},
+				},
+			}
+
+			fakeConnection = new(uaafakes.FakeConnection)
+			fakeConnection.MakeReturns(uaa.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests})
+
+			delays = nil
+			retryWrapper := NewRetryRequest(2)
+			retryWrapper.Sleep = func(delay time.Duration) {
+				delays = append(delays, delay)
+			}
+			wrapper = retryWrapper.Wrap(fakeConnection)
+		})
+
+		When("the response has no Retry-After header", func() {
+			It("backs off exponentially with jitter", func() {
+				Expect(wrapper.Make(request, response)).To(HaveOccurred())
+				Expect(delays).To(HaveLen(2))
+				Expect(delays[0]).To(BeNumerically(">=", 250*time.Millisecond))
+				Expect(delays[0]).To(BeNumerically("<=", 500*time.Millisecond))
+				Expect(delays[1]).To(BeNumerically(">=", 500*time.Millisecond))
+				Expect(delays[1]).To(BeNumerically("<=", time.Second))
+			})
+		})
+
+		When("the response has a Retry-After header", func() {
+			BeforeEach(func() {
+				response.HTTPResponse.Header.Set("Retry-After", "3")
+			})
+
+			It("waits for the requested number of seconds", func() {
+				Expect(wrapper.Make(request, response)).To(HaveOccurred())
+				Expect(delays).To(Equal([]time.Duration{3 * time.Second, 3 * time.Second}))
+			})
+		})
+
+		When("the Retry-After header asks to wait too long", func() {
+			BeforeEach(func() {
+				response.HTTPResponse.Header.Set("Retry-After", "3600")
+			})
+
+			It("does not retry", func() {
+				Expect(wrapper.Make(request, response)).To(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
@@ -54,6 +54,17 @@ type FakeRequestLoggerOutput struct {
 	displayHostReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisplayMessageStub        func(msg string) error
+	displayMessageMutex       sync.RWMutex
+	displayMessageArgsForCall []struct {
+		msg string
+	}
+	displayMessageReturns struct {
+		result1 error
+	}
+	displayMessageReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayRequestHeaderStub        func(method string, uri string, httpProtocol string) error
 	displayRequestHeaderMutex       sync.RWMutex
 	displayRequestHeaderArgsForCall []struct {
@@ -67,6 +78,17 @@ type FakeRequestLoggerOutput struct {
 	displayRequestHeaderReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisplayRequestIDStub        func(id string) error
+	displayRequestIDMutex       sync.RWMutex
+	displayRequestIDArgsForCall []struct {
+		id string
+	}
+	displayRequestIDReturns struct {
+		result1 error
+	}
+	displayRequestIDReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayResponseHeaderStub        func(httpProtocol string, status string) error
 	displayResponseHeaderMutex       sync.RWMutex
 	displayResponseHeaderArgsForCall []struct {
@@ -321,6 +343,54 @@ func (fake *FakeRequestLoggerOutput) DisplayHostReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakeRequestLoggerOutput) DisplayMessage(msg string) error {
+	fake.displayMessageMutex.Lock()
+	ret, specificReturn := fake.displayMessageReturnsOnCall[len(fake.displayMessageArgsForCall)]
+	fake.displayMessageArgsForCall = append(fake.displayMessageArgsForCall, struct {
+		msg string
+	}{msg})
+	fake.recordInvocation("DisplayMessage", []interface{}{msg})
+	fake.displayMessageMutex.Unlock()
+	if fake.DisplayMessageStub != nil {
+		return fake.DisplayMessageStub(msg)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.displayMessageReturns.result1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayMessageCallCount() int {
+	fake.displayMessageMutex.RLock()
+	defer fake.displayMessageMutex.RUnlock()
+	return len(fake.displayMessageArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayMessageArgsForCall(i int) string {
+	fake.displayMessageMutex.RLock()
+	defer fake.displayMessageMutex.RUnlock()
+	return fake.displayMessageArgsForCall[i].msg
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayMessageReturns(result1 error) {
+	fake.DisplayMessageStub = nil
+	fake.displayMessageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayMessageReturnsOnCall(i int, result1 error) {
+	fake.DisplayMessageStub = nil
+	if fake.displayMessageReturnsOnCall == nil {
+		fake.displayMessageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayMessageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRequestLoggerOutput) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
 	fake.displayRequestHeaderMutex.Lock()
 	ret, specificReturn := fake.displayRequestHeaderReturnsOnCall[len(fake.displayRequestHeaderArgsForCall)]
@@ -371,6 +441,54 @@ func (fake *FakeRequestLoggerOutput) DisplayRequestHeaderReturnsOnCall(i int, re
 	}{result1}
 }
 
+func (fake *FakeRequestLoggerOutput) DisplayRequestID(id string) error {
+	fake.displayRequestIDMutex.Lock()
+	ret, specificReturn := fake.displayRequestIDReturnsOnCall[len(fake.displayRequestIDArgsForCall)]
+	fake.displayRequestIDArgsForCall = append(fake.displayRequestIDArgsForCall, struct {
+		id string
+	}{id})
+	fake.recordInvocation("DisplayRequestID", []interface{}{id})
+	fake.displayRequestIDMutex.Unlock()
+	if fake.DisplayRequestIDStub != nil {
+		return fake.DisplayRequestIDStub(id)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.displayRequestIDReturns.result1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestIDCallCount() int {
+	fake.displayRequestIDMutex.RLock()
+	defer fake.displayRequestIDMutex.RUnlock()
+	return len(fake.displayRequestIDArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestIDArgsForCall(i int) string {
+	fake.displayRequestIDMutex.RLock()
+	defer fake.displayRequestIDMutex.RUnlock()
+	return fake.displayRequestIDArgsForCall[i].id
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestIDReturns(result1 error) {
+	fake.DisplayRequestIDStub = nil
+	fake.displayRequestIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestIDReturnsOnCall(i int, result1 error) {
+	fake.DisplayRequestIDStub = nil
+	if fake.displayRequestIDReturnsOnCall == nil {
+		fake.displayRequestIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayRequestIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRequestLoggerOutput) DisplayResponseHeader(httpProtocol string, status string) error {
 	fake.displayResponseHeaderMutex.Lock()
 	ret, specificReturn := fake.displayResponseHeaderReturnsOnCall[len(fake.displayResponseHeaderArgsForCall)]
@@ -584,8 +702,12 @@ func (fake *FakeRequestLoggerOutput) Invocations() map[string][][]interface{} {
 	defer fake.displayHeaderMutex.RUnlock()
 	fake.displayHostMutex.RLock()
 	defer fake.displayHostMutex.RUnlock()
+	fake.displayMessageMutex.RLock()
+	defer fake.displayMessageMutex.RUnlock()
 	fake.displayRequestHeaderMutex.RLock()
 	defer fake.displayRequestHeaderMutex.RUnlock()
+	fake.displayRequestIDMutex.RLock()
+	defer fake.displayRequestIDMutex.RUnlock()
 	fake.displayResponseHeaderMutex.RLock()
 	defer fake.displayResponseHeaderMutex.RUnlock()
 	fake.displayTypeMutex.RLock()
@@ -1,7 +1,6 @@
 package authentication
 
 import (
-	"crypto/tls"
 	"encoding/base64"
 	"fmt"
 	"net/http"
@@ -49,6 +48,11 @@ func NewUAARepository(gateway net.Gateway, config coreconfig.ReadWriter, dumper
 }
 
 func (uaa UAARepository) Authorize(token string) (string, error) {
+	tlsConfig, err := net.NewTLSConfig(nil, uaa.config)
+	if err != nil {
+		return "", err
+	}
+
 	httpClient := &http.Client{
 		CheckRedirect: func(req *http.Request, _ []*http.Request) error {
 			uaa.DumpRequest(req)
@@ -56,10 +60,8 @@ func (uaa UAARepository) Authorize(token string) (string, error) {
 		},
 		Timeout: 30 * time.Second,
 		Transport: &http.Transport{
-			DisableKeepAlives: true,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: uaa.config.IsSSLDisabled(),
-			},
+			DisableKeepAlives:   true,
+			TLSClientConfig:     tlsConfig,
 			Proxy:               http.ProxyFromEnvironment,
 			TLSHandshakeTimeout: 10 * time.Second,
 		},
@@ -99,7 +99,13 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 	loc.domainRepo = NewCloudControllerDomainRepository(config, cloudControllerGateway)
 	loc.endpointRepo = NewEndpointRepository(cloudControllerGateway)
 
-	tlsConfig := net.NewTLSConfig([]tls.Certificate{}, config.IsSSLDisabled())
+	// An invalid certificate configuration is returned by the Cloud Controller
+	// gateway, which is always used before any logs are streamed, so the
+	// system certificates are used for the logs in that case.
+	tlsConfig, err := net.NewTLSConfig(nil, config)
+	if err != nil {
+		tlsConfig = &tls.Config{InsecureSkipVerify: config.IsSSLDisabled()}
+	}
 
 	var noaaRetryTimeout time.Duration
 	convertedTime, err := strconv.Atoi(envDialTimeout)
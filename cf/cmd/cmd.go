@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"os/exec"
 	"runtime"
 	"strings"
 
@@ -21,7 +23,11 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
 	"code.cloudfoundry.org/cli/cf/util/spellcheck"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/plugin"
 	"code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/util/configv3"
 
 	netrpc "net/rpc"
 )
@@ -130,33 +136,68 @@ func Main(traceEnv string, args []string) {
 			os.Exit(1)
 		}
 
-		os.Exit(0)
+		// pre-command hooks have already been run by the command parser that
+		// handed this command over to the legacy code
+		err = runPostCommandHooks(meta.Name, cmdArgs)
+		if _, ok := err.(*exec.ExitError); err != nil && !ok {
+			deps.UI.Failed(err.Error())
+		}
+		os.Exit(rpc.ExitStatus(err))
 	}
 
 	//non core command, try plugin command
+	rpcService := newRPCService(deps)
+	pluginConfig := newPluginConfig(deps)
+	pluginList := pluginConfig.Plugins()
+
+	ran, exitStatus := rpc.RunMethodIfExists(rpcService, args[1:], pluginList)
+	if !ran {
+		deps.UI.Say("'" + args[1] + T("' is not a registered command. See 'cf help -a'"))
+		suggestCommands(cmdName, deps.UI, append(cmdRegistry.ListCommands(), pluginConfig.ListCommands()...))
+		os.Exit(1)
+	}
+	if exitStatus != 0 {
+		os.Exit(exitStatus)
+	}
+}
+
+func newRPCService(deps commandregistry.Dependency) *rpc.CliRpcService {
 	server := netrpc.NewServer()
 	rpcService, err := rpc.NewRpcService(deps.TeePrinter, deps.TeePrinter, deps.Config, deps.RepoLocator, rpc.NewCommandRunner(), deps.Logger, Writer, server)
 	if err != nil {
 		deps.UI.Say(T("Error initializing RPC service: ") + err.Error())
 		os.Exit(1)
 	}
+	return rpcService
+}
 
+func newPluginConfig(deps commandregistry.Dependency) *pluginconfig.PluginConfig {
 	pluginPath := filepath.Join(confighelpers.PluginRepoDir(), ".cf", "plugins")
-	pluginConfig := pluginconfig.NewPluginConfig(
+	return pluginconfig.NewPluginConfig(
 		func(err error) {
 			deps.UI.Failed(fmt.Sprintf("Error read/writing plugin config: %s, ", err.Error()))
 		},
 		configuration.NewDiskPersistor(filepath.Join(pluginPath, "config.json")),
 		pluginPath,
 	)
-	pluginList := pluginConfig.Plugins()
+}
 
-	ran := rpc.RunMethodIfExists(rpcService, args[1:], pluginList)
-	if !ran {
-		deps.UI.Say("'" + args[1] + T("' is not a registered command. See 'cf help -a'"))
-		suggestCommands(cmdName, deps.UI, append(cmdRegistry.ListCommands(), pluginConfig.ListCommands()...))
-		os.Exit(1)
+// hookUI provides the plugin hook runner with the legacy output writer.
+type hookUI struct{}
+
+func (hookUI) Writer() io.Writer {
+	return Writer
+}
+
+func runPostCommandHooks(commandName string, args []string) error {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		if _, ok := err.(translatableerror.EmptyConfigError); !ok {
+			return err
+		}
 	}
+
+	return shared.RunHooks(config, hookUI{}, plugin.PostCommandHook, commandName, args)
 }
 
 func suggestCommands(cmdName string, ui terminal.UI, cmdsList []string) {
@@ -8,6 +8,7 @@ import (
 
 	"path/filepath"
 
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/actors/brokerbuilder"
 	"code.cloudfoundry.org/cli/cf/actors/planbuilder"
@@ -23,8 +24,10 @@ import (
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/plugin/models"
 	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/randomword"
 )
 
@@ -104,8 +107,11 @@ func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string
 	terminal.UserAskedForColors = deps.Config.ColorEnabled()
 	terminal.InitColorSupport()
 
+	ccGateway := net.NewCloudControllerGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout)
+	ccGateway.ClearCache = clearHTTPCache
+
 	deps.Gateways = map[string]net.Gateway{
-		"cloud-controller": net.NewCloudControllerGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout),
+		"cloud-controller": ccGateway,
 		"uaa":              net.NewUAAGateway(deps.Config, deps.UI, logger, envDialTimeout),
 		"routing-api":      net.NewRoutingAPIGateway(deps.Config, time.Now, deps.UI, logger, envDialTimeout),
 	}
@@ -159,3 +165,20 @@ func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string
 
 	return deps
 }
+
+// clearHTTPCache removes the Cloud Controller responses cached for the current
+// target and user, since the legacy commands change resources without going
+// through the cache.
+func clearHTTPCache() error {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		if _, ok := err.(translatableerror.EmptyConfigError); !ok {
+			return err
+		}
+	}
+
+	if enabled, _ := config.HTTPCache(); !enabled {
+		return nil
+	}
+	return ccWrapper.ClearCache(config.HTTPCacheDirectory(), config)
+}
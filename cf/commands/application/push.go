@@ -66,6 +66,8 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)")}
 	fs["vars-file"] = &flags.StringFlag{Usage: T("Path to a variable substitution file for manifest; can specify multiple times")}
 	fs["var"] = &flags.StringFlag{Usage: T("Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times")}
+	fs["vars-env"] = &flags.StringFlag{Usage: T("Prefix of environment variables to use for variable substitution, (e.g., APP reads APP_name as name); can specify multiple times")}
+	fs["vars-dir"] = &flags.StringFlag{Usage: T("Path to a directory of variable substitution files, each named after its variable; can specify multiple times")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app")}
 	fs["docker-image"] = &flags.StringFlag{Name: "docker-image", ShortName: "o", Usage: T("Docker-image to be used (e.g. user/docker-image-name)")}
 	fs["docker-username"] = &flags.StringFlag{Name: "docker-username", Usage: T("Repository username; used with password from environment variable CF_DOCKER_PASSWORD")}
@@ -98,15 +100,14 @@ func (cmd *Push) Requirements(requirementsFactory requirements.Factory, fc flags
 
 	reqs = append(reqs, usageReq)
 
-	if fc.String("vars-file") != "" || fc.String("var") != "" {
-		var flags []string
-		if fc.String("vars-file") != "" {
-			flags = append(flags, "vars-file")
+	var unsupportedFlags []string
+	for _, flag := range []string{"vars-file", "var", "vars-env", "vars-dir"} {
+		if fc.String(flag) != "" {
+			unsupportedFlags = append(unsupportedFlags, flag)
 		}
-		if fc.String("var") != "" {
-			flags = append(flags, "var")
-		}
-		reqs = append(reqs, requirementsFactory.NewUnsupportedLegacyFlagRequirement(flags...))
+	}
+	if len(unsupportedFlags) > 0 {
+		reqs = append(reqs, requirementsFactory.NewUnsupportedLegacyFlagRequirement(unsupportedFlags...))
 	}
 
 	reqs = append(reqs, []requirements.Requirement{
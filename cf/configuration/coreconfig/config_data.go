@@ -23,6 +23,9 @@ type Data struct {
 	APIVersion               string
 	AsyncTimeout             uint
 	AuthorizationEndpoint    string
+	CACertFile               string `json:",omitempty"`
+	ClientCertFile           string `json:",omitempty"`
+	ClientKeyFile            string `json:",omitempty"`
 	ColorEnabled             string
 	ConfigVersion            int
 	DopplerEndPoint          string
@@ -30,6 +33,7 @@ type Data struct {
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
 	OrganizationFields       models.OrganizationFields
+	PluginKeys               []models.PluginKey
 	PluginRepos              []models.PluginRepo
 	RefreshToken             string
 	RoutingAPIEndpoint       string
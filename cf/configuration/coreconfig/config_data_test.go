@@ -53,6 +53,12 @@ var _ = Describe("V3 Config files", func() {
 			"URL": "http://repo.com"
 		}
 		],
+		"PluginKeys": [
+		{
+			"Name": "key1",
+			"PublicKey": "some-public-key"
+		}
+		],
 		"MinCLIVersion": "6.0.0",
 		"MinRecommendedCLIVersion": "6.9.0"
 	}`
@@ -101,6 +107,12 @@ var _ = Describe("V3 Config files", func() {
 			"URL": "http://repo.com"
 		}
 		],
+		"PluginKeys": [
+		{
+			"Name": "key1",
+			"PublicKey": "some-public-key"
+		}
+		],
 		"MinCLIVersion": "6.0.0",
 		"MinRecommendedCLIVersion": "6.9.0"
 	}`
@@ -149,6 +161,12 @@ var _ = Describe("V3 Config files", func() {
 						URL:  "http://repo.com",
 					},
 				},
+				PluginKeys: []models.PluginKey{
+					{
+						Name:      "key1",
+						PublicKey: "some-public-key",
+					},
+				},
 			}
 
 			jsonData, err := data.JSONMarshalV3()
@@ -200,6 +218,12 @@ var _ = Describe("V3 Config files", func() {
 						URL:  "http://repo.com",
 					},
 				},
+				PluginKeys: []models.PluginKey{
+					{
+						Name:      "key1",
+						PublicKey: "some-public-key",
+					},
+				},
 			}
 
 			actualData := coreconfig.NewData()
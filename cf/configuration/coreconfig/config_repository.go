@@ -1,11 +1,14 @@
 package coreconfig
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"strings"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/configuration"
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
 	"code.cloudfoundry.org/cli/version"
 	"github.com/blang/semver"
 )
@@ -81,6 +84,7 @@ type Reader interface {
 	UserEmail() string
 	IsLoggedIn() bool
 	IsSSLDisabled() bool
+	TLSCertificates() (*x509.CertPool, []tls.Certificate, error)
 	IsMinAPIVersion(semver.Version) bool
 	IsMinCLIVersion(string) bool
 	MinCLIVersion() string
@@ -329,6 +333,18 @@ func (c *ConfigRepository) IsSSLDisabled() (isSSLDisabled bool) {
 	return
 }
 
+// TLSCertificates loads the CA certificate and the client certificate set
+// with 'cf api'. The pool is nil when no CA certificate is configured.
+func (c *ConfigRepository) TLSCertificates() (rootCAs *x509.CertPool, certificates []tls.Certificate, err error) {
+	var caCertFile, clientCertFile, clientKeyFile string
+	c.read(func() {
+		caCertFile = c.data.CACertFile
+		clientCertFile = c.data.ClientCertFile
+		clientKeyFile = c.data.ClientKeyFile
+	})
+	return tlsconfig.LoadCertificates(caCertFile, clientCertFile, clientKeyFile)
+}
+
 // SetCLIVersion should only be used in testing
 func (c *ConfigRepository) SetCLIVersion(v string) {
 	c.CFCLIVersion = v
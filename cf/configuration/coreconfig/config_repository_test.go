@@ -259,6 +259,31 @@ var _ = Describe("Configuration Repository", func() {
 		})
 	})
 
+	Describe("TLSCertificates", func() {
+		When("no certificates are configured", func() {
+			It("returns no certificates", func() {
+				rootCAs, certificates, err := config.TLSCertificates()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rootCAs).To(BeNil())
+				Expect(certificates).To(BeEmpty())
+			})
+		})
+
+		When("the configured CA certificate cannot be read", func() {
+			BeforeEach(func() {
+				persistor.LoadStub = func(data configuration.DataInterface) error {
+					data.(*coreconfig.Data).CACertFile = filepath.Join("some", "missing", "ca.pem")
+					return nil
+				}
+			})
+
+			It("returns the error", func() {
+				_, _, err := config.TLSCertificates()
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("IsMinCLIVersion", func() {
 		It("returns true when the actual version is the default version string", func() {
 			Expect(config.IsMinCLIVersion(version.DefaultVersion)).To(BeTrue())
@@ -2,6 +2,8 @@
 package coreconfigfakes
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
@@ -199,6 +201,19 @@ type FakeReadWriter struct {
 	isSSLDisabledReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	TLSCertificatesStub        func() (*x509.CertPool, []tls.Certificate, error)
+	tLSCertificatesMutex       sync.RWMutex
+	tLSCertificatesArgsForCall []struct{}
+	tLSCertificatesReturns     struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}
+	tLSCertificatesReturnsOnCall map[int]struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}
 	IsMinAPIVersionStub        func(semver.Version) bool
 	isMinAPIVersionMutex       sync.RWMutex
 	isMinAPIVersionArgsForCall []struct {
@@ -296,6 +311,11 @@ type FakeReadWriter struct {
 	ClearSessionStub          func()
 	clearSessionMutex         sync.RWMutex
 	clearSessionArgsForCall   []struct{}
+	SetAccessTokenStub        func(string)
+	setAccessTokenMutex       sync.RWMutex
+	setAccessTokenArgsForCall []struct {
+		arg1 string
+	}
 	SetAPIEndpointStub        func(string)
 	setAPIEndpointMutex       sync.RWMutex
 	setAPIEndpointArgsForCall []struct {
@@ -306,114 +326,104 @@ type FakeReadWriter struct {
 	setAPIVersionArgsForCall []struct {
 		arg1 string
 	}
-	SetMinCLIVersionStub        func(string)
-	setMinCLIVersionMutex       sync.RWMutex
-	setMinCLIVersionArgsForCall []struct {
-		arg1 string
-	}
-	SetMinRecommendedCLIVersionStub        func(string)
-	setMinRecommendedCLIVersionMutex       sync.RWMutex
-	setMinRecommendedCLIVersionArgsForCall []struct {
-		arg1 string
+	SetAsyncTimeoutStub        func(uint)
+	setAsyncTimeoutMutex       sync.RWMutex
+	setAsyncTimeoutArgsForCall []struct {
+		arg1 uint
 	}
 	SetAuthenticationEndpointStub        func(string)
 	setAuthenticationEndpointMutex       sync.RWMutex
 	setAuthenticationEndpointArgsForCall []struct {
 		arg1 string
 	}
-	SetDopplerEndpointStub        func(string)
-	setDopplerEndpointMutex       sync.RWMutex
-	setDopplerEndpointArgsForCall []struct {
+	SetCLIVersionStub        func(string)
+	setCLIVersionMutex       sync.RWMutex
+	setCLIVersionArgsForCall []struct {
 		arg1 string
 	}
-	SetUaaEndpointStub        func(string)
-	setUaaEndpointMutex       sync.RWMutex
-	setUaaEndpointArgsForCall []struct {
+	SetColorEnabledStub        func(string)
+	setColorEnabledMutex       sync.RWMutex
+	setColorEnabledArgsForCall []struct {
 		arg1 string
 	}
-	SetRoutingAPIEndpointStub        func(string)
-	setRoutingAPIEndpointMutex       sync.RWMutex
-	setRoutingAPIEndpointArgsForCall []struct {
+	SetDopplerEndpointStub        func(string)
+	setDopplerEndpointMutex       sync.RWMutex
+	setDopplerEndpointArgsForCall []struct {
 		arg1 string
 	}
-	SetAccessTokenStub        func(string)
-	setAccessTokenMutex       sync.RWMutex
-	setAccessTokenArgsForCall []struct {
+	SetLocaleStub        func(string)
+	setLocaleMutex       sync.RWMutex
+	setLocaleArgsForCall []struct {
 		arg1 string
 	}
-	SetUAAOAuthClientStub        func(string)
-	setUAAOAuthClientMutex       sync.RWMutex
-	setUAAOAuthClientArgsForCall []struct {
+	SetMinCLIVersionStub        func(string)
+	setMinCLIVersionMutex       sync.RWMutex
+	setMinCLIVersionArgsForCall []struct {
 		arg1 string
 	}
-	SetUAAOAuthClientSecretStub        func(string)
-	setUAAOAuthClientSecretMutex       sync.RWMutex
-	setUAAOAuthClientSecretArgsForCall []struct {
+	SetMinRecommendedCLIVersionStub        func(string)
+	setMinRecommendedCLIVersionMutex       sync.RWMutex
+	setMinRecommendedCLIVersionArgsForCall []struct {
 		arg1 string
 	}
-	SetSSHOAuthClientStub        func(string)
-	setSSHOAuthClientMutex       sync.RWMutex
-	setSSHOAuthClientArgsForCall []struct {
-		arg1 string
+	SetOrganizationFieldsStub        func(models.OrganizationFields)
+	setOrganizationFieldsMutex       sync.RWMutex
+	setOrganizationFieldsArgsForCall []struct {
+		arg1 models.OrganizationFields
+	}
+	SetPluginRepoStub        func(models.PluginRepo)
+	setPluginRepoMutex       sync.RWMutex
+	setPluginRepoArgsForCall []struct {
+		arg1 models.PluginRepo
 	}
 	SetRefreshTokenStub        func(string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
 		arg1 string
 	}
-	SetOrganizationFieldsStub        func(models.OrganizationFields)
-	setOrganizationFieldsMutex       sync.RWMutex
-	setOrganizationFieldsArgsForCall []struct {
-		arg1 models.OrganizationFields
+	SetRoutingAPIEndpointStub        func(string)
+	setRoutingAPIEndpointMutex       sync.RWMutex
+	setRoutingAPIEndpointArgsForCall []struct {
+		arg1 string
 	}
 	SetSpaceFieldsStub        func(models.SpaceFields)
 	setSpaceFieldsMutex       sync.RWMutex
 	setSpaceFieldsArgsForCall []struct {
 		arg1 models.SpaceFields
 	}
+	SetSSHOAuthClientStub        func(string)
+	setSSHOAuthClientMutex       sync.RWMutex
+	setSSHOAuthClientArgsForCall []struct {
+		arg1 string
+	}
 	SetSSLDisabledStub        func(bool)
 	setSSLDisabledMutex       sync.RWMutex
 	setSSLDisabledArgsForCall []struct {
 		arg1 bool
 	}
-	SetAsyncTimeoutStub        func(uint)
-	setAsyncTimeoutMutex       sync.RWMutex
-	setAsyncTimeoutArgsForCall []struct {
-		arg1 uint
-	}
 	SetTraceStub        func(string)
 	setTraceMutex       sync.RWMutex
 	setTraceArgsForCall []struct {
 		arg1 string
 	}
-	SetColorEnabledStub        func(string)
-	setColorEnabledMutex       sync.RWMutex
-	setColorEnabledArgsForCall []struct {
+	SetUaaEndpointStub        func(string)
+	setUaaEndpointMutex       sync.RWMutex
+	setUaaEndpointArgsForCall []struct {
 		arg1 string
 	}
-	SetLocaleStub        func(string)
-	setLocaleMutex       sync.RWMutex
-	setLocaleArgsForCall []struct {
+	SetUAAGrantTypeStub        func(string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
 		arg1 string
 	}
-	SetPluginRepoStub        func(models.PluginRepo)
-	setPluginRepoMutex       sync.RWMutex
-	setPluginRepoArgsForCall []struct {
-		arg1 models.PluginRepo
-	}
-	UnSetPluginRepoStub        func(int)
-	unSetPluginRepoMutex       sync.RWMutex
-	unSetPluginRepoArgsForCall []struct {
-		arg1 int
-	}
-	SetCLIVersionStub        func(string)
-	setCLIVersionMutex       sync.RWMutex
-	setCLIVersionArgsForCall []struct {
+	SetUAAOAuthClientStub        func(string)
+	setUAAOAuthClientMutex       sync.RWMutex
+	setUAAOAuthClientArgsForCall []struct {
 		arg1 string
 	}
-	SetUAAGrantTypeStub        func(string)
-	setUAAGrantTypeMutex       sync.RWMutex
-	setUAAGrantTypeArgsForCall []struct {
+	SetUAAOAuthClientSecretStub        func(string)
+	setUAAOAuthClientSecretMutex       sync.RWMutex
+	setUAAOAuthClientSecretArgsForCall []struct {
 		arg1 string
 	}
 	UAAGrantTypeStub        func() string
@@ -425,6 +435,11 @@ type FakeReadWriter struct {
 	uAAGrantTypeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UnSetPluginRepoStub        func(int)
+	unSetPluginRepoMutex       sync.RWMutex
+	unSetPluginRepoArgsForCall []struct {
+		arg1 int
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1269,6 +1284,52 @@ func (fake *FakeReadWriter) IsSSLDisabledReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) TLSCertificates() (*x509.CertPool, []tls.Certificate, error) {
+	fake.tLSCertificatesMutex.Lock()
+	ret, specificReturn := fake.tLSCertificatesReturnsOnCall[len(fake.tLSCertificatesArgsForCall)]
+	fake.tLSCertificatesArgsForCall = append(fake.tLSCertificatesArgsForCall, struct{}{})
+	fake.recordInvocation("TLSCertificates", []interface{}{})
+	fake.tLSCertificatesMutex.Unlock()
+	if fake.TLSCertificatesStub != nil {
+		return fake.TLSCertificatesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.tLSCertificatesReturns.result1, fake.tLSCertificatesReturns.result2, fake.tLSCertificatesReturns.result3
+}
+
+func (fake *FakeReadWriter) TLSCertificatesCallCount() int {
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
+	return len(fake.tLSCertificatesArgsForCall)
+}
+
+func (fake *FakeReadWriter) TLSCertificatesReturns(result1 *x509.CertPool, result2 []tls.Certificate, result3 error) {
+	fake.TLSCertificatesStub = nil
+	fake.tLSCertificatesReturns = struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeReadWriter) TLSCertificatesReturnsOnCall(i int, result1 *x509.CertPool, result2 []tls.Certificate, result3 error) {
+	fake.TLSCertificatesStub = nil
+	if fake.tLSCertificatesReturnsOnCall == nil {
+		fake.tLSCertificatesReturnsOnCall = make(map[int]struct {
+			result1 *x509.CertPool
+			result2 []tls.Certificate
+			result3 error
+		})
+	}
+	fake.tLSCertificatesReturnsOnCall[i] = struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeReadWriter) IsMinAPIVersion(arg1 semver.Version) bool {
 	fake.isMinAPIVersionMutex.Lock()
 	ret, specificReturn := fake.isMinAPIVersionReturnsOnCall[len(fake.isMinAPIVersionArgsForCall)]
@@ -1701,6 +1762,30 @@ func (fake *FakeReadWriter) ClearSessionCallCount() int {
 	return len(fake.clearSessionArgsForCall)
 }
 
+func (fake *FakeReadWriter) SetAccessToken(arg1 string) {
+	fake.setAccessTokenMutex.Lock()
+	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetAccessToken", []interface{}{arg1})
+	fake.setAccessTokenMutex.Unlock()
+	if fake.SetAccessTokenStub != nil {
+		fake.SetAccessTokenStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetAccessTokenCallCount() int {
+	fake.setAccessTokenMutex.RLock()
+	defer fake.setAccessTokenMutex.RUnlock()
+	return len(fake.setAccessTokenArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetAccessTokenArgsForCall(i int) string {
+	fake.setAccessTokenMutex.RLock()
+	defer fake.setAccessTokenMutex.RUnlock()
+	return fake.setAccessTokenArgsForCall[i].arg1
+}
+
 func (fake *FakeReadWriter) SetAPIEndpoint(arg1 string) {
 	fake.setAPIEndpointMutex.Lock()
 	fake.setAPIEndpointArgsForCall = append(fake.setAPIEndpointArgsForCall, struct {
@@ -1749,52 +1834,28 @@ func (fake *FakeReadWriter) SetAPIVersionArgsForCall(i int) string {
 	return fake.setAPIVersionArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetMinCLIVersion(arg1 string) {
-	fake.setMinCLIVersionMutex.Lock()
-	fake.setMinCLIVersionArgsForCall = append(fake.setMinCLIVersionArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	fake.recordInvocation("SetMinCLIVersion", []interface{}{arg1})
-	fake.setMinCLIVersionMutex.Unlock()
-	if fake.SetMinCLIVersionStub != nil {
-		fake.SetMinCLIVersionStub(arg1)
-	}
-}
-
-func (fake *FakeReadWriter) SetMinCLIVersionCallCount() int {
-	fake.setMinCLIVersionMutex.RLock()
-	defer fake.setMinCLIVersionMutex.RUnlock()
-	return len(fake.setMinCLIVersionArgsForCall)
-}
-
-func (fake *FakeReadWriter) SetMinCLIVersionArgsForCall(i int) string {
-	fake.setMinCLIVersionMutex.RLock()
-	defer fake.setMinCLIVersionMutex.RUnlock()
-	return fake.setMinCLIVersionArgsForCall[i].arg1
-}
-
-func (fake *FakeReadWriter) SetMinRecommendedCLIVersion(arg1 string) {
-	fake.setMinRecommendedCLIVersionMutex.Lock()
-	fake.setMinRecommendedCLIVersionArgsForCall = append(fake.setMinRecommendedCLIVersionArgsForCall, struct {
-		arg1 string
+func (fake *FakeReadWriter) SetAsyncTimeout(arg1 uint) {
+	fake.setAsyncTimeoutMutex.Lock()
+	fake.setAsyncTimeoutArgsForCall = append(fake.setAsyncTimeoutArgsForCall, struct {
+		arg1 uint
 	}{arg1})
-	fake.recordInvocation("SetMinRecommendedCLIVersion", []interface{}{arg1})
-	fake.setMinRecommendedCLIVersionMutex.Unlock()
-	if fake.SetMinRecommendedCLIVersionStub != nil {
-		fake.SetMinRecommendedCLIVersionStub(arg1)
+	fake.recordInvocation("SetAsyncTimeout", []interface{}{arg1})
+	fake.setAsyncTimeoutMutex.Unlock()
+	if fake.SetAsyncTimeoutStub != nil {
+		fake.SetAsyncTimeoutStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetMinRecommendedCLIVersionCallCount() int {
-	fake.setMinRecommendedCLIVersionMutex.RLock()
-	defer fake.setMinRecommendedCLIVersionMutex.RUnlock()
-	return len(fake.setMinRecommendedCLIVersionArgsForCall)
+func (fake *FakeReadWriter) SetAsyncTimeoutCallCount() int {
+	fake.setAsyncTimeoutMutex.RLock()
+	defer fake.setAsyncTimeoutMutex.RUnlock()
+	return len(fake.setAsyncTimeoutArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetMinRecommendedCLIVersionArgsForCall(i int) string {
-	fake.setMinRecommendedCLIVersionMutex.RLock()
-	defer fake.setMinRecommendedCLIVersionMutex.RUnlock()
-	return fake.setMinRecommendedCLIVersionArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetAsyncTimeoutArgsForCall(i int) uint {
+	fake.setAsyncTimeoutMutex.RLock()
+	defer fake.setAsyncTimeoutMutex.RUnlock()
+	return fake.setAsyncTimeoutArgsForCall[i].arg1
 }
 
 func (fake *FakeReadWriter) SetAuthenticationEndpoint(arg1 string) {
@@ -1821,172 +1882,196 @@ func (fake *FakeReadWriter) SetAuthenticationEndpointArgsForCall(i int) string {
 	return fake.setAuthenticationEndpointArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetDopplerEndpoint(arg1 string) {
-	fake.setDopplerEndpointMutex.Lock()
-	fake.setDopplerEndpointArgsForCall = append(fake.setDopplerEndpointArgsForCall, struct {
+func (fake *FakeReadWriter) SetCLIVersion(arg1 string) {
+	fake.setCLIVersionMutex.Lock()
+	fake.setCLIVersionArgsForCall = append(fake.setCLIVersionArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetDopplerEndpoint", []interface{}{arg1})
-	fake.setDopplerEndpointMutex.Unlock()
-	if fake.SetDopplerEndpointStub != nil {
-		fake.SetDopplerEndpointStub(arg1)
+	fake.recordInvocation("SetCLIVersion", []interface{}{arg1})
+	fake.setCLIVersionMutex.Unlock()
+	if fake.SetCLIVersionStub != nil {
+		fake.SetCLIVersionStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetDopplerEndpointCallCount() int {
-	fake.setDopplerEndpointMutex.RLock()
-	defer fake.setDopplerEndpointMutex.RUnlock()
-	return len(fake.setDopplerEndpointArgsForCall)
+func (fake *FakeReadWriter) SetCLIVersionCallCount() int {
+	fake.setCLIVersionMutex.RLock()
+	defer fake.setCLIVersionMutex.RUnlock()
+	return len(fake.setCLIVersionArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetDopplerEndpointArgsForCall(i int) string {
-	fake.setDopplerEndpointMutex.RLock()
-	defer fake.setDopplerEndpointMutex.RUnlock()
-	return fake.setDopplerEndpointArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetCLIVersionArgsForCall(i int) string {
+	fake.setCLIVersionMutex.RLock()
+	defer fake.setCLIVersionMutex.RUnlock()
+	return fake.setCLIVersionArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetUaaEndpoint(arg1 string) {
-	fake.setUaaEndpointMutex.Lock()
-	fake.setUaaEndpointArgsForCall = append(fake.setUaaEndpointArgsForCall, struct {
+func (fake *FakeReadWriter) SetColorEnabled(arg1 string) {
+	fake.setColorEnabledMutex.Lock()
+	fake.setColorEnabledArgsForCall = append(fake.setColorEnabledArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetUaaEndpoint", []interface{}{arg1})
-	fake.setUaaEndpointMutex.Unlock()
-	if fake.SetUaaEndpointStub != nil {
-		fake.SetUaaEndpointStub(arg1)
+	fake.recordInvocation("SetColorEnabled", []interface{}{arg1})
+	fake.setColorEnabledMutex.Unlock()
+	if fake.SetColorEnabledStub != nil {
+		fake.SetColorEnabledStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetUaaEndpointCallCount() int {
-	fake.setUaaEndpointMutex.RLock()
-	defer fake.setUaaEndpointMutex.RUnlock()
-	return len(fake.setUaaEndpointArgsForCall)
+func (fake *FakeReadWriter) SetColorEnabledCallCount() int {
+	fake.setColorEnabledMutex.RLock()
+	defer fake.setColorEnabledMutex.RUnlock()
+	return len(fake.setColorEnabledArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetUaaEndpointArgsForCall(i int) string {
-	fake.setUaaEndpointMutex.RLock()
-	defer fake.setUaaEndpointMutex.RUnlock()
-	return fake.setUaaEndpointArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetColorEnabledArgsForCall(i int) string {
+	fake.setColorEnabledMutex.RLock()
+	defer fake.setColorEnabledMutex.RUnlock()
+	return fake.setColorEnabledArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetRoutingAPIEndpoint(arg1 string) {
-	fake.setRoutingAPIEndpointMutex.Lock()
-	fake.setRoutingAPIEndpointArgsForCall = append(fake.setRoutingAPIEndpointArgsForCall, struct {
+func (fake *FakeReadWriter) SetDopplerEndpoint(arg1 string) {
+	fake.setDopplerEndpointMutex.Lock()
+	fake.setDopplerEndpointArgsForCall = append(fake.setDopplerEndpointArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetRoutingAPIEndpoint", []interface{}{arg1})
-	fake.setRoutingAPIEndpointMutex.Unlock()
-	if fake.SetRoutingAPIEndpointStub != nil {
-		fake.SetRoutingAPIEndpointStub(arg1)
+	fake.recordInvocation("SetDopplerEndpoint", []interface{}{arg1})
+	fake.setDopplerEndpointMutex.Unlock()
+	if fake.SetDopplerEndpointStub != nil {
+		fake.SetDopplerEndpointStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetRoutingAPIEndpointCallCount() int {
-	fake.setRoutingAPIEndpointMutex.RLock()
-	defer fake.setRoutingAPIEndpointMutex.RUnlock()
-	return len(fake.setRoutingAPIEndpointArgsForCall)
+func (fake *FakeReadWriter) SetDopplerEndpointCallCount() int {
+	fake.setDopplerEndpointMutex.RLock()
+	defer fake.setDopplerEndpointMutex.RUnlock()
+	return len(fake.setDopplerEndpointArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetRoutingAPIEndpointArgsForCall(i int) string {
-	fake.setRoutingAPIEndpointMutex.RLock()
-	defer fake.setRoutingAPIEndpointMutex.RUnlock()
-	return fake.setRoutingAPIEndpointArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetDopplerEndpointArgsForCall(i int) string {
+	fake.setDopplerEndpointMutex.RLock()
+	defer fake.setDopplerEndpointMutex.RUnlock()
+	return fake.setDopplerEndpointArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetAccessToken(arg1 string) {
-	fake.setAccessTokenMutex.Lock()
-	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
+func (fake *FakeReadWriter) SetLocale(arg1 string) {
+	fake.setLocaleMutex.Lock()
+	fake.setLocaleArgsForCall = append(fake.setLocaleArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetAccessToken", []interface{}{arg1})
-	fake.setAccessTokenMutex.Unlock()
-	if fake.SetAccessTokenStub != nil {
-		fake.SetAccessTokenStub(arg1)
+	fake.recordInvocation("SetLocale", []interface{}{arg1})
+	fake.setLocaleMutex.Unlock()
+	if fake.SetLocaleStub != nil {
+		fake.SetLocaleStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetAccessTokenCallCount() int {
-	fake.setAccessTokenMutex.RLock()
-	defer fake.setAccessTokenMutex.RUnlock()
-	return len(fake.setAccessTokenArgsForCall)
+func (fake *FakeReadWriter) SetLocaleCallCount() int {
+	fake.setLocaleMutex.RLock()
+	defer fake.setLocaleMutex.RUnlock()
+	return len(fake.setLocaleArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetAccessTokenArgsForCall(i int) string {
-	fake.setAccessTokenMutex.RLock()
-	defer fake.setAccessTokenMutex.RUnlock()
-	return fake.setAccessTokenArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetLocaleArgsForCall(i int) string {
+	fake.setLocaleMutex.RLock()
+	defer fake.setLocaleMutex.RUnlock()
+	return fake.setLocaleArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetUAAOAuthClient(arg1 string) {
-	fake.setUAAOAuthClientMutex.Lock()
-	fake.setUAAOAuthClientArgsForCall = append(fake.setUAAOAuthClientArgsForCall, struct {
+func (fake *FakeReadWriter) SetMinCLIVersion(arg1 string) {
+	fake.setMinCLIVersionMutex.Lock()
+	fake.setMinCLIVersionArgsForCall = append(fake.setMinCLIVersionArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetUAAOAuthClient", []interface{}{arg1})
-	fake.setUAAOAuthClientMutex.Unlock()
-	if fake.SetUAAOAuthClientStub != nil {
-		fake.SetUAAOAuthClientStub(arg1)
+	fake.recordInvocation("SetMinCLIVersion", []interface{}{arg1})
+	fake.setMinCLIVersionMutex.Unlock()
+	if fake.SetMinCLIVersionStub != nil {
+		fake.SetMinCLIVersionStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetUAAOAuthClientCallCount() int {
-	fake.setUAAOAuthClientMutex.RLock()
-	defer fake.setUAAOAuthClientMutex.RUnlock()
-	return len(fake.setUAAOAuthClientArgsForCall)
+func (fake *FakeReadWriter) SetMinCLIVersionCallCount() int {
+	fake.setMinCLIVersionMutex.RLock()
+	defer fake.setMinCLIVersionMutex.RUnlock()
+	return len(fake.setMinCLIVersionArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetUAAOAuthClientArgsForCall(i int) string {
-	fake.setUAAOAuthClientMutex.RLock()
-	defer fake.setUAAOAuthClientMutex.RUnlock()
-	return fake.setUAAOAuthClientArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetMinCLIVersionArgsForCall(i int) string {
+	fake.setMinCLIVersionMutex.RLock()
+	defer fake.setMinCLIVersionMutex.RUnlock()
+	return fake.setMinCLIVersionArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetUAAOAuthClientSecret(arg1 string) {
-	fake.setUAAOAuthClientSecretMutex.Lock()
-	fake.setUAAOAuthClientSecretArgsForCall = append(fake.setUAAOAuthClientSecretArgsForCall, struct {
+func (fake *FakeReadWriter) SetMinRecommendedCLIVersion(arg1 string) {
+	fake.setMinRecommendedCLIVersionMutex.Lock()
+	fake.setMinRecommendedCLIVersionArgsForCall = append(fake.setMinRecommendedCLIVersionArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetUAAOAuthClientSecret", []interface{}{arg1})
-	fake.setUAAOAuthClientSecretMutex.Unlock()
-	if fake.SetUAAOAuthClientSecretStub != nil {
-		fake.SetUAAOAuthClientSecretStub(arg1)
+	fake.recordInvocation("SetMinRecommendedCLIVersion", []interface{}{arg1})
+	fake.setMinRecommendedCLIVersionMutex.Unlock()
+	if fake.SetMinRecommendedCLIVersionStub != nil {
+		fake.SetMinRecommendedCLIVersionStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetUAAOAuthClientSecretCallCount() int {
-	fake.setUAAOAuthClientSecretMutex.RLock()
-	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
-	return len(fake.setUAAOAuthClientSecretArgsForCall)
+func (fake *FakeReadWriter) SetMinRecommendedCLIVersionCallCount() int {
+	fake.setMinRecommendedCLIVersionMutex.RLock()
+	defer fake.setMinRecommendedCLIVersionMutex.RUnlock()
+	return len(fake.setMinRecommendedCLIVersionArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetUAAOAuthClientSecretArgsForCall(i int) string {
-	fake.setUAAOAuthClientSecretMutex.RLock()
-	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
-	return fake.setUAAOAuthClientSecretArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetMinRecommendedCLIVersionArgsForCall(i int) string {
+	fake.setMinRecommendedCLIVersionMutex.RLock()
+	defer fake.setMinRecommendedCLIVersionMutex.RUnlock()
+	return fake.setMinRecommendedCLIVersionArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetSSHOAuthClient(arg1 string) {
-	fake.setSSHOAuthClientMutex.Lock()
-	fake.setSSHOAuthClientArgsForCall = append(fake.setSSHOAuthClientArgsForCall, struct {
-		arg1 string
+func (fake *FakeReadWriter) SetOrganizationFields(arg1 models.OrganizationFields) {
+	fake.setOrganizationFieldsMutex.Lock()
+	fake.setOrganizationFieldsArgsForCall = append(fake.setOrganizationFieldsArgsForCall, struct {
+		arg1 models.OrganizationFields
 	}{arg1})
-	fake.recordInvocation("SetSSHOAuthClient", []interface{}{arg1})
-	fake.setSSHOAuthClientMutex.Unlock()
-	if fake.SetSSHOAuthClientStub != nil {
-		fake.SetSSHOAuthClientStub(arg1)
+	fake.recordInvocation("SetOrganizationFields", []interface{}{arg1})
+	fake.setOrganizationFieldsMutex.Unlock()
+	if fake.SetOrganizationFieldsStub != nil {
+		fake.SetOrganizationFieldsStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetSSHOAuthClientCallCount() int {
-	fake.setSSHOAuthClientMutex.RLock()
-	defer fake.setSSHOAuthClientMutex.RUnlock()
-	return len(fake.setSSHOAuthClientArgsForCall)
+func (fake *FakeReadWriter) SetOrganizationFieldsCallCount() int {
+	fake.setOrganizationFieldsMutex.RLock()
+	defer fake.setOrganizationFieldsMutex.RUnlock()
+	return len(fake.setOrganizationFieldsArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetSSHOAuthClientArgsForCall(i int) string {
-	fake.setSSHOAuthClientMutex.RLock()
-	defer fake.setSSHOAuthClientMutex.RUnlock()
-	return fake.setSSHOAuthClientArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetOrganizationFieldsArgsForCall(i int) models.OrganizationFields {
+	fake.setOrganizationFieldsMutex.RLock()
+	defer fake.setOrganizationFieldsMutex.RUnlock()
+	return fake.setOrganizationFieldsArgsForCall[i].arg1
+}
+
+func (fake *FakeReadWriter) SetPluginRepo(arg1 models.PluginRepo) {
+	fake.setPluginRepoMutex.Lock()
+	fake.setPluginRepoArgsForCall = append(fake.setPluginRepoArgsForCall, struct {
+		arg1 models.PluginRepo
+	}{arg1})
+	fake.recordInvocation("SetPluginRepo", []interface{}{arg1})
+	fake.setPluginRepoMutex.Unlock()
+	if fake.SetPluginRepoStub != nil {
+		fake.SetPluginRepoStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetPluginRepoCallCount() int {
+	fake.setPluginRepoMutex.RLock()
+	defer fake.setPluginRepoMutex.RUnlock()
+	return len(fake.setPluginRepoArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetPluginRepoArgsForCall(i int) models.PluginRepo {
+	fake.setPluginRepoMutex.RLock()
+	defer fake.setPluginRepoMutex.RUnlock()
+	return fake.setPluginRepoArgsForCall[i].arg1
 }
 
 func (fake *FakeReadWriter) SetRefreshToken(arg1 string) {
@@ -2013,28 +2098,28 @@ func (fake *FakeReadWriter) SetRefreshTokenArgsForCall(i int) string {
 	return fake.setRefreshTokenArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetOrganizationFields(arg1 models.OrganizationFields) {
-	fake.setOrganizationFieldsMutex.Lock()
-	fake.setOrganizationFieldsArgsForCall = append(fake.setOrganizationFieldsArgsForCall, struct {
-		arg1 models.OrganizationFields
+func (fake *FakeReadWriter) SetRoutingAPIEndpoint(arg1 string) {
+	fake.setRoutingAPIEndpointMutex.Lock()
+	fake.setRoutingAPIEndpointArgsForCall = append(fake.setRoutingAPIEndpointArgsForCall, struct {
+		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetOrganizationFields", []interface{}{arg1})
-	fake.setOrganizationFieldsMutex.Unlock()
-	if fake.SetOrganizationFieldsStub != nil {
-		fake.SetOrganizationFieldsStub(arg1)
+	fake.recordInvocation("SetRoutingAPIEndpoint", []interface{}{arg1})
+	fake.setRoutingAPIEndpointMutex.Unlock()
+	if fake.SetRoutingAPIEndpointStub != nil {
+		fake.SetRoutingAPIEndpointStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetOrganizationFieldsCallCount() int {
-	fake.setOrganizationFieldsMutex.RLock()
-	defer fake.setOrganizationFieldsMutex.RUnlock()
-	return len(fake.setOrganizationFieldsArgsForCall)
+func (fake *FakeReadWriter) SetRoutingAPIEndpointCallCount() int {
+	fake.setRoutingAPIEndpointMutex.RLock()
+	defer fake.setRoutingAPIEndpointMutex.RUnlock()
+	return len(fake.setRoutingAPIEndpointArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetOrganizationFieldsArgsForCall(i int) models.OrganizationFields {
-	fake.setOrganizationFieldsMutex.RLock()
-	defer fake.setOrganizationFieldsMutex.RUnlock()
-	return fake.setOrganizationFieldsArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetRoutingAPIEndpointArgsForCall(i int) string {
+	fake.setRoutingAPIEndpointMutex.RLock()
+	defer fake.setRoutingAPIEndpointMutex.RUnlock()
+	return fake.setRoutingAPIEndpointArgsForCall[i].arg1
 }
 
 func (fake *FakeReadWriter) SetSpaceFields(arg1 models.SpaceFields) {
@@ -2061,6 +2146,30 @@ func (fake *FakeReadWriter) SetSpaceFieldsArgsForCall(i int) models.SpaceFields
 	return fake.setSpaceFieldsArgsForCall[i].arg1
 }
 
+func (fake *FakeReadWriter) SetSSHOAuthClient(arg1 string) {
+	fake.setSSHOAuthClientMutex.Lock()
+	fake.setSSHOAuthClientArgsForCall = append(fake.setSSHOAuthClientArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetSSHOAuthClient", []interface{}{arg1})
+	fake.setSSHOAuthClientMutex.Unlock()
+	if fake.SetSSHOAuthClientStub != nil {
+		fake.SetSSHOAuthClientStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) SetSSHOAuthClientCallCount() int {
+	fake.setSSHOAuthClientMutex.RLock()
+	defer fake.setSSHOAuthClientMutex.RUnlock()
+	return len(fake.setSSHOAuthClientArgsForCall)
+}
+
+func (fake *FakeReadWriter) SetSSHOAuthClientArgsForCall(i int) string {
+	fake.setSSHOAuthClientMutex.RLock()
+	defer fake.setSSHOAuthClientMutex.RUnlock()
+	return fake.setSSHOAuthClientArgsForCall[i].arg1
+}
+
 func (fake *FakeReadWriter) SetSSLDisabled(arg1 bool) {
 	fake.setSSLDisabledMutex.Lock()
 	fake.setSSLDisabledArgsForCall = append(fake.setSSLDisabledArgsForCall, struct {
@@ -2079,34 +2188,10 @@ func (fake *FakeReadWriter) SetSSLDisabledCallCount() int {
 	return len(fake.setSSLDisabledArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetSSLDisabledArgsForCall(i int) bool {
-	fake.setSSLDisabledMutex.RLock()
-	defer fake.setSSLDisabledMutex.RUnlock()
-	return fake.setSSLDisabledArgsForCall[i].arg1
-}
-
-func (fake *FakeReadWriter) SetAsyncTimeout(arg1 uint) {
-	fake.setAsyncTimeoutMutex.Lock()
-	fake.setAsyncTimeoutArgsForCall = append(fake.setAsyncTimeoutArgsForCall, struct {
-		arg1 uint
-	}{arg1})
-	fake.recordInvocation("SetAsyncTimeout", []interface{}{arg1})
-	fake.setAsyncTimeoutMutex.Unlock()
-	if fake.SetAsyncTimeoutStub != nil {
-		fake.SetAsyncTimeoutStub(arg1)
-	}
-}
-
-func (fake *FakeReadWriter) SetAsyncTimeoutCallCount() int {
-	fake.setAsyncTimeoutMutex.RLock()
-	defer fake.setAsyncTimeoutMutex.RUnlock()
-	return len(fake.setAsyncTimeoutArgsForCall)
-}
-
-func (fake *FakeReadWriter) SetAsyncTimeoutArgsForCall(i int) uint {
-	fake.setAsyncTimeoutMutex.RLock()
-	defer fake.setAsyncTimeoutMutex.RUnlock()
-	return fake.setAsyncTimeoutArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetSSLDisabledArgsForCall(i int) bool {
+	fake.setSSLDisabledMutex.RLock()
+	defer fake.setSSLDisabledMutex.RUnlock()
+	return fake.setSSLDisabledArgsForCall[i].arg1
 }
 
 func (fake *FakeReadWriter) SetTrace(arg1 string) {
@@ -2133,148 +2218,100 @@ func (fake *FakeReadWriter) SetTraceArgsForCall(i int) string {
 	return fake.setTraceArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetColorEnabled(arg1 string) {
-	fake.setColorEnabledMutex.Lock()
-	fake.setColorEnabledArgsForCall = append(fake.setColorEnabledArgsForCall, struct {
+func (fake *FakeReadWriter) SetUaaEndpoint(arg1 string) {
+	fake.setUaaEndpointMutex.Lock()
+	fake.setUaaEndpointArgsForCall = append(fake.setUaaEndpointArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetColorEnabled", []interface{}{arg1})
-	fake.setColorEnabledMutex.Unlock()
-	if fake.SetColorEnabledStub != nil {
-		fake.SetColorEnabledStub(arg1)
+	fake.recordInvocation("SetUaaEndpoint", []interface{}{arg1})
+	fake.setUaaEndpointMutex.Unlock()
+	if fake.SetUaaEndpointStub != nil {
+		fake.SetUaaEndpointStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetColorEnabledCallCount() int {
-	fake.setColorEnabledMutex.RLock()
-	defer fake.setColorEnabledMutex.RUnlock()
-	return len(fake.setColorEnabledArgsForCall)
+func (fake *FakeReadWriter) SetUaaEndpointCallCount() int {
+	fake.setUaaEndpointMutex.RLock()
+	defer fake.setUaaEndpointMutex.RUnlock()
+	return len(fake.setUaaEndpointArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetColorEnabledArgsForCall(i int) string {
-	fake.setColorEnabledMutex.RLock()
-	defer fake.setColorEnabledMutex.RUnlock()
-	return fake.setColorEnabledArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetUaaEndpointArgsForCall(i int) string {
+	fake.setUaaEndpointMutex.RLock()
+	defer fake.setUaaEndpointMutex.RUnlock()
+	return fake.setUaaEndpointArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetLocale(arg1 string) {
-	fake.setLocaleMutex.Lock()
-	fake.setLocaleArgsForCall = append(fake.setLocaleArgsForCall, struct {
+func (fake *FakeReadWriter) SetUAAGrantType(arg1 string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetLocale", []interface{}{arg1})
-	fake.setLocaleMutex.Unlock()
-	if fake.SetLocaleStub != nil {
-		fake.SetLocaleStub(arg1)
-	}
-}
-
-func (fake *FakeReadWriter) SetLocaleCallCount() int {
-	fake.setLocaleMutex.RLock()
-	defer fake.setLocaleMutex.RUnlock()
-	return len(fake.setLocaleArgsForCall)
-}
-
-func (fake *FakeReadWriter) SetLocaleArgsForCall(i int) string {
-	fake.setLocaleMutex.RLock()
-	defer fake.setLocaleMutex.RUnlock()
-	return fake.setLocaleArgsForCall[i].arg1
-}
-
-func (fake *FakeReadWriter) SetPluginRepo(arg1 models.PluginRepo) {
-	fake.setPluginRepoMutex.Lock()
-	fake.setPluginRepoArgsForCall = append(fake.setPluginRepoArgsForCall, struct {
-		arg1 models.PluginRepo
-	}{arg1})
-	fake.recordInvocation("SetPluginRepo", []interface{}{arg1})
-	fake.setPluginRepoMutex.Unlock()
-	if fake.SetPluginRepoStub != nil {
-		fake.SetPluginRepoStub(arg1)
-	}
-}
-
-func (fake *FakeReadWriter) SetPluginRepoCallCount() int {
-	fake.setPluginRepoMutex.RLock()
-	defer fake.setPluginRepoMutex.RUnlock()
-	return len(fake.setPluginRepoArgsForCall)
-}
-
-func (fake *FakeReadWriter) SetPluginRepoArgsForCall(i int) models.PluginRepo {
-	fake.setPluginRepoMutex.RLock()
-	defer fake.setPluginRepoMutex.RUnlock()
-	return fake.setPluginRepoArgsForCall[i].arg1
-}
-
-func (fake *FakeReadWriter) UnSetPluginRepo(arg1 int) {
-	fake.unSetPluginRepoMutex.Lock()
-	fake.unSetPluginRepoArgsForCall = append(fake.unSetPluginRepoArgsForCall, struct {
-		arg1 int
-	}{arg1})
-	fake.recordInvocation("UnSetPluginRepo", []interface{}{arg1})
-	fake.unSetPluginRepoMutex.Unlock()
-	if fake.UnSetPluginRepoStub != nil {
-		fake.UnSetPluginRepoStub(arg1)
+	fake.recordInvocation("SetUAAGrantType", []interface{}{arg1})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) UnSetPluginRepoCallCount() int {
-	fake.unSetPluginRepoMutex.RLock()
-	defer fake.unSetPluginRepoMutex.RUnlock()
-	return len(fake.unSetPluginRepoArgsForCall)
+func (fake *FakeReadWriter) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
 }
 
-func (fake *FakeReadWriter) UnSetPluginRepoArgsForCall(i int) int {
-	fake.unSetPluginRepoMutex.RLock()
-	defer fake.unSetPluginRepoMutex.RUnlock()
-	return fake.unSetPluginRepoArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetCLIVersion(arg1 string) {
-	fake.setCLIVersionMutex.Lock()
-	fake.setCLIVersionArgsForCall = append(fake.setCLIVersionArgsForCall, struct {
+func (fake *FakeReadWriter) SetUAAOAuthClient(arg1 string) {
+	fake.setUAAOAuthClientMutex.Lock()
+	fake.setUAAOAuthClientArgsForCall = append(fake.setUAAOAuthClientArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetCLIVersion", []interface{}{arg1})
-	fake.setCLIVersionMutex.Unlock()
-	if fake.SetCLIVersionStub != nil {
-		fake.SetCLIVersionStub(arg1)
+	fake.recordInvocation("SetUAAOAuthClient", []interface{}{arg1})
+	fake.setUAAOAuthClientMutex.Unlock()
+	if fake.SetUAAOAuthClientStub != nil {
+		fake.SetUAAOAuthClientStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetCLIVersionCallCount() int {
-	fake.setCLIVersionMutex.RLock()
-	defer fake.setCLIVersionMutex.RUnlock()
-	return len(fake.setCLIVersionArgsForCall)
+func (fake *FakeReadWriter) SetUAAOAuthClientCallCount() int {
+	fake.setUAAOAuthClientMutex.RLock()
+	defer fake.setUAAOAuthClientMutex.RUnlock()
+	return len(fake.setUAAOAuthClientArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetCLIVersionArgsForCall(i int) string {
-	fake.setCLIVersionMutex.RLock()
-	defer fake.setCLIVersionMutex.RUnlock()
-	return fake.setCLIVersionArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetUAAOAuthClientArgsForCall(i int) string {
+	fake.setUAAOAuthClientMutex.RLock()
+	defer fake.setUAAOAuthClientMutex.RUnlock()
+	return fake.setUAAOAuthClientArgsForCall[i].arg1
 }
 
-func (fake *FakeReadWriter) SetUAAGrantType(arg1 string) {
-	fake.setUAAGrantTypeMutex.Lock()
-	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+func (fake *FakeReadWriter) SetUAAOAuthClientSecret(arg1 string) {
+	fake.setUAAOAuthClientSecretMutex.Lock()
+	fake.setUAAOAuthClientSecretArgsForCall = append(fake.setUAAOAuthClientSecretArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	fake.recordInvocation("SetUAAGrantType", []interface{}{arg1})
-	fake.setUAAGrantTypeMutex.Unlock()
-	if fake.SetUAAGrantTypeStub != nil {
-		fake.SetUAAGrantTypeStub(arg1)
+	fake.recordInvocation("SetUAAOAuthClientSecret", []interface{}{arg1})
+	fake.setUAAOAuthClientSecretMutex.Unlock()
+	if fake.SetUAAOAuthClientSecretStub != nil {
+		fake.SetUAAOAuthClientSecretStub(arg1)
 	}
 }
 
-func (fake *FakeReadWriter) SetUAAGrantTypeCallCount() int {
-	fake.setUAAGrantTypeMutex.RLock()
-	defer fake.setUAAGrantTypeMutex.RUnlock()
-	return len(fake.setUAAGrantTypeArgsForCall)
+func (fake *FakeReadWriter) SetUAAOAuthClientSecretCallCount() int {
+	fake.setUAAOAuthClientSecretMutex.RLock()
+	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
+	return len(fake.setUAAOAuthClientSecretArgsForCall)
 }
 
-func (fake *FakeReadWriter) SetUAAGrantTypeArgsForCall(i int) string {
-	fake.setUAAGrantTypeMutex.RLock()
-	defer fake.setUAAGrantTypeMutex.RUnlock()
-	return fake.setUAAGrantTypeArgsForCall[i].arg1
+func (fake *FakeReadWriter) SetUAAOAuthClientSecretArgsForCall(i int) string {
+	fake.setUAAOAuthClientSecretMutex.RLock()
+	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
+	return fake.setUAAOAuthClientSecretArgsForCall[i].arg1
 }
 
 func (fake *FakeReadWriter) UAAGrantType() string {
@@ -2317,6 +2354,30 @@ func (fake *FakeReadWriter) UAAGrantTypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeReadWriter) UnSetPluginRepo(arg1 int) {
+	fake.unSetPluginRepoMutex.Lock()
+	fake.unSetPluginRepoArgsForCall = append(fake.unSetPluginRepoArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("UnSetPluginRepo", []interface{}{arg1})
+	fake.unSetPluginRepoMutex.Unlock()
+	if fake.UnSetPluginRepoStub != nil {
+		fake.UnSetPluginRepoStub(arg1)
+	}
+}
+
+func (fake *FakeReadWriter) UnSetPluginRepoCallCount() int {
+	fake.unSetPluginRepoMutex.RLock()
+	defer fake.unSetPluginRepoMutex.RUnlock()
+	return len(fake.unSetPluginRepoArgsForCall)
+}
+
+func (fake *FakeReadWriter) UnSetPluginRepoArgsForCall(i int) int {
+	fake.unSetPluginRepoMutex.RLock()
+	defer fake.unSetPluginRepoMutex.RUnlock()
+	return fake.unSetPluginRepoArgsForCall[i].arg1
+}
+
 func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2362,6 +2423,8 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.isLoggedInMutex.RUnlock()
 	fake.isSSLDisabledMutex.RLock()
 	defer fake.isSSLDisabledMutex.RUnlock()
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
 	fake.isMinAPIVersionMutex.RLock()
 	defer fake.isMinAPIVersionMutex.RUnlock()
 	fake.isMinCLIVersionMutex.RLock()
@@ -2384,56 +2447,56 @@ func (fake *FakeReadWriter) Invocations() map[string][][]interface{} {
 	defer fake.pluginReposMutex.RUnlock()
 	fake.clearSessionMutex.RLock()
 	defer fake.clearSessionMutex.RUnlock()
+	fake.setAccessTokenMutex.RLock()
+	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setAPIEndpointMutex.RLock()
 	defer fake.setAPIEndpointMutex.RUnlock()
 	fake.setAPIVersionMutex.RLock()
 	defer fake.setAPIVersionMutex.RUnlock()
-	fake.setMinCLIVersionMutex.RLock()
-	defer fake.setMinCLIVersionMutex.RUnlock()
-	fake.setMinRecommendedCLIVersionMutex.RLock()
-	defer fake.setMinRecommendedCLIVersionMutex.RUnlock()
+	fake.setAsyncTimeoutMutex.RLock()
+	defer fake.setAsyncTimeoutMutex.RUnlock()
 	fake.setAuthenticationEndpointMutex.RLock()
 	defer fake.setAuthenticationEndpointMutex.RUnlock()
+	fake.setCLIVersionMutex.RLock()
+	defer fake.setCLIVersionMutex.RUnlock()
+	fake.setColorEnabledMutex.RLock()
+	defer fake.setColorEnabledMutex.RUnlock()
 	fake.setDopplerEndpointMutex.RLock()
 	defer fake.setDopplerEndpointMutex.RUnlock()
-	fake.setUaaEndpointMutex.RLock()
-	defer fake.setUaaEndpointMutex.RUnlock()
-	fake.setRoutingAPIEndpointMutex.RLock()
-	defer fake.setRoutingAPIEndpointMutex.RUnlock()
-	fake.setAccessTokenMutex.RLock()
-	defer fake.setAccessTokenMutex.RUnlock()
-	fake.setUAAOAuthClientMutex.RLock()
-	defer fake.setUAAOAuthClientMutex.RUnlock()
-	fake.setUAAOAuthClientSecretMutex.RLock()
-	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
-	fake.setSSHOAuthClientMutex.RLock()
-	defer fake.setSSHOAuthClientMutex.RUnlock()
-	fake.setRefreshTokenMutex.RLock()
-	defer fake.setRefreshTokenMutex.RUnlock()
+	fake.setLocaleMutex.RLock()
+	defer fake.setLocaleMutex.RUnlock()
+	fake.setMinCLIVersionMutex.RLock()
+	defer fake.setMinCLIVersionMutex.RUnlock()
+	fake.setMinRecommendedCLIVersionMutex.RLock()
+	defer fake.setMinRecommendedCLIVersionMutex.RUnlock()
 	fake.setOrganizationFieldsMutex.RLock()
 	defer fake.setOrganizationFieldsMutex.RUnlock()
+	fake.setPluginRepoMutex.RLock()
+	defer fake.setPluginRepoMutex.RUnlock()
+	fake.setRefreshTokenMutex.RLock()
+	defer fake.setRefreshTokenMutex.RUnlock()
+	fake.setRoutingAPIEndpointMutex.RLock()
+	defer fake.setRoutingAPIEndpointMutex.RUnlock()
 	fake.setSpaceFieldsMutex.RLock()
 	defer fake.setSpaceFieldsMutex.RUnlock()
+	fake.setSSHOAuthClientMutex.RLock()
+	defer fake.setSSHOAuthClientMutex.RUnlock()
 	fake.setSSLDisabledMutex.RLock()
 	defer fake.setSSLDisabledMutex.RUnlock()
-	fake.setAsyncTimeoutMutex.RLock()
-	defer fake.setAsyncTimeoutMutex.RUnlock()
 	fake.setTraceMutex.RLock()
 	defer fake.setTraceMutex.RUnlock()
-	fake.setColorEnabledMutex.RLock()
-	defer fake.setColorEnabledMutex.RUnlock()
-	fake.setLocaleMutex.RLock()
-	defer fake.setLocaleMutex.RUnlock()
-	fake.setPluginRepoMutex.RLock()
-	defer fake.setPluginRepoMutex.RUnlock()
-	fake.unSetPluginRepoMutex.RLock()
-	defer fake.unSetPluginRepoMutex.RUnlock()
-	fake.setCLIVersionMutex.RLock()
-	defer fake.setCLIVersionMutex.RUnlock()
+	fake.setUaaEndpointMutex.RLock()
+	defer fake.setUaaEndpointMutex.RUnlock()
 	fake.setUAAGrantTypeMutex.RLock()
 	defer fake.setUAAGrantTypeMutex.RUnlock()
+	fake.setUAAOAuthClientMutex.RLock()
+	defer fake.setUAAOAuthClientMutex.RUnlock()
+	fake.setUAAOAuthClientSecretMutex.RLock()
+	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
 	defer fake.uAAGrantTypeMutex.RUnlock()
+	fake.unSetPluginRepoMutex.RLock()
+	defer fake.unSetPluginRepoMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
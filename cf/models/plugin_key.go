@@ -0,0 +1,6 @@
+package models
+
+type PluginKey struct {
+	Name      string
+	PublicKey string
+}
@@ -70,6 +70,10 @@ type Gateway struct {
 	ui              terminal.UI
 	logger          trace.Printer
 	DialTimeout     time.Duration
+
+	// ClearCache is called before every request that is not a GET, so that the
+	// responses cached by the other commands do not outlive the change.
+	ClearCache func() error
 }
 
 func (gateway *Gateway) AsyncTimeout() time.Duration {
@@ -392,6 +396,13 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response,
 }
 
 func (gateway Gateway) doRequestAndHandlerError(request *Request) (*http.Response, error) {
+	if gateway.ClearCache != nil && request.HTTPReq.Method != http.MethodGet {
+		err := gateway.ClearCache()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	rawResponse, err := gateway.doRequest(request.HTTPReq)
 	if err != nil {
 		return rawResponse, WrapNetworkErrors(request.HTTPReq.URL.Host, err)
@@ -412,7 +423,10 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 	var err error
 
 	if gateway.transport == nil {
-		makeHTTPTransport(&gateway)
+		err = makeHTTPTransport(&gateway)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	httpClient := NewHTTPClient(gateway.transport, NewRequestDumper(gateway.logger))
@@ -446,16 +460,22 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 	return response, err
 }
 
-func makeHTTPTransport(gateway *Gateway) {
+func makeHTTPTransport(gateway *Gateway) error {
+	tlsConfig, err := NewTLSConfig(gateway.trustedCerts, gateway.config)
+	if err != nil {
+		return err
+	}
+
 	gateway.transport = &http.Transport{
 		DisableKeepAlives: true,
 		Dial: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   gateway.DialTimeout,
 		}).Dial,
-		TLSClientConfig: NewTLSConfig(gateway.trustedCerts, gateway.config.IsSSLDisabled()),
+		TLSClientConfig: tlsConfig,
 		Proxy:           http.ProxyFromEnvironment,
 	}
+	return nil
 }
 
 func dialTimeout(envDialTimeout string) time.Duration {
@@ -468,5 +488,6 @@ func dialTimeout(envDialTimeout string) time.Duration {
 
 func (gateway *Gateway) SetTrustedCerts(certificates []tls.Certificate) {
 	gateway.trustedCerts = certificates
-	makeHTTPTransport(gateway)
+	// the transport is made with the new certificates by the next request
+	gateway.transport = nil
 }
@@ -100,6 +100,69 @@ var _ = Describe("Gateway", func() {
 		})
 	})
 
+	Describe("clearing the cache", func() {
+		var (
+			oldNewHTTPClient func(tr *http.Transport, dumper RequestDumper) HTTPClientInterface
+			clearCount       int
+			clearErr         error
+		)
+
+		BeforeEach(func() {
+			client = new(netfakes.FakeHTTPClientInterface)
+			client.DoReturns(&http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("{}"))}, nil)
+
+			oldNewHTTPClient = NewHTTPClient
+			NewHTTPClient = func(tr *http.Transport, dumper RequestDumper) HTTPClientInterface {
+				return client
+			}
+
+			clearCount = 0
+			clearErr = nil
+			ccGateway.ClearCache = func() error {
+				clearCount++
+				return clearErr
+			}
+		})
+
+		AfterEach(func() {
+			NewHTTPClient = oldNewHTTPClient
+		})
+
+		It("does not clear the cache for GET requests", func() {
+			request, apiErr := ccGateway.NewRequest("GET", "https://example.com/v2/apps", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, apiErr = ccGateway.PerformRequest(request)
+			Expect(apiErr).ToNot(HaveOccurred())
+			Expect(clearCount).To(Equal(0))
+		})
+
+		It("clears the cache before other requests", func() {
+			request, apiErr := ccGateway.NewRequest("DELETE", "https://example.com/v2/apps/some-guid", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, apiErr = ccGateway.PerformRequest(request)
+			Expect(apiErr).ToNot(HaveOccurred())
+			Expect(clearCount).To(Equal(1))
+			Expect(client.DoCallCount()).To(Equal(1))
+		})
+
+		Context("when the cache cannot be cleared", func() {
+			BeforeEach(func() {
+				clearErr = errors.New("some-cache-error")
+			})
+
+			It("returns the error without making the request", func() {
+				request, apiErr := ccGateway.NewRequest("DELETE", "https://example.com/v2/apps/some-guid", "BEARER my-access-token", nil)
+				Expect(apiErr).ToNot(HaveOccurred())
+
+				_, apiErr = ccGateway.PerformRequest(request)
+				Expect(apiErr).To(MatchError("some-cache-error"))
+				Expect(client.DoCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("NewRequest", func() {
 		var (
 			request *Request
@@ -3,23 +3,37 @@ package net
 import (
 	"crypto/tls"
 	"crypto/x509"
+
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 )
 
-func NewTLSConfig(trustedCerts []tls.Certificate, disableSSL bool) (TLSConfig *tls.Config) {
-	TLSConfig = &tls.Config{
-		MinVersion: tls.VersionTLS10,
+// NewTLSConfig returns the TLS configuration for connections to Cloud
+// Foundry. Servers are verified with the CA certificate set with 'cf api' and
+// trustedCerts, and the configured client certificate is presented to servers
+// that request one.
+func NewTLSConfig(trustedCerts []tls.Certificate, config coreconfig.Reader) (*tls.Config, error) {
+	rootCAs, certificates, err := config.TLSCertificates()
+	if err != nil {
+		return nil, err
+	}
+
+	TLSConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS10,
+		RootCAs:      rootCAs,
+		Certificates: certificates,
 	}
 
 	if len(trustedCerts) > 0 {
-		certPool := x509.NewCertPool()
+		if TLSConfig.RootCAs == nil {
+			TLSConfig.RootCAs = x509.NewCertPool()
+		}
 		for _, tlsCert := range trustedCerts {
 			cert, _ := x509.ParseCertificate(tlsCert.Certificate[0])
-			certPool.AddCert(cert)
+			TLSConfig.RootCAs.AddCert(cert)
 		}
-		TLSConfig.RootCAs = certPool
 	}
 
-	TLSConfig.InsecureSkipVerify = disableSSL
+	TLSConfig.InsecureSkipVerify = config.IsSSLDisabled()
 
-	return
+	return TLSConfig, nil
 }
@@ -6,7 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/util/testhelpers/rpcserver"
 	"code.cloudfoundry.org/cli/plugin"
-	"code.cloudfoundry.org/cli/plugin/models"
+	plugin_models "code.cloudfoundry.org/cli/plugin/models"
 )
 
 type FakeHandlers struct {
@@ -370,6 +370,102 @@ type FakeHandlers struct {
 	getServiceReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetV3AppStub        func(appName string, retVal *plugin_models.V3AppModel) error
+	getV3AppMutex       sync.RWMutex
+	getV3AppArgsForCall []struct {
+		appName string
+		retVal  *plugin_models.V3AppModel
+	}
+	getV3AppReturns struct {
+		result1 error
+	}
+	getV3AppReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetV3AppsStub        func(args string, retVal *[]plugin_models.V3AppModel) error
+	getV3AppsMutex       sync.RWMutex
+	getV3AppsArgsForCall []struct {
+		args   string
+		retVal *[]plugin_models.V3AppModel
+	}
+	getV3AppsReturns struct {
+		result1 error
+	}
+	getV3AppsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetV3DropletsStub        func(appName string, retVal *[]plugin_models.V3DropletModel) error
+	getV3DropletsMutex       sync.RWMutex
+	getV3DropletsArgsForCall []struct {
+		appName string
+		retVal  *[]plugin_models.V3DropletModel
+	}
+	getV3DropletsReturns struct {
+		result1 error
+	}
+	getV3DropletsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetV3PackagesStub        func(appName string, retVal *[]plugin_models.V3PackageModel) error
+	getV3PackagesMutex       sync.RWMutex
+	getV3PackagesArgsForCall []struct {
+		appName string
+		retVal  *[]plugin_models.V3PackageModel
+	}
+	getV3PackagesReturns struct {
+		result1 error
+	}
+	getV3PackagesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunV3TaskStub        func(request plugin_models.RunV3TaskRequest, retVal *plugin_models.V3TaskModel) error
+	runV3TaskMutex       sync.RWMutex
+	runV3TaskArgsForCall []struct {
+		request plugin_models.RunV3TaskRequest
+		retVal  *plugin_models.V3TaskModel
+	}
+	runV3TaskReturns struct {
+		result1 error
+	}
+	runV3TaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetV3TasksStub        func(appName string, retVal *[]plugin_models.V3TaskModel) error
+	getV3TasksMutex       sync.RWMutex
+	getV3TasksArgsForCall []struct {
+		appName string
+		retVal  *[]plugin_models.V3TaskModel
+	}
+	getV3TasksReturns struct {
+		result1 error
+	}
+	getV3TasksReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetIsolationSegmentsStub        func(args string, retVal *[]plugin_models.IsolationSegmentModel) error
+	getIsolationSegmentsMutex       sync.RWMutex
+	getIsolationSegmentsArgsForCall []struct {
+		args   string
+		retVal *[]plugin_models.IsolationSegmentModel
+	}
+	getIsolationSegmentsReturns struct {
+		result1 error
+	}
+	getIsolationSegmentsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CloudControllerRequestStub        func(request plugin_models.CloudControllerRequest, retVal *plugin_models.CloudControllerResponse) error
+	cloudControllerRequestMutex       sync.RWMutex
+	cloudControllerRequestArgsForCall []struct {
+		request plugin_models.CloudControllerRequest
+		retVal  *plugin_models.CloudControllerResponse
+	}
+	cloudControllerRequestReturns struct {
+		result1 error
+	}
+	cloudControllerRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1859,6 +1955,398 @@ func (fake *FakeHandlers) GetServiceReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetV3App(appName string, retVal *plugin_models.V3AppModel) error {
+	fake.getV3AppMutex.Lock()
+	ret, specificReturn := fake.getV3AppReturnsOnCall[len(fake.getV3AppArgsForCall)]
+	fake.getV3AppArgsForCall = append(fake.getV3AppArgsForCall, struct {
+		appName string
+		retVal  *plugin_models.V3AppModel
+	}{appName, retVal})
+	fake.recordInvocation("GetV3App", []interface{}{appName, retVal})
+	fake.getV3AppMutex.Unlock()
+	if fake.GetV3AppStub != nil {
+		return fake.GetV3AppStub(appName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getV3AppReturns.result1
+}
+
+func (fake *FakeHandlers) GetV3AppCallCount() int {
+	fake.getV3AppMutex.RLock()
+	defer fake.getV3AppMutex.RUnlock()
+	return len(fake.getV3AppArgsForCall)
+}
+
+func (fake *FakeHandlers) GetV3AppArgsForCall(i int) (string, *plugin_models.V3AppModel) {
+	fake.getV3AppMutex.RLock()
+	defer fake.getV3AppMutex.RUnlock()
+	return fake.getV3AppArgsForCall[i].appName, fake.getV3AppArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetV3AppReturns(result1 error) {
+	fake.GetV3AppStub = nil
+	fake.getV3AppReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3AppReturnsOnCall(i int, result1 error) {
+	fake.GetV3AppStub = nil
+	if fake.getV3AppReturnsOnCall == nil {
+		fake.getV3AppReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getV3AppReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3Apps(args string, retVal *[]plugin_models.V3AppModel) error {
+	fake.getV3AppsMutex.Lock()
+	ret, specificReturn := fake.getV3AppsReturnsOnCall[len(fake.getV3AppsArgsForCall)]
+	fake.getV3AppsArgsForCall = append(fake.getV3AppsArgsForCall, struct {
+		args   string
+		retVal *[]plugin_models.V3AppModel
+	}{args, retVal})
+	fake.recordInvocation("GetV3Apps", []interface{}{args, retVal})
+	fake.getV3AppsMutex.Unlock()
+	if fake.GetV3AppsStub != nil {
+		return fake.GetV3AppsStub(args, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getV3AppsReturns.result1
+}
+
+func (fake *FakeHandlers) GetV3AppsCallCount() int {
+	fake.getV3AppsMutex.RLock()
+	defer fake.getV3AppsMutex.RUnlock()
+	return len(fake.getV3AppsArgsForCall)
+}
+
+func (fake *FakeHandlers) GetV3AppsArgsForCall(i int) (string, *[]plugin_models.V3AppModel) {
+	fake.getV3AppsMutex.RLock()
+	defer fake.getV3AppsMutex.RUnlock()
+	return fake.getV3AppsArgsForCall[i].args, fake.getV3AppsArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetV3AppsReturns(result1 error) {
+	fake.GetV3AppsStub = nil
+	fake.getV3AppsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3AppsReturnsOnCall(i int, result1 error) {
+	fake.GetV3AppsStub = nil
+	if fake.getV3AppsReturnsOnCall == nil {
+		fake.getV3AppsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getV3AppsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3Droplets(appName string, retVal *[]plugin_models.V3DropletModel) error {
+	fake.getV3DropletsMutex.Lock()
+	ret, specificReturn := fake.getV3DropletsReturnsOnCall[len(fake.getV3DropletsArgsForCall)]
+	fake.getV3DropletsArgsForCall = append(fake.getV3DropletsArgsForCall, struct {
+		appName string
+		retVal  *[]plugin_models.V3DropletModel
+	}{appName, retVal})
+	fake.recordInvocation("GetV3Droplets", []interface{}{appName, retVal})
+	fake.getV3DropletsMutex.Unlock()
+	if fake.GetV3DropletsStub != nil {
+		return fake.GetV3DropletsStub(appName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getV3DropletsReturns.result1
+}
+
+func (fake *FakeHandlers) GetV3DropletsCallCount() int {
+	fake.getV3DropletsMutex.RLock()
+	defer fake.getV3DropletsMutex.RUnlock()
+	return len(fake.getV3DropletsArgsForCall)
+}
+
+func (fake *FakeHandlers) GetV3DropletsArgsForCall(i int) (string, *[]plugin_models.V3DropletModel) {
+	fake.getV3DropletsMutex.RLock()
+	defer fake.getV3DropletsMutex.RUnlock()
+	return fake.getV3DropletsArgsForCall[i].appName, fake.getV3DropletsArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetV3DropletsReturns(result1 error) {
+	fake.GetV3DropletsStub = nil
+	fake.getV3DropletsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3DropletsReturnsOnCall(i int, result1 error) {
+	fake.GetV3DropletsStub = nil
+	if fake.getV3DropletsReturnsOnCall == nil {
+		fake.getV3DropletsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getV3DropletsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3Packages(appName string, retVal *[]plugin_models.V3PackageModel) error {
+	fake.getV3PackagesMutex.Lock()
+	ret, specificReturn := fake.getV3PackagesReturnsOnCall[len(fake.getV3PackagesArgsForCall)]
+	fake.getV3PackagesArgsForCall = append(fake.getV3PackagesArgsForCall, struct {
+		appName string
+		retVal  *[]plugin_models.V3PackageModel
+	}{appName, retVal})
+	fake.recordInvocation("GetV3Packages", []interface{}{appName, retVal})
+	fake.getV3PackagesMutex.Unlock()
+	if fake.GetV3PackagesStub != nil {
+		return fake.GetV3PackagesStub(appName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getV3PackagesReturns.result1
+}
+
+func (fake *FakeHandlers) GetV3PackagesCallCount() int {
+	fake.getV3PackagesMutex.RLock()
+	defer fake.getV3PackagesMutex.RUnlock()
+	return len(fake.getV3PackagesArgsForCall)
+}
+
+func (fake *FakeHandlers) GetV3PackagesArgsForCall(i int) (string, *[]plugin_models.V3PackageModel) {
+	fake.getV3PackagesMutex.RLock()
+	defer fake.getV3PackagesMutex.RUnlock()
+	return fake.getV3PackagesArgsForCall[i].appName, fake.getV3PackagesArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetV3PackagesReturns(result1 error) {
+	fake.GetV3PackagesStub = nil
+	fake.getV3PackagesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3PackagesReturnsOnCall(i int, result1 error) {
+	fake.GetV3PackagesStub = nil
+	if fake.getV3PackagesReturnsOnCall == nil {
+		fake.getV3PackagesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getV3PackagesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) RunV3Task(request plugin_models.RunV3TaskRequest, retVal *plugin_models.V3TaskModel) error {
+	fake.runV3TaskMutex.Lock()
+	ret, specificReturn := fake.runV3TaskReturnsOnCall[len(fake.runV3TaskArgsForCall)]
+	fake.runV3TaskArgsForCall = append(fake.runV3TaskArgsForCall, struct {
+		request plugin_models.RunV3TaskRequest
+		retVal  *plugin_models.V3TaskModel
+	}{request, retVal})
+	fake.recordInvocation("RunV3Task", []interface{}{request, retVal})
+	fake.runV3TaskMutex.Unlock()
+	if fake.RunV3TaskStub != nil {
+		return fake.RunV3TaskStub(request, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.runV3TaskReturns.result1
+}
+
+func (fake *FakeHandlers) RunV3TaskCallCount() int {
+	fake.runV3TaskMutex.RLock()
+	defer fake.runV3TaskMutex.RUnlock()
+	return len(fake.runV3TaskArgsForCall)
+}
+
+func (fake *FakeHandlers) RunV3TaskArgsForCall(i int) (plugin_models.RunV3TaskRequest, *plugin_models.V3TaskModel) {
+	fake.runV3TaskMutex.RLock()
+	defer fake.runV3TaskMutex.RUnlock()
+	return fake.runV3TaskArgsForCall[i].request, fake.runV3TaskArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) RunV3TaskReturns(result1 error) {
+	fake.RunV3TaskStub = nil
+	fake.runV3TaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) RunV3TaskReturnsOnCall(i int, result1 error) {
+	fake.RunV3TaskStub = nil
+	if fake.runV3TaskReturnsOnCall == nil {
+		fake.runV3TaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runV3TaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3Tasks(appName string, retVal *[]plugin_models.V3TaskModel) error {
+	fake.getV3TasksMutex.Lock()
+	ret, specificReturn := fake.getV3TasksReturnsOnCall[len(fake.getV3TasksArgsForCall)]
+	fake.getV3TasksArgsForCall = append(fake.getV3TasksArgsForCall, struct {
+		appName string
+		retVal  *[]plugin_models.V3TaskModel
+	}{appName, retVal})
+	fake.recordInvocation("GetV3Tasks", []interface{}{appName, retVal})
+	fake.getV3TasksMutex.Unlock()
+	if fake.GetV3TasksStub != nil {
+		return fake.GetV3TasksStub(appName, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getV3TasksReturns.result1
+}
+
+func (fake *FakeHandlers) GetV3TasksCallCount() int {
+	fake.getV3TasksMutex.RLock()
+	defer fake.getV3TasksMutex.RUnlock()
+	return len(fake.getV3TasksArgsForCall)
+}
+
+func (fake *FakeHandlers) GetV3TasksArgsForCall(i int) (string, *[]plugin_models.V3TaskModel) {
+	fake.getV3TasksMutex.RLock()
+	defer fake.getV3TasksMutex.RUnlock()
+	return fake.getV3TasksArgsForCall[i].appName, fake.getV3TasksArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetV3TasksReturns(result1 error) {
+	fake.GetV3TasksStub = nil
+	fake.getV3TasksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetV3TasksReturnsOnCall(i int, result1 error) {
+	fake.GetV3TasksStub = nil
+	if fake.getV3TasksReturnsOnCall == nil {
+		fake.getV3TasksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getV3TasksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetIsolationSegments(args string, retVal *[]plugin_models.IsolationSegmentModel) error {
+	fake.getIsolationSegmentsMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentsReturnsOnCall[len(fake.getIsolationSegmentsArgsForCall)]
+	fake.getIsolationSegmentsArgsForCall = append(fake.getIsolationSegmentsArgsForCall, struct {
+		args   string
+		retVal *[]plugin_models.IsolationSegmentModel
+	}{args, retVal})
+	fake.recordInvocation("GetIsolationSegments", []interface{}{args, retVal})
+	fake.getIsolationSegmentsMutex.Unlock()
+	if fake.GetIsolationSegmentsStub != nil {
+		return fake.GetIsolationSegmentsStub(args, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getIsolationSegmentsReturns.result1
+}
+
+func (fake *FakeHandlers) GetIsolationSegmentsCallCount() int {
+	fake.getIsolationSegmentsMutex.RLock()
+	defer fake.getIsolationSegmentsMutex.RUnlock()
+	return len(fake.getIsolationSegmentsArgsForCall)
+}
+
+func (fake *FakeHandlers) GetIsolationSegmentsArgsForCall(i int) (string, *[]plugin_models.IsolationSegmentModel) {
+	fake.getIsolationSegmentsMutex.RLock()
+	defer fake.getIsolationSegmentsMutex.RUnlock()
+	return fake.getIsolationSegmentsArgsForCall[i].args, fake.getIsolationSegmentsArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetIsolationSegmentsReturns(result1 error) {
+	fake.GetIsolationSegmentsStub = nil
+	fake.getIsolationSegmentsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetIsolationSegmentsReturnsOnCall(i int, result1 error) {
+	fake.GetIsolationSegmentsStub = nil
+	if fake.getIsolationSegmentsReturnsOnCall == nil {
+		fake.getIsolationSegmentsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.getIsolationSegmentsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) CloudControllerRequest(request plugin_models.CloudControllerRequest, retVal *plugin_models.CloudControllerResponse) error {
+	fake.cloudControllerRequestMutex.Lock()
+	ret, specificReturn := fake.cloudControllerRequestReturnsOnCall[len(fake.cloudControllerRequestArgsForCall)]
+	fake.cloudControllerRequestArgsForCall = append(fake.cloudControllerRequestArgsForCall, struct {
+		request plugin_models.CloudControllerRequest
+		retVal  *plugin_models.CloudControllerResponse
+	}{request, retVal})
+	fake.recordInvocation("CloudControllerRequest", []interface{}{request, retVal})
+	fake.cloudControllerRequestMutex.Unlock()
+	if fake.CloudControllerRequestStub != nil {
+		return fake.CloudControllerRequestStub(request, retVal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerRequestReturns.result1
+}
+
+func (fake *FakeHandlers) CloudControllerRequestCallCount() int {
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	return len(fake.cloudControllerRequestArgsForCall)
+}
+
+func (fake *FakeHandlers) CloudControllerRequestArgsForCall(i int) (plugin_models.CloudControllerRequest, *plugin_models.CloudControllerResponse) {
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	return fake.cloudControllerRequestArgsForCall[i].request, fake.cloudControllerRequestArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) CloudControllerRequestReturns(result1 error) {
+	fake.CloudControllerRequestStub = nil
+	fake.cloudControllerRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) CloudControllerRequestReturnsOnCall(i int, result1 error) {
+	fake.CloudControllerRequestStub = nil
+	if fake.cloudControllerRequestReturnsOnCall == nil {
+		fake.cloudControllerRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cloudControllerRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1922,6 +2410,22 @@ func (fake *FakeHandlers) Invocations() map[string][][]interface{} {
 	defer fake.getSpaceMutex.RUnlock()
 	fake.getServiceMutex.RLock()
 	defer fake.getServiceMutex.RUnlock()
+	fake.getV3AppMutex.RLock()
+	defer fake.getV3AppMutex.RUnlock()
+	fake.getV3AppsMutex.RLock()
+	defer fake.getV3AppsMutex.RUnlock()
+	fake.getV3DropletsMutex.RLock()
+	defer fake.getV3DropletsMutex.RUnlock()
+	fake.getV3PackagesMutex.RLock()
+	defer fake.getV3PackagesMutex.RUnlock()
+	fake.runV3TaskMutex.RLock()
+	defer fake.runV3TaskMutex.RUnlock()
+	fake.getV3TasksMutex.RLock()
+	defer fake.getV3TasksMutex.RUnlock()
+	fake.getIsolationSegmentsMutex.RLock()
+	defer fake.getIsolationSegmentsMutex.RUnlock()
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -46,6 +46,14 @@ type Handlers interface {
 	GetOrg(orgName string, retVal *plugin_models.GetOrg_Model) error
 	GetSpace(spaceName string, retVal *plugin_models.GetSpace_Model) error
 	GetService(serviceInstance string, retVal *plugin_models.GetService_Model) error
+	GetV3App(appName string, retVal *plugin_models.V3AppModel) error
+	GetV3Apps(args string, retVal *[]plugin_models.V3AppModel) error
+	GetV3Droplets(appName string, retVal *[]plugin_models.V3DropletModel) error
+	GetV3Packages(appName string, retVal *[]plugin_models.V3PackageModel) error
+	RunV3Task(request plugin_models.RunV3TaskRequest, retVal *plugin_models.V3TaskModel) error
+	GetV3Tasks(appName string, retVal *[]plugin_models.V3TaskModel) error
+	GetIsolationSegments(args string, retVal *[]plugin_models.IsolationSegmentModel) error
+	CloudControllerRequest(request plugin_models.CloudControllerRequest, retVal *plugin_models.CloudControllerResponse) error
 }
 
 type TestServer struct {
@@ -2,6 +2,8 @@
 package commandfakes
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"sync"
 	"time"
 
@@ -24,6 +26,12 @@ type FakeConfig struct {
 	addPluginArgsForCall []struct {
 		arg1 configv3.Plugin
 	}
+	AddPluginKeyStub        func(name string, publicKey string)
+	addPluginKeyMutex       sync.RWMutex
+	addPluginKeyArgsForCall []struct {
+		name      string
+		publicKey string
+	}
 	AddPluginRepositoryStub        func(name string, url string)
 	addPluginRepositoryMutex       sync.RWMutex
 	addPluginRepositoryArgsForCall []struct {
@@ -57,6 +65,15 @@ type FakeConfig struct {
 	binaryVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CACertFileStub        func() string
+	cACertFileMutex       sync.RWMutex
+	cACertFileArgsForCall []struct{}
+	cACertFileReturns     struct {
+		result1 string
+	}
+	cACertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CFPasswordStub        func() string
 	cFPasswordMutex       sync.RWMutex
 	cFPasswordArgsForCall []struct{}
@@ -75,6 +92,24 @@ type FakeConfig struct {
 	cFUsernameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ClientCertFileStub        func() string
+	clientCertFileMutex       sync.RWMutex
+	clientCertFileArgsForCall []struct{}
+	clientCertFileReturns     struct {
+		result1 string
+	}
+	clientCertFileReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ClientKeyFileStub        func() string
+	clientKeyFileMutex       sync.RWMutex
+	clientKeyFileArgsForCall []struct{}
+	clientKeyFileReturns     struct {
+		result1 string
+	}
+	clientKeyFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct{}
@@ -95,6 +130,17 @@ type FakeConfig struct {
 		result1 configv3.User
 		result2 error
 	}
+	CurrentUserNameStub        func() (string, error)
+	currentUserNameMutex       sync.RWMutex
+	currentUserNameArgsForCall []struct{}
+	currentUserNameReturns     struct {
+		result1 string
+		result2 error
+	}
+	currentUserNameReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	DialTimeoutStub        func() time.Duration
 	dialTimeoutMutex       sync.RWMutex
 	dialTimeoutArgsForCall []struct{}
@@ -135,6 +181,26 @@ type FakeConfig struct {
 		result1 configv3.Plugin
 		result2 bool
 	}
+	HTTPCacheStub        func() (bool, time.Duration)
+	hTTPCacheMutex       sync.RWMutex
+	hTTPCacheArgsForCall []struct{}
+	hTTPCacheReturns     struct {
+		result1 bool
+		result2 time.Duration
+	}
+	hTTPCacheReturnsOnCall map[int]struct {
+		result1 bool
+		result2 time.Duration
+	}
+	HTTPCacheDirectoryStub        func() string
+	hTTPCacheDirectoryMutex       sync.RWMutex
+	hTTPCacheDirectoryArgsForCall []struct{}
+	hTTPCacheDirectoryReturns     struct {
+		result1 string
+	}
+	hTTPCacheDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
 	GetPluginCaseInsensitiveStub        func(pluginName string) (configv3.Plugin, bool)
 	getPluginCaseInsensitiveMutex       sync.RWMutex
 	getPluginCaseInsensitiveArgsForCall []struct {
@@ -211,6 +277,15 @@ type FakeConfig struct {
 	pluginHomeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PluginKeysStub        func() []configv3.PluginKey
+	pluginKeysMutex       sync.RWMutex
+	pluginKeysArgsForCall []struct{}
+	pluginKeysReturns     struct {
+		result1 []configv3.PluginKey
+	}
+	pluginKeysReturnsOnCall map[int]struct {
+		result1 []configv3.PluginKey
+	}
 	PluginRepositoriesStub        func() []configv3.PluginRepository
 	pluginRepositoriesMutex       sync.RWMutex
 	pluginRepositoriesArgsForCall []struct{}
@@ -238,6 +313,15 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	RecordDirectoryStub        func() string
+	recordDirectoryMutex       sync.RWMutex
+	recordDirectoryArgsForCall []struct{}
+	recordDirectoryReturns     struct {
+		result1 string
+	}
+	recordDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -252,6 +336,20 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RemovePluginKeyStub        func(name string)
+	removePluginKeyMutex       sync.RWMutex
+	removePluginKeyArgsForCall []struct {
+		name string
+	}
+	ReplayDirectoryStub        func() string
+	replayDirectoryMutex       sync.RWMutex
+	replayDirectoryArgsForCall []struct{}
+	replayDirectoryReturns     struct {
+		result1 string
+	}
+	replayDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct{}
@@ -284,6 +382,13 @@ type FakeConfig struct {
 		name     string
 		allowSSH bool
 	}
+	SetTLSCertificateFilesStub        func(caCertFile string, clientCertFile string, clientKeyFile string)
+	setTLSCertificateFilesMutex       sync.RWMutex
+	setTLSCertificateFilesArgsForCall []struct {
+		caCertFile     string
+		clientCertFile string
+		clientKeyFile  string
+	}
 	SetTargetInformationStub        func(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	setTargetInformationMutex       sync.RWMutex
 	setTargetInformationArgsForCall []struct {
@@ -354,6 +459,15 @@ type FakeConfig struct {
 	startupTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	StoppedAppsFilePathStub        func() string
+	stoppedAppsFilePathMutex       sync.RWMutex
+	stoppedAppsFilePathArgsForCall []struct{}
+	stoppedAppsFilePathReturns     struct {
+		result1 string
+	}
+	stoppedAppsFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	TargetStub        func() string
 	targetMutex       sync.RWMutex
 	targetArgsForCall []struct{}
@@ -381,6 +495,28 @@ type FakeConfig struct {
 	targetedSpaceReturnsOnCall map[int]struct {
 		result1 configv3.Space
 	}
+	TLSCertificatesStub        func() (*x509.CertPool, []tls.Certificate, error)
+	tLSCertificatesMutex       sync.RWMutex
+	tLSCertificatesArgsForCall []struct{}
+	tLSCertificatesReturns     struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}
+	tLSCertificatesReturnsOnCall map[int]struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}
+	TraceHARFilesStub        func() []string
+	traceHARFilesMutex       sync.RWMutex
+	traceHARFilesArgsForCall []struct{}
+	traceHARFilesReturns     struct {
+		result1 []string
+	}
+	traceHARFilesReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct{}
@@ -514,6 +650,31 @@ func (fake *FakeConfig) AddPluginArgsForCall(i int) configv3.Plugin {
 	return fake.addPluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) AddPluginKey(name string, publicKey string) {
+	fake.addPluginKeyMutex.Lock()
+	fake.addPluginKeyArgsForCall = append(fake.addPluginKeyArgsForCall, struct {
+		name      string
+		publicKey string
+	}{name, publicKey})
+	fake.recordInvocation("AddPluginKey", []interface{}{name, publicKey})
+	fake.addPluginKeyMutex.Unlock()
+	if fake.AddPluginKeyStub != nil {
+		fake.AddPluginKeyStub(name, publicKey)
+	}
+}
+
+func (fake *FakeConfig) AddPluginKeyCallCount() int {
+	fake.addPluginKeyMutex.RLock()
+	defer fake.addPluginKeyMutex.RUnlock()
+	return len(fake.addPluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginKeyArgsForCall(i int) (string, string) {
+	fake.addPluginKeyMutex.RLock()
+	defer fake.addPluginKeyMutex.RUnlock()
+	return fake.addPluginKeyArgsForCall[i].name, fake.addPluginKeyArgsForCall[i].publicKey
+}
+
 func (fake *FakeConfig) AddPluginRepository(name string, url string) {
 	fake.addPluginRepositoryMutex.Lock()
 	fake.addPluginRepositoryArgsForCall = append(fake.addPluginRepositoryArgsForCall, struct {
@@ -659,6 +820,46 @@ func (fake *FakeConfig) BinaryVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) CACertFile() string {
+	fake.cACertFileMutex.Lock()
+	ret, specificReturn := fake.cACertFileReturnsOnCall[len(fake.cACertFileArgsForCall)]
+	fake.cACertFileArgsForCall = append(fake.cACertFileArgsForCall, struct{}{})
+	fake.recordInvocation("CACertFile", []interface{}{})
+	fake.cACertFileMutex.Unlock()
+	if fake.CACertFileStub != nil {
+		return fake.CACertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cACertFileReturns.result1
+}
+
+func (fake *FakeConfig) CACertFileCallCount() int {
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
+	return len(fake.cACertFileArgsForCall)
+}
+
+func (fake *FakeConfig) CACertFileReturns(result1 string) {
+	fake.CACertFileStub = nil
+	fake.cACertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CACertFileReturnsOnCall(i int, result1 string) {
+	fake.CACertFileStub = nil
+	if fake.cACertFileReturnsOnCall == nil {
+		fake.cACertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cACertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CFPassword() string {
 	fake.cFPasswordMutex.Lock()
 	ret, specificReturn := fake.cFPasswordReturnsOnCall[len(fake.cFPasswordArgsForCall)]
@@ -739,6 +940,86 @@ func (fake *FakeConfig) CFUsernameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ClientCertFile() string {
+	fake.clientCertFileMutex.Lock()
+	ret, specificReturn := fake.clientCertFileReturnsOnCall[len(fake.clientCertFileArgsForCall)]
+	fake.clientCertFileArgsForCall = append(fake.clientCertFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientCertFile", []interface{}{})
+	fake.clientCertFileMutex.Unlock()
+	if fake.ClientCertFileStub != nil {
+		return fake.ClientCertFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.clientCertFileReturns.result1
+}
+
+func (fake *FakeConfig) ClientCertFileCallCount() int {
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	return len(fake.clientCertFileArgsForCall)
+}
+
+func (fake *FakeConfig) ClientCertFileReturns(result1 string) {
+	fake.ClientCertFileStub = nil
+	fake.clientCertFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientCertFileReturnsOnCall(i int, result1 string) {
+	fake.ClientCertFileStub = nil
+	if fake.clientCertFileReturnsOnCall == nil {
+		fake.clientCertFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.clientCertFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientKeyFile() string {
+	fake.clientKeyFileMutex.Lock()
+	ret, specificReturn := fake.clientKeyFileReturnsOnCall[len(fake.clientKeyFileArgsForCall)]
+	fake.clientKeyFileArgsForCall = append(fake.clientKeyFileArgsForCall, struct{}{})
+	fake.recordInvocation("ClientKeyFile", []interface{}{})
+	fake.clientKeyFileMutex.Unlock()
+	if fake.ClientKeyFileStub != nil {
+		return fake.ClientKeyFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.clientKeyFileReturns.result1
+}
+
+func (fake *FakeConfig) ClientKeyFileCallCount() int {
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
+	return len(fake.clientKeyFileArgsForCall)
+}
+
+func (fake *FakeConfig) ClientKeyFileReturns(result1 string) {
+	fake.ClientKeyFileStub = nil
+	fake.clientKeyFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ClientKeyFileReturnsOnCall(i int, result1 string) {
+	fake.ClientKeyFileStub = nil
+	if fake.clientKeyFileReturnsOnCall == nil {
+		fake.clientKeyFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.clientKeyFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
@@ -822,6 +1103,49 @@ func (fake *FakeConfig) CurrentUserReturnsOnCall(i int, result1 configv3.User, r
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) CurrentUserName() (string, error) {
+	fake.currentUserNameMutex.Lock()
+	ret, specificReturn := fake.currentUserNameReturnsOnCall[len(fake.currentUserNameArgsForCall)]
+	fake.currentUserNameArgsForCall = append(fake.currentUserNameArgsForCall, struct{}{})
+	fake.recordInvocation("CurrentUserName", []interface{}{})
+	fake.currentUserNameMutex.Unlock()
+	if fake.CurrentUserNameStub != nil {
+		return fake.CurrentUserNameStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.currentUserNameReturns.result1, fake.currentUserNameReturns.result2
+}
+
+func (fake *FakeConfig) CurrentUserNameCallCount() int {
+	fake.currentUserNameMutex.RLock()
+	defer fake.currentUserNameMutex.RUnlock()
+	return len(fake.currentUserNameArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentUserNameReturns(result1 string, result2 error) {
+	fake.CurrentUserNameStub = nil
+	fake.currentUserNameReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) CurrentUserNameReturnsOnCall(i int, result1 string, result2 error) {
+	fake.CurrentUserNameStub = nil
+	if fake.currentUserNameReturnsOnCall == nil {
+		fake.currentUserNameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.currentUserNameReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) DialTimeout() time.Duration {
 	fake.dialTimeoutMutex.Lock()
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
@@ -993,6 +1317,89 @@ func (fake *FakeConfig) GetPluginReturnsOnCall(i int, result1 configv3.Plugin, r
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) HTTPCache() (bool, time.Duration) {
+	fake.hTTPCacheMutex.Lock()
+	ret, specificReturn := fake.hTTPCacheReturnsOnCall[len(fake.hTTPCacheArgsForCall)]
+	fake.hTTPCacheArgsForCall = append(fake.hTTPCacheArgsForCall, struct{}{})
+	fake.recordInvocation("HTTPCache", []interface{}{})
+	fake.hTTPCacheMutex.Unlock()
+	if fake.HTTPCacheStub != nil {
+		return fake.HTTPCacheStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.hTTPCacheReturns.result1, fake.hTTPCacheReturns.result2
+}
+
+func (fake *FakeConfig) HTTPCacheCallCount() int {
+	fake.hTTPCacheMutex.RLock()
+	defer fake.hTTPCacheMutex.RUnlock()
+	return len(fake.hTTPCacheArgsForCall)
+}
+
+func (fake *FakeConfig) HTTPCacheReturns(result1 bool, result2 time.Duration) {
+	fake.HTTPCacheStub = nil
+	fake.hTTPCacheReturns = struct {
+		result1 bool
+		result2 time.Duration
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) HTTPCacheReturnsOnCall(i int, result1 bool, result2 time.Duration) {
+	fake.HTTPCacheStub = nil
+	if fake.hTTPCacheReturnsOnCall == nil {
+		fake.hTTPCacheReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 time.Duration
+		})
+	}
+	fake.hTTPCacheReturnsOnCall[i] = struct {
+		result1 bool
+		result2 time.Duration
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) HTTPCacheDirectory() string {
+	fake.hTTPCacheDirectoryMutex.Lock()
+	ret, specificReturn := fake.hTTPCacheDirectoryReturnsOnCall[len(fake.hTTPCacheDirectoryArgsForCall)]
+	fake.hTTPCacheDirectoryArgsForCall = append(fake.hTTPCacheDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("HTTPCacheDirectory", []interface{}{})
+	fake.hTTPCacheDirectoryMutex.Unlock()
+	if fake.HTTPCacheDirectoryStub != nil {
+		return fake.HTTPCacheDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.hTTPCacheDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) HTTPCacheDirectoryCallCount() int {
+	fake.hTTPCacheDirectoryMutex.RLock()
+	defer fake.hTTPCacheDirectoryMutex.RUnlock()
+	return len(fake.hTTPCacheDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) HTTPCacheDirectoryReturns(result1 string) {
+	fake.HTTPCacheDirectoryStub = nil
+	fake.hTTPCacheDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HTTPCacheDirectoryReturnsOnCall(i int, result1 string) {
+	fake.HTTPCacheDirectoryStub = nil
+	if fake.hTTPCacheDirectoryReturnsOnCall == nil {
+		fake.hTTPCacheDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.hTTPCacheDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool) {
 	fake.getPluginCaseInsensitiveMutex.Lock()
 	ret, specificReturn := fake.getPluginCaseInsensitiveReturnsOnCall[len(fake.getPluginCaseInsensitiveArgsForCall)]
@@ -1324,6 +1731,46 @@ func (fake *FakeConfig) PluginHomeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) PluginKeys() []configv3.PluginKey {
+	fake.pluginKeysMutex.Lock()
+	ret, specificReturn := fake.pluginKeysReturnsOnCall[len(fake.pluginKeysArgsForCall)]
+	fake.pluginKeysArgsForCall = append(fake.pluginKeysArgsForCall, struct{}{})
+	fake.recordInvocation("PluginKeys", []interface{}{})
+	fake.pluginKeysMutex.Unlock()
+	if fake.PluginKeysStub != nil {
+		return fake.PluginKeysStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.pluginKeysReturns.result1
+}
+
+func (fake *FakeConfig) PluginKeysCallCount() int {
+	fake.pluginKeysMutex.RLock()
+	defer fake.pluginKeysMutex.RUnlock()
+	return len(fake.pluginKeysArgsForCall)
+}
+
+func (fake *FakeConfig) PluginKeysReturns(result1 []configv3.PluginKey) {
+	fake.PluginKeysStub = nil
+	fake.pluginKeysReturns = struct {
+		result1 []configv3.PluginKey
+	}{result1}
+}
+
+func (fake *FakeConfig) PluginKeysReturnsOnCall(i int, result1 []configv3.PluginKey) {
+	fake.PluginKeysStub = nil
+	if fake.pluginKeysReturnsOnCall == nil {
+		fake.pluginKeysReturnsOnCall = make(map[int]struct {
+			result1 []configv3.PluginKey
+		})
+	}
+	fake.pluginKeysReturnsOnCall[i] = struct {
+		result1 []configv3.PluginKey
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginRepositories() []configv3.PluginRepository {
 	fake.pluginRepositoriesMutex.Lock()
 	ret, specificReturn := fake.pluginRepositoriesReturnsOnCall[len(fake.pluginRepositoriesArgsForCall)]
@@ -1444,6 +1891,46 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) RecordDirectory() string {
+	fake.recordDirectoryMutex.Lock()
+	ret, specificReturn := fake.recordDirectoryReturnsOnCall[len(fake.recordDirectoryArgsForCall)]
+	fake.recordDirectoryArgsForCall = append(fake.recordDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("RecordDirectory", []interface{}{})
+	fake.recordDirectoryMutex.Unlock()
+	if fake.RecordDirectoryStub != nil {
+		return fake.RecordDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.recordDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) RecordDirectoryCallCount() int {
+	fake.recordDirectoryMutex.RLock()
+	defer fake.recordDirectoryMutex.RUnlock()
+	return len(fake.recordDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) RecordDirectoryReturns(result1 string) {
+	fake.RecordDirectoryStub = nil
+	fake.recordDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RecordDirectoryReturnsOnCall(i int, result1 string) {
+	fake.RecordDirectoryStub = nil
+	if fake.recordDirectoryReturnsOnCall == nil {
+		fake.recordDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.recordDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -1508,6 +1995,70 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) RemovePluginKey(name string) {
+	fake.removePluginKeyMutex.Lock()
+	fake.removePluginKeyArgsForCall = append(fake.removePluginKeyArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("RemovePluginKey", []interface{}{name})
+	fake.removePluginKeyMutex.Unlock()
+	if fake.RemovePluginKeyStub != nil {
+		fake.RemovePluginKeyStub(name)
+	}
+}
+
+func (fake *FakeConfig) RemovePluginKeyCallCount() int {
+	fake.removePluginKeyMutex.RLock()
+	defer fake.removePluginKeyMutex.RUnlock()
+	return len(fake.removePluginKeyArgsForCall)
+}
+
+func (fake *FakeConfig) RemovePluginKeyArgsForCall(i int) string {
+	fake.removePluginKeyMutex.RLock()
+	defer fake.removePluginKeyMutex.RUnlock()
+	return fake.removePluginKeyArgsForCall[i].name
+}
+
+func (fake *FakeConfig) ReplayDirectory() string {
+	fake.replayDirectoryMutex.Lock()
+	ret, specificReturn := fake.replayDirectoryReturnsOnCall[len(fake.replayDirectoryArgsForCall)]
+	fake.replayDirectoryArgsForCall = append(fake.replayDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("ReplayDirectory", []interface{}{})
+	fake.replayDirectoryMutex.Unlock()
+	if fake.ReplayDirectoryStub != nil {
+		return fake.ReplayDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.replayDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) ReplayDirectoryCallCount() int {
+	fake.replayDirectoryMutex.RLock()
+	defer fake.replayDirectoryMutex.RUnlock()
+	return len(fake.replayDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) ReplayDirectoryReturns(result1 string) {
+	fake.ReplayDirectoryStub = nil
+	fake.replayDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ReplayDirectoryReturnsOnCall(i int, result1 string) {
+	fake.ReplayDirectoryStub = nil
+	if fake.replayDirectoryReturnsOnCall == nil {
+		fake.replayDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.replayDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
@@ -1647,6 +2198,32 @@ func (fake *FakeConfig) SetSpaceInformationArgsForCall(i int) (string, string, b
 	return fake.setSpaceInformationArgsForCall[i].guid, fake.setSpaceInformationArgsForCall[i].name, fake.setSpaceInformationArgsForCall[i].allowSSH
 }
 
+func (fake *FakeConfig) SetTLSCertificateFiles(caCertFile string, clientCertFile string, clientKeyFile string) {
+	fake.setTLSCertificateFilesMutex.Lock()
+	fake.setTLSCertificateFilesArgsForCall = append(fake.setTLSCertificateFilesArgsForCall, struct {
+		caCertFile     string
+		clientCertFile string
+		clientKeyFile  string
+	}{caCertFile, clientCertFile, clientKeyFile})
+	fake.recordInvocation("SetTLSCertificateFiles", []interface{}{caCertFile, clientCertFile, clientKeyFile})
+	fake.setTLSCertificateFilesMutex.Unlock()
+	if fake.SetTLSCertificateFilesStub != nil {
+		fake.SetTLSCertificateFilesStub(caCertFile, clientCertFile, clientKeyFile)
+	}
+}
+
+func (fake *FakeConfig) SetTLSCertificateFilesCallCount() int {
+	fake.setTLSCertificateFilesMutex.RLock()
+	defer fake.setTLSCertificateFilesMutex.RUnlock()
+	return len(fake.setTLSCertificateFilesArgsForCall)
+}
+
+func (fake *FakeConfig) SetTLSCertificateFilesArgsForCall(i int) (string, string, string) {
+	fake.setTLSCertificateFilesMutex.RLock()
+	defer fake.setTLSCertificateFilesMutex.RUnlock()
+	return fake.setTLSCertificateFilesArgsForCall[i].caCertFile, fake.setTLSCertificateFilesArgsForCall[i].clientCertFile, fake.setTLSCertificateFilesArgsForCall[i].clientKeyFile
+}
+
 func (fake *FakeConfig) SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool) {
 	fake.setTargetInformationMutex.Lock()
 	fake.setTargetInformationArgsForCall = append(fake.setTargetInformationArgsForCall, struct {
@@ -1936,6 +2513,46 @@ func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration
 	}{result1}
 }
 
+func (fake *FakeConfig) StoppedAppsFilePath() string {
+	fake.stoppedAppsFilePathMutex.Lock()
+	ret, specificReturn := fake.stoppedAppsFilePathReturnsOnCall[len(fake.stoppedAppsFilePathArgsForCall)]
+	fake.stoppedAppsFilePathArgsForCall = append(fake.stoppedAppsFilePathArgsForCall, struct{}{})
+	fake.recordInvocation("StoppedAppsFilePath", []interface{}{})
+	fake.stoppedAppsFilePathMutex.Unlock()
+	if fake.StoppedAppsFilePathStub != nil {
+		return fake.StoppedAppsFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.stoppedAppsFilePathReturns.result1
+}
+
+func (fake *FakeConfig) StoppedAppsFilePathCallCount() int {
+	fake.stoppedAppsFilePathMutex.RLock()
+	defer fake.stoppedAppsFilePathMutex.RUnlock()
+	return len(fake.stoppedAppsFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) StoppedAppsFilePathReturns(result1 string) {
+	fake.StoppedAppsFilePathStub = nil
+	fake.stoppedAppsFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) StoppedAppsFilePathReturnsOnCall(i int, result1 string) {
+	fake.StoppedAppsFilePathStub = nil
+	if fake.stoppedAppsFilePathReturnsOnCall == nil {
+		fake.stoppedAppsFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.stoppedAppsFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Target() string {
 	fake.targetMutex.Lock()
 	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
@@ -2056,6 +2673,92 @@ func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space
 	}{result1}
 }
 
+func (fake *FakeConfig) TLSCertificates() (*x509.CertPool, []tls.Certificate, error) {
+	fake.tLSCertificatesMutex.Lock()
+	ret, specificReturn := fake.tLSCertificatesReturnsOnCall[len(fake.tLSCertificatesArgsForCall)]
+	fake.tLSCertificatesArgsForCall = append(fake.tLSCertificatesArgsForCall, struct{}{})
+	fake.recordInvocation("TLSCertificates", []interface{}{})
+	fake.tLSCertificatesMutex.Unlock()
+	if fake.TLSCertificatesStub != nil {
+		return fake.TLSCertificatesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.tLSCertificatesReturns.result1, fake.tLSCertificatesReturns.result2, fake.tLSCertificatesReturns.result3
+}
+
+func (fake *FakeConfig) TLSCertificatesCallCount() int {
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
+	return len(fake.tLSCertificatesArgsForCall)
+}
+
+func (fake *FakeConfig) TLSCertificatesReturns(result1 *x509.CertPool, result2 []tls.Certificate, result3 error) {
+	fake.TLSCertificatesStub = nil
+	fake.tLSCertificatesReturns = struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeConfig) TLSCertificatesReturnsOnCall(i int, result1 *x509.CertPool, result2 []tls.Certificate, result3 error) {
+	fake.TLSCertificatesStub = nil
+	if fake.tLSCertificatesReturnsOnCall == nil {
+		fake.tLSCertificatesReturnsOnCall = make(map[int]struct {
+			result1 *x509.CertPool
+			result2 []tls.Certificate
+			result3 error
+		})
+	}
+	fake.tLSCertificatesReturnsOnCall[i] = struct {
+		result1 *x509.CertPool
+		result2 []tls.Certificate
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeConfig) TraceHARFiles() []string {
+	fake.traceHARFilesMutex.Lock()
+	ret, specificReturn := fake.traceHARFilesReturnsOnCall[len(fake.traceHARFilesArgsForCall)]
+	fake.traceHARFilesArgsForCall = append(fake.traceHARFilesArgsForCall, struct{}{})
+	fake.recordInvocation("TraceHARFiles", []interface{}{})
+	fake.traceHARFilesMutex.Unlock()
+	if fake.TraceHARFilesStub != nil {
+		return fake.TraceHARFilesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.traceHARFilesReturns.result1
+}
+
+func (fake *FakeConfig) TraceHARFilesCallCount() int {
+	fake.traceHARFilesMutex.RLock()
+	defer fake.traceHARFilesMutex.RUnlock()
+	return len(fake.traceHARFilesArgsForCall)
+}
+
+func (fake *FakeConfig) TraceHARFilesReturns(result1 []string) {
+	fake.TraceHARFilesStub = nil
+	fake.traceHARFilesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceHARFilesReturnsOnCall(i int, result1 []string) {
+	fake.TraceHARFilesStub = nil
+	if fake.traceHARFilesReturnsOnCall == nil {
+		fake.traceHARFilesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.traceHARFilesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -2354,6 +3057,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.accessTokenMutex.RUnlock()
 	fake.addPluginMutex.RLock()
 	defer fake.addPluginMutex.RUnlock()
+	fake.addPluginKeyMutex.RLock()
+	defer fake.addPluginKeyMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
@@ -2362,14 +3067,22 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
+	fake.cACertFileMutex.RLock()
+	defer fake.cACertFileMutex.RUnlock()
 	fake.cFPasswordMutex.RLock()
 	defer fake.cFPasswordMutex.RUnlock()
 	fake.cFUsernameMutex.RLock()
 	defer fake.cFUsernameMutex.RUnlock()
+	fake.clientCertFileMutex.RLock()
+	defer fake.clientCertFileMutex.RUnlock()
+	fake.clientKeyFileMutex.RLock()
+	defer fake.clientKeyFileMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
+	fake.currentUserNameMutex.RLock()
+	defer fake.currentUserNameMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
@@ -2378,6 +3091,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.experimentalMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
+	fake.hTTPCacheMutex.RLock()
+	defer fake.hTTPCacheMutex.RUnlock()
+	fake.hTTPCacheDirectoryMutex.RLock()
+	defer fake.hTTPCacheDirectoryMutex.RUnlock()
 	fake.getPluginCaseInsensitiveMutex.RLock()
 	defer fake.getPluginCaseInsensitiveMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
@@ -2394,16 +3111,24 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
+	fake.pluginKeysMutex.RLock()
+	defer fake.pluginKeysMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
 	defer fake.pluginRepositoriesMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.recordDirectoryMutex.RLock()
+	defer fake.recordDirectoryMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.removePluginKeyMutex.RLock()
+	defer fake.removePluginKeyMutex.RUnlock()
+	fake.replayDirectoryMutex.RLock()
+	defer fake.replayDirectoryMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
@@ -2414,6 +3139,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setRefreshTokenMutex.RUnlock()
 	fake.setSpaceInformationMutex.RLock()
 	defer fake.setSpaceInformationMutex.RUnlock()
+	fake.setTLSCertificateFilesMutex.RLock()
+	defer fake.setTLSCertificateFilesMutex.RUnlock()
 	fake.setTargetInformationMutex.RLock()
 	defer fake.setTargetInformationMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
@@ -2432,12 +3159,18 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
 	defer fake.startupTimeoutMutex.RUnlock()
+	fake.stoppedAppsFilePathMutex.RLock()
+	defer fake.stoppedAppsFilePathMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
 	fake.targetedOrganizationMutex.RLock()
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
+	fake.traceHARFilesMutex.RLock()
+	defer fake.traceHARFilesMutex.RUnlock()
 	fake.uAADisableKeepAlivesMutex.RLock()
 	defer fake.uAADisableKeepAlivesMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
@@ -16,7 +16,8 @@ type V2CommandList struct {
 }
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool   `short:"v" long:"version" description:"verbose and version flag"`
+	TraceHAR         string `long:"trace-har" description:"Record API requests and responses to a file as an HTTP Archive (HAR)"`
 
 	App                  v3.AppCommand                  `command:"app" description:"Display health and status for an app"`
 	V3Apps               v3.V3AppsCommand               `command:"v3-apps" description:"List all apps in the target space"`
@@ -41,10 +42,14 @@ type commandList struct {
 	V3UnsetEnv           v3.V3UnsetEnvCommand           `command:"v3-unset-env" description:"Remove an env variable from an app"`
 	V3SSH                v3.V3SSHCommand                `command:"v3-ssh" description:"SSH to an application container instance"`
 
+	AddPluginKey                       plugin.AddPluginKeyCommand                   `command:"add-plugin-key" description:"Trust a public key to sign plugin binaries"`
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
+	AddGroupMember                     v2.AddGroupMemberCommand                     `command:"add-group-member" description:"Add a user to a UAA group"`
 	AddNetworkPolicy                   v3.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
 	AllowSpaceSSH                      v2.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
+	ApplyNetworkPolicies               v3.ApplyNetworkPoliciesCommand               `command:"apply-network-policies" description:"Apply a file of network policies to the apps in the target space"`
+	ApplyOrg                           v3.ApplyOrgCommand                           `command:"apply-org" description:"Create or update an org to match an org file"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
 	Auth                               v2.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v2.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
@@ -53,7 +58,10 @@ type commandList struct {
 	BindService                        v2.BindServiceCommand                        `command:"bind-service" alias:"bs" description:"Bind a service instance to an app"`
 	BindStagingSecurityGroup           v2.BindStagingSecurityGroupCommand           `command:"bind-staging-security-group" description:"Bind a security group to the list of security groups to be used for staging applications"`
 	Buildpacks                         v2.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
+	Canary                             v3.CanaryCommand                             `command:"canary" description:"Gradually shift traffic from one app to another by scaling instances, aborting on crashes"`
+	CheckEgress                        v2.CheckEgressCommand                        `command:"check-egress" description:"Check whether the security groups of an app's space allow traffic to a destination"`
 	CheckRoute                         v2.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
+	Cleanup                            v3.CleanupCommand                            `command:"cleanup" description:"Delete unused routes, service instances, service keys, stopped apps, droplets and packages in the targeted space"`
 	Config                             v2.ConfigCommand                             `command:"config" description:"Write default values to the config"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
@@ -69,6 +77,7 @@ type commandList struct {
 	CreateServiceKey                   v2.CreateServiceKeyCommand                   `command:"create-service-key" alias:"csk" description:"Create key for a service instance"`
 	CreateService                      v2.CreateServiceCommand                      `command:"create-service" alias:"cs" description:"Create a service instance"`
 	CreateSharedDomain                 v2.CreateSharedDomainCommand                 `command:"create-shared-domain" description:"Create a domain that can be used by all orgs (admin-only)"`
+	CreateSpaceManifest                v2.CreateSpaceManifestCommand                `command:"create-space-manifest" description:"Create a manifest for all the apps in the targeted space"`
 	CreateSpaceQuota                   v2.CreateSpaceQuotaCommand                   `command:"create-space-quota" description:"Define a new space resource quota"`
 	CreateSpace                        v2.CreateSpaceCommand                        `command:"create-space" description:"Create a space"`
 	CreateUserProvidedService          v2.CreateUserProvidedServiceCommand          `command:"create-user-provided-service" alias:"cups" description:"Make a user-provided service instance available to CF apps"`
@@ -103,15 +112,19 @@ type commandList struct {
 	EnableSSH                          v2.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	Env                                v2.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v2.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportOrg                          v3.ExportOrgCommand                          `command:"export-org" description:"Write an org's spaces, quotas, isolation segments, domains, roles and security groups as an org file"`
 	FeatureFlags                       v2.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	FeatureFlag                        v2.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	Files                              v2.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
 	GetHealthCheck                     v2.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
+	Groups                             v2.GroupsCommand                             `command:"groups" description:"List UAA groups"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
+	InstallPlugins                     InstallPluginsCommand                        `command:"install-plugins" description:"Install the plugins listed in a plugin file"`
 	IsolationSegments                  v3.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
 	NetworkPolicies                    v3.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
+	LockUser                           v2.LockUserCommand                           `command:"lock-user" description:"Lock a user so that they can no longer log in"`
 	Login                              v2.LoginCommand                              `command:"login" alias:"l" description:"Log user in"`
 	Logout                             v2.LogoutCommand                             `command:"logout" alias:"lo" description:"Log user out"`
 	Logs                               v2.LogsCommand                               `command:"logs" description:"Tail or show recent logs for an app"`
@@ -129,7 +142,10 @@ type commandList struct {
 	Push                               v2.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
 	Quotas                             v2.QuotasCommand                             `command:"quotas" description:"List available usage quotas"`
 	Quota                              v2.QuotaCommand                              `command:"quota" description:"Show quota info"`
+	QuotaUsage                         v2.QuotaUsageCommand                         `command:"quota-usage" description:"Show org and space usage against their quotas"`
+	RemoveGroupMember                  v2.RemoveGroupMemberCommand                  `command:"remove-group-member" description:"Remove a user from a UAA group"`
 	RemoveNetworkPolicy                v3.RemoveNetworkPolicyCommand                `command:"remove-network-policy" description:"Remove network traffic policy of an app"`
+	RemovePluginKey                    plugin.RemovePluginKeyCommand                `command:"remove-plugin-key" description:"Stop trusting a plugin signing key"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	RenameBuildpack                    v2.RenameBuildpackCommand                    `command:"rename-buildpack" description:"Rename a buildpack"`
 	RenameOrg                          v2.RenameOrgCommand                          `command:"rename-org" description:"Rename an org"`
@@ -139,10 +155,12 @@ type commandList struct {
 	Rename                             v2.RenameCommand                             `command:"rename" description:"Rename an app"`
 	RepoPlugins                        plugin.RepoPluginsCommand                    `command:"repo-plugins" description:"List all available plugins in specified repository or in all added repositories"`
 	ResetOrgDefaultIsolationSegment    v3.ResetOrgDefaultIsolationSegmentCommand    `command:"reset-org-default-isolation-segment" description:"Reset the default isolation segment used for apps in spaces of an org"`
+	ResetUserPassword                  v2.ResetUserPasswordCommand                  `command:"reset-user-password" description:"Set a new password for a user"`
 	ResetSpaceIsolationSegment         v3.ResetSpaceIsolationSegmentCommand         `command:"reset-space-isolation-segment" description:"Reset the space's isolation segment to the org default"`
 	Restage                            v2.RestageCommand                            `command:"restage" alias:"rg" description:"Recreate the app's executable artifact using the latest pushed app files and the latest environment (variables, service bindings, buildpack, stack, etc.)"`
 	RestartAppInstance                 v2.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate, then restart an app instance"`
 	Restart                            v2.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again. This causes downtime."`
+	RestartAll                         v3.RestartAllCommand                         `command:"restart-all" description:"Restart the started apps in a space or org"`
 	RouterGroups                       v2.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Routes                             v2.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
 	RunningEnvironmentVariableGroup    v2.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
@@ -184,7 +202,11 @@ type commandList struct {
 	StagingEnvironmentVariableGroup    v2.StagingEnvironmentVariableGroupCommand    `command:"staging-environment-variable-group" alias:"sevg" description:"Retrieve the contents of the staging environment variable group"`
 	StagingSecurityGroups              v2.StagingSecurityGroupsCommand              `command:"staging-security-groups" description:"List security groups in the staging set for applications"`
 	Start                              v2.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
+	StartAll                           v3.StartAllCommand                           `command:"start-all" description:"Start the stopped apps in a space or org"`
 	Stop                               v2.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
+	StopAll                            v3.StopAllCommand                            `command:"stop-all" description:"Stop the started apps in a space or org"`
+	SwapRoutes                         v2.SwapRoutesCommand                         `command:"swap-routes" description:"Move all routes from one app to another, reverting on failure"`
+	SyncBuildpacks                     v2.SyncBuildpacksCommand                     `command:"sync-buildpacks" description:"Create, update and optionally delete buildpacks to match a buildpacks file"`
 	Target                             v2.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
 	Tasks                              v3.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v3.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
@@ -194,6 +216,7 @@ type commandList struct {
 	UnbindService                      v2.UnbindServiceCommand                      `command:"unbind-service" alias:"us" description:"Unbind a service instance from an app"`
 	UnbindStagingSecurityGroup         v2.UnbindStagingSecurityGroupCommand         `command:"unbind-staging-security-group" description:"Unbind a security group from the set of security groups for staging applications"`
 	UninstallPlugin                    plugin.UninstallPluginCommand                `command:"uninstall-plugin" description:"Uninstall CLI plugin"`
+	UnlockUser                         v2.UnlockUserCommand                         `command:"unlock-user" description:"Unlock a user so that they can log in again"`
 	UnmapRoute                         v2.UnmapRouteCommand                         `command:"unmap-route" description:"Remove a url route from an app"`
 	UnsetEnv                           v2.UnsetEnvCommand                           `command:"unset-env" description:"Remove an env variable"`
 	UnsetOrgRole                       v2.UnsetOrgRoleCommand                       `command:"unset-org-role" description:"Remove an org role from a user"`
@@ -202,6 +225,7 @@ type commandList struct {
 	UnsharePrivateDomain               v2.UnsharePrivateDomainCommand               `command:"unshare-private-domain" description:"Unshare a private domain with an org"`
 	UnshareService                     v3.UnshareServiceCommand                     `command:"unshare-service" description:"Unshare a shared service instance from a space"`
 	UpdateBuildpack                    v2.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
+	UpdatePlugins                      UpdatePluginsCommand                         `command:"update-plugins" description:"Update installed plugins to the latest version available in the plugin repositories"`
 	UpdateQuota                        v2.UpdateQuotaCommand                        `command:"update-quota" description:"Update an existing resource quota"`
 	UpdateSecurityGroup                v2.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateServiceAuthToken             v2.UpdateServiceAuthTokenCommand             `command:"update-service-auth-token" description:"Update a service auth token"`
@@ -209,6 +233,8 @@ type commandList struct {
 	UpdateService                      v2.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpdateSpaceQuota                   v2.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v2.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	Users                              v2.UsersCommand                              `command:"users" description:"List UAA users"`
+	ValidateManifest                   v2.ValidateManifestCommand                   `command:"validate-manifest" description:"Check a manifest for problems and report them with their line and column"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
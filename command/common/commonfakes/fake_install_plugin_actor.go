@@ -66,6 +66,18 @@ type FakeInstallPluginActor struct {
 		result1 configv3.Plugin
 		result2 error
 	}
+	GetDetachedPluginSignatureStub        func(pluginLocation string, tempPluginDir string) string
+	getDetachedPluginSignatureMutex       sync.RWMutex
+	getDetachedPluginSignatureArgsForCall []struct {
+		pluginLocation string
+		tempPluginDir  string
+	}
+	getDetachedPluginSignatureReturns struct {
+		result1 string
+	}
+	getDetachedPluginSignatureReturnsOnCall map[int]struct {
+		result1 string
+	}
 	GetPlatformStringStub        func(runtimeGOOS string, runtimeGOARCH string) string
 	getPlatformStringMutex       sync.RWMutex
 	getPlatformStringArgsForCall []struct {
@@ -144,6 +156,30 @@ type FakeInstallPluginActor struct {
 	validateFileChecksumReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ValidateFileSHA256ChecksumStub        func(path string, checksum string) bool
+	validateFileSHA256ChecksumMutex       sync.RWMutex
+	validateFileSHA256ChecksumArgsForCall []struct {
+		path     string
+		checksum string
+	}
+	validateFileSHA256ChecksumReturns struct {
+		result1 bool
+	}
+	validateFileSHA256ChecksumReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	VerifyPluginSignatureStub        func(path string, signature string) error
+	verifyPluginSignatureMutex       sync.RWMutex
+	verifyPluginSignatureArgsForCall []struct {
+		path      string
+		signature string
+	}
+	verifyPluginSignatureReturns struct {
+		result1 error
+	}
+	verifyPluginSignatureReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -354,6 +390,55 @@ func (fake *FakeInstallPluginActor) GetAndValidatePluginReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignature(pluginLocation string, tempPluginDir string) string {
+	fake.getDetachedPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.getDetachedPluginSignatureReturnsOnCall[len(fake.getDetachedPluginSignatureArgsForCall)]
+	fake.getDetachedPluginSignatureArgsForCall = append(fake.getDetachedPluginSignatureArgsForCall, struct {
+		pluginLocation string
+		tempPluginDir  string
+	}{pluginLocation, tempPluginDir})
+	fake.recordInvocation("GetDetachedPluginSignature", []interface{}{pluginLocation, tempPluginDir})
+	fake.getDetachedPluginSignatureMutex.Unlock()
+	if fake.GetDetachedPluginSignatureStub != nil {
+		return fake.GetDetachedPluginSignatureStub(pluginLocation, tempPluginDir)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getDetachedPluginSignatureReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignatureCallCount() int {
+	fake.getDetachedPluginSignatureMutex.RLock()
+	defer fake.getDetachedPluginSignatureMutex.RUnlock()
+	return len(fake.getDetachedPluginSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignatureArgsForCall(i int) (string, string) {
+	fake.getDetachedPluginSignatureMutex.RLock()
+	defer fake.getDetachedPluginSignatureMutex.RUnlock()
+	return fake.getDetachedPluginSignatureArgsForCall[i].pluginLocation, fake.getDetachedPluginSignatureArgsForCall[i].tempPluginDir
+}
+
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignatureReturns(result1 string) {
+	fake.GetDetachedPluginSignatureStub = nil
+	fake.getDetachedPluginSignatureReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) GetDetachedPluginSignatureReturnsOnCall(i int, result1 string) {
+	fake.GetDetachedPluginSignatureStub = nil
+	if fake.getDetachedPluginSignatureReturnsOnCall == nil {
+		fake.getDetachedPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getDetachedPluginSignatureReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeInstallPluginActor) GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string {
 	fake.getPlatformStringMutex.Lock()
 	ret, specificReturn := fake.getPlatformStringReturnsOnCall[len(fake.getPlatformStringArgsForCall)]
@@ -662,6 +747,104 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) ValidateFileSHA256Checksum(path string, checksum string) bool {
+	fake.validateFileSHA256ChecksumMutex.Lock()
+	ret, specificReturn := fake.validateFileSHA256ChecksumReturnsOnCall[len(fake.validateFileSHA256ChecksumArgsForCall)]
+	fake.validateFileSHA256ChecksumArgsForCall = append(fake.validateFileSHA256ChecksumArgsForCall, struct {
+		path     string
+		checksum string
+	}{path, checksum})
+	fake.recordInvocation("ValidateFileSHA256Checksum", []interface{}{path, checksum})
+	fake.validateFileSHA256ChecksumMutex.Unlock()
+	if fake.ValidateFileSHA256ChecksumStub != nil {
+		return fake.ValidateFileSHA256ChecksumStub(path, checksum)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.validateFileSHA256ChecksumReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSHA256ChecksumCallCount() int {
+	fake.validateFileSHA256ChecksumMutex.RLock()
+	defer fake.validateFileSHA256ChecksumMutex.RUnlock()
+	return len(fake.validateFileSHA256ChecksumArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSHA256ChecksumArgsForCall(i int) (string, string) {
+	fake.validateFileSHA256ChecksumMutex.RLock()
+	defer fake.validateFileSHA256ChecksumMutex.RUnlock()
+	return fake.validateFileSHA256ChecksumArgsForCall[i].path, fake.validateFileSHA256ChecksumArgsForCall[i].checksum
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSHA256ChecksumReturns(result1 bool) {
+	fake.ValidateFileSHA256ChecksumStub = nil
+	fake.validateFileSHA256ChecksumReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSHA256ChecksumReturnsOnCall(i int, result1 bool) {
+	fake.ValidateFileSHA256ChecksumStub = nil
+	if fake.validateFileSHA256ChecksumReturnsOnCall == nil {
+		fake.validateFileSHA256ChecksumReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.validateFileSHA256ChecksumReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignature(path string, signature string) error {
+	fake.verifyPluginSignatureMutex.Lock()
+	ret, specificReturn := fake.verifyPluginSignatureReturnsOnCall[len(fake.verifyPluginSignatureArgsForCall)]
+	fake.verifyPluginSignatureArgsForCall = append(fake.verifyPluginSignatureArgsForCall, struct {
+		path      string
+		signature string
+	}{path, signature})
+	fake.recordInvocation("VerifyPluginSignature", []interface{}{path, signature})
+	fake.verifyPluginSignatureMutex.Unlock()
+	if fake.VerifyPluginSignatureStub != nil {
+		return fake.VerifyPluginSignatureStub(path, signature)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.verifyPluginSignatureReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureCallCount() int {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	return len(fake.verifyPluginSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureArgsForCall(i int) (string, string) {
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
+	return fake.verifyPluginSignatureArgsForCall[i].path, fake.verifyPluginSignatureArgsForCall[i].signature
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureReturns(result1 error) {
+	fake.VerifyPluginSignatureStub = nil
+	fake.verifyPluginSignatureReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) VerifyPluginSignatureReturnsOnCall(i int, result1 error) {
+	fake.VerifyPluginSignatureStub = nil
+	if fake.verifyPluginSignatureReturnsOnCall == nil {
+		fake.verifyPluginSignatureReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyPluginSignatureReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -673,6 +856,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.fileExistsMutex.RUnlock()
 	fake.getAndValidatePluginMutex.RLock()
 	defer fake.getAndValidatePluginMutex.RUnlock()
+	fake.getDetachedPluginSignatureMutex.RLock()
+	defer fake.getDetachedPluginSignatureMutex.RUnlock()
 	fake.getPlatformStringMutex.RLock()
 	defer fake.getPlatformStringMutex.RUnlock()
 	fake.getPluginInfoFromRepositoriesForPlatformMutex.RLock()
@@ -685,6 +870,10 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.uninstallPluginMutex.RUnlock()
 	fake.validateFileChecksumMutex.RLock()
 	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.validateFileSHA256ChecksumMutex.RLock()
+	defer fake.validateFileSHA256ChecksumMutex.RUnlock()
+	fake.verifyPluginSignatureMutex.RLock()
+	defer fake.verifyPluginSignatureMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
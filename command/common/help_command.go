@@ -311,9 +311,11 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
+		{"CF_HTTP_CACHE=true", cmd.UI.TranslateText("Cache API responses on disk and revalidate them after 30 seconds, or the number of seconds given")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_TRACE_FORMAT=har", cmd.UI.TranslateText("Record the CF_TRACE log files as HTTP Archives (HAR)")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable proxying for HTTP requests")},
 	}
@@ -323,6 +325,7 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--trace-har FILE", cmd.UI.TranslateText("Record API requests and responses to a file as an HTTP Archive (HAR)")},
 	}
 }
 
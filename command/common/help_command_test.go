@@ -403,8 +403,9 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("  install-plugin    list-plugin-repos"))
 
 			Expect(testUI.Out).To(Say("Global options:"))
-			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
-			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
+			Expect(testUI.Out).To(Say("  --help, -h                               Show help"))
+			Expect(testUI.Out).To(Say("  -v                                       Print API request diagnostics to stdout"))
+			Expect(testUI.Out).To(Say(`  --trace-har FILE                         Record API requests and responses to a file as an HTTP Archive \(HAR\)`))
 
 			Expect(testUI.Out).To(Say("Use 'cf help -a' to see all commands\\."))
 		})
@@ -590,15 +591,18 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
+				Expect(testUI.Out).To(Say("   CF_HTTP_CACHE=true                 Cache API responses on disk and revalidate them after 30 seconds, or the number of seconds given"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
+				Expect(testUI.Out).To(Say(`   CF_TRACE_FORMAT=har                Record the CF_TRACE log files as HTTP Archives \(HAR\)`))
 				Expect(testUI.Out).To(Say("   all_proxy=proxy.example.com:8080   Specify a proxy server to enable proxying for all requests"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable proxying for HTTP requests"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
-				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
-				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say("   --help, -h                               Show help"))
+				Expect(testUI.Out).To(Say("   -v                                       Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say(`   --trace-har FILE                         Record API requests and responses to a file as an HTTP Archive \(HAR\)`))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("APPS \\(experimental\\):"))
 				Expect(testUI.Out).To(Say("   v3-apps\\s+List all apps in the target space"))
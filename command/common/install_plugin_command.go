@@ -26,12 +26,15 @@ type InstallPluginActor interface {
 	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
 	FileExists(path string) bool
 	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetDetachedPluginSignature(pluginLocation string, tempPluginDir string) string
 	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
 	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
 	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
 	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSHA256Checksum(path string, checksum string) bool
+	VerifyPluginSignature(path string, signature string) error
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -56,8 +59,9 @@ type InstallPluginCommand struct {
 	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
 	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
-	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
+	AllowUnsigned        bool                   `long:"allow-unsigned" description:"Install the plugin even if it is not signed by a trusted plugin key"`
+	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--allow-unsigned]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f] [--allow-unsigned]\n\n   Plugins must be signed by a key trusted with add-plugin-key. The signature\n   of a local or URL plugin is read from the same location with a .sig suffix.\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
+	relatedCommands      interface{}            `related_commands:"add-plugin-key, add-plugin-repo, list-plugin-repos, plugins"`
 	UI                   command.UI
 	Config               command.Config
 	Actor                InstallPluginActor
@@ -67,7 +71,11 @@ type InstallPluginCommand struct {
 func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
-	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+	pluginClient, err := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pluginaction.NewActor(config, pluginClient)
 
 	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
 
@@ -207,7 +215,7 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 
 	case cmd.Actor.FileExists(pluginNameOrLocation):
 		log.WithField("pluginNameOrLocation", pluginNameOrLocation).Info("installing from specified file")
-		return cmd.getPluginFromLocalFile(pluginNameOrLocation)
+		return cmd.getPluginFromLocalFile(pluginNameOrLocation, tempPluginDir)
 
 	case util.IsHTTPScheme(pluginNameOrLocation):
 		log.WithField("pluginNameOrLocation", pluginNameOrLocation).Info("installing from specified URL")
@@ -231,7 +239,7 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 				return "", 0, translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: pluginNameOrLocation, BinaryName: cmd.Config.BinaryName()}
 
 			case actionerror.FetchingPluginInfoFromRepositoryError:
-				return "", 0, cmd.handleFetchingPluginInfoFromRepositoriesError(pluginErr)
+				return "", 0, handleFetchingPluginInfoFromRepositoriesError(pluginErr)
 
 			default:
 				return "", 0, err
@@ -243,7 +251,7 @@ func (cmd InstallPluginCommand) getPluginBinaryAndSource(tempPluginDir string) (
 
 // These are specific errors that we output to the user in the context of
 // installing from any repository.
-func (InstallPluginCommand) handleFetchingPluginInfoFromRepositoriesError(fetchErr actionerror.FetchingPluginInfoFromRepositoryError) error {
+func handleFetchingPluginInfoFromRepositoriesError(fetchErr actionerror.FetchingPluginInfoFromRepositoryError) error {
 	switch clientErr := fetchErr.Err.(type) {
 	case pluginerror.RawHTTPStatusError:
 		return translatableerror.FetchingPluginInfoFromRepositoriesError{
@@ -268,7 +276,34 @@ func (InstallPluginCommand) handleFetchingPluginInfoFromRepositoriesError(fetchE
 	}
 }
 
-func (cmd InstallPluginCommand) getPluginFromLocalFile(pluginLocation string) (string, PluginSource, error) {
+type pluginChecksumValidator interface {
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSHA256Checksum(path string, checksum string) bool
+}
+
+// validatePluginChecksum prefers the SHA-256 digest of the repository entry,
+// falling back to the SHA1 checksum for repositories that do not provide one.
+func validatePluginChecksum(validator pluginChecksumValidator, path string, pluginInfo pluginaction.PluginInfo) bool {
+	if pluginInfo.SHA256 != "" {
+		return validator.ValidateFileSHA256Checksum(path, pluginInfo.SHA256)
+	}
+	return validator.ValidateFileChecksum(path, pluginInfo.Checksum)
+}
+
+// convertPluginSignatureError adds the plugin location to signature
+// verification errors so the user knows which plugin was rejected.
+func convertPluginSignatureError(err error, pluginLocation string, binaryName string) error {
+	switch err.(type) {
+	case actionerror.PluginNotSignedError:
+		return translatableerror.PluginNotSignedError{Path: pluginLocation, BinaryName: binaryName}
+	case actionerror.PluginSignatureInvalidError:
+		return translatableerror.PluginSignatureInvalidError{Path: pluginLocation, BinaryName: binaryName}
+	default:
+		return err
+	}
+}
+
+func (cmd InstallPluginCommand) getPluginFromLocalFile(pluginLocation string, tempPluginDir string) (string, PluginSource, error) {
 	err := cmd.installPluginPrompt(installConfirmationPrompt, map[string]interface{}{
 		"Path": pluginLocation,
 	})
@@ -276,6 +311,14 @@ func (cmd InstallPluginCommand) getPluginFromLocalFile(pluginLocation string) (s
 		return "", 0, err
 	}
 
+	if !cmd.AllowUnsigned {
+		signature := cmd.Actor.GetDetachedPluginSignature(pluginLocation, tempPluginDir)
+		err = convertPluginSignatureError(cmd.Actor.VerifyPluginSignature(pluginLocation, signature), pluginLocation, cmd.Config.BinaryName())
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
 	return pluginLocation, PluginFromLocalFile, err
 }
 
@@ -294,6 +337,14 @@ func (cmd InstallPluginCommand) getPluginFromURL(pluginLocation string, tempPlug
 		return "", 0, err
 	}
 
+	if !cmd.AllowUnsigned {
+		signature := cmd.Actor.GetDetachedPluginSignature(pluginLocation, tempPluginDir)
+		err = convertPluginSignatureError(cmd.Actor.VerifyPluginSignature(tempPath, signature), pluginLocation, cmd.Config.BinaryName())
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
 	return tempPath, PluginFromURL, err
 }
 
@@ -350,10 +401,17 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 		return "", 0, err
 	}
 
-	if !cmd.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
+	if !validatePluginChecksum(cmd.Actor, tempPath, pluginInfo) {
 		return "", 0, translatableerror.InvalidChecksumError{}
 	}
 
+	if !cmd.AllowUnsigned {
+		err = convertPluginSignatureError(cmd.Actor.VerifyPluginSignature(tempPath, pluginInfo.Signature), pluginName, cmd.Config.BinaryName())
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
 	return tempPath, PluginFromRepository, err
 }
 
@@ -91,6 +91,53 @@ var _ = Describe("install-plugin command", func() {
 					cmd.Force = true
 				})
 
+				It("verifies the plugin signature before running the plugin", func() {
+					Expect(fakeActor.GetDetachedPluginSignatureCallCount()).To(Equal(1))
+					location, tempPluginDir := fakeActor.GetDetachedPluginSignatureArgsForCall(0)
+					Expect(location).To(Equal("some-path"))
+					Expect(tempPluginDir).To(ContainSubstring("some-pluginhome"))
+
+					Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(1))
+					path, _ := fakeActor.VerifyPluginSignatureArgsForCall(0)
+					Expect(path).To(Equal("some-path"))
+				})
+
+				When("the plugin is not signed", func() {
+					BeforeEach(func() {
+						fakeActor.VerifyPluginSignatureReturns(actionerror.PluginNotSignedError{})
+					})
+
+					It("returns a PluginNotSignedError without running the plugin", func() {
+						Expect(executeErr).To(MatchError(translatableerror.PluginNotSignedError{Path: "some-path", BinaryName: "faceman"}))
+						Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+					})
+
+					When("--allow-unsigned is given", func() {
+						BeforeEach(func() {
+							cmd.AllowUnsigned = true
+							fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "some-plugin"}, nil)
+						})
+
+						It("installs the plugin without verifying the signature", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(fakeActor.GetDetachedPluginSignatureCallCount()).To(Equal(0))
+							Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(0))
+							Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+						})
+					})
+				})
+
+				When("the plugin signature does not match a trusted key", func() {
+					BeforeEach(func() {
+						fakeActor.VerifyPluginSignatureReturns(actionerror.PluginSignatureInvalidError{})
+					})
+
+					It("returns a PluginSignatureInvalidError without running the plugin", func() {
+						Expect(executeErr).To(MatchError(translatableerror.PluginSignatureInvalidError{Path: "some-path", BinaryName: "faceman"}))
+						Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+					})
+				})
+
 				When("the plugin is invalid", func() {
 					var returnedErr error
 
@@ -491,6 +538,27 @@ var _ = Describe("install-plugin command", func() {
 					fakeActor.CreateExecutableCopyReturns(executablePluginPath, nil)
 				})
 
+				It("verifies the signature published next to the plugin URL", func() {
+					Expect(fakeActor.GetDetachedPluginSignatureCallCount()).To(Equal(1))
+					location, _ := fakeActor.GetDetachedPluginSignatureArgsForCall(0)
+					Expect(location).To(Equal("http://some-url"))
+
+					Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(1))
+					path, _ := fakeActor.VerifyPluginSignatureArgsForCall(0)
+					Expect(path).To(Equal("some-path"))
+				})
+
+				When("the plugin is not signed", func() {
+					BeforeEach(func() {
+						fakeActor.VerifyPluginSignatureReturns(actionerror.PluginNotSignedError{})
+					})
+
+					It("returns a PluginNotSignedError without running the plugin", func() {
+						Expect(executeErr).To(MatchError(translatableerror.PluginNotSignedError{Path: "http://some-url", BinaryName: "faceman"}))
+						Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+					})
+				})
+
 				It("sets up the progress bar", func() {
 					Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
 					_, _, path := fakeActor.GetAndValidatePluginArgsForCall(0)
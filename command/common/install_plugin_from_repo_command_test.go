@@ -263,6 +263,60 @@ var _ = Describe("install-plugin command", func() {
 								fakeActor.DownloadExecutableBinaryFromURLReturns(execPath, nil)
 							})
 
+							When("the repository provides a SHA-256 digest and signature", func() {
+								BeforeEach(func() {
+									fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: downloadedVersionString, URL: pluginURL, Checksum: checksum, SHA256: "some-sha256", Signature: "some-signature"}, []string{repoName}, nil)
+									fakeActor.ValidateFileSHA256ChecksumReturns(true)
+									fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+								})
+
+								It("validates the SHA-256 digest and the signature", func() {
+									Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(0))
+									Expect(fakeActor.ValidateFileSHA256ChecksumCallCount()).To(Equal(1))
+									pathArg, checksumArg := fakeActor.ValidateFileSHA256ChecksumArgsForCall(0)
+									Expect(pathArg).To(Equal(execPath))
+									Expect(checksumArg).To(Equal("some-sha256"))
+
+									Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(1))
+									pathArg, signatureArg := fakeActor.VerifyPluginSignatureArgsForCall(0)
+									Expect(pathArg).To(Equal(execPath))
+									Expect(signatureArg).To(Equal("some-signature"))
+								})
+
+								When("the SHA-256 digest does not match", func() {
+									BeforeEach(func() {
+										fakeActor.ValidateFileSHA256ChecksumReturns(false)
+									})
+
+									It("returns the checksum error", func() {
+										Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+										Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(0))
+									})
+								})
+
+								When("the signature does not match a trusted key", func() {
+									BeforeEach(func() {
+										fakeActor.VerifyPluginSignatureReturns(actionerror.PluginSignatureInvalidError{})
+									})
+
+									It("returns a PluginSignatureInvalidError without running the plugin", func() {
+										Expect(executeErr).To(MatchError(translatableerror.PluginSignatureInvalidError{Path: pluginName, BinaryName: binaryName}))
+										Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+									})
+
+									When("--allow-unsigned is given", func() {
+										BeforeEach(func() {
+											cmd.AllowUnsigned = true
+										})
+
+										It("does not verify the signature", func() {
+											Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(0))
+											Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(1))
+										})
+									})
+								})
+							})
+
 							When("the checksum fails", func() {
 								BeforeEach(func() {
 									fakeActor.ValidateFileChecksumReturns(false)
@@ -0,0 +1,243 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/pluginfile"
+)
+
+//go:generate counterfeiter . InstallPluginsActor
+
+type InstallPluginsActor interface {
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetDetachedPluginSignature(pluginLocation string, tempPluginDir string) string
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginVersionInfoFromRepositoriesForPlatform(pluginName string, version string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
+	GetPluginRepository(repositoryName string) (configv3.PluginRepository, error)
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSHA256Checksum(path string, checksum string) bool
+	VerifyPluginSignature(path string, signature string) error
+}
+
+type InstallPluginsCommand struct {
+	PluginsFile       flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to the plugin file"`
+	SkipSSLValidation bool                        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	AllowUnsigned     bool                        `long:"allow-unsigned" description:"Install plugins even if they are not signed by a trusted plugin key, or have checksums but none for this platform"`
+	usage             interface{}                 `usage:"CF_NAME install-plugins -f PLUGIN_FILE [--allow-unsigned]\n\n   The plugin file lists the plugins to install:\n\n   plugins:\n   - name: some-plugin\n     version: 1.2.3\n     repository: CF-Community\n     url: https://example.com/some-plugin-1.2.3-linux64\n     checksums:\n       linux64: 2a5f9...\n\n   Plugins are installed from the url, from the repository, or from any\n   registered repository, in that order. Plugins already installed at the\n   listed version are skipped. The downloaded binary must report the listed\n   name and version. A plugin file can be created with\n   'CF_NAME plugins --export'.\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugins -f cf-plugins.yml"`
+	relatedCommands   interface{}                 `related_commands:"install-plugin, plugins, update-plugins"`
+	UI                command.UI
+	Config            command.Config
+	Actor             InstallPluginsActor
+	ProgressBar       plugin.ProxyReader
+}
+
+func (cmd *InstallPluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	pluginClient, err := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pluginaction.NewActor(config, pluginClient)
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd InstallPluginsCommand) Execute([]string) error {
+	plugins, err := pluginfile.ReadPlugins(string(cmd.PluginsFile))
+	if err != nil {
+		return err
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	currentPlatform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	for _, desired := range plugins {
+		installedPlugin, installed := cmd.Config.GetPluginCaseInsensitive(desired.Name)
+		if installed && installedPlugin.Version.String() == desired.Version {
+			cmd.UI.DisplayText("Plugin {{.Name}} {{.Version}} is already installed.", map[string]interface{}{
+				"Name":    installedPlugin.Name,
+				"Version": desired.Version,
+			})
+			continue
+		}
+
+		if installed {
+			cmd.UI.DisplayTextWithFlavor("Updating plugin {{.Name}} from {{.CurrentVersion}} to {{.Version}}...", map[string]interface{}{
+				"Name":           installedPlugin.Name,
+				"CurrentVersion": installedPlugin.Version.String(),
+				"Version":        desired.Version,
+			})
+		} else {
+			cmd.UI.DisplayTextWithFlavor("Installing plugin {{.Name}} {{.Version}}...", map[string]interface{}{
+				"Name":    desired.Name,
+				"Version": desired.Version,
+			})
+		}
+
+		err = cmd.installPlugin(desired, currentPlatform, tempPluginDir, rpcService)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+	}
+
+	return nil
+}
+
+func (cmd InstallPluginsCommand) installPlugin(desired pluginfile.Plugin, platform string, tempPluginDir string, rpcService *shared.RPCService) error {
+	var (
+		tempPath       string
+		repositoryName string
+		err            error
+	)
+	if desired.URL != "" {
+		tempPath, err = cmd.getPluginFromURL(desired, platform, tempPluginDir)
+	} else {
+		tempPath, repositoryName, err = cmd.getPluginFromRepositories(desired, platform, tempPluginDir)
+	}
+	if err != nil {
+		return err
+	}
+
+	executablePath, err := cmd.Actor.CreateExecutableCopy(tempPath, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	newPlugin, err := cmd.Actor.GetAndValidatePlugin(rpcService, Commands, executablePath)
+	if err != nil {
+		return err
+	}
+	newPlugin.Repository = repositoryName
+	newPlugin.URL = desired.URL
+
+	if !strings.EqualFold(newPlugin.Name, desired.Name) || newPlugin.Version.String() != desired.Version {
+		return translatableerror.PluginBinaryMismatchError{
+			PluginName:    desired.Name,
+			Version:       desired.Version,
+			BinaryName:    newPlugin.Name,
+			BinaryVersion: newPlugin.Version.String(),
+		}
+	}
+
+	if installedPlugin, installed := cmd.Config.GetPluginCaseInsensitive(desired.Name); installed {
+		err = cmd.Actor.UninstallPlugin(rpcService, installedPlugin.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return cmd.Actor.InstallPluginFromPath(executablePath, newPlugin)
+}
+
+func (cmd InstallPluginsCommand) getPluginFromURL(desired pluginfile.Plugin, platform string, tempPluginDir string) (string, error) {
+	cmd.UI.DisplayText("Starting download of plugin binary from URL...")
+
+	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(desired.URL, tempPluginDir, cmd.ProgressBar)
+	if err != nil {
+		return "", err
+	}
+
+	checksum, ok := desired.Checksums[platform]
+	switch {
+	case ok && !cmd.Actor.ValidateFileSHA256Checksum(tempPath, checksum):
+		return "", translatableerror.InvalidChecksumError{}
+	case !ok && len(desired.Checksums) > 0 && !cmd.AllowUnsigned:
+		return "", translatableerror.PluginChecksumMissingError{PluginName: desired.Name, Platform: platform}
+	}
+
+	if !cmd.AllowUnsigned {
+		signature := cmd.Actor.GetDetachedPluginSignature(desired.URL, tempPluginDir)
+		err = convertPluginSignatureError(cmd.Actor.VerifyPluginSignature(tempPath, signature), desired.URL, cmd.Config.BinaryName())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return tempPath, nil
+}
+
+func (cmd InstallPluginsCommand) getPluginFromRepositories(desired pluginfile.Plugin, platform string, tempPluginDir string) (string, string, error) {
+	repos := cmd.Config.PluginRepositories()
+	if desired.Repository != "" {
+		repo, err := cmd.Actor.GetPluginRepository(desired.Repository)
+		if err != nil {
+			return "", "", err
+		}
+		repos = []configv3.PluginRepository{repo}
+	}
+	if len(repos) == 0 {
+		return "", "", translatableerror.NoPluginRepositoriesError{}
+	}
+
+	pluginInfo, repoList, err := cmd.Actor.GetPluginVersionInfoFromRepositoriesForPlatform(desired.Name, desired.Version, repos, platform)
+	if err != nil {
+		switch pluginErr := err.(type) {
+		case actionerror.PluginVersionNotFoundError:
+			return "", "", translatableerror.PluginVersionNotAvailableError{PluginName: desired.Name, Version: desired.Version}
+		case actionerror.PluginNotFoundInAnyRepositoryError:
+			return "", "", translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: desired.Name, BinaryName: cmd.Config.BinaryName()}
+		case actionerror.FetchingPluginInfoFromRepositoryError:
+			return "", "", handleFetchingPluginInfoFromRepositoriesError(pluginErr)
+		default:
+			return "", "", err
+		}
+	}
+
+	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": repoList[0],
+	})
+
+	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, cmd.ProgressBar)
+	if err != nil {
+		return "", "", err
+	}
+
+	// A checksum pinned in the plugin file takes precedence over the one
+	// provided by the repository.
+	if checksum, ok := desired.Checksums[platform]; ok {
+		if !cmd.Actor.ValidateFileSHA256Checksum(tempPath, checksum) {
+			return "", "", translatableerror.InvalidChecksumError{}
+		}
+	} else if !validatePluginChecksum(cmd.Actor, tempPath, pluginInfo) {
+		return "", "", translatableerror.InvalidChecksumError{}
+	}
+
+	if !cmd.AllowUnsigned {
+		err = convertPluginSignatureError(cmd.Actor.VerifyPluginSignature(tempPath, pluginInfo.Signature), desired.Name, cmd.Config.BinaryName())
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return tempPath, repoList[0], nil
+}
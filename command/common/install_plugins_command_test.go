@@ -0,0 +1,351 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/fileerror"
+	"code.cloudfoundry.org/cli/util/pluginfile"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("install-plugins command", func() {
+	var (
+		cmd             InstallPluginsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeInstallPluginsActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+		pluginsFile     string
+	)
+
+	writePluginsFile := func(contents string) {
+		Expect(ioutil.WriteFile(pluginsFile, []byte(contents), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeInstallPluginsActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "some-pluginhome")
+		Expect(err).NotTo(HaveOccurred())
+		pluginsFile = filepath.Join(pluginHome, "cf-plugins.yml")
+
+		cmd = InstallPluginsCommand{
+			PluginsFile: flag.PathWithExistenceCheck(pluginsFile),
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+			{Name: "repo-1", URL: "https://repo-1.example.com"},
+			{Name: "repo-2", URL: "https://repo-2.example.com"},
+		})
+		fakeConfig.GetPluginCaseInsensitiveStub = func(name string) (configv3.Plugin, bool) {
+			switch name {
+			case "plugin-1":
+				return configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 1}}, true
+			case "plugin-2":
+				return configv3.Plugin{Name: "plugin-2", Version: configv3.PluginVersion{Major: 2}}, true
+			}
+			return configv3.Plugin{}, false
+		}
+
+		fakeActor.GetPlatformStringReturns("linux64")
+		fakeActor.DownloadExecutableBinaryFromURLStub = func(url string, _ string, _ plugin.ProxyReader) (string, error) {
+			return url + "-downloaded", nil
+		}
+		fakeActor.ValidateFileChecksumReturns(true)
+		fakeActor.ValidateFileSHA256ChecksumReturns(true)
+		fakeActor.CreateExecutableCopyStub = func(path string, _ string) (string, error) {
+			return path + "-copy", nil
+		}
+		fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "some-plugin", Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3}}, nil)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the plugin file is empty", func() {
+		BeforeEach(func() {
+			writePluginsFile("plugins: []\n")
+		})
+
+		It("returns an EmptyFileError", func() {
+			Expect(executeErr).To(MatchError(fileerror.EmptyFileError{Kind: pluginfile.Kind, Path: pluginsFile}))
+		})
+	})
+
+	When("a plugin is already installed at the listed version", func() {
+		BeforeEach(func() {
+			writePluginsFile("plugins:\n- name: plugin-1\n  version: 1.0.0\n")
+		})
+
+		It("skips the plugin", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Plugin plugin-1 1\.0\.0 is already installed\.`))
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a plugin has a url", func() {
+		BeforeEach(func() {
+			writePluginsFile(`plugins:
+- name: some-plugin
+  version: 1.2.3
+  url: http://some-url/some-plugin
+  checksums:
+    linux64: some-sha256
+`)
+			fakeActor.GetDetachedPluginSignatureReturns("some-signature")
+		})
+
+		It("downloads, verifies and installs the plugin", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Installing plugin some-plugin 1\.2\.3\.\.\.`))
+			Expect(testUI.Out).To(Say(`Starting download of plugin binary from URL\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformCallCount()).To(Equal(0))
+
+			url, _, _ := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+			Expect(url).To(Equal("http://some-url/some-plugin"))
+
+			Expect(fakeActor.ValidateFileSHA256ChecksumCallCount()).To(Equal(1))
+			path, checksum := fakeActor.ValidateFileSHA256ChecksumArgsForCall(0)
+			Expect(path).To(Equal("http://some-url/some-plugin-downloaded"))
+			Expect(checksum).To(Equal("some-sha256"))
+
+			path, signature := fakeActor.VerifyPluginSignatureArgsForCall(0)
+			Expect(path).To(Equal("http://some-url/some-plugin-downloaded"))
+			Expect(signature).To(Equal("some-signature"))
+
+			Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			path, installed := fakeActor.InstallPluginFromPathArgsForCall(0)
+			Expect(path).To(Equal("http://some-url/some-plugin-downloaded-copy"))
+			Expect(installed.Name).To(Equal("some-plugin"))
+			Expect(installed.URL).To(Equal("http://some-url/some-plugin"))
+			Expect(installed.Repository).To(BeEmpty())
+		})
+
+		When("the checksum does not match", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateFileSHA256ChecksumReturns(false)
+			})
+
+			It("returns an InvalidChecksumError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+
+		When("there is no checksum for the current platform", func() {
+			BeforeEach(func() {
+				fakeActor.GetPlatformStringReturns("win64")
+			})
+
+			It("returns a PluginChecksumMissingError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginChecksumMissingError{PluginName: "some-plugin", Platform: "win64"}))
+				Expect(fakeActor.ValidateFileSHA256ChecksumCallCount()).To(Equal(0))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+
+			When("--allow-unsigned is provided", func() {
+				BeforeEach(func() {
+					cmd.AllowUnsigned = true
+				})
+
+				It("installs the plugin without verifying the checksum", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.ValidateFileSHA256ChecksumCallCount()).To(Equal(0))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		When("the downloaded binary reports a different version", func() {
+			BeforeEach(func() {
+				fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "some-plugin", Version: configv3.PluginVersion{Major: 1, Minor: 2}}, nil)
+			})
+
+			It("returns a PluginBinaryMismatchError without installing the plugin", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginBinaryMismatchError{
+					PluginName:    "some-plugin",
+					Version:       "1.2.3",
+					BinaryName:    "some-plugin",
+					BinaryVersion: "1.2.0",
+				}))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the plugin is not signed", func() {
+			BeforeEach(func() {
+				fakeActor.VerifyPluginSignatureReturns(actionerror.PluginNotSignedError{})
+			})
+
+			It("returns a PluginNotSignedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginNotSignedError{Path: "http://some-url/some-plugin", BinaryName: "faceman"}))
+			})
+
+			When("--allow-unsigned is provided", func() {
+				BeforeEach(func() {
+					cmd.AllowUnsigned = true
+				})
+
+				It("installs the plugin", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(0))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	When("a plugin is installed from the repositories", func() {
+		BeforeEach(func() {
+			writePluginsFile("plugins:\n- name: plugin-2\n  version: 3.0.0\n")
+			fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{
+				Name:      "plugin-2",
+				Version:   "3.0.0",
+				URL:       "http://some-url/plugin-2",
+				Checksum:  "some-sha1",
+				Signature: "some-signature",
+			}, []string{"repo-2"}, nil)
+			fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "plugin-2", Version: configv3.PluginVersion{Major: 3}}, nil)
+		})
+
+		It("updates the installed plugin", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Updating plugin plugin-2 from 2\.0\.0 to 3\.0\.0\.\.\.`))
+			Expect(testUI.Out).To(Say(`Starting download of plugin binary from repository repo-2\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			name, version, repos, platform := fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformArgsForCall(0)
+			Expect(name).To(Equal("plugin-2"))
+			Expect(version).To(Equal("3.0.0"))
+			Expect(repos).To(HaveLen(2))
+			Expect(platform).To(Equal("linux64"))
+
+			path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+			Expect(path).To(Equal("http://some-url/plugin-2-downloaded"))
+			Expect(checksum).To(Equal("some-sha1"))
+
+			Expect(fakeActor.UninstallPluginCallCount()).To(Equal(1))
+			_, uninstalledName := fakeActor.UninstallPluginArgsForCall(0)
+			Expect(uninstalledName).To(Equal("plugin-2"))
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			_, installed := fakeActor.InstallPluginFromPathArgsForCall(0)
+			Expect(installed.Repository).To(Equal("repo-2"))
+			Expect(installed.URL).To(BeEmpty())
+		})
+
+		When("the plugin names a repository", func() {
+			BeforeEach(func() {
+				writePluginsFile("plugins:\n- name: plugin-2\n  version: 3.0.0\n  repository: repo-2\n")
+				fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: "repo-2", URL: "https://repo-2.example.com"}, nil)
+			})
+
+			It("only searches that repository", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetPluginRepositoryArgsForCall(0)).To(Equal("repo-2"))
+				_, _, repos, _ := fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformArgsForCall(0)
+				Expect(repos).To(ConsistOf(configv3.PluginRepository{Name: "repo-2", URL: "https://repo-2.example.com"}))
+			})
+		})
+
+		When("there are no plugin repositories", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginRepositoriesReturns(nil)
+			})
+
+			It("returns a NoPluginRepositoriesError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+			})
+		})
+
+		When("the repositories do not list the version", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{}, nil, actionerror.PluginVersionNotFoundError{PluginName: "plugin-2", Version: "3.0.0"})
+			})
+
+			It("returns a PluginVersionNotAvailableError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginVersionNotAvailableError{
+					PluginName: "plugin-2",
+					Version:    "3.0.0",
+				}))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the plugin is not found in any repository", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginVersionInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{}, nil, actionerror.PluginNotFoundInAnyRepositoryError{PluginName: "plugin-2"})
+			})
+
+			It("returns a PluginNotFoundOnDiskOrInAnyRepositoryError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: "plugin-2", BinaryName: "faceman"}))
+			})
+		})
+
+		When("the downloaded binary reports a different name", func() {
+			BeforeEach(func() {
+				fakeActor.GetAndValidatePluginReturns(configv3.Plugin{Name: "other-plugin", Version: configv3.PluginVersion{Major: 3}}, nil)
+			})
+
+			It("returns a PluginBinaryMismatchError without uninstalling the installed plugin", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginBinaryMismatchError{
+					PluginName:    "plugin-2",
+					Version:       "3.0.0",
+					BinaryName:    "other-plugin",
+					BinaryVersion: "3.0.0",
+				}))
+				Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+			})
+		})
+
+		When("installing the plugin fails", func() {
+			BeforeEach(func() {
+				fakeActor.InstallPluginFromPathReturns(errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
+})
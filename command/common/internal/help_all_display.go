@@ -14,11 +14,13 @@ var HelpCategoryList = []HelpCategory{
 			{"apps", "app"},
 			{"push", "scale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
+			{"start-all", "stop-all", "restart-all"},
 			{"run-task", "tasks", "terminate-task"},
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "create-space-manifest", "validate-manifest"},
+			{"cleanup"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
 	},
@@ -59,24 +61,28 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ROUTES:",
 		CommandList: [][]string{
 			{"routes", "create-route", "check-route", "map-route", "unmap-route", "delete-route", "delete-orphaned-routes"},
+			{"swap-routes", "canary"},
 		},
 	},
 	{
 		CategoryName: "NETWORK POLICIES:",
 		CommandList: [][]string{
-			{"network-policies", "add-network-policy", "remove-network-policy"},
+			{"network-policies", "add-network-policy", "remove-network-policy", "apply-network-policies"},
 		},
 	},
 	{
 		CategoryName: "BUILDPACKS:",
 		CommandList: [][]string{
 			{"buildpacks", "create-buildpack", "update-buildpack", "rename-buildpack", "delete-buildpack"},
+			{"sync-buildpacks"},
 		},
 	},
 	{
 		CategoryName: "USER ADMIN:",
 		CommandList: [][]string{
-			{"create-user", "delete-user"},
+			{"users", "create-user", "delete-user"},
+			{"lock-user", "unlock-user", "reset-user-password"},
+			{"groups", "add-group-member", "remove-group-member"},
 			{"org-users", "set-org-role", "unset-org-role"},
 			{"space-users", "set-space-role", "unset-space-role"},
 		},
@@ -86,7 +92,9 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"quotas", "quota", "set-quota"},
 			{"create-quota", "delete-quota", "update-quota"},
+			{"quota-usage"},
 			{"share-private-domain", "unshare-private-domain"},
+			{"export-org", "apply-org"},
 		},
 	},
 	{
@@ -112,6 +120,7 @@ var HelpCategoryList = []HelpCategory{
 			{"security-group", "security-groups", "create-security-group", "update-security-group", "delete-security-group", "bind-security-group", "unbind-security-group"},
 			{"bind-staging-security-group", "staging-security-groups", "unbind-staging-security-group"},
 			{"bind-running-security-group", "running-security-groups", "unbind-running-security-group"},
+			{"check-egress"},
 		},
 	},
 	{
@@ -147,7 +156,8 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
-			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"plugins", "install-plugin", "install-plugins", "update-plugins", "uninstall-plugin"},
+			{"add-plugin-key", "remove-plugin-key"},
 		},
 	},
 }
@@ -0,0 +1,247 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate counterfeiter . UpdatePluginsActor
+
+type UpdatePluginsActor interface {
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSHA256Checksum(path string, checksum string) bool
+	VerifyPluginSignature(path string, signature string) error
+}
+
+type UpdatePluginsCommand struct {
+	OptionalArgs      flag.UpdatePluginsArgs `positional-args:"yes"`
+	SkipSSLValidation bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	Force             bool                   `short:"f" description:"Force update of plugins without confirmation"`
+	AllowUnsigned     bool                   `long:"allow-unsigned" description:"Update plugins even if they are not signed by a trusted plugin key"`
+	usage             interface{}            `usage:"CF_NAME update-plugins [PLUGIN_NAME...] [-f] [--allow-unsigned]\n\n   Updates the named plugins, or all installed plugins, to the latest version\n   found in the registered plugin repositories.\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME update-plugins\n   CF_NAME update-plugins plugin-echo -f"`
+	relatedCommands   interface{}            `related_commands:"install-plugin, list-plugin-repos, plugins"`
+	UI                command.UI
+	Config            command.Config
+	Actor             UpdatePluginsActor
+	ProgressBar       plugin.ProxyReader
+}
+
+func (cmd *UpdatePluginsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	pluginClient, err := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pluginaction.NewActor(config, pluginClient)
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd UpdatePluginsCommand) Execute([]string) error {
+	repos := cmd.Config.PluginRepositories()
+	if len(repos) == 0 {
+		return translatableerror.NoPluginRepositoriesError{}
+	}
+
+	for _, name := range cmd.OptionalArgs.PluginNames {
+		if _, installed := cmd.Config.GetPluginCaseInsensitive(name); !installed {
+			return translatableerror.PluginNotFoundError{PluginName: name}
+		}
+	}
+
+	repoNames := make([]string, len(repos))
+	for i := range repos {
+		repoNames[i] = repos[i].Name
+	}
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepoNames}} for newer versions of installed plugins...", map[string]interface{}{
+		"RepoNames": strings.Join(repoNames, ", "),
+	})
+
+	outdatedPlugins, err := cmd.Actor.GetOutdatedPlugins()
+	if err != nil {
+		return err
+	}
+	outdatedPlugins = cmd.filterPlugins(outdatedPlugins)
+
+	cmd.UI.DisplayNewline()
+	if len(outdatedPlugins) == 0 {
+		cmd.UI.DisplayText("All plugins are up to date.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("plugin"),
+			cmd.UI.TranslateText("version"),
+			cmd.UI.TranslateText("latest version"),
+		},
+	}
+	for _, outdated := range outdatedPlugins {
+		table = append(table, []string{outdated.Name, outdated.CurrentVersion, outdated.LatestVersion})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
+	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
+
+	if !cmd.Force {
+		really, promptErr := cmd.UI.DisplayBoolPrompt(false, "Do you want to update these plugins?")
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !really {
+			cmd.UI.DisplayText("Plugin update cancelled.")
+			return nil
+		}
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempPluginDir)
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	currentPlatform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	for _, outdated := range outdatedPlugins {
+		err = cmd.updatePlugin(outdated, repos, currentPlatform, tempPluginDir, rpcService)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// filterPlugins limits the outdated plugins to the ones named on the command
+// line, if any.
+func (cmd UpdatePluginsCommand) filterPlugins(outdatedPlugins []pluginaction.OutdatedPlugin) []pluginaction.OutdatedPlugin {
+	if len(cmd.OptionalArgs.PluginNames) == 0 {
+		return outdatedPlugins
+	}
+
+	var filtered []pluginaction.OutdatedPlugin
+	for _, outdated := range outdatedPlugins {
+		for _, name := range cmd.OptionalArgs.PluginNames {
+			if strings.EqualFold(outdated.Name, name) {
+				filtered = append(filtered, outdated)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func (cmd UpdatePluginsCommand) updatePlugin(outdated pluginaction.OutdatedPlugin, repos []configv3.PluginRepository, platform string, tempPluginDir string, rpcService *shared.RPCService) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Updating plugin {{.Name}} from {{.CurrentVersion}}...", map[string]interface{}{
+		"Name":           outdated.Name,
+		"CurrentVersion": outdated.CurrentVersion,
+	})
+
+	pluginInfo, repoList, err := cmd.Actor.GetPluginInfoFromRepositoriesForPlatform(outdated.Name, repos, platform)
+	if err != nil {
+		switch pluginErr := err.(type) {
+		case actionerror.PluginNotFoundInAnyRepositoryError:
+			return translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: outdated.Name, BinaryName: cmd.Config.BinaryName()}
+		case actionerror.FetchingPluginInfoFromRepositoryError:
+			return handleFetchingPluginInfoFromRepositoriesError(pluginErr)
+		default:
+			return err
+		}
+	}
+
+	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": repoList[0],
+	})
+
+	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, cmd.ProgressBar)
+	if err != nil {
+		return err
+	}
+
+	if !validatePluginChecksum(cmd.Actor, tempPath, pluginInfo) {
+		return translatableerror.InvalidChecksumError{}
+	}
+
+	if !cmd.AllowUnsigned {
+		err = convertPluginSignatureError(cmd.Actor.VerifyPluginSignature(tempPath, pluginInfo.Signature), outdated.Name, cmd.Config.BinaryName())
+		if err != nil {
+			return err
+		}
+	}
+
+	executablePath, err := cmd.Actor.CreateExecutableCopy(tempPath, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	newPlugin, err := cmd.Actor.GetAndValidatePlugin(rpcService, Commands, executablePath)
+	if err != nil {
+		return err
+	}
+	newPlugin.Repository = repoList[0]
+
+	var backupPath string
+	installedPlugin, installed := cmd.Config.GetPluginCaseInsensitive(outdated.Name)
+	if installed {
+		// Keep a copy of the old binary so it can be restored if installing the
+		// new one fails after the old one has been uninstalled.
+		backupPath, err = cmd.Actor.CreateExecutableCopy(installedPlugin.Location, tempPluginDir)
+		if err != nil {
+			return err
+		}
+
+		err = cmd.Actor.UninstallPlugin(rpcService, installedPlugin.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.Actor.InstallPluginFromPath(executablePath, newPlugin)
+	if err != nil {
+		if installed {
+			if restoreErr := cmd.Actor.InstallPluginFromPath(backupPath, installedPlugin); restoreErr != nil {
+				log.WithField("plugin", installedPlugin.Name).Errorln("restoring plugin after failed update:", restoreErr)
+			}
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Plugin {{.Name}} successfully updated to {{.Version}}.", map[string]interface{}{
+		"Name":    newPlugin.Name,
+		"Version": newPlugin.Version.String(),
+	})
+	return nil
+}
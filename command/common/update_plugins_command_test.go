@@ -0,0 +1,326 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-plugins command", func() {
+	var (
+		cmd             UpdatePluginsCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeUpdatePluginsActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeUpdatePluginsActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		cmd = UpdatePluginsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "some-pluginhome")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+			{Name: "repo-1", URL: "https://repo-1.example.com"},
+			{Name: "repo-2", URL: "https://repo-2.example.com"},
+		})
+		fakeConfig.GetPluginCaseInsensitiveStub = func(name string) (configv3.Plugin, bool) {
+			switch name {
+			case "plugin-1", "PLUGIN-1":
+				return configv3.Plugin{Name: "plugin-1", Location: "/plugin-home/plugin-1"}, true
+			case "plugin-2":
+				return configv3.Plugin{Name: "plugin-2", Location: "/plugin-home/plugin-2"}, true
+			}
+			return configv3.Plugin{}, false
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("there are no plugin repositories", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginRepositoriesReturns(nil)
+		})
+
+		It("returns a NoPluginRepositoriesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+			Expect(fakeActor.GetOutdatedPluginsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a named plugin is not installed", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNames = []string{"plugin-1", "some-other-plugin"}
+		})
+
+		It("returns a PluginNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginNotFoundError{PluginName: "some-other-plugin"}))
+			Expect(fakeActor.GetOutdatedPluginsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the outdated plugins fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOutdatedPluginsReturns(nil, actionerror.GettingPluginRepositoryError{Name: "repo-1", Message: "some-message"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.GettingPluginRepositoryError{Name: "repo-1", Message: "some-message"}))
+			Expect(testUI.Out).To(Say(`Searching repo-1, repo-2 for newer versions of installed plugins\.\.\.`))
+		})
+	})
+
+	When("all plugins are up to date", func() {
+		BeforeEach(func() {
+			fakeActor.GetOutdatedPluginsReturns(nil, nil)
+		})
+
+		It("displays that there is nothing to update", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("All plugins are up to date."))
+			Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are outdated plugins", func() {
+		BeforeEach(func() {
+			fakeActor.GetOutdatedPluginsReturns([]pluginaction.OutdatedPlugin{
+				{Name: "plugin-1", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
+				{Name: "plugin-2", CurrentVersion: "1.1.0", LatestVersion: "1.2.0"},
+			}, nil)
+			fakeActor.GetPlatformStringReturns("some-platform")
+			fakeActor.GetPluginInfoFromRepositoriesForPlatformStub = func(name string, _ []configv3.PluginRepository, _ string) (pluginaction.PluginInfo, []string, error) {
+				return pluginaction.PluginInfo{Name: name, URL: "http://some-url/" + name, Checksum: name + "-checksum", Signature: name + "-signature"}, []string{"repo-1"}, nil
+			}
+			fakeActor.DownloadExecutableBinaryFromURLStub = func(url string, _ string, _ plugin.ProxyReader) (string, error) {
+				return url + "-downloaded", nil
+			}
+			fakeActor.ValidateFileChecksumReturns(true)
+			fakeActor.CreateExecutableCopyStub = func(path string, _ string) (string, error) {
+				return path + "-copy", nil
+			}
+			fakeActor.GetAndValidatePluginStub = func(_ pluginaction.PluginMetadata, _ pluginaction.CommandList, path string) (configv3.Plugin, error) {
+				if path == "http://some-url/plugin-1-downloaded-copy" {
+					return configv3.Plugin{Name: "plugin-1", Version: configv3.PluginVersion{Major: 2}}, nil
+				}
+				return configv3.Plugin{Name: "plugin-2", Version: configv3.PluginVersion{Major: 1, Minor: 2}}, nil
+			}
+		})
+
+		It("displays the outdated plugins and prompts for confirmation", func() {
+			Expect(testUI.Out).To(Say(`plugin\s+version\s+latest version`))
+			Expect(testUI.Out).To(Say(`plugin-1\s+1\.0\.0\s+2\.0\.0`))
+			Expect(testUI.Out).To(Say(`plugin-2\s+1\.1\.0\s+1\.2\.0`))
+			Expect(testUI.Out).To(Say(`Attention: Plugins are binaries written by potentially untrusted authors\.`))
+			Expect(testUI.Out).To(Say(`Do you want to update these plugins\?`))
+		})
+
+		When("the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not update any plugins", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Plugin update cancelled."))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the -f flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("updates every outdated plugin without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say(`Do you want to update these plugins\?`))
+
+				Expect(testUI.Out).To(Say(`Updating plugin plugin-1 from 1\.0\.0\.\.\.`))
+				Expect(testUI.Out).To(Say(`Starting download of plugin binary from repository repo-1\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`Plugin plugin-1 successfully updated to 2\.0\.0\.`))
+				Expect(testUI.Out).To(Say(`Updating plugin plugin-2 from 1\.1\.0\.\.\.`))
+				Expect(testUI.Out).To(Say(`Plugin plugin-2 successfully updated to 1\.2\.0\.`))
+
+				Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(2))
+				name, repos, platform := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+				Expect(name).To(Equal("plugin-1"))
+				Expect(repos).To(HaveLen(2))
+				Expect(platform).To(Equal("some-platform"))
+
+				Expect(fakeActor.ValidateFileChecksumCallCount()).To(Equal(2))
+				path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+				Expect(path).To(Equal("http://some-url/plugin-1-downloaded"))
+				Expect(checksum).To(Equal("plugin-1-checksum"))
+
+				Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(2))
+				path, signature := fakeActor.VerifyPluginSignatureArgsForCall(0)
+				Expect(path).To(Equal("http://some-url/plugin-1-downloaded"))
+				Expect(signature).To(Equal("plugin-1-signature"))
+
+				Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(4))
+				backupSource, _ := fakeActor.CreateExecutableCopyArgsForCall(1)
+				Expect(backupSource).To(Equal("/plugin-home/plugin-1"))
+
+				Expect(fakeActor.UninstallPluginCallCount()).To(Equal(2))
+				_, uninstalledName := fakeActor.UninstallPluginArgsForCall(0)
+				Expect(uninstalledName).To(Equal("plugin-1"))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+				installPath, installedPlugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+				Expect(installPath).To(Equal("http://some-url/plugin-1-downloaded-copy"))
+				Expect(installedPlugin.Name).To(Equal("plugin-1"))
+				Expect(installedPlugin.Repository).To(Equal("repo-1"))
+			})
+
+			When("plugin names are provided", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.PluginNames = []string{"PLUGIN-1"}
+				})
+
+				It("only updates the named plugins", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say(`plugin-2\s+1\.1\.0`))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				})
+			})
+
+			When("the downloaded checksum does not match", func() {
+				BeforeEach(func() {
+					fakeActor.ValidateFileChecksumReturns(false)
+				})
+
+				It("returns an InvalidChecksumError and leaves the plugin installed", func() {
+					Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the plugin is no longer available for this platform", func() {
+				BeforeEach(func() {
+					fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{}, nil, actionerror.PluginNotFoundInAnyRepositoryError{PluginName: "plugin-1"})
+					fakeActor.GetPluginInfoFromRepositoriesForPlatformStub = nil
+				})
+
+				It("returns a PluginNotFoundOnDiskOrInAnyRepositoryError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.PluginNotFoundOnDiskOrInAnyRepositoryError{PluginName: "plugin-1", BinaryName: "faceman"}))
+				})
+			})
+
+			When("the new binary is not signed by a trusted key", func() {
+				BeforeEach(func() {
+					fakeActor.VerifyPluginSignatureReturns(actionerror.PluginSignatureInvalidError{})
+				})
+
+				It("returns a PluginSignatureInvalidError and leaves the plugin installed", func() {
+					Expect(executeErr).To(MatchError(translatableerror.PluginSignatureInvalidError{Path: "plugin-1", BinaryName: "faceman"}))
+					Expect(fakeActor.GetAndValidatePluginCallCount()).To(Equal(0))
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+				})
+
+				When("--allow-unsigned is given", func() {
+					BeforeEach(func() {
+						cmd.AllowUnsigned = true
+					})
+
+					It("updates the plugins", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.VerifyPluginSignatureCallCount()).To(Equal(0))
+						Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+					})
+				})
+			})
+
+			When("installing the new plugin fails", func() {
+				BeforeEach(func() {
+					fakeActor.InstallPluginFromPathStub = func(path string, _ configv3.Plugin) error {
+						if path == "http://some-url/plugin-1-downloaded-copy" {
+							return errors.New("install-error")
+						}
+						return nil
+					}
+				})
+
+				It("restores the old plugin and returns the error", func() {
+					Expect(executeErr).To(MatchError("install-error"))
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(1))
+
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+					restorePath, restoredPlugin := fakeActor.InstallPluginFromPathArgsForCall(1)
+					Expect(restorePath).To(Equal("/plugin-home/plugin-1-copy"))
+					Expect(restoredPlugin).To(Equal(configv3.Plugin{Name: "plugin-1", Location: "/plugin-home/plugin-1"}))
+				})
+			})
+
+			When("backing up the old plugin fails", func() {
+				BeforeEach(func() {
+					fakeActor.CreateExecutableCopyStub = func(path string, _ string) (string, error) {
+						if path == "/plugin-home/plugin-1" {
+							return "", errors.New("backup-error")
+						}
+						return path + "-copy", nil
+					}
+				})
+
+				It("returns the error and leaves the plugin installed", func() {
+					Expect(executeErr).To(MatchError("backup-error"))
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+				})
+			})
+
+			When("validating the new plugin fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetAndValidatePluginStub = nil
+					fakeActor.GetAndValidatePluginReturns(configv3.Plugin{}, errors.New("validate-error"))
+				})
+
+				It("returns the error and leaves the plugin installed", func() {
+					Expect(executeErr).To(MatchError("validate-error"))
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package command
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"time"
 
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -12,18 +14,25 @@ import (
 type Config interface {
 	AccessToken() string
 	AddPlugin(configv3.Plugin)
+	AddPluginKey(name string, publicKey string)
 	AddPluginRepository(name string, url string)
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
+	CACertFile() string
 	CFPassword() string
 	CFUsername() string
+	ClientCertFile() string
+	ClientKeyFile() string
 	ColorEnabled() configv3.ColorSetting
 	CurrentUser() (configv3.User, error)
+	CurrentUserName() (string, error)
 	DialTimeout() time.Duration
 	DockerPassword() string
 	Experimental() bool
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
+	HTTPCache() (bool, time.Duration)
+	HTTPCacheDirectory() string
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
@@ -32,16 +41,21 @@ type Config interface {
 	NOAARequestRetryCount() int
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
+	PluginKeys() []configv3.PluginKey
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	RecordDirectory() string
 	RefreshToken() string
 	RemovePlugin(string)
+	RemovePluginKey(name string)
+	ReplayDirectory() string
 	RequestRetryCount() int
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
+	SetTLSCertificateFiles(caCertFile string, clientCertFile string, clientKeyFile string)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SetUAAClientCredentials(client string, clientSecret string)
@@ -51,9 +65,12 @@ type Config interface {
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
+	StoppedAppsFilePath() string
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	TLSCertificates() (*x509.CertPool, []tls.Certificate, error)
+	TraceHARFiles() []string
 	UAADisableKeepAlives() bool
 	UAAGrantType() string
 	UAAOAuthClient() string
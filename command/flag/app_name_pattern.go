@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"path"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// AppNamePattern is a shell pattern, as used by path.Match, that app names
+// are matched against.
+type AppNamePattern struct {
+	Pattern string
+}
+
+func (p *AppNamePattern) UnmarshalFlag(rawValue string) error {
+	if _, err := path.Match(rawValue, ""); err != nil {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Value must be a valid name pattern, such as "web-*".`,
+		}
+	}
+
+	p.Pattern = rawValue
+	return nil
+}
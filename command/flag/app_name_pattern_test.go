@@ -0,0 +1,43 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("AppNamePattern", func() {
+	var pattern AppNamePattern
+
+	BeforeEach(func() {
+		pattern = AppNamePattern{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("valid patterns",
+			func(input string) {
+				err := pattern.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pattern.Pattern).To(Equal(input))
+			},
+			Entry("plain name", "web"),
+			Entry("wildcard", "web-*"),
+			Entry("character class", "worker-[0-9]"),
+		)
+
+		DescribeTable("invalid patterns",
+			func(input string) {
+				err := pattern.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Value must be a valid name pattern, such as "web-*".`,
+				}))
+			},
+			Entry("unclosed character class", "web-[0-9"),
+			Entry("trailing escape", `web\`),
+		)
+	})
+})
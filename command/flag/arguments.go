@@ -40,6 +40,10 @@ type Organization struct {
 	Organization string `positional-arg-name:"ORG" required:"true" description:"The organization"`
 }
 
+type OptionalOrganization struct {
+	Organization string `positional-arg-name:"ORG" description:"The organization"`
+}
+
 type APIPath struct {
 	Path string `positional-arg-name:"PATH" required:"true" description:"The API endpoint"`
 }
@@ -80,6 +84,11 @@ type Username struct {
 	Username string `positional-arg-name:"USERNAME" required:"true" description:"The username"`
 }
 
+type GroupMember struct {
+	Group    string `positional-arg-name:"GROUP" required:"true" description:"The name of the group"`
+	Username string `positional-arg-name:"USERNAME" required:"true" description:"The username"`
+}
+
 type APITarget struct {
 	URL string `positional-arg-name:"URL" description:"API URL to target"`
 }
@@ -166,6 +175,16 @@ type CopySourceArgs struct {
 	TargetAppName string `positional-arg-name:"TARGET-NAME" required:"true" description:"The new application name"`
 }
 
+type SwapRoutesArgs struct {
+	OldAppName string `positional-arg-name:"OLD_APP" required:"true" description:"The application currently serving the routes"`
+	NewAppName string `positional-arg-name:"NEW_APP" required:"true" description:"The application that will receive the routes"`
+}
+
+type CanaryArgs struct {
+	OldAppName string `positional-arg-name:"APP_OLD" required:"true" description:"The application currently receiving traffic"`
+	NewAppName string `positional-arg-name:"APP_NEW" required:"true" description:"The application receiving the canary traffic"`
+}
+
 type CreateServiceArgs struct {
 	ServiceOffering string `positional-arg-name:"SERVICE" required:"true" description:"The service offering"`
 	ServicePlan     string `positional-arg-name:"SERVICE_PLAN" required:"true" description:"The service plan that the service instance will use"`
@@ -282,10 +301,23 @@ type AddPluginRepoArgs struct {
 	PluginRepoURL  string `positional-arg-name:"URL" required:"true" description:"The URL to the plugin repo"`
 }
 
+type AddPluginKeyArgs struct {
+	PluginKeyName string `positional-arg-name:"KEY_NAME" required:"true" description:"The plugin key name"`
+	PublicKey     string `positional-arg-name:"PUBLIC_KEY" required:"true" description:"The base64-encoded Ed25519 public key"`
+}
+
+type PluginKeyName struct {
+	PluginKeyName string `positional-arg-name:"KEY_NAME" required:"true" description:"The plugin key name"`
+}
+
 type InstallPluginArgs struct {
 	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, if the plugin exists locally; the URL to the plugin, if the plugin exists online; or the plugin name, if a repo is specified"`
 }
 
+type UpdatePluginsArgs struct {
+	PluginNames []string `positional-arg-name:"PLUGIN_NAME" description:"The names of the plugins to update"`
+}
+
 type RunTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
@@ -318,6 +350,11 @@ type ResetOrgDefaultIsolationArgs struct {
 	OrgName string `positional-arg-name:"ORG_NAME" required:"true" description:"The organization name"`
 }
 
+type CheckEgressArgs struct {
+	AppName     string            `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Destination EgressDestination `positional-arg-name:"DEST[:PORT]" required:"true" description:"The hostname or IP address, and optional port, to check"`
+}
+
 type AddNetworkPolicyArgs struct {
 	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
 }
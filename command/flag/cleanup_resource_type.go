@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type CleanupResourceType struct {
+	Type string
+}
+
+func (CleanupResourceType) Complete(prefix string) []flags.Completion {
+	return completions([]string{"app", "droplet", "package", "route", "service-instance", "service-key"}, prefix, false)
+}
+
+func (c *CleanupResourceType) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "app", "droplet", "package", "route", "service-instance", "service-key":
+		c.Type = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `TYPE must be "app", "droplet", "package", "route", "service-instance", or "service-key"`,
+		}
+	}
+	return nil
+}
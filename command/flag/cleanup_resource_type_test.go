@@ -0,0 +1,59 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CleanupResourceType", func() {
+	var resourceType CleanupResourceType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := resourceType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'service-instance' and 'service-key' when passed 's'", "s",
+				[]flags.Completion{{Item: "service-instance"}, {Item: "service-key"}}),
+			Entry("returns 'droplet' when passed 'D'", "D",
+				[]flags.Completion{{Item: "droplet"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			resourceType = CleanupResourceType{}
+		})
+
+		DescribeTable("downcases and sets type",
+			func(settingType string, expectedType string) {
+				err := resourceType.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resourceType.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'app' when passed 'app'", "app", "app"),
+			Entry("sets 'droplet' when passed 'DropLet'", "DropLet", "droplet"),
+			Entry("sets 'package' when passed 'package'", "package", "package"),
+			Entry("sets 'route' when passed 'route'", "route", "route"),
+			Entry("sets 'service-instance' when passed 'service-instance'", "service-instance", "service-instance"),
+			Entry("sets 'service-key' when passed 'service-key'", "service-key", "service-key"),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := resourceType.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TYPE must be "app", "droplet", "package", "route", "service-instance", or "service-key"`,
+				}))
+				Expect(resourceType.Type).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,56 @@
+package flag
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// EgressDestination is a hostname or IP address with an optional port, such
+// as example.com:443, 10.0.0.1 or [2001:db8::1]:443.
+type EgressDestination struct {
+	Host string
+	Port int
+}
+
+func (d *EgressDestination) UnmarshalFlag(val string) error {
+	host := val
+	port := ""
+	if strings.HasPrefix(val, "[") || strings.Count(val, ":") == 1 {
+		var err error
+		host, port, err = net.SplitHostPort(val)
+		if err != nil {
+			if !strings.HasPrefix(val, "[") || !strings.HasSuffix(val, "]") {
+				return &flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `DEST must be a hostname or IP address with an optional port`,
+				}
+			}
+			host, port = strings.Trim(val, "[]"), ""
+		}
+	}
+
+	if host == "" {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `DEST must be a hostname or IP address with an optional port`,
+		}
+	}
+
+	d.Host = host
+	d.Port = 0
+	if port != "" {
+		portNumber, err := strconv.Atoi(port)
+		if err != nil || portNumber < 1 || portNumber > 65535 {
+			return &flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `PORT must be an integer between 1 and 65535`,
+			}
+		}
+		d.Port = portNumber
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EgressDestination", func() {
+	var destination EgressDestination
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			destination = EgressDestination{}
+		})
+
+		DescribeTable("sets the host and port",
+			func(input string, expectedHost string, expectedPort int) {
+				err := destination.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(destination).To(Equal(EgressDestination{Host: expectedHost, Port: expectedPort}))
+			},
+			Entry("hostname", "example.com", "example.com", 0),
+			Entry("hostname and port", "example.com:443", "example.com", 443),
+			Entry("IPv4 address and port", "10.0.0.1:8080", "10.0.0.1", 8080),
+			Entry("IPv6 address", "2001:db8::1", "2001:db8::1", 0),
+			Entry("bracketed IPv6 address", "[2001:db8::1]", "2001:db8::1", 0),
+			Entry("bracketed IPv6 address and port", "[2001:db8::1]:443", "2001:db8::1", 443),
+		)
+
+		DescribeTable("returns an error",
+			func(input string, message string) {
+				err := destination.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: message,
+				}))
+			},
+			Entry("missing host", ":443", `DEST must be a hostname or IP address with an optional port`),
+			Entry("port is not a number", "example.com:https", `PORT must be an integer between 1 and 65535`),
+			Entry("port is out of range", "example.com:65536", `PORT must be an integer between 1 and 65535`),
+		)
+	})
+})
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type EgressProtocol struct {
+	Protocol string
+}
+
+func (EgressProtocol) Complete(prefix string) []flags.Completion {
+	return completions([]string{"tcp", "udp", "icmp"}, prefix, false)
+}
+
+func (p *EgressProtocol) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "tcp", "udp", "icmp":
+		p.Protocol = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `PROTOCOL must be "tcp", "udp" or "icmp"`,
+		}
+	}
+	return nil
+}
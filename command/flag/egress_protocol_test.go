@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EgressProtocol", func() {
+	var proto EgressProtocol
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := proto.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'icmp' when passed 'i'", "i",
+				[]flags.Completion{{Item: "icmp"}}),
+			Entry("returns 'tcp' when passed 'T'", "T",
+				[]flags.Completion{{Item: "tcp"}}),
+			Entry("returns all protocols when passed ''", "",
+				[]flags.Completion{{Item: "tcp"}, {Item: "udp"}, {Item: "icmp"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			proto = EgressProtocol{}
+		})
+
+		DescribeTable("downcases and sets type",
+			func(input string, expectedProtocol string) {
+				err := proto.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(proto.Protocol).To(Equal(expectedProtocol))
+			},
+			Entry("sets 'tcp' when passed 'tCp'", "tCp", "tcp"),
+			Entry("sets 'udp' when passed 'udp'", "udp", "udp"),
+			Entry("sets 'icmp' when passed 'ICMP'", "ICMP", "icmp"),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := proto.UnmarshalFlag("all")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `PROTOCOL must be "tcp", "udp" or "icmp"`,
+				}))
+				Expect(proto.Protocol).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type NetworkPolicyGraphFormat struct {
+	Format string
+}
+
+func (NetworkPolicyGraphFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"dot", "mermaid"}, prefix, false)
+}
+
+func (f *NetworkPolicyGraphFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "dot", "mermaid":
+		f.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `FORMAT must be "dot" or "mermaid"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NetworkPolicyGraphFormat", func() {
+	var format NetworkPolicyGraphFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := format.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'dot' when passed 'd'", "d",
+				[]flags.Completion{{Item: "dot"}}),
+			Entry("returns 'mermaid' when passed 'M'", "M",
+				[]flags.Completion{{Item: "mermaid"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			format = NetworkPolicyGraphFormat{}
+		})
+
+		DescribeTable("downcases and sets format",
+			func(settingFormat string, expectedFormat string) {
+				err := format.UnmarshalFlag(settingFormat)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(format.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'dot' when passed 'dot'", "dot", "dot"),
+			Entry("sets 'mermaid' when passed 'Mermaid'", "Mermaid", "mermaid"),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := format.UnmarshalFlag("png")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `FORMAT must be "dot" or "mermaid"`,
+				}))
+				Expect(format.Format).To(BeEmpty())
+			})
+		})
+	})
+})
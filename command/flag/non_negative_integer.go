@@ -0,0 +1,28 @@
+package flag
+
+import (
+	"strconv"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type NonNegativeInteger struct {
+	Value int
+}
+
+func (nonNegInt *NonNegativeInteger) UnmarshalFlag(rawValue string) error {
+	value, err := strconv.Atoi(rawValue)
+	if err != nil {
+		return err
+	}
+
+	if value < 0 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Value must be greater than or equal to 0.`,
+		}
+	}
+
+	nonNegInt.Value = value
+	return nil
+}
@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Non-Negative Integer", func() {
+	var (
+		nonNegInt NonNegativeInteger
+	)
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			nonNegInt = NonNegativeInteger{}
+		})
+
+		When("passed zero", func() {
+			It("sets the value", func() {
+				err := nonNegInt.UnmarshalFlag("0")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nonNegInt.Value).To(Equal(0))
+			})
+		})
+
+		When("passed a positive integer", func() {
+			It("sets the value", func() {
+				err := nonNegInt.UnmarshalFlag("42")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nonNegInt.Value).To(Equal(42))
+			})
+		})
+
+		When("passed a negative integer", func() {
+			It("it returns an error", func() {
+				err := nonNegInt.UnmarshalFlag("-1")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Value must be greater than or equal to 0.`,
+				}))
+			})
+		})
+	})
+})
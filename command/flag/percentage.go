@@ -0,0 +1,24 @@
+package flag
+
+import (
+	"strconv"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Percentage struct {
+	Value int
+}
+
+func (p *Percentage) UnmarshalFlag(rawValue string) error {
+	value, err := strconv.Atoi(rawValue)
+	if err != nil || value < 1 || value > 100 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Value must be an integer between 1 and 100.`,
+		}
+	}
+
+	p.Value = value
+	return nil
+}
@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Percentage", func() {
+	var percentage Percentage
+
+	BeforeEach(func() {
+		percentage = Percentage{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("valid percentages",
+			func(input string, expected int) {
+				err := percentage.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(percentage.Value).To(Equal(expected))
+			},
+			Entry("lower bound", "1", 1),
+			Entry("middle", "25", 25),
+			Entry("upper bound", "100", 100),
+		)
+
+		DescribeTable("invalid percentages",
+			func(input string) {
+				err := percentage.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Value must be an integer between 1 and 100.`,
+				}))
+			},
+			Entry("zero", "0"),
+			Entry("over 100", "101"),
+			Entry("not a number", "half"),
+		)
+	})
+})
@@ -0,0 +1,46 @@
+package plugin
+
+import (
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+)
+
+//go:generate counterfeiter . AddPluginKeyActor
+
+type AddPluginKeyActor interface {
+	AddPluginKey(keyName string, publicKey string) error
+}
+
+type AddPluginKeyCommand struct {
+	RequiredArgs    flag.AddPluginKeyArgs `positional-args:"yes"`
+	usage           interface{}           `usage:"CF_NAME add-plugin-key KEY_NAME PUBLIC_KEY\n\n   Trusts a base64-encoded Ed25519 public key to sign plugin binaries.\n   Plugins must be signed by a trusted key to be installed without\n   --allow-unsigned.\n\nEXAMPLES:\n   CF_NAME add-plugin-key ExampleCorp 11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="`
+	relatedCommands interface{}           `related_commands:"install-plugin, remove-plugin-key"`
+	UI              command.UI
+	Config          command.Config
+	Actor           AddPluginKeyActor
+}
+
+func (cmd *AddPluginKeyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	pluginClient, err := shared.NewClient(config, ui, false)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pluginaction.NewActor(config, pluginClient)
+	return nil
+}
+
+func (cmd AddPluginKeyCommand) Execute(args []string) error {
+	err := cmd.Actor.AddPluginKey(cmd.RequiredArgs.PluginKeyName, cmd.RequiredArgs.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Plugin key {{.KeyName}} added.", map[string]interface{}{
+		"KeyName": cmd.RequiredArgs.PluginKeyName,
+	})
+	return nil
+}
@@ -0,0 +1,58 @@
+package plugin_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("add-plugin-key command", func() {
+	var (
+		cmd        AddPluginKeyCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeAddPluginKeyActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeAddPluginKeyActor)
+		cmd = AddPluginKeyCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+		cmd.RequiredArgs.PluginKeyName = "some-key"
+		cmd.RequiredArgs.PublicKey = "some-public-key"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("adding the key fails", func() {
+		BeforeEach(func() {
+			fakeActor.AddPluginKeyReturns(actionerror.PluginKeyNameTakenError{Name: "some-key"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.PluginKeyNameTakenError{Name: "some-key"}))
+			Expect(testUI.Out).ToNot(Say("added"))
+		})
+	})
+
+	When("adding the key succeeds", func() {
+		It("adds the key and displays a success message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Plugin key some-key added."))
+
+			Expect(fakeActor.AddPluginKeyCallCount()).To(Equal(1))
+			keyName, publicKey := fakeActor.AddPluginKeyArgsForCall(0)
+			Expect(keyName).To(Equal("some-key"))
+			Expect(publicKey).To(Equal("some-public-key"))
+		})
+	})
+})
@@ -27,7 +27,11 @@ type AddPluginRepoCommand struct {
 func (cmd *AddPluginRepoCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
-	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+	pluginClient, err := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pluginaction.NewActor(config, pluginClient)
 	return nil
 }
 
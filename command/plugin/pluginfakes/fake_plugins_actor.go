@@ -6,9 +6,23 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/pluginaction"
 	"code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/util/pluginfile"
 )
 
 type FakePluginsActor struct {
+	GetInstalledPluginsForPluginFileStub        func(platform string) ([]pluginfile.Plugin, error)
+	getInstalledPluginsForPluginFileMutex       sync.RWMutex
+	getInstalledPluginsForPluginFileArgsForCall []struct {
+		platform string
+	}
+	getInstalledPluginsForPluginFileReturns struct {
+		result1 []pluginfile.Plugin
+		result2 error
+	}
+	getInstalledPluginsForPluginFileReturnsOnCall map[int]struct {
+		result1 []pluginfile.Plugin
+		result2 error
+	}
 	GetOutdatedPluginsStub        func() ([]pluginaction.OutdatedPlugin, error)
 	getOutdatedPluginsMutex       sync.RWMutex
 	getOutdatedPluginsArgsForCall []struct{}
@@ -20,10 +34,73 @@ type FakePluginsActor struct {
 		result1 []pluginaction.OutdatedPlugin
 		result2 error
 	}
+	GetPlatformStringStub        func(runtimeGOOS string, runtimeGOARCH string) string
+	getPlatformStringMutex       sync.RWMutex
+	getPlatformStringArgsForCall []struct {
+		runtimeGOOS   string
+		runtimeGOARCH string
+	}
+	getPlatformStringReturns struct {
+		result1 string
+	}
+	getPlatformStringReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePluginsActor) GetInstalledPluginsForPluginFile(platform string) ([]pluginfile.Plugin, error) {
+	fake.getInstalledPluginsForPluginFileMutex.Lock()
+	ret, specificReturn := fake.getInstalledPluginsForPluginFileReturnsOnCall[len(fake.getInstalledPluginsForPluginFileArgsForCall)]
+	fake.getInstalledPluginsForPluginFileArgsForCall = append(fake.getInstalledPluginsForPluginFileArgsForCall, struct {
+		platform string
+	}{platform})
+	fake.recordInvocation("GetInstalledPluginsForPluginFile", []interface{}{platform})
+	fake.getInstalledPluginsForPluginFileMutex.Unlock()
+	if fake.GetInstalledPluginsForPluginFileStub != nil {
+		return fake.GetInstalledPluginsForPluginFileStub(platform)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getInstalledPluginsForPluginFileReturns.result1, fake.getInstalledPluginsForPluginFileReturns.result2
+}
+
+func (fake *FakePluginsActor) GetInstalledPluginsForPluginFileCallCount() int {
+	fake.getInstalledPluginsForPluginFileMutex.RLock()
+	defer fake.getInstalledPluginsForPluginFileMutex.RUnlock()
+	return len(fake.getInstalledPluginsForPluginFileArgsForCall)
+}
+
+func (fake *FakePluginsActor) GetInstalledPluginsForPluginFileArgsForCall(i int) string {
+	fake.getInstalledPluginsForPluginFileMutex.RLock()
+	defer fake.getInstalledPluginsForPluginFileMutex.RUnlock()
+	return fake.getInstalledPluginsForPluginFileArgsForCall[i].platform
+}
+
+func (fake *FakePluginsActor) GetInstalledPluginsForPluginFileReturns(result1 []pluginfile.Plugin, result2 error) {
+	fake.GetInstalledPluginsForPluginFileStub = nil
+	fake.getInstalledPluginsForPluginFileReturns = struct {
+		result1 []pluginfile.Plugin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePluginsActor) GetInstalledPluginsForPluginFileReturnsOnCall(i int, result1 []pluginfile.Plugin, result2 error) {
+	fake.GetInstalledPluginsForPluginFileStub = nil
+	if fake.getInstalledPluginsForPluginFileReturnsOnCall == nil {
+		fake.getInstalledPluginsForPluginFileReturnsOnCall = make(map[int]struct {
+			result1 []pluginfile.Plugin
+			result2 error
+		})
+	}
+	fake.getInstalledPluginsForPluginFileReturnsOnCall[i] = struct {
+		result1 []pluginfile.Plugin
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePluginsActor) GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error) {
 	fake.getOutdatedPluginsMutex.Lock()
 	ret, specificReturn := fake.getOutdatedPluginsReturnsOnCall[len(fake.getOutdatedPluginsArgsForCall)]
@@ -67,11 +144,64 @@ func (fake *FakePluginsActor) GetOutdatedPluginsReturnsOnCall(i int, result1 []p
 	}{result1, result2}
 }
 
+func (fake *FakePluginsActor) GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string {
+	fake.getPlatformStringMutex.Lock()
+	ret, specificReturn := fake.getPlatformStringReturnsOnCall[len(fake.getPlatformStringArgsForCall)]
+	fake.getPlatformStringArgsForCall = append(fake.getPlatformStringArgsForCall, struct {
+		runtimeGOOS   string
+		runtimeGOARCH string
+	}{runtimeGOOS, runtimeGOARCH})
+	fake.recordInvocation("GetPlatformString", []interface{}{runtimeGOOS, runtimeGOARCH})
+	fake.getPlatformStringMutex.Unlock()
+	if fake.GetPlatformStringStub != nil {
+		return fake.GetPlatformStringStub(runtimeGOOS, runtimeGOARCH)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.getPlatformStringReturns.result1
+}
+
+func (fake *FakePluginsActor) GetPlatformStringCallCount() int {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	return len(fake.getPlatformStringArgsForCall)
+}
+
+func (fake *FakePluginsActor) GetPlatformStringArgsForCall(i int) (string, string) {
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
+	return fake.getPlatformStringArgsForCall[i].runtimeGOOS, fake.getPlatformStringArgsForCall[i].runtimeGOARCH
+}
+
+func (fake *FakePluginsActor) GetPlatformStringReturns(result1 string) {
+	fake.GetPlatformStringStub = nil
+	fake.getPlatformStringReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePluginsActor) GetPlatformStringReturnsOnCall(i int, result1 string) {
+	fake.GetPlatformStringStub = nil
+	if fake.getPlatformStringReturnsOnCall == nil {
+		fake.getPlatformStringReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getPlatformStringReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakePluginsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getInstalledPluginsForPluginFileMutex.RLock()
+	defer fake.getInstalledPluginsForPluginFileMutex.RUnlock()
 	fake.getOutdatedPluginsMutex.RLock()
 	defer fake.getOutdatedPluginsMutex.RUnlock()
+	fake.getPlatformStringMutex.RLock()
+	defer fake.getPlatformStringMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
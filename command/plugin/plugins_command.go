@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"runtime"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/pluginaction"
@@ -8,20 +9,24 @@ import (
 	"code.cloudfoundry.org/cli/command/plugin/shared"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/pluginfile"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . PluginsActor
 
 type PluginsActor interface {
+	GetInstalledPluginsForPluginFile(platform string) ([]pluginfile.Plugin, error)
 	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
 }
 
 type PluginsCommand struct {
 	Checksum          bool        `long:"checksum" description:"Compute and show the sha1 value of the plugin binary file"`
 	Outdated          bool        `long:"outdated" description:"Search the plugin repositories for new versions of installed plugins"`
-	usage             interface{} `usage:"CF_NAME plugins [--checksum | --outdated]"`
-	relatedCommands   interface{} `related_commands:"install-plugin, repo-plugins, uninstall-plugin"`
+	Export            bool        `long:"export" description:"Write the installed plugins in the plugin file format read by install-plugins"`
+	usage             interface{} `usage:"CF_NAME plugins [--checksum | --outdated | --export]\n\nEXAMPLES:\n   CF_NAME plugins --export > cf-plugins.yml"`
+	relatedCommands   interface{} `related_commands:"install-plugin, install-plugins, repo-plugins, uninstall-plugin"`
 	SkipSSLValidation bool        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	UI                command.UI
 	Config            command.Config
@@ -31,7 +36,10 @@ type PluginsCommand struct {
 func (cmd *PluginsCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
-	pluginClient := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	pluginClient, err := shared.NewClient(config, ui, cmd.SkipSSLValidation)
+	if err != nil {
+		return err
+	}
 	cmd.Actor = pluginaction.NewActor(config, pluginClient)
 	return nil
 }
@@ -42,6 +50,8 @@ func (cmd PluginsCommand) Execute([]string) error {
 		return cmd.displayOutdatedPlugins()
 	case cmd.Checksum:
 		return cmd.displayPluginChecksums(cmd.Config.Plugins())
+	case cmd.Export:
+		return cmd.exportPlugins()
 	default:
 		return cmd.displayPluginCommands(cmd.Config.Plugins())
 	}
@@ -59,6 +69,24 @@ func (cmd PluginsCommand) displayPluginChecksums(plugins []configv3.Plugin) erro
 	return nil
 }
 
+// exportPlugins writes only the plugin file, so the output can be redirected
+// to a file.
+func (cmd PluginsCommand) exportPlugins() error {
+	currentPlatform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	plugins, err := cmd.Actor.GetInstalledPluginsForPluginFile(currentPlatform)
+	if err != nil {
+		return err
+	}
+
+	raw, err := pluginfile.MarshalPlugins(plugins)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.UI.Writer().Write(raw)
+	return err
+}
+
 func (cmd PluginsCommand) displayOutdatedPlugins() error {
 	repos := cmd.Config.PluginRepositories()
 	if len(repos) == 0 {
@@ -88,7 +116,7 @@ func (cmd PluginsCommand) displayOutdatedPlugins() error {
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 
 	cmd.UI.DisplayNewline()
-	cmd.UI.DisplayText("Use '{{.BinaryName}} install-plugin' to update a plugin to the latest version.", map[string]interface{}{
+	cmd.UI.DisplayText("Use '{{.BinaryName}} update-plugins' to update plugins to the latest version.", map[string]interface{}{
 		"BinaryName": cmd.Config.BinaryName(),
 	})
 
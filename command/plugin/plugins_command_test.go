@@ -1,6 +1,7 @@
 package plugin_test
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 
@@ -11,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/pluginfile"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -213,7 +215,7 @@ var _ = Describe("plugins Command", func() {
 
 						Expect(testUI.Out).To(Say("Searching repo-1, repo-2 for newer versions of installed plugins..."))
 						Expect(testUI.Out).To(Say(""))
-						Expect(testUI.Out).To(Say("plugin\\s+version\\s+latest version\\n\\nUse 'faceman install-plugin' to update a plugin to the latest version\\."))
+						Expect(testUI.Out).To(Say("plugin\\s+version\\s+latest version\\n\\nUse 'faceman update-plugins' to update plugins to the latest version\\."))
 
 						Expect(fakeActor.GetOutdatedPluginsCallCount()).To(Equal(1))
 					})
@@ -238,10 +240,51 @@ var _ = Describe("plugins Command", func() {
 						Expect(testUI.Out).To(Say("plugin-1\\s+1.0.0\\s+2.0.0"))
 						Expect(testUI.Out).To(Say("plugin-2\\s+2.0.0\\s+3.0.0"))
 						Expect(testUI.Out).To(Say(""))
-						Expect(testUI.Out).To(Say("Use 'faceman install-plugin' to update a plugin to the latest version\\."))
+						Expect(testUI.Out).To(Say("Use 'faceman update-plugins' to update plugins to the latest version\\."))
 					})
 				})
 			})
 		})
 	})
+
+	When("the --export flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Export = true
+			fakeActor.GetPlatformStringReturns("linux64")
+		})
+
+		When("getting the installed plugins succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetInstalledPluginsForPluginFileReturns([]pluginfile.Plugin{
+					{Name: "plugin-1", Version: "1.2.3", Checksums: map[string]string{"linux64": "some-sha256"}},
+				}, nil)
+			})
+
+			It("writes only the plugin file", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeActor.GetInstalledPluginsForPluginFileCallCount()).To(Equal(1))
+				Expect(fakeActor.GetInstalledPluginsForPluginFileArgsForCall(0)).To(Equal("linux64"))
+
+				Expect(testUI.Out).NotTo(Say("Listing installed plugins"))
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchYAML(`
+plugins:
+- name: plugin-1
+  version: 1.2.3
+  checksums:
+    linux64: some-sha256
+`))
+			})
+		})
+
+		When("getting the installed plugins fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetInstalledPluginsForPluginFileReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
 })
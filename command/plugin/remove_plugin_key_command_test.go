@@ -0,0 +1,54 @@
+package plugin_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/plugin"
+	"code.cloudfoundry.org/cli/command/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("remove-plugin-key command", func() {
+	var (
+		cmd        RemovePluginKeyCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeActor  *pluginfakes.FakeRemovePluginKeyActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(pluginfakes.FakeRemovePluginKeyActor)
+		cmd = RemovePluginKeyCommand{UI: testUI, Config: fakeConfig, Actor: fakeActor}
+		cmd.RequiredArgs.PluginKeyName = "some-key"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the key does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.RemovePluginKeyReturns(actionerror.PluginKeyNotFoundError{Name: "some-key"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.PluginKeyNotFoundError{Name: "some-key"}))
+		})
+	})
+
+	When("removing the key succeeds", func() {
+		It("removes the key and displays a success message", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Plugin key some-key removed."))
+
+			Expect(fakeActor.RemovePluginKeyCallCount()).To(Equal(1))
+			Expect(fakeActor.RemovePluginKeyArgsForCall(0)).To(Equal("some-key"))
+		})
+	})
+})
@@ -8,15 +8,22 @@ import (
 
 // NewClients creates a new V2 Cloud Controller client and UAA client using the
 // passed in config.
-func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *plugin.Client {
+func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) (*plugin.Client, error) {
 
 	verbose, location := config.Verbose()
 
+	rootCAs, certificates, err := config.TLSCertificates()
+	if err != nil {
+		return nil, err
+	}
+
 	pluginClient := plugin.NewClient(plugin.Config{
 		AppName:           config.BinaryName(),
 		AppVersion:        config.BinaryVersion(),
 		DialTimeout:       config.DialTimeout(),
 		SkipSSLValidation: skipSSLValidation,
+		RootCAs:           rootCAs,
+		Certificates:      certificates,
 	})
 
 	if verbose {
@@ -25,8 +32,11 @@ func NewClient(config command.Config, ui command.UI, skipSSLValidation bool) *pl
 	if location != nil {
 		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
+	if harFiles := config.TraceHARFiles(); harFiles != nil {
+		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerHARWriter(harFiles)))
+	}
 
 	pluginClient.WrapConnection(wrapper.NewRetryRequest(config.RequestRetryCount()))
 
-	return pluginClient
+	return pluginClient, nil
 }
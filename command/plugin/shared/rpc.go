@@ -11,6 +11,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/trace"
+	"code.cloudfoundry.org/cli/plugin"
 	"code.cloudfoundry.org/cli/plugin/rpc"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
@@ -20,6 +21,12 @@ type Config interface {
 	Verbose() (bool, []string)
 }
 
+// HookConfig is the configuration needed to run plugin hooks.
+type HookConfig interface {
+	Config
+	Plugins() []configv3.Plugin
+}
+
 type UI interface {
 	Writer() io.Writer
 }
@@ -64,6 +71,25 @@ func (r RPCService) Run(path string, command string) error {
 	return cmd.Run()
 }
 
+// RunHook runs the hook of the plugin at path for the given context. The
+// plugin's input and output are attached to the terminal.
+func (r RPCService) RunHook(path string, context plugin.HookContext) error {
+	err := r.rpcService.Start()
+	if err != nil {
+		return err
+	}
+	defer r.rpcService.Stop()
+
+	r.rpcService.RpcCmd.HookContext = context
+
+	cmd := exec.Command(path, r.rpcService.Port(), "RunHook")
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
 func (r RPCService) GetMetadata(path string) (configv3.Plugin, error) {
 	err := r.Run(path, "SendMetadata")
 	if err != nil {
@@ -81,6 +107,13 @@ func (r RPCService) GetMetadata(path string) (configv3.Plugin, error) {
 		Commands: make([]configv3.PluginCommand, len(metadata.Commands)),
 	}
 
+	for _, hook := range metadata.Hooks {
+		plugin.Hooks = append(plugin.Hooks, configv3.PluginHook{
+			Stage:   string(hook.Stage),
+			Command: hook.Command,
+		})
+	}
+
 	for i, command := range metadata.Commands {
 		plugin.Commands[i] = configv3.PluginCommand{
 			Name:     command.Name,
@@ -95,3 +128,38 @@ func (r RPCService) GetMetadata(path string) (configv3.Plugin, error) {
 
 	return plugin, nil
 }
+
+// RunHooks runs the hooks that installed plugins registered for the stage of
+// commandName, in plugin name order. It stops at the first hook that fails and
+// returns its error.
+func RunHooks(config HookConfig, ui UI, stage plugin.HookStage, commandName string, args []string) error {
+	var hookPlugins []configv3.Plugin
+	for _, installedPlugin := range config.Plugins() {
+		if installedPlugin.HasHook(string(stage), commandName) {
+			hookPlugins = append(hookPlugins, installedPlugin)
+		}
+	}
+
+	if len(hookPlugins) == 0 {
+		return nil
+	}
+
+	rpcService, err := NewRPCService(config, ui)
+	if err != nil {
+		return err
+	}
+
+	context := plugin.HookContext{
+		Stage:   stage,
+		Command: commandName,
+		Args:    args,
+	}
+	for _, hookPlugin := range hookPlugins {
+		err = rpcService.RunHook(hookPlugin.Location, context)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type ApplicationNotHealthyError struct {
+	Name string
+}
+
+func (ApplicationNotHealthyError) Error() string {
+	return "Application '{{.AppName}}' does not have all instances running"
+}
+
+func (e ApplicationNotHealthyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.Name,
+	})
+}
@@ -0,0 +1,42 @@
+package translatableerror
+
+import "strings"
+
+// BulkAppFailure is an app that stop-all, start-all or restart-all failed on.
+type BulkAppFailure struct {
+	SpaceName string
+	AppName   string
+	Err       error
+}
+
+// BulkAppOperationFailedError is returned when stop-all, start-all or
+// restart-all fails for some of the apps.
+type BulkAppOperationFailedError struct {
+	Operation string
+	Failures  []BulkAppFailure
+}
+
+func (BulkAppOperationFailedError) Error() string {
+	return "Failed to {{.Operation}} {{.Count}} apps:\n{{.Failures}}"
+}
+
+func (e BulkAppOperationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	var failures []string
+	for _, failure := range e.Failures {
+		var message string
+		if err, ok := failure.Err.(TranslatableError); ok {
+			message = err.Translate(translate)
+		} else if failure.Err != nil {
+			message = failure.Err.Error()
+		} else {
+			message = translate("UNKNOWN REASON")
+		}
+		failures = append(failures, "   "+failure.SpaceName+"/"+failure.AppName+": "+message)
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Operation": e.Operation,
+		"Count":     len(e.Failures),
+		"Failures":  strings.Join(failures, "\n"),
+	})
+}
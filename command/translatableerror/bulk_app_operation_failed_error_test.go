@@ -0,0 +1,43 @@
+package translatableerror_test
+
+import (
+	"bytes"
+	"errors"
+	"text/template"
+
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BulkAppOperationFailedError", func() {
+	Describe("Translate()", func() {
+		var translateFunc func(string, ...interface{}) string
+
+		BeforeEach(func() {
+			translateFunc = func(templateStr string, subs ...interface{}) string {
+				t := template.Must(template.New("some-text-template").Parse(templateStr))
+				buffer := bytes.NewBuffer([]byte{})
+				var data interface{}
+				if len(subs) > 0 {
+					data = subs[0]
+				}
+				Expect(t.Execute(buffer, data)).To(Succeed())
+				return buffer.String()
+			}
+		})
+
+		It("lists every failed app with its translated error", func() {
+			err := BulkAppOperationFailedError{
+				Operation: "stop",
+				Failures: []BulkAppFailure{
+					{SpaceName: "dev", AppName: "web", Err: errors.New("stop failed")},
+					{SpaceName: "prod", AppName: "worker", Err: ApplicationNotFoundError{Name: "worker"}},
+				},
+			}
+
+			Expect(err.Translate(translateFunc)).To(Equal("Failed to stop 2 apps:\n   dev/web: stop failed\n   prod/worker: App worker not found"))
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package translatableerror
+
+type CanaryAbortedError struct {
+	AppName          string
+	Percent          int
+	CrashedInstances int
+}
+
+func (CanaryAbortedError) Error() string {
+	return "Canary aborted at {{.Percent}}%: app {{.AppName}} has {{.CrashedInstances}} crashed instance(s). All traffic was returned to the old app."
+}
+
+func (e CanaryAbortedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":          e.AppName,
+		"Percent":          e.Percent,
+		"CrashedInstances": e.CrashedInstances,
+	})
+}
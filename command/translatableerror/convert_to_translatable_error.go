@@ -11,7 +11,11 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/download"
+	"code.cloudfoundry.org/cli/util/fileerror"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/networkpolicyfile"
+	"code.cloudfoundry.org/cli/util/orgfile"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -24,6 +28,8 @@ func ConvertToTranslatableError(err error) error {
 		return AddPluginRepositoryError(e)
 	case actionerror.ApplicationNotFoundError:
 		return ApplicationNotFoundError(e)
+	case actionerror.ApplicationNotHealthyError:
+		return ApplicationNotHealthyError(e)
 	case actionerror.ApplicationNotStartedError:
 		return ApplicationNotStartedError(e)
 	case actionerror.AppNotFoundInManifestError:
@@ -40,6 +46,8 @@ func ConvertToTranslatableError(err error) error {
 		return DomainNotFoundError(e)
 	case manifest.EmptyBuildpacksError:
 		return EmptyBuildpacksError(e)
+	case actionerror.EgressDestinationNotResolvedError:
+		return EgressDestinationNotResolvedError(e)
 	case actionerror.EmptyDirectoryError:
 		return EmptyDirectoryError(e)
 	case actionerror.EmptyBuildpackDirectoryError:
@@ -56,6 +64,8 @@ func ConvertToTranslatableError(err error) error {
 		return InvalidBuildpacksError{}
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
+	case actionerror.InvalidPluginKeyError:
+		return InvalidPluginKeyError(e)
 	case actionerror.InvalidRouteError:
 		return InvalidRouteError(e)
 	case actionerror.InvalidTCPRouteSettings:
@@ -66,6 +76,8 @@ func ConvertToTranslatableError(err error) error {
 		return RequiredNameForPushError{}
 	case actionerror.MultipleBuildpacksFoundError:
 		return MultipleBuildpacksFoundError(e)
+	case actionerror.MultipleUAAUsersFoundError:
+		return MultipleUAAUsersFoundError(e)
 	case actionerror.NoCompatibleBinaryError:
 		return NoCompatibleBinaryError{}
 	case actionerror.NoDomainsFoundError:
@@ -92,6 +104,10 @@ func ConvertToTranslatableError(err error) error {
 		return PluginCommandsConflictError(e)
 	case actionerror.PluginInvalidError:
 		return PluginInvalidError(e)
+	case actionerror.PluginKeyNameTakenError:
+		return PluginKeyNameTakenError(e)
+	case actionerror.PluginKeyNotFoundError:
+		return PluginKeyNotFoundError(e)
 	case actionerror.PluginNotFoundError:
 		return PluginNotFoundError(e)
 	case actionerror.ProcessInstanceNotFoundError:
@@ -110,6 +126,10 @@ func ConvertToTranslatableError(err error) error {
 		return RouteInDifferentSpaceError(e)
 	case actionerror.RoutePathWithTCPDomainError:
 		return RoutePathWithTCPDomainError(e)
+	case actionerror.RouteSwapError:
+		return RouteSwapError{Err: ConvertToTranslatableError(e.Err), UnrevertedRoutes: e.UnrevertedRoutes}
+	case actionerror.RouteSwapSameApplicationError:
+		return RouteSwapSameApplicationError(e)
 	case actionerror.SecurityGroupNotFoundError:
 		return SecurityGroupNotFoundError(e)
 	case actionerror.ServiceInstanceNotFoundError:
@@ -125,6 +145,8 @@ func ConvertToTranslatableError(err error) error {
 		return SharedServiceInstanceNotFoundError(e)
 	case actionerror.SpaceNotFoundError:
 		return SpaceNotFoundError{Name: e.Name}
+	case actionerror.SpaceQuotaNotFoundForNameError:
+		return SpaceQuotaNotFoundForNameError(e)
 	case actionerror.StackNotFoundError:
 		return StackNotFoundError(e)
 	case actionerror.StagingTimeoutError:
@@ -135,6 +157,10 @@ func ConvertToTranslatableError(err error) error {
 		return TCPRouteOptionsNotProvidedError{}
 	case actionerror.TriggerLegacyPushError:
 		return TriggerLegacyPushError{DomainHostRelated: e.DomainHostRelated}
+	case actionerror.UAAGroupNotFoundError:
+		return UAAGroupNotFoundError(e)
+	case actionerror.UAAUserNotFoundError:
+		return UAAUserNotFoundError(e)
 	case actionerror.UploadFailedError:
 		return UploadFailedError{Err: ConvertToTranslatableError(e.Err)}
 	case actionerror.CommandLineOptionsAndManifestConflictError:
@@ -165,6 +191,26 @@ func ConvertToTranslatableError(err error) error {
 			return RunTaskError{Message: "App is not staged."}
 		}
 
+	// Resource File Errors
+	case fileerror.EmptyFileError:
+		return FileEmptyError(e)
+	case fileerror.InvalidYAMLError:
+		return FileInvalidYAMLError(e)
+	case fileerror.MissingFieldError:
+		return FileMissingFieldError(e)
+
+	// Network Policy File Errors
+	case networkpolicyfile.InvalidFieldError:
+		return NetworkPolicyFileInvalidFieldError(e)
+
+	// Org File Errors
+	case orgfile.DuplicateNameError:
+		return OrgFileDuplicateNameError(e)
+
+	// Security Group File Errors
+	case securitygroupfile.InvalidJSONError:
+		return SecurityGroupFileInvalidJSONError{Path: e.Path}
+
 	// JSON Errors
 	case *json.SyntaxError:
 		return JSONSyntaxError{Err: e}
@@ -178,6 +224,12 @@ func ConvertToTranslatableError(err error) error {
 		return TriggerLegacyPushError{GlobalRelated: e.Fields}
 	case manifest.InterpolationError:
 		return InterpolationError(e)
+	case manifest.UnresolvedVariablesError:
+		variables := make([]UnresolvedManifestVariable, 0, len(e.Variables))
+		for _, variable := range e.Variables {
+			variables = append(variables, UnresolvedManifestVariable{Name: variable.Name, Locations: e.Locations(variable)})
+		}
+		return UnresolvedManifestVariablesError{Variables: variables}
 
 	// Plugin Execution Errors
 	case pluginerror.RawHTTPStatusError:
@@ -12,7 +12,12 @@ import (
 	. "code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/download"
+	"code.cloudfoundry.org/cli/util/fileerror"
 	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/networkpolicyfile"
+	"code.cloudfoundry.org/cli/util/orgfile"
+	"code.cloudfoundry.org/cli/util/pluginfile"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -39,6 +44,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.ApplicationNotFoundError{Name: "some-app"},
 			ApplicationNotFoundError{Name: "some-app"}),
 
+		Entry("actionerror.ApplicationNotHealthyError -> ApplicationNotHealthyError",
+			actionerror.ApplicationNotHealthyError{Name: "some-app"},
+			ApplicationNotHealthyError{Name: "some-app"}),
+
 		Entry("actionerror.ApplicationNotStartedError -> ApplicationNotStartedError",
 			actionerror.ApplicationNotStartedError{Name: "some-app"},
 			ApplicationNotStartedError{Name: "some-app"}),
@@ -72,6 +81,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			EmptyBuildpacksError{},
 		),
 
+		Entry("actionerror.EgressDestinationNotResolvedError -> EgressDestinationNotResolvedError",
+			actionerror.EgressDestinationNotResolvedError{Destination: "some-host"},
+			EgressDestinationNotResolvedError{Destination: "some-host"}),
+
 		Entry("actionerror.EmptyDirectoryError -> EmptyDirectoryError",
 			actionerror.EmptyDirectoryError{Path: "some-filename"},
 			EmptyDirectoryError{Path: "some-filename"}),
@@ -104,6 +117,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidHTTPRouteSettings{Domain: "some-domain"},
 			PortNotAllowedWithHTTPDomainError{Domain: "some-domain"}),
 
+		Entry("actionerror.InvalidPluginKeyError -> InvalidPluginKeyError",
+			actionerror.InvalidPluginKeyError{Name: "some-key"},
+			InvalidPluginKeyError{Name: "some-key"}),
+
 		Entry("actionerror.InvalidRouteError -> InvalidRouteError",
 			actionerror.InvalidRouteError{Route: "some-invalid-route"},
 			InvalidRouteError{Route: "some-invalid-route"}),
@@ -120,6 +137,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.MultipleBuildpacksFoundError{BuildpackName: "some-bp-name"},
 			MultipleBuildpacksFoundError{BuildpackName: "some-bp-name"}),
 
+		Entry("actionerror.MultipleUAAUsersFoundError -> MultipleUAAUsersFoundError",
+			actionerror.MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}},
+			MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}}),
+
 		Entry("actionerror.NoCompatibleBinaryError -> NoCompatibleBinaryError",
 			actionerror.NoCompatibleBinaryError{},
 			NoCompatibleBinaryError{}),
@@ -186,6 +207,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PluginInvalidError{Err: genericErr},
 			PluginInvalidError{Err: genericErr}),
 
+		Entry("actionerror.PluginKeyNameTakenError -> PluginKeyNameTakenError",
+			actionerror.PluginKeyNameTakenError{Name: "some-key"},
+			PluginKeyNameTakenError{Name: "some-key"}),
+
+		Entry("actionerror.PluginKeyNotFoundError -> PluginKeyNotFoundError",
+			actionerror.PluginKeyNotFoundError{Name: "some-key"},
+			PluginKeyNotFoundError{Name: "some-key"}),
+
 		Entry("actionerror.PluginNotFoundError -> PluginNotFoundError",
 			actionerror.PluginNotFoundError{PluginName: "some-plugin"},
 			PluginNotFoundError{PluginName: "some-plugin"}),
@@ -222,6 +251,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.RoutePathWithTCPDomainError{},
 			RoutePathWithTCPDomainError{}),
 
+		Entry("actionerror.RouteSwapError -> RouteSwapError",
+			actionerror.RouteSwapError{Err: actionerror.NoDomainsFoundError{}, UnrevertedRoutes: []string{"some-route"}},
+			RouteSwapError{Err: NoDomainsFoundError{}, UnrevertedRoutes: []string{"some-route"}}),
+
+		Entry("actionerror.RouteSwapSameApplicationError -> RouteSwapSameApplicationError",
+			actionerror.RouteSwapSameApplicationError{Name: "some-app"},
+			RouteSwapSameApplicationError{Name: "some-app"}),
+
 		Entry("actionerror.SecurityGroupNotFoundError -> SecurityGroupNotFoundError",
 			actionerror.SecurityGroupNotFoundError{Name: "some-security-group"},
 			SecurityGroupNotFoundError{Name: "some-security-group"}),
@@ -246,6 +283,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.SpaceNotFoundError{Name: "some-space"},
 			SpaceNotFoundError{Name: "some-space"}),
 
+		Entry("actionerror.SpaceQuotaNotFoundForNameError -> SpaceQuotaNotFoundForNameError",
+			actionerror.SpaceQuotaNotFoundForNameError{Name: "some-quota"},
+			SpaceQuotaNotFoundForNameError{Name: "some-quota"}),
+
 		Entry("actionerror.StackNotFoundError -> StackNotFoundError",
 			actionerror.StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"},
 			StackNotFoundError{Name: "some-stack-name", GUID: "some-stack-guid"}),
@@ -262,6 +303,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}},
 			TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}}),
 
+		Entry("actionerror.UAAGroupNotFoundError -> UAAGroupNotFoundError",
+			actionerror.UAAGroupNotFoundError{Name: "some-group"},
+			UAAGroupNotFoundError{Name: "some-group"}),
+
+		Entry("actionerror.UAAUserNotFoundError -> UAAUserNotFoundError",
+			actionerror.UAAUserNotFoundError{Username: "some-user", Origin: "uaa"},
+			UAAUserNotFoundError{Username: "some-user", Origin: "uaa"}),
+
 		Entry("actionerror.UploadFailedError -> UploadFailedError",
 			actionerror.UploadFailedError{Err: actionerror.NoDomainsFoundError{}},
 			UploadFailedError{Err: NoDomainsFoundError{}}),
@@ -343,6 +392,34 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			HTTPStatusError{Status: "some status"},
 		),
 
+		// Resource File Errors
+		Entry("fileerror.EmptyFileError -> FileEmptyError",
+			fileerror.EmptyFileError{Kind: pluginfile.Kind, Path: "some-path"},
+			FileEmptyError{Kind: pluginfile.Kind, Path: "some-path"}),
+
+		Entry("fileerror.InvalidYAMLError -> FileInvalidYAMLError",
+			fileerror.InvalidYAMLError{Kind: orgfile.Kind, Path: "some-path", Err: errors.New("some-error")},
+			FileInvalidYAMLError{Kind: orgfile.Kind, Path: "some-path", Err: errors.New("some-error")}),
+
+		Entry("fileerror.MissingFieldError -> FileMissingFieldError",
+			fileerror.MissingFieldError{Kind: orgfile.Kind, List: "spaces", Index: 2, Field: "name"},
+			FileMissingFieldError{Kind: orgfile.Kind, List: "spaces", Index: 2, Field: "name"}),
+
+		// Network Policy File Errors
+		Entry("networkpolicyfile.InvalidFieldError -> NetworkPolicyFileInvalidFieldError",
+			networkpolicyfile.InvalidFieldError{Index: 2, Field: "ports", Value: "abc"},
+			NetworkPolicyFileInvalidFieldError{Index: 2, Field: "ports", Value: "abc"}),
+
+		// Org File Errors
+		Entry("orgfile.DuplicateNameError -> OrgFileDuplicateNameError",
+			orgfile.DuplicateNameError{Field: "spaces", Name: "dev"},
+			OrgFileDuplicateNameError{Field: "spaces", Name: "dev"}),
+
+		// Security Group File Errors
+		Entry("securitygroupfile.InvalidJSONError -> SecurityGroupFileInvalidJSONError",
+			securitygroupfile.InvalidJSONError{Path: "some-path", Err: errors.New("some-error")},
+			SecurityGroupFileInvalidJSONError{Path: "some-path"}),
+
 		Entry("json.SyntaxError -> JSONSyntaxError",
 			jsonErr,
 			JSONSyntaxError{Err: jsonErr},
@@ -365,6 +442,13 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			manifest.InterpolationError{Err: errors.New("an-error")},
 			InterpolationError{Err: errors.New("an-error")}),
 
+		Entry("manifest.UnresolvedVariablesError -> UnresolvedManifestVariablesError",
+			manifest.UnresolvedVariablesError{
+				ManifestPath: "manifest.yml",
+				Variables:    []manifest.UnresolvedVariable{{Name: "name", Positions: []manifest.Position{{Line: 2, Column: 9}, {Line: 6, Column: 12}}}},
+			},
+			UnresolvedManifestVariablesError{Variables: []UnresolvedManifestVariable{{Name: "name", Locations: []string{"manifest.yml:2:9", "manifest.yml:6:12"}}}}),
+
 		// Plugin Errors
 		Entry("pluginerror.RawHTTPStatusError -> DownloadPluginHTTPError",
 			pluginerror.RawHTTPStatusError{Status: "some status"},
@@ -0,0 +1,15 @@
+package translatableerror
+
+type EgressDestinationNotResolvedError struct {
+	Destination string
+}
+
+func (EgressDestinationNotResolvedError) Error() string {
+	return "Unable to resolve destination {{.Destination}}"
+}
+
+func (e EgressDestinationNotResolvedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Destination": e.Destination,
+	})
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+type EgressPortNotAllowedError struct{}
+
+func (EgressPortNotAllowedError) DisplayUsage() {}
+
+func (EgressPortNotAllowedError) Error() string {
+	return "Incorrect Usage: DEST cannot include a port for the icmp protocol"
+}
+
+func (e EgressPortNotAllowedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+type EgressPortRequiredError struct{}
+
+func (EgressPortRequiredError) DisplayUsage() {}
+
+func (EgressPortRequiredError) Error() string {
+	return "Incorrect Usage: DEST must include a port for the tcp and udp protocols"
+}
+
+func (e EgressPortRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,21 @@
+package translatableerror
+
+import "code.cloudfoundry.org/cli/util/fileerror"
+
+// FileEmptyError is returned when a file of resources to apply does not list
+// any.
+type FileEmptyError struct {
+	Kind fileerror.Kind
+	Path string
+}
+
+func (FileEmptyError) Error() string {
+	return "No {{.Entries}} found in {{.Path}}"
+}
+
+func (e FileEmptyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Entries": translate(e.Kind.Entries),
+		"Path":    e.Path,
+	})
+}
@@ -0,0 +1,23 @@
+package translatableerror
+
+import "code.cloudfoundry.org/cli/util/fileerror"
+
+// FileInvalidYAMLError is returned when a file of resources to apply is not
+// valid YAML or has unknown fields.
+type FileInvalidYAMLError struct {
+	Kind fileerror.Kind
+	Path string
+	Err  error
+}
+
+func (FileInvalidYAMLError) Error() string {
+	return "{{.Path}} is not a valid {{.Kind}}: {{.Err}}"
+}
+
+func (e FileInvalidYAMLError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+		"Kind": translate(e.Kind.Name),
+		"Err":  e.Err.Error(),
+	})
+}
@@ -0,0 +1,34 @@
+package translatableerror
+
+import "code.cloudfoundry.org/cli/util/fileerror"
+
+// FileMissingFieldError is returned when an entry in a file of resources to
+// apply is missing a required field. Index is 0 when the field is missing from
+// the top level of the file.
+type FileMissingFieldError struct {
+	Kind  fileerror.Kind
+	List  string
+	Index int
+	Field string
+}
+
+func (e FileMissingFieldError) Error() string {
+	switch {
+	case e.Index == 0:
+		return "The {{.Kind}} is missing the required '{{.Field}}' field"
+	case e.List != "":
+		return "Entry {{.Index}} of '{{.List}}' in the {{.Kind}} is missing the required '{{.Field}}' field"
+	default:
+		return "{{.Entry}} {{.Index}} is missing the required '{{.Field}}' field"
+	}
+}
+
+func (e FileMissingFieldError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Kind":  translate(e.Kind.Name),
+		"Entry": translate(e.Kind.Entry),
+		"List":  e.List,
+		"Index": e.Index,
+		"Field": e.Field,
+	})
+}
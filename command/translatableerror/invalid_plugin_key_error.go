@@ -0,0 +1,15 @@
+package translatableerror
+
+// InvalidPluginKeyError is returned when a plugin key is not a base64-encoded
+// Ed25519 public key.
+type InvalidPluginKeyError struct {
+	Name string
+}
+
+func (InvalidPluginKeyError) Error() string {
+	return "Plugin key {{.KeyName}} is not a base64-encoded Ed25519 public key."
+}
+
+func (e InvalidPluginKeyError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"KeyName": e.Name})
+}
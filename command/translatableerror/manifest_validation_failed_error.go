@@ -0,0 +1,15 @@
+package translatableerror
+
+type ManifestValidationFailedError struct {
+	ErrorCount int
+}
+
+func (ManifestValidationFailedError) Error() string {
+	return "Manifest validation failed with {{.ErrorCount}} errors."
+}
+
+func (e ManifestValidationFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ErrorCount": e.ErrorCount,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+type MultipleUAAUsersFoundError struct {
+	Username string
+	Origins  []string
+}
+
+func (MultipleUAAUsersFoundError) Error() string {
+	return "User '{{.Username}}' exists in multiple origins: {{.Origins}}\nSpecify the origin with '--origin'."
+}
+
+func (e MultipleUAAUsersFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+		"Origins":  strings.Join(e.Origins, ", "),
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+type NetworkPolicyFileInvalidFieldError struct {
+	Index int
+	Field string
+	Value string
+}
+
+func (NetworkPolicyFileInvalidFieldError) Error() string {
+	return "Policy {{.Index}} has an invalid '{{.Field}}' value: {{.Value}}"
+}
+
+func (e NetworkPolicyFileInvalidFieldError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Index": e.Index,
+		"Field": e.Field,
+		"Value": e.Value,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// OrgFileDuplicateNameError is returned when two entries in a list of an
+// organization file have the same name.
+type OrgFileDuplicateNameError struct {
+	Field string
+	Name  string
+}
+
+func (OrgFileDuplicateNameError) Error() string {
+	return "'{{.Field}}' in the organization file has more than one entry named {{.Name}}"
+}
+
+func (e OrgFileDuplicateNameError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Field": e.Field,
+		"Name":  e.Name,
+	})
+}
@@ -0,0 +1,13 @@
+package translatableerror
+
+// PasswordVerificationError is returned when a password and its verification
+// do not match.
+type PasswordVerificationError struct{}
+
+func (PasswordVerificationError) Error() string {
+	return "Password verification does not match."
+}
+
+func (e PasswordVerificationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
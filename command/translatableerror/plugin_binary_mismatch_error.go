@@ -0,0 +1,24 @@
+package translatableerror
+
+// PluginBinaryMismatchError is returned when the binary downloaded for a
+// plugin in the plugin file reports a different name or version than the one
+// listed.
+type PluginBinaryMismatchError struct {
+	PluginName    string
+	Version       string
+	BinaryName    string
+	BinaryVersion string
+}
+
+func (PluginBinaryMismatchError) Error() string {
+	return "The binary downloaded for plugin {{.PluginName}} {{.Version}} is plugin {{.BinaryName}} {{.BinaryVersion}}. Check the url and version of the plugin in the plugin file."
+}
+
+func (e PluginBinaryMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName":    e.PluginName,
+		"Version":       e.Version,
+		"BinaryName":    e.BinaryName,
+		"BinaryVersion": e.BinaryVersion,
+	})
+}
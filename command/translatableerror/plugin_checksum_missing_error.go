@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginChecksumMissingError is returned when a plugin in the plugin file has
+// checksums, but none for the current platform.
+type PluginChecksumMissingError struct {
+	PluginName string
+	Platform   string
+}
+
+func (PluginChecksumMissingError) Error() string {
+	return "Plugin {{.PluginName}} has no checksum for platform {{.Platform}} in the plugin file. Add one, or use --allow-unsigned to install the plugin without verifying it."
+}
+
+func (e PluginChecksumMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Platform":   e.Platform,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// PluginKeyNameTakenError is returned when adding a plugin key fails due to a
+// key already existing with the same name.
+type PluginKeyNameTakenError struct {
+	Name string
+}
+
+func (PluginKeyNameTakenError) Error() string {
+	return "Plugin key named '{{.KeyName}}' already exists, please use another name."
+}
+
+func (e PluginKeyNameTakenError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"KeyName": e.Name})
+}
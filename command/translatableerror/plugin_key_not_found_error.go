@@ -0,0 +1,14 @@
+package translatableerror
+
+// PluginKeyNotFoundError is returned when a trusted plugin key does not exist.
+type PluginKeyNotFoundError struct {
+	Name string
+}
+
+func (PluginKeyNotFoundError) Error() string {
+	return "Plugin key {{.KeyName}} not found."
+}
+
+func (e PluginKeyNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"KeyName": e.Name})
+}
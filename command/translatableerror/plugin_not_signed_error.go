@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginNotSignedError is returned when installing a plugin binary that has
+// no signature.
+type PluginNotSignedError struct {
+	Path       string
+	BinaryName string
+}
+
+func (PluginNotSignedError) Error() string {
+	return "Plugin {{.Path}} is not signed.\nUse '{{.BinaryName}} install-plugin --allow-unsigned' to install it anyway."
+}
+
+func (e PluginNotSignedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":       e.Path,
+		"BinaryName": e.BinaryName,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginSignatureInvalidError is returned when installing a plugin binary
+// whose signature cannot be verified with any trusted plugin key.
+type PluginSignatureInvalidError struct {
+	Path       string
+	BinaryName string
+}
+
+func (PluginSignatureInvalidError) Error() string {
+	return "The signature of plugin {{.Path}} could not be verified with any trusted plugin key.\nUse '{{.BinaryName}} add-plugin-key' to trust the plugin author's key."
+}
+
+func (e PluginSignatureInvalidError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":       e.Path,
+		"BinaryName": e.BinaryName,
+	})
+}
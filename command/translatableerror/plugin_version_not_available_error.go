@@ -0,0 +1,19 @@
+package translatableerror
+
+// PluginVersionNotAvailableError is returned when the plugin repositories do
+// not provide the version of a plugin listed in the plugin file.
+type PluginVersionNotAvailableError struct {
+	PluginName string
+	Version    string
+}
+
+func (PluginVersionNotAvailableError) Error() string {
+	return "Plugin {{.PluginName}} {{.Version}} is not available in the plugin repositories. Add the url of the plugin binary to the plugin file to install this version."
+}
+
+func (e PluginVersionNotAvailableError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Version":    e.Version,
+	})
+}
@@ -0,0 +1,31 @@
+package translatableerror
+
+import "strings"
+
+type RouteSwapError struct {
+	Err              error
+	UnrevertedRoutes []string
+}
+
+func (e RouteSwapError) Error() string {
+	if len(e.UnrevertedRoutes) > 0 {
+		return "Swapping routes failed: {{.Error}}\nThe following route mappings could not be reverted: {{.Routes}}"
+	}
+	return "Swapping routes failed: {{.Error}}\nAll route mappings were reverted."
+}
+
+func (e RouteSwapError) Translate(translate func(string, ...interface{}) string) string {
+	var message string
+	if err, ok := e.Err.(TranslatableError); ok {
+		message = err.Translate(translate)
+	} else if e.Err != nil {
+		message = e.Err.Error()
+	} else {
+		message = translate("UNKNOWN REASON")
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Error":  message,
+		"Routes": strings.Join(e.UnrevertedRoutes, ", "),
+	})
+}
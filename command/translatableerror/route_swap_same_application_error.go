@@ -0,0 +1,15 @@
+package translatableerror
+
+type RouteSwapSameApplicationError struct {
+	Name string
+}
+
+func (RouteSwapSameApplicationError) Error() string {
+	return "OLD_APP and NEW_APP must be different apps; both are '{{.AppName}}'."
+}
+
+func (e RouteSwapSameApplicationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.Name,
+	})
+}
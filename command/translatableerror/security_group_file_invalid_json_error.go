@@ -0,0 +1,24 @@
+package translatableerror
+
+type SecurityGroupFileInvalidJSONError struct {
+	Path string
+}
+
+func (SecurityGroupFileInvalidJSONError) Error() string {
+	return `Incorrect json format: file: {{.Path}}
+
+Valid json file example:
+[
+  {
+    "protocol": "tcp",
+    "destination": "10.244.1.18",
+    "ports": "3306"
+  }
+]`
+}
+
+func (e SecurityGroupFileInvalidJSONError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type SecurityGroupFileInvalidRulesError struct {
+	Path string
+}
+
+func (SecurityGroupFileInvalidRulesError) Error() string {
+	return "Invalid security group rules in {{.Path}}"
+}
+
+func (e SecurityGroupFileInvalidRulesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
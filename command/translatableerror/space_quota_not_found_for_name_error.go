@@ -0,0 +1,17 @@
+package translatableerror
+
+// SpaceQuotaNotFoundForNameError is returned when a space quota with the given
+// name can't be found.
+type SpaceQuotaNotFoundForNameError struct {
+	Name string
+}
+
+func (e SpaceQuotaNotFoundForNameError) Error() string {
+	return "Space quota {{.QuotaName}} not found"
+}
+
+func (e SpaceQuotaNotFoundForNameError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"QuotaName": e.Name,
+	})
+}
@@ -45,15 +45,22 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("AssignDropletError", AssignDropletError{}),
 		Entry("BadCredentialsError", UnauthorizedError{}),
 		Entry("BuildpackNotFoundError", BuildpackNotFoundError{}),
+		Entry("BulkAppOperationFailedError", BulkAppOperationFailedError{}),
 		Entry("CFNetworkingEndpointNotFoundError", CFNetworkingEndpointNotFoundError{}),
 		Entry("CommandLineArgsWithMultipleAppsError", CommandLineArgsWithMultipleAppsError{}),
 		Entry("CommandLineOptionsAndManifestConflictError", CommandLineOptionsAndManifestConflictError{}),
 		Entry("DockerPasswordNotSetError", DockerPasswordNotSetError{}),
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
+		Entry("EgressDestinationNotResolvedError", EgressDestinationNotResolvedError{}),
+		Entry("EgressPortNotAllowedError", EgressPortNotAllowedError{}),
+		Entry("EgressPortRequiredError", EgressPortRequiredError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("EmptyBuildpacksError", EmptyBuildpacksError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
 		Entry("FileChangedError", FileChangedError{}),
+		Entry("FileEmptyError", FileEmptyError{}),
+		Entry("FileInvalidYAMLError", FileInvalidYAMLError{Err: errors.New("some-error")}),
+		Entry("FileMissingFieldError", FileMissingFieldError{}),
 		Entry("FileNotFoundError", FileNotFoundError{}),
 		Entry("GettingPluginRepositoryError", GettingPluginRepositoryError{}),
 		Entry("HealthCheckTypeUnsupportedError", HealthCheckTypeUnsupportedError{SupportedTypes: []string{"some-type", "another-type"}}),
@@ -71,10 +78,12 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("LifecycleMinimumAPIVersionNotMetError", LifecycleMinimumAPIVersionNotMetError{}),
 		Entry("ManifestCreationError", ManifestCreationError{}),
 		Entry("ManifestFileNotFoundInDirectoryError", ManifestFileNotFoundInDirectoryError{}),
+		Entry("ManifestValidationFailedError", ManifestValidationFailedError{}),
 		Entry("MinimumCFAPIVersionNotMetError", MinimumCFAPIVersionNotMetError{}),
 		Entry("MinimumCLIVersionNotMetError", MinimumCLIVersionNotMetError{}),
 		Entry("MissingCredentialsError", MissingCredentialsError{}),
 		Entry("MultiError", MultiError{}),
+		Entry("NetworkPolicyDestinationOrgWithoutSpaceError", NetworkPolicyDestinationOrgWithoutSpaceError{}),
 		Entry("NetworkPolicyProtocolOrPortNotProvidedError", NetworkPolicyProtocolOrPortNotProvidedError{}),
 		Entry("NoAPISetError", NoAPISetError{}),
 		Entry("NoCompatibleBinaryError", NoCompatibleBinaryError{}),
@@ -84,13 +93,16 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
+		Entry("OrgFileDuplicateNameError", OrgFileDuplicateNameError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
 		Entry("OrganizationQuotaNotFoundForNameError", OrganizationQuotaNotFoundForNameError{}),
 		Entry("ParseArgumentError", ParseArgumentError{}),
 		Entry("PasswordGrantTypeLogoutRequiredError", PasswordGrantTypeLogoutRequiredError{}),
 		Entry("PluginAlreadyInstalledError", PluginAlreadyInstalledError{}),
+		Entry("PluginBinaryMismatchError", PluginBinaryMismatchError{}),
 		Entry("PluginBinaryRemoveFailedError", PluginBinaryRemoveFailedError{}),
 		Entry("PluginBinaryUninstallError", PluginBinaryUninstallError{}),
+		Entry("PluginChecksumMissingError", PluginChecksumMissingError{}),
 		Entry("PluginCommandsConflictError", PluginCommandsConflictError{}),
 		Entry("PluginInvalidError", PluginInvalidError{Err: errors.New("invalid error")}),
 		Entry("PluginInvalidError", PluginInvalidError{}),
@@ -107,12 +119,16 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("RequiredNameForPushError", RequiredNameForPushError{}),
 		Entry("RouteInDifferentSpaceError", RouteInDifferentSpaceError{}),
 		Entry("RoutePathWithTCPDomainError", RoutePathWithTCPDomainError{}),
+		Entry("RouteSwapSameApplicationError", RouteSwapSameApplicationError{}),
 		Entry("RunTaskError", RunTaskError{}),
+		Entry("SecurityGroupFileInvalidJSONError", SecurityGroupFileInvalidJSONError{}),
+		Entry("SecurityGroupFileInvalidRulesError", SecurityGroupFileInvalidRulesError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
 		Entry("ServiceInstanceNotShareableError", ServiceInstanceNotShareableError{}),
 		Entry("ServiceInstanceNotFoundError", ServiceInstanceNotFoundError{}),
 		Entry("SharedServiceInstanceNotFoundError", SharedServiceInstanceNotFoundError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("SpaceQuotaNotFoundForNameError", SpaceQuotaNotFoundForNameError{}),
 		Entry("SSHUnableToAuthenticateError", SSHUnableToAuthenticateError{}),
 		Entry("SSLCertError", SSLCertError{}),
 		Entry("StackNotFoundError with name", SpaceNotFoundError{Name: "steve"}),
@@ -123,6 +139,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
 		Entry("TriggerLegacyPushError", TriggerLegacyPushError{}),
+		Entry("UnresolvedManifestVariablesError", UnresolvedManifestVariablesError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
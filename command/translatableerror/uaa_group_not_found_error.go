@@ -0,0 +1,15 @@
+package translatableerror
+
+type UAAGroupNotFoundError struct {
+	Name string
+}
+
+func (UAAGroupNotFoundError) Error() string {
+	return "Group '{{.Name}}' not found."
+}
+
+func (e UAAGroupNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
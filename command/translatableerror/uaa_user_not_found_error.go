@@ -0,0 +1,16 @@
+package translatableerror
+
+type UAAUserNotFoundError struct {
+	Username string
+	Origin   string
+}
+
+func (UAAUserNotFoundError) Error() string {
+	return "User '{{.Username}}' not found."
+}
+
+func (e UAAUserNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Username": e.Username,
+	})
+}
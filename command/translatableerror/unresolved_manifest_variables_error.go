@@ -0,0 +1,33 @@
+package translatableerror
+
+import "strings"
+
+// UnresolvedManifestVariable is a manifest variable without a value, with
+// the MANIFEST_PATH:LINE:COLUMN locations where it is used.
+type UnresolvedManifestVariable struct {
+	Name      string
+	Locations []string
+}
+
+// UnresolvedManifestVariablesError is returned when variables in a manifest
+// are not provided by any vars source.
+type UnresolvedManifestVariablesError struct {
+	Variables []UnresolvedManifestVariable
+}
+
+func (UnresolvedManifestVariablesError) Error() string {
+	return "Expected to find variables: {{.Names}}\n{{.Locations}}"
+}
+
+func (e UnresolvedManifestVariablesError) Translate(translate func(string, ...interface{}) string) string {
+	var names, locations []string
+	for _, variable := range e.Variables {
+		names = append(names, variable.Name)
+		locations = append(locations, "   "+variable.Name+": "+strings.Join(variable.Locations, ", "))
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Names":     strings.Join(names, ", "),
+		"Locations": strings.Join(locations, "\n"),
+	})
+}
@@ -0,0 +1,43 @@
+package translatableerror_test
+
+import (
+	"bytes"
+	"text/template"
+
+	. "code.cloudfoundry.org/cli/command/translatableerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UnresolvedManifestVariablesError", func() {
+	Describe("Translate()", func() {
+		var translateFunc func(string, ...interface{}) string
+
+		BeforeEach(func() {
+			translateFunc = func(templateStr string, subs ...interface{}) string {
+				t := template.Must(template.New("some-text-template").Parse(templateStr))
+				buffer := bytes.NewBuffer([]byte{})
+				var data interface{}
+				if len(subs) > 0 {
+					data = subs[0]
+				}
+				Expect(t.Execute(buffer, data)).To(Succeed())
+				return buffer.String()
+			}
+		})
+
+		It("lists every variable with the locations it is used at", func() {
+			err := UnresolvedManifestVariablesError{
+				Variables: []UnresolvedManifestVariable{
+					{Name: "domain", Locations: []string{"manifest.yml:6:21"}},
+					{Name: "name", Locations: []string{"manifest.yml:2:9", "manifest.yml:6:12"}},
+				},
+			}
+
+			Expect(err.Translate(translateFunc)).To(Equal("Expected to find variables: domain, name\n" +
+				"   domain: manifest.yml:6:21\n" +
+				"   name: manifest.yml:2:9, manifest.yml:6:12"))
+		})
+	})
+})
@@ -21,6 +21,7 @@ type UI interface {
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
 	DisplayOK()
+	DisplayQuotaUsageTable(table [][]string, highlighted [][]bool)
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
@@ -31,6 +32,7 @@ type UI interface {
 	GetOut() io.Writer
 	GetErr() io.Writer
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
+	RequestLoggerHARWriter(filePaths []string) *ui.RequestLoggerHARWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
 	TranslateText(template string, data ...map[string]interface{}) string
 	UserFriendlyDate(input time.Time) string
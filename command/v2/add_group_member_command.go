@@ -0,0 +1,75 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . AddGroupMemberActor
+
+type AddGroupMemberActor interface {
+	AddUserToUAAGroup(username string, origin string, groupName string) error
+}
+
+type AddGroupMemberCommand struct {
+	RequiredArgs    flag.GroupMember `positional-args:"yes"`
+	Origin          string           `long:"origin" description:"Origin of the user, required when the username exists in more than one origin"`
+	usage           interface{}      `usage:"CF_NAME add-group-member GROUP USERNAME [--origin ORIGIN]\n\nEXAMPLES:\n   CF_NAME add-group-member cloud_controller.admin j.smith@example.com"`
+	relatedCommands interface{}      `related_commands:"groups, remove-group-member, users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AddGroupMemberActor
+}
+
+func (cmd *AddGroupMemberCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd AddGroupMemberCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Adding user {{.TargetUser}} to group {{.Group}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"Group":       cmd.RequiredArgs.Group,
+		"CurrentUser": user.Name,
+	})
+
+	err = cmd.Actor.AddUserToUAAGroup(cmd.RequiredArgs.Username, cmd.Origin, cmd.RequiredArgs.Group)
+	if err != nil {
+		if _, ok := err.(uaa.ConflictError); !ok {
+			return err
+		}
+
+		cmd.UI.DisplayWarning("User {{.TargetUser}} is already a member of group {{.Group}}.", map[string]interface{}{
+			"TargetUser": cmd.RequiredArgs.Username,
+			"Group":      cmd.RequiredArgs.Group,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
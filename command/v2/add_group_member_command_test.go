@@ -0,0 +1,94 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("add-group-member Command", func() {
+	var (
+		cmd             AddGroupMemberCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAddGroupMemberActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAddGroupMemberActor)
+
+		cmd = AddGroupMemberCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Group = "some-group"
+		cmd.RequiredArgs.Username = "some-user"
+		cmd.Origin = "ldap"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-admin"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("the user is added", func() {
+		It("adds the user to the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			username, origin, group := fakeActor.AddUserToUAAGroupArgsForCall(0)
+			Expect(username).To(Equal("some-user"))
+			Expect(origin).To(Equal("ldap"))
+			Expect(group).To(Equal("some-group"))
+
+			Expect(testUI.Out).To(Say(`Adding user some-user to group some-group as some-admin\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the user is already a member", func() {
+		BeforeEach(func() {
+			fakeActor.AddUserToUAAGroupReturns(uaa.ConflictError{Message: "already a member"})
+		})
+
+		It("displays a warning and OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say(`User some-user is already a member of group some-group\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the group does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.AddUserToUAAGroupReturns(actionerror.UAAGroupNotFoundError{Name: "some-group"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.UAAGroupNotFoundError{Name: "some-group"}))
+		})
+	})
+})
@@ -2,11 +2,13 @@ package v2
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
@@ -18,11 +20,14 @@ type ApiActor interface {
 }
 
 type ApiCommand struct {
-	OptionalArgs      flag.APITarget `positional-args:"yes"`
-	SkipSSLValidation bool           `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
-	Unset             bool           `long:"unset" description:"Remove all api endpoint targeting"`
-	usage             interface{}    `usage:"CF_NAME api [URL]"`
-	relatedCommands   interface{}    `related_commands:"auth, login, target"`
+	OptionalArgs      flag.APITarget              `positional-args:"yes"`
+	CACert            flag.PathWithExistenceCheck `long:"ca-cert" description:"Path to a PEM encoded CA certificate to trust in addition to the system's certificates"`
+	ClientCert        flag.PathWithExistenceCheck `long:"client-cert" description:"Path to a PEM encoded client certificate to present to the API endpoint, UAA and plugin repositories"`
+	ClientKey         flag.PathWithExistenceCheck `long:"client-key" description:"Path to the PEM encoded private key of the client certificate"`
+	SkipSSLValidation bool                        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
+	Unset             bool                        `long:"unset" description:"Remove all api endpoint targeting"`
+	usage             interface{}                 `usage:"CF_NAME api [URL] [--ca-cert CA_CERT_FILE] [--client-cert CERT_FILE --client-key KEY_FILE]\n\n   The certificates are saved and used for all connections to the Cloud\n   Controller, UAA, Loggregator and plugin repositories until the next\n   'CF_NAME api URL'.\n\nEXAMPLES:\n   CF_NAME api api.example.com --ca-cert ~/certs/internal-ca.pem"`
+	relatedCommands   interface{}                 `related_commands:"auth, login, target"`
 
 	UI     command.UI
 	Actor  ApiActor
@@ -46,6 +51,13 @@ func (cmd *ApiCommand) Execute(args []string) error {
 		return cmd.ClearTarget()
 	}
 
+	if (cmd.ClientCert == "") != (cmd.ClientKey == "") {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--client-cert",
+			Arg2: "--client-key",
+		}
+	}
+
 	if cmd.OptionalArgs.URL != "" {
 		err := cmd.setAPI()
 		if err != nil {
@@ -95,11 +107,27 @@ func (cmd *ApiCommand) setAPI() error {
 
 	apiURL := processURL(cmd.OptionalArgs.URL)
 
-	_, err := cmd.Actor.SetTarget(v2action.TargetSettings{
+	settings := v2action.TargetSettings{
 		URL:               apiURL,
 		SkipSSLValidation: cmd.SkipSSLValidation,
 		DialTimeout:       cmd.Config.DialTimeout(),
-	})
+	}
+
+	var err error
+	settings.CACertFile, err = absolutePath(cmd.CACert)
+	if err != nil {
+		return err
+	}
+	settings.ClientCertFile, err = absolutePath(cmd.ClientCert)
+	if err != nil {
+		return err
+	}
+	settings.ClientKeyFile, err = absolutePath(cmd.ClientKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = cmd.Actor.SetTarget(settings)
 	if err != nil {
 		return err
 	}
@@ -113,6 +141,15 @@ func (cmd *ApiCommand) setAPI() error {
 	return nil
 }
 
+// absolutePath returns the absolute path of a certificate file, since the
+// path is saved in the config and used from any directory.
+func absolutePath(path flag.PathWithExistenceCheck) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	return filepath.Abs(string(path))
+}
+
 func processURL(apiURL string) string {
 	if !strings.HasPrefix(apiURL, "http") {
 		return fmt.Sprintf("https://%s", apiURL)
@@ -2,9 +2,11 @@ package v2_test
 
 import (
 	"errors"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -219,6 +221,41 @@ api version:    100.200.300`,
 			})
 		})
 
+		When("certificate files are passed", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.URL = "https://api.foo.com"
+				cmd.CACert = "/some/ca.pem"
+				cmd.ClientCert = "some-client.pem"
+				cmd.ClientKey = "some-client-key.pem"
+			})
+
+			It("sets the target with the absolute paths of the certificate files", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(1))
+				settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.CACertFile).To(Equal("/some/ca.pem"))
+				Expect(filepath.IsAbs(settings.ClientCertFile)).To(BeTrue())
+				Expect(settings.ClientCertFile).To(HaveSuffix("some-client.pem"))
+				Expect(filepath.IsAbs(settings.ClientKeyFile)).To(BeTrue())
+				Expect(settings.ClientKeyFile).To(HaveSuffix("some-client-key.pem"))
+			})
+
+			When("the client key is not passed", func() {
+				BeforeEach(func() {
+					cmd.ClientKey = ""
+				})
+
+				It("returns a RequiredFlagsError", func() {
+					Expect(err).To(MatchError(translatableerror.RequiredFlagsError{
+						Arg1: "--client-cert",
+						Arg2: "--client-key",
+					}))
+					Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		When("the URL host does not exist", func() {
 			var (
 				CCAPI      string
@@ -0,0 +1,171 @@
+package v2
+
+import (
+	"net"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . CheckEgressActor
+
+type CheckEgressActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	CheckSpaceEgress(spaceGUID string, destination string, port int, protocol string, lifecycle constant.SecurityGroupLifecycle) (v2action.EgressCheck, v2action.Warnings, error)
+}
+
+type CheckEgressCommand struct {
+	RequiredArgs    flag.CheckEgressArgs `positional-args:"yes"`
+	Protocol        flag.EgressProtocol  `long:"protocol" description:"Protocol of the traffic to check (Default: tcp)"`
+	Staging         bool                 `long:"staging" description:"Check the staging security groups instead of the running security groups"`
+	usage           interface{}          `usage:"CF_NAME check-egress APP_NAME DEST[:PORT] [--protocol (tcp | udp | icmp)] [--staging]\n\n   Checks the rules of the security groups that apply to the app's space,\n   including the global running or staging security groups, against the\n   destination. Hostnames are resolved on this machine, which may resolve\n   them differently than the app does. A port is required for tcp and udp.\n\nEXAMPLES:\n   CF_NAME check-egress my-app 10.0.0.5:5432\n   CF_NAME check-egress my-app dns.example.com:53 --protocol udp\n   CF_NAME check-egress my-app 10.0.0.5 --protocol icmp --staging"`
+	relatedCommands interface{}          `related_commands:"bind-security-group, running-security-groups, security-groups, staging-security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CheckEgressActor
+}
+
+func (cmd *CheckEgressCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd CheckEgressCommand) Execute(args []string) error {
+	protocol := cmd.Protocol.Protocol
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	destination := cmd.RequiredArgs.Destination
+	switch {
+	case protocol == "icmp" && destination.Port != 0:
+		return translatableerror.EgressPortNotAllowedError{}
+	case protocol != "icmp" && destination.Port == 0:
+		return translatableerror.EgressPortRequiredError{}
+	}
+
+	lifecycle := constant.SecurityGroupLifecycleRunning
+	if cmd.Staging {
+		lifecycle = constant.SecurityGroupLifecycleStaging
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Checking {{.Lifecycle}} {{.Protocol}} egress from app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} to {{.Destination}} as {{.Username}}...", map[string]interface{}{
+		"Lifecycle":   lifecycle,
+		"Protocol":    protocol,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Destination": egressDestination(destination.Host, destination.Port),
+		"Username":    user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	check, warnings, err := cmd.Actor.CheckSpaceEgress(cmd.Config.TargetedSpace().GUID, destination.Host, destination.Port, protocol, lifecycle)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if check.IP != destination.Host {
+		cmd.UI.DisplayText("Resolved {{.Host}} to {{.IP}}.", map[string]interface{}{
+			"Host": destination.Host,
+			"IP":   check.IP,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("security group"),
+			cmd.UI.TranslateText("scope"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("result"),
+		},
+	}
+	for _, rule := range check.Rules {
+		scope := cmd.UI.TranslateText("space")
+		if rule.Global {
+			scope = cmd.UI.TranslateText("global")
+		}
+		table = append(table, []string{
+			rule.Name,
+			scope,
+			rule.Protocol,
+			rule.Destination,
+			rule.Ports,
+			cmd.resultText(rule.Mismatch),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	checked := map[string]interface{}{
+		"Destination": egressDestination(check.IP, destination.Port),
+		"Protocol":    protocol,
+	}
+	if rule, allowed := check.AllowingRule(); allowed {
+		checked["SecurityGroupName"] = rule.Name
+		cmd.UI.DisplayText("{{.Protocol}} egress to {{.Destination}} is allowed by security group {{.SecurityGroupName}}.", checked)
+	} else {
+		cmd.UI.DisplayText("{{.Protocol}} egress to {{.Destination}} is denied: no security group rule allows it.", checked)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd CheckEgressCommand) resultText(mismatch v2action.EgressRuleMismatch) string {
+	switch mismatch {
+	case v2action.EgressRuleProtocolMismatch:
+		return cmd.UI.TranslateText("protocol does not match")
+	case v2action.EgressRuleDestinationMismatch:
+		return cmd.UI.TranslateText("destination does not match")
+	case v2action.EgressRulePortsMismatch:
+		return cmd.UI.TranslateText("ports do not match")
+	default:
+		return cmd.UI.TranslateText("allows")
+	}
+}
+
+// egressDestination returns the host with the port appended, or only the host
+// when there is no port.
+func egressDestination(host string, port int) string {
+	if port == 0 {
+		return host
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
@@ -0,0 +1,235 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("check-egress Command", func() {
+	var (
+		cmd             CheckEgressCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCheckEgressActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCheckEgressActor)
+
+		cmd = CheckEgressCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			RequiredArgs: flag.CheckEgressArgs{
+				AppName:     "some-app",
+				Destination: flag.EgressDestination{Host: "10.0.1.5", Port: 443},
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{Name: "some-app"}, v2action.Warnings{"app-warning"}, nil)
+		fakeActor.CheckSpaceEgressReturns(v2action.EgressCheck{
+			IP: "10.0.1.5",
+			Rules: []v2action.EgressRuleCheck{
+				{
+					SecurityGroupRule: v2action.SecurityGroupRule{Name: "dns", Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+					Global:            true,
+					Mismatch:          v2action.EgressRuleProtocolMismatch,
+				},
+				{
+					SecurityGroupRule: v2action.SecurityGroupRule{Name: "private", Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+					Mismatch:          v2action.EgressRuleDestinationMismatch,
+				},
+				{
+					SecurityGroupRule: v2action.SecurityGroupRule{Name: "private", Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80"},
+					Mismatch:          v2action.EgressRulePortsMismatch,
+				},
+				{
+					SecurityGroupRule: v2action.SecurityGroupRule{Name: "web", Protocol: "all", Destination: "10.0.1.5"},
+				},
+			},
+		}, v2action.Warnings{"egress-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the protocol is tcp and no port is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Destination.Port = 0
+		})
+
+		It("returns an EgressPortRequiredError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.EgressPortRequiredError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the protocol is icmp and a port is provided", func() {
+		BeforeEach(func() {
+			cmd.Protocol.Protocol = "icmp"
+		})
+
+		It("returns an EgressPortNotAllowedError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.EgressPortNotAllowedError{}))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
+	When("the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.CheckSpaceEgressCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking the egress fails", func() {
+		BeforeEach(func() {
+			fakeActor.CheckSpaceEgressReturns(v2action.EgressCheck{}, v2action.Warnings{"egress-warning"}, actionerror.EgressDestinationNotResolvedError{Destination: "10.0.1.5"})
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.EgressDestinationNotResolvedError{Destination: "10.0.1.5"}))
+			Expect(testUI.Err).To(Say("egress-warning"))
+		})
+	})
+
+	It("checks the running egress of the app's space and displays each rule", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.CheckSpaceEgressCallCount()).To(Equal(1))
+		spaceGUID, destination, port, protocol, lifecycle := fakeActor.CheckSpaceEgressArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(destination).To(Equal("10.0.1.5"))
+		Expect(port).To(Equal(443))
+		Expect(protocol).To(Equal("tcp"))
+		Expect(lifecycle).To(Equal(constant.SecurityGroupLifecycleRunning))
+
+		Expect(testUI.Out).To(Say(`Checking running tcp egress from app some-app in org some-org / space some-space to 10\.0\.1\.5:443 as some-user\.\.\.`))
+		Expect(testUI.Out).ToNot(Say("Resolved"))
+		Expect(testUI.Out).To(Say(`security group\s+scope\s+protocol\s+destination\s+ports\s+result`))
+		Expect(testUI.Out).To(Say(`dns\s+global\s+udp\s+0\.0\.0\.0/0\s+53\s+protocol does not match`))
+		Expect(testUI.Out).To(Say(`private\s+space\s+tcp\s+10\.0\.0\.0/24\s+443\s+destination does not match`))
+		Expect(testUI.Out).To(Say(`private\s+space\s+tcp\s+10\.0\.1\.0/24\s+80\s+ports do not match`))
+		Expect(testUI.Out).To(Say(`web\s+space\s+all\s+10\.0\.1\.5\s+allows`))
+		Expect(testUI.Out).To(Say(`tcp egress to 10\.0\.1\.5:443 is allowed by security group web\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(testUI.Err).To(Say("app-warning"))
+		Expect(testUI.Err).To(Say("egress-warning"))
+	})
+
+	When("no rule allows the destination", func() {
+		BeforeEach(func() {
+			fakeActor.CheckSpaceEgressReturns(v2action.EgressCheck{
+				IP: "10.0.1.5",
+				Rules: []v2action.EgressRuleCheck{
+					{
+						SecurityGroupRule: v2action.SecurityGroupRule{Name: "dns", Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"},
+						Global:            true,
+						Mismatch:          v2action.EgressRuleProtocolMismatch,
+					},
+				},
+			}, nil, nil)
+		})
+
+		It("reports that egress is denied", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`tcp egress to 10\.0\.1\.5:443 is denied: no security group rule allows it\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the destination is a hostname", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Destination = flag.EgressDestination{Host: "db.example.com", Port: 5432}
+		})
+
+		It("displays the resolved IP address", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`to db\.example\.com:5432 as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`Resolved db\.example\.com to 10\.0\.1\.5\.`))
+			Expect(testUI.Out).To(Say(`tcp egress to 10\.0\.1\.5:5432 is allowed by security group web\.`))
+		})
+	})
+
+	When("--staging and --protocol icmp are passed", func() {
+		BeforeEach(func() {
+			cmd.Staging = true
+			cmd.Protocol.Protocol = "icmp"
+			cmd.RequiredArgs.Destination.Port = 0
+		})
+
+		It("checks the staging icmp egress", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, port, protocol, lifecycle := fakeActor.CheckSpaceEgressArgsForCall(0)
+			Expect(port).To(Equal(0))
+			Expect(protocol).To(Equal("icmp"))
+			Expect(lifecycle).To(Equal(constant.SecurityGroupLifecycleStaging))
+
+			Expect(testUI.Out).To(Say(`Checking staging icmp egress from app some-app in org some-org / space some-space to 10\.0\.1\.5 as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`icmp egress to 10\.0\.1\.5 is allowed by security group web\.`))
+		})
+	})
+})
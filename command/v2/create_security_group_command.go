@@ -1,21 +1,80 @@
 package v2
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
 )
 
+//go:generate counterfeiter . CreateSecurityGroupActor
+
+type CreateSecurityGroupActor interface {
+	CreateSecurityGroup(name string, rules []securitygroupfile.Rule) (v2action.SecurityGroup, v2action.Warnings, error)
+}
+
 type CreateSecurityGroupCommand struct {
 	RequiredArgs    flag.SecurityGroupArgs `positional-args:"yes"`
 	usage           interface{}            `usage:"CF_NAME create-security-group SECURITY_GROUP PATH_TO_JSON_RULES_FILE\n\n   The provided path can be an absolute or relative path to a file.  The file should have\n   a single array with JSON objects inside describing the rules.  The JSON Base Object is\n   omitted and only the square brackets and associated child object are required in the file.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]"`
 	relatedCommands interface{}            `related_commands:"bind-security-group, bind-running-security-group, bind-staging-security-group, security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateSecurityGroupActor
 }
 
-func (CreateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *CreateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (CreateSecurityGroupCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd CreateSecurityGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	rules, err := shared.ReadSecurityGroupRules(cmd.UI, string(cmd.RequiredArgs.PathToJsonRules))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating security group {{.SecurityGroupName}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+		"Username":          user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.CreateSecurityGroup(cmd.RequiredArgs.SecurityGroup, rules)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.SecurityGroupNameTakenError); ok {
+			cmd.UI.DisplayOK()
+			cmd.UI.DisplayWarning("Security group {{.SecurityGroupName}} already exists", map[string]interface{}{
+				"SecurityGroupName": cmd.RequiredArgs.SecurityGroup,
+			})
+			return nil
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
 }
@@ -0,0 +1,186 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-security-group Command", func() {
+	var (
+		cmd             CreateSecurityGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateSecurityGroupActor
+		binaryName      string
+		rulesPath       string
+		executeErr      error
+	)
+
+	writeRules := func(contents string) {
+		Expect(ioutil.WriteFile(rulesPath, []byte(contents), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateSecurityGroupActor)
+
+		rulesFile, err := ioutil.TempFile("", "create-security-group")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rulesFile.Close()).To(Succeed())
+		rulesPath = rulesFile.Name()
+		writeRules(`[{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "443"}]`)
+
+		cmd = CreateSecurityGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			RequiredArgs: flag.SecurityGroupArgs{
+				SecurityGroup:   "some-security-group",
+				PathToJsonRules: flag.PathWithExistenceCheck(rulesPath),
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(rulesPath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("getting the current user fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
+	When("the rules file is not valid JSON", func() {
+		BeforeEach(func() {
+			writeRules(`{"protocol": "tcp"}`)
+		})
+
+		It("returns an InvalidJSONError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(securitygroupfile.InvalidJSONError{}))
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a rule is invalid", func() {
+		BeforeEach(func() {
+			writeRules(`[
+				{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "443"},
+				{"protocol": "tcp", "destination": "10.0.0.0/33", "ports": "443"}
+			]`)
+		})
+
+		It("displays the problem and returns a SecurityGroupFileInvalidRulesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SecurityGroupFileInvalidRulesError{Path: rulesPath}))
+			Expect(testUI.Err).To(Say("Rule 2: destination must be IP addresses, CIDRs or ranges of IP addresses"))
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a rule is valid but probably not intended", func() {
+		BeforeEach(func() {
+			writeRules(`[
+				{"protocol": "tcp", "destination": "10.0.0.0/16", "ports": "1-1000"},
+				{"protocol": "tcp", "destination": "10.0.1.0/24", "ports": "443"}
+			]`)
+		})
+
+		It("displays the warning and creates the security group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Rule 2 is already allowed by rule 1"))
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(1))
+		})
+	})
+
+	When("the security group is created", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSecurityGroupReturns(v2action.SecurityGroup{GUID: "some-guid"}, v2action.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates the security group with the rules and displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreateSecurityGroupCallCount()).To(Equal(1))
+			name, rules := fakeActor.CreateSecurityGroupArgsForCall(0)
+			Expect(name).To(Equal("some-security-group"))
+			Expect(rules).To(Equal([]securitygroupfile.Rule{
+				{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+			}))
+
+			Expect(testUI.Out).To(Say(`Creating security group some-security-group as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+
+	When("the security group already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSecurityGroupReturns(v2action.SecurityGroup{}, v2action.Warnings{"create-warning"}, actionerror.SecurityGroupNameTakenError{Name: "some-security-group"})
+		})
+
+		It("displays OK and a warning", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Err).To(Say("Security group some-security-group already exists"))
+		})
+	})
+
+	When("creating the security group fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateSecurityGroupReturns(v2action.SecurityGroup{}, v2action.Warnings{"create-warning"}, errors.New("create-error"))
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError("create-error"))
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
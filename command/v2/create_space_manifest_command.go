@@ -0,0 +1,120 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+//go:generate counterfeiter . CreateSpaceManifestActor
+
+type CreateSpaceManifestActor interface {
+	CreateApplicationManifestsBySpace(spaceGUID string) ([]manifest.Application, v2v3action.Warnings, error)
+	WriteApplicationManifests(manifestApps []manifest.Application, manifestPath string) error
+	WriteParameterizedApplicationManifests(manifestApps []manifest.Application, orgGUID string, manifestPath string, varsFilePath string) (v2v3action.Warnings, error)
+}
+
+type CreateSpaceManifestCommand struct {
+	FilePath        flag.Path   `short:"p" description:"Specify a path for file creation. If path not specified, manifest file is created in current working directory."`
+	VarsFile        flag.Path   `long:"vars-file" description:"Replace environment-specific values (route domains and instance counts) with ((variables)) and write their values to this file"`
+	usage           interface{} `usage:"CF_NAME create-space-manifest [-p /path/to/<space-name>_manifest.yml] [--vars-file /path/to/vars.yml]\n\n   Creates a manifest for every app in the targeted space, including their routes,\n   services, environment variables, health checks, buildpacks and docker images.\n\nEXAMPLES:\n   CF_NAME create-space-manifest\n   CF_NAME create-space-manifest -p manifest.yml --vars-file production-vars.yml\n   CF_NAME push -f manifest.yml --vars-file staging-vars.yml"`
+	relatedCommands interface{} `related_commands:"apps, create-app-manifest, push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateSpaceManifestActor
+}
+
+func (cmd *CreateSpaceManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClientV3, uaaClientV3, err := sharedV3.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumCFAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionApplicationFlowV3}
+		}
+		return err
+	}
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	v2Actor := v2action.NewActor(ccClientV2, uaaClientV2, config)
+	v3Actor := v3action.NewActor(ccClientV3, config, sharedActor, uaaClientV3)
+	cmd.Actor = v2v3action.NewActor(v2Actor, v3Actor)
+
+	return nil
+}
+
+func (cmd CreateSpaceManifestCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating a manifest from current settings of all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	manifestPath := cmd.FilePath.String()
+	if manifestPath == "" {
+		manifestPath = fmt.Sprintf(".%s%s_manifest.yml", string(os.PathSeparator), cmd.Config.TargetedSpace().Name)
+	}
+
+	manifestApps, warnings, err := cmd.Actor.CreateApplicationManifestsBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(manifestApps) == 0 {
+		cmd.UI.DisplayText("No apps found.")
+		return nil
+	}
+
+	if cmd.VarsFile == "" {
+		err = cmd.Actor.WriteApplicationManifests(manifestApps, manifestPath)
+	} else {
+		warnings, err = cmd.Actor.WriteParameterizedApplicationManifests(manifestApps, cmd.Config.TargetedOrganization().GUID, manifestPath, cmd.VarsFile.String())
+		cmd.UI.DisplayWarnings(warnings)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Manifest file created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": manifestPath,
+	})
+	if cmd.VarsFile != "" {
+		cmd.UI.DisplayText("Vars file created successfully at {{.FilePath}}", map[string]interface{}{
+			"FilePath": cmd.VarsFile.String(),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package v2_test
+
+import (
+	"errors"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-space-manifest Command", func() {
+	var (
+		cmd             CreateSpaceManifestCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeCreateSpaceManifestActor
+		binaryName      string
+		manifestApps    []manifest.Application
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeCreateSpaceManifestActor)
+
+		cmd = CreateSpaceManifestCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.FilePath = flag.Path("some-file-path")
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		manifestApps = []manifest.Application{{Name: "api"}, {Name: "worker"}}
+		fakeActor.CreateApplicationManifestsBySpaceReturns(manifestApps, v2v3action.Warnings{"some-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("creating the manifest errors", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationManifestsBySpaceReturns(nil, v2v3action.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error, prints warnings", func() {
+			Expect(testUI.Out).To(Say(`Creating a manifest from current settings of all apps in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(fakeActor.WriteApplicationManifestsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no apps in the space", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationManifestsBySpaceReturns(nil, nil, nil)
+		})
+
+		It("does not write a manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps found."))
+			Expect(fakeActor.WriteApplicationManifestsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("creating the manifest succeeds", func() {
+		It("writes the manifest for all the apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Manifest file created successfully at some-file-path"))
+
+			Expect(fakeActor.CreateApplicationManifestsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.WriteApplicationManifestsCallCount()).To(Equal(1))
+			appsArg, pathArg := fakeActor.WriteApplicationManifestsArgsForCall(0)
+			Expect(appsArg).To(Equal(manifestApps))
+			Expect(pathArg).To(Equal("some-file-path"))
+			Expect(fakeActor.WriteParameterizedApplicationManifestsCallCount()).To(Equal(0))
+		})
+
+		When("no filepath is provided", func() {
+			BeforeEach(func() {
+				cmd.FilePath = ""
+			})
+
+			It("writes the manifest named after the space in the current directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Manifest file created successfully at %s", regexp.QuoteMeta("."+filepath.FromSlash("/some-space_manifest.yml"))))
+
+				_, pathArg := fakeActor.WriteApplicationManifestsArgsForCall(0)
+				Expect(pathArg).To(Equal("." + filepath.FromSlash("/some-space_manifest.yml")))
+			})
+		})
+
+		When("writing the manifest fails", func() {
+			BeforeEach(func() {
+				fakeActor.WriteApplicationManifestsReturns(errors.New("some-write-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-write-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		When("--vars-file is provided", func() {
+			BeforeEach(func() {
+				cmd.VarsFile = flag.Path("some-vars-path")
+				fakeActor.WriteParameterizedApplicationManifestsReturns(v2v3action.Warnings{"domains-warning"}, nil)
+			})
+
+			It("writes a parameterized manifest and its vars file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("domains-warning"))
+				Expect(testUI.Out).To(Say("Manifest file created successfully at some-file-path"))
+				Expect(testUI.Out).To(Say("Vars file created successfully at some-vars-path"))
+
+				Expect(fakeActor.WriteApplicationManifestsCallCount()).To(Equal(0))
+				appsArg, orgGUIDArg, pathArg, varsPathArg := fakeActor.WriteParameterizedApplicationManifestsArgsForCall(0)
+				Expect(appsArg).To(Equal(manifestApps))
+				Expect(orgGUIDArg).To(Equal("some-org-guid"))
+				Expect(pathArg).To(Equal("some-file-path"))
+				Expect(varsPathArg).To(Equal("some-vars-path"))
+			})
+
+			When("writing the parameterized manifest fails", func() {
+				BeforeEach(func() {
+					fakeActor.WriteParameterizedApplicationManifestsReturns(v2v3action.Warnings{"domains-warning"}, errors.New("some-write-error"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError("some-write-error"))
+					Expect(testUI.Err).To(Say("domains-warning"))
+				})
+			})
+		})
+	})
+})
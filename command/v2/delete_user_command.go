@@ -1,22 +1,91 @@
 package v2
 
 import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . DeleteUserActor
+
+type DeleteUserActor interface {
+	DeleteUser(username string, origin string) (v2action.Warnings, error)
+}
+
 type DeleteUserCommand struct {
 	RequiredArgs    flag.Username `positional-args:"yes"`
 	Force           bool          `short:"f" description:"Force deletion without confirmation"`
-	usage           interface{}   `usage:"CF_NAME delete-user USERNAME [-f]"`
-	relatedCommands interface{}   `related_commands:"org-users"`
+	Origin          string        `long:"origin" description:"Origin of the user, required when the username exists in more than one origin"`
+	usage           interface{}   `usage:"CF_NAME delete-user USERNAME [-f] [--origin ORIGIN]"`
+	relatedCommands interface{}   `related_commands:"org-users, users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteUserActor
 }
 
-func (DeleteUserCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *DeleteUserCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (DeleteUserCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd DeleteUserCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		deleteUser, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the user {{.TargetUser}}?", map[string]interface{}{
+			"TargetUser": cmd.RequiredArgs.Username,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteUser {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting user {{.TargetUser}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"CurrentUser": user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteUser(cmd.RequiredArgs.Username, cmd.Origin)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.UAAUserNotFoundError); !ok {
+			return err
+		}
+
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayWarning("User {{.TargetUser}} does not exist.", map[string]interface{}{
+			"TargetUser": cmd.RequiredArgs.Username,
+		})
+		return nil
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
 }
@@ -0,0 +1,123 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-user Command", func() {
+	var (
+		cmd             DeleteUserCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDeleteUserActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDeleteUserActor)
+
+		cmd = DeleteUserCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "some-user"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-admin"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.DeleteUserCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the deletion is not confirmed", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the user", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really delete the user some-user\?`))
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeActor.DeleteUserCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the deletion is forced", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			cmd.Origin = "ldap"
+		})
+
+		When("the user is deleted", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteUserReturns(v2action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the user from the origin", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				username, origin := fakeActor.DeleteUserArgsForCall(0)
+				Expect(username).To(Equal("some-user"))
+				Expect(origin).To(Equal("ldap"))
+
+				Expect(testUI.Out).To(Say(`Deleting user some-user as some-admin\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+			})
+		})
+
+		When("the user does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteUserReturns(nil, actionerror.UAAUserNotFoundError{Username: "some-user"})
+			})
+
+			It("displays OK and a warning", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say(`User some-user does not exist\.`))
+			})
+		})
+
+		When("deleting the user fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteUserReturns(v2action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package v2
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . GroupsActor
+
+type GroupsActor interface {
+	GetUAAGroups(filter string) ([]v2action.UAAGroup, error)
+}
+
+type GroupsCommand struct {
+	Filter          string      `long:"filter" description:"Only list groups matching a SCIM filter"`
+	usage           interface{} `usage:"CF_NAME groups [--filter FILTER]\n\nEXAMPLES:\n   CF_NAME groups\n   CF_NAME groups --filter 'displayName sw \"cloud_controller.\"'"`
+	relatedCommands interface{} `related_commands:"add-group-member, remove-group-member, users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       GroupsActor
+}
+
+func (cmd *GroupsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd GroupsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting groups as {{.CurrentUser}}...", map[string]interface{}{
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	groups, err := cmd.Actor.GetUAAGroups(cmd.Filter)
+	if err != nil {
+		return err
+	}
+
+	if len(groups) == 0 {
+		cmd.UI.DisplayText("No groups found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("members"),
+		},
+	}
+	for _, group := range groups {
+		table = append(table, []string{group.DisplayName, strconv.Itoa(len(group.MemberIDs))})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("groups Command", func() {
+	var (
+		cmd             GroupsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeGroupsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeGroupsActor)
+
+		cmd = GroupsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-admin"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("there are no groups", func() {
+		It("displays that there are no groups", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting groups as some-admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`No groups found\.`))
+		})
+	})
+
+	When("there are groups", func() {
+		BeforeEach(func() {
+			cmd.Filter = `displayName sw "cloud_controller."`
+			fakeActor.GetUAAGroupsReturns([]v2action.UAAGroup{
+				{DisplayName: "cloud_controller.admin", MemberIDs: []string{"user-1", "user-2"}},
+				{DisplayName: "cloud_controller.read"},
+			}, nil)
+		})
+
+		It("displays the groups and their number of members", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetUAAGroupsArgsForCall(0)).To(Equal(`displayName sw "cloud_controller."`))
+
+			Expect(testUI.Out).To(Say(`name\s+members`))
+			Expect(testUI.Out).To(Say(`cloud_controller\.admin\s+2`))
+			Expect(testUI.Out).To(Say(`cloud_controller\.read\s+0`))
+		})
+	})
+
+	When("getting the groups fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetUAAGroupsReturns(nil, errors.New("get-groups-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("get-groups-error"))
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . LockUserActor
+
+type LockUserActor interface {
+	LockUser(username string, origin string) error
+	UAAAPIVersion() string
+}
+
+type LockUserCommand struct {
+	RequiredArgs    flag.Username `positional-args:"yes"`
+	Origin          string        `long:"origin" description:"Origin of the user, required when the username exists in more than one origin"`
+	usage           interface{}   `usage:"CF_NAME lock-user USERNAME [--origin ORIGIN]"`
+	relatedCommands interface{}   `related_commands:"unlock-user, users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       LockUserActor
+}
+
+func (cmd *LockUserCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd LockUserCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumUAAAPIVersionCheck(cmd.Actor.UAAAPIVersion(), uaaversion.MinVersionUserAccountStatus)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Locking user {{.TargetUser}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"CurrentUser": user.Name,
+	})
+
+	err = cmd.Actor.LockUser(cmd.RequiredArgs.Username, cmd.Origin)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -542,6 +542,49 @@ var _ = Describe("push Command", func() {
 													}))
 												})
 											})
+
+											Context("vars env and vars dir", func() {
+												var varsDir string
+
+												BeforeEach(func() {
+													varsDir = filepath.Join(tmpDir, "vars-dir")
+													Expect(os.Mkdir(varsDir, 0755)).To(Succeed())
+													Expect(ioutil.WriteFile(filepath.Join(varsDir, "password"), []byte("some-password\n"), 0600)).To(Succeed())
+													cmd.VarsDirs = []flag.PathWithExistenceCheck{flag.PathWithExistenceCheck(varsDir)}
+
+													varsEnv := template.VarsEnvArg{EnvironFunc: func() []string {
+														return []string{"APP_instances=2", "APP_password=env-password"}
+													}}
+													Expect(varsEnv.UnmarshalFlag("APP")).To(Succeed())
+													cmd.VarsEnv = []template.VarsEnvArg{varsEnv}
+
+													cmd.Vars = []template.VarKV{{Name: "instances", Value: "3"}}
+												})
+
+												It("passes the variables to ReadManifest with the vars flag taking precedence", func() {
+													Expect(executeErr).ToNot(HaveOccurred())
+
+													Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
+													_, _, vars := fakeActor.ReadManifestArgsForCall(0)
+													Expect(vars).To(Equal([]template.VarKV{
+														{Name: "password", Value: "some-password"},
+														{Name: "instances", Value: 2},
+														{Name: "password", Value: "env-password"},
+														{Name: "instances", Value: "3"},
+													}))
+												})
+
+												When("the vars dir cannot be read", func() {
+													BeforeEach(func() {
+														Expect(os.RemoveAll(varsDir)).To(Succeed())
+													})
+
+													It("returns the error without reading the manifest", func() {
+														Expect(os.IsNotExist(executeErr)).To(BeTrue())
+														Expect(fakeActor.ReadManifestCallCount()).To(Equal(0))
+													})
+												})
+											})
 										})
 									})
 								})
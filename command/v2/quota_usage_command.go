@@ -0,0 +1,162 @@
+package v2
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . QuotaUsageActor
+
+type QuotaUsageActor interface {
+	GetOrganizationQuotaUsage(orgName string) (v2action.OrganizationQuotaUsage, v2action.Warnings, error)
+}
+
+type QuotaUsageCommand struct {
+	RequiredArgs    flag.OptionalOrganization `positional-args:"yes"`
+	Spaces          bool                      `long:"spaces" description:"Also show the usage of each space in the org"`
+	Threshold       flag.Percentage           `long:"threshold" default:"80" description:"Highlight usage at or above this percentage of a limit (1-100)"`
+	usage           interface{}               `usage:"CF_NAME quota-usage [ORG] [--spaces] [--threshold PERCENT]\n\n   Shows the memory, app instances, routes, service instances and reserved\n   route ports used by started apps against the org quota, and with --spaces\n   against each space quota. Defaults to the targeted org.\n\nEXAMPLES:\n   CF_NAME quota-usage\n   CF_NAME quota-usage my-org --spaces --threshold 90"`
+	relatedCommands interface{}               `related_commands:"org, quota, quotas, space-quota"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       QuotaUsageActor
+}
+
+func (cmd *QuotaUsageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd QuotaUsageCommand) Execute(args []string) error {
+	orgName := cmd.RequiredArgs.Organization
+
+	err := cmd.SharedActor.CheckTarget(orgName == "", false)
+	if err != nil {
+		return err
+	}
+
+	if orgName == "" {
+		orgName = cmd.Config.TargetedOrganization().Name
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting quota usage for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  orgName,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	usage, warnings, err := cmd.Actor.GetOrganizationQuotaUsage(orgName)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	anyHighlighted := cmd.displayUsageTable("org", []v2action.QuotaUsage{usage.QuotaUsage})
+
+	if cmd.Spaces && len(usage.Spaces) > 0 {
+		cmd.UI.DisplayNewline()
+		if cmd.displayUsageTable("space", usage.Spaces) {
+			anyHighlighted = true
+		}
+	}
+
+	if anyHighlighted {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Highlighted usage is at or above {{.Threshold}}% of its limit.", map[string]interface{}{
+			"Threshold": cmd.Threshold.Value,
+		})
+	}
+
+	return nil
+}
+
+// displayUsageTable displays one row per usage and returns whether any cell
+// was highlighted.
+func (cmd QuotaUsageCommand) displayUsageTable(nameHeader string, usages []v2action.QuotaUsage) bool {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText(nameHeader),
+			cmd.UI.TranslateText("quota"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("app instances"),
+			cmd.UI.TranslateText("routes"),
+			cmd.UI.TranslateText("service instances"),
+			cmd.UI.TranslateText("reserved route ports"),
+		},
+	}
+	var highlighted [][]bool
+	anyHighlighted := false
+
+	for _, usage := range usages {
+		cells := []struct {
+			used, limit int
+			unit        string
+		}{
+			{usage.Memory, usage.MemoryLimit, "M"},
+			{usage.AppInstances, usage.AppInstanceLimit, ""},
+			{usage.Routes, usage.RouteLimit, ""},
+			{usage.ServiceInstances, usage.ServiceInstanceLimit, ""},
+			{usage.ReservedRoutePorts, usage.ReservedRoutePortLimit, ""},
+		}
+
+		row := []string{usage.Name, usage.QuotaName}
+		rowHighlighted := []bool{false, false}
+		for _, cell := range cells {
+			row = append(row, cmd.usageText(cell.used, cell.limit, cell.unit))
+			highlight := cmd.overThreshold(cell.used, cell.limit)
+			rowHighlighted = append(rowHighlighted, highlight)
+			anyHighlighted = anyHighlighted || highlight
+		}
+
+		table = append(table, row)
+		highlighted = append(highlighted, rowHighlighted)
+	}
+
+	cmd.UI.DisplayQuotaUsageTable(table, highlighted)
+	return anyHighlighted
+}
+
+func (cmd QuotaUsageCommand) usageText(used int, limit int, unit string) string {
+	if limit < 0 {
+		return fmt.Sprintf("%d%s / %s", used, unit, cmd.UI.TranslateText("unlimited"))
+	}
+	if limit == 0 {
+		return fmt.Sprintf("%d%s / %d%s", used, unit, limit, unit)
+	}
+	return fmt.Sprintf("%d%s / %d%s (%d%%)", used, unit, limit, unit, used*100/limit)
+}
+
+// overThreshold returns whether used is at or above the threshold percentage
+// of limit. Unlimited limits are never reached, and a limit of 0 only counts
+// as reached once something is used.
+func (cmd QuotaUsageCommand) overThreshold(used int, limit int) bool {
+	switch {
+	case limit < 0:
+		return false
+	case limit == 0:
+		return used > 0
+	default:
+		return used*100 >= cmd.Threshold.Value*limit
+	}
+}
@@ -0,0 +1,177 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("quota-usage Command", func() {
+	var (
+		cmd             QuotaUsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeQuotaUsageActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeQuotaUsageActor)
+
+		cmd = QuotaUsageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Threshold:   flag.Percentage{Value: 80},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org"})
+
+		fakeActor.GetOrganizationQuotaUsageReturns(
+			v2action.OrganizationQuotaUsage{
+				QuotaUsage: v2action.QuotaUsage{
+					Name:                   "targeted-org",
+					QuotaName:              "default",
+					Memory:                 900,
+					MemoryLimit:            1000,
+					AppInstances:           3,
+					AppInstanceLimit:       -1,
+					Routes:                 2,
+					RouteLimit:             10,
+					ServiceInstances:       1,
+					ServiceInstanceLimit:   0,
+					ReservedRoutePorts:     0,
+					ReservedRoutePortLimit: 0,
+				},
+				Spaces: []v2action.QuotaUsage{
+					{
+						Name:                   "dev",
+						Memory:                 100,
+						MemoryLimit:            -1,
+						AppInstances:           1,
+						AppInstanceLimit:       -1,
+						RouteLimit:             -1,
+						ServiceInstanceLimit:   -1,
+						ReservedRoutePortLimit: -1,
+					},
+				},
+			},
+			v2action.Warnings{"usage-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeActor.GetOrganizationQuotaUsageCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no org is provided", func() {
+		It("uses the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeFalse())
+			Expect(fakeActor.GetOrganizationQuotaUsageArgsForCall(0)).To(Equal("targeted-org"))
+		})
+
+		It("displays the org usage, highlighting usage above the threshold", func() {
+			Expect(testUI.Out).To(Say("Getting quota usage for org targeted-org as some-user..."))
+			Expect(testUI.Out).To(Say(`org\s+quota\s+memory\s+app instances\s+routes\s+service instances\s+reserved route ports`))
+			Expect(testUI.Out).To(Say(`targeted-org\s+default\s+900M / 1000M \(%d%%\)\s+3 / unlimited\s+2 / 10 \(%d%%\)\s+1 / 0\s+0 / 0`, 90, 20))
+			Expect(testUI.Out).To(Say(`Highlighted usage is at or above %d%% of its limit\.`, 80))
+			Expect(testUI.Out).ToNot(Say("space"))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+	})
+
+	When("an org is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Organization = "other-org"
+		})
+
+		It("does not require a targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeFalse())
+			Expect(fakeActor.GetOrganizationQuotaUsageArgsForCall(0)).To(Equal("other-org"))
+			Expect(testUI.Out).To(Say("Getting quota usage for org other-org as some-user..."))
+		})
+	})
+
+	When("--spaces is provided", func() {
+		BeforeEach(func() {
+			cmd.Spaces = true
+		})
+
+		It("also displays the usage of each space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`targeted-org\s+default`))
+			Expect(testUI.Out).To(Say(`space\s+quota\s+memory`))
+			Expect(testUI.Out).To(Say(`dev\s+100M / unlimited\s+1 / unlimited\s+0 / unlimited\s+0 / unlimited\s+0 / unlimited`))
+		})
+	})
+
+	When("nothing is at the threshold", func() {
+		BeforeEach(func() {
+			cmd.Threshold = flag.Percentage{Value: 95}
+			fakeActor.GetOrganizationQuotaUsageReturns(
+				v2action.OrganizationQuotaUsage{
+					QuotaUsage: v2action.QuotaUsage{Name: "targeted-org", Memory: 900, MemoryLimit: 1000},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("does not display the legend", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Highlighted"))
+		})
+	})
+
+	When("getting the quota usage fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get usage failed")
+			fakeActor.GetOrganizationQuotaUsageReturns(v2action.OrganizationQuotaUsage{}, v2action.Warnings{"usage-warning"}, expectedErr)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+	})
+})
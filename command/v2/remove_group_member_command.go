@@ -0,0 +1,67 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . RemoveGroupMemberActor
+
+type RemoveGroupMemberActor interface {
+	RemoveUserFromUAAGroup(username string, origin string, groupName string) error
+}
+
+type RemoveGroupMemberCommand struct {
+	RequiredArgs    flag.GroupMember `positional-args:"yes"`
+	Origin          string           `long:"origin" description:"Origin of the user, required when the username exists in more than one origin"`
+	usage           interface{}      `usage:"CF_NAME remove-group-member GROUP USERNAME [--origin ORIGIN]"`
+	relatedCommands interface{}      `related_commands:"add-group-member, groups, users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RemoveGroupMemberActor
+}
+
+func (cmd *RemoveGroupMemberCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd RemoveGroupMemberCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Removing user {{.TargetUser}} from group {{.Group}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"Group":       cmd.RequiredArgs.Group,
+		"CurrentUser": user.Name,
+	})
+
+	err = cmd.Actor.RemoveUserFromUAAGroup(cmd.RequiredArgs.Username, cmd.Origin, cmd.RequiredArgs.Group)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,80 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("remove-group-member Command", func() {
+	var (
+		cmd             RemoveGroupMemberCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRemoveGroupMemberActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRemoveGroupMemberActor)
+
+		cmd = RemoveGroupMemberCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Group = "some-group"
+		cmd.RequiredArgs.Username = "some-user"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-admin"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("the user is removed", func() {
+		It("removes the user from the group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			username, origin, group := fakeActor.RemoveUserFromUAAGroupArgsForCall(0)
+			Expect(username).To(Equal("some-user"))
+			Expect(origin).To(BeEmpty())
+			Expect(group).To(Equal("some-group"))
+
+			Expect(testUI.Out).To(Say(`Removing user some-user from group some-group as some-admin\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the user exists in multiple origins", func() {
+		BeforeEach(func() {
+			fakeActor.RemoveUserFromUAAGroupReturns(actionerror.MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.MultipleUAAUsersFoundError{Username: "some-user", Origins: []string{"uaa", "ldap"}}))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . ResetUserPasswordActor
+
+type ResetUserPasswordActor interface {
+	ResetUserPassword(username string, origin string, password string) error
+}
+
+type ResetUserPasswordCommand struct {
+	RequiredArgs    flag.Username `positional-args:"yes"`
+	Origin          string        `long:"origin" description:"Origin of the user, required when the username exists in more than one origin"`
+	usage           interface{}   `usage:"CF_NAME reset-user-password USERNAME [--origin ORIGIN]"`
+	relatedCommands interface{}   `related_commands:"passwd, unlock-user, users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ResetUserPasswordActor
+}
+
+func (cmd *ResetUserPasswordCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ResetUserPasswordCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	password, err := cmd.UI.DisplayPasswordPrompt("New password")
+	if err != nil {
+		return err
+	}
+
+	verification, err := cmd.UI.DisplayPasswordPrompt("Verify password")
+	if err != nil {
+		return err
+	}
+
+	if password != verification {
+		return translatableerror.PasswordVerificationError{}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Resetting password of user {{.TargetUser}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TargetUser":  cmd.RequiredArgs.Username,
+		"CurrentUser": user.Name,
+	})
+
+	err = cmd.Actor.ResetUserPassword(cmd.RequiredArgs.Username, cmd.Origin, password)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
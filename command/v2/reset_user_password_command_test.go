@@ -0,0 +1,103 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("reset-user-password Command", func() {
+	var (
+		cmd             ResetUserPasswordCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeResetUserPasswordActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeResetUserPasswordActor)
+
+		cmd = ResetUserPasswordCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "some-user"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-admin"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("the passwords match", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("new-password\nnew-password\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("resets the password", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			username, origin, password := fakeActor.ResetUserPasswordArgsForCall(0)
+			Expect(username).To(Equal("some-user"))
+			Expect(origin).To(BeEmpty())
+			Expect(password).To(Equal("new-password"))
+
+			Expect(testUI.Out).To(Say("New password"))
+			Expect(testUI.Out).To(Say("Verify password"))
+			Expect(testUI.Out).To(Say(`Resetting password of user some-user as some-admin\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("resetting the password fails", func() {
+			BeforeEach(func() {
+				fakeActor.ResetUserPasswordReturns(errors.New("reset-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("reset-error"))
+			})
+		})
+	})
+
+	When("the passwords do not match", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("new-password\nother-password\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns a PasswordVerificationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PasswordVerificationError{}))
+			Expect(fakeActor.ResetUserPasswordCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,45 @@
+package shared
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// ManifestVariables combines the variables read from the --vars-dir
+// directories and --vars-env prefixes with the --var flags. Later sources
+// take precedence, so a --var overrides an environment variable, which
+// overrides a file in a vars directory. Vars files are applied before all of
+// them when the manifest is interpolated.
+func ManifestVariables(varsDirs []string, varsEnv []template.VarsEnvArg, vars []template.VarKV) ([]template.VarKV, error) {
+	var variables []template.VarKV
+
+	for _, dir := range varsDirs {
+		dirVariables, err := manifest.ReadVarsDirectory(dir)
+		if err != nil {
+			return nil, err
+		}
+		variables = append(variables, sortedVarKVs(dirVariables)...)
+	}
+
+	for _, env := range varsEnv {
+		variables = append(variables, sortedVarKVs(env.Vars)...)
+	}
+
+	return append(variables, vars...), nil
+}
+
+func sortedVarKVs(variables template.StaticVariables) []template.VarKV {
+	var names []string
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var kvs []template.VarKV
+	for _, name := range names {
+		kvs = append(kvs, template.VarKV{Name: name, Value: variables[name]})
+	}
+	return kvs
+}
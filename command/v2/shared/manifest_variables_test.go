@@ -0,0 +1,69 @@
+package shared_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ManifestVariables", func() {
+	var (
+		varsDir string
+		varsEnv []template.VarsEnvArg
+		vars    []template.VarKV
+
+		variables  []template.VarKV
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		var err error
+		varsDir, err = ioutil.TempDir("", "manifest-variables-test")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(filepath.Join(varsDir, "password"), []byte("from-dir\n"), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(varsDir, "instances"), []byte("1"), 0600)).To(Succeed())
+
+		varsEnv = []template.VarsEnvArg{{
+			EnvironFunc: func() []string {
+				return []string{"APP_instances=2", "APP_domain=example.com", "OTHER_instances=5"}
+			},
+		}}
+		Expect(varsEnv[0].UnmarshalFlag("APP")).To(Succeed())
+
+		vars = []template.VarKV{{Name: "domain", Value: "from-var.com"}}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(varsDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		variables, executeErr = ManifestVariables([]string{varsDir}, varsEnv, vars)
+	})
+
+	It("orders the variables so that environment variables override directories and --var overrides both", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(variables).To(Equal([]template.VarKV{
+			{Name: "instances", Value: "1"},
+			{Name: "password", Value: "from-dir"},
+			{Name: "domain", Value: "example.com"},
+			{Name: "instances", Value: 2},
+			{Name: "domain", Value: "from-var.com"},
+		}))
+	})
+
+	When("a vars directory cannot be read", func() {
+		BeforeEach(func() {
+			Expect(os.RemoveAll(varsDir)).To(Succeed())
+		})
+
+		It("returns the error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,90 @@
+package shared
+
+import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+var securityGroupRuleProblemTemplates = map[securitygroupfile.ProblemKind]string{
+	securitygroupfile.InvalidProtocol:          "Rule {{.Index}}: protocol must be tcp, udp, icmp or all",
+	securitygroupfile.InvalidDestination:       "Rule {{.Index}}: destination must be IP addresses, CIDRs or ranges of IP addresses",
+	securitygroupfile.InvalidPorts:             "Rule {{.Index}}: ports must be ports or ranges of ports between 1 and 65535, such as 80,443 or 8080-8090",
+	securitygroupfile.MissingPorts:             "Rule {{.Index}}: ports are required for the tcp and udp protocols",
+	securitygroupfile.PortsNotAllowed:          "Rule {{.Index}}: ports are only allowed for the tcp and udp protocols",
+	securitygroupfile.MissingICMPTypeOrCode:    "Rule {{.Index}}: type and code are required for the icmp protocol",
+	securitygroupfile.ICMPTypeOrCodeNotAllowed: "Rule {{.Index}}: type and code are only allowed for the icmp protocol",
+	securitygroupfile.BroadRule:                "Rule {{.Index}} allows traffic to every destination on every port",
+	securitygroupfile.ShadowedRule:             "Rule {{.Index}} is already allowed by rule {{.OtherIndex}}",
+	securitygroupfile.OverlappingRule:          "Rule {{.Index}} overlaps rule {{.OtherIndex}}",
+}
+
+// ReadSecurityGroupRules reads and lints the security group rules file,
+// displaying each problem found as a warning. It returns an error when any
+// rule is invalid.
+func ReadSecurityGroupRules(commandUI command.UI, path string) ([]securitygroupfile.Rule, error) {
+	rules, err := securitygroupfile.ReadRules(path)
+	if err != nil {
+		return nil, err
+	}
+
+	invalid := false
+	for _, problem := range securitygroupfile.Lint(rules) {
+		commandUI.DisplayWarning(securityGroupRuleProblemTemplates[problem.Kind], map[string]interface{}{
+			"Index":      problem.Index,
+			"OtherIndex": problem.OtherIndex,
+		})
+		if problem.IsError() {
+			invalid = true
+		}
+	}
+
+	if invalid {
+		return nil, translatableerror.SecurityGroupFileInvalidRulesError{Path: path}
+	}
+	return rules, nil
+}
+
+// GetSecurityGroupRuleChanges returns the removed and added rules as a single
+// change, so that they are displayed as a diff.
+func GetSecurityGroupRuleChanges(changes v2action.SecurityGroupRuleChanges) []ui.Change {
+	return []ui.Change{
+		{
+			Header:       "rules:",
+			CurrentValue: securityGroupRuleTexts(changes.Removed),
+			NewValue:     securityGroupRuleTexts(changes.Added),
+		},
+	}
+}
+
+func securityGroupRuleTexts(rules []securitygroupfile.Rule) []string {
+	texts := []string{}
+	for _, rule := range rules {
+		fields := []string{
+			"protocol: " + rule.Protocol,
+			"destination: " + rule.Destination,
+		}
+		if rule.Ports != "" {
+			fields = append(fields, "ports: "+rule.Ports)
+		}
+		if rule.Type.IsSet {
+			fields = append(fields, "type: "+strconv.Itoa(rule.Type.Value))
+		}
+		if rule.Code.IsSet {
+			fields = append(fields, "code: "+strconv.Itoa(rule.Code.Value))
+		}
+		if rule.Log.IsSet {
+			fields = append(fields, "log: "+strconv.FormatBool(rule.Log.Value))
+		}
+		if rule.Description != "" {
+			fields = append(fields, "description: "+rule.Description)
+		}
+		texts = append(texts, strings.Join(fields, ", "))
+	}
+	return texts
+}
@@ -0,0 +1,38 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetSecurityGroupRuleChanges", func() {
+	It("returns the removed and added rules as a single change", func() {
+		changes := GetSecurityGroupRuleChanges(v2action.SecurityGroupRuleChanges{
+			Added: []securitygroupfile.Rule{
+				{
+					Protocol:    "icmp",
+					Destination: "10.0.0.0/8",
+					Type:        types.NullInt{IsSet: true, Value: 0},
+					Code:        types.NullInt{IsSet: true, Value: -1},
+					Log:         types.NullBool{IsSet: true, Value: true},
+				},
+			},
+			Removed: []securitygroupfile.Rule{
+				{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80,443", Description: "web"},
+			},
+		})
+
+		Expect(changes).To(Equal([]ui.Change{
+			{
+				Header:       "rules:",
+				CurrentValue: []string{"protocol: tcp, destination: 10.0.0.0/24, ports: 80,443, description: web"},
+				NewValue:     []string{"protocol: icmp, destination: 10.0.0.0/8, type: 0, code: -1, log: true"},
+			},
+		}))
+	})
+})
@@ -59,7 +59,10 @@ func (cmd *StartCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.ApplicationSummaryActor = v2v3action.NewActor(v2Actor, v3Actor)
 
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
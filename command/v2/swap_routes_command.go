@@ -0,0 +1,96 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . SwapRoutesActor
+
+type SwapRoutesActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	SwapApplicationRoutes(oldApp v2action.Application, newApp v2action.Application) (v2action.Routes, v2action.Warnings, error)
+}
+
+type SwapRoutesCommand struct {
+	RequiredArgs        flag.SwapRoutesArgs `positional-args:"yes"`
+	usage               interface{}         `usage:"CF_NAME swap-routes OLD_APP NEW_APP\n\n   The routes of OLD_APP are mapped to NEW_APP. Once all instances of NEW_APP are running, the routes are unmapped from OLD_APP. If any step fails, the route mappings are reverted."`
+	relatedCommands     interface{}         `related_commands:"map-route, routes, unmap-route"`
+	envCFStartupTimeout interface{}         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for all instances of NEW_APP to be running, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SwapRoutesActor
+}
+
+func (cmd *SwapRoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd SwapRoutesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Swapping routes from app {{.OldAppName}} to app {{.NewAppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"OldAppName":  cmd.RequiredArgs.OldAppName,
+		"NewAppName":  cmd.RequiredArgs.NewAppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	})
+
+	oldApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.OldAppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	newApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.NewAppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	routes, warnings, err := cmd.Actor.SwapApplicationRoutes(oldApp, newApp)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(routes) == 0 {
+		cmd.UI.DisplayText("App {{.AppName}} has no routes to swap.", map[string]interface{}{
+			"AppName": oldApp.Name,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("routes:"), routes.Summary()},
+	}, 3)
+
+	return nil
+}
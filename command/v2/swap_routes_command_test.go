@@ -0,0 +1,150 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("swap-routes Command", func() {
+	var (
+		cmd             v2.SwapRoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSwapRoutesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSwapRoutesActor)
+
+		cmd = v2.SwapRoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.OldAppName = "blue"
+		cmd.RequiredArgs.NewAppName = "green"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+			fakeActor.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+				return v2action.Application{GUID: name + "-guid", Name: name}, v2action.Warnings{"get-" + name + "-warning"}, nil
+			}
+		})
+
+		When("the routes are swapped", func() {
+			BeforeEach(func() {
+				fakeActor.SwapApplicationRoutesReturns(
+					v2action.Routes{
+						{Host: "www", Domain: v2action.Domain{Name: "example.com"}},
+						{Host: "api", Domain: v2action.Domain{Name: "example.com"}},
+					},
+					v2action.Warnings{"swap-warning"},
+					nil)
+			})
+
+			It("displays the swapped routes and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Swapping routes from app blue to app green in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`routes:\s+www.example.com, api.example.com`))
+
+				Expect(testUI.Err).To(Say("get-blue-warning"))
+				Expect(testUI.Err).To(Say("get-green-warning"))
+				Expect(testUI.Err).To(Say("swap-warning"))
+
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(2))
+				appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("blue"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.SwapApplicationRoutesCallCount()).To(Equal(1))
+				oldApp, newApp := fakeActor.SwapApplicationRoutesArgsForCall(0)
+				Expect(oldApp.GUID).To(Equal("blue-guid"))
+				Expect(newApp.GUID).To(Equal("green-guid"))
+			})
+		})
+
+		When("the old app has no routes", func() {
+			BeforeEach(func() {
+				fakeActor.SwapApplicationRoutesReturns(nil, nil, nil)
+			})
+
+			It("tells the user there is nothing to swap", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("App blue has no routes to swap."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("the new app cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(1, v2action.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "green"})
+			})
+
+			It("returns the error without swapping", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "green"}))
+				Expect(fakeActor.SwapApplicationRoutesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("swapping the routes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = actionerror.RouteSwapError{Err: errors.New("map-error")}
+				fakeActor.SwapApplicationRoutesReturns(nil, v2action.Warnings{"swap-warning"}, expectedErr)
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("swap-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -0,0 +1,206 @@
+package v2
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/buildpackfile"
+	"code.cloudfoundry.org/cli/util/download"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . SyncBuildpacksActor
+
+type SyncBuildpacksActor interface {
+	GetBuildpackSyncChanges(desired []buildpackfile.Buildpack, deleteUnlisted bool, tmpDirPath string, downloader v2action.Downloader) ([]v2action.BuildpackSyncChange, v2action.Warnings, error)
+	ApplyBuildpackSyncChange(change v2action.BuildpackSyncChange, progBar v2action.SimpleProgressBar) (v2action.Warnings, error)
+}
+
+type SyncBuildpacksCommand struct {
+	FilePath        flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to the buildpacks file"`
+	DeleteUnlisted  bool                        `long:"delete-unlisted" description:"Delete buildpacks that are not listed in the buildpacks file"`
+	DryRun          bool                        `long:"dry-run" description:"Show the changes without making them"`
+	Force           bool                        `long:"force" description:"Force deletion of unlisted buildpacks without confirmation"`
+	usage           interface{}                 `usage:"CF_NAME sync-buildpacks -f BUILDPACKS_FILE [--delete-unlisted [--force]] [--dry-run]\n\n   The buildpacks file lists the desired buildpacks:\n\n   buildpacks:\n   - name: ruby_buildpack\n     stack: cflinuxfs3\n     position: 1\n     enabled: true\n     locked: false\n     path: https://example.com/ruby_buildpack-cflinuxfs3-v1.7.zip\n\n   Path is a zip file, a URL to a zip file, or a local directory. Position\n   defaults to the buildpack's place in the file. Enabled and locked are left\n   unchanged when they are not provided. Bits are only uploaded when they\n   differ from the last bits uploaded by this command.\n\nEXAMPLES:\n   CF_NAME sync-buildpacks -f buildpacks.yml --dry-run"`
+	relatedCommands interface{}                 `related_commands:"buildpacks, create-buildpack, delete-buildpack, update-buildpack"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SyncBuildpacksActor
+	ProgressBar v2action.SimpleProgressBar
+}
+
+func (cmd *SyncBuildpacksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+	cmd.ProgressBar = v2action.NewProgressBar()
+
+	return nil
+}
+
+func (cmd SyncBuildpacksCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	buildpacks, err := buildpackfile.ReadBuildpacks(string(cmd.FilePath))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Syncing buildpacks from {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"Path":     cmd.FilePath,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	tmpDirPath, err := ioutil.TempDir("", "buildpack-dir-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDirPath)
+
+	changes, warnings, err := cmd.Actor.GetBuildpackSyncChanges(buildpacks, cmd.DeleteUnlisted, tmpDirPath, download.NewDownloader(time.Second*30))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.displayChanges(changes)
+
+	if cmd.DryRun {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run, no changes were made.")
+		return nil
+	}
+
+	if deletions := countDeletions(changes); deletions > 0 && !cmd.Force {
+		cmd.UI.DisplayNewline()
+		deleteUnlisted, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete {{.Count}} buildpacks not listed in {{.Path}}?", map[string]interface{}{
+			"Count": deletions,
+			"Path":  cmd.FilePath,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteUnlisted {
+			cmd.UI.DisplayText("Buildpacks have not been synced.")
+			return nil
+		}
+	}
+
+	for _, change := range changes {
+		if change.Unchanged() {
+			continue
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("{{.Action}} buildpack {{.Name}}...", map[string]interface{}{
+			"Action": cmd.progressText(change),
+			"Name":   change.Buildpack.Name,
+		})
+
+		warnings, err = cmd.Actor.ApplyBuildpackSyncChange(change, cmd.ProgressBar)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func countDeletions(changes []v2action.BuildpackSyncChange) int {
+	count := 0
+	for _, change := range changes {
+		if change.Delete {
+			count++
+		}
+	}
+	return count
+}
+
+func (cmd SyncBuildpacksCommand) displayChanges(changes []v2action.BuildpackSyncChange) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("position"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("stack"),
+			cmd.UI.TranslateText("action"),
+		},
+	}
+
+	for _, change := range changes {
+		var position string
+		if change.Buildpack.Position.IsSet {
+			position = strconv.Itoa(change.Buildpack.Position.Value)
+		}
+
+		table = append(table, []string{
+			position,
+			change.Buildpack.Name,
+			change.Buildpack.Stack,
+			cmd.actionText(change),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd SyncBuildpacksCommand) actionText(change v2action.BuildpackSyncChange) string {
+	var actions []string
+	switch {
+	case change.Delete:
+		actions = append(actions, cmd.UI.TranslateText("delete"))
+	case change.Create:
+		actions = append(actions, cmd.UI.TranslateText("create"))
+	case change.Update:
+		actions = append(actions, cmd.UI.TranslateText("update"))
+	}
+	if change.Upload {
+		actions = append(actions, cmd.UI.TranslateText("upload"))
+	}
+
+	if len(actions) == 0 {
+		return cmd.UI.TranslateText("none")
+	}
+	return strings.Join(actions, ", ")
+}
+
+func (cmd SyncBuildpacksCommand) progressText(change v2action.BuildpackSyncChange) string {
+	switch {
+	case change.Delete:
+		return cmd.UI.TranslateText("Deleting")
+	case change.Create:
+		return cmd.UI.TranslateText("Creating")
+	case change.Update:
+		return cmd.UI.TranslateText("Updating")
+	default:
+		return cmd.UI.TranslateText("Uploading")
+	}
+}
@@ -0,0 +1,250 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/buildpackfile"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/fileerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+var _ = Describe("SyncBuildpacksCommand", func() {
+	var (
+		cmd             SyncBuildpacksCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSyncBuildpacksActor
+		fakeProgressBar *v2actionfakes.FakeSimpleProgressBar
+		tmpDir          string
+		binaryName      string
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSyncBuildpacksActor)
+		fakeProgressBar = new(v2actionfakes.FakeSimpleProgressBar)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "sync-buildpacks")
+		Expect(err).ToNot(HaveOccurred())
+		pathToFile := filepath.Join(tmpDir, "buildpacks.yml")
+		Expect(ioutil.WriteFile(pathToFile, []byte("buildpacks:\n- name: some-buildpack\n  path: some-buildpack.zip\n"), 0600)).To(Succeed())
+
+		cmd = SyncBuildpacksCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+			FilePath:    flag.PathWithExistenceCheck(pathToFile),
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrgArg, checkTargetedSpaceArg := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrgArg).To(BeFalse())
+			Expect(checkTargetedSpaceArg).To(BeFalse())
+		})
+	})
+
+	When("the buildpacks file is invalid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(string(cmd.FilePath), []byte("buildpacks:\n- path: some-buildpack.zip\n"), 0600)).To(Succeed())
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(fileerror.MissingFieldError{Kind: buildpackfile.Kind, Index: 1, Field: "name"}))
+			Expect(fakeActor.GetBuildpackSyncChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the changes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetBuildpackSyncChangesReturns(nil, v2action.Warnings{"get-warning"}, errors.New("get-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-error"))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+
+	When("there are changes", func() {
+		var changes []v2action.BuildpackSyncChange
+
+		BeforeEach(func() {
+			changes = []v2action.BuildpackSyncChange{
+				{
+					Buildpack: v2action.Buildpack{Name: "old-buildpack", Position: types.NullInt{IsSet: true, Value: 4}},
+					Delete:    true,
+				},
+				{
+					Buildpack: v2action.Buildpack{Name: "same-buildpack", Stack: "some-stack", Position: types.NullInt{IsSet: true, Value: 1}},
+				},
+				{
+					Buildpack: v2action.Buildpack{Name: "some-buildpack", Position: types.NullInt{IsSet: true, Value: 2}},
+					Update:    true,
+					Upload:    true,
+				},
+			}
+			fakeActor.GetBuildpackSyncChangesReturns(changes, v2action.Warnings{"get-warning"}, nil)
+			fakeActor.ApplyBuildpackSyncChangeReturns(v2action.Warnings{"apply-warning"}, nil)
+
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("displays the changes, confirms the deletions and applies them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Syncing buildpacks from .*buildpacks\.yml as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`position\s+name\s+stack\s+action`))
+			Expect(testUI.Out).To(Say(`4\s+old-buildpack\s+delete`))
+			Expect(testUI.Out).To(Say(`1\s+same-buildpack\s+some-stack\s+none`))
+			Expect(testUI.Out).To(Say(`2\s+some-buildpack\s+update, upload`))
+			Expect(testUI.Out).To(Say(`Really delete 1 buildpacks not listed in .*buildpacks\.yml\?`))
+			Expect(testUI.Out).To(Say(`Deleting buildpack old-buildpack\.\.\.`))
+			Expect(testUI.Out).To(Say(`Updating buildpack some-buildpack\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+
+			Expect(fakeActor.GetBuildpackSyncChangesCallCount()).To(Equal(1))
+			desired, deleteUnlisted, tmpDirPath, _ := fakeActor.GetBuildpackSyncChangesArgsForCall(0)
+			Expect(desired).To(Equal([]buildpackfile.Buildpack{{Name: "some-buildpack", Position: 1, Path: "some-buildpack.zip"}}))
+			Expect(deleteUnlisted).To(BeFalse())
+			Expect(tmpDirPath).ToNot(BeEmpty())
+
+			Expect(fakeActor.ApplyBuildpackSyncChangeCallCount()).To(Equal(2))
+			change, progressBar := fakeActor.ApplyBuildpackSyncChangeArgsForCall(0)
+			Expect(change).To(Equal(changes[0]))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+			change, _ = fakeActor.ApplyBuildpackSyncChangeArgsForCall(1)
+			Expect(change).To(Equal(changes[2]))
+		})
+
+		When("--delete-unlisted is provided", func() {
+			BeforeEach(func() {
+				cmd.DeleteUnlisted = true
+			})
+
+			It("passes it to the actor", func() {
+				_, deleteUnlisted, _, _ := fakeActor.GetBuildpackSyncChangesArgsForCall(0)
+				Expect(deleteUnlisted).To(BeTrue())
+			})
+		})
+
+		When("the user does not confirm the deletions", func() {
+			BeforeEach(func() {
+				input = NewBuffer()
+				testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+				cmd.UI = testUI
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not apply any changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Buildpacks have not been synced."))
+				Expect(fakeActor.ApplyBuildpackSyncChangeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--force is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+				input = NewBuffer()
+				testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+				cmd.UI = testUI
+			})
+
+			It("applies the changes without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Really delete"))
+				Expect(fakeActor.ApplyBuildpackSyncChangeCallCount()).To(Equal(2))
+			})
+		})
+
+		When("no buildpacks are deleted", func() {
+			BeforeEach(func() {
+				fakeActor.GetBuildpackSyncChangesReturns(changes[1:], nil, nil)
+				input = NewBuffer()
+				testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+				cmd.UI = testUI
+			})
+
+			It("applies the changes without prompting", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Really delete"))
+				Expect(fakeActor.ApplyBuildpackSyncChangeCallCount()).To(Equal(1))
+			})
+		})
+
+		When("--dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the changes without applying them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`2\s+some-buildpack\s+update, upload`))
+				Expect(testUI.Out).To(Say("Dry run, no changes were made."))
+				Expect(fakeActor.ApplyBuildpackSyncChangeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("applying a change fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyBuildpackSyncChangeReturns(v2action.Warnings{"apply-warning"}, errors.New("apply-error"))
+			})
+
+			It("returns the error and stops", func() {
+				Expect(executeErr).To(MatchError("apply-error"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+				Expect(fakeActor.ApplyBuildpackSyncChangeCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,97 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unlock-user Command", func() {
+	var (
+		cmd             UnlockUserCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUnlockUserActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUnlockUserActor)
+
+		cmd = UnlockUserCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Username = "some-user"
+		cmd.Origin = "ldap"
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-admin"}, nil)
+		fakeActor.UAAAPIVersionReturns(uaaversion.MinVersionUserAccountStatus)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	When("the UAA is older than the minimum version", func() {
+		BeforeEach(func() {
+			fakeActor.UAAAPIVersionReturns(uaaversion.MinUAAClientVersion)
+		})
+
+		It("returns a MinimumUAAAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumUAAAPIVersionNotMetError{
+				MinimumVersion: uaaversion.MinVersionUserAccountStatus,
+			}))
+			Expect(fakeActor.UnlockUserCallCount()).To(Equal(0))
+		})
+	})
+
+	When("unlocking the user succeeds", func() {
+		It("unlocks the user", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			username, origin := fakeActor.UnlockUserArgsForCall(0)
+			Expect(username).To(Equal("some-user"))
+			Expect(origin).To(Equal("ldap"))
+
+			Expect(testUI.Out).To(Say(`Unlocking user some-user as some-admin\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("unlocking the user fails", func() {
+		BeforeEach(func() {
+			fakeActor.UnlockUserReturns(errors.New("unlock-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("unlock-error"))
+		})
+	})
+})
@@ -1,21 +1,113 @@
 package v2
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
 )
 
+//go:generate counterfeiter . UpdateSecurityGroupActor
+
+type UpdateSecurityGroupActor interface {
+	GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
+	GetSecurityGroupRuleChanges(securityGroup v2action.SecurityGroup, rules []securitygroupfile.Rule) v2action.SecurityGroupRuleChanges
+	UpdateSecurityGroupRules(securityGroupGUID string, rules []securitygroupfile.Rule) (v2action.Warnings, error)
+}
+
 type UpdateSecurityGroupCommand struct {
 	RequiredArgs    flag.SecurityGroupArgs `positional-args:"yes"`
-	usage           interface{}            `usage:"CF_NAME update-security-group SECURITY_GROUP PATH_TO_JSON_RULES_FILE\n\n   The provided path can be an absolute or relative path to a file.\n   It should have a single array with JSON objects inside describing the rules.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
+	Force           bool                   `short:"f" description:"Force update without confirmation"`
+	usage           interface{}            `usage:"CF_NAME update-security-group SECURITY_GROUP PATH_TO_JSON_RULES_FILE [-f]\n\n   The provided path can be an absolute or relative path to a file.\n   It should have a single array with JSON objects inside describing the rules.\n\n   Valid json file example:\n   [\n     {\n       \"protocol\": \"tcp\",\n       \"destination\": \"10.0.11.0/24\",\n       \"ports\": \"80,443\",\n       \"description\": \"Allow http and https traffic from ZoneA\"\n     }\n   ]\n\nTIP: Changes will not apply to existing running applications until they are restarted."`
 	relatedCommands interface{}            `related_commands:"restage, security-groups"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UpdateSecurityGroupActor
 }
 
-func (UpdateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *UpdateSecurityGroupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (UpdateSecurityGroupCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd UpdateSecurityGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	rules, err := shared.ReadSecurityGroupRules(cmd.UI, string(cmd.RequiredArgs.PathToJsonRules))
+	if err != nil {
+		return err
+	}
+
+	securityGroup, warnings, err := cmd.Actor.GetSecurityGroupByName(cmd.RequiredArgs.SecurityGroup)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating security group {{.SecurityGroupName}} as {{.Username}}...", map[string]interface{}{
+		"SecurityGroupName": securityGroup.Name,
+		"Username":          user.Name,
+	})
+
+	changes := cmd.Actor.GetSecurityGroupRuleChanges(securityGroup, rules)
+	if changes.Unchanged() {
+		cmd.UI.DisplayText("No changes to security group rules.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	err = cmd.UI.DisplayChangesForPush(shared.GetSecurityGroupRuleChanges(changes))
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	if !cmd.Force {
+		update, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really update security group {{.SecurityGroupName}}?", map[string]interface{}{
+			"SecurityGroupName": securityGroup.Name,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !update {
+			cmd.UI.DisplayText("Security group {{.SecurityGroupName}} has not been updated.", map[string]interface{}{
+				"SecurityGroupName": securityGroup.Name,
+			})
+			return nil
+		}
+	}
+
+	warnings, err = cmd.Actor.UpdateSecurityGroupRules(securityGroup.GUID, rules)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Changes will not apply to existing running applications until they are restarted.")
+	return nil
 }
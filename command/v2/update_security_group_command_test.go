@@ -0,0 +1,201 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/securitygroupfile"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-security-group Command", func() {
+	var (
+		cmd             UpdateSecurityGroupCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUpdateSecurityGroupActor
+		binaryName      string
+		rulesPath       string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUpdateSecurityGroupActor)
+
+		rulesFile, err := ioutil.TempFile("", "update-security-group")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = rulesFile.WriteString(`[{"protocol": "tcp", "destination": "10.0.0.0/24", "ports": "443"}]`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rulesFile.Close()).To(Succeed())
+		rulesPath = rulesFile.Name()
+
+		cmd = UpdateSecurityGroupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			RequiredArgs: flag.SecurityGroupArgs{
+				SecurityGroup:   "some-security-group",
+				PathToJsonRules: flag.PathWithExistenceCheck(rulesPath),
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{GUID: "some-guid", Name: "some-security-group"}, v2action.Warnings{"get-warning"}, nil)
+		fakeActor.GetSecurityGroupRuleChangesReturns(v2action.SecurityGroupRuleChanges{
+			Added:   []securitygroupfile.Rule{{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"}},
+			Removed: []securitygroupfile.Rule{{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80", Description: "http"}},
+		})
+		fakeActor.UpdateSecurityGroupRulesReturns(v2action.Warnings{"update-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(rulesPath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("a rule is invalid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(rulesPath, []byte(`[{"protocol": "tcp", "destination": "10.0.0.0/24"}]`), 0600)).To(Succeed())
+		})
+
+		It("displays the problem and returns a SecurityGroupFileInvalidRulesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.SecurityGroupFileInvalidRulesError{Path: rulesPath}))
+			Expect(testUI.Err).To(Say("Rule 1: ports are required for the tcp and udp protocols"))
+			Expect(fakeActor.GetSecurityGroupByNameCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the security group does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{}, v2action.Warnings{"get-warning"}, actionerror.SecurityGroupNotFoundError{Name: "some-security-group"})
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.SecurityGroupNotFoundError{Name: "some-security-group"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the rules are unchanged", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecurityGroupRuleChangesReturns(v2action.SecurityGroupRuleChanges{})
+		})
+
+		It("does not update the security group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Updating security group some-security-group as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`No changes to security group rules\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user confirms the update", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("displays the diff and updates the security group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetSecurityGroupByNameArgsForCall(0)).To(Equal("some-security-group"))
+			securityGroup, rules := fakeActor.GetSecurityGroupRuleChangesArgsForCall(0)
+			Expect(securityGroup.GUID).To(Equal("some-guid"))
+			Expect(rules).To(Equal([]securitygroupfile.Rule{{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"}}))
+
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(1))
+			guid, rules := fakeActor.UpdateSecurityGroupRulesArgsForCall(0)
+			Expect(guid).To(Equal("some-guid"))
+			Expect(rules).To(Equal([]securitygroupfile.Rule{{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"}}))
+
+			Expect(testUI.Out).To(Say(`Updating security group some-security-group as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`rules:`))
+			Expect(testUI.Out).To(Say(`\+\s+protocol: tcp, destination: 10\.0\.0\.0/24, ports: 443`))
+			Expect(testUI.Out).To(Say(`-\s+protocol: tcp, destination: 10\.0\.0\.0/24, ports: 80, description: http`))
+			Expect(testUI.Out).To(Say(`Really update security group some-security-group\?`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: Changes will not apply to existing running applications until they are restarted."))
+
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(testUI.Err).To(Say("update-warning"))
+		})
+	})
+
+	When("the user declines the update", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not update the security group", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Security group some-security-group has not been updated\.`))
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("updates the security group without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Really update"))
+			Expect(fakeActor.UpdateSecurityGroupRulesCallCount()).To(Equal(1))
+		})
+
+		When("updating the security group fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateSecurityGroupRulesReturns(v2action.Warnings{"update-warning"}, errors.New("update-error"))
+			})
+
+			It("displays warnings and returns the error", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(testUI.Err).To(Say("update-warning"))
+			})
+		})
+	})
+})
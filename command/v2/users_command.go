@@ -0,0 +1,90 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . UsersActor
+
+type UsersActor interface {
+	GetUAAUsers(filter string) ([]v2action.UAAUser, error)
+}
+
+type UsersCommand struct {
+	Filter          string      `long:"filter" description:"Only list users matching a SCIM filter"`
+	usage           interface{} `usage:"CF_NAME users [--filter FILTER]\n\nEXAMPLES:\n   CF_NAME users\n   CF_NAME users --filter 'origin eq \"ldap\"'\n   CF_NAME users --filter 'userName co \"example.com\"'"`
+	relatedCommands interface{} `related_commands:"create-user, groups, lock-user, org-users, space-users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UsersActor
+}
+
+func (cmd *UsersCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd UsersCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting users as {{.CurrentUser}}...", map[string]interface{}{
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	users, err := cmd.Actor.GetUAAUsers(cmd.Filter)
+	if err != nil {
+		return err
+	}
+
+	if len(users) == 0 {
+		cmd.UI.DisplayText("No users found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("username"),
+			cmd.UI.TranslateText("origin"),
+			cmd.UI.TranslateText("status"),
+			cmd.UI.TranslateText("verified"),
+		},
+	}
+	for _, user := range users {
+		status := cmd.UI.TranslateText("active")
+		if !user.Active {
+			status = cmd.UI.TranslateText("locked")
+		}
+		verified := cmd.UI.TranslateText("no")
+		if user.Verified {
+			verified = cmd.UI.TranslateText("yes")
+		}
+		table = append(table, []string{user.Username, user.Origin, status, verified})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("users Command", func() {
+	var (
+		cmd             UsersCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeUsersActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeUsersActor)
+
+		cmd = UsersCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-admin"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("there are no users", func() {
+		It("displays that there are no users", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting users as some-admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`No users found\.`))
+		})
+	})
+
+	When("there are users", func() {
+		BeforeEach(func() {
+			cmd.Filter = `origin eq "ldap"`
+			fakeActor.GetUAAUsersReturns([]v2action.UAAUser{
+				{Username: "user-1", Origin: "uaa", Active: true, Verified: true},
+				{Username: "user-2", Origin: "ldap"},
+			}, nil)
+		})
+
+		It("displays the users matching the filter", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetUAAUsersArgsForCall(0)).To(Equal(`origin eq "ldap"`))
+
+			Expect(testUI.Out).To(Say(`Getting users as some-admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`username\s+origin\s+status\s+verified`))
+			Expect(testUI.Out).To(Say(`user-1\s+uaa\s+active\s+yes`))
+			Expect(testUI.Out).To(Say(`user-2\s+ldap\s+locked\s+no`))
+		})
+	})
+
+	When("getting the users fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetUAAUsersReturns(nil, errors.New("get-users-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("get-users-error"))
+		})
+	})
+})
@@ -0,0 +1,141 @@
+package v2
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+//go:generate counterfeiter . ValidateManifestActor
+
+type ValidateManifestActor interface {
+	ValidateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) (manifest.ManifestValidation, error)
+	ValidateManifestResources(apps []manifest.Application, orgGUID string, spaceGUID string) ([]manifest.ValidationIssue, v2action.Warnings, error)
+}
+
+type ValidateManifestCommand struct {
+	PathToManifest  flag.PathWithExistenceCheck   `short:"f" required:"true" description:"Path to manifest"`
+	VarsFilePaths   []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	Vars            []template.VarKV              `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	VarsEnv         []template.VarsEnvArg         `long:"vars-env" description:"Prefix of environment variables to use for variable substitution, (e.g., APP reads APP_name as name); can specify multiple times"`
+	VarsDirs        []flag.PathWithExistenceCheck `long:"vars-dir" description:"Path to a directory of variable substitution files, each named after its variable; can specify multiple times"`
+	Online          bool                          `long:"online" description:"Also check that the stacks, service instances and route domains exist in the targeted org and space"`
+	usage           interface{}                   `usage:"CF_NAME validate-manifest -f MANIFEST_PATH [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n   [--vars-env PREFIX]... [--vars-dir VARS_DIR_PATH]... [--online]\n\n   Checks every attribute of the applications in a manifest and reports each problem\n   with its line and column. The API is only contacted when --online is given.\n\nEXAMPLES:\n   CF_NAME validate-manifest -f manifest.yml\n   CF_NAME validate-manifest -f manifest.yml --vars-file staging-vars.yml --online\n   CF_NAME validate-manifest -f manifest.yml --vars-env APP --vars-dir /etc/secrets"`
+	relatedCommands interface{}                   `related_commands:"create-app-manifest, push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ValidateManifestActor
+}
+
+func (cmd *ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, cmd.Online)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
+	return nil
+}
+
+func (cmd ValidateManifestCommand) Execute(args []string) error {
+	pathToManifest := string(cmd.PathToManifest)
+
+	if cmd.Online {
+		err := cmd.SharedActor.CheckTarget(true, true)
+		if err != nil {
+			return err
+		}
+
+		user, err := cmd.Config.CurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Validating manifest {{.Path}} against org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"Path":      pathToManifest,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Validating manifest {{.Path}}...", map[string]interface{}{
+			"Path": pathToManifest,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	var pathsToVarsFiles []string
+	for _, path := range cmd.VarsFilePaths {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(path))
+	}
+
+	var varsDirs []string
+	for _, dir := range cmd.VarsDirs {
+		varsDirs = append(varsDirs, string(dir))
+	}
+
+	vars, err := shared.ManifestVariables(varsDirs, cmd.VarsEnv, cmd.Vars)
+	if err != nil {
+		return err
+	}
+
+	validation, err := cmd.Actor.ValidateManifest(pathToManifest, pathsToVarsFiles, vars)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Online {
+		if validation.Applications == nil {
+			cmd.UI.DisplayWarning("Skipping the online checks because the manifest could not be read.")
+		} else {
+			issues, warnings, resourcesErr := cmd.Actor.ValidateManifestResources(validation.Applications, cmd.Config.TargetedOrganization().GUID, cmd.Config.TargetedSpace().GUID)
+			cmd.UI.DisplayWarnings(warnings)
+			if resourcesErr != nil {
+				return resourcesErr
+			}
+			validation.AddIssues(issues...)
+		}
+	}
+
+	for _, issue := range validation.Issues {
+		cmd.UI.DisplayText("{{.Location}}: {{.Severity}}: {{.Message}}", map[string]interface{}{
+			"Location": issueLocation(pathToManifest, issue.Position),
+			"Severity": issue.Severity,
+			"Message":  issue.Message,
+		})
+	}
+
+	if errorCount := validation.ErrorCount(); errorCount > 0 {
+		return translatableerror.ManifestValidationFailedError{ErrorCount: errorCount}
+	}
+
+	if len(validation.Issues) > 0 {
+		cmd.UI.DisplayNewline()
+	}
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func issueLocation(pathToManifest string, position manifest.Position) string {
+	switch {
+	case position.Line == 0:
+		return pathToManifest
+	case position.Column == 0:
+		return fmt.Sprintf("%s:%d", pathToManifest, position.Line)
+	default:
+		return fmt.Sprintf("%s:%d:%d", pathToManifest, position.Line, position.Column)
+	}
+}
@@ -0,0 +1,213 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-manifest Command", func() {
+	var (
+		cmd             ValidateManifestCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeValidateManifestActor
+		binaryName      string
+		apps            []manifest.Application
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeValidateManifestActor)
+
+		cmd = ValidateManifestCommand{
+			UI:             testUI,
+			Config:         fakeConfig,
+			SharedActor:    fakeSharedActor,
+			Actor:          fakeActor,
+			PathToManifest: flag.PathWithExistenceCheck("manifest.yml"),
+			VarsFilePaths:  []flag.PathWithExistenceCheck{"vars.yml"},
+			Vars:           []template.VarKV{{Name: "instances", Value: "2"}},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		apps = []manifest.Application{{Name: "web"}}
+		fakeActor.ValidateManifestReturns(manifest.ManifestValidation{Applications: apps}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the manifest is valid", func() {
+		It("validates the manifest without contacting the API", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Validating manifest manifest\.yml\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			pathToManifest, pathsToVarsFiles, vars := fakeActor.ValidateManifestArgsForCall(0)
+			Expect(pathToManifest).To(Equal("manifest.yml"))
+			Expect(pathsToVarsFiles).To(Equal([]string{"vars.yml"}))
+			Expect(vars).To(Equal([]template.VarKV{{Name: "instances", Value: "2"}}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.ValidateManifestResourcesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("vars are given in environment variables", func() {
+		BeforeEach(func() {
+			varsEnv := template.VarsEnvArg{EnvironFunc: func() []string {
+				return []string{"APP_instances=4", "APP_domain=example.com"}
+			}}
+			Expect(varsEnv.UnmarshalFlag("APP")).To(Succeed())
+			cmd.VarsEnv = []template.VarsEnvArg{varsEnv}
+		})
+
+		It("passes them before the vars flags", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, vars := fakeActor.ValidateManifestArgsForCall(0)
+			Expect(vars).To(Equal([]template.VarKV{
+				{Name: "domain", Value: "example.com"},
+				{Name: "instances", Value: 4},
+				{Name: "instances", Value: "2"},
+			}))
+		})
+	})
+
+	When("a vars dir cannot be read", func() {
+		BeforeEach(func() {
+			cmd.VarsDirs = []flag.PathWithExistenceCheck{"/does/not/exist"}
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(HaveOccurred())
+			Expect(fakeActor.ValidateManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the manifest has issues", func() {
+		BeforeEach(func() {
+			fakeActor.ValidateManifestReturns(manifest.ManifestValidation{Issues: []manifest.ValidationIssue{
+				{Position: manifest.Position{Line: 1}, Severity: manifest.ValidationError, Message: "did not find expected key"},
+				{Position: manifest.Position{Line: 3, Column: 3}, Severity: manifest.ValidationError, Message: "memory 1GG is not a valid size"},
+				{Position: manifest.Position{Line: 5, Column: 3}, Severity: manifest.ValidationWarning, Message: "host is deprecated; use routes instead"},
+			}}, nil)
+		})
+
+		It("displays every issue with its position and returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestValidationFailedError{ErrorCount: 2}))
+			Expect(testUI.Out).To(Say("manifest.yml:1: error: did not find expected key"))
+			Expect(testUI.Out).To(Say("manifest.yml:3:3: error: memory 1GG is not a valid size"))
+			Expect(testUI.Out).To(Say("manifest.yml:5:3: warning: host is deprecated; use routes instead"))
+		})
+	})
+
+	When("the manifest only has warnings", func() {
+		BeforeEach(func() {
+			fakeActor.ValidateManifestReturns(manifest.ManifestValidation{Issues: []manifest.ValidationIssue{
+				{Severity: manifest.ValidationWarning, Message: "some warning"},
+			}}, nil)
+		})
+
+		It("displays the warnings and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("manifest.yml: warning: some warning"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the manifest cannot be read", func() {
+		BeforeEach(func() {
+			fakeActor.ValidateManifestReturns(manifest.ManifestValidation{}, errors.New("read error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("read error"))
+		})
+	})
+
+	When("--online is given", func() {
+		BeforeEach(func() {
+			cmd.Online = true
+			fakeActor.ValidateManifestResourcesReturns([]manifest.ValidationIssue{
+				{Path: "applications[0].stack", Severity: manifest.ValidationError, Message: "stack cflinuxfs9 does not exist"},
+			}, v2action.Warnings{"resources-warning"}, nil)
+		})
+
+		It("checks the resources in the targeted org and space", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestValidationFailedError{ErrorCount: 1}))
+			Expect(testUI.Out).To(Say(`Validating manifest manifest\.yml against org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("manifest.yml: error: stack cflinuxfs9 does not exist"))
+			Expect(testUI.Err).To(Say("resources-warning"))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			appsArg, orgGUID, spaceGUID := fakeActor.ValidateManifestResourcesArgsForCall(0)
+			Expect(appsArg).To(Equal(apps))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		When("checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+				Expect(fakeActor.ValidateManifestCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the manifest could not be read into applications", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateManifestReturns(manifest.ManifestValidation{Issues: []manifest.ValidationIssue{
+					{Severity: manifest.ValidationError, Message: "applications must be a list"},
+				}}, nil)
+			})
+
+			It("skips the online checks", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ManifestValidationFailedError{ErrorCount: 1}))
+				Expect(testUI.Err).To(Say("Skipping the online checks because the manifest could not be read."))
+				Expect(fakeActor.ValidateManifestResourcesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("checking the resources fails", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateManifestResourcesReturns(nil, v2action.Warnings{"resources-warning"}, errors.New("resources error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("resources error"))
+				Expect(testUI.Err).To(Say("resources-warning"))
+			})
+		})
+	})
+})
@@ -16,22 +16,32 @@ import (
 //go:generate counterfeiter . AddNetworkPolicyActor
 
 type AddNetworkPolicyActor interface {
-	AddNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	AddNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
 }
 
-type AddNetworkPolicyCommand struct {
-	RequiredArgs   flag.AddNetworkPolicyArgs `positional-args:"yes"`
-	DestinationApp string                    `long:"destination-app" required:"true" description:"Name of app to connect to"`
-	Port           flag.NetworkPort          `long:"port" description:"Port or range of ports for connection to destination app (Default: 8080)"`
-	Protocol       flag.NetworkProtocol      `long:"protocol" description:"Protocol to connect apps with (Default: tcp)"`
+//go:generate counterfeiter . NetworkPolicyMembershipActor
+
+type NetworkPolicyMembershipActor interface {
+	GetOrganizationByName(name string) (v3action.Organization, v3action.Warnings, error)
+	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (v3action.Space, v3action.Warnings, error)
+}
 
-	usage           interface{} `usage:"CF_NAME add-network-policy SOURCE_APP --destination-app DESTINATION_APP [(--protocol (tcp | udp) --port RANGE)]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090"`
+type AddNetworkPolicyCommand struct {
+	RequiredArgs     flag.AddNetworkPolicyArgs `positional-args:"yes"`
+	DestinationApp   string                    `long:"destination-app" required:"true" description:"Name of app to connect to"`
+	DestinationOrg   string                    `long:"destination-org" description:"The org of the destination app (Default: targeted org)"`
+	DestinationSpace string                    `long:"destination-space" description:"The space of the destination app (Default: targeted space)"`
+	Port             flag.NetworkPort          `long:"port" description:"Port or range of ports for connection to destination app (Default: 8080)"`
+	Protocol         flag.NetworkProtocol      `long:"protocol" description:"Protocol to connect apps with (Default: tcp)"`
+
+	usage           interface{} `usage:"CF_NAME add-network-policy SOURCE_APP --destination-app DESTINATION_APP [--destination-space DESTINATION_SPACE [--destination-org DESTINATION_ORG]] [(--protocol (tcp | udp) --port RANGE)]\n\nEXAMPLES:\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME add-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090\n   CF_NAME add-network-policy frontend --destination-app backend --destination-org backend-org --destination-space backend-space"`
 	relatedCommands interface{} `related_commands:"apps, network-policies"`
 
-	UI          command.UI
-	Config      command.Config
-	SharedActor command.SharedActor
-	Actor       AddNetworkPolicyActor
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	Actor           AddNetworkPolicyActor
+	MembershipActor NetworkPolicyMembershipActor
 }
 
 func (cmd *AddNetworkPolicyCommand) Setup(config command.Config, ui command.UI) error {
@@ -54,11 +64,16 @@ func (cmd *AddNetworkPolicyCommand) Setup(config command.Config, ui command.UI)
 		return err
 	}
 	cmd.Actor = cfnetworkingaction.NewActor(networkingClient, v3Actor)
+	cmd.MembershipActor = v3Actor
 
 	return nil
 }
 
 func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
+	if cmd.DestinationOrg != "" && cmd.DestinationSpace == "" {
+		return translatableerror.NetworkPolicyDestinationOrgWithoutSpaceError{}
+	}
+
 	switch {
 	case cmd.Protocol.Protocol != "" && cmd.Port.StartPort == 0 && cmd.Port.EndPort == 0:
 		return translatableerror.NetworkPolicyProtocolOrPortNotProvidedError{}
@@ -79,14 +94,33 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
-	cmd.UI.DisplayTextWithFlavor("Adding network policy to app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-		"SrcAppName": cmd.RequiredArgs.SourceApp,
-		"Org":        cmd.Config.TargetedOrganization().Name,
-		"Space":      cmd.Config.TargetedSpace().Name,
-		"User":       user.Name,
-	})
-
-	warnings, err := cmd.Actor.AddNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
+
+	if cmd.DestinationSpace == "" {
+		cmd.UI.DisplayTextWithFlavor("Adding network policy to app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName": cmd.RequiredArgs.SourceApp,
+			"Org":        cmd.Config.TargetedOrganization().Name,
+			"Space":      cmd.Config.TargetedSpace().Name,
+			"User":       user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Adding network policy from app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} to app {{.DstAppName}} in org {{.DstOrg}} / space {{.DstSpace}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName": cmd.RequiredArgs.SourceApp,
+			"Org":        cmd.Config.TargetedOrganization().Name,
+			"Space":      cmd.Config.TargetedSpace().Name,
+			"DstAppName": cmd.DestinationApp,
+			"DstOrg":     destinationOrgName(cmd.Config, cmd.DestinationOrg),
+			"DstSpace":   cmd.DestinationSpace,
+			"User":       user.Name,
+		})
+	}
+
+	destSpaceGUID, spaceWarnings, err := destinationSpaceGUID(cmd.Config, cmd.MembershipActor, cmd.DestinationOrg, cmd.DestinationSpace)
+	cmd.UI.DisplayWarnings(spaceWarnings)
+	if err != nil {
+		return err
+	}
+
+	warnings, err := cmd.Actor.AddNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, destSpaceGUID, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -95,3 +129,39 @@ func (cmd AddNetworkPolicyCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// destinationOrgName returns the name of the org of a network policy's
+// destination app, which defaults to the targeted org.
+func destinationOrgName(config command.Config, orgName string) string {
+	if orgName == "" {
+		return config.TargetedOrganization().Name
+	}
+	return orgName
+}
+
+// destinationSpaceGUID returns the GUID of the space of a network policy's
+// destination app. The space defaults to the targeted space, and is looked up
+// in the targeted org when no org is given.
+func destinationSpaceGUID(config command.Config, actor NetworkPolicyMembershipActor, orgName string, spaceName string) (string, v3action.Warnings, error) {
+	if spaceName == "" {
+		return config.TargetedSpace().GUID, nil, nil
+	}
+
+	var allWarnings v3action.Warnings
+	orgGUID := config.TargetedOrganization().GUID
+	if orgName != "" {
+		org, warnings, err := actor.GetOrganizationByName(orgName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return "", allWarnings, err
+		}
+		orgGUID = org.GUID
+	}
+
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+	return space.GUID, allWarnings, nil
+}
@@ -3,6 +3,7 @@ package v3_test
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -22,6 +23,7 @@ var _ = Describe("add-network-policy Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeAddNetworkPolicyActor
+		fakeMembership  *v3fakes.FakeNetworkPolicyMembershipActor
 		binaryName      string
 		executeErr      error
 		srcApp          string
@@ -34,18 +36,20 @@ var _ = Describe("add-network-policy Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeAddNetworkPolicyActor)
+		fakeMembership = new(v3fakes.FakeNetworkPolicyMembershipActor)
 
 		srcApp = "some-app"
 		destApp = "some-other-app"
 		protocol = "tcp"
 
 		cmd = AddNetworkPolicyCommand{
-			UI:             testUI,
-			Config:         fakeConfig,
-			SharedActor:    fakeSharedActor,
-			Actor:          fakeActor,
-			RequiredArgs:   flag.AddNetworkPolicyArgs{SourceApp: srcApp},
-			DestinationApp: destApp,
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			MembershipActor: fakeMembership,
+			RequiredArgs:    flag.AddNetworkPolicyArgs{SourceApp: srcApp},
+			DestinationApp:  destApp,
 		}
 
 		binaryName = "faceman"
@@ -75,7 +79,7 @@ var _ = Describe("add-network-policy Command", func() {
 		BeforeEach(func() {
 			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
-			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
 		})
 
 		When("protocol is specified but port is not", func() {
@@ -114,9 +118,10 @@ var _ = Describe("add-network-policy Command", func() {
 				It("displays OK when no error occurs", func() {
 					Expect(executeErr).ToNot(HaveOccurred())
 					Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(1))
-					passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
-					Expect(passedSpaceGuid).To(Equal("some-space-guid"))
+					passedSrcSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
+					Expect(passedSrcSpaceGuid).To(Equal("some-space-guid"))
 					Expect(passedSrcAppName).To(Equal("some-app"))
+					Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 					Expect(passedDestAppName).To(Equal("some-other-app"))
 					Expect(passedProtocol).To(Equal("tcp"))
 					Expect(passedStartPort).To(Equal(8080))
@@ -150,11 +155,96 @@ var _ = Describe("add-network-policy Command", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
 				Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(1))
-				_, _, _, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
+				_, _, _, _, passedProtocol, passedStartPort, passedEndPort := fakeActor.AddNetworkPolicyArgsForCall(0)
 				Expect(passedProtocol).To(Equal("tcp"))
 				Expect(passedStartPort).To(Equal(8080))
 				Expect(passedEndPort).To(Equal(8080))
 			})
 		})
+
+		When("a destination space is specified", func() {
+			BeforeEach(func() {
+				cmd.DestinationSpace = "some-destination-space"
+				fakeMembership.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "some-destination-space-guid"}, v3action.Warnings{"space-warning"}, nil)
+			})
+
+			It("creates the policy for the app in that space of the targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeMembership.GetOrganizationByNameCallCount()).To(Equal(0))
+				Expect(fakeMembership.GetSpaceByNameAndOrganizationCallCount()).To(Equal(1))
+				spaceName, orgGUID := fakeMembership.GetSpaceByNameAndOrganizationArgsForCall(0)
+				Expect(spaceName).To(Equal("some-destination-space"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+
+				Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(1))
+				passedSrcSpaceGuid, _, passedDestSpaceGuid, passedDestAppName, _, _, _ := fakeActor.AddNetworkPolicyArgsForCall(0)
+				Expect(passedSrcSpaceGuid).To(Equal("some-space-guid"))
+				Expect(passedDestSpaceGuid).To(Equal("some-destination-space-guid"))
+				Expect(passedDestAppName).To(Equal("some-other-app"))
+
+				Expect(testUI.Out).To(Say(`Adding network policy from app some-app in org some-org / space some-space to app some-other-app in org some-org / space some-destination-space as some-user\.\.\.`))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+
+			When("a destination org is specified", func() {
+				BeforeEach(func() {
+					cmd.DestinationOrg = "some-destination-org"
+					fakeMembership.GetOrganizationByNameReturns(v3action.Organization{GUID: "some-destination-org-guid"}, v3action.Warnings{"org-warning"}, nil)
+				})
+
+				It("creates the policy for the app in that org and space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(fakeMembership.GetOrganizationByNameCallCount()).To(Equal(1))
+					Expect(fakeMembership.GetOrganizationByNameArgsForCall(0)).To(Equal("some-destination-org"))
+					_, orgGUID := fakeMembership.GetSpaceByNameAndOrganizationArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-destination-org-guid"))
+
+					_, _, passedDestSpaceGuid, _, _, _, _ := fakeActor.AddNetworkPolicyArgsForCall(0)
+					Expect(passedDestSpaceGuid).To(Equal("some-destination-space-guid"))
+
+					Expect(testUI.Out).To(Say(`to app some-other-app in org some-destination-org / space some-destination-space as some-user`))
+					Expect(testUI.Err).To(Say("org-warning"))
+					Expect(testUI.Err).To(Say("space-warning"))
+				})
+
+				When("the org cannot be found", func() {
+					BeforeEach(func() {
+						fakeMembership.GetOrganizationByNameReturns(v3action.Organization{}, v3action.Warnings{"org-warning"}, actionerror.OrganizationNotFoundError{Name: "some-destination-org"})
+					})
+
+					It("returns the error without creating the policy", func() {
+						Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-destination-org"}))
+						Expect(testUI.Err).To(Say("org-warning"))
+						Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			When("the space cannot be found", func() {
+				BeforeEach(func() {
+					fakeMembership.GetSpaceByNameAndOrganizationReturns(v3action.Space{}, v3action.Warnings{"space-warning"}, actionerror.SpaceNotFoundError{Name: "some-destination-space"})
+				})
+
+				It("returns the error without creating the policy", func() {
+					Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-destination-space"}))
+					Expect(testUI.Err).To(Say("space-warning"))
+					Expect(fakeActor.AddNetworkPolicyCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("a destination org is specified without a destination space", func() {
+			BeforeEach(func() {
+				cmd.DestinationOrg = "some-destination-org"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NetworkPolicyDestinationOrgWithoutSpaceError{}))
+				Expect(testUI.Out).NotTo(Say(`Adding network policy`))
+			})
+		})
 	})
 })
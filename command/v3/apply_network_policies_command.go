@@ -0,0 +1,163 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/networkpolicyfile"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ApplyNetworkPoliciesActor
+
+type ApplyNetworkPoliciesActor interface {
+	GetNetworkPolicyChanges(spaceGUID string, desired []cfnetworkingaction.Policy) (cfnetworkingaction.NetworkPolicyChanges, cfnetworkingaction.Warnings, error)
+	ApplyNetworkPolicyChanges(changes cfnetworkingaction.NetworkPolicyChanges) error
+}
+
+type ApplyNetworkPoliciesCommand struct {
+	FilePath        flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to the network policies file"`
+	DryRun          bool                        `long:"dry-run" description:"Show the changes without making them"`
+	usage           interface{}                 `usage:"CF_NAME apply-network-policies -f POLICIES_FILE [--dry-run]\n\n   The policies file lists every desired policy from the apps in the targeted\n   space. Policies from these apps that are not listed are removed:\n\n   policies:\n   - source: frontend\n     destination: backend\n     protocol: tcp\n     ports: 8080-8090\n   - source: frontend\n     destination: auth\n     destination_space: auth-space\n     destination_org: auth-org\n\n   Protocol defaults to tcp and ports default to 8080. The destination space\n   and org default to the targeted space and org.\n\nEXAMPLES:\n   CF_NAME apply-network-policies -f policies.yml --dry-run"`
+	relatedCommands interface{}                 `related_commands:"add-network-policy, network-policies, remove-network-policy"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyNetworkPoliciesActor
+}
+
+func (cmd *ApplyNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	client, uaa, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.CFNetworkingEndpointNotFoundError{}
+		}
+
+		return err
+	}
+
+	v3Actor := v3action.NewActor(client, config, nil, nil)
+	networkingClient, err := shared.NewNetworkingClient(client.NetworkPolicyV1(), config, uaa, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = cfnetworkingaction.NewActor(networkingClient, v3Actor)
+
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	filePolicies, err := networkpolicyfile.ReadPolicies(string(cmd.FilePath))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying network policies from {{.Path}} to org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"Path":  cmd.FilePath,
+		"Org":   cmd.Config.TargetedOrganization().Name,
+		"Space": cmd.Config.TargetedSpace().Name,
+		"User":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	desired := make([]cfnetworkingaction.Policy, 0, len(filePolicies))
+	for _, policy := range filePolicies {
+		destinationSpace := policy.DestinationSpace
+		if destinationSpace == "" {
+			destinationSpace = cmd.Config.TargetedSpace().Name
+		}
+		desired = append(desired, cfnetworkingaction.Policy{
+			SourceName:           policy.Source,
+			DestinationName:      policy.Destination,
+			DestinationSpaceName: destinationSpace,
+			DestinationOrgName:   destinationOrgName(cmd.Config, policy.DestinationOrg),
+			Protocol:             policy.Protocol,
+			StartPort:            policy.StartPort,
+			EndPort:              policy.EndPort,
+		})
+	}
+
+	changes, warnings, err := cmd.Actor.GetNetworkPolicyChanges(cmd.Config.TargetedSpace().GUID, desired)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if changes.Unchanged() {
+		cmd.UI.DisplayText("Network policies are up to date.")
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.displayChanges(changes)
+
+	if cmd.DryRun {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run, no changes were made.")
+		return nil
+	}
+
+	err = cmd.Actor.ApplyNetworkPolicyChanges(changes)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) displayChanges(changes cfnetworkingaction.NetworkPolicyChanges) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("action"),
+			cmd.UI.TranslateText("source"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("destination space"),
+			cmd.UI.TranslateText("destination org"),
+		},
+	}
+
+	addRows := func(action string, policies []cfnetworkingaction.Policy) {
+		for _, policy := range policies {
+			table = append(table, []string{
+				action,
+				policy.SourceName,
+				policy.DestinationName,
+				policy.Protocol,
+				networkPolicyPorts(policy),
+				policy.DestinationSpaceName,
+				policy.DestinationOrgName,
+			})
+		}
+	}
+	addRows(cmd.UI.TranslateText("add"), changes.Add)
+	addRows(cmd.UI.TranslateText("remove"), changes.Remove)
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
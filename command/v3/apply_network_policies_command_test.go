@@ -0,0 +1,239 @@
+package v3_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/fileerror"
+	"code.cloudfoundry.org/cli/util/networkpolicyfile"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-network-policies Command", func() {
+	var (
+		cmd             ApplyNetworkPoliciesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeApplyNetworkPoliciesActor
+		tmpDir          string
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeApplyNetworkPoliciesActor)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "apply-network-policies")
+		Expect(err).ToNot(HaveOccurred())
+		pathToFile := filepath.Join(tmpDir, "policies.yml")
+		Expect(ioutil.WriteFile(pathToFile, []byte(`policies:
+- source: app1
+  destination: app2
+- source: app1
+  destination: app3
+  destination_space: space2
+  destination_org: org2
+  protocol: udp
+  ports: 1234-2345
+`), 0600)).To(Succeed())
+
+		cmd = ApplyNetworkPoliciesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			FilePath:    flag.PathWithExistenceCheck(pathToFile),
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("fetching the user fails", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some-error"))
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
+	When("the policies file is invalid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(string(cmd.FilePath), []byte("policies:\n- source: app1\n"), 0600)).To(Succeed())
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(fileerror.MissingFieldError{Kind: networkpolicyfile.Kind, Index: 1, Field: "destination"}))
+			Expect(fakeActor.GetNetworkPolicyChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	It("outputs flavor text and passes the desired policies with defaults", func() {
+		Expect(testUI.Out).To(Say(`Applying network policies from %s to org some-org / space some-space as some-user\.\.\.`, cmd.FilePath))
+
+		Expect(fakeActor.GetNetworkPolicyChangesCallCount()).To(Equal(1))
+		spaceGUID, desired := fakeActor.GetNetworkPolicyChangesArgsForCall(0)
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(desired).To(Equal([]cfnetworkingaction.Policy{
+			{
+				SourceName:           "app1",
+				DestinationName:      "app2",
+				DestinationSpaceName: "some-space",
+				DestinationOrgName:   "some-org",
+				Protocol:             "tcp",
+				StartPort:            8080,
+				EndPort:              8080,
+			},
+			{
+				SourceName:           "app1",
+				DestinationName:      "app3",
+				DestinationSpaceName: "space2",
+				DestinationOrgName:   "org2",
+				Protocol:             "udp",
+				StartPort:            1234,
+				EndPort:              2345,
+			},
+		}))
+	})
+
+	When("getting the changes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetNetworkPolicyChangesReturns(cfnetworkingaction.NetworkPolicyChanges{}, cfnetworkingaction.Warnings{"get-warning"}, actionerror.ApplicationNotFoundError{Name: "app1"})
+		})
+
+		It("displays warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "app1"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(fakeActor.ApplyNetworkPolicyChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no changes", func() {
+		BeforeEach(func() {
+			fakeActor.GetNetworkPolicyChangesReturns(cfnetworkingaction.NetworkPolicyChanges{}, nil, nil)
+		})
+
+		It("says the policies are up to date", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Network policies are up to date."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.ApplyNetworkPolicyChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are changes", func() {
+		var changes cfnetworkingaction.NetworkPolicyChanges
+
+		BeforeEach(func() {
+			changes = cfnetworkingaction.NetworkPolicyChanges{
+				Add: []cfnetworkingaction.Policy{
+					{
+						SourceName:           "app1",
+						DestinationName:      "app3",
+						DestinationSpaceName: "space2",
+						DestinationOrgName:   "org2",
+						Protocol:             "udp",
+						StartPort:            1234,
+						EndPort:              2345,
+					},
+				},
+				Remove: []cfnetworkingaction.Policy{
+					{
+						SourceName:           "app1",
+						DestinationName:      "app4",
+						DestinationSpaceName: "some-space",
+						DestinationOrgName:   "some-org",
+						Protocol:             "tcp",
+						StartPort:            8080,
+						EndPort:              8080,
+					},
+				},
+			}
+			fakeActor.GetNetworkPolicyChangesReturns(changes, cfnetworkingaction.Warnings{"get-warning"}, nil)
+		})
+
+		It("displays the changes and applies them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("action\\s+source\\s+destination\\s+protocol\\s+ports\\s+destination space\\s+destination org"))
+			Expect(testUI.Out).To(Say("add\\s+app1\\s+app3\\s+udp\\s+1234-2345\\s+space2\\s+org2"))
+			Expect(testUI.Out).To(Say("remove\\s+app1\\s+app4\\s+tcp\\s+8080\\s+some-space\\s+some-org"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("get-warning"))
+
+			Expect(fakeActor.ApplyNetworkPolicyChangesCallCount()).To(Equal(1))
+			Expect(fakeActor.ApplyNetworkPolicyChangesArgsForCall(0)).To(Equal(changes))
+		})
+
+		When("applying the changes fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyNetworkPolicyChangesReturns(errors.New("apply-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("apply-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+
+		When("--dry-run is passed", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the changes without applying them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("add\\s+app1\\s+app3"))
+				Expect(testUI.Out).To(Say("remove\\s+app1\\s+app4"))
+				Expect(testUI.Out).To(Say("Dry run, no changes were made."))
+				Expect(fakeActor.ApplyNetworkPolicyChangesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
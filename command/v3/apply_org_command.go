@@ -0,0 +1,194 @@
+package v3
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/orgfile"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . ApplyOrgActor
+
+type ApplyOrgActor interface {
+	CloudControllerV3APIVersion() string
+	GetOrgChanges(org orgfile.Org) ([]v2v3action.OrgChange, v2v3action.Warnings, error)
+	ApplyOrgChange(orgName string, change v2v3action.OrgChange) (v2v3action.Warnings, error)
+}
+
+type ApplyOrgCommand struct {
+	PathToOrgFile   flag.PathWithExistenceCheck `short:"f" required:"true" description:"Path to an org file, as written by export-org"`
+	DryRun          bool                        `long:"dry-run" description:"List the changes that would be made without making them"`
+	usage           interface{}                 `usage:"CF_NAME apply-org -f FILE [--dry-run]\n\n   Creates the org, private domains, space quotas and spaces in FILE that do not exist, updates quota and isolation segment assignments and space quota limits that differ, and adds missing user roles and security group bindings.\n\n   Nothing is deleted, and user roles and security group bindings that are not in FILE are kept.\n\nEXAMPLES:\n   CF_NAME apply-org -f my-org.yml --dry-run\n   CF_NAME apply-org -f my-org.yml"`
+	relatedCommands interface{}                 `related_commands:"export-org, create-org, create-space, set-org-role, set-space-role"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyOrgActor
+}
+
+func (cmd *ApplyOrgCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClientV3, uaaClientV3, err := sharedV3.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumCFAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionIsolationSegmentV3}
+		}
+
+		return err
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v2v3action.NewActor(
+		v2action.NewActor(ccClientV2, uaaClientV2, config),
+		v3action.NewActor(ccClientV3, config, sharedActor, uaaClientV3),
+	)
+
+	return nil
+}
+
+func (cmd ApplyOrgCommand) Execute(args []string) error {
+	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionIsolationSegmentV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	org, err := orgfile.ReadOrg(string(cmd.PathToOrgFile))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing org {{.OrgName}} with {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  org.Name,
+		"Path":     cmd.PathToOrgFile,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	changes, warnings, err := cmd.Actor.GetOrgChanges(org)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("Org {{.OrgName}} is up to date.", map[string]interface{}{
+			"OrgName": org.Name,
+		})
+		return nil
+	}
+
+	cmd.displayChanges(changes)
+
+	if cmd.DryRun {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run, no changes were made.")
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	for _, change := range changes {
+		cmd.UI.DisplayText("Applying {{.Type}} {{.Name}}...", map[string]interface{}{
+			"Type": change.Type,
+			"Name": change.Name,
+		})
+
+		warnings, err = cmd.Actor.ApplyOrgChange(org.Name, change)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ApplyOrgCommand) displayChanges(changes []v2v3action.OrgChange) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("change"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("details"),
+		},
+	}
+
+	for _, change := range changes {
+		table = append(table, []string{
+			string(change.Type),
+			change.Space,
+			change.Name,
+			cmd.changeDetails(change),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd ApplyOrgCommand) changeDetails(change v2v3action.OrgChange) string {
+	switch change.Type {
+	case v2v3action.OrgChangeAddOrgRole, v2v3action.OrgChangeAddSpaceRole:
+		return string(change.Role)
+	case v2v3action.OrgChangeBindSecurityGroup:
+		return string(change.Lifecycle)
+	case v2v3action.OrgChangeCreateSpaceQuota, v2v3action.OrgChangeUpdateSpaceQuota:
+		quota := change.SpaceQuota
+		return fmt.Sprintf("%s %s, %s %s, %s %s, %s %s, %s %s, %s %s, %s %t",
+			cmd.UI.TranslateText("memory"), cmd.memoryLimit(quota.MemoryLimit),
+			cmd.UI.TranslateText("instance memory"), cmd.memoryLimit(quota.InstanceMemoryLimit),
+			cmd.UI.TranslateText("routes"), cmd.limit(quota.TotalRoutes),
+			cmd.UI.TranslateText("services"), cmd.limit(quota.TotalServices),
+			cmd.UI.TranslateText("app instances"), cmd.limit(quota.AppInstanceLimit),
+			cmd.UI.TranslateText("reserved route ports"), cmd.limit(quota.TotalReservedRoutePorts),
+			cmd.UI.TranslateText("paid services"), quota.NonBasicServicesAllowed,
+		)
+	default:
+		return ""
+	}
+}
+
+func (cmd ApplyOrgCommand) limit(value int) string {
+	if value == -1 {
+		return cmd.UI.TranslateText("unlimited")
+	}
+	return strconv.Itoa(value)
+}
+
+func (cmd ApplyOrgCommand) memoryLimit(value int) string {
+	if value == -1 {
+		return cmd.UI.TranslateText("unlimited")
+	}
+	return fmt.Sprintf("%dM", value)
+}
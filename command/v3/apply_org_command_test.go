@@ -0,0 +1,189 @@
+package v3_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/fileerror"
+	"code.cloudfoundry.org/cli/util/orgfile"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-org Command", func() {
+	var (
+		cmd             v3.ApplyOrgCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeApplyOrgActor
+		orgFilePath     string
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeApplyOrgActor)
+
+		orgFile, err := ioutil.TempFile("", "apply-org-command-test")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = orgFile.WriteString("name: some-org\nspaces:\n- name: dev\n")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orgFile.Close()).To(Succeed())
+		orgFilePath = orgFile.Name()
+
+		cmd = v3.ApplyOrgCommand{
+			UI:            testUI,
+			Config:        fakeConfig,
+			SharedActor:   fakeSharedActor,
+			Actor:         fakeActor,
+			PathToOrgFile: flag.PathWithExistenceCheck(orgFilePath),
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinVersionIsolationSegmentV3)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(orgFilePath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the org file is invalid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(orgFilePath, []byte("spaces: []\n"), 0600)).To(Succeed())
+		})
+
+		It("returns the error without comparing", func() {
+			Expect(executeErr).To(MatchError(fileerror.MissingFieldError{Kind: orgfile.Kind, Field: "name"}))
+			Expect(fakeActor.GetOrgChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	It("compares the org with the org file", func() {
+		Expect(testUI.Out).To(Say(`Comparing org some-org with %s as some-user\.\.\.`, orgFilePath))
+		Expect(fakeActor.GetOrgChangesArgsForCall(0)).To(Equal(orgfile.Org{
+			Name:   "some-org",
+			Spaces: []orgfile.Space{{Name: "dev"}},
+		}))
+	})
+
+	When("the org is up to date", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrgChangesReturns(nil, v2v3action.Warnings{"get-warning"}, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Org some-org is up to date."))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(fakeActor.ApplyOrgChangeCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the changes fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrgChangesReturns(nil, v2v3action.Warnings{"get-warning"}, errors.New("get-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-error"))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+
+	When("there are changes", func() {
+		var changes []v2v3action.OrgChange
+
+		BeforeEach(func() {
+			changes = []v2v3action.OrgChange{
+				{Type: v2v3action.OrgChangeCreateSpaceQuota, Name: "small", SpaceQuota: orgfile.SpaceQuota{Name: "small", MemoryLimit: 1024, InstanceMemoryLimit: -1, TotalRoutes: 10, TotalServices: -1, AppInstanceLimit: 5, TotalReservedRoutePorts: -1}},
+				{Type: v2v3action.OrgChangeAddSpaceRole, Space: "dev", Name: "bob", Role: constant.SpaceDeveloper},
+				{Type: v2v3action.OrgChangeBindSecurityGroup, Space: "dev", Name: "dns", Lifecycle: constant.SecurityGroupLifecycleStaging},
+			}
+			fakeActor.GetOrgChangesReturns(changes, nil, nil)
+			fakeActor.ApplyOrgChangeReturns(v2v3action.Warnings{"apply-warning"}, nil)
+		})
+
+		It("displays the changes in a table", func() {
+			Expect(testUI.Out).To(Say(`change\s+space\s+name\s+details`))
+			Expect(testUI.Out).To(Say(`create-space-quota\s+small\s+memory 1024M, instance memory unlimited, routes 10, services unlimited, app instances 5, reserved route ports unlimited, paid services false`))
+			Expect(testUI.Out).To(Say(`add-space-role\s+dev\s+bob\s+developers`))
+			Expect(testUI.Out).To(Say(`bind-security-group\s+dev\s+dns\s+staging`))
+		})
+
+		It("applies every change in order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Applying create-space-quota small\.\.\.`))
+			Expect(testUI.Out).To(Say(`Applying add-space-role bob\.\.\.`))
+			Expect(testUI.Out).To(Say(`Applying bind-security-group dns\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+
+			Expect(fakeActor.ApplyOrgChangeCallCount()).To(Equal(3))
+			for i, change := range changes {
+				orgName, appliedChange := fakeActor.ApplyOrgChangeArgsForCall(i)
+				Expect(orgName).To(Equal("some-org"))
+				Expect(appliedChange).To(Equal(change))
+			}
+		})
+
+		When("--dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("does not change anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Dry run, no changes were made."))
+				Expect(fakeActor.ApplyOrgChangeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a change fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplyOrgChangeReturns(v2v3action.Warnings{"apply-warning"}, errors.New("apply-error"))
+			})
+
+			It("returns the error and stops applying", func() {
+				Expect(executeErr).To(MatchError("apply-error"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+				Expect(fakeActor.ApplyOrgChangeCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,251 @@
+package v3
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . CanaryActor
+
+type CanaryActor interface {
+	CloudControllerAPIVersion() string
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetProcessSummaryByTypeAndApplication(processType string, appGUID string) (v3action.ProcessSummary, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . CanaryTrafficActor
+
+type CanaryTrafficActor interface {
+	MapCanaryRoutes(oldAppGUID string, newAppGUID string) (v2action.Routes, v2v3action.Warnings, error)
+	ScaleCanary(oldAppGUID string, newAppGUID string, step v2v3action.CanaryStep) (v2v3action.Warnings, error)
+	AbortCanary(oldAppGUID string, newAppGUID string, oldInstances int, newInstances int, mappedRoutes v2action.Routes) (v2v3action.Warnings, error)
+	CompleteCanary(oldAppGUID string, newAppGUID string) (v2v3action.Warnings, error)
+}
+
+type CanaryCommand struct {
+	RequiredArgs    flag.CanaryArgs `positional-args:"yes"`
+	Percent         flag.Percentage `long:"percent" required:"true" description:"Percentage of traffic to shift to the new app (1-100)"`
+	Step            flag.Percentage `long:"step" description:"Percentage of traffic to shift per step (Default: shift --percent in a single step)"`
+	Interval        int             `long:"interval" default:"60" description:"Time (in seconds) to wait after each step before checking the new app for crashed instances"`
+	usage           interface{}     `usage:"CF_NAME canary APP_OLD APP_NEW --percent PERCENT [--step PERCENT] [--interval SECONDS]\n\n   The shared routes are mapped to both apps and the web process instances are\n   scaled so that PERCENT of them belong to APP_NEW. If APP_NEW has crashed\n   instances after a step, all traffic is returned to APP_OLD.\n\nEXAMPLES:\n   CF_NAME canary my-app my-app-v2 --percent 50 --step 10 --interval 120"`
+	relatedCommands interface{}     `related_commands:"map-route, swap-routes, v3-scale"`
+
+	UI           command.UI
+	Config       command.Config
+	SharedActor  command.SharedActor
+	Actor        CanaryActor
+	TrafficActor CanaryTrafficActor
+	Sleep        func(time.Duration)
+}
+
+func (cmd *CanaryCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.Sleep = time.Sleep
+
+	ccClientV3, uaaClientV3, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumCFAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionApplicationFlowV3}
+		}
+
+		return err
+	}
+	v3Actor := v3action.NewActor(ccClientV3, config, sharedActor, uaaClientV3)
+	cmd.Actor = v3Actor
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.TrafficActor = v2v3action.NewActor(
+		v2action.NewActor(ccClientV2, uaaClientV2, config),
+		v3Actor,
+	)
+
+	return nil
+}
+
+func (cmd CanaryCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionApplicationFlowV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Shifting {{.Percent}}% of traffic from app {{.OldAppName}} to app {{.NewAppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Percent":    cmd.Percent.Value,
+		"OldAppName": cmd.RequiredArgs.OldAppName,
+		"NewAppName": cmd.RequiredArgs.NewAppName,
+		"OrgName":    cmd.Config.TargetedOrganization().Name,
+		"SpaceName":  cmd.Config.TargetedSpace().Name,
+		"Username":   user.Name,
+	})
+
+	oldApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.OldAppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	newApp, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.NewAppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	oldProcess, warnings, err := cmd.Actor.GetProcessSummaryByTypeAndApplication(constant.ProcessTypeWeb, oldApp.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	newProcess, warnings, err := cmd.Actor.GetProcessSummaryByTypeAndApplication(constant.ProcessTypeWeb, newApp.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	original := canaryInstances{old: oldProcess.Instances.Value, new: newProcess.Instances.Value}
+
+	mappedRoutes, trafficWarnings, err := cmd.TrafficActor.MapCanaryRoutes(oldApp.GUID, newApp.GUID)
+	cmd.UI.DisplayWarnings(trafficWarnings)
+	if err != nil {
+		return cmd.abort(oldApp, newApp, original, mappedRoutes, err)
+	}
+
+	steps := v2v3action.CanarySteps(original.old, cmd.Percent.Value, cmd.Step.Value)
+	for _, step := range steps {
+		cmd.UI.DisplayText("Step {{.Percent}}%: {{.OldAppName}} {{.OldInstances}} instance(s), {{.NewAppName}} {{.NewInstances}} instance(s)", map[string]interface{}{
+			"Percent":      step.Percent,
+			"OldAppName":   oldApp.Name,
+			"OldInstances": step.OldInstances,
+			"NewAppName":   newApp.Name,
+			"NewInstances": step.NewInstances,
+		})
+
+		trafficWarnings, err = cmd.TrafficActor.ScaleCanary(oldApp.GUID, newApp.GUID, step)
+		cmd.UI.DisplayWarnings(trafficWarnings)
+		if err != nil {
+			return cmd.abort(oldApp, newApp, original, mappedRoutes, err)
+		}
+
+		cmd.Sleep(time.Duration(cmd.Interval) * time.Second)
+
+		newProcess, warnings, err = cmd.Actor.GetProcessSummaryByTypeAndApplication(constant.ProcessTypeWeb, newApp.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return cmd.abort(oldApp, newApp, original, mappedRoutes, err)
+		}
+
+		if crashed := newProcess.CrashedInstanceCount(); crashed > 0 {
+			return cmd.abort(oldApp, newApp, original, mappedRoutes, translatableerror.CanaryAbortedError{
+				AppName:          newApp.Name,
+				Percent:          step.Percent,
+				CrashedInstances: crashed,
+			})
+		}
+	}
+
+	if cmd.Percent.Value == 100 {
+		err = cmd.waitForHealthyInstances(newApp)
+		if err != nil {
+			return cmd.abort(oldApp, newApp, original, mappedRoutes, err)
+		}
+
+		cmd.UI.DisplayText("Unmapping shared routes from app {{.OldAppName}}...", map[string]interface{}{
+			"OldAppName": oldApp.Name,
+		})
+
+		trafficWarnings, err = cmd.TrafficActor.CompleteCanary(oldApp.GUID, newApp.GUID)
+		cmd.UI.DisplayWarnings(trafficWarnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// canaryInstances are the web process instance counts of both applications.
+type canaryInstances struct {
+	old int
+	new int
+}
+
+// waitForHealthyInstances polls the web process of the new app until all of
+// its instances are running. It returns a CanaryAbortedError if any instance
+// crashes and an ApplicationNotHealthyError if the instances are not all
+// running within the startup timeout.
+func (cmd CanaryCommand) waitForHealthyInstances(newApp v3action.Application) error {
+	for waited := time.Duration(0); ; waited += cmd.Config.PollingInterval() {
+		process, warnings, err := cmd.Actor.GetProcessSummaryByTypeAndApplication(constant.ProcessTypeWeb, newApp.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if crashed := process.CrashedInstanceCount(); crashed > 0 {
+			return translatableerror.CanaryAbortedError{
+				AppName:          newApp.Name,
+				Percent:          cmd.Percent.Value,
+				CrashedInstances: crashed,
+			}
+		}
+
+		if running := process.HealthyInstanceCount(); running > 0 && running == process.TotalInstanceCount() {
+			return nil
+		}
+
+		if waited >= cmd.Config.StartupTimeout() {
+			return actionerror.ApplicationNotHealthyError{Name: newApp.Name}
+		}
+		cmd.Sleep(cmd.Config.PollingInterval())
+	}
+}
+
+// abort returns all traffic to the old app, restoring the original instance
+// counts, and then reports canaryErr. If the rollback itself fails, that
+// error is returned instead.
+func (cmd CanaryCommand) abort(oldApp v3action.Application, newApp v3action.Application, original canaryInstances, mappedRoutes v2action.Routes, canaryErr error) error {
+	cmd.UI.DisplayText("Aborting canary, returning all traffic to app {{.OldAppName}}...", map[string]interface{}{
+		"OldAppName": oldApp.Name,
+	})
+
+	warnings, err := cmd.TrafficActor.AbortCanary(oldApp.GUID, newApp.GUID, original.old, original.new, mappedRoutes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	return canaryErr
+}
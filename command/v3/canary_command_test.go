@@ -0,0 +1,334 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("canary Command", func() {
+	var (
+		cmd              v3.CanaryCommand
+		testUI           *ui.UI
+		fakeConfig       *commandfakes.FakeConfig
+		fakeSharedActor  *commandfakes.FakeSharedActor
+		fakeActor        *v3fakes.FakeCanaryActor
+		fakeTrafficActor *v3fakes.FakeCanaryTrafficActor
+		binaryName       string
+		sleeps           []time.Duration
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeCanaryActor)
+		fakeTrafficActor = new(v3fakes.FakeCanaryTrafficActor)
+
+		cmd = v3.CanaryCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+			TrafficActor: fakeTrafficActor,
+			Sleep: func(duration time.Duration) {
+				sleeps = append(sleeps, duration)
+			},
+		}
+		sleeps = nil
+		cmd.RequiredArgs.OldAppName = "blue"
+		cmd.RequiredArgs.NewAppName = "green"
+		cmd.Percent.Value = 50
+		cmd.Step.Value = 25
+		cmd.Interval = 30
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns(ccversion.MinVersionApplicationFlowV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	When("the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns(ccversion.MinV3ClientVersion)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinV3ClientVersion,
+				MinimumVersion: ccversion.MinVersionApplicationFlowV3,
+			}))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+			fakeActor.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+				return v3action.Application{GUID: name + "-guid", Name: name}, v3action.Warnings{"get-" + name + "-warning"}, nil
+			}
+			fakeActor.GetProcessSummaryByTypeAndApplicationStub = func(processType string, appGUID string) (v3action.ProcessSummary, v3action.Warnings, error) {
+				instances := 4
+				if appGUID == "green-guid" {
+					instances = 1
+				}
+				return v3action.ProcessSummary{
+					Process:         v3action.Process{Type: processType, Instances: types.NullInt{Value: instances, IsSet: true}},
+					InstanceDetails: []v3action.ProcessInstance{{State: constant.ProcessInstanceRunning}},
+				}, v3action.Warnings{"get-process-warning"}, nil
+			}
+			fakeTrafficActor.MapCanaryRoutesReturns(v2action.Routes{{GUID: "route-guid"}}, v2v3action.Warnings{"map-warning"}, nil)
+			fakeTrafficActor.ScaleCanaryReturns(v2v3action.Warnings{"scale-warning"}, nil)
+		})
+
+		When("every step is healthy", func() {
+			It("maps the routes and scales through every step", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Shifting %d%% of traffic from app blue to app green in org some-org / space some-space as some-user\.\.\.`, 50))
+				Expect(testUI.Out).To(Say(`Step %d%%: blue 3 instance\(s\), green 1 instance\(s\)`, 25))
+				Expect(testUI.Out).To(Say(`Step %d%%: blue 2 instance\(s\), green 2 instance\(s\)`, 50))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(testUI.Err).To(Say("get-blue-warning"))
+				Expect(testUI.Err).To(Say("get-green-warning"))
+				Expect(testUI.Err).To(Say("map-warning"))
+				Expect(testUI.Err).To(Say("scale-warning"))
+
+				Expect(fakeActor.GetProcessSummaryByTypeAndApplicationCallCount()).To(Equal(4))
+				processType, appGUID := fakeActor.GetProcessSummaryByTypeAndApplicationArgsForCall(0)
+				Expect(processType).To(Equal(constant.ProcessTypeWeb))
+				Expect(appGUID).To(Equal("blue-guid"))
+				processType, appGUID = fakeActor.GetProcessSummaryByTypeAndApplicationArgsForCall(1)
+				Expect(processType).To(Equal(constant.ProcessTypeWeb))
+				Expect(appGUID).To(Equal("green-guid"))
+
+				Expect(sleeps).To(Equal([]time.Duration{30 * time.Second, 30 * time.Second}))
+
+				Expect(fakeTrafficActor.MapCanaryRoutesCallCount()).To(Equal(1))
+				oldAppGUID, newAppGUID := fakeTrafficActor.MapCanaryRoutesArgsForCall(0)
+				Expect(oldAppGUID).To(Equal("blue-guid"))
+				Expect(newAppGUID).To(Equal("green-guid"))
+
+				Expect(fakeTrafficActor.ScaleCanaryCallCount()).To(Equal(2))
+				_, _, step := fakeTrafficActor.ScaleCanaryArgsForCall(1)
+				Expect(step).To(Equal(v2v3action.CanaryStep{Percent: 50, OldInstances: 2, NewInstances: 2}))
+
+				Expect(fakeTrafficActor.AbortCanaryCallCount()).To(Equal(0))
+				Expect(fakeTrafficActor.CompleteCanaryCallCount()).To(Equal(0))
+			})
+
+			When("shifting all traffic", func() {
+				BeforeEach(func() {
+					cmd.Percent.Value = 100
+					cmd.Step.Value = 0
+					fakeConfig.PollingIntervalReturns(time.Second)
+					fakeConfig.StartupTimeoutReturns(2 * time.Second)
+					fakeTrafficActor.CompleteCanaryReturns(v2v3action.Warnings{"complete-warning"}, nil)
+				})
+
+				It("waits after the only step and unmaps the shared routes from the old app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(sleeps).To(Equal([]time.Duration{30 * time.Second}))
+					Expect(testUI.Out).To(Say("Unmapping shared routes from app blue..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("complete-warning"))
+
+					Expect(fakeActor.GetProcessSummaryByTypeAndApplicationCallCount()).To(Equal(4))
+					Expect(fakeTrafficActor.CompleteCanaryCallCount()).To(Equal(1))
+					oldAppGUID, newAppGUID := fakeTrafficActor.CompleteCanaryArgsForCall(0)
+					Expect(oldAppGUID).To(Equal("blue-guid"))
+					Expect(newAppGUID).To(Equal("green-guid"))
+				})
+
+				When("the new instances are still starting", func() {
+					BeforeEach(func() {
+						summaries := []v3action.ProcessSummary{
+							{Process: v3action.Process{Instances: types.NullInt{Value: 4, IsSet: true}}},
+							{Process: v3action.Process{Instances: types.NullInt{Value: 1, IsSet: true}}},
+							{InstanceDetails: []v3action.ProcessInstance{{State: constant.ProcessInstanceStarting}}},
+							{InstanceDetails: []v3action.ProcessInstance{{State: constant.ProcessInstanceStarting}}},
+							{InstanceDetails: []v3action.ProcessInstance{{State: constant.ProcessInstanceRunning}}},
+						}
+						for i, summary := range summaries {
+							fakeActor.GetProcessSummaryByTypeAndApplicationReturnsOnCall(i, summary, nil, nil)
+						}
+					})
+
+					It("polls until they are all running before unmapping the shared routes", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(sleeps).To(Equal([]time.Duration{30 * time.Second, time.Second}))
+						Expect(fakeActor.GetProcessSummaryByTypeAndApplicationCallCount()).To(Equal(5))
+						Expect(fakeTrafficActor.CompleteCanaryCallCount()).To(Equal(1))
+					})
+				})
+
+				When("the new instances crash after the first poll", func() {
+					BeforeEach(func() {
+						summaries := []v3action.ProcessSummary{
+							{Process: v3action.Process{Instances: types.NullInt{Value: 4, IsSet: true}}},
+							{Process: v3action.Process{Instances: types.NullInt{Value: 1, IsSet: true}}},
+							{InstanceDetails: []v3action.ProcessInstance{{State: constant.ProcessInstanceStarting}}},
+							{InstanceDetails: []v3action.ProcessInstance{{State: constant.ProcessInstanceCrashed}}},
+						}
+						for i, summary := range summaries {
+							fakeActor.GetProcessSummaryByTypeAndApplicationReturnsOnCall(i, summary, nil, nil)
+						}
+					})
+
+					It("aborts the canary without unmapping the shared routes from the old app", func() {
+						Expect(executeErr).To(MatchError(translatableerror.CanaryAbortedError{
+							AppName:          "green",
+							Percent:          100,
+							CrashedInstances: 1,
+						}))
+
+						Expect(fakeTrafficActor.ScaleCanaryCallCount()).To(Equal(1))
+						Expect(fakeTrafficActor.AbortCanaryCallCount()).To(Equal(1))
+						Expect(fakeTrafficActor.CompleteCanaryCallCount()).To(Equal(0))
+					})
+				})
+
+				When("the new instances are not running within the startup timeout", func() {
+					BeforeEach(func() {
+						fakeActor.GetProcessSummaryByTypeAndApplicationReturnsOnCall(0, v3action.ProcessSummary{
+							Process: v3action.Process{Instances: types.NullInt{Value: 4, IsSet: true}},
+						}, nil, nil)
+						fakeActor.GetProcessSummaryByTypeAndApplicationReturnsOnCall(1, v3action.ProcessSummary{
+							Process: v3action.Process{Instances: types.NullInt{Value: 1, IsSet: true}},
+						}, nil, nil)
+						fakeActor.GetProcessSummaryByTypeAndApplicationReturns(v3action.ProcessSummary{
+							InstanceDetails: []v3action.ProcessInstance{{State: constant.ProcessInstanceStarting}},
+						}, nil, nil)
+					})
+
+					It("aborts the canary and returns an ApplicationNotHealthyError", func() {
+						Expect(executeErr).To(MatchError(actionerror.ApplicationNotHealthyError{Name: "green"}))
+						Expect(sleeps).To(Equal([]time.Duration{30 * time.Second, time.Second, time.Second}))
+						Expect(fakeTrafficActor.AbortCanaryCallCount()).To(Equal(1))
+						Expect(fakeTrafficActor.CompleteCanaryCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+
+		When("the new app has crashed instances after a step", func() {
+			BeforeEach(func() {
+				fakeActor.GetProcessSummaryByTypeAndApplicationReturnsOnCall(0, v3action.ProcessSummary{
+					Process: v3action.Process{Instances: types.NullInt{Value: 4, IsSet: true}},
+				}, nil, nil)
+				fakeActor.GetProcessSummaryByTypeAndApplicationReturnsOnCall(1, v3action.ProcessSummary{
+					Process: v3action.Process{Instances: types.NullInt{Value: 1, IsSet: true}},
+				}, nil, nil)
+				fakeActor.GetProcessSummaryByTypeAndApplicationReturnsOnCall(2, v3action.ProcessSummary{
+					InstanceDetails: []v3action.ProcessInstance{
+						{State: constant.ProcessInstanceRunning},
+						{State: constant.ProcessInstanceCrashed},
+					},
+				}, nil, nil)
+				fakeTrafficActor.AbortCanaryReturns(v2v3action.Warnings{"abort-warning"}, nil)
+			})
+
+			It("returns all traffic to the old app and returns a CanaryAbortedError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.CanaryAbortedError{
+					AppName:          "green",
+					Percent:          25,
+					CrashedInstances: 1,
+				}))
+
+				Expect(testUI.Out).To(Say("Aborting canary, returning all traffic to app blue..."))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(testUI.Err).To(Say("abort-warning"))
+
+				Expect(fakeTrafficActor.ScaleCanaryCallCount()).To(Equal(1))
+				Expect(fakeTrafficActor.AbortCanaryCallCount()).To(Equal(1))
+				oldAppGUID, newAppGUID, oldInstances, newInstances, mappedRoutes := fakeTrafficActor.AbortCanaryArgsForCall(0)
+				Expect(oldAppGUID).To(Equal("blue-guid"))
+				Expect(newAppGUID).To(Equal("green-guid"))
+				Expect(oldInstances).To(Equal(4))
+				Expect(newInstances).To(Equal(1))
+				Expect(mappedRoutes).To(Equal(v2action.Routes{{GUID: "route-guid"}}))
+			})
+
+			When("aborting fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("abort-error")
+					fakeTrafficActor.AbortCanaryReturns(nil, expectedErr)
+				})
+
+				It("returns the abort error", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+				})
+			})
+		})
+
+		When("scaling fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("scale-error")
+				fakeTrafficActor.ScaleCanaryReturns(nil, expectedErr)
+			})
+
+			It("aborts the canary and returns the scaling error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeTrafficActor.AbortCanaryCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the old app cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(0, v3action.Application{}, nil, actionerror.ApplicationNotFoundError{Name: "blue"})
+			})
+
+			It("returns the error without changing any routes", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "blue"}))
+				Expect(fakeTrafficActor.MapCanaryRoutesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
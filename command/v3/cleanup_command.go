@@ -0,0 +1,181 @@
+package v3
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . CleanupActor
+
+type CleanupActor interface {
+	CloudControllerV3APIVersion() string
+	GetCleanupResources(spaceGUID string, settings v2v3action.CleanupSettings) ([]v2v3action.CleanupResource, v2v3action.Warnings, error)
+	DeleteCleanupResource(resource v2v3action.CleanupResource) (v2v3action.Warnings, error)
+}
+
+type CleanupCommand struct {
+	DryRun          bool                       `long:"dry-run" description:"List the resources that would be deleted without deleting them"`
+	Force           bool                       `short:"f" description:"Force deletion without confirmation"`
+	Days            flag.NonNegativeInteger    `long:"days" default:"30" description:"Number of days after which stopped apps and service keys are considered stale"`
+	Keep            flag.NonNegativeInteger    `long:"keep" default:"2" description:"Number of most recent droplets and packages to keep for every app"`
+	Types           []flag.CleanupResourceType `long:"type" description:"Only clean up resources of this type; can be repeated (Default: all types except service-key)"`
+	usage           interface{}                `usage:"CF_NAME cleanup [--type TYPE]... [--days DAYS] [--keep COUNT] [--dry-run] [-f]\n\n   TYPE is one of app, droplet, package, route, service-instance or service-key.\n\n   Unused resources in the targeted space are:\n      - routes not mapped to any app\n      - service instances not bound to any app and without service keys\n      - service keys created more than DAYS ago, only with --type service-key\n      - stopped apps not updated in the last DAYS\n      - droplets and packages beyond the COUNT most recent ones of each app\n\n   Service keys are not checked for use, so every key older than DAYS is\n   deleted. The table lists the service instance of each key.\n\nEXAMPLES:\n   CF_NAME cleanup --dry-run\n   CF_NAME cleanup --type route --type service-instance -f\n   CF_NAME cleanup --type service-key --days 90 --dry-run"`
+	relatedCommands interface{}                `related_commands:"delete-orphaned-routes, delete-service, delete-service-key, v3-droplets, v3-packages"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CleanupActor
+}
+
+func (cmd *CleanupCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClientV3, uaaClientV3, err := sharedV3.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumCFAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionApplicationFlowV3}
+		}
+
+		return err
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v2v3action.NewActor(
+		v2action.NewActor(ccClientV2, uaaClientV2, config),
+		v3action.NewActor(ccClientV3, config, sharedActor, uaaClientV3),
+	)
+
+	return nil
+}
+
+func (cmd CleanupCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionApplicationFlowV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting unused resources in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	settings := v2v3action.CleanupSettings{
+		StaleBefore: time.Now().AddDate(0, 0, -cmd.Days.Value),
+		Retain:      cmd.Keep.Value,
+	}
+	for _, resourceType := range cmd.Types {
+		settings.Types = append(settings.Types, v2v3action.CleanupResourceType(resourceType.Type))
+	}
+
+	resources, warnings, err := cmd.Actor.GetCleanupResources(cmd.Config.TargetedSpace().GUID, settings)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(resources) == 0 {
+		cmd.UI.DisplayText("No unused resources found.")
+		return nil
+	}
+
+	cmd.displayResources(resources)
+
+	if cmd.DryRun {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Dry run, no resources were deleted.")
+		return nil
+	}
+
+	if !cmd.Force {
+		cmd.UI.DisplayNewline()
+		deleteResources, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete these {{.Count}} resources?", map[string]interface{}{
+			"Count": len(resources),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteResources {
+			cmd.UI.DisplayText("Cleanup cancelled")
+			return nil
+		}
+	}
+
+	for _, resource := range resources {
+		cmd.UI.DisplayText("Deleting {{.Type}} {{.Name}}...", map[string]interface{}{
+			"Type": resource.Type,
+			"Name": resource.Name,
+		})
+
+		warnings, err = cmd.Actor.DeleteCleanupResource(resource)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd CleanupCommand) displayResources(resources []v2v3action.CleanupResource) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("owner"),
+			cmd.UI.TranslateText("date"),
+		},
+	}
+
+	for _, resource := range resources {
+		var date string
+		if !resource.Date.IsZero() {
+			date = cmd.UI.UserFriendlyDate(resource.Date)
+		}
+
+		table = append(table, []string{
+			string(resource.Type),
+			resource.Name,
+			resource.Owner,
+			date,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
@@ -0,0 +1,230 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("cleanup Command", func() {
+	var (
+		cmd             v3.CleanupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeCleanupActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeCleanupActor)
+
+		cmd = v3.CleanupCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Days:        flag.NonNegativeInteger{Value: 30},
+			Keep:        flag.NonNegativeInteger{Value: 2},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinVersionApplicationFlowV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	When("the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinV3ClientVersion)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinV3ClientVersion,
+				MinimumVersion: ccversion.MinVersionApplicationFlowV3,
+			}))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		})
+
+		When("there are no unused resources", func() {
+			BeforeEach(func() {
+				fakeActor.GetCleanupResourcesReturns(nil, v2v3action.Warnings{"get-warning"}, nil)
+			})
+
+			It("says so and does not prompt", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Getting unused resources in org some-org / space some-space as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say("No unused resources found."))
+				Expect(testUI.Out).ToNot(Say("Really delete"))
+				Expect(testUI.Err).To(Say("get-warning"))
+			})
+		})
+
+		When("getting the unused resources fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetCleanupResourcesReturns(nil, v2v3action.Warnings{"get-warning"}, errors.New("get-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("get-error"))
+				Expect(testUI.Err).To(Say("get-warning"))
+			})
+		})
+
+		When("there are unused resources", func() {
+			var resources []v2v3action.CleanupResource
+
+			BeforeEach(func() {
+				resources = []v2v3action.CleanupResource{
+					{Type: v2v3action.CleanupRoute, GUID: "route-guid", Name: "host.example.com"},
+					{Type: v2v3action.CleanupDroplet, GUID: "droplet-guid", Name: "droplet-guid", Owner: "some-app", Date: time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC)},
+				}
+				fakeActor.GetCleanupResourcesReturns(resources, v2v3action.Warnings{"get-warning"}, nil)
+				fakeActor.DeleteCleanupResourceReturns(v2v3action.Warnings{"delete-warning"}, nil)
+			})
+
+			It("displays them in a table", func() {
+				Expect(testUI.Out).To(Say(`type\s+name\s+owner\s+date`))
+				Expect(testUI.Out).To(Say(`route\s+host\.example\.com`))
+				Expect(testUI.Out).To(Say(`droplet\s+droplet-guid\s+some-app\s+Wed 01 Mar 00:00:00 UTC 2017`))
+			})
+
+			It("passes the settings to the actor", func() {
+				Expect(fakeActor.GetCleanupResourcesCallCount()).To(Equal(1))
+				spaceGUID, settings := fakeActor.GetCleanupResourcesArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(settings.Retain).To(Equal(2))
+				Expect(settings.Types).To(BeEmpty())
+				Expect(settings.StaleBefore).To(BeTemporally("~", time.Now().AddDate(0, 0, -30), time.Minute))
+			})
+
+			When("types are provided", func() {
+				BeforeEach(func() {
+					cmd.Types = []flag.CleanupResourceType{{Type: "route"}, {Type: "service-key"}}
+				})
+
+				It("limits the search to those types", func() {
+					_, settings := fakeActor.GetCleanupResourcesArgsForCall(0)
+					Expect(settings.Types).To(Equal([]v2v3action.CleanupResourceType{v2v3action.CleanupRoute, v2v3action.CleanupServiceKey}))
+				})
+			})
+
+			When("--dry-run is provided", func() {
+				BeforeEach(func() {
+					cmd.DryRun = true
+				})
+
+				It("does not delete anything", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Dry run, no resources were deleted."))
+					Expect(fakeActor.DeleteCleanupResourceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("-f is provided", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+				})
+
+				It("deletes every resource without prompting", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Really delete"))
+					Expect(testUI.Out).To(Say(`Deleting route host\.example\.com\.\.\.`))
+					Expect(testUI.Out).To(Say(`Deleting droplet droplet-guid\.\.\.`))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("delete-warning"))
+
+					Expect(fakeActor.DeleteCleanupResourceCallCount()).To(Equal(2))
+					Expect(fakeActor.DeleteCleanupResourceArgsForCall(0)).To(Equal(resources[0]))
+					Expect(fakeActor.DeleteCleanupResourceArgsForCall(1)).To(Equal(resources[1]))
+				})
+
+				When("a deletion fails", func() {
+					BeforeEach(func() {
+						fakeActor.DeleteCleanupResourceReturns(v2v3action.Warnings{"delete-warning"}, errors.New("delete-error"))
+					})
+
+					It("returns the error and stops deleting", func() {
+						Expect(executeErr).To(MatchError("delete-error"))
+						Expect(fakeActor.DeleteCleanupResourceCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			When("the user confirms the prompt", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("y\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("deletes every resource", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Really delete these 2 resources\?`))
+					Expect(fakeActor.DeleteCleanupResourceCallCount()).To(Equal(2))
+				})
+			})
+
+			When("the user declines the prompt", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not delete anything", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Cleanup cancelled"))
+					Expect(fakeActor.DeleteCleanupResourceCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,86 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	sharedV3 "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/orgfile"
+)
+
+//go:generate counterfeiter . ExportOrgActor
+
+type ExportOrgActor interface {
+	CloudControllerV3APIVersion() string
+	ExportOrganization(orgName string) (orgfile.Org, v2v3action.Warnings, error)
+}
+
+type ExportOrgCommand struct {
+	RequiredArgs    flag.Organization `positional-args:"yes"`
+	usage           interface{}       `usage:"CF_NAME export-org ORG > FILE\n\n   Writes the org's quota, isolation segments, private domains, user roles, space quotas and spaces, with their quotas, isolation segments, security groups and user roles, as YAML that can be applied with apply-org.\n\nEXAMPLES:\n   CF_NAME export-org my-org > my-org.yml"`
+	relatedCommands interface{}       `related_commands:"apply-org, org, org-users, space-users"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportOrgActor
+}
+
+func (cmd *ExportOrgCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClientV3, uaaClientV3, err := sharedV3.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumCFAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionIsolationSegmentV3}
+		}
+
+		return err
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v2v3action.NewActor(
+		v2action.NewActor(ccClientV2, uaaClientV2, config),
+		v3action.NewActor(ccClientV3, config, sharedActor, uaaClientV3),
+	)
+
+	return nil
+}
+
+func (cmd ExportOrgCommand) Execute(args []string) error {
+	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionIsolationSegmentV3)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	// Only the YAML is written to stdout so that it can be redirected to a
+	// file; warnings go to stderr.
+	org, warnings, err := cmd.Actor.ExportOrganization(cmd.RequiredArgs.Organization)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	return orgfile.WriteOrg(org, cmd.UI.Writer())
+}
@@ -0,0 +1,112 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/orgfile"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-org Command", func() {
+	var (
+		cmd             v3.ExportOrgCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeExportOrgActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeExportOrgActor)
+
+		cmd = v3.ExportOrgCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.Organization = "some-org"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinVersionIsolationSegmentV3)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinV3ClientVersion)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinV3ClientVersion,
+				MinimumVersion: ccversion.MinVersionIsolationSegmentV3,
+			}))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the org is exported", func() {
+		BeforeEach(func() {
+			fakeActor.ExportOrganizationReturns(
+				orgfile.Org{
+					Name:     "some-org",
+					Managers: []string{"alice"},
+					Spaces:   []orgfile.Space{{Name: "dev"}},
+				},
+				v2v3action.Warnings{"export-warning"},
+				nil,
+			)
+		})
+
+		It("writes only the org file to stdout and warnings to stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.ExportOrganizationArgsForCall(0)).To(Equal("some-org"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("---\nname: some-org\nmanagers:\n- alice\nspaces:\n- name: dev\n"))
+			Expect(testUI.Err).To(Say("export-warning"))
+		})
+	})
+
+	When("exporting the org fails", func() {
+		BeforeEach(func() {
+			fakeActor.ExportOrganizationReturns(orgfile.Org{}, v2v3action.Warnings{"export-warning"}, errors.New("export-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("export-error"))
+			Expect(testUI.Err).To(Say("export-warning"))
+		})
+	})
+})
@@ -1,15 +1,18 @@
 package v3
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -23,10 +26,11 @@ type NetworkPoliciesActor interface {
 }
 
 type NetworkPoliciesCommand struct {
-	SourceApp string `long:"source" required:"false" description:"Source app to filter results by"`
+	SourceApp string                        `long:"source" required:"false" description:"Source app to filter results by"`
+	Graph     flag.NetworkPolicyGraphFormat `long:"graph" description:"Print the policies as a graph in the given format instead of a table"`
 
-	usage           interface{} `usage:"CF_NAME network-policies [--source SOURCE_APP]"`
-	relatedCommands interface{} `related_commands:"add-network-policy, apps, remove-network-policy"`
+	usage           interface{} `usage:"CF_NAME network-policies [--source SOURCE_APP] [--graph (dot | mermaid)]\n\nEXAMPLES:\n   CF_NAME network-policies --graph dot | dot -Tpng -o policies.png"`
+	relatedCommands interface{} `related_commands:"add-network-policy, apply-network-policies, apps, remove-network-policy"`
 
 	UI          command.UI
 	Config      command.Config
@@ -73,19 +77,23 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 	var warnings cfnetworkingaction.Warnings
 
 	if cmd.SourceApp != "" {
-		cmd.UI.DisplayTextWithFlavor("Listing network policies of app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-			"SrcAppName": cmd.SourceApp,
-			"Org":        cmd.Config.TargetedOrganization().Name,
-			"Space":      cmd.Config.TargetedSpace().Name,
-			"User":       user.Name,
-		})
+		if cmd.Graph.Format == "" {
+			cmd.UI.DisplayTextWithFlavor("Listing network policies of app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+				"SrcAppName": cmd.SourceApp,
+				"Org":        cmd.Config.TargetedOrganization().Name,
+				"Space":      cmd.Config.TargetedSpace().Name,
+				"User":       user.Name,
+			})
+		}
 		policies, warnings, err = cmd.Actor.NetworkPoliciesBySpaceAndAppName(cmd.Config.TargetedSpace().GUID, cmd.SourceApp)
 	} else {
-		cmd.UI.DisplayTextWithFlavor("Listing network policies in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-			"Org":   cmd.Config.TargetedOrganization().Name,
-			"Space": cmd.Config.TargetedSpace().Name,
-			"User":  user.Name,
-		})
+		if cmd.Graph.Format == "" {
+			cmd.UI.DisplayTextWithFlavor("Listing network policies in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+				"Org":   cmd.Config.TargetedOrganization().Name,
+				"Space": cmd.Config.TargetedSpace().Name,
+				"User":  user.Name,
+			})
+		}
 		policies, warnings, err = cmd.Actor.NetworkPoliciesBySpace(cmd.Config.TargetedSpace().GUID)
 	}
 
@@ -94,6 +102,11 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Graph.Format != "" {
+		_, err = cmd.UI.Writer().Write([]byte(cmd.graph(policies)))
+		return err
+	}
+
 	cmd.UI.DisplayNewline()
 
 	table := [][]string{
@@ -102,21 +115,19 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 			cmd.UI.TranslateText("destination"),
 			cmd.UI.TranslateText("protocol"),
 			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("destination space"),
+			cmd.UI.TranslateText("destination org"),
 		},
 	}
 
 	for _, policy := range policies {
-		var portEntry string
-		if policy.StartPort == policy.EndPort {
-			portEntry = strconv.Itoa(policy.StartPort)
-		} else {
-			portEntry = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
-		}
 		table = append(table, []string{
 			policy.SourceName,
 			policy.DestinationName,
 			policy.Protocol,
-			portEntry,
+			networkPolicyPorts(policy),
+			policy.DestinationSpaceName,
+			policy.DestinationOrgName,
 		})
 	}
 
@@ -124,3 +135,67 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// graph renders the policies as a directed graph of apps in the requested
+// format. Apps outside the targeted space are labeled with their org and
+// space.
+func (cmd NetworkPoliciesCommand) graph(policies []cfnetworkingaction.Policy) string {
+	var nodes []string
+	nodeIDs := map[string]string{}
+	nodeID := func(label string) string {
+		if id, ok := nodeIDs[label]; ok {
+			return id
+		}
+		id := fmt.Sprintf("n%d", len(nodes))
+		nodeIDs[label] = id
+		nodes = append(nodes, label)
+		return id
+	}
+
+	type edge struct {
+		source, destination, label string
+	}
+	var edges []edge
+	for _, policy := range policies {
+		destination := policy.DestinationName
+		if policy.DestinationSpaceName != cmd.Config.TargetedSpace().Name || policy.DestinationOrgName != cmd.Config.TargetedOrganization().Name {
+			destination = fmt.Sprintf("%s (%s/%s)", policy.DestinationName, policy.DestinationOrgName, policy.DestinationSpaceName)
+		}
+		edges = append(edges, edge{
+			source:      nodeID(policy.SourceName),
+			destination: nodeID(destination),
+			label:       fmt.Sprintf("%s %s", policy.Protocol, networkPolicyPorts(policy)),
+		})
+	}
+
+	var graph bytes.Buffer
+	switch cmd.Graph.Format {
+	case "mermaid":
+		graph.WriteString("graph LR\n")
+		for i, label := range nodes {
+			fmt.Fprintf(&graph, "  n%d[\"%s\"]\n", i, strings.Replace(label, `"`, "#quot;", -1))
+		}
+		for _, e := range edges {
+			fmt.Fprintf(&graph, "  %s -->|%s| %s\n", e.source, e.label, e.destination)
+		}
+	default:
+		graph.WriteString("digraph network_policies {\n")
+		for i, label := range nodes {
+			fmt.Fprintf(&graph, "  n%d [label=%s];\n", i, strconv.Quote(label))
+		}
+		for _, e := range edges {
+			fmt.Fprintf(&graph, "  %s -> %s [label=%s];\n", e.source, e.destination, strconv.Quote(e.label))
+		}
+		graph.WriteString("}\n")
+	}
+	return graph.String()
+}
+
+// networkPolicyPorts returns the policy's port, or its range of ports when
+// the start and end ports differ.
+func networkPolicyPorts(policy cfnetworkingaction.Policy) string {
+	if policy.StartPort == policy.EndPort {
+		return strconv.Itoa(policy.StartPort)
+	}
+	return fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+}
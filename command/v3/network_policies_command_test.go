@@ -91,17 +91,21 @@ var _ = Describe("network-policies Command", func() {
 			BeforeEach(func() {
 				fakeActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
 					{
-						SourceName:      "app1",
-						DestinationName: "app2",
-						Protocol:        "tcp",
-						StartPort:       8080,
-						EndPort:         8080,
+						SourceName:           "app1",
+						DestinationName:      "app2",
+						Protocol:             "tcp",
+						StartPort:            8080,
+						EndPort:              8080,
+						DestinationSpaceName: "space1",
+						DestinationOrgName:   "org1",
 					}, {
-						SourceName:      "app2",
-						DestinationName: "app1",
-						Protocol:        "udp",
-						StartPort:       1234,
-						EndPort:         2345,
+						SourceName:           "app2",
+						DestinationName:      "app1",
+						Protocol:             "udp",
+						StartPort:            1234,
+						EndPort:              2345,
+						DestinationSpaceName: "space2",
+						DestinationOrgName:   "org2",
 					},
 				}, cfnetworkingaction.Warnings{"some-warning-1", "some-warning-2"}, nil)
 			})
@@ -114,9 +118,9 @@ var _ = Describe("network-policies Command", func() {
 
 				Expect(testUI.Out).To(Say(`Listing network policies in org some-org / space some-space as some-user\.\.\.`))
 				Expect(testUI.Out).To(Say("\n\n"))
-				Expect(testUI.Out).To(Say("source\\s+destination\\s+protocol\\s+ports"))
-				Expect(testUI.Out).To(Say("app1\\s+app2\\s+tcp\\s+8080[^-]"))
-				Expect(testUI.Out).To(Say("app2\\s+app1\\s+udp\\s+1234-2345"))
+				Expect(testUI.Out).To(Say("source\\s+destination\\s+protocol\\s+ports\\s+destination space\\s+destination org"))
+				Expect(testUI.Out).To(Say("app1\\s+app2\\s+tcp\\s+8080\\s+space1\\s+org1"))
+				Expect(testUI.Out).To(Say("app2\\s+app1\\s+udp\\s+1234-2345\\s+space2\\s+org2"))
 
 				Expect(testUI.Err).To(Say("some-warning-1"))
 				Expect(testUI.Err).To(Say("some-warning-2"))
@@ -127,17 +131,21 @@ var _ = Describe("network-policies Command", func() {
 					cmd.SourceApp = "some-app"
 					fakeActor.NetworkPoliciesBySpaceAndAppNameReturns([]cfnetworkingaction.Policy{
 						{
-							SourceName:      "app1",
-							DestinationName: "app2",
-							Protocol:        "tcp",
-							StartPort:       8080,
-							EndPort:         8080,
+							SourceName:           "app1",
+							DestinationName:      "app2",
+							Protocol:             "tcp",
+							StartPort:            8080,
+							EndPort:              8080,
+							DestinationSpaceName: "space1",
+							DestinationOrgName:   "org1",
 						}, {
-							SourceName:      "app2",
-							DestinationName: "app1",
-							Protocol:        "udp",
-							StartPort:       1234,
-							EndPort:         2345,
+							SourceName:           "app2",
+							DestinationName:      "app1",
+							Protocol:             "udp",
+							StartPort:            1234,
+							EndPort:              2345,
+							DestinationSpaceName: "space2",
+							DestinationOrgName:   "org2",
 						},
 					}, cfnetworkingaction.Warnings{"some-warning-1", "some-warning-2"}, nil)
 				})
@@ -151,14 +159,76 @@ var _ = Describe("network-policies Command", func() {
 
 					Expect(testUI.Out).To(Say(`Listing network policies of app %s in org some-org / space some-space as some-user\.\.\.`, cmd.SourceApp))
 					Expect(testUI.Out).To(Say("\n\n"))
-					Expect(testUI.Out).To(Say("source\\s+destination\\s+protocol\\s+ports"))
-					Expect(testUI.Out).To(Say("app1\\s+app2\\s+tcp\\s+8080[^-]"))
-					Expect(testUI.Out).To(Say("app2\\s+app1\\s+udp\\s+1234-2345"))
+					Expect(testUI.Out).To(Say("source\\s+destination\\s+protocol\\s+ports\\s+destination space\\s+destination org"))
+					Expect(testUI.Out).To(Say("app1\\s+app2\\s+tcp\\s+8080\\s+space1\\s+org1"))
+					Expect(testUI.Out).To(Say("app2\\s+app1\\s+udp\\s+1234-2345\\s+space2\\s+org2"))
 
 					Expect(testUI.Err).To(Say("some-warning-1"))
 					Expect(testUI.Err).To(Say("some-warning-2"))
 				})
 			})
+			When("a graph format is passed", func() {
+				BeforeEach(func() {
+					fakeActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+						{
+							SourceName:           "app1",
+							DestinationName:      "app2",
+							Protocol:             "tcp",
+							StartPort:            8080,
+							EndPort:              8080,
+							DestinationSpaceName: "some-space",
+							DestinationOrgName:   "some-org",
+						}, {
+							SourceName:           "app2",
+							DestinationName:      "app3",
+							Protocol:             "udp",
+							StartPort:            1234,
+							EndPort:              2345,
+							DestinationSpaceName: "space2",
+							DestinationOrgName:   "org2",
+						},
+					}, cfnetworkingaction.Warnings{"some-warning-1"}, nil)
+				})
+
+				When("the format is dot", func() {
+					BeforeEach(func() {
+						cmd.Graph.Format = "dot"
+					})
+
+					It("prints only the graph", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out.(*Buffer).Contents()).To(Equal([]byte(`digraph network_policies {
+  n0 [label="app1"];
+  n1 [label="app2"];
+  n2 [label="app3 (org2/space2)"];
+  n0 -> n1 [label="tcp 8080"];
+  n1 -> n2 [label="udp 1234-2345"];
+}
+`)))
+						Expect(testUI.Err).To(Say("some-warning-1"))
+					})
+				})
+
+				When("the format is mermaid", func() {
+					BeforeEach(func() {
+						cmd.Graph.Format = "mermaid"
+					})
+
+					It("prints only the graph", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out.(*Buffer).Contents()).To(Equal([]byte(`graph LR
+  n0["app1"]
+  n1["app2"]
+  n2["app3 (org2/space2)"]
+  n0 -->|tcp 8080| n1
+  n1 -->|udp 1234-2345| n2
+`)))
+						Expect(testUI.Err).To(Say("some-warning-1"))
+					})
+				})
+			})
 		})
 
 		When("listing the policies is not successful", func() {
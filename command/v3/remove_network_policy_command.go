@@ -17,22 +17,25 @@ import (
 //go:generate counterfeiter . RemoveNetworkPolicyActor
 
 type RemoveNetworkPolicyActor interface {
-	RemoveNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	RemoveNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
 }
 
 type RemoveNetworkPolicyCommand struct {
-	RequiredArgs   flag.RemoveNetworkPolicyArgs `positional-args:"yes"`
-	DestinationApp string                       `long:"destination-app" required:"true" description:"Name of app to connect to"`
-	Port           flag.NetworkPort             `long:"port" required:"true" description:"Port or range of ports that destination app is connected with"`
-	Protocol       flag.NetworkProtocol         `long:"protocol" required:"true" description:"Protocol that apps are connected with"`
-
-	usage           interface{} `usage:"CF_NAME remove-network-policy SOURCE_APP --destination-app DESTINATION_APP --protocol (tcp | udp) --port RANGE\n\nEXAMPLES:\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090"`
+	RequiredArgs     flag.RemoveNetworkPolicyArgs `positional-args:"yes"`
+	DestinationApp   string                       `long:"destination-app" required:"true" description:"Name of app to connect to"`
+	DestinationOrg   string                       `long:"destination-org" description:"The org of the destination app (Default: targeted org)"`
+	DestinationSpace string                       `long:"destination-space" description:"The space of the destination app (Default: targeted space)"`
+	Port             flag.NetworkPort             `long:"port" required:"true" description:"Port or range of ports that destination app is connected with"`
+	Protocol         flag.NetworkProtocol         `long:"protocol" required:"true" description:"Protocol that apps are connected with"`
+
+	usage           interface{} `usage:"CF_NAME remove-network-policy SOURCE_APP --destination-app DESTINATION_APP [--destination-space DESTINATION_SPACE [--destination-org DESTINATION_ORG]] --protocol (tcp | udp) --port RANGE\n\nEXAMPLES:\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8081\n   CF_NAME remove-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090\n   CF_NAME remove-network-policy frontend --destination-app backend --destination-org backend-org --destination-space backend-space --protocol tcp --port 8080"`
 	relatedCommands interface{} `related_commands:"apps, network-policies"`
 
-	UI          command.UI
-	Config      command.Config
-	SharedActor command.SharedActor
-	Actor       RemoveNetworkPolicyActor
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	Actor           RemoveNetworkPolicyActor
+	MembershipActor NetworkPolicyMembershipActor
 }
 
 func (cmd *RemoveNetworkPolicyCommand) Setup(config command.Config, ui command.UI) error {
@@ -55,11 +58,16 @@ func (cmd *RemoveNetworkPolicyCommand) Setup(config command.Config, ui command.U
 		return err
 	}
 	cmd.Actor = cfnetworkingaction.NewActor(networkingClient, v3Actor)
+	cmd.MembershipActor = v3Actor
 
 	return nil
 }
 
 func (cmd RemoveNetworkPolicyCommand) Execute(args []string) error {
+	if cmd.DestinationOrg != "" && cmd.DestinationSpace == "" {
+		return translatableerror.NetworkPolicyDestinationOrgWithoutSpaceError{}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -69,14 +77,33 @@ func (cmd RemoveNetworkPolicyCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
-	cmd.UI.DisplayTextWithFlavor("Removing network policy for app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
-		"SrcAppName": cmd.RequiredArgs.SourceApp,
-		"Org":        cmd.Config.TargetedOrganization().Name,
-		"Space":      cmd.Config.TargetedSpace().Name,
-		"User":       user.Name,
-	})
-
-	warnings, err := cmd.Actor.RemoveNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
+
+	if cmd.DestinationSpace == "" {
+		cmd.UI.DisplayTextWithFlavor("Removing network policy for app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName": cmd.RequiredArgs.SourceApp,
+			"Org":        cmd.Config.TargetedOrganization().Name,
+			"Space":      cmd.Config.TargetedSpace().Name,
+			"User":       user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Removing network policy from app {{.SrcAppName}} in org {{.Org}} / space {{.Space}} to app {{.DstAppName}} in org {{.DstOrg}} / space {{.DstSpace}} as {{.User}}...", map[string]interface{}{
+			"SrcAppName": cmd.RequiredArgs.SourceApp,
+			"Org":        cmd.Config.TargetedOrganization().Name,
+			"Space":      cmd.Config.TargetedSpace().Name,
+			"DstAppName": cmd.DestinationApp,
+			"DstOrg":     destinationOrgName(cmd.Config, cmd.DestinationOrg),
+			"DstSpace":   cmd.DestinationSpace,
+			"User":       user.Name,
+		})
+	}
+
+	destSpaceGUID, spaceWarnings, err := destinationSpaceGUID(cmd.Config, cmd.MembershipActor, cmd.DestinationOrg, cmd.DestinationSpace)
+	cmd.UI.DisplayWarnings(spaceWarnings)
+	if err != nil {
+		return err
+	}
+
+	warnings, err := cmd.Actor.RemoveNetworkPolicy(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SourceApp, destSpaceGUID, cmd.DestinationApp, cmd.Protocol.Protocol, cmd.Port.StartPort, cmd.Port.EndPort)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		switch err.(type) {
@@ -3,8 +3,10 @@ package v3_test
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -21,6 +23,7 @@ var _ = Describe("remove-network-policy Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeRemoveNetworkPolicyActor
+		fakeMembership  *v3fakes.FakeNetworkPolicyMembershipActor
 		binaryName      string
 		executeErr      error
 		srcApp          string
@@ -33,20 +36,22 @@ var _ = Describe("remove-network-policy Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeRemoveNetworkPolicyActor)
+		fakeMembership = new(v3fakes.FakeNetworkPolicyMembershipActor)
 
 		srcApp = "some-app"
 		destApp = "some-other-app"
 		protocol = "tcp"
 
 		cmd = RemoveNetworkPolicyCommand{
-			UI:             testUI,
-			Config:         fakeConfig,
-			SharedActor:    fakeSharedActor,
-			Actor:          fakeActor,
-			RequiredArgs:   flag.RemoveNetworkPolicyArgs{SourceApp: srcApp},
-			DestinationApp: destApp,
-			Protocol:       flag.NetworkProtocol{Protocol: protocol},
-			Port:           flag.NetworkPort{StartPort: 8080, EndPort: 8081},
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			MembershipActor: fakeMembership,
+			RequiredArgs:    flag.RemoveNetworkPolicyArgs{SourceApp: srcApp},
+			DestinationApp:  destApp,
+			Protocol:        flag.NetworkProtocol{Protocol: protocol},
+			Port:            flag.NetworkPort{StartPort: 8080, EndPort: 8081},
 		}
 
 		binaryName = "faceman"
@@ -76,7 +81,7 @@ var _ = Describe("remove-network-policy Command", func() {
 		BeforeEach(func() {
 			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
-			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
 		})
 
 		It("outputs flavor text", func() {
@@ -91,8 +96,9 @@ var _ = Describe("remove-network-policy Command", func() {
 			It("displays OK when no error occurs", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
-				passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
-				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
+				passedSrcSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				Expect(passedSrcSpaceGuid).To(Equal("some-space-guid"))
+				Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedSrcAppName).To(Equal("some-app"))
 				Expect(passedDestAppName).To(Equal("some-other-app"))
 				Expect(passedProtocol).To(Equal("tcp"))
@@ -114,8 +120,9 @@ var _ = Describe("remove-network-policy Command", func() {
 			It("displays OK when no error occurs", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
-				passedSpaceGuid, passedSrcAppName, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
-				Expect(passedSpaceGuid).To(Equal("some-space-guid"))
+				passedSrcSpaceGuid, passedSrcAppName, passedDestSpaceGuid, passedDestAppName, passedProtocol, passedStartPort, passedEndPort := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				Expect(passedSrcSpaceGuid).To(Equal("some-space-guid"))
+				Expect(passedDestSpaceGuid).To(Equal("some-space-guid"))
 				Expect(passedSrcAppName).To(Equal("some-app"))
 				Expect(passedDestAppName).To(Equal("some-other-app"))
 				Expect(passedProtocol).To(Equal("tcp"))
@@ -144,5 +151,55 @@ var _ = Describe("remove-network-policy Command", func() {
 				Expect(testUI.Out).ToNot(Say("OK"))
 			})
 		})
+
+		When("a destination org and space are specified", func() {
+			BeforeEach(func() {
+				cmd.DestinationOrg = "some-destination-org"
+				cmd.DestinationSpace = "some-destination-space"
+				fakeMembership.GetOrganizationByNameReturns(v3action.Organization{GUID: "some-destination-org-guid"}, v3action.Warnings{"org-warning"}, nil)
+				fakeMembership.GetSpaceByNameAndOrganizationReturns(v3action.Space{GUID: "some-destination-space-guid"}, v3action.Warnings{"space-warning"}, nil)
+			})
+
+			It("removes the policy for the app in that org and space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeMembership.GetOrganizationByNameArgsForCall(0)).To(Equal("some-destination-org"))
+				spaceName, orgGUID := fakeMembership.GetSpaceByNameAndOrganizationArgsForCall(0)
+				Expect(spaceName).To(Equal("some-destination-space"))
+				Expect(orgGUID).To(Equal("some-destination-org-guid"))
+
+				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(1))
+				passedSrcSpaceGuid, _, passedDestSpaceGuid, _, _, _, _ := fakeActor.RemoveNetworkPolicyArgsForCall(0)
+				Expect(passedSrcSpaceGuid).To(Equal("some-space-guid"))
+				Expect(passedDestSpaceGuid).To(Equal("some-destination-space-guid"))
+
+				Expect(testUI.Out).To(Say(`Removing network policy from app some-app in org some-org / space some-space to app some-other-app in org some-destination-org / space some-destination-space as some-user\.\.\.`))
+				Expect(testUI.Err).To(Say("org-warning"))
+				Expect(testUI.Err).To(Say("space-warning"))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+
+			When("the space cannot be found", func() {
+				BeforeEach(func() {
+					fakeMembership.GetSpaceByNameAndOrganizationReturns(v3action.Space{}, v3action.Warnings{"space-warning"}, actionerror.SpaceNotFoundError{Name: "some-destination-space"})
+				})
+
+				It("returns the error without removing the policy", func() {
+					Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-destination-space"}))
+					Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("a destination org is specified without a destination space", func() {
+			BeforeEach(func() {
+				cmd.DestinationOrg = "some-destination-org"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NetworkPolicyDestinationOrgWithoutSpaceError{}))
+				Expect(fakeActor.RemoveNetworkPolicyCallCount()).To(Equal(0))
+			})
+		})
 	})
 })
@@ -0,0 +1,158 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . RestartAllActor
+
+type RestartAllActor interface {
+	CloudControllerV3APIVersion() string
+	GetBulkApplications(orgName string, spaceName string, filter v2v3action.BulkApplicationFilter) ([]v2v3action.BulkApplication, v2v3action.Warnings, error)
+	RestartBulkApplications(apps []v2v3action.BulkApplication, maxInFlight int, newNOAAClient func() v2action.NOAAClient) []v2v3action.BulkApplicationResult
+}
+
+type RestartAllCommand struct {
+	Org                 string               `short:"o" long:"org" description:"Restart the apps in every space of this org"`
+	Space               string               `short:"s" long:"space" description:"Restart the apps in this space of the targeted org, or of --org (Default: targeted space)"`
+	Name                flag.AppNamePattern  `long:"name" description:"Only restart apps whose name matches this pattern, such as 'web-*'"`
+	Label               string               `long:"label" description:"Only restart apps whose labels match this selector, such as 'env=prod,tier!=web'"`
+	MaxInFlight         flag.PositiveInteger `long:"max-in-flight" default:"4" description:"Maximum number of apps restarted at the same time"`
+	Force               bool                 `short:"f" description:"Force restart without confirmation"`
+	usage               interface{}          `usage:"CF_NAME restart-all [-o ORG] [-s SPACE] [--name PATTERN] [--label SELECTOR] [--max-in-flight COUNT] [-f]\n\n   Restarts the started apps in the targeted space, or with --org in every space\n   of an org, and waits for them to stage and run. Stopped apps stay stopped.\n\nEXAMPLES:\n   CF_NAME restart-all -f\n   CF_NAME restart-all -o my-org --label 'env=staging' --max-in-flight 8\n   CF_NAME restart-all -s dev --name 'worker-*'"`
+	envCFStagingTimeout interface{}          `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}          `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	relatedCommands     interface{}          `related_commands:"apps, restart, start-all, stop-all"`
+
+	UI            command.UI
+	Config        command.Config
+	SharedActor   command.SharedActor
+	Actor         RestartAllActor
+	NewNOAAClient func() v2action.NOAAClient
+}
+
+func (cmd *RestartAllCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClientV3, uaaClientV3, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumCFAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionApplicationFlowV3}
+		}
+
+		return err
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v2v3action.NewActor(
+		v2action.NewActor(ccClientV2, uaaClientV2, config),
+		v3action.NewActor(ccClientV3, config, sharedActor, uaaClientV3),
+	)
+
+	newNOAAClient, err := sharedV2.NOAAClientFactory(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NewNOAAClient = func() v2action.NOAAClient {
+		return newNOAAClient()
+	}
+
+	return nil
+}
+
+func (cmd RestartAllCommand) Execute(args []string) error {
+	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionApplicationFlowV3)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Label != "" {
+		err = command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionLabelsV3, "Option '--label'")
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Org == "", cmd.Org == "" && cmd.Space == "")
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName, spaceName := shared.BulkAppScope(cmd.Config, cmd.Org, cmd.Space)
+	if spaceName == "" {
+		cmd.UI.DisplayTextWithFlavor("Restarting apps in every space of org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  orgName,
+			"Username": user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Restarting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   orgName,
+			"SpaceName": spaceName,
+			"Username":  user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	apps, warnings, err := cmd.Actor.GetBulkApplications(orgName, spaceName, v2v3action.BulkApplicationFilter{
+		NamePattern:   cmd.Name.Pattern,
+		LabelSelector: cmd.Label,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var started []v2v3action.BulkApplication
+	for _, app := range apps {
+		if app.Started() {
+			started = append(started, app)
+		}
+	}
+
+	if len(started) == 0 {
+		cmd.UI.DisplayText("No started apps found.")
+		return nil
+	}
+
+	if !cmd.Force {
+		restartApps, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really restart these {{.Count}} apps?", map[string]interface{}{
+			"Count": len(started),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !restartApps {
+			cmd.UI.DisplayText("Apps have not been restarted")
+			return nil
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	results := cmd.Actor.RestartBulkApplications(started, cmd.MaxInFlight.Value, cmd.NewNOAAClient)
+	return shared.DisplayBulkAppResults(cmd.UI, "restart", "restarted", results)
+}
@@ -0,0 +1,151 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("restart-all Command", func() {
+	var (
+		cmd             v3.RestartAllCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeRestartAllActor
+		input           *Buffer
+		binaryName      string
+		startedApp      v2v3action.BulkApplication
+		stoppedApp      v2v3action.BulkApplication
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeRestartAllActor)
+
+		cmd = v3.RestartAllCommand{
+			UI:            testUI,
+			Config:        fakeConfig,
+			SharedActor:   fakeSharedActor,
+			Actor:         fakeActor,
+			MaxInFlight:   flag.PositiveInteger{Value: 4},
+			NewNOAAClient: func() v2action.NOAAClient { return nil },
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinVersionApplicationFlowV3)
+
+		startedApp = v2v3action.BulkApplication{
+			Application: v3action.Application{GUID: "web-guid", Name: "web", State: constant.ApplicationStarted},
+			SpaceName:   "some-space",
+		}
+		stoppedApp = v2v3action.BulkApplication{
+			Application: v3action.Application{GUID: "worker-guid", Name: "worker", State: constant.ApplicationStopped},
+			SpaceName:   "some-space",
+		}
+		fakeActor.GetBulkApplicationsReturns([]v2v3action.BulkApplication{startedApp, stoppedApp}, v2v3action.Warnings{"get-warning"}, nil)
+		fakeActor.RestartBulkApplicationsReturns([]v2v3action.BulkApplicationResult{
+			{Application: startedApp, Warnings: v2v3action.Warnings{"restart-warning"}},
+		})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("-f is given", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("restarts only the started apps without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Restarting apps in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).ToNot(Say("Really restart"))
+			Expect(testUI.Out).To(Say(`some-space\s+web\s+restarted`))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(testUI.Err).To(Say("restart-warning"))
+
+			apps, maxInFlight, newNOAAClient := fakeActor.RestartBulkApplicationsArgsForCall(0)
+			Expect(apps).To(Equal([]v2v3action.BulkApplication{startedApp}))
+			Expect(maxInFlight).To(Equal(4))
+			Expect(newNOAAClient).ToNot(BeNil())
+		})
+
+		When("restarting some apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.RestartBulkApplicationsReturns([]v2v3action.BulkApplicationResult{
+					{Application: startedApp, Err: errors.New("restart failed")},
+				})
+			})
+
+			It("returns the failures", func() {
+				Expect(executeErr).To(MatchError(translatableerror.BulkAppOperationFailedError{
+					Operation: "restart",
+					Failures: []translatableerror.BulkAppFailure{
+						{SpaceName: "some-space", AppName: "web", Err: errors.New("restart failed")},
+					},
+				}))
+			})
+		})
+	})
+
+	When("the user does not confirm", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not restart the apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really restart these 1 apps\?`))
+			Expect(testUI.Out).To(Say("Apps have not been restarted"))
+			Expect(fakeActor.RestartBulkApplicationsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no started apps", func() {
+		BeforeEach(func() {
+			fakeActor.GetBulkApplicationsReturns([]v2v3action.BulkApplication{stoppedApp}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No started apps found."))
+			Expect(fakeActor.RestartBulkApplicationsCallCount()).To(Equal(0))
+		})
+	})
+})
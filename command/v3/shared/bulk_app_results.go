@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// BulkAppScope returns the org and space that stop-all, start-all and
+// restart-all act on, given their --org and --space flags. An empty space
+// means every space in the org. Without either flag, the targeted space is
+// used.
+func BulkAppScope(config command.Config, org string, space string) (string, string) {
+	orgName, spaceName := config.TargetedOrganization().Name, config.TargetedSpace().Name
+	if org != "" {
+		orgName, spaceName = org, ""
+	}
+	if space != "" {
+		spaceName = space
+	}
+	return orgName, spaceName
+}
+
+// DisplayBulkAppResults displays the warnings and the result of a bulk
+// lifecycle operation for every app, showing doneText for the apps it
+// succeeded on. It returns a BulkAppOperationFailedError listing the apps the
+// operation failed on.
+func DisplayBulkAppResults(commandUI command.UI, operation string, doneText string, results []v2v3action.BulkApplicationResult) error {
+	table := [][]string{
+		{
+			commandUI.TranslateText("space"),
+			commandUI.TranslateText("name"),
+			commandUI.TranslateText("result"),
+		},
+	}
+
+	var failures []translatableerror.BulkAppFailure
+	for _, result := range results {
+		commandUI.DisplayWarnings(result.Warnings)
+
+		var text string
+		switch {
+		case result.Err != nil:
+			text = commandUI.TranslateText("failed")
+			failures = append(failures, translatableerror.BulkAppFailure{
+				SpaceName: result.Application.SpaceName,
+				AppName:   result.Application.Name,
+				Err:       translatableerror.ConvertToTranslatableError(result.Err),
+			})
+		case result.Skipped:
+			text = commandUI.TranslateText("skipped")
+		default:
+			text = commandUI.TranslateText(doneText)
+		}
+
+		table = append(table, []string{result.Application.SpaceName, result.Application.Name, text})
+	}
+
+	commandUI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if len(failures) > 0 {
+		return translatableerror.BulkAppOperationFailedError{
+			Operation: operation,
+			Failures:  failures,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package shared_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Bulk app results", func() {
+	DescribeTable("BulkAppScope",
+		func(org string, space string, expectedOrg string, expectedSpace string) {
+			fakeConfig := new(commandfakes.FakeConfig)
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "targeted-space"})
+
+			orgName, spaceName := BulkAppScope(fakeConfig, org, space)
+			Expect(orgName).To(Equal(expectedOrg))
+			Expect(spaceName).To(Equal(expectedSpace))
+		},
+		Entry("defaults to the targeted space", "", "", "targeted-org", "targeted-space"),
+		Entry("uses a space in the targeted org", "", "dev", "targeted-org", "dev"),
+		Entry("uses every space in the org", "other-org", "", "other-org", ""),
+		Entry("uses a space in the org", "other-org", "dev", "other-org", "dev"),
+	)
+
+	Describe("DisplayBulkAppResults", func() {
+		var (
+			testUI  *ui.UI
+			results []v2v3action.BulkApplicationResult
+		)
+
+		BeforeEach(func() {
+			testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+			results = []v2v3action.BulkApplicationResult{
+				{
+					Application: v2v3action.BulkApplication{Application: v3action.Application{Name: "web"}, SpaceName: "dev"},
+					Warnings:    v2v3action.Warnings{"stop-warning"},
+				},
+				{
+					Application: v2v3action.BulkApplication{Application: v3action.Application{Name: "worker"}, SpaceName: "dev"},
+					Skipped:     true,
+				},
+			}
+		})
+
+		It("displays the result and warnings of every app", func() {
+			Expect(DisplayBulkAppResults(testUI, "stop", "stopped", results)).To(Succeed())
+			Expect(testUI.Out).To(Say(`space\s+name\s+result`))
+			Expect(testUI.Out).To(Say(`dev\s+web\s+stopped`))
+			Expect(testUI.Out).To(Say(`dev\s+worker\s+skipped`))
+			Expect(testUI.Err).To(Say("stop-warning"))
+		})
+
+		When("the operation failed for some apps", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("stop failed")
+				results = append(results,
+					v2v3action.BulkApplicationResult{
+						Application: v2v3action.BulkApplication{Application: v3action.Application{Name: "api"}, SpaceName: "prod"},
+						Err:         expectedErr,
+					},
+					v2v3action.BulkApplicationResult{
+						Application: v2v3action.BulkApplication{Application: v3action.Application{Name: "gone"}, SpaceName: "prod"},
+						Err:         actionerror.ApplicationNotFoundError{Name: "gone"},
+					},
+				)
+			})
+
+			It("returns the failures with translatable errors", func() {
+				err := DisplayBulkAppResults(testUI, "stop", "stopped", results)
+				Expect(err).To(MatchError(translatableerror.BulkAppOperationFailedError{
+					Operation: "stop",
+					Failures: []translatableerror.BulkAppFailure{
+						{SpaceName: "prod", AppName: "api", Err: expectedErr},
+						{SpaceName: "prod", AppName: "gone", Err: translatableerror.ApplicationNotFoundError{Name: "gone"}},
+					},
+				}))
+				Expect(testUI.Out).To(Say(`prod\s+api\s+failed`))
+				Expect(testUI.Out).To(Say(`prod\s+gone\s+failed`))
+			})
+		})
+	})
+})
@@ -14,6 +14,14 @@ import (
 func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV3 string) (*ccv3.Client, *uaa.Client, error) {
 	ccWrappers := []ccv3.ConnectionWrapper{}
 
+	// Recordings are made and replayed beneath the other wrappers, so that they
+	// contain the Cloud Controller's responses before they are handled.
+	if directory := config.ReplayDirectory(); directory != "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewReplayRequest(directory), ccv3.NewErrorWrapper())
+	} else if directory := config.RecordDirectory(); directory != "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRecordRequest(directory))
+	}
+
 	verbose, location := config.Verbose()
 	if verbose {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -21,6 +29,16 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 	if location != nil {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
+	harFiles := config.TraceHARFiles()
+	if harFiles != nil {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerHARWriter(harFiles)))
+	}
+
+	// Responses are cached above the loggers, so that the logs only contain the
+	// requests that are sent to the Cloud Controller.
+	if enabled, ttl := config.HTTPCache(); enabled && config.ReplayDirectory() == "" && config.RecordDirectory() == "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewCacheRequest(config.HTTPCacheDirectory(), ttl, config))
+	}
 
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
@@ -45,9 +63,16 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 		}
 	}
 
-	_, err := ccClient.TargetCF(ccv3.TargetSettings{
+	rootCAs, certificates, err := config.TLSCertificates()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = ccClient.TargetCF(ccv3.TargetSettings{
 		URL:               config.Target(),
 		SkipSSLValidation: config.SkipSSLValidation(),
+		RootCAs:           rootCAs,
+		Certificates:      certificates,
 		DialTimeout:       config.DialTimeout(),
 	})
 	if err != nil {
@@ -68,7 +93,17 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 		return nil, nil, translatableerror.UAAEndpointNotFoundError{}
 	}
 
-	uaaClient := uaa.NewClient(config)
+	uaaClient, err := uaa.NewClient(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if directory := config.ReplayDirectory(); directory != "" {
+		uaaClient.WrapConnection(uaaWrapper.NewReplayRequest(directory))
+		uaaClient.WrapConnection(uaa.NewErrorWrapper())
+	} else if directory := config.RecordDirectory(); directory != "" {
+		uaaClient.WrapConnection(uaaWrapper.NewRecordRequest(directory))
+	}
 
 	if verbose {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -76,6 +111,9 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 	if location != nil {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
+	if harFiles != nil {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerHARWriter(harFiles)))
+	}
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
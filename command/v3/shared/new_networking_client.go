@@ -23,6 +23,9 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 	if location != nil {
 		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
+	if harFiles := config.TraceHARFiles(); harFiles != nil {
+		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerHARWriter(harFiles)))
+	}
 
 	authWrapper := wrapper.NewUAAAuthentication(uaaClient, config)
 	wrappers = append(wrappers, authWrapper)
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"net/http"
 	"time"
 
@@ -38,11 +39,34 @@ func (p DebugPrinter) Print(title string, dump string) {
 }
 
 // NewNOAAClient returns back a configured NOAA Client.
-func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) *consumer.Consumer {
+func NewNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) (*consumer.Consumer, error) {
+	newClient, err := NOAAClientFactory(apiURL, config, uaaClient, ui)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(), nil
+}
+
+// NOAAClientFactory loads the configured certificates and returns a function
+// that creates NOAA clients which use them.
+func NOAAClientFactory(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) (func() *consumer.Consumer, error) {
+	rootCAs, certificates, err := config.TLSCertificates()
+	if err != nil {
+		return nil, err
+	}
+
+	return func() *consumer.Consumer {
+		return newNOAAClient(apiURL, config, uaaClient, ui, rootCAs, certificates)
+	}, nil
+}
+
+func newNOAAClient(apiURL string, config command.Config, uaaClient *uaa.Client, ui command.UI, rootCAs *x509.CertPool, certificates []tls.Certificate) *consumer.Consumer {
 	client := consumer.New(
 		apiURL,
 		&tls.Config{
 			InsecureSkipVerify: config.SkipSSLValidation(),
+			RootCAs:            rootCAs,
+			Certificates:       certificates,
 		},
 		http.ProxyFromEnvironment,
 	)
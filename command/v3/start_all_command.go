@@ -0,0 +1,166 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/stoppedapps"
+)
+
+//go:generate counterfeiter . StartAllActor
+
+type StartAllActor interface {
+	CloudControllerV3APIVersion() string
+	GetBulkApplications(orgName string, spaceName string, filter v2v3action.BulkApplicationFilter) ([]v2v3action.BulkApplication, v2v3action.Warnings, error)
+	StartBulkApplications(apps []v2v3action.BulkApplication, maxInFlight int, newNOAAClient func() v2action.NOAAClient) []v2v3action.BulkApplicationResult
+}
+
+type StartAllCommand struct {
+	Org                 string               `short:"o" long:"org" description:"Start the apps in every space of this org"`
+	Space               string               `short:"s" long:"space" description:"Start the apps in this space of the targeted org, or of --org (Default: targeted space)"`
+	Name                flag.AppNamePattern  `long:"name" description:"Only start apps whose name matches this pattern, such as 'web-*'"`
+	Label               string               `long:"label" description:"Only start apps whose labels match this selector, such as 'env=prod,tier!=web'"`
+	MaxInFlight         flag.PositiveInteger `long:"max-in-flight" default:"4" description:"Maximum number of apps started at the same time"`
+	Restore             bool                 `long:"restore" description:"Only start the apps that were running when stop-all stopped them"`
+	usage               interface{}          `usage:"CF_NAME start-all [-o ORG] [-s SPACE] [--name PATTERN] [--label SELECTOR] [--max-in-flight COUNT] [--restore]\n\n   Starts the stopped apps in the targeted space, or with --org in every space of\n   an org, and waits for them to stage and run.\n\nEXAMPLES:\n   CF_NAME start-all --restore\n   CF_NAME start-all -o my-org --label 'env=staging' --restore\n   CF_NAME start-all -s dev --name 'worker-*' --max-in-flight 2"`
+	envCFStagingTimeout interface{}          `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout interface{}          `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	relatedCommands     interface{}          `related_commands:"apps, restart-all, start, stop-all"`
+
+	UI            command.UI
+	Config        command.Config
+	SharedActor   command.SharedActor
+	Actor         StartAllActor
+	NewNOAAClient func() v2action.NOAAClient
+}
+
+func (cmd *StartAllCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClientV3, uaaClientV3, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumCFAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionApplicationFlowV3}
+		}
+
+		return err
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v2v3action.NewActor(
+		v2action.NewActor(ccClientV2, uaaClientV2, config),
+		v3action.NewActor(ccClientV3, config, sharedActor, uaaClientV3),
+	)
+
+	newNOAAClient, err := sharedV2.NOAAClientFactory(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NewNOAAClient = func() v2action.NOAAClient {
+		return newNOAAClient()
+	}
+
+	return nil
+}
+
+func (cmd StartAllCommand) Execute(args []string) error {
+	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionApplicationFlowV3)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Label != "" {
+		err = command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionLabelsV3, "Option '--label'")
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Org == "", cmd.Org == "" && cmd.Space == "")
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	record, err := stoppedapps.Read(cmd.Config.StoppedAppsFilePath())
+	if err != nil {
+		return err
+	}
+
+	orgName, spaceName := shared.BulkAppScope(cmd.Config, cmd.Org, cmd.Space)
+	if spaceName == "" {
+		cmd.UI.DisplayTextWithFlavor("Starting apps in every space of org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  orgName,
+			"Username": user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Starting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   orgName,
+			"SpaceName": spaceName,
+			"Username":  user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	apps, warnings, err := cmd.Actor.GetBulkApplications(orgName, spaceName, v2v3action.BulkApplicationFilter{
+		NamePattern:   cmd.Name.Pattern,
+		LabelSelector: cmd.Label,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var stopped []v2v3action.BulkApplication
+	for _, app := range apps {
+		if app.Started() || (cmd.Restore && !record.Has(app.GUID)) {
+			continue
+		}
+		stopped = append(stopped, app)
+	}
+
+	if len(stopped) == 0 {
+		if cmd.Restore {
+			cmd.UI.DisplayText("No apps stopped by stop-all found.")
+		} else {
+			cmd.UI.DisplayText("No stopped apps found.")
+		}
+		return nil
+	}
+
+	results := cmd.Actor.StartBulkApplications(stopped, cmd.MaxInFlight.Value, cmd.NewNOAAClient)
+
+	// Started apps no longer need to be restored.
+	for _, result := range results {
+		if result.Err == nil {
+			record.Remove(result.Application.GUID)
+		}
+	}
+	err = stoppedapps.Write(cmd.Config.StoppedAppsFilePath(), record)
+	if err != nil {
+		return err
+	}
+
+	return shared.DisplayBulkAppResults(cmd.UI, "start", "started", results)
+}
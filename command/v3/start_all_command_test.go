@@ -0,0 +1,216 @@
+package v3_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/stoppedapps"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("start-all Command", func() {
+	var (
+		cmd             v3.StartAllCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeStartAllActor
+		binaryName      string
+		recordDir       string
+		recordPath      string
+		startedApp      v2v3action.BulkApplication
+		stoppedApp      v2v3action.BulkApplication
+		otherStoppedApp v2v3action.BulkApplication
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeStartAllActor)
+
+		cmd = v3.StartAllCommand{
+			UI:            testUI,
+			Config:        fakeConfig,
+			SharedActor:   fakeSharedActor,
+			Actor:         fakeActor,
+			MaxInFlight:   flag.PositiveInteger{Value: 4},
+			NewNOAAClient: func() v2action.NOAAClient { return nil },
+		}
+
+		var err error
+		recordDir, err = ioutil.TempDir("", "start-all")
+		Expect(err).ToNot(HaveOccurred())
+		recordPath = filepath.Join(recordDir, "stopped-apps.json")
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		fakeConfig.StoppedAppsFilePathReturns(recordPath)
+		fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinVersionApplicationFlowV3)
+
+		startedApp = v2v3action.BulkApplication{
+			Application: v3action.Application{GUID: "api-guid", Name: "api", State: constant.ApplicationStarted},
+			SpaceName:   "some-space",
+		}
+		stoppedApp = v2v3action.BulkApplication{
+			Application: v3action.Application{GUID: "web-guid", Name: "web", State: constant.ApplicationStopped},
+			SpaceName:   "some-space",
+		}
+		otherStoppedApp = v2v3action.BulkApplication{
+			Application: v3action.Application{GUID: "worker-guid", Name: "worker", State: constant.ApplicationStopped},
+			SpaceName:   "some-space",
+		}
+		fakeActor.GetBulkApplicationsReturns([]v2v3action.BulkApplication{startedApp, stoppedApp, otherStoppedApp}, v2v3action.Warnings{"get-warning"}, nil)
+		fakeActor.StartBulkApplicationsStub = func(apps []v2v3action.BulkApplication, _ int, _ func() v2action.NOAAClient) []v2v3action.BulkApplicationResult {
+			var results []v2v3action.BulkApplicationResult
+			for _, app := range apps {
+				results = append(results, v2v3action.BulkApplicationResult{Application: app, Warnings: v2v3action.Warnings{"start-warning"}})
+			}
+			return results
+		}
+
+		record := stoppedapps.Record{}
+		record.Add(
+			stoppedapps.App{GUID: "web-guid", Name: "web"},
+			stoppedapps.App{GUID: "deleted-guid", Name: "deleted"},
+		)
+		Expect(stoppedapps.Write(recordPath, record)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(recordDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	When("--space is given", func() {
+		BeforeEach(func() {
+			cmd.Space = "dev"
+		})
+
+		It("only requires a targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			orgName, spaceName, _ := fakeActor.GetBulkApplicationsArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(spaceName).To(Equal("dev"))
+			Expect(testUI.Out).To(Say(`Starting apps in org some-org / space dev as some-user\.\.\.`))
+		})
+	})
+
+	It("starts every stopped app and removes them from the record", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`some-space\s+web\s+started`))
+		Expect(testUI.Out).To(Say(`some-space\s+worker\s+started`))
+		Expect(testUI.Err).To(Say("get-warning"))
+		Expect(testUI.Err).To(Say("start-warning"))
+
+		apps, maxInFlight, newNOAAClient := fakeActor.StartBulkApplicationsArgsForCall(0)
+		Expect(apps).To(Equal([]v2v3action.BulkApplication{stoppedApp, otherStoppedApp}))
+		Expect(maxInFlight).To(Equal(4))
+		Expect(newNOAAClient).ToNot(BeNil())
+
+		record, err := stoppedapps.Read(recordPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(record).To(Equal(stoppedapps.Record{
+			"deleted-guid": {GUID: "deleted-guid", Name: "deleted"},
+		}))
+	})
+
+	When("--restore is given", func() {
+		BeforeEach(func() {
+			cmd.Restore = true
+		})
+
+		It("only starts the apps stopped by stop-all", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			apps, _, _ := fakeActor.StartBulkApplicationsArgsForCall(0)
+			Expect(apps).To(Equal([]v2v3action.BulkApplication{stoppedApp}))
+		})
+
+		When("no apps were stopped by stop-all", func() {
+			BeforeEach(func() {
+				Expect(stoppedapps.Write(recordPath, stoppedapps.Record{})).To(Succeed())
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No apps stopped by stop-all found."))
+				Expect(fakeActor.StartBulkApplicationsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("starting some apps fails", func() {
+		BeforeEach(func() {
+			cmd.Restore = true
+			fakeActor.StartBulkApplicationsReturns([]v2v3action.BulkApplicationResult{
+				{Application: stoppedApp, Err: errors.New("staging failed")},
+			})
+			fakeActor.StartBulkApplicationsStub = nil
+		})
+
+		It("returns the failures and keeps the apps in the record", func() {
+			Expect(executeErr).To(MatchError(translatableerror.BulkAppOperationFailedError{
+				Operation: "start",
+				Failures: []translatableerror.BulkAppFailure{
+					{SpaceName: "some-space", AppName: "web", Err: errors.New("staging failed")},
+				},
+			}))
+
+			record, err := stoppedapps.Read(recordPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record.Has("web-guid")).To(BeTrue())
+		})
+	})
+
+	When("there are no stopped apps", func() {
+		BeforeEach(func() {
+			fakeActor.GetBulkApplicationsReturns([]v2v3action.BulkApplication{startedApp}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No stopped apps found."))
+			Expect(fakeActor.StartBulkApplicationsCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,175 @@
+package v3
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/stoppedapps"
+)
+
+//go:generate counterfeiter . StopAllActor
+
+type StopAllActor interface {
+	CloudControllerV3APIVersion() string
+	GetBulkApplications(orgName string, spaceName string, filter v2v3action.BulkApplicationFilter) ([]v2v3action.BulkApplication, v2v3action.Warnings, error)
+	StopBulkApplications(apps []v2v3action.BulkApplication, maxInFlight int) []v2v3action.BulkApplicationResult
+}
+
+type StopAllCommand struct {
+	Org             string               `short:"o" long:"org" description:"Stop the apps in every space of this org"`
+	Space           string               `short:"s" long:"space" description:"Stop the apps in this space of the targeted org, or of --org (Default: targeted space)"`
+	Name            flag.AppNamePattern  `long:"name" description:"Only stop apps whose name matches this pattern, such as 'web-*'"`
+	Label           string               `long:"label" description:"Only stop apps whose labels match this selector, such as 'env=prod,tier!=web'"`
+	MaxInFlight     flag.PositiveInteger `long:"max-in-flight" default:"4" description:"Maximum number of apps stopped at the same time"`
+	Force           bool                 `short:"f" description:"Force stop without confirmation"`
+	usage           interface{}          `usage:"CF_NAME stop-all [-o ORG] [-s SPACE] [--name PATTERN] [--label SELECTOR] [--max-in-flight COUNT] [-f]\n\n   Stops the started apps in the targeted space, or with --org in every space of\n   an org. The apps that were running are recorded so that start-all --restore\n   starts only those apps again.\n\nEXAMPLES:\n   CF_NAME stop-all\n   CF_NAME stop-all -o my-org --label 'env=staging' -f\n   CF_NAME stop-all -s dev --name 'worker-*'"`
+	relatedCommands interface{}          `related_commands:"apps, restart-all, start-all, stop"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       StopAllActor
+}
+
+func (cmd *StopAllCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClientV3, uaaClientV3, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		if v3Err, ok := err.(ccerror.V3UnexpectedResponseError); ok && v3Err.ResponseCode == http.StatusNotFound {
+			return translatableerror.MinimumCFAPIVersionNotMetError{MinimumVersion: ccversion.MinVersionApplicationFlowV3}
+		}
+
+		return err
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v2v3action.NewActor(
+		v2action.NewActor(ccClientV2, uaaClientV2, config),
+		v3action.NewActor(ccClientV3, config, sharedActor, uaaClientV3),
+	)
+
+	return nil
+}
+
+func (cmd StopAllCommand) Execute(args []string) error {
+	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionApplicationFlowV3)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Label != "" {
+		err = command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerV3APIVersion(), ccversion.MinVersionLabelsV3, "Option '--label'")
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Org == "", cmd.Org == "" && cmd.Space == "")
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgName, spaceName := shared.BulkAppScope(cmd.Config, cmd.Org, cmd.Space)
+	if spaceName == "" {
+		cmd.UI.DisplayTextWithFlavor("Stopping apps in every space of org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  orgName,
+			"Username": user.Name,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Stopping apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   orgName,
+			"SpaceName": spaceName,
+			"Username":  user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	apps, warnings, err := cmd.Actor.GetBulkApplications(orgName, spaceName, v2v3action.BulkApplicationFilter{
+		NamePattern:   cmd.Name.Pattern,
+		LabelSelector: cmd.Label,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var started []v2v3action.BulkApplication
+	for _, app := range apps {
+		if app.Started() {
+			started = append(started, app)
+		}
+	}
+
+	if len(started) == 0 {
+		cmd.UI.DisplayText("No started apps found.")
+		return nil
+	}
+
+	if !cmd.Force {
+		stopApps, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really stop these {{.Count}} apps?", map[string]interface{}{
+			"Count": len(started),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !stopApps {
+			cmd.UI.DisplayText("Apps have not been stopped")
+			return nil
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	results := cmd.Actor.StopBulkApplications(started, cmd.MaxInFlight.Value)
+
+	// The apps are recorded before the results are displayed so that the
+	// ones that stopped can be restored even when others failed to stop.
+	err = cmd.recordStoppedApps(results)
+	if err != nil {
+		return err
+	}
+
+	return shared.DisplayBulkAppResults(cmd.UI, "stop", "stopped", results)
+}
+
+func (cmd StopAllCommand) recordStoppedApps(results []v2v3action.BulkApplicationResult) error {
+	record, err := stoppedapps.Read(cmd.Config.StoppedAppsFilePath())
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		if result.Err == nil && !result.Skipped {
+			record.Add(stoppedapps.App{
+				GUID:      result.Application.GUID,
+				Name:      result.Application.Name,
+				SpaceGUID: result.Application.SpaceGUID,
+			})
+		}
+	}
+
+	return stoppedapps.Write(cmd.Config.StoppedAppsFilePath(), record)
+}
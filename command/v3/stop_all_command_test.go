@@ -0,0 +1,265 @@
+package v3_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/stoppedapps"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("stop-all Command", func() {
+	var (
+		cmd             v3.StopAllCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeStopAllActor
+		input           *Buffer
+		binaryName      string
+		recordDir       string
+		recordPath      string
+		startedApp      v2v3action.BulkApplication
+		stoppedApp      v2v3action.BulkApplication
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeStopAllActor)
+
+		cmd = v3.StopAllCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			MaxInFlight: flag.PositiveInteger{Value: 4},
+		}
+
+		var err error
+		recordDir, err = ioutil.TempDir("", "stop-all")
+		Expect(err).ToNot(HaveOccurred())
+		recordPath = filepath.Join(recordDir, "stopped-apps.json")
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		fakeConfig.StoppedAppsFilePathReturns(recordPath)
+		fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinVersionLabelsV3)
+
+		startedApp = v2v3action.BulkApplication{
+			Application: v3action.Application{GUID: "web-guid", Name: "web", SpaceGUID: "space-guid", State: constant.ApplicationStarted},
+			SpaceName:   "some-space",
+		}
+		stoppedApp = v2v3action.BulkApplication{
+			Application: v3action.Application{GUID: "worker-guid", Name: "worker", SpaceGUID: "space-guid", State: constant.ApplicationStopped},
+			SpaceName:   "some-space",
+		}
+		fakeActor.GetBulkApplicationsReturns([]v2v3action.BulkApplication{startedApp, stoppedApp}, v2v3action.Warnings{"get-warning"}, nil)
+		fakeActor.StopBulkApplicationsStub = func(apps []v2v3action.BulkApplication, _ int) []v2v3action.BulkApplicationResult {
+			var results []v2v3action.BulkApplicationResult
+			for _, app := range apps {
+				results = append(results, v2v3action.BulkApplicationResult{Application: app, Warnings: v2v3action.Warnings{"stop-warning"}})
+			}
+			return results
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(recordDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinV3ClientVersion)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				CurrentVersion: ccversion.MinV3ClientVersion,
+				MinimumVersion: ccversion.MinVersionApplicationFlowV3,
+			}))
+		})
+	})
+
+	When("--label is given and the API version does not support labels", func() {
+		BeforeEach(func() {
+			cmd.Label = "env=prod"
+			fakeActor.CloudControllerV3APIVersionReturns(ccversion.MinVersionApplicationFlowV3)
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.MinimumCFAPIVersionNotMetError{
+				Command:        "Option '--label'",
+				CurrentVersion: ccversion.MinVersionApplicationFlowV3,
+				MinimumVersion: ccversion.MinVersionLabelsV3,
+			}))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("--org is given", func() {
+		BeforeEach(func() {
+			cmd.Org = "other-org"
+			cmd.Force = true
+		})
+
+		It("stops the apps in every space of the org without requiring a target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			orgName, spaceName, _ := fakeActor.GetBulkApplicationsArgsForCall(0)
+			Expect(orgName).To(Equal("other-org"))
+			Expect(spaceName).To(BeEmpty())
+			Expect(testUI.Out).To(Say(`Stopping apps in every space of org other-org as some-user\.\.\.`))
+		})
+	})
+
+	When("-f is given", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+			cmd.Name = flag.AppNamePattern{Pattern: "w*"}
+			cmd.Label = "env=prod"
+			cmd.MaxInFlight = flag.PositiveInteger{Value: 2}
+		})
+
+		It("stops the started apps without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Stopping apps in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).ToNot(Say("Really stop"))
+			Expect(testUI.Out).To(Say(`space\s+name\s+result`))
+			Expect(testUI.Out).To(Say(`some-space\s+web\s+stopped`))
+			Expect(testUI.Err).To(Say("get-warning"))
+			Expect(testUI.Err).To(Say("stop-warning"))
+
+			orgName, spaceName, filter := fakeActor.GetBulkApplicationsArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(filter).To(Equal(v2v3action.BulkApplicationFilter{NamePattern: "w*", LabelSelector: "env=prod"}))
+
+			apps, maxInFlight := fakeActor.StopBulkApplicationsArgsForCall(0)
+			Expect(apps).To(Equal([]v2v3action.BulkApplication{startedApp}))
+			Expect(maxInFlight).To(Equal(2))
+		})
+
+		It("records the stopped apps", func() {
+			record, err := stoppedapps.Read(recordPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record).To(Equal(stoppedapps.Record{
+				"web-guid": {GUID: "web-guid", Name: "web", SpaceGUID: "space-guid"},
+			}))
+		})
+
+		When("stopping some apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.StopBulkApplicationsReturns([]v2v3action.BulkApplicationResult{
+					{Application: startedApp, Err: errors.New("stop failed")},
+				})
+				fakeActor.StopBulkApplicationsStub = nil
+			})
+
+			It("returns the failures and does not record the apps", func() {
+				Expect(executeErr).To(MatchError(translatableerror.BulkAppOperationFailedError{
+					Operation: "stop",
+					Failures: []translatableerror.BulkAppFailure{
+						{SpaceName: "some-space", AppName: "web", Err: errors.New("stop failed")},
+					},
+				}))
+				Expect(testUI.Out).To(Say(`some-space\s+web\s+failed`))
+
+				_, err := os.Stat(recordPath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	When("the user does not confirm", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not stop the apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really stop these 1 apps\?`))
+			Expect(testUI.Out).To(Say("Apps have not been stopped"))
+			Expect(fakeActor.StopBulkApplicationsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user confirms", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stops the apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.StopBulkApplicationsCallCount()).To(Equal(1))
+		})
+	})
+
+	When("there are no started apps", func() {
+		BeforeEach(func() {
+			fakeActor.GetBulkApplicationsReturns([]v2v3action.BulkApplication{stoppedApp}, nil, nil)
+		})
+
+		It("says so and does not prompt", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No started apps found."))
+			Expect(testUI.Out).ToNot(Say("Really stop"))
+		})
+	})
+
+	When("getting the apps fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetBulkApplicationsReturns(nil, v2v3action.Warnings{"get-warning"}, actionerror.SpaceNotFoundError{Name: "some-space"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+})
@@ -114,7 +114,10 @@ func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
 	v2Actor := v2action.NewActor(ccClientV2, uaaClientV2, config)
 	cmd.Actor = pushaction.NewActor(v2Actor, v3Actor, sharedActor)
 
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
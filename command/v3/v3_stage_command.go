@@ -51,7 +51,10 @@ func (cmd *V3StageCommand) Setup(config command.Config, ui command.UI) error {
 	}
 
 	cmd.Actor = v3action.NewActor(ccClient, config, nil, nil)
-	cmd.NOAAClient = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+	cmd.NOAAClient, err = shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -9,15 +9,16 @@ import (
 )
 
 type FakeRemoveNetworkPolicyActor struct {
-	RemoveNetworkPolicyStub        func(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
+	RemoveNetworkPolicyStub        func(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error)
 	removeNetworkPolicyMutex       sync.RWMutex
 	removeNetworkPolicyArgsForCall []struct {
-		spaceGUID   string
-		srcAppName  string
-		destAppName string
-		protocol    string
-		startPort   int
-		endPort     int
+		srcSpaceGUID  string
+		srcAppName    string
+		destSpaceGUID string
+		destAppName   string
+		protocol      string
+		startPort     int
+		endPort       int
 	}
 	removeNetworkPolicyReturns struct {
 		result1 cfnetworkingaction.Warnings
@@ -31,21 +32,22 @@ type FakeRemoveNetworkPolicyActor struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicy(spaceGUID string, srcAppName string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error) {
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicy(srcSpaceGUID string, srcAppName string, destSpaceGUID string, destAppName string, protocol string, startPort int, endPort int) (cfnetworkingaction.Warnings, error) {
 	fake.removeNetworkPolicyMutex.Lock()
 	ret, specificReturn := fake.removeNetworkPolicyReturnsOnCall[len(fake.removeNetworkPolicyArgsForCall)]
 	fake.removeNetworkPolicyArgsForCall = append(fake.removeNetworkPolicyArgsForCall, struct {
-		spaceGUID   string
-		srcAppName  string
-		destAppName string
-		protocol    string
-		startPort   int
-		endPort     int
-	}{spaceGUID, srcAppName, destAppName, protocol, startPort, endPort})
-	fake.recordInvocation("RemoveNetworkPolicy", []interface{}{spaceGUID, srcAppName, destAppName, protocol, startPort, endPort})
+		srcSpaceGUID  string
+		srcAppName    string
+		destSpaceGUID string
+		destAppName   string
+		protocol      string
+		startPort     int
+		endPort       int
+	}{srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort})
+	fake.recordInvocation("RemoveNetworkPolicy", []interface{}{srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort})
 	fake.removeNetworkPolicyMutex.Unlock()
 	if fake.RemoveNetworkPolicyStub != nil {
-		return fake.RemoveNetworkPolicyStub(spaceGUID, srcAppName, destAppName, protocol, startPort, endPort)
+		return fake.RemoveNetworkPolicyStub(srcSpaceGUID, srcAppName, destSpaceGUID, destAppName, protocol, startPort, endPort)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -59,10 +61,10 @@ func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyCallCount() int {
 	return len(fake.removeNetworkPolicyArgsForCall)
 }
 
-func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyArgsForCall(i int) (string, string, string, string, int, int) {
+func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyArgsForCall(i int) (string, string, string, string, string, int, int) {
 	fake.removeNetworkPolicyMutex.RLock()
 	defer fake.removeNetworkPolicyMutex.RUnlock()
-	return fake.removeNetworkPolicyArgsForCall[i].spaceGUID, fake.removeNetworkPolicyArgsForCall[i].srcAppName, fake.removeNetworkPolicyArgsForCall[i].destAppName, fake.removeNetworkPolicyArgsForCall[i].protocol, fake.removeNetworkPolicyArgsForCall[i].startPort, fake.removeNetworkPolicyArgsForCall[i].endPort
+	return fake.removeNetworkPolicyArgsForCall[i].srcSpaceGUID, fake.removeNetworkPolicyArgsForCall[i].srcAppName, fake.removeNetworkPolicyArgsForCall[i].destSpaceGUID, fake.removeNetworkPolicyArgsForCall[i].destAppName, fake.removeNetworkPolicyArgsForCall[i].protocol, fake.removeNetworkPolicyArgsForCall[i].startPort, fake.removeNetworkPolicyArgsForCall[i].endPort
 }
 
 func (fake *FakeRemoveNetworkPolicyActor) RemoveNetworkPolicyReturns(result1 cfnetworkingaction.Warnings, result2 error) {
@@ -42,7 +42,10 @@ func CreateCCV2Client() (*ccv2.Client, error) {
 		return nil, translatableerror.AuthorizationEndpointNotFoundError{}
 	}
 
-	uaaClient := uaa.NewClient(config)
+	uaaClient, err := uaa.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(nil, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
@@ -17,15 +17,15 @@ type PluginCommand struct {
 
 func InstallConfigurablePlugin(name string, version string, pluginCommands []PluginCommand) {
 	path := BuildConfigurablePlugin("configurable_plugin", name, version, pluginCommands)
-	Eventually(CF("install-plugin", "-f", path)).Should(Exit(0))
+	Eventually(CF("install-plugin", "--allow-unsigned", "-f", path)).Should(Exit(0))
 	Eventually(CFWithEnv(
 		map[string]string{"CF_CLI_EXPERIMENTAL": "true"},
-		"install-plugin", "-f", path)).Should(Exit(0))
+		"install-plugin", "--allow-unsigned", "-f", path)).Should(Exit(0))
 }
 
 func InstallConfigurablePluginFailsUninstall(name string, version string, pluginCommands []PluginCommand) {
 	path := BuildConfigurablePlugin("configurable_plugin_fails_uninstall", name, version, pluginCommands)
-	Eventually(CF("install-plugin", "-f", path)).Should(Exit(0))
+	Eventually(CF("install-plugin", "--allow-unsigned", "-f", path)).Should(Exit(0))
 }
 
 func BuildConfigurablePlugin(pluginType string, name string, version string, pluginCommands []PluginCommand) string {
@@ -25,11 +25,14 @@ var _ = Describe("network-policies command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("network-policies - List direct network traffic policies"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say(regexp.QuoteMeta("cf network-policies [--source SOURCE_APP]")))
+				Eventually(session).Should(Say(regexp.QuoteMeta("cf network-policies [--source SOURCE_APP] [--graph (dot | mermaid)]")))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say(regexp.QuoteMeta("cf network-policies --graph dot | dot -Tpng -o policies.png")))
 				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say("   --graph       Print the policies as a graph in the given format instead of a table"))
 				Eventually(session).Should(Say("   --source      Source app to filter results by"))
 				Eventually(session).Should(Say("SEE ALSO:"))
-				Eventually(session).Should(Say("   add-network-policy, apps, remove-network-policy"))
+				Eventually(session).Should(Say("   add-network-policy, apply-network-policies, apps, remove-network-policy"))
 				Eventually(session).Should(Exit(0))
 			})
 		})
@@ -93,8 +96,8 @@ var _ = Describe("network-policies command", func() {
 				username, _ := helpers.GetCredentials()
 				Eventually(session).Should(Say(`Listing network policies in org %s / space %s as %s\.\.\.`, orgName, spaceName, username))
 				Consistently(session).ShouldNot(Say("OK"))
-				Eventually(session).Should(Say("source\\s+destination\\s+protocol\\s+ports"))
-				Eventually(session).Should(Say("%s\\s+%s\\s+tcp\\s+8080[^-]", appName, appName))
+				Eventually(session).Should(Say("source\\s+destination\\s+protocol\\s+ports\\s+destination space\\s+destination org"))
+				Eventually(session).Should(Say("%s\\s+%s\\s+tcp\\s+8080\\s+%s\\s+%s", appName, appName, spaceName, orgName))
 				Eventually(session).Should(Exit(0))
 			})
 		})
@@ -116,9 +119,9 @@ var _ = Describe("network-policies command", func() {
 
 				username, _ := helpers.GetCredentials()
 				Eventually(session).Should(Say(`Listing network policies of app %s in org %s / space %s as %s\.\.\.`, srcAppName, orgName, spaceName, username))
-				Eventually(session).Should(Say("source\\s+destination\\s+protocol\\s+ports"))
+				Eventually(session).Should(Say("source\\s+destination\\s+protocol\\s+ports\\s+destination space\\s+destination org"))
 				Eventually(session).ShouldNot(Say("%s\\s+%s\\s+tcp\\s+8080[^-]", appName, appName))
-				Eventually(session).Should(Say("%s\\s+%s\\s+tcp\\s+8080[^-]", srcAppName, appName))
+				Eventually(session).Should(Say("%s\\s+%s\\s+tcp\\s+8080\\s+%s\\s+%s", srcAppName, appName, spaceName, orgName))
 				Eventually(session).Should(Exit(0))
 			})
 		})
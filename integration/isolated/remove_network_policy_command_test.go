@@ -25,14 +25,17 @@ var _ = Describe("remove-network-policy command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("remove-network-policy - Remove network traffic policy of an app"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say(regexp.QuoteMeta("cf remove-network-policy SOURCE_APP --destination-app DESTINATION_APP --protocol (tcp | udp) --port RANGE")))
+				Eventually(session).Should(Say(regexp.QuoteMeta("cf remove-network-policy SOURCE_APP --destination-app DESTINATION_APP [--destination-space DESTINATION_SPACE [--destination-org DESTINATION_ORG]] --protocol (tcp | udp) --port RANGE")))
 				Eventually(session).Should(Say("EXAMPLES:"))
 				Eventually(session).Should(Say("   cf remove-network-policy frontend --destination-app backend --protocol tcp --port 8081"))
 				Eventually(session).Should(Say("   cf remove-network-policy frontend --destination-app backend --protocol tcp --port 8080-8090"))
+				Eventually(session).Should(Say("   cf remove-network-policy frontend --destination-app backend --destination-org backend-org --destination-space backend-space --protocol tcp --port 8080"))
 				Eventually(session).Should(Say("OPTIONS:"))
-				Eventually(session).Should(Say("   --destination-app      Name of app to connect to"))
-				Eventually(session).Should(Say("   --port                 Port or range of ports that destination app is connected with"))
-				Eventually(session).Should(Say("   --protocol             Protocol that apps are connected with"))
+				Eventually(session).Should(Say("   --destination-app        Name of app to connect to"))
+				Eventually(session).Should(Say("   --destination-org        The org of the destination app \\(Default: targeted org\\)"))
+				Eventually(session).Should(Say("   --destination-space      The space of the destination app \\(Default: targeted space\\)"))
+				Eventually(session).Should(Say("   --port                   Port or range of ports that destination app is connected with"))
+				Eventually(session).Should(Say("   --protocol               Protocol that apps are connected with"))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("   apps, network-policies"))
 				Eventually(session).Should(Exit(0))
@@ -45,8 +45,9 @@ var _ = Describe("install-plugin command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("install-plugin - Install CLI plugin"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf install-plugin PLUGIN_NAME \\[-r REPO_NAME\\] \\[-f\\]"))
-				Eventually(session).Should(Say("cf install-plugin LOCAL-PATH/TO/PLUGIN | URL \\[-f\\]"))
+				Eventually(session).Should(Say("cf install-plugin PLUGIN_NAME \\[-r REPO_NAME\\] \\[-f\\] \\[--allow-unsigned\\]"))
+				Eventually(session).Should(Say("cf install-plugin LOCAL-PATH/TO/PLUGIN | URL \\[-f\\] \\[--allow-unsigned\\]"))
+				Eventually(session).Should(Say("Plugins must be signed by a key trusted with add-plugin-key."))
 				Eventually(session).Should(Say(""))
 				Eventually(session).Should(Say("WARNING:"))
 				Eventually(session).Should(Say("Plugins are binaries written by potentially untrusted authors."))
@@ -59,8 +60,9 @@ var _ = Describe("install-plugin command", func() {
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say("-f\\s+Force install of plugin without confirmation"))
 				Eventually(session).Should(Say("-r\\s+Restrict search for plugin to this registered repository"))
+				Eventually(session).Should(Say("--allow-unsigned\\s+Install the plugin even if it is not signed by a trusted plugin key"))
 				Eventually(session).Should(Say("SEE ALSO:"))
-				Eventually(session).Should(Say("add-plugin-repo, list-plugin-repos, plugins"))
+				Eventually(session).Should(Say("add-plugin-key, add-plugin-repo, list-plugin-repos, plugins"))
 
 				Eventually(session).Should(Exit(0))
 			})
@@ -107,7 +109,7 @@ var _ = Describe("install-plugin command", func() {
 		})
 
 		It("creates the new directory, and continues as normal", func() {
-			session := helpers.CF("install-plugin", pluginPath, "-f")
+			session := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 			Eventually(session).Should(Exit(0))
 
 			log.Println(newPluginHome)
@@ -140,7 +142,7 @@ var _ = Describe("install-plugin command", func() {
 			})
 
 			It("fails and reports the file is not a valid CLI plugin", func() {
-				session := helpers.CF("install-plugin", pluginPath, "-f")
+				session := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 
 				Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 				Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -162,7 +164,7 @@ var _ = Describe("install-plugin command", func() {
 
 			When("the -f flag is given", func() {
 				It("installs the plugin and cleans up all temp files", func() {
-					session := helpers.CF("install-plugin", pluginPath, "-f")
+					session := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 
 					Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 					Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -193,7 +195,7 @@ var _ = Describe("install-plugin command", func() {
 					})
 
 					It("installs the plugin", func() {
-						session := helpers.CF("install-plugin", pluginPath, "-f")
+						session := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 						Eventually(session).Should(Say("Plugin some-plugin 1\\.0\\.0 successfully installed\\."))
 						Eventually(session).Should(Exit(0))
 					})
@@ -201,11 +203,11 @@ var _ = Describe("install-plugin command", func() {
 
 				When("the plugin is already installed", func() {
 					BeforeEach(func() {
-						Eventually(helpers.CF("install-plugin", pluginPath, "-f")).Should(Exit(0))
+						Eventually(helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")).Should(Exit(0))
 					})
 
 					It("uninstalls the existing plugin and installs the plugin", func() {
-						session := helpers.CF("install-plugin", pluginPath, "-f")
+						session := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 
 						Eventually(session).Should(Say("Plugin some-plugin 1\\.0\\.0 is already installed\\. Uninstalling existing plugin\\.\\.\\."))
 						Eventually(session).Should(Say("CLI-MESSAGE-UNINSTALL"))
@@ -218,7 +220,7 @@ var _ = Describe("install-plugin command", func() {
 
 				When("the file does not exist", func() {
 					It("tells the user that the file was not found and fails", func() {
-						session := helpers.CF("install-plugin", "some/path/that/does/not/exist", "-f")
+						session := helpers.CF("install-plugin", "--allow-unsigned", "some/path/that/does/not/exist", "-f")
 						Eventually(session.Err).Should(Say("Plugin some/path/that/does/not/exist not found on disk or in any registered repo\\."))
 						Eventually(session.Err).Should(Say("Use 'cf repo-plugins' to list plugins available in the repos\\."))
 
@@ -244,7 +246,7 @@ var _ = Describe("install-plugin command", func() {
 					})
 
 					It("tells the user that the file is not a plugin and fails", func() {
-						session := helpers.CF("install-plugin", pluginPath, "-f")
+						session := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 						Eventually(session.Err).Should(Say("File is not a valid cf CLI plugin binary\\."))
 
 						Eventually(session).Should(Exit(1))
@@ -259,7 +261,7 @@ var _ = Describe("install-plugin command", func() {
 					})
 
 					It("tells the user that the file is not a plugin and fails", func() {
-						session := helpers.CF("install-plugin", pluginPath, "-f")
+						session := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 						Eventually(session.Err).Should(Say("File is not a valid cf CLI plugin binary\\."))
 
 						Eventually(session).Should(Exit(1))
@@ -274,7 +276,7 @@ var _ = Describe("install-plugin command", func() {
 					})
 
 					It("displays the error to stderr", func() {
-						session := helpers.CF("install-plugin", pluginPath, "-f")
+						session := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 						Eventually(session.Err).Should(Say("exit status 51"))
 						Eventually(session.Err).Should(Say("File is not a valid cf CLI plugin binary\\."))
 
@@ -293,7 +295,7 @@ var _ = Describe("install-plugin command", func() {
 						})
 
 						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", pluginPath)
 
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 							Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -315,7 +317,7 @@ var _ = Describe("install-plugin command", func() {
 						})
 
 						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", pluginPath)
 
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 							Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -342,7 +344,7 @@ var _ = Describe("install-plugin command", func() {
 						})
 
 						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", pluginPath)
 
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 							Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -369,7 +371,7 @@ var _ = Describe("install-plugin command", func() {
 						})
 
 						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", pluginPath)
 
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 							Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -394,7 +396,7 @@ var _ = Describe("install-plugin command", func() {
 						})
 
 						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", pluginPath)
 
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 							Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -416,7 +418,7 @@ var _ = Describe("install-plugin command", func() {
 						})
 
 						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", pluginPath)
 
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 							Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -443,7 +445,7 @@ var _ = Describe("install-plugin command", func() {
 						})
 
 						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", pluginPath)
 
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 							Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -470,7 +472,7 @@ var _ = Describe("install-plugin command", func() {
 						})
 
 						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", pluginPath)
 
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 							Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -499,7 +501,7 @@ var _ = Describe("install-plugin command", func() {
 						})
 
 						It("tells the user about the conflict and fails", func() {
-							session := helpers.CF("install-plugin", "-f", pluginPath)
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", pluginPath)
 
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 							Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -521,7 +523,7 @@ var _ = Describe("install-plugin command", func() {
 					})
 
 					It("installs the plugin", func() {
-						session := helpers.CFWithStdin(buffer, "install-plugin", pluginPath)
+						session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", pluginPath)
 
 						Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 						Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -547,11 +549,11 @@ var _ = Describe("install-plugin command", func() {
 
 					When("the plugin is already installed", func() {
 						BeforeEach(func() {
-							Eventually(helpers.CF("install-plugin", pluginPath, "-f")).Should(Exit(0))
+							Eventually(helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")).Should(Exit(0))
 						})
 
 						It("fails and tells the user how to force a reinstall", func() {
-							session := helpers.CFWithStdin(buffer, "install-plugin", pluginPath)
+							session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", pluginPath)
 
 							Eventually(session).Should(Say("FAILED"))
 							Eventually(session.Err).Should(Say("Plugin some-plugin 1\\.0\\.0 could not be installed\\. A plugin with that name is already installed\\."))
@@ -569,7 +571,7 @@ var _ = Describe("install-plugin command", func() {
 					})
 
 					It("does not install the plugin", func() {
-						session := helpers.CFWithStdin(buffer, "install-plugin", pluginPath)
+						session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", pluginPath)
 
 						Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 						Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -581,11 +583,11 @@ var _ = Describe("install-plugin command", func() {
 
 					When("the plugin is already installed", func() {
 						BeforeEach(func() {
-							Eventually(helpers.CF("install-plugin", pluginPath, "-f")).Should(Exit(0))
+							Eventually(helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")).Should(Exit(0))
 						})
 
 						It("does not uninstall the existing plugin", func() {
-							session := helpers.CFWithStdin(buffer, "install-plugin", pluginPath)
+							session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", pluginPath)
 
 							Eventually(session).Should(Say("Plugin installation cancelled\\."))
 
@@ -605,7 +607,7 @@ var _ = Describe("install-plugin command", func() {
 					})
 
 					It("does not install the plugin and does not create a bad state", func() {
-						session := helpers.CFWithStdin(buffer, "install-plugin", pluginPath)
+						session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", pluginPath)
 
 						Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 						Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -622,7 +624,7 @@ var _ = Describe("install-plugin command", func() {
 						Eventually(helpers.CF("plugins", "--checksum")).Should(Exit(0))
 
 						// make sure a retry of the plugin install works
-						retrySession := helpers.CF("install-plugin", pluginPath, "-f")
+						retrySession := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 						Eventually(retrySession).Should(Say("Plugin some-plugin 1\\.0\\.0 successfully installed\\."))
 						Eventually(retrySession).Should(Exit(0))
 					})
@@ -676,7 +678,7 @@ var _ = Describe("install-plugin command", func() {
 				})
 
 				It("installs the plugin", func() {
-					session := helpers.CF("install-plugin", "-f", server.URL(), "-k")
+					session := helpers.CF("install-plugin", "--allow-unsigned", "-f", server.URL(), "-k")
 
 					Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 					Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -706,7 +708,7 @@ var _ = Describe("install-plugin command", func() {
 					})
 
 					It("installs the plugin", func() {
-						session := helpers.CF("install-plugin", "-f", fmt.Sprintf("%s/redirect", server.URL()), "-k")
+						session := helpers.CF("install-plugin", "--allow-unsigned", "-f", fmt.Sprintf("%s/redirect", server.URL()), "-k")
 
 						Eventually(session).Should(Say("Installing plugin some-plugin\\.\\.\\."))
 						Eventually(session).Should(Say("OK"))
@@ -718,11 +720,11 @@ var _ = Describe("install-plugin command", func() {
 
 				When("the plugin has already been installed", func() {
 					BeforeEach(func() {
-						Eventually(helpers.CF("install-plugin", pluginPath, "-f")).Should(Exit(0))
+						Eventually(helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")).Should(Exit(0))
 					})
 
 					It("uninstalls and reinstalls the plugin", func() {
-						session := helpers.CF("install-plugin", "-f", server.URL(), "-k")
+						session := helpers.CF("install-plugin", "--allow-unsigned", "-f", server.URL(), "-k")
 
 						Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 						Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -752,7 +754,7 @@ var _ = Describe("install-plugin command", func() {
 				})
 
 				It("displays an appropriate error", func() {
-					session := helpers.CF("install-plugin", "-f", server.URL(), "-k")
+					session := helpers.CF("install-plugin", "--allow-unsigned", "-f", server.URL(), "-k")
 
 					Eventually(session).Should(Say("Starting download of plugin binary from URL\\.\\.\\."))
 					Eventually(session).Should(Say("FAILED"))
@@ -785,7 +787,7 @@ var _ = Describe("install-plugin command", func() {
 				})
 
 				It("tells the user that the file is not a plugin and fails", func() {
-					session := helpers.CF("install-plugin", "-f", server.URL(), "-k")
+					session := helpers.CF("install-plugin", "--allow-unsigned", "-f", server.URL(), "-k")
 
 					Eventually(session).Should(Say("Starting download of plugin binary from URL\\.\\.\\."))
 					Eventually(session).Should(Say("FAILED"))
@@ -831,7 +833,7 @@ var _ = Describe("install-plugin command", func() {
 				})
 
 				It("installs the plugin", func() {
-					session := helpers.CFWithStdin(buffer, "install-plugin", server.URL(), "-k")
+					session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", server.URL(), "-k")
 
 					Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 					Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -849,11 +851,11 @@ var _ = Describe("install-plugin command", func() {
 
 				When("the plugin is already installed", func() {
 					BeforeEach(func() {
-						Eventually(helpers.CF("install-plugin", pluginPath, "-f")).Should(Exit(0))
+						Eventually(helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")).Should(Exit(0))
 					})
 
 					It("fails and tells the user how to force a reinstall", func() {
-						session := helpers.CFWithStdin(buffer, "install-plugin", server.URL(), "-k")
+						session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", server.URL(), "-k")
 
 						Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 						Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -877,7 +879,7 @@ var _ = Describe("install-plugin command", func() {
 				})
 
 				It("does not install the plugin", func() {
-					session := helpers.CFWithStdin(buffer, "install-plugin", server.URL())
+					session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", server.URL())
 
 					Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 					Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -897,7 +899,7 @@ var _ = Describe("install-plugin command", func() {
 				})
 
 				It("does not install the plugin and does not create a bad state", func() {
-					session := helpers.CFWithStdin(buffer, "install-plugin", pluginPath)
+					session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", pluginPath)
 
 					Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
 					Eventually(session).Should(Say("Install and use plugins at your own risk\\."))
@@ -916,7 +918,7 @@ var _ = Describe("install-plugin command", func() {
 					Eventually(helpers.CF("plugins", "--checksum")).Should(Exit(0))
 
 					// make sure a retry of the plugin install works
-					retrySession := helpers.CF("install-plugin", pluginPath, "-f")
+					retrySession := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 					Eventually(retrySession).Should(Say("Plugin some-plugin 1\\.0\\.0 successfully installed\\."))
 					Eventually(retrySession).Should(Exit(0))
 				})
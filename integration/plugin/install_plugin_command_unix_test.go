@@ -26,7 +26,7 @@ var _ = Describe("install-plugin command", func() {
 
 		When("the -f flag is given", func() {
 			It("sets the installed plugin's permissions to 0755", func() {
-				session := helpers.CF("install-plugin", pluginPath, "-f")
+				session := helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f")
 				Eventually(session).Should(Exit(0))
 
 				installedPath := filepath.Join(homeDir, ".cf", "plugins", "some-plugin")
@@ -28,7 +28,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 			})
 
 			It("it parses the arguments correctly", func() {
-				session := helpers.CF("install-plugin", "-f", "some-plugin", "-r", "kaka", "-k")
+				session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "some-plugin", "-r", "kaka", "-k")
 
 				Eventually(session.Err).Should(Say("Plugin some-plugin not found in repository kaka\\."))
 				Eventually(session).Should(Exit(1))
@@ -37,7 +37,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 		When("the repo is not registered", func() {
 			It("fails with an error message", func() {
-				session := helpers.CF("install-plugin", "-f", "-r", "repo-that-does-not-exist", "some-plugin")
+				session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "-r", "repo-that-does-not-exist", "some-plugin")
 
 				Eventually(session.Err).Should(Say("Plugin repository repo-that-does-not-exist not found\\."))
 				Eventually(session.Err).Should(Say("Use 'cf list-plugin-repos' to list registered repos\\."))
@@ -70,7 +70,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 			})
 
 			It("fails with an error message", func() {
-				session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k")
+				session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "-r", "kaka", "some-plugin", "-k")
 
 				Eventually(session.Err).Should(Say("Download attempt failed; server returned 418 I'm a teapot"))
 				Eventually(session.Err).Should(Say("Unable to install; plugin is not available from the given URL\\."))
@@ -103,7 +103,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 			})
 
 			It("fails with an error message", func() {
-				session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k")
+				session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "-r", "kaka", "some-plugin", "-k")
 
 				Eventually(session.Err).Should(Say("Invalid JSON content from server: invalid character '}' looking for beginning of value"))
 				Eventually(session).Should(Exit(1))
@@ -123,7 +123,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 			})
 
 			It("fails with an error message", func() {
-				session := helpers.CF("install-plugin", "-f", "-r", "kaka", "plugin-that-does-not-exist", "-k")
+				session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "-r", "kaka", "plugin-that-does-not-exist", "-k")
 
 				Eventually(session).Should(Say("FAILED"))
 				Eventually(session.Err).Should(Say("Plugin plugin-that-does-not-exist not found in repository kaka\\."))
@@ -147,7 +147,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 				})
 
 				It("returns plugin not found", func() {
-					session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k")
+					session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "-r", "kaka", "some-plugin", "-k")
 					Eventually(session).Should(Say("FAILED"))
 					Eventually(session.Err).Should(Say("Plugin requested has no binary available for your platform\\."))
 
@@ -168,7 +168,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						})
 
 						It("installs the plugin case-insensitively", func() {
-							session := helpers.CF("install-plugin", "-f", "-r", "kAkA", "some-plugin", "-k")
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "-r", "kAkA", "some-plugin", "-k")
 							Eventually(session).Should(Say("Searching kaka for plugin some-plugin\\.\\.\\."))
 							Eventually(session).Should(Say("Plugin some-plugin 1\\.0\\.0 found in: kaka\n"))
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
@@ -195,7 +195,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						})
 
 						It("fails with an error message", func() {
-							session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k")
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "-r", "kaka", "some-plugin", "-k")
 							Eventually(session).Should(Say("FAILED"))
 							Eventually(session.Err).Should(Say("Downloaded plugin binary's checksum does not match repo metadata\\."))
 							Eventually(session.Err).Should(Say("Please try again or contact the plugin author\\."))
@@ -211,7 +211,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 								{Name: "some-command", Help: "some-command-help"},
 							},
 						)
-						Eventually(helpers.CF("install-plugin", pluginPath, "-f", "-k")).Should(Exit(0))
+						Eventually(helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f", "-k")).Should(Exit(0))
 					})
 
 					When("the plugin checksum is valid", func() {
@@ -225,7 +225,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						})
 
 						It("reinstalls the plugin", func() {
-							session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k")
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "-r", "kaka", "some-plugin", "-k")
 
 							Eventually(session).Should(Say("Searching kaka for plugin some-plugin\\.\\.\\."))
 							Eventually(session).Should(Say("Plugin some-plugin 2\\.0\\.0 found in: kaka\n"))
@@ -256,7 +256,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						})
 
 						It("fails with an error message", func() {
-							session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k")
+							session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "-r", "kaka", "some-plugin", "-k")
 
 							Eventually(session).Should(Say("Searching kaka for plugin some-plugin\\.\\.\\."))
 							Eventually(session).Should(Say("Plugin some-plugin 2\\.0\\.0 found in: kaka\n"))
@@ -299,7 +299,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						})
 
 						It("installs the plugin", func() {
-							session := helpers.CFWithStdin(buffer, "install-plugin", "-r", "kaka", "some-plugin", "-k")
+							session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "-r", "kaka", "some-plugin", "-k")
 							Eventually(session).Should(Say("Searching kaka for plugin some-plugin\\.\\.\\."))
 							Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka\n"))
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
@@ -321,7 +321,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						})
 
 						It("does not install the plugin", func() {
-							session := helpers.CFWithStdin(buffer, "install-plugin", "-r", "kaka", "some-plugin", "-k")
+							session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "-r", "kaka", "some-plugin", "-k")
 							Eventually(session).Should(Say("Searching kaka for plugin some-plugin\\.\\.\\."))
 							Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka\n"))
 							Eventually(session).Should(Say("Attention: Plugins are binaries written by potentially untrusted authors\\."))
@@ -342,7 +342,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 								{Name: "some-command", Help: "some-command-help"},
 							},
 						)
-						Eventually(helpers.CF("install-plugin", pluginPath, "-f", "-k")).Should(Exit(0))
+						Eventually(helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f", "-k")).Should(Exit(0))
 					})
 
 					When("the user chooses yes", func() {
@@ -361,7 +361,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 							})
 
 							It("installs the plugin", func() {
-								session := helpers.CFWithStdin(buffer, "install-plugin", "-r", "kaka", "some-plugin", "-k")
+								session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "-r", "kaka", "some-plugin", "-k")
 
 								Eventually(session).Should(Say("Searching kaka for plugin some-plugin\\.\\.\\."))
 								Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka\n"))
@@ -394,7 +394,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 							})
 
 							It("fails with an error message", func() {
-								session := helpers.CFWithStdin(buffer, "install-plugin", "-r", "kaka", "some-plugin", "-k")
+								session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "-r", "kaka", "some-plugin", "-k")
 								Eventually(session).Should(Say("Searching kaka for plugin some-plugin\\.\\.\\."))
 								Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka\n"))
 								Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.2 is already installed\\."))
@@ -425,7 +425,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						})
 
 						It("does not install the plugin", func() {
-							session := helpers.CFWithStdin(buffer, "install-plugin", "-r", "kaka", "some-plugin", "-k")
+							session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "-r", "kaka", "some-plugin", "-k")
 
 							Eventually(session).Should(Say("Searching kaka for plugin some-plugin\\.\\.\\."))
 							Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka\n"))
@@ -446,7 +446,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 	Describe("installing a plugin from any repo", func() {
 		When("there are no repositories registered", func() {
 			It("fails and displays the plugin not found message", func() {
-				session := helpers.CF("install-plugin", "some-plugin")
+				session := helpers.CF("install-plugin", "--allow-unsigned", "some-plugin")
 
 				Eventually(session).Should(Say("FAILED"))
 				Eventually(session.Err).Should(Say("Plugin some-plugin not found on disk or in any registered repo\\."))
@@ -482,7 +482,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 				})
 
 				It("fails with an error message", func() {
-					session := helpers.CF("install-plugin", "-f", "some-plugin", "-k")
+					session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "some-plugin", "-k")
 
 					Eventually(session.Err).Should(Say("Plugin list download failed; repository kaka returned 418 I'm a teapot"))
 					Consistently(session.Err).ShouldNot(Say("Unable to install; plugin is not available from the given URL\\."))
@@ -507,7 +507,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 				})
 
 				It("fails and displays the plugin not found message", func() {
-					session := helpers.CF("install-plugin", "some-plugin", "-k")
+					session := helpers.CF("install-plugin", "--allow-unsigned", "some-plugin", "-k")
 
 					Eventually(session).Should(Say("Searching kaka1, kaka2 for plugin some-plugin\\.\\.\\."))
 					Eventually(session).Should(Say("FAILED"))
@@ -539,7 +539,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 					When("the plugin is not already installed", func() {
 						When("the checksum is valid", func() {
 							It("installs the plugin", func() {
-								session := helpers.CF("install-plugin", "some-plugin", "-f", "-k")
+								session := helpers.CF("install-plugin", "--allow-unsigned", "some-plugin", "-f", "-k")
 
 								Eventually(session).Should(Say("Searching kaka1, kaka2 for plugin some-plugin\\.\\.\\."))
 								Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka1, kaka2"))
@@ -573,7 +573,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 							})
 
 							It("fails with the invalid checksum message", func() {
-								session := helpers.CF("install-plugin", "some-plugin-with-bad-checksum", "-f", "-k")
+								session := helpers.CF("install-plugin", "--allow-unsigned", "some-plugin-with-bad-checksum", "-f", "-k")
 
 								Eventually(session).Should(Say("Searching kaka1, kaka2, kaka3, kaka4 for plugin some-plugin-with-bad-checksum\\.\\.\\."))
 								Eventually(session).Should(Say("Plugin some-plugin-with-bad-checksum 2\\.2\\.3 found in: kaka3, kaka4"))
@@ -598,11 +598,11 @@ var _ = Describe("install-plugin (from repo) command", func() {
 										{Name: "some-command", Help: "some-command-help"},
 									},
 								)
-								Eventually(helpers.CF("install-plugin", pluginPath, "-f", "-k")).Should(Exit(0))
+								Eventually(helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f", "-k")).Should(Exit(0))
 							})
 
 							It("reinstalls the plugin", func() {
-								session := helpers.CF("install-plugin", "-f", "some-plugin", "-k")
+								session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "some-plugin", "-k")
 
 								Eventually(session).Should(Say("Searching kaka1, kaka2 for plugin some-plugin\\.\\.\\."))
 								Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka1, kaka2"))
@@ -633,7 +633,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 					})
 
 					It("installs the plugin from the correct repo", func() {
-						session := helpers.CF("install-plugin", "-f", "some-plugin", "-k")
+						session := helpers.CF("install-plugin", "--allow-unsigned", "-f", "some-plugin", "-k")
 
 						Eventually(session).Should(Say("Searching kaka1, kaka2 for plugin some-plugin\\.\\.\\."))
 						Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka2"))
@@ -664,7 +664,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						})
 
 						It("installs the newest plugin", func() {
-							session := helpers.CF("install-plugin", "some-plugin", "-f", "-k")
+							session := helpers.CF("install-plugin", "--allow-unsigned", "some-plugin", "-f", "-k")
 
 							Eventually(session).Should(Say("Searching kaka1, kaka2 for plugin some-plugin\\.\\.\\."))
 							Eventually(session).Should(Say("Plugin some-plugin 1.2.4 found in: kaka2"))
@@ -698,7 +698,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						})
 
 						It("prints the invalid checksum error", func() {
-							session := helpers.CF("install-plugin", "some-plugin", "-f", "-k")
+							session := helpers.CF("install-plugin", "--allow-unsigned", "some-plugin", "-f", "-k")
 
 							Eventually(session).Should(Say("Searching kaka1, kaka2 for plugin some-plugin\\.\\.\\."))
 							Eventually(session).Should(Say("Plugin some-plugin 1.2.4 found in: kaka2"))
@@ -748,7 +748,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 							When("the checksum is valid", func() {
 								It("installs the plugin", func() {
-									session := helpers.CFWithStdin(buffer, "install-plugin", "some-plugin", "-k")
+									session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "some-plugin", "-k")
 
 									Eventually(session).Should(Say("Searching kaka1, kaka2 for plugin some-plugin\\.\\.\\."))
 									Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka1, kaka2"))
@@ -783,7 +783,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 								})
 
 								It("fails with the invalid checksum message", func() {
-									session := helpers.CFWithStdin(buffer, "install-plugin", "some-plugin-with-bad-checksum", "-k")
+									session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "some-plugin-with-bad-checksum", "-k")
 
 									Eventually(session).Should(Say("FAILED"))
 									Eventually(session.Err).Should(Say("Downloaded plugin binary's checksum does not match repo metadata\\."))
@@ -800,7 +800,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 							})
 
 							It("does not install the plugin", func() {
-								session := helpers.CFWithStdin(buffer, "install-plugin", "some-plugin", "-k")
+								session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "some-plugin", "-k")
 
 								Eventually(session).Should(Say("Do you want to install the plugin some-plugin\\? \\[yN\\]: n"))
 								Eventually(session).Should(Say("Plugin installation cancelled"))
@@ -817,7 +817,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 									{Name: "some-command", Help: "some-command-help"},
 								},
 							)
-							Eventually(helpers.CF("install-plugin", pluginPath, "-f", "-k")).Should(Exit(0))
+							Eventually(helpers.CF("install-plugin", "--allow-unsigned", pluginPath, "-f", "-k")).Should(Exit(0))
 						})
 
 						When("the user says yes", func() {
@@ -827,7 +827,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 							When("the checksum is valid", func() {
 								It("installs the plugin", func() {
-									session := helpers.CFWithStdin(buffer, "install-plugin", "some-plugin", "-k")
+									session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "some-plugin", "-k")
 
 									Eventually(session).Should(Say("Searching kaka1, kaka2 for plugin some-plugin\\.\\.\\."))
 									Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka1, kaka2"))
@@ -855,7 +855,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 							})
 
 							It("does not install the plugin", func() {
-								session := helpers.CFWithStdin(buffer, "install-plugin", "some-plugin", "-k")
+								session := helpers.CFWithStdin(buffer, "install-plugin", "--allow-unsigned", "some-plugin", "-k")
 
 								Eventually(session).Should(Say("Searching kaka1, kaka2 for plugin some-plugin\\.\\.\\."))
 								Eventually(session).Should(Say("Plugin some-plugin 1\\.2\\.3 found in: kaka1, kaka2"))
@@ -71,7 +71,7 @@ var _ = AfterEach(func() {
 })
 
 func installTestPlugin() {
-	session := helpers.CF("install-plugin", "-f", testPluginPath)
+	session := helpers.CF("install-plugin", "--allow-unsigned", "-f", testPluginPath)
 	Eventually(session).Should(Exit(0))
 }
 
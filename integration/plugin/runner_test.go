@@ -13,7 +13,7 @@ import (
 var _ = Describe("running plugins", func() {
 	Describe("panic handling", func() {
 		BeforeEach(func() {
-			Eventually(helpers.CF("install-plugin", "-f", panicTestPluginPath)).Should(Exit(0))
+			Eventually(helpers.CF("install-plugin", "--allow-unsigned", "-f", panicTestPluginPath)).Should(Exit(0))
 		})
 
 		It("will exit 1 if the plugin panics", func() {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"reflect"
 	"strings"
 
@@ -11,7 +12,10 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/plugin/rpc"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/panichandler"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -42,40 +46,45 @@ var ParseErr = errors.New("incorrect type for arg")
 
 func main() {
 	defer panichandler.HandlePanic()
-	exitStatus := parse(os.Args[1:], &common.Commands)
+	exitStatus := parse(os.Args[1:], &common.Commands, true)
 	if exitStatus == switchToV2 {
-		exitStatus = parse(os.Args[1:], &common.V2Commands)
+		// the V3 version of the command has already run the pre-command hooks
+		exitStatus = parse(os.Args[1:], &common.V2Commands, false)
 	}
 	if exitStatus != 0 {
 		os.Exit(exitStatus)
 	}
 }
 
-func parse(args []string, commandList interface{}) int {
+func parse(args []string, commandList interface{}, runPreHooks bool) int {
 	parser := flags.NewParser(commandList, flags.HelpFlag)
-	parser.CommandHandler = executionWrapper
+	parser.CommandHandler = func(cmd flags.Commander, args []string) error {
+		return executionWrapper(cmd, args, parser.Active.Name, runPreHooks)
+	}
 	extraArgs, err := parser.ParseArgs(args)
 	if err == nil {
 		return 0
 	} else if _, ok := err.(translatableerror.V3V2SwitchError); ok {
 		return switchToV2
 	} else if flagErr, ok := err.(*flags.Error); ok {
-		return handleFlagErrorAndCommandHelp(flagErr, parser, extraArgs, args, commandList)
+		return handleFlagErrorAndCommandHelp(flagErr, parser, extraArgs, args, commandList, runPreHooks)
 	} else if err == ErrFailed {
 		return 1
 	} else if err == ParseErr {
 		fmt.Println()
-		parse([]string{"help", args[0]}, commandList)
+		parse([]string{"help", args[0]}, commandList, runPreHooks)
 		return 1
 	} else if exitError, ok := err.(*ssh.ExitError); ok {
 		return exitError.ExitStatus()
+	} else if exitError, ok := err.(*exec.ExitError); ok {
+		return rpc.ExitStatus(exitError)
 	}
 
 	fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
 	return 1
 }
 
-func handleFlagErrorAndCommandHelp(flagErr *flags.Error, parser *flags.Parser, extraArgs []string, originalArgs []string, commandList interface{}) int {
+func handleFlagErrorAndCommandHelp(flagErr *flags.Error, parser *flags.Parser, extraArgs []string, originalArgs []string, commandList interface{}, runPreHooks bool) int {
 	switch flagErr.Type {
 	case flags.ErrHelp, flags.ErrUnknownFlag, flags.ErrExpectedArgument, flags.ErrInvalidChoice:
 		_, found := reflect.TypeOf(common.Commands).FieldByNameFunc(
@@ -94,7 +103,7 @@ func handleFlagErrorAndCommandHelp(flagErr *flags.Error, parser *flags.Parser, e
 					newArgs = append(newArgs, arg)
 				}
 			}
-			parse(newArgs, commandList)
+			parse(newArgs, commandList, runPreHooks)
 			return 0
 		}
 
@@ -104,22 +113,22 @@ func handleFlagErrorAndCommandHelp(flagErr *flags.Error, parser *flags.Parser, e
 
 		var helpErrored int
 		if found {
-			helpErrored = parse([]string{"help", parser.Active.Name}, commandList)
+			helpErrored = parse([]string{"help", parser.Active.Name}, commandList, runPreHooks)
 		} else {
 			switch len(extraArgs) {
 			case 0:
-				helpErrored = parse([]string{"help"}, commandList)
+				helpErrored = parse([]string{"help"}, commandList, runPreHooks)
 			case 1:
 				if !isOption(extraArgs[0]) || (len(originalArgs) > 1 && extraArgs[0] == "-a") {
-					helpErrored = parse([]string{"help", extraArgs[0]}, commandList)
+					helpErrored = parse([]string{"help", extraArgs[0]}, commandList, runPreHooks)
 				} else {
-					helpErrored = parse([]string{"help"}, commandList)
+					helpErrored = parse([]string{"help"}, commandList, runPreHooks)
 				}
 			default:
 				if isCommand(extraArgs[0]) {
-					helpErrored = parse([]string{"help", extraArgs[0]}, commandList)
+					helpErrored = parse([]string{"help", extraArgs[0]}, commandList, runPreHooks)
 				} else {
-					helpErrored = parse(extraArgs[1:], commandList)
+					helpErrored = parse(extraArgs[1:], commandList, runPreHooks)
 				}
 			}
 		}
@@ -129,15 +138,15 @@ func handleFlagErrorAndCommandHelp(flagErr *flags.Error, parser *flags.Parser, e
 		}
 	case flags.ErrRequired, flags.ErrMarshal:
 		fmt.Fprintf(os.Stderr, "Incorrect Usage: %s\n\n", flagErr.Error())
-		parse([]string{"help", originalArgs[0]}, commandList)
+		parse([]string{"help", originalArgs[0]}, commandList, runPreHooks)
 		return 1
 	case flags.ErrUnknownCommand:
 		cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	case flags.ErrCommandRequired:
 		if common.Commands.VerboseOrVersion {
-			parse([]string{"version"}, commandList)
+			parse([]string{"version"}, commandList, runPreHooks)
 		} else {
-			parse([]string{"help"}, commandList)
+			parse([]string{"help"}, commandList, runPreHooks)
 		}
 	default:
 		fmt.Fprintf(os.Stderr, "Unexpected flag error\ntype: %s\nmessage: %s\n", flagErr.Type, flagErr.Error())
@@ -159,9 +168,10 @@ func isOption(s string) bool {
 	return strings.HasPrefix(s, "-")
 }
 
-func executionWrapper(cmd flags.Commander, args []string) error {
+func executionWrapper(cmd flags.Commander, args []string, commandName string, runPreHooks bool) error {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Verbose:  common.Commands.VerboseOrVersion,
+		TraceHAR: common.Commands.TraceHAR,
 	})
 	if configErr != nil {
 		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
@@ -218,7 +228,20 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		if err != nil {
 			return handleError(err, commandUI)
 		}
-		return handleError(extendedCmd.Execute(args), commandUI)
+
+		if runPreHooks {
+			err = shared.RunHooks(cfConfig, commandUI, plugin.PreCommandHook, commandName, args)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = handleError(extendedCmd.Execute(args), commandUI)
+		if err != nil {
+			return err
+		}
+
+		return shared.RunHooks(cfConfig, commandUI, plugin.PostCommandHook, commandName, args)
 	}
 
 	return fmt.Errorf("command does not conform to ExtendedCommander")
@@ -50,6 +50,21 @@ func (c *cliConnection) sendPluginMetadataToCliServer(metadata PluginMetadata) {
 	os.Exit(0)
 }
 
+func (c *cliConnection) getHookContext() HookContext {
+	var context HookContext
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetHookContext", "", &context)
+	})
+
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	return context
+}
+
 func (c *cliConnection) isMinCliVersion(version string) bool {
 	var result bool
 
@@ -381,3 +396,94 @@ func (c *cliConnection) GetService(serviceInstance string) (plugin_models.GetSer
 
 	return result, err
 }
+
+func (c *cliConnection) GetV3App(appName string) (plugin_models.V3AppModel, error) {
+	var result plugin_models.V3AppModel
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3App", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Apps() ([]plugin_models.V3AppModel, error) {
+	var result []plugin_models.V3AppModel
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3Apps", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Droplets(appName string) ([]plugin_models.V3DropletModel, error) {
+	var result []plugin_models.V3DropletModel
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3Droplets", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Packages(appName string) ([]plugin_models.V3PackageModel, error) {
+	var result []plugin_models.V3PackageModel
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3Packages", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) RunV3Task(appName string, task plugin_models.V3TaskModel) (plugin_models.V3TaskModel, error) {
+	var result plugin_models.V3TaskModel
+
+	request := plugin_models.RunV3TaskRequest{
+		AppName: appName,
+		Task:    task,
+	}
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.RunV3Task", request, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3Tasks(appName string) ([]plugin_models.V3TaskModel, error) {
+	var result []plugin_models.V3TaskModel
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3Tasks", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetIsolationSegments() ([]plugin_models.IsolationSegmentModel, error) {
+	var result []plugin_models.IsolationSegmentModel
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetIsolationSegments", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) CloudControllerRequest(method string, path string, body []byte) (plugin_models.CloudControllerResponse, error) {
+	var result plugin_models.CloudControllerResponse
+
+	request := plugin_models.CloudControllerRequest{
+		Method: method,
+		Path:   path,
+		Body:   body,
+	}
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.CloudControllerRequest", request, &result)
+	})
+
+	return result, err
+}
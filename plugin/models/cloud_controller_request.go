@@ -0,0 +1,14 @@
+package plugin_models
+
+type CloudControllerRequest struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+type CloudControllerResponse struct {
+	StatusCode int
+	Headers    map[string][]string
+	Body       []byte
+	Warnings   []string
+}
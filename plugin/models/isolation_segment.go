@@ -0,0 +1,6 @@
+package plugin_models
+
+type IsolationSegmentModel struct {
+	Name         string
+	EntitledOrgs []string
+}
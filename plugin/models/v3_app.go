@@ -0,0 +1,21 @@
+package plugin_models
+
+type V3AppModel struct {
+	Guid          string
+	Name          string
+	State         string
+	LifecycleType string
+	Buildpacks    []string
+	Processes     []V3ProcessModel
+}
+
+type V3ProcessModel struct {
+	Guid             string
+	Type             string
+	Command          string
+	HealthCheckType  string
+	TotalInstances   int
+	RunningInstances int
+	MemoryInMB       uint64
+	DiskInMB         uint64
+}
@@ -0,0 +1,10 @@
+package plugin_models
+
+type V3DropletModel struct {
+	Guid       string
+	State      string
+	CreatedAt  string
+	Stack      string
+	Image      string
+	Buildpacks []string
+}
@@ -0,0 +1,9 @@
+package plugin_models
+
+type V3PackageModel struct {
+	Guid        string
+	Type        string
+	State       string
+	CreatedAt   string
+	DockerImage string
+}
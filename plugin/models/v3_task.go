@@ -0,0 +1,17 @@
+package plugin_models
+
+type V3TaskModel struct {
+	Guid       string
+	SequenceId int
+	Name       string
+	Command    string
+	State      string
+	MemoryInMB uint64
+	DiskInMB   uint64
+	CreatedAt  string
+}
+
+type RunV3TaskRequest struct {
+	AppName string
+	Task    V3TaskModel
+}
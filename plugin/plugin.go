@@ -10,6 +10,16 @@ type Plugin interface {
 	GetMetadata() PluginMetadata
 }
 
+/**
+	HookPlugin is implemented by plugins that register Hooks in their metadata.
+	RunHook is called with the core command's name and args; exiting with a
+	non-zero status from a pre-command hook stops the core command from running.
+**/
+type HookPlugin interface {
+	Plugin
+	RunHook(cliConnection CliConnection, context HookContext)
+}
+
 //go:generate counterfeiter . CliConnection
 /**
 	List of commands available to CliConnection variable passed into run
@@ -45,6 +55,20 @@ type CliConnection interface {
 	GetService(string) (plugin_models.GetService_Model, error)
 	GetOrg(string) (plugin_models.GetOrg_Model, error)
 	GetSpace(string) (plugin_models.GetSpace_Model, error)
+	// The following are backed by the V3 Cloud Controller API and operate on
+	// the targeted space.
+	GetV3App(string) (plugin_models.V3AppModel, error)
+	GetV3Apps() ([]plugin_models.V3AppModel, error)
+	GetV3Droplets(string) ([]plugin_models.V3DropletModel, error)
+	GetV3Packages(string) ([]plugin_models.V3PackageModel, error)
+	RunV3Task(string, plugin_models.V3TaskModel) (plugin_models.V3TaskModel, error)
+	GetV3Tasks(string) ([]plugin_models.V3TaskModel, error)
+	GetIsolationSegments() ([]plugin_models.IsolationSegmentModel, error)
+	// CloudControllerRequest makes an authenticated request to the Cloud
+	// Controller. The path is relative to the API endpoint, e.g. "/v3/apps".
+	// Error status codes and Cloud Controller warnings are returned in the
+	// response rather than as an error.
+	CloudControllerRequest(method string, path string, body []byte) (plugin_models.CloudControllerResponse, error)
 }
 
 type VersionType struct {
@@ -58,6 +82,32 @@ type PluginMetadata struct {
 	Version       VersionType
 	MinCliVersion VersionType
 	Commands      []Command
+	Hooks         []Hook
+}
+
+type HookStage string
+
+const (
+	// PreCommandHook runs before the core command. The core command is not run
+	// if the hook exits with a non-zero status.
+	PreCommandHook HookStage = "pre"
+
+	// PostCommandHook runs after the core command succeeds.
+	PostCommandHook HookStage = "post"
+)
+
+// Hook registers the plugin to run at a stage of a core command, e.g.
+// Hook{Stage: PreCommandHook, Command: "push"}.
+type Hook struct {
+	Stage   HookStage
+	Command string
+}
+
+// HookContext describes the core command a hook is running for.
+type HookContext struct {
+	Stage   HookStage
+	Command string
+	Args    []string
 }
 
 type Usage struct {
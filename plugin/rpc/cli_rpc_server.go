@@ -39,6 +39,9 @@ type CliRpcService struct {
 type CliRpcCmd struct {
 	PluginMetadata       *plugin.PluginMetadata
 	MetadataMutex        *sync.RWMutex
+	HookContext          plugin.HookContext
+	V3Actor              V3Actor
+	V3Config             V3Config
 	outputCapture        OutputCapture
 	terminalOutputSwitch TerminalOutputSwitch
 	cliConfig            coreconfig.Repository
@@ -163,6 +166,13 @@ func (cmd *CliRpcCmd) SetPluginMetadata(pluginMetadata plugin.PluginMetadata, re
 	return nil
 }
 
+// GetHookContext returns the core command that the plugin's hook is running
+// for.
+func (cmd *CliRpcCmd) GetHookContext(_ string, retVal *plugin.HookContext) error {
+	*retVal = cmd.HookContext
+	return nil
+}
+
 func (cmd *CliRpcCmd) DisableTerminalOutput(disable bool, retVal *bool) error {
 	cmd.terminalOutputSwitch.DisableTerminalOutput(disable)
 	*retVal = true
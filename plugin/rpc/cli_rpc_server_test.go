@@ -206,6 +206,46 @@ var _ = Describe("Server", func() {
 		})
 	})
 
+	Describe(".GetHookContext", func() {
+		BeforeEach(func() {
+			rpcService, err = NewRpcService(nil, nil, nil, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+			Expect(err).ToNot(HaveOccurred())
+
+			rpcService.RpcCmd.HookContext = plugin.HookContext{
+				Stage:   plugin.PreCommandHook,
+				Command: "push",
+				Args:    []string{"some-app", "-f", "manifest.yml"},
+			}
+
+			err := rpcService.Start()
+			Expect(err).ToNot(HaveOccurred())
+
+			pingCli(rpcService.Port())
+
+			client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			rpcService.Stop()
+
+			//give time for server to stop
+			time.Sleep(50 * time.Millisecond)
+		})
+
+		It("returns the context of the running hook", func() {
+			var context plugin.HookContext
+			err = client.Call("CliRpcCmd.GetHookContext", "", &context)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(context).To(Equal(plugin.HookContext{
+				Stage:   plugin.PreCommandHook,
+				Command: "push",
+				Args:    []string{"some-app", "-f", "manifest.yml"},
+			}))
+		})
+	})
+
 	Describe(".GetOutputAndReset", func() {
 		Context("success", func() {
 			BeforeEach(func() {
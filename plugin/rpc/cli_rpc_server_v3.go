@@ -0,0 +1,326 @@
+package rpc
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/plugin/models"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . V3Actor
+
+// V3Actor is the actor backing the V3 plugin API.
+type V3Actor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationDroplets(appName string, spaceGUID string) ([]v3action.Droplet, v3action.Warnings, error)
+	GetApplicationPackages(appName string, spaceGUID string) ([]v3action.Package, v3action.Warnings, error)
+	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string, withObfuscatedValues bool) (v3action.ApplicationSummary, v3action.Warnings, error)
+	GetApplicationsWithProcessesBySpace(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
+	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	GetIsolationSegmentSummaries() ([]v3action.IsolationSegmentSummary, v3action.Warnings, error)
+	MakeCloudControllerRequest(method string, path string, body []byte) (v3action.CloudControllerResponse, v3action.Warnings, error)
+	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . V3Config
+
+// V3Config is the configuration used by the V3Actor. Its tokens are kept in
+// sync with the CLI configuration the RPC service was started with.
+type V3Config interface {
+	AccessToken() string
+	BinaryName() string
+	Locale() string
+	RefreshToken() string
+	SetAccessToken(token string)
+	SetRefreshToken(token string)
+}
+
+func (cmd *CliRpcCmd) GetV3App(appName string, retVal *plugin_models.V3AppModel) error {
+	return cmd.withV3Actor(func(actor V3Actor, spaceGUID string) error {
+		summary, _, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID, false)
+		if err != nil {
+			return err
+		}
+
+		*retVal = newV3AppModel(summary.Application, summary.ProcessSummaries)
+		return nil
+	})
+}
+
+func (cmd *CliRpcCmd) GetV3Apps(_ string, retVal *[]plugin_models.V3AppModel) error {
+	return cmd.withV3Actor(func(actor V3Actor, spaceGUID string) error {
+		summaries, _, err := actor.GetApplicationsWithProcessesBySpace(spaceGUID)
+		if err != nil {
+			return err
+		}
+
+		apps := []plugin_models.V3AppModel{}
+		for _, summary := range summaries {
+			apps = append(apps, newV3AppModel(summary.Application, summary.ProcessSummaries))
+		}
+		*retVal = apps
+		return nil
+	})
+}
+
+func (cmd *CliRpcCmd) GetV3Droplets(appName string, retVal *[]plugin_models.V3DropletModel) error {
+	return cmd.withV3Actor(func(actor V3Actor, spaceGUID string) error {
+		droplets, _, err := actor.GetApplicationDroplets(appName, spaceGUID)
+		if err != nil {
+			return err
+		}
+
+		models := []plugin_models.V3DropletModel{}
+		for _, droplet := range droplets {
+			model := plugin_models.V3DropletModel{
+				Guid:      droplet.GUID,
+				State:     string(droplet.State),
+				CreatedAt: droplet.CreatedAt,
+				Stack:     droplet.Stack,
+				Image:     droplet.Image,
+			}
+			for _, buildpack := range droplet.Buildpacks {
+				model.Buildpacks = append(model.Buildpacks, buildpack.Name)
+			}
+			models = append(models, model)
+		}
+		*retVal = models
+		return nil
+	})
+}
+
+func (cmd *CliRpcCmd) GetV3Packages(appName string, retVal *[]plugin_models.V3PackageModel) error {
+	return cmd.withV3Actor(func(actor V3Actor, spaceGUID string) error {
+		packages, _, err := actor.GetApplicationPackages(appName, spaceGUID)
+		if err != nil {
+			return err
+		}
+
+		models := []plugin_models.V3PackageModel{}
+		for _, pkg := range packages {
+			models = append(models, plugin_models.V3PackageModel{
+				Guid:        pkg.GUID,
+				Type:        string(pkg.Type),
+				State:       string(pkg.State),
+				CreatedAt:   pkg.CreatedAt,
+				DockerImage: pkg.DockerImage,
+			})
+		}
+		*retVal = models
+		return nil
+	})
+}
+
+func (cmd *CliRpcCmd) RunV3Task(request plugin_models.RunV3TaskRequest, retVal *plugin_models.V3TaskModel) error {
+	return cmd.withV3Actor(func(actor V3Actor, spaceGUID string) error {
+		app, _, err := actor.GetApplicationByNameAndSpace(request.AppName, spaceGUID)
+		if err != nil {
+			return err
+		}
+
+		task, _, err := actor.RunTask(app.GUID, v3action.Task{
+			Name:       request.Task.Name,
+			Command:    request.Task.Command,
+			MemoryInMB: request.Task.MemoryInMB,
+			DiskInMB:   request.Task.DiskInMB,
+		})
+		if err != nil {
+			return err
+		}
+
+		*retVal = newV3TaskModel(task)
+		return nil
+	})
+}
+
+func (cmd *CliRpcCmd) GetV3Tasks(appName string, retVal *[]plugin_models.V3TaskModel) error {
+	return cmd.withV3Actor(func(actor V3Actor, spaceGUID string) error {
+		app, _, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		if err != nil {
+			return err
+		}
+
+		tasks, _, err := actor.GetApplicationTasks(app.GUID, v3action.Descending)
+		if err != nil {
+			return err
+		}
+
+		models := []plugin_models.V3TaskModel{}
+		for _, task := range tasks {
+			models = append(models, newV3TaskModel(task))
+		}
+		*retVal = models
+		return nil
+	})
+}
+
+func (cmd *CliRpcCmd) GetIsolationSegments(_ string, retVal *[]plugin_models.IsolationSegmentModel) error {
+	return cmd.withV3ActorWithoutTarget(func(actor V3Actor) error {
+		summaries, _, err := actor.GetIsolationSegmentSummaries()
+		if err != nil {
+			return err
+		}
+
+		models := []plugin_models.IsolationSegmentModel{}
+		for _, summary := range summaries {
+			models = append(models, plugin_models.IsolationSegmentModel{
+				Name:         summary.Name,
+				EntitledOrgs: summary.EntitledOrgs,
+			})
+		}
+		*retVal = models
+		return nil
+	})
+}
+
+func (cmd *CliRpcCmd) CloudControllerRequest(request plugin_models.CloudControllerRequest, retVal *plugin_models.CloudControllerResponse) error {
+	if request.Method == "" {
+		request.Method = http.MethodGet
+	}
+
+	return cmd.withV3ActorWithoutTarget(func(actor V3Actor) error {
+		response, warnings, err := actor.MakeCloudControllerRequest(request.Method, request.Path, request.Body)
+		if err != nil {
+			*retVal = plugin_models.CloudControllerResponse{Warnings: warnings}
+			return err
+		}
+
+		*retVal = plugin_models.CloudControllerResponse{
+			StatusCode: response.StatusCode,
+			Headers:    response.Header,
+			Body:       response.Body,
+			Warnings:   warnings,
+		}
+		return nil
+	})
+}
+
+// withV3Actor runs f with the V3 actor and the GUID of the targeted space.
+func (cmd *CliRpcCmd) withV3Actor(f func(actor V3Actor, spaceGUID string) error) error {
+	return cmd.withV3ActorWithoutTarget(func(actor V3Actor) error {
+		spaceGUID := cmd.cliConfig.SpaceFields().GUID
+		if spaceGUID == "" {
+			return actionerror.NoSpaceTargetedError{BinaryName: cmd.V3Config.BinaryName()}
+		}
+
+		return f(actor, spaceGUID)
+	})
+}
+
+// withV3ActorWithoutTarget runs f with the V3 actor. Tokens refreshed by the
+// V3 actor are saved to the CLI configuration, and errors are translated the
+// same way as they are for core commands.
+func (cmd *CliRpcCmd) withV3ActorWithoutTarget(f func(actor V3Actor) error) error {
+	err := cmd.setupV3Actor()
+	if err != nil {
+		return err
+	}
+
+	cmd.V3Config.SetAccessToken(cmd.cliConfig.AccessToken())
+	cmd.V3Config.SetRefreshToken(cmd.cliConfig.RefreshToken())
+
+	err = f(cmd.V3Actor)
+
+	if accessToken := cmd.V3Config.AccessToken(); accessToken != cmd.cliConfig.AccessToken() {
+		cmd.cliConfig.SetAccessToken(accessToken)
+	}
+	if refreshToken := cmd.V3Config.RefreshToken(); refreshToken != cmd.cliConfig.RefreshToken() {
+		cmd.cliConfig.SetRefreshToken(refreshToken)
+	}
+
+	return cmd.translateV3Error(err)
+}
+
+// setupV3Actor creates the V3 actor the first time it is needed, so that
+// plugins that only use the V2 plugin API do not connect to the V3 API.
+func (cmd *CliRpcCmd) setupV3Actor() error {
+	if cmd.V3Actor != nil && cmd.V3Config != nil {
+		return nil
+	}
+
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return err
+	}
+
+	ccClient, _, err := shared.NewClients(config, commandUI, true, "")
+	if err != nil {
+		return cmd.translateV3ErrorWithConfig(err, config)
+	}
+
+	cmd.V3Actor = v3action.NewActor(ccClient, config, nil, nil)
+	cmd.V3Config = config
+	return nil
+}
+
+func (cmd *CliRpcCmd) translateV3Error(err error) error {
+	return cmd.translateV3ErrorWithConfig(err, cmd.V3Config)
+}
+
+// translateV3ErrorWithConfig converts err into an error with a translated
+// message, since only the message of an error is sent back to the plugin.
+func (cmd *CliRpcCmd) translateV3ErrorWithConfig(err error, config V3Config) error {
+	if err == nil {
+		return nil
+	}
+
+	translatedErr := translatableerror.ConvertToTranslatableError(err)
+	if translatableErr, ok := translatedErr.(translatableerror.TranslatableError); ok {
+		translate, translateErr := ui.GetTranslationFunc(config)
+		if translateErr != nil {
+			return translateErr
+		}
+		return errors.New(translatableErr.Translate(translate))
+	}
+
+	return translatedErr
+}
+
+func newV3AppModel(app v3action.Application, processSummaries v3action.ProcessSummaries) plugin_models.V3AppModel {
+	model := plugin_models.V3AppModel{
+		Guid:          app.GUID,
+		Name:          app.Name,
+		State:         string(app.State),
+		LifecycleType: string(app.LifecycleType),
+		Buildpacks:    app.LifecycleBuildpacks,
+	}
+
+	for _, summary := range processSummaries {
+		model.Processes = append(model.Processes, plugin_models.V3ProcessModel{
+			Guid:             summary.GUID,
+			Type:             summary.Type,
+			Command:          summary.Command,
+			HealthCheckType:  summary.HealthCheckType,
+			TotalInstances:   summary.TotalInstanceCount(),
+			RunningInstances: summary.HealthyInstanceCount(),
+			MemoryInMB:       summary.MemoryInMB.Value,
+			DiskInMB:         summary.DiskInMB.Value,
+		})
+	}
+
+	return model
+}
+
+func newV3TaskModel(task v3action.Task) plugin_models.V3TaskModel {
+	return plugin_models.V3TaskModel{
+		Guid:       task.GUID,
+		SequenceId: task.SequenceID,
+		Name:       task.Name,
+		Command:    task.Command,
+		State:      string(task.State),
+		MemoryInMB: task.MemoryInMB,
+		DiskInMB:   task.DiskInMB,
+		CreatedAt:  task.CreatedAt,
+	}
+}
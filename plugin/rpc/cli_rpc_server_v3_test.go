@@ -0,0 +1,360 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/http"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	testconfig "code.cloudfoundry.org/cli/cf/util/testhelpers/configuration"
+	"code.cloudfoundry.org/cli/plugin/models"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V3 Plugin API", func() {
+	var (
+		err            error
+		client         *rpc.Client
+		rpcService     *CliRpcService
+		config         coreconfig.Repository
+		fakeV3Actor    *rpcfakes.FakeV3Actor
+		fakeV3Config   *rpcfakes.FakeV3Config
+		v3AccessToken  string
+		v3RefreshToken string
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		config = testconfig.NewRepositoryWithDefaults()
+		config.SetSpaceFields(models.SpaceFields{GUID: "some-space-guid", Name: "some-space"})
+		config.SetAccessToken("some-access-token")
+		config.SetRefreshToken("some-refresh-token")
+
+		fakeV3Actor = new(rpcfakes.FakeV3Actor)
+		fakeV3Config = new(rpcfakes.FakeV3Config)
+		fakeV3Config.BinaryNameReturns("faceman")
+		fakeV3Config.SetAccessTokenStub = func(token string) { v3AccessToken = token }
+		fakeV3Config.AccessTokenStub = func() string { return v3AccessToken }
+		fakeV3Config.SetRefreshTokenStub = func(token string) { v3RefreshToken = token }
+		fakeV3Config.RefreshTokenStub = func() string { return v3RefreshToken }
+
+		rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+		rpcService.RpcCmd.V3Actor = fakeV3Actor
+		rpcService.RpcCmd.V3Config = fakeV3Config
+
+		err = rpcService.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		pingCli(rpcService.Port())
+
+		client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		client.Close()
+		rpcService.Stop()
+
+		//give time for server to stop
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	Describe("GetV3Apps", func() {
+		var result []plugin_models.V3AppModel
+
+		BeforeEach(func() {
+			fakeV3Actor.GetApplicationsWithProcessesBySpaceReturns(
+				[]v3action.ApplicationWithProcessSummary{
+					{
+						Application: v3action.Application{
+							GUID:                "some-app-guid",
+							Name:                "some-app",
+							State:               constant.ApplicationStarted,
+							LifecycleType:       constant.AppLifecycleTypeBuildpack,
+							LifecycleBuildpacks: []string{"some-buildpack"},
+						},
+						ProcessSummaries: v3action.ProcessSummaries{
+							{
+								Process: v3action.Process{
+									GUID:       "some-process-guid",
+									Type:       "web",
+									MemoryInMB: types.NullUint64{IsSet: true, Value: 32},
+									DiskInMB:   types.NullUint64{IsSet: true, Value: 64},
+								},
+								InstanceDetails: []v3action.ProcessInstance{
+									{State: constant.ProcessInstanceRunning},
+									{State: constant.ProcessInstanceCrashed},
+								},
+							},
+						},
+					},
+				},
+				v3action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("returns the apps in the targeted space with their processes", func() {
+			err = client.Call("CliRpcCmd.GetV3Apps", "", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal([]plugin_models.V3AppModel{
+				{
+					Guid:          "some-app-guid",
+					Name:          "some-app",
+					State:         "STARTED",
+					LifecycleType: "buildpack",
+					Buildpacks:    []string{"some-buildpack"},
+					Processes: []plugin_models.V3ProcessModel{
+						{
+							Guid:             "some-process-guid",
+							Type:             "web",
+							TotalInstances:   2,
+							RunningInstances: 1,
+							MemoryInMB:       32,
+							DiskInMB:         64,
+						},
+					},
+				},
+			}))
+
+			Expect(fakeV3Actor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(1))
+			Expect(fakeV3Actor.GetApplicationsWithProcessesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+		})
+
+		It("uses the tokens from the CLI configuration", func() {
+			err = client.Call("CliRpcCmd.GetV3Apps", "", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeV3Config.SetAccessTokenArgsForCall(0)).To(Equal("some-access-token"))
+			Expect(fakeV3Config.SetRefreshTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+		})
+
+		When("the V3 actor refreshes the tokens", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetApplicationsWithProcessesBySpaceStub = func(string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error) {
+					v3AccessToken = "new-access-token"
+					v3RefreshToken = "new-refresh-token"
+					return nil, nil, nil
+				}
+			})
+
+			It("saves the new tokens to the CLI configuration", func() {
+				err = client.Call("CliRpcCmd.GetV3Apps", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.AccessToken()).To(Equal("new-access-token"))
+				Expect(config.RefreshToken()).To(Equal("new-refresh-token"))
+			})
+		})
+
+		When("no space is targeted", func() {
+			BeforeEach(func() {
+				config.SetSpaceFields(models.SpaceFields{})
+			})
+
+			It("returns a translated error", func() {
+				err = client.Call("CliRpcCmd.GetV3Apps", "", &result)
+				Expect(err).To(MatchError("No space targeted, use 'faceman target -s SPACE' to target a space."))
+				Expect(fakeV3Actor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetV3App", func() {
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeV3Actor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, nil, actionerror.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns a translated error", func() {
+				var result plugin_models.V3AppModel
+				err = client.Call("CliRpcCmd.GetV3App", "some-app", &result)
+				Expect(err).To(MatchError("App some-app not found"))
+
+				appName, spaceGUID, _ := fakeV3Actor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+	})
+
+	Describe("GetV3Droplets", func() {
+		BeforeEach(func() {
+			fakeV3Actor.GetApplicationDropletsReturns(
+				[]v3action.Droplet{
+					{
+						GUID:       "some-droplet-guid",
+						State:      constant.DropletStaged,
+						Stack:      "some-stack",
+						Buildpacks: []v3action.Buildpack{{Name: "some-buildpack"}},
+					},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("returns the droplets of the app", func() {
+			var result []plugin_models.V3DropletModel
+			err = client.Call("CliRpcCmd.GetV3Droplets", "some-app", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal([]plugin_models.V3DropletModel{
+				{Guid: "some-droplet-guid", State: "STAGED", Stack: "some-stack", Buildpacks: []string{"some-buildpack"}},
+			}))
+		})
+	})
+
+	Describe("GetV3Packages", func() {
+		BeforeEach(func() {
+			fakeV3Actor.GetApplicationPackagesReturns(
+				[]v3action.Package{
+					{GUID: "some-package-guid", Type: constant.PackageTypeBits, State: constant.PackageReady},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("returns the packages of the app", func() {
+			var result []plugin_models.V3PackageModel
+			err = client.Call("CliRpcCmd.GetV3Packages", "some-app", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal([]plugin_models.V3PackageModel{
+				{Guid: "some-package-guid", Type: "bits", State: "READY"},
+			}))
+		})
+	})
+
+	Describe("RunV3Task", func() {
+		BeforeEach(func() {
+			fakeV3Actor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, nil, nil)
+			fakeV3Actor.RunTaskReturns(v3action.Task{GUID: "some-task-guid", SequenceID: 3, Name: "some-task", State: constant.TaskRunning}, nil, nil)
+		})
+
+		It("runs the task on the app", func() {
+			var result plugin_models.V3TaskModel
+			err = client.Call("CliRpcCmd.RunV3Task", plugin_models.RunV3TaskRequest{
+				AppName: "some-app",
+				Task:    plugin_models.V3TaskModel{Name: "some-task", Command: "some-command", MemoryInMB: 128},
+			}, &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal(plugin_models.V3TaskModel{Guid: "some-task-guid", SequenceId: 3, Name: "some-task", State: "RUNNING"}))
+
+			appGUID, task := fakeV3Actor.RunTaskArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(task).To(Equal(v3action.Task{Name: "some-task", Command: "some-command", MemoryInMB: 128}))
+		})
+	})
+
+	Describe("GetV3Tasks", func() {
+		BeforeEach(func() {
+			fakeV3Actor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, nil, nil)
+			fakeV3Actor.GetApplicationTasksReturns([]v3action.Task{{GUID: "task-2", SequenceID: 2}, {GUID: "task-1", SequenceID: 1}}, nil, nil)
+		})
+
+		It("returns the tasks of the app, newest first", func() {
+			var result []plugin_models.V3TaskModel
+			err = client.Call("CliRpcCmd.GetV3Tasks", "some-app", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal([]plugin_models.V3TaskModel{{Guid: "task-2", SequenceId: 2}, {Guid: "task-1", SequenceId: 1}}))
+
+			appGUID, sortOrder := fakeV3Actor.GetApplicationTasksArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(sortOrder).To(Equal(v3action.Descending))
+		})
+	})
+
+	Describe("GetIsolationSegments", func() {
+		BeforeEach(func() {
+			config.SetSpaceFields(models.SpaceFields{})
+			fakeV3Actor.GetIsolationSegmentSummariesReturns([]v3action.IsolationSegmentSummary{
+				{Name: "some-iso-seg", EntitledOrgs: []string{"some-org"}},
+			}, nil, nil)
+		})
+
+		It("returns the isolation segments without requiring a targeted space", func() {
+			var result []plugin_models.IsolationSegmentModel
+			err = client.Call("CliRpcCmd.GetIsolationSegments", "", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal([]plugin_models.IsolationSegmentModel{
+				{Name: "some-iso-seg", EntitledOrgs: []string{"some-org"}},
+			}))
+		})
+	})
+
+	Describe("CloudControllerRequest", func() {
+		var result plugin_models.CloudControllerResponse
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				fakeV3Actor.MakeCloudControllerRequestReturns(v3action.CloudControllerResponse{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": {"application/json"}},
+					Body:       []byte(`{"resources":[]}`),
+				}, v3action.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns the response", func() {
+				err = client.Call("CliRpcCmd.CloudControllerRequest", plugin_models.CloudControllerRequest{
+					Path: "/v3/apps",
+				}, &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal(plugin_models.CloudControllerResponse{
+					StatusCode: http.StatusOK,
+					Headers:    map[string][]string{"Content-Type": {"application/json"}},
+					Body:       []byte(`{"resources":[]}`),
+					Warnings:   []string{"some-warning"},
+				}))
+
+				method, path, body := fakeV3Actor.MakeCloudControllerRequestArgsForCall(0)
+				Expect(method).To(Equal(http.MethodGet))
+				Expect(path).To(Equal("/v3/apps"))
+				Expect(body).To(BeEmpty())
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				fakeV3Actor.MakeCloudControllerRequestReturns(v3action.CloudControllerResponse{}, v3action.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				err = client.Call("CliRpcCmd.CloudControllerRequest", plugin_models.CloudControllerRequest{
+					Method: http.MethodPost,
+					Path:   "/v3/spaces",
+					Body:   []byte(`{}`),
+				}, &result)
+				Expect(err).To(MatchError("some-error"))
+			})
+
+			It("returns the warnings with the error", func() {
+				err = rpcService.RpcCmd.CloudControllerRequest(plugin_models.CloudControllerRequest{
+					Method: http.MethodPost,
+					Path:   "/v3/spaces",
+					Body:   []byte(`{}`),
+				}, &result)
+				Expect(err).To(MatchError("some-error"))
+				Expect(result.Warnings).To(Equal([]string{"some-warning"}))
+			})
+		})
+	})
+})
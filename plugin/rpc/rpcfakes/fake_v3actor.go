@@ -0,0 +1,690 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package rpcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/plugin/rpc"
+)
+
+type FakeV3Actor struct {
+	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationDropletsStub        func(appName string, spaceGUID string) ([]v3action.Droplet, v3action.Warnings, error)
+	getApplicationDropletsMutex       sync.RWMutex
+	getApplicationDropletsArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationDropletsReturns struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationDropletsReturnsOnCall map[int]struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationPackagesStub        func(appName string, spaceGUID string) ([]v3action.Package, v3action.Warnings, error)
+	getApplicationPackagesMutex       sync.RWMutex
+	getApplicationPackagesArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationPackagesReturns struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationPackagesReturnsOnCall map[int]struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationSummaryByNameAndSpaceStub        func(appName string, spaceGUID string, withObfuscatedValues bool) (v3action.ApplicationSummary, v3action.Warnings, error)
+	getApplicationSummaryByNameAndSpaceMutex       sync.RWMutex
+	getApplicationSummaryByNameAndSpaceArgsForCall []struct {
+		appName              string
+		spaceGUID            string
+		withObfuscatedValues bool
+	}
+	getApplicationSummaryByNameAndSpaceReturns struct {
+		result1 v3action.ApplicationSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationSummaryByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.ApplicationSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationsWithProcessesBySpaceStub        func(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error)
+	getApplicationsWithProcessesBySpaceMutex       sync.RWMutex
+	getApplicationsWithProcessesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsWithProcessesBySpaceReturns struct {
+		result1 []v3action.ApplicationWithProcessSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationsWithProcessesBySpaceReturnsOnCall map[int]struct {
+		result1 []v3action.ApplicationWithProcessSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationTasksStub        func(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	getApplicationTasksMutex       sync.RWMutex
+	getApplicationTasksArgsForCall []struct {
+		appGUID   string
+		sortOrder v3action.SortOrder
+	}
+	getApplicationTasksReturns struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationTasksReturnsOnCall map[int]struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentSummariesStub        func() ([]v3action.IsolationSegmentSummary, v3action.Warnings, error)
+	getIsolationSegmentSummariesMutex       sync.RWMutex
+	getIsolationSegmentSummariesArgsForCall []struct{}
+	getIsolationSegmentSummariesReturns     struct {
+		result1 []v3action.IsolationSegmentSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentSummariesReturnsOnCall map[int]struct {
+		result1 []v3action.IsolationSegmentSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	MakeCloudControllerRequestStub        func(method string, path string, body []byte) (v3action.CloudControllerResponse, v3action.Warnings, error)
+	makeCloudControllerRequestMutex       sync.RWMutex
+	makeCloudControllerRequestArgsForCall []struct {
+		method string
+		path   string
+		body   []byte
+	}
+	makeCloudControllerRequestReturns struct {
+		result1 v3action.CloudControllerResponse
+		result2 v3action.Warnings
+		result3 error
+	}
+	makeCloudControllerRequestReturnsOnCall map[int]struct {
+		result1 v3action.CloudControllerResponse
+		result2 v3action.Warnings
+		result3 error
+	}
+	RunTaskStub        func(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+	runTaskMutex       sync.RWMutex
+	runTaskArgsForCall []struct {
+		appGUID string
+		task    v3action.Task
+	}
+	runTaskReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	runTaskReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].appName, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationDroplets(appName string, spaceGUID string) ([]v3action.Droplet, v3action.Warnings, error) {
+	fake.getApplicationDropletsMutex.Lock()
+	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
+	fake.getApplicationDropletsArgsForCall = append(fake.getApplicationDropletsArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationDroplets", []interface{}{appName, spaceGUID})
+	fake.getApplicationDropletsMutex.Unlock()
+	if fake.GetApplicationDropletsStub != nil {
+		return fake.GetApplicationDropletsStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationDropletsReturns.result1, fake.getApplicationDropletsReturns.result2, fake.getApplicationDropletsReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationDropletsCallCount() int {
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	return len(fake.getApplicationDropletsArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationDropletsArgsForCall(i int) (string, string) {
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	return fake.getApplicationDropletsArgsForCall[i].appName, fake.getApplicationDropletsArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) GetApplicationDropletsReturns(result1 []v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationDropletsStub = nil
+	fake.getApplicationDropletsReturns = struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationDropletsReturnsOnCall(i int, result1 []v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationDropletsStub = nil
+	if fake.getApplicationDropletsReturnsOnCall == nil {
+		fake.getApplicationDropletsReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Droplet
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationDropletsReturnsOnCall[i] = struct {
+		result1 []v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationPackages(appName string, spaceGUID string) ([]v3action.Package, v3action.Warnings, error) {
+	fake.getApplicationPackagesMutex.Lock()
+	ret, specificReturn := fake.getApplicationPackagesReturnsOnCall[len(fake.getApplicationPackagesArgsForCall)]
+	fake.getApplicationPackagesArgsForCall = append(fake.getApplicationPackagesArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationPackages", []interface{}{appName, spaceGUID})
+	fake.getApplicationPackagesMutex.Unlock()
+	if fake.GetApplicationPackagesStub != nil {
+		return fake.GetApplicationPackagesStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationPackagesReturns.result1, fake.getApplicationPackagesReturns.result2, fake.getApplicationPackagesReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationPackagesCallCount() int {
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	return len(fake.getApplicationPackagesArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationPackagesArgsForCall(i int) (string, string) {
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	return fake.getApplicationPackagesArgsForCall[i].appName, fake.getApplicationPackagesArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) GetApplicationPackagesReturns(result1 []v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationPackagesStub = nil
+	fake.getApplicationPackagesReturns = struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationPackagesReturnsOnCall(i int, result1 []v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationPackagesStub = nil
+	if fake.getApplicationPackagesReturnsOnCall == nil {
+		fake.getApplicationPackagesReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Package
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationPackagesReturnsOnCall[i] = struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string, withObfuscatedValues bool) (v3action.ApplicationSummary, v3action.Warnings, error) {
+	fake.getApplicationSummaryByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationSummaryByNameAndSpaceReturnsOnCall[len(fake.getApplicationSummaryByNameAndSpaceArgsForCall)]
+	fake.getApplicationSummaryByNameAndSpaceArgsForCall = append(fake.getApplicationSummaryByNameAndSpaceArgsForCall, struct {
+		appName              string
+		spaceGUID            string
+		withObfuscatedValues bool
+	}{appName, spaceGUID, withObfuscatedValues})
+	fake.recordInvocation("GetApplicationSummaryByNameAndSpace", []interface{}{appName, spaceGUID, withObfuscatedValues})
+	fake.getApplicationSummaryByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationSummaryByNameAndSpaceStub != nil {
+		return fake.GetApplicationSummaryByNameAndSpaceStub(appName, spaceGUID, withObfuscatedValues)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationSummaryByNameAndSpaceReturns.result1, fake.getApplicationSummaryByNameAndSpaceReturns.result2, fake.getApplicationSummaryByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpaceCallCount() int {
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationSummaryByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpaceArgsForCall(i int) (string, string, bool) {
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationSummaryByNameAndSpaceArgsForCall[i].appName, fake.getApplicationSummaryByNameAndSpaceArgsForCall[i].spaceGUID, fake.getApplicationSummaryByNameAndSpaceArgsForCall[i].withObfuscatedValues
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpaceReturns(result1 v3action.ApplicationSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationSummaryByNameAndSpaceStub = nil
+	fake.getApplicationSummaryByNameAndSpaceReturns = struct {
+		result1 v3action.ApplicationSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i int, result1 v3action.ApplicationSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationSummaryByNameAndSpaceStub = nil
+	if fake.getApplicationSummaryByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationSummaryByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.ApplicationSummary
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSummaryByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.ApplicationSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationsWithProcessesBySpace(spaceGUID string) ([]v3action.ApplicationWithProcessSummary, v3action.Warnings, error) {
+	fake.getApplicationsWithProcessesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsWithProcessesBySpaceReturnsOnCall[len(fake.getApplicationsWithProcessesBySpaceArgsForCall)]
+	fake.getApplicationsWithProcessesBySpaceArgsForCall = append(fake.getApplicationsWithProcessesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsWithProcessesBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsWithProcessesBySpaceMutex.Unlock()
+	if fake.GetApplicationsWithProcessesBySpaceStub != nil {
+		return fake.GetApplicationsWithProcessesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsWithProcessesBySpaceReturns.result1, fake.getApplicationsWithProcessesBySpaceReturns.result2, fake.getApplicationsWithProcessesBySpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationsWithProcessesBySpaceCallCount() int {
+	fake.getApplicationsWithProcessesBySpaceMutex.RLock()
+	defer fake.getApplicationsWithProcessesBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsWithProcessesBySpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationsWithProcessesBySpaceArgsForCall(i int) string {
+	fake.getApplicationsWithProcessesBySpaceMutex.RLock()
+	defer fake.getApplicationsWithProcessesBySpaceMutex.RUnlock()
+	return fake.getApplicationsWithProcessesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) GetApplicationsWithProcessesBySpaceReturns(result1 []v3action.ApplicationWithProcessSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationsWithProcessesBySpaceStub = nil
+	fake.getApplicationsWithProcessesBySpaceReturns = struct {
+		result1 []v3action.ApplicationWithProcessSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationsWithProcessesBySpaceReturnsOnCall(i int, result1 []v3action.ApplicationWithProcessSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationsWithProcessesBySpaceStub = nil
+	if fake.getApplicationsWithProcessesBySpaceReturnsOnCall == nil {
+		fake.getApplicationsWithProcessesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v3action.ApplicationWithProcessSummary
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsWithProcessesBySpaceReturnsOnCall[i] = struct {
+		result1 []v3action.ApplicationWithProcessSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error) {
+	fake.getApplicationTasksMutex.Lock()
+	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
+	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
+		appGUID   string
+		sortOrder v3action.SortOrder
+	}{appGUID, sortOrder})
+	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, sortOrder})
+	fake.getApplicationTasksMutex.Unlock()
+	if fake.GetApplicationTasksStub != nil {
+		return fake.GetApplicationTasksStub(appGUID, sortOrder)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationTasksReturns.result1, fake.getApplicationTasksReturns.result2, fake.getApplicationTasksReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksCallCount() int {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return len(fake.getApplicationTasksArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksArgsForCall(i int) (string, v3action.SortOrder) {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].sortOrder
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationTasksStub = nil
+	fake.getApplicationTasksReturns = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksReturnsOnCall(i int, result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationTasksStub = nil
+	if fake.getApplicationTasksReturnsOnCall == nil {
+		fake.getApplicationTasksReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationTasksReturnsOnCall[i] = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentSummaries() ([]v3action.IsolationSegmentSummary, v3action.Warnings, error) {
+	fake.getIsolationSegmentSummariesMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentSummariesReturnsOnCall[len(fake.getIsolationSegmentSummariesArgsForCall)]
+	fake.getIsolationSegmentSummariesArgsForCall = append(fake.getIsolationSegmentSummariesArgsForCall, struct{}{})
+	fake.recordInvocation("GetIsolationSegmentSummaries", []interface{}{})
+	fake.getIsolationSegmentSummariesMutex.Unlock()
+	if fake.GetIsolationSegmentSummariesStub != nil {
+		return fake.GetIsolationSegmentSummariesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentSummariesReturns.result1, fake.getIsolationSegmentSummariesReturns.result2, fake.getIsolationSegmentSummariesReturns.result3
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentSummariesCallCount() int {
+	fake.getIsolationSegmentSummariesMutex.RLock()
+	defer fake.getIsolationSegmentSummariesMutex.RUnlock()
+	return len(fake.getIsolationSegmentSummariesArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentSummariesReturns(result1 []v3action.IsolationSegmentSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentSummariesStub = nil
+	fake.getIsolationSegmentSummariesReturns = struct {
+		result1 []v3action.IsolationSegmentSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentSummariesReturnsOnCall(i int, result1 []v3action.IsolationSegmentSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentSummariesStub = nil
+	if fake.getIsolationSegmentSummariesReturnsOnCall == nil {
+		fake.getIsolationSegmentSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v3action.IsolationSegmentSummary
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentSummariesReturnsOnCall[i] = struct {
+		result1 []v3action.IsolationSegmentSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequest(method string, path string, body []byte) (v3action.CloudControllerResponse, v3action.Warnings, error) {
+	var bodyCopy []byte
+	if body != nil {
+		bodyCopy = make([]byte, len(body))
+		copy(bodyCopy, body)
+	}
+	fake.makeCloudControllerRequestMutex.Lock()
+	ret, specificReturn := fake.makeCloudControllerRequestReturnsOnCall[len(fake.makeCloudControllerRequestArgsForCall)]
+	fake.makeCloudControllerRequestArgsForCall = append(fake.makeCloudControllerRequestArgsForCall, struct {
+		method string
+		path   string
+		body   []byte
+	}{method, path, bodyCopy})
+	fake.recordInvocation("MakeCloudControllerRequest", []interface{}{method, path, bodyCopy})
+	fake.makeCloudControllerRequestMutex.Unlock()
+	if fake.MakeCloudControllerRequestStub != nil {
+		return fake.MakeCloudControllerRequestStub(method, path, body)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.makeCloudControllerRequestReturns.result1, fake.makeCloudControllerRequestReturns.result2, fake.makeCloudControllerRequestReturns.result3
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequestCallCount() int {
+	fake.makeCloudControllerRequestMutex.RLock()
+	defer fake.makeCloudControllerRequestMutex.RUnlock()
+	return len(fake.makeCloudControllerRequestArgsForCall)
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequestArgsForCall(i int) (string, string, []byte) {
+	fake.makeCloudControllerRequestMutex.RLock()
+	defer fake.makeCloudControllerRequestMutex.RUnlock()
+	return fake.makeCloudControllerRequestArgsForCall[i].method, fake.makeCloudControllerRequestArgsForCall[i].path, fake.makeCloudControllerRequestArgsForCall[i].body
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequestReturns(result1 v3action.CloudControllerResponse, result2 v3action.Warnings, result3 error) {
+	fake.MakeCloudControllerRequestStub = nil
+	fake.makeCloudControllerRequestReturns = struct {
+		result1 v3action.CloudControllerResponse
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) MakeCloudControllerRequestReturnsOnCall(i int, result1 v3action.CloudControllerResponse, result2 v3action.Warnings, result3 error) {
+	fake.MakeCloudControllerRequestStub = nil
+	if fake.makeCloudControllerRequestReturnsOnCall == nil {
+		fake.makeCloudControllerRequestReturnsOnCall = make(map[int]struct {
+			result1 v3action.CloudControllerResponse
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.makeCloudControllerRequestReturnsOnCall[i] = struct {
+		result1 v3action.CloudControllerResponse
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error) {
+	fake.runTaskMutex.Lock()
+	ret, specificReturn := fake.runTaskReturnsOnCall[len(fake.runTaskArgsForCall)]
+	fake.runTaskArgsForCall = append(fake.runTaskArgsForCall, struct {
+		appGUID string
+		task    v3action.Task
+	}{appGUID, task})
+	fake.recordInvocation("RunTask", []interface{}{appGUID, task})
+	fake.runTaskMutex.Unlock()
+	if fake.RunTaskStub != nil {
+		return fake.RunTaskStub(appGUID, task)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.runTaskReturns.result1, fake.runTaskReturns.result2, fake.runTaskReturns.result3
+}
+
+func (fake *FakeV3Actor) RunTaskCallCount() int {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return len(fake.runTaskArgsForCall)
+}
+
+func (fake *FakeV3Actor) RunTaskArgsForCall(i int) (string, v3action.Task) {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return fake.runTaskArgsForCall[i].appGUID, fake.runTaskArgsForCall[i].task
+}
+
+func (fake *FakeV3Actor) RunTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskStub = nil
+	fake.runTaskReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) RunTaskReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskStub = nil
+	if fake.runTaskReturnsOnCall == nil {
+		fake.runTaskReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.runTaskReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationsWithProcessesBySpaceMutex.RLock()
+	defer fake.getApplicationsWithProcessesBySpaceMutex.RUnlock()
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	fake.getIsolationSegmentSummariesMutex.RLock()
+	defer fake.getIsolationSegmentSummariesMutex.RUnlock()
+	fake.makeCloudControllerRequestMutex.RLock()
+	defer fake.makeCloudControllerRequestMutex.RUnlock()
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeV3Actor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rpc.V3Actor = new(FakeV3Actor)
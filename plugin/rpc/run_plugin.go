@@ -3,36 +3,57 @@ package rpc
 import (
 	"os"
 	"os/exec"
+	"syscall"
 
 	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
 )
 
-func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[string]pluginconfig.PluginMetadata) bool {
+// RunMethodIfExists runs the plugin command matching args[0]. It returns false
+// if no installed plugin provides the command; otherwise it returns true and
+// the exit status of the plugin.
+func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[string]pluginconfig.PluginMetadata) (bool, int) {
 	for _, metadata := range pluginList {
 		for _, command := range metadata.Commands {
 			if command.Name == args[0] || command.Alias == args[0] {
 				args[0] = command.Name
+				return true, runPlugin(rpcService, metadata.Location, args)
+			}
+		}
+	}
+	return false, 0
+}
 
-				rpcService.Start()
-				defer rpcService.Stop()
-
-				pluginArgs := append([]string{rpcService.Port()}, args...)
-
-				cmd := exec.Command(metadata.Location, pluginArgs...)
-				cmd.Stdout = os.Stdout
-				cmd.Stdin = os.Stdin
-				cmd.Stderr = os.Stderr
+// ExitStatus returns the exit status of a plugin process given the error
+// returned from running it.
+func ExitStatus(err error) int {
+	if err == nil {
+		return 0
+	}
 
-				defer stopPlugin(cmd)
-				err := cmd.Run()
-				if err != nil {
-					os.Exit(1)
-				}
-				return true
-			}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.ExitStatus() > 0 {
+			return status.ExitStatus()
 		}
 	}
-	return false
+	return 1
+}
+
+func runPlugin(rpcService *CliRpcService, location string, args []string) int {
+	err := rpcService.Start()
+	if err != nil {
+		return 1
+	}
+	defer rpcService.Stop()
+
+	pluginArgs := append([]string{rpcService.Port()}, args...)
+
+	cmd := exec.Command(location, pluginArgs...)
+	cmd.Stdout = os.Stdout
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+
+	defer stopPlugin(cmd)
+	return ExitStatus(cmd.Run())
 }
 
 func stopPlugin(plugin *exec.Cmd) {
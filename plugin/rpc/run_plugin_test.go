@@ -0,0 +1,27 @@
+package rpc_test
+
+import (
+	"errors"
+	"os/exec"
+
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("run_plugin", func() {
+	Describe("ExitStatus", func() {
+		It("returns 0 when the plugin succeeds", func() {
+			Expect(ExitStatus(nil)).To(Equal(0))
+		})
+
+		It("returns the exit status of the plugin process", func() {
+			err := exec.Command("sh", "-c", "exit 42").Run()
+			Expect(ExitStatus(err)).To(Equal(42))
+		})
+
+		It("returns 1 when the plugin could not be run", func() {
+			Expect(ExitStatus(errors.New("some-error"))).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+// Package buildpackfile reads the declarative buildpacks file used by the
+// sync-buildpacks command.
+package buildpackfile
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/util/fileerror"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Buildpack is the desired state of a single buildpack.
+type Buildpack struct {
+	Name  string `yaml:"name"`
+	Stack string `yaml:"stack,omitempty"`
+
+	// Position is the desired position of the buildpack. When it is not
+	// provided, the buildpack's place in the file is used.
+	Position int `yaml:"position,omitempty"`
+
+	// Enabled and Locked are left unchanged on the Cloud Controller when they
+	// are not provided.
+	Enabled *bool `yaml:"enabled,omitempty"`
+	Locked  *bool `yaml:"locked,omitempty"`
+
+	// Path is a local zip file or directory, or a URL to a zip file.
+	Path string `yaml:"path"`
+}
+
+// ReadBuildpacks reads the buildpacks listed in the provided file.
+func ReadBuildpacks(pathToFile string) ([]Buildpack, error) {
+	bytes, err := ioutil.ReadFile(pathToFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		Buildpacks []Buildpack `yaml:"buildpacks"`
+	}
+
+	err = yaml.Unmarshal(bytes, &raw)
+	if err != nil {
+		return nil, fileerror.InvalidYAMLError{Kind: Kind, Path: pathToFile, Err: err}
+	}
+
+	if len(raw.Buildpacks) == 0 {
+		return nil, fileerror.EmptyFileError{Kind: Kind, Path: pathToFile}
+	}
+
+	for i, buildpack := range raw.Buildpacks {
+		if buildpack.Name == "" {
+			return nil, fileerror.MissingFieldError{Kind: Kind, Index: i + 1, Field: "name"}
+		}
+		if buildpack.Path == "" {
+			return nil, fileerror.MissingFieldError{Kind: Kind, Index: i + 1, Field: "path"}
+		}
+		if buildpack.Position == 0 {
+			raw.Buildpacks[i].Position = i + 1
+		}
+	}
+
+	return raw.Buildpacks, nil
+}
@@ -0,0 +1,13 @@
+package buildpackfile_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestBuildpackfile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Buildpack File Suite")
+}
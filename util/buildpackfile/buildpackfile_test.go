@@ -0,0 +1,119 @@
+package buildpackfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/buildpackfile"
+	"code.cloudfoundry.org/cli/util/fileerror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadBuildpacks", func() {
+	var (
+		tmpDir     string
+		pathToFile string
+		buildpacks []Buildpack
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "buildpackfile")
+		Expect(err).ToNot(HaveOccurred())
+		pathToFile = filepath.Join(tmpDir, "buildpacks.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		buildpacks, executeErr = ReadBuildpacks(pathToFile)
+	})
+
+	When("the file is valid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte(`---
+buildpacks:
+- name: ruby_buildpack
+  stack: cflinuxfs3
+  enabled: false
+  locked: true
+  path: https://example.com/ruby_buildpack.zip
+- name: go_buildpack
+  position: 5
+  path: ./go_buildpack
+`), 0600)).To(Succeed())
+		})
+
+		It("returns the buildpacks, defaulting the position to their place in the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			enabled, locked := false, true
+			Expect(buildpacks).To(Equal([]Buildpack{
+				{
+					Name:     "ruby_buildpack",
+					Stack:    "cflinuxfs3",
+					Position: 1,
+					Enabled:  &enabled,
+					Locked:   &locked,
+					Path:     "https://example.com/ruby_buildpack.zip",
+				},
+				{
+					Name:     "go_buildpack",
+					Position: 5,
+					Path:     "./go_buildpack",
+				},
+			}))
+		})
+	})
+
+	When("the file does not exist", func() {
+		It("returns the error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+
+	When("the file is not valid YAML", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte("buildpacks: [\n"), 0600)).To(Succeed())
+		})
+
+		It("returns an InvalidYAMLError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(fileerror.InvalidYAMLError{}))
+		})
+	})
+
+	When("the file lists no buildpacks", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte("buildpacks: []\n"), 0600)).To(Succeed())
+		})
+
+		It("returns an EmptyFileError", func() {
+			Expect(executeErr).To(MatchError(fileerror.EmptyFileError{Kind: Kind, Path: pathToFile}))
+		})
+	})
+
+	When("a buildpack is missing its name", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte("buildpacks:\n- name: a\n  path: a.zip\n- path: b.zip\n"), 0600)).To(Succeed())
+		})
+
+		It("returns a MissingFieldError", func() {
+			Expect(executeErr).To(MatchError(fileerror.MissingFieldError{Kind: Kind, Index: 2, Field: "name"}))
+		})
+	})
+
+	When("a buildpack is missing its path", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte("buildpacks:\n- name: a\n"), 0600)).To(Succeed())
+		})
+
+		It("returns a MissingFieldError", func() {
+			Expect(executeErr).To(MatchError(fileerror.MissingFieldError{Kind: Kind, Index: 1, Field: "path"}))
+		})
+	})
+})
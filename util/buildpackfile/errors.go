@@ -0,0 +1,6 @@
+package buildpackfile
+
+import "code.cloudfoundry.org/cli/util/fileerror"
+
+// Kind names buildpack files in the errors returned by Parse.
+var Kind = fileerror.Kind{Name: "buildpacks file", Entries: "buildpacks", Entry: "Buildpack"}
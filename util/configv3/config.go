@@ -4,6 +4,7 @@ package configv3
 import (
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/cli/version"
 )
@@ -59,7 +60,38 @@ func (config *Config) TerminalWidth() int {
 //   - The $CF_TRACE enviroment variable if set (true/false/file path)
 //   - The '-v/--verbose' global flag
 //   - Defaults to false
+//
+// The file paths are omitted when $CF_TRACE_FORMAT is "har", since they are
+// returned by TraceHARFiles instead.
 func (config *Config) Verbose() (bool, []string) {
+	verbose, filePaths := config.trace()
+	if config.traceFormatIsHAR() {
+		return verbose, nil
+	}
+	return verbose, filePaths
+}
+
+// TraceHARFiles returns the full paths of the files in which requests and
+// responses are recorded as HTTP Archives (HAR). This is based off of:
+//   - The '--trace-har' global flag
+//   - The trace file paths from the config file and $CF_TRACE when
+//     $CF_TRACE_FORMAT is "har"
+func (config *Config) TraceHARFiles() []string {
+	var filePaths []string
+	if config.traceFormatIsHAR() {
+		_, filePaths = config.trace()
+	}
+	if config.Flags.TraceHAR != "" {
+		filePaths = append(filePaths, config.absolutePath(config.Flags.TraceHAR))
+	}
+	return filePaths
+}
+
+func (config *Config) traceFormatIsHAR() bool {
+	return strings.EqualFold(config.ENV.CFTraceFormat, "har")
+}
+
+func (config *Config) trace() (bool, []string) {
 	var (
 		verbose     bool
 		envOverride bool
@@ -86,10 +118,15 @@ func (config *Config) Verbose() (bool, []string) {
 	verbose = config.Flags.Verbose || verbose
 
 	for i, path := range filePath {
-		if !filepath.IsAbs(path) {
-			filePath[i] = filepath.Join(config.detectedSettings.currentDirectory, path)
-		}
+		filePath[i] = config.absolutePath(path)
 	}
 
 	return verbose, filePath
 }
+
+func (config *Config) absolutePath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(config.detectedSettings.currentDirectory, path)
+}
@@ -2,6 +2,7 @@ package configv3_test
 
 import (
 	"os"
+	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
 
@@ -41,4 +42,63 @@ var _ = Describe("Config", func() {
 			Expect(config.IsTTY()).To(BeTrue())
 		})
 	})
+
+	Describe("TraceHARFiles", func() {
+		var (
+			cwd      string
+			traceHAR string
+		)
+
+		BeforeEach(func() {
+			var err error
+			cwd, err = os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+
+			traceHAR = ""
+			Expect(os.Setenv("CF_TRACE", "some-trace")).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			config, err = LoadConfig(FlagOverride{TraceHAR: traceHAR})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_TRACE")).ToNot(HaveOccurred())
+			Expect(os.Unsetenv("CF_TRACE_FORMAT")).ToNot(HaveOccurred())
+		})
+
+		When("CF_TRACE_FORMAT is not set", func() {
+			It("returns no files and leaves the trace files to Verbose", func() {
+				Expect(config.TraceHARFiles()).To(BeEmpty())
+				_, location := config.Verbose()
+				Expect(location).To(Equal([]string{filepath.Join(cwd, "some-trace")}))
+			})
+		})
+
+		When("CF_TRACE_FORMAT is har", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_TRACE_FORMAT", "HAR")).ToNot(HaveOccurred())
+			})
+
+			It("returns the trace files instead of Verbose", func() {
+				Expect(config.TraceHARFiles()).To(Equal([]string{filepath.Join(cwd, "some-trace")}))
+				_, location := config.Verbose()
+				Expect(location).To(BeEmpty())
+			})
+		})
+
+		When("the --trace-har flag is provided", func() {
+			BeforeEach(func() {
+				traceHAR = "some-file.har"
+			})
+
+			It("returns the flag's file in addition to the trace files", func() {
+				Expect(config.TraceHARFiles()).To(Equal([]string{filepath.Join(cwd, "some-file.har")}))
+				_, location := config.Verbose()
+				Expect(location).To(Equal([]string{filepath.Join(cwd, "some-trace")}))
+			})
+		})
+	})
 })
@@ -6,6 +6,10 @@ const (
 	// DefaultDialTimeout is the default timeout for the dail.
 	DefaultDialTimeout = 5 * time.Second
 
+	// DefaultHTTPCacheTTL is the default time that cached Cloud Controller
+	// responses are used before they are revalidated.
+	DefaultHTTPCacheTTL = 30 * time.Second
+
 	// DefaultNOAARetryCount is the default number of request retries.
 	DefaultNOAARetryCount = 5
 
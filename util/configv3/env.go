@@ -12,12 +12,16 @@ type EnvOverride struct {
 	CFColor          string
 	CFDialTimeout    string
 	CFHome           string
+	CFHTTPCache      string
 	CFLogLevel       string
 	CFPassword       string
 	CFPluginHome     string
+	CFRecord         string
+	CFReplay         string
 	CFStagingTimeout string
 	CFStartupTimeout string
 	CFTrace          string
+	CFTraceFormat    string
 	CFUsername       string
 	DockerPassword   string
 	Experimental     string
@@ -116,6 +120,20 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
+// RecordDirectory returns the directory that Cloud Controller and UAA
+// requests and responses are recorded to, from the $CF_RECORD environment
+// variable. It is empty when they are not recorded.
+func (config *Config) RecordDirectory() string {
+	return config.ENV.CFRecord
+}
+
+// ReplayDirectory returns the directory of the recorded Cloud Controller and
+// UAA responses that are replayed instead of sending requests, from the
+// $CF_REPLAY environment variable. It is empty when requests are sent.
+func (config *Config) ReplayDirectory() string {
+	return config.ENV.CFReplay
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
@@ -30,6 +30,8 @@ var _ = Describe("Config", func() {
 			Expect(os.Setenv("CF_DIAL_TIMEOUT", "1234")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_DOCKER_PASSWORD", "banana")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_PASSWORD", "I am password.")).ToNot(HaveOccurred())
+			Expect(os.Setenv("CF_RECORD", "some-record-dir")).ToNot(HaveOccurred())
+			Expect(os.Setenv("CF_REPLAY", "some-replay-dir")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_STAGING_TIMEOUT", "8675")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_STARTUP_TIMEOUT", "309")).ToNot(HaveOccurred())
 			Expect(os.Setenv("CF_USERNAME", "i-R-user")).ToNot(HaveOccurred())
@@ -45,6 +47,8 @@ var _ = Describe("Config", func() {
 			Expect(os.Unsetenv("CF_DIAL_TIMEOUT")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_DOCKER_PASSWORD")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_PASSWORD")).ToNot(HaveOccurred())
+			Expect(os.Unsetenv("CF_RECORD")).ToNot(HaveOccurred())
+			Expect(os.Unsetenv("CF_REPLAY")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_STAGING_TIMEOUT")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_STARTUP_TIMEOUT")).ToNot(HaveOccurred())
 			Expect(os.Unsetenv("CF_USERNAME")).ToNot(HaveOccurred())
@@ -57,6 +61,8 @@ var _ = Describe("Config", func() {
 			Expect(config.DialTimeout()).To(Equal(1234 * time.Second))
 			Expect(config.DockerPassword()).To(Equal("banana"))
 			Expect(config.HTTPSProxy()).To(Equal("proxy.com"))
+			Expect(config.RecordDirectory()).To(Equal("some-record-dir"))
+			Expect(config.ReplayDirectory()).To(Equal("some-replay-dir"))
 			Expect(config.StagingTimeout()).To(Equal(time.Duration(8675) * time.Minute))
 			Expect(config.StartupTimeout()).To(Equal(time.Duration(309) * time.Minute))
 		})
@@ -2,5 +2,6 @@ package configv3
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	Verbose  bool
+	TraceHAR string
 }
@@ -0,0 +1,38 @@
+package configv3
+
+import (
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// HTTPCache returns true if Cloud Controller GET responses should be cached on
+// disk, in addition to how long cached responses are used before they are
+// revalidated. This is based off of:
+//   - The $CF_HTTP_CACHE environment variable if set (true/false/TTL in
+//     seconds)
+//   - Defaults to false
+func (config *Config) HTTPCache() (bool, time.Duration) {
+	if config.ENV.CFHTTPCache == "" {
+		return false, 0
+	}
+
+	if seconds, err := strconv.ParseInt(config.ENV.CFHTTPCache, 10, 64); err == nil {
+		if seconds < 0 {
+			return false, 0
+		}
+		return true, time.Duration(seconds) * time.Second
+	}
+
+	if enabled, err := strconv.ParseBool(config.ENV.CFHTTPCache); err == nil && enabled {
+		return true, DefaultHTTPCacheTTL
+	}
+
+	return false, 0
+}
+
+// HTTPCacheDirectory returns the directory that Cloud Controller responses are
+// cached in.
+func (*Config) HTTPCacheDirectory() string {
+	return filepath.Join(configDirectory(), "http-cache")
+}
@@ -0,0 +1,49 @@
+package configv3_test
+
+import (
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTP cache", func() {
+	DescribeTable("HTTPCache",
+		func(envVal string, expectedEnabled bool, expectedTTL time.Duration) {
+			config := Config{ENV: EnvOverride{CFHTTPCache: envVal}}
+			enabled, ttl := config.HTTPCache()
+			Expect(enabled).To(Equal(expectedEnabled))
+			Expect(ttl).To(Equal(expectedTTL))
+		},
+
+		Entry("defaults to disabled", "", false, time.Duration(0)),
+		Entry("enables the cache with the default TTL", "true", true, DefaultHTTPCacheTTL),
+		Entry("disables the cache", "false", false, time.Duration(0)),
+		Entry("enables the cache with the TTL in seconds", "120", true, 2*time.Minute),
+		Entry("enables the cache without a TTL", "0", true, time.Duration(0)),
+		Entry("ignores a negative TTL", "-5", false, time.Duration(0)),
+		Entry("ignores invalid values", "banana", false, time.Duration(0)),
+	)
+
+	Describe("HTTPCacheDirectory", func() {
+		var homeDir string
+
+		BeforeEach(func() {
+			homeDir = setup()
+		})
+
+		AfterEach(func() {
+			teardown(homeDir)
+		})
+
+		It("returns the http-cache directory in the .cf directory", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.HTTPCacheDirectory()).To(Equal(filepath.Join(homeDir, ".cf", "http-cache")))
+		})
+	})
+})
@@ -24,11 +24,15 @@ type JSONConfig struct {
 	TargetedOrganization     Organization       `json:"OrganizationFields"`
 	TargetedSpace            Space              `json:"SpaceFields"`
 	SkipSSLValidation        bool               `json:"SSLDisabled"`
+	CACertFile               string             `json:"CACertFile,omitempty"`
+	ClientCertFile           string             `json:"ClientCertFile,omitempty"`
+	ClientKeyFile            string             `json:"ClientKeyFile,omitempty"`
 	AsyncTimeout             int                `json:"AsyncTimeout"`
 	Trace                    string             `json:"Trace"`
 	ColorEnabled             string             `json:"ColorEnabled"`
 	Locale                   string             `json:"Locale"`
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
+	PluginKeys               []PluginKey        `json:"PluginKeys"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
 }
@@ -81,6 +85,13 @@ func (config *Config) CurrentUser() (User, error) {
 	return decodeUserFromJWT(config.ConfigFile.AccessToken)
 }
 
+// CurrentUserName returns the name of the user decoded from the JWT access
+// token in .cf/config.json.
+func (config *Config) CurrentUserName() (string, error) {
+	user, err := config.CurrentUser()
+	return user.Name, err
+}
+
 // HasTargetedOrganization returns true if the organization is set.
 func (config *Config) HasTargetedOrganization() bool {
 	return config.ConfigFile.TargetedOrganization.GUID != ""
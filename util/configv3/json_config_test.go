@@ -92,6 +92,20 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("CurrentUserName", func() {
+		It("returns the name of the user", func() {
+			config = &Config{
+				ConfigFile: JSONConfig{
+					AccessToken: AccessTokenForHumanUsers,
+				},
+			}
+
+			name, err := config.CurrentUserName()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("admin"))
+		})
+	})
+
 	Describe("HasTargetedOrganization", func() {
 		When("an organization is targeted", func() {
 			It("returns true", func() {
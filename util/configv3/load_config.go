@@ -81,12 +81,16 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		BinaryName:       filepath.Base(os.Args[0]),
 		CFColor:          os.Getenv("CF_COLOR"),
 		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
+		CFHTTPCache:      os.Getenv("CF_HTTP_CACHE"),
 		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
 		CFPassword:       os.Getenv("CF_PASSWORD"),
 		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
+		CFRecord:         os.Getenv("CF_RECORD"),
+		CFReplay:         os.Getenv("CF_REPLAY"),
 		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
 		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
 		CFTrace:          os.Getenv("CF_TRACE"),
+		CFTraceFormat:    os.Getenv("CF_TRACE_FORMAT"),
 		CFUsername:       os.Getenv("CF_USERNAME"),
 		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
 		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
@@ -0,0 +1,42 @@
+package configv3
+
+import (
+	"sort"
+	"strings"
+)
+
+// PluginKey is a saved public key trusted to sign plugin binaries. PublicKey
+// is a base64-encoded Ed25519 public key.
+type PluginKey struct {
+	Name      string `json:"Name"`
+	PublicKey string `json:"PublicKey"`
+}
+
+// AddPluginKey adds a new trusted key to the plugin config.
+func (config *Config) AddPluginKey(name string, publicKey string) {
+	config.ConfigFile.PluginKeys = append(config.ConfigFile.PluginKeys,
+		PluginKey{Name: name, PublicKey: publicKey})
+}
+
+// PluginKeys returns the currently trusted plugin keys from the
+// .cf/config.json.
+func (config *Config) PluginKeys() []PluginKey {
+	keys := make([]PluginKey, len(config.ConfigFile.PluginKeys))
+	copy(keys, config.ConfigFile.PluginKeys)
+	sort.Slice(keys, func(i, j int) bool {
+		return strings.ToLower(keys[i].Name) < strings.ToLower(keys[j].Name)
+	})
+	return keys
+}
+
+// RemovePluginKey removes the trusted key with the provided name, ignoring
+// case, from the plugin config.
+func (config *Config) RemovePluginKey(name string) {
+	var keys []PluginKey
+	for _, key := range config.ConfigFile.PluginKeys {
+		if !strings.EqualFold(key.Name, name) {
+			keys = append(keys, key)
+		}
+	}
+	config.ConfigFile.PluginKeys = keys
+}
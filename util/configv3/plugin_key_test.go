@@ -0,0 +1,60 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PluginKey", func() {
+	var config Config
+
+	BeforeEach(func() {
+		config = Config{
+			ConfigFile: JSONConfig{
+				PluginKeys: []PluginKey{
+					{Name: "S-key", PublicKey: "S-public-key"},
+					{Name: "key-2", PublicKey: "public-key-2"},
+					{Name: "key-1", PublicKey: "public-key-1"},
+				},
+			},
+		}
+	})
+
+	Describe("PluginKeys", func() {
+		It("returns sorted plugin keys", func() {
+			Expect(config.PluginKeys()).To(Equal([]PluginKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+				{Name: "key-2", PublicKey: "public-key-2"},
+				{Name: "S-key", PublicKey: "S-public-key"},
+			}))
+		})
+
+		It("does not reorder the keys in the config", func() {
+			config.PluginKeys()
+			Expect(config.ConfigFile.PluginKeys).To(Equal([]PluginKey{
+				{Name: "S-key", PublicKey: "S-public-key"},
+				{Name: "key-2", PublicKey: "public-key-2"},
+				{Name: "key-1", PublicKey: "public-key-1"},
+			}))
+		})
+	})
+
+	Describe("AddPluginKey", func() {
+		It("adds the key name and public key to the list of keys", func() {
+			config.AddPluginKey("some-key", "some-public-key")
+			Expect(config.PluginKeys()).To(ContainElement(PluginKey{Name: "some-key", PublicKey: "some-public-key"}))
+		})
+	})
+
+	Describe("RemovePluginKey", func() {
+		It("removes the key with a case insensitive name match", func() {
+			config.RemovePluginKey("S-KEY")
+			Expect(config.PluginKeys()).To(Equal([]PluginKey{
+				{Name: "key-1", PublicKey: "public-key-1"},
+				{Name: "key-2", PublicKey: "public-key-2"},
+			}))
+		})
+	})
+})
@@ -23,6 +23,12 @@ type Plugin struct {
 	Location string          `json:"Location"`
 	Version  PluginVersion   `json:"Version"`
 	Commands []PluginCommand `json:"Commands"`
+	Hooks    []PluginHook    `json:"Hooks,omitempty"`
+
+	// Repository and URL record where the plugin binary was downloaded from,
+	// when it was installed from a plugin repository or a URL.
+	Repository string `json:"Repository,omitempty"`
+	URL        string `json:"URL,omitempty"`
 }
 
 // CalculateSHA1 returns the sha1 value of the plugin executable. If an error
@@ -45,6 +51,17 @@ func (p Plugin) PluginCommands() []PluginCommand {
 	return p.Commands
 }
 
+// HasHook returns true if the plugin registered a hook for the given stage of
+// the command.
+func (p Plugin) HasHook(stage string, commandName string) bool {
+	for _, hook := range p.Hooks {
+		if hook.Stage == stage && hook.Command == commandName {
+			return true
+		}
+	}
+	return false
+}
+
 // PluginVersion is the plugin version information
 type PluginVersion struct {
 	Major int `json:"Major"`
@@ -77,6 +94,13 @@ func (c PluginCommand) CommandName() string {
 	return c.Name
 }
 
+// PluginHook is a core command that the plugin runs its hook before ("pre")
+// or after ("post") as given by Stage.
+type PluginHook struct {
+	Stage   string `json:"Stage"`
+	Command string `json:"Command"`
+}
+
 // PluginUsageDetails contains the usage metadata provided by the plugin
 type PluginUsageDetails struct {
 	Usage   string            `json:"Usage"`
@@ -514,6 +514,29 @@ var _ = Describe("PluginsConfig", func() {
 				}))
 			})
 		})
+
+		Describe("HasHook", func() {
+			var plugin Plugin
+
+			BeforeEach(func() {
+				plugin = Plugin{
+					Hooks: []PluginHook{
+						{Stage: "pre", Command: "push"},
+						{Stage: "post", Command: "delete"},
+					},
+				}
+			})
+
+			It("returns true when the plugin has a hook for the stage of the command", func() {
+				Expect(plugin.HasHook("pre", "push")).To(BeTrue())
+				Expect(plugin.HasHook("post", "delete")).To(BeTrue())
+			})
+
+			It("returns false otherwise", func() {
+				Expect(plugin.HasHook("post", "push")).To(BeFalse())
+				Expect(plugin.HasHook("pre", "apps")).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("PluginVersion", func() {
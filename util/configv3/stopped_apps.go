@@ -0,0 +1,9 @@
+package configv3
+
+import "path/filepath"
+
+// StoppedAppsFilePath returns the file that records the apps stopped by
+// stop-all, so that start-all --restore starts only those apps again.
+func (*Config) StoppedAppsFilePath() string {
+	return filepath.Join(configDirectory(), "stopped-apps.json")
+}
@@ -0,0 +1,30 @@
+package configv3_test
+
+import (
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stopped apps", func() {
+	Describe("StoppedAppsFilePath", func() {
+		var homeDir string
+
+		BeforeEach(func() {
+			homeDir = setup()
+		})
+
+		AfterEach(func() {
+			teardown(homeDir)
+		})
+
+		It("returns the stopped-apps.json file in the .cf directory", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.StoppedAppsFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "stopped-apps.json")))
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package configv3
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"code.cloudfoundry.org/cli/util/tlsconfig"
+)
+
+// CACertFile returns the path of the CA certificate trusted in addition to the
+// system's certificates.
+func (config *Config) CACertFile() string {
+	return config.ConfigFile.CACertFile
+}
+
+// ClientCertFile returns the path of the client certificate presented for
+// mutual TLS.
+func (config *Config) ClientCertFile() string {
+	return config.ConfigFile.ClientCertFile
+}
+
+// ClientKeyFile returns the path of the private key of the client
+// certificate.
+func (config *Config) ClientKeyFile() string {
+	return config.ConfigFile.ClientKeyFile
+}
+
+// SetTLSCertificateFiles sets the paths of the CA certificate and of the
+// client certificate and key. Empty paths unset them.
+func (config *Config) SetTLSCertificateFiles(caCertFile string, clientCertFile string, clientKeyFile string) {
+	config.ConfigFile.CACertFile = caCertFile
+	config.ConfigFile.ClientCertFile = clientCertFile
+	config.ConfigFile.ClientKeyFile = clientKeyFile
+}
+
+// TLSCertificates loads the certificate pool used to verify servers and the
+// client certificates presented to them from the configured files. The pool
+// is nil when no CA certificate is configured.
+func (config *Config) TLSCertificates() (*x509.CertPool, []tls.Certificate, error) {
+	return tlsconfig.LoadCertificates(config.ConfigFile.CACertFile, config.ConfigFile.ClientCertFile, config.ConfigFile.ClientKeyFile)
+}
@@ -0,0 +1,61 @@
+package configv3_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/tlsconfig"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TLS certificates", func() {
+	var config Config
+
+	BeforeEach(func() {
+		config = Config{}
+	})
+
+	Describe("SetTLSCertificateFiles", func() {
+		It("sets the certificate file paths", func() {
+			config.SetTLSCertificateFiles("some-ca-cert", "some-client-cert", "some-client-key")
+			Expect(config.CACertFile()).To(Equal("some-ca-cert"))
+			Expect(config.ClientCertFile()).To(Equal("some-client-cert"))
+			Expect(config.ClientKeyFile()).To(Equal("some-client-key"))
+		})
+	})
+
+	Describe("TLSCertificates", func() {
+		When("no certificate files are set", func() {
+			It("returns no pool and no certificates", func() {
+				rootCAs, certificates, err := config.TLSCertificates()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rootCAs).To(BeNil())
+				Expect(certificates).To(BeEmpty())
+			})
+		})
+
+		When("the CA certificate file does not exist", func() {
+			BeforeEach(func() {
+				config.SetTLSCertificateFiles("/non-existent/ca.pem", "", "")
+			})
+
+			It("returns the error", func() {
+				_, _, err := config.TLSCertificates()
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		When("the client certificate cannot be loaded", func() {
+			BeforeEach(func() {
+				config.SetTLSCertificateFiles("", "/non-existent/client.pem", "/non-existent/client-key.pem")
+			})
+
+			It("returns an InvalidClientCertificateError", func() {
+				_, _, err := config.TLSCertificates()
+				Expect(err).To(BeAssignableToTypeOf(tlsconfig.InvalidClientCertificateError{}))
+			})
+		})
+	})
+})
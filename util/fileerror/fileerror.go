@@ -0,0 +1,63 @@
+// Package fileerror contains the errors returned when reading the YAML files
+// that list the resources that commands apply, such as plugin, buildpack,
+// network policy and organization files.
+package fileerror
+
+import "fmt"
+
+// Kind describes a kind of file, so that its errors can name it and its
+// entries.
+type Kind struct {
+	// Name is the name of the kind of file, e.g. "plugin file".
+	Name string
+
+	// Entries is the name of the entries listed by the file, e.g. "plugins".
+	Entries string
+
+	// Entry is the name of an entry at the start of a sentence, e.g. "Plugin".
+	Entry string
+}
+
+// EmptyFileError is returned when the file does not list any entries.
+type EmptyFileError struct {
+	Kind Kind
+	Path string
+}
+
+func (e EmptyFileError) Error() string {
+	return fmt.Sprintf("No %s found in %s", e.Kind.Entries, e.Path)
+}
+
+// InvalidYAMLError is returned when the file is not valid YAML, or does not
+// have the fields of the kind of file.
+type InvalidYAMLError struct {
+	Kind Kind
+	Path string
+	Err  error
+}
+
+func (e InvalidYAMLError) Error() string {
+	return fmt.Sprintf("%s is not a valid %s: %s", e.Path, e.Kind.Name, e.Err)
+}
+
+// MissingFieldError is returned when an entry in the file is missing a
+// required field. Index starts at 1, and is 0 when the field is missing from
+// the top level of the file. List is the name of the list that contains the
+// entry when the file has more than one.
+type MissingFieldError struct {
+	Kind  Kind
+	List  string
+	Index int
+	Field string
+}
+
+func (e MissingFieldError) Error() string {
+	switch {
+	case e.Index == 0:
+		return fmt.Sprintf("The %s is missing the required '%s' field", e.Kind.Name, e.Field)
+	case e.List != "":
+		return fmt.Sprintf("Entry %d of '%s' in the %s is missing the required '%s' field", e.Index, e.List, e.Kind.Name, e.Field)
+	default:
+		return fmt.Sprintf("%s %d is missing the required '%s' field", e.Kind.Entry, e.Index, e.Field)
+	}
+}
@@ -0,0 +1,13 @@
+package fileerror_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFileerror(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "File Error Suite")
+}
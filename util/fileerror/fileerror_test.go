@@ -0,0 +1,36 @@
+package fileerror_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/util/fileerror"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("File errors", func() {
+	kind := Kind{Name: "widget file", Entries: "widgets", Entry: "Widget"}
+
+	DescribeTable("Error",
+		func(err error, expectedMessage string) {
+			Expect(err.Error()).To(Equal(expectedMessage))
+		},
+		Entry("EmptyFileError",
+			EmptyFileError{Kind: kind, Path: "some-path"},
+			"No widgets found in some-path"),
+		Entry("InvalidYAMLError",
+			InvalidYAMLError{Kind: kind, Path: "some-path", Err: errors.New("some-error")},
+			"some-path is not a valid widget file: some-error"),
+		Entry("MissingFieldError for an entry",
+			MissingFieldError{Kind: kind, Index: 2, Field: "name"},
+			"Widget 2 is missing the required 'name' field"),
+		Entry("MissingFieldError for an entry of a list",
+			MissingFieldError{Kind: kind, List: "parts", Index: 2, Field: "name"},
+			"Entry 2 of 'parts' in the widget file is missing the required 'name' field"),
+		Entry("MissingFieldError for the top level of the file",
+			MissingFieldError{Kind: kind, Field: "name"},
+			"The widget file is missing the required 'name' field"),
+	)
+})
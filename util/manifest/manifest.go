@@ -40,13 +40,47 @@ func ReadAndInterpolateManifest(pathToManifest string, pathsToVarsFiles []string
 		return nil, err
 	}
 
-	tpl := template.NewTemplate(rawManifest)
-	fileVars := template.StaticVariables{}
+	variables, err := loadVariables(pathsToVarsFiles, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rawManifest, err = interpolate(pathToManifest, rawManifest, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+
+	err = yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	resolveApplicationPaths(manifest.Applications, pathToManifest)
+
+	return manifest.Applications, err
+}
+
+// resolveApplicationPaths makes the relative application paths relative to
+// the directory of the manifest.
+func resolveApplicationPaths(applications []Application, pathToManifest string) {
+	for i, app := range applications {
+		if app.Path != "" && !filepath.IsAbs(app.Path) {
+			applications[i].Path = filepath.Join(filepath.Dir(pathToManifest), app.Path)
+		}
+	}
+}
+
+// loadVariables merges the variables from the vars files and the provided
+// variables, the latter taking precedence.
+func loadVariables(pathsToVarsFiles []string, vars []template.VarKV) (template.StaticVariables, error) {
+	variables := template.StaticVariables{}
 
 	for _, path := range pathsToVarsFiles {
-		rawVarsFile, ioerr := ioutil.ReadFile(path)
-		if ioerr != nil {
-			return nil, ioerr
+		rawVarsFile, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
 		}
 
 		var sv template.StaticVariables
@@ -57,45 +91,55 @@ func ReadAndInterpolateManifest(pathToManifest string, pathsToVarsFiles []string
 		}
 
 		for k, v := range sv {
-			fileVars[k] = v
+			variables[k] = v
 		}
 	}
 
 	for _, kv := range vars {
-		fileVars[kv.Name] = kv.Value
+		variables[kv.Name] = kv.Value
 	}
 
-	rawManifest, err = tpl.Evaluate(fileVars, nil, template.EvaluateOpts{ExpectAllKeys: true})
-	if err != nil {
-		return nil, InterpolationError{Err: err}
-	}
-
-	var manifest Manifest
+	return variables, nil
+}
 
-	err = yaml.Unmarshal(rawManifest, &manifest)
-	if err != nil {
-		return nil, err
+// interpolate replaces the variables in the raw manifest. When variables have
+// no value, all of them are reported with their positions in an
+// UnresolvedVariablesError.
+func interpolate(pathToManifest string, rawManifest []byte, variables template.StaticVariables) ([]byte, error) {
+	if unresolved := findUnresolvedVariables(rawManifest, variables); len(unresolved) > 0 {
+		return nil, UnresolvedVariablesError{ManifestPath: pathToManifest, Variables: unresolved}
 	}
 
-	for i, app := range manifest.Applications {
-		if app.Path != "" && !filepath.IsAbs(app.Path) {
-			manifest.Applications[i].Path = filepath.Join(filepath.Dir(pathToManifest), app.Path)
-		}
+	tpl := template.NewTemplate(rawManifest)
+	interpolated, err := tpl.Evaluate(variables, nil, template.EvaluateOpts{ExpectAllKeys: true})
+	if err != nil {
+		return nil, InterpolationError{Err: err}
 	}
 
-	return manifest.Applications, err
+	return interpolated, nil
 }
 
 // WriteApplicationManifest writes the provided application to the given
 // filepath. If the filepath does not exist, it will create it.
 func WriteApplicationManifest(application Application, filePath string) error {
-	manifest := Manifest{Applications: []Application{application}}
-	manifestBytes, err := yaml.Marshal(manifest)
+	return WriteApplicationManifests([]Application{application}, filePath)
+}
+
+// WriteApplicationManifests writes the provided applications to the given
+// filepath as a single manifest. If the filepath does not exist, it will
+// create it.
+func WriteApplicationManifests(applications []Application, filePath string) error {
+	manifest := Manifest{Applications: applications}
+	return writeYAML(manifest, filePath)
+}
+
+func writeYAML(value interface{}, filePath string) error {
+	yamlBytes, err := yaml.Marshal(value)
 	if err != nil {
 		return ManifestCreationError{Err: err}
 	}
 
-	err = ioutil.WriteFile(filePath, manifestBytes, 0644)
+	err = ioutil.WriteFile(filePath, yamlBytes, 0644)
 	if err != nil {
 		return ManifestCreationError{Err: err}
 	}
@@ -467,7 +467,13 @@ applications:
 					})
 
 					It("returns an error", func() {
-						Expect(executeErr.Error()).To(Equal("Expected to find variables: var1, var2"))
+						Expect(executeErr).To(MatchError(UnresolvedVariablesError{
+							ManifestPath: pathToManifest,
+							Variables: []UnresolvedVariable{
+								{Name: "var1", Positions: []Position{{Line: 3, Column: 9}}},
+								{Name: "var2", Positions: []Position{{Line: 4, Column: 14}}},
+							},
+						}))
 					})
 				})
 
@@ -724,4 +730,39 @@ applications:
 			})
 		})
 	})
+
+	Describe("WriteApplicationManifests", func() {
+		var (
+			tmpDir   string
+			filePath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "manifest-test-")
+			Expect(err).NotTo(HaveOccurred())
+			filePath = filepath.Join(tmpDir, "manifest.yml")
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		It("writes all the applications to a single manifest", func() {
+			err := WriteApplicationManifests([]Application{
+				{Name: "app-1", Instances: types.NullInt{Value: 2, IsSet: true}},
+				{Name: "app-2", NoRoute: true},
+			}, filePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			manifestBytes, err := ioutil.ReadFile(filePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+  instances: 2
+- name: app-2
+  no-route: true
+`))
+		})
+	})
 })
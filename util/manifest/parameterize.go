@@ -0,0 +1,149 @@
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+var invalidVariableNameChars = regexp.MustCompile(`[^-.\w]+`)
+
+// parameterizedApplication marshals an application with its instance count
+// replaced by a variable, which the integer instances field of
+// rawManifestApplication cannot hold.
+type parameterizedApplication struct {
+	Application
+	InstancesVariable string
+}
+
+func (app parameterizedApplication) MarshalYAML() (interface{}, error) {
+	raw, err := app.Application.MarshalYAML()
+	if err != nil {
+		return nil, err
+	}
+
+	rawBytes, err := yaml.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields yaml.MapSlice
+	err = yaml.Unmarshal(rawBytes, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, field := range fields {
+		if field.Key == "instances" {
+			fields[i].Value = fmt.Sprintf("((%s))", app.InstancesVariable)
+		}
+	}
+
+	return fields, nil
+}
+
+// WriteParameterizedApplicationManifests writes the provided applications to
+// manifestPath as a single manifest in which the environment-specific values
+// are replaced by ((variables)): the instance count of every application
+// becomes ((<app-name>-instances)) and every route on one of the provided
+// domains has the domain replaced by ((domain-N)). The values of the
+// variables are written to varsFilePath, so that the manifest can be pushed
+// to another environment with a different vars file.
+func WriteParameterizedApplicationManifests(applications []Application, domains []string, manifestPath string, varsFilePath string) error {
+	sortedDomains := sortDomainsForMatching(domains)
+
+	var usedDomains []string
+	for _, app := range applications {
+		for _, route := range app.Routes {
+			if domain, _, _, ok := splitRouteDomain(route, sortedDomains); ok && !containsString(usedDomains, domain) {
+				usedDomains = append(usedDomains, domain)
+			}
+		}
+	}
+	sort.Strings(usedDomains)
+
+	vars := map[string]interface{}{}
+	domainVariables := map[string]string{}
+	for i, domain := range usedDomains {
+		variable := fmt.Sprintf("domain-%d", i+1)
+		domainVariables[domain] = variable
+		vars[variable] = domain
+	}
+
+	var parameterizedApps []parameterizedApplication
+	for _, app := range applications {
+		parameterizedApp := parameterizedApplication{Application: app}
+
+		if app.Instances.IsSet {
+			parameterizedApp.InstancesVariable = invalidVariableNameChars.ReplaceAllString(app.Name, "-") + "-instances"
+			vars[parameterizedApp.InstancesVariable] = app.Instances.Value
+		}
+
+		parameterizedApp.Routes = nil
+		for _, route := range app.Routes {
+			if domain, prefix, suffix, ok := splitRouteDomain(route, sortedDomains); ok {
+				route = fmt.Sprintf("%s((%s))%s", prefix, domainVariables[domain], suffix)
+			}
+			parameterizedApp.Routes = append(parameterizedApp.Routes, route)
+		}
+
+		parameterizedApps = append(parameterizedApps, parameterizedApp)
+	}
+
+	err := writeYAML(struct {
+		Applications []parameterizedApplication `yaml:"applications"`
+	}{Applications: parameterizedApps}, manifestPath)
+	if err != nil {
+		return err
+	}
+
+	return writeYAML(vars, varsFilePath)
+}
+
+// RouteDomain returns which of the domains the route is on.
+func RouteDomain(route string, domains []string) (string, bool) {
+	domain, _, _, ok := splitRouteDomain(route, sortDomainsForMatching(domains))
+	return domain, ok
+}
+
+// sortDomainsForMatching returns the domains longest first, so that a route
+// on a subdomain of another domain is attributed to the subdomain.
+func sortDomainsForMatching(domains []string) []string {
+	sortedDomains := make([]string, len(domains))
+	copy(sortedDomains, domains)
+	sort.Slice(sortedDomains, func(i int, j int) bool {
+		return len(sortedDomains[i]) > len(sortedDomains[j])
+	})
+	return sortedDomains
+}
+
+// splitRouteDomain finds which of the domains, sorted for matching, the route
+// is on and returns it together with the parts of the route before and after
+// it.
+func splitRouteDomain(route string, domains []string) (string, string, string, bool) {
+	hostEnd := strings.IndexAny(route, ":/")
+	if hostEnd == -1 {
+		hostEnd = len(route)
+	}
+	host := route[:hostEnd]
+
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return domain, host[:len(host)-len(domain)], route[hostEnd:], true
+		}
+	}
+
+	return "", "", "", false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/types"
+	. "code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WriteParameterizedApplicationManifests", func() {
+	var (
+		applications []Application
+		domains      []string
+		tmpDir       string
+		manifestPath string
+		varsFilePath string
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "manifest-test-")
+		Expect(err).NotTo(HaveOccurred())
+		manifestPath = filepath.Join(tmpDir, "manifest.yml")
+		varsFilePath = filepath.Join(tmpDir, "vars.yml")
+
+		applications = []Application{
+			{
+				Name:      "web",
+				Instances: types.NullInt{Value: 3, IsSet: true},
+				Memory:    types.NullByteSizeInMb{Value: 256, IsSet: true},
+				Routes: []string{
+					"web.apps.example.com",
+					"web.internal.apps.example.com/api",
+					"tcp.example.com:1024",
+					"other.unknown.com",
+				},
+			},
+			{
+				Name:        "my worker",
+				DockerImage: "some-image",
+				Instances:   types.NullInt{Value: 1, IsSet: true},
+				NoRoute:     true,
+			},
+		}
+		domains = []string{"apps.example.com", "internal.apps.example.com", "tcp.example.com"}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = WriteParameterizedApplicationManifests(applications, domains, manifestPath, varsFilePath)
+	})
+
+	It("replaces instance counts and route domains with variables", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		manifestBytes, err := ioutil.ReadFile(manifestPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(manifestBytes)).To(Equal(`applications:
+- name: web
+  instances: ((web-instances))
+  memory: 256M
+  routes:
+  - route: web.((domain-1))
+  - route: web.((domain-2))/api
+  - route: ((domain-3)):1024
+  - route: other.unknown.com
+- name: my worker
+  docker:
+    image: some-image
+  instances: ((my-worker-instances))
+  no-route: true
+`))
+
+		varsBytes, err := ioutil.ReadFile(varsFilePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(varsBytes)).To(Equal(`domain-1: apps.example.com
+domain-2: internal.apps.example.com
+domain-3: tcp.example.com
+my-worker-instances: 1
+web-instances: 3
+`))
+	})
+
+	It("writes a manifest that interpolates back to the original applications", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		apps, err := ReadAndInterpolateManifest(manifestPath, []string{varsFilePath}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apps).To(HaveLen(2))
+		Expect(apps[0].Instances).To(Equal(types.NullInt{Value: 3, IsSet: true}))
+		Expect(apps[0].Routes).To(Equal(applications[0].Routes))
+		Expect(apps[1].Instances).To(Equal(types.NullInt{Value: 1, IsSet: true}))
+	})
+
+	When("the manifest cannot be written", func() {
+		BeforeEach(func() {
+			manifestPath = filepath.Join(tmpDir, "missing", "manifest.yml")
+		})
+
+		It("returns a ManifestCreationError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(ManifestCreationError{}))
+		})
+	})
+})
@@ -0,0 +1,174 @@
+package manifest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Position is a line and column in a manifest file. Both start at 1; a zero
+// Line means the position is unknown.
+type Position struct {
+	Line   int
+	Column int
+}
+
+type positionFrame struct {
+	indent   int
+	path     string
+	sequence bool
+	index    int
+}
+
+type pendingBlock struct {
+	indent int
+	path   string
+}
+
+// locateNodes returns the position of every mapping key and sequence item in
+// the block-style YAML document, indexed by its path (for example
+// applications[0].routes[1].route). Nodes inside flow collections are not
+// located; use locatePath to fall back to their closest located ancestor.
+func locateNodes(raw []byte) map[string]Position {
+	positions := map[string]Position{}
+	stack := []positionFrame{{indent: 0}}
+
+	var (
+		pending          *pendingBlock
+		blockScalarDepth = -1
+	)
+
+	for lineIndex, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimRight(line, "\r")
+		text := strings.TrimLeft(line, " ")
+		indent := len(line) - len(text)
+
+		if blockScalarDepth >= 0 {
+			if text == "" || indent > blockScalarDepth {
+				continue
+			}
+			blockScalarDepth = -1
+		}
+
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, "---") {
+			continue
+		}
+
+		if pending != nil {
+			if indent > pending.indent || (indent == pending.indent && isSequenceItem(text)) {
+				stack = append(stack, positionFrame{indent: indent, path: pending.path, sequence: isSequenceItem(text), index: -1})
+			}
+			pending = nil
+		}
+
+		for len(stack) > 1 {
+			top := stack[len(stack)-1]
+			if top.indent > indent || (top.indent == indent && top.sequence && !isSequenceItem(text)) {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			break
+		}
+
+		column := indent
+		top := &stack[len(stack)-1]
+		if top.sequence && isSequenceItem(text) {
+			top.index++
+			itemPath := fmt.Sprintf("%s[%d]", top.path, top.index)
+			positions[itemPath] = Position{Line: lineIndex + 1, Column: column + 1}
+
+			rest := strings.TrimLeft(text[1:], " ")
+			column += len(text) - len(rest)
+			text = rest
+
+			if text == "" {
+				pending = &pendingBlock{indent: indent, path: itemPath}
+				continue
+			}
+			if _, _, isKey := splitMappingKey(text); !isKey {
+				continue
+			}
+
+			stack = append(stack, positionFrame{indent: column, path: itemPath})
+			top = &stack[len(stack)-1]
+		}
+
+		key, value, isKey := splitMappingKey(text)
+		if !isKey || top.sequence {
+			continue
+		}
+
+		path := key
+		if top.path != "" {
+			path = top.path + "." + key
+		}
+		positions[path] = Position{Line: lineIndex + 1, Column: column + 1}
+
+		switch {
+		case value == "":
+			pending = &pendingBlock{indent: column, path: path}
+		case strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">"):
+			blockScalarDepth = column
+		}
+	}
+
+	return positions
+}
+
+// locatePath returns the position of the node at path, or of its closest
+// located ancestor.
+func locatePath(positions map[string]Position, path string) Position {
+	for path != "" {
+		if position, ok := positions[path]; ok {
+			return position
+		}
+
+		cut := strings.LastIndexAny(path, ".[")
+		if cut == -1 {
+			break
+		}
+		path = path[:cut]
+	}
+
+	return Position{}
+}
+
+func isSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitMappingKey splits a "key: value" line into its key and its value with
+// any trailing comment removed.
+func splitMappingKey(text string) (string, string, bool) {
+	var keyEnd int
+	switch text[0] {
+	case '"', '\'':
+		closing := strings.IndexByte(text[1:], text[0])
+		if closing == -1 {
+			return "", "", false
+		}
+		keyEnd = closing + 2
+		if !strings.HasPrefix(text[keyEnd:], ":") {
+			return "", "", false
+		}
+	case '[', '{':
+		return "", "", false
+	default:
+		keyEnd = strings.Index(text, ": ")
+		if keyEnd == -1 {
+			if !strings.HasSuffix(text, ":") {
+				return "", "", false
+			}
+			keyEnd = len(text) - 1
+		}
+	}
+
+	key := strings.Trim(text[:keyEnd], `"'`)
+	value := strings.TrimSpace(text[keyEnd+1:])
+	if value == "#" || strings.HasPrefix(value, "# ") {
+		value = ""
+	} else if comment := strings.Index(value, " #"); comment != -1 {
+		value = strings.TrimSpace(value[:comment])
+	}
+
+	return key, value, true
+}
@@ -0,0 +1,36 @@
+package manifest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnresolvedVariable is a manifest variable without a value, with the
+// positions where it is used.
+type UnresolvedVariable struct {
+	Name      string
+	Positions []Position
+}
+
+type UnresolvedVariablesError struct {
+	ManifestPath string
+	Variables    []UnresolvedVariable
+}
+
+func (e UnresolvedVariablesError) Error() string {
+	var variables []string
+	for _, variable := range e.Variables {
+		variables = append(variables, fmt.Sprintf("%s (%s)", variable.Name, strings.Join(e.Locations(variable), ", ")))
+	}
+	return fmt.Sprintf("Expected to find variables: %s", strings.Join(variables, ", "))
+}
+
+// Locations returns where the variable is used, formatted as
+// MANIFEST_PATH:LINE:COLUMN.
+func (e UnresolvedVariablesError) Locations(variable UnresolvedVariable) []string {
+	var locations []string
+	for _, position := range variable.Positions {
+		locations = append(locations, fmt.Sprintf("%s:%d:%d", e.ManifestPath, position.Line, position.Column))
+	}
+	return locations
+}
@@ -0,0 +1,510 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/types"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ValidationSeverity is how serious a ValidationIssue is. Errors prevent the
+// manifest from being pushed; warnings do not.
+type ValidationSeverity string
+
+const (
+	ValidationError   ValidationSeverity = "error"
+	ValidationWarning ValidationSeverity = "warning"
+)
+
+// ValidationIssue is a problem found in a manifest.
+type ValidationIssue struct {
+	// Path identifies the attribute with the problem, for example
+	// applications[0].memory.
+	Path string
+	Position
+	Severity ValidationSeverity
+	Message  string
+}
+
+// ManifestValidation is the result of validating a manifest.
+type ManifestValidation struct {
+	// Applications are the interpolated applications of the manifest. They are
+	// nil when the manifest cannot be read into applications.
+	Applications []Application
+	// Issues are the problems found in the manifest, sorted by position.
+	Issues []ValidationIssue
+
+	positions map[string]Position
+}
+
+// AddIssues adds issues found outside of ValidateManifest, such as by checks
+// against the Cloud Controller, positioning them by their paths.
+func (validation *ManifestValidation) AddIssues(issues ...ValidationIssue) {
+	for _, issue := range issues {
+		if issue.Line == 0 && issue.Path != "" {
+			issue.Position = locatePath(validation.positions, issue.Path)
+		}
+		validation.Issues = append(validation.Issues, issue)
+	}
+
+	sort.SliceStable(validation.Issues, func(i int, j int) bool {
+		a, b := validation.Issues[i], validation.Issues[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Path < b.Path
+	})
+}
+
+// ErrorCount returns the number of issues that are errors.
+func (validation ManifestValidation) ErrorCount() int {
+	count := 0
+	for _, issue := range validation.Issues {
+		if issue.Severity == ValidationError {
+			count++
+		}
+	}
+	return count
+}
+
+var (
+	yamlErrorLine = regexp.MustCompile(`line (\d+): (.*)`)
+	routeRegexp   = regexp.MustCompile(`^(?:https?://|tcp://)?(?:(?:[\w-]+\.)|(?:[*]\.))+\w+(?::(\d+))?(?:/.*)*(?:\.\w+)?$`)
+
+	applicationAttributes = []string{
+		"buildpack", "buildpacks", "command", "disk_quota", "docker", "domain",
+		"domains", "droplet-path", "env", "health-check-http-endpoint",
+		"health-check-type", "host", "hosts", "instances", "memory", "name",
+		"no-hostname", "no-route", "path", "random-route", "routes", "services",
+		"stack", "timeout",
+	}
+	deprecatedAttributes = []string{"domain", "domains", "host", "hosts", "no-hostname"}
+)
+
+// ValidateManifest reads and interpolates the manifest the same way as
+// ReadAndInterpolateManifest, and checks every attribute of its
+// applications. Problems with the manifest are returned as issues with their
+// position in the manifest file; the returned error is only set when the
+// manifest or the vars files cannot be read.
+func ValidateManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) (ManifestValidation, error) {
+	rawManifest, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return ManifestValidation{}, err
+	}
+
+	variables, err := loadVariables(pathsToVarsFiles, vars)
+	if err != nil {
+		return ManifestValidation{}, err
+	}
+
+	validation := ManifestValidation{positions: locateNodes(rawManifest)}
+
+	var document interface{}
+	err = yaml.Unmarshal(rawManifest, &document)
+	if err != nil {
+		validation.AddIssues(yamlErrorIssue(err))
+		return validation, nil
+	}
+
+	interpolated, err := interpolate(pathToManifest, rawManifest, variables)
+	if unresolvedErr, ok := err.(UnresolvedVariablesError); ok {
+		for _, variable := range unresolvedErr.Variables {
+			for _, position := range variable.Positions {
+				validation.AddIssues(ValidationIssue{
+					Position: position,
+					Severity: ValidationError,
+					Message:  fmt.Sprintf("variable ((%s)) has no value", variable.Name),
+				})
+			}
+		}
+		return validation, nil
+	} else if err != nil {
+		validation.AddIssues(ValidationIssue{Severity: ValidationError, Message: err.Error()})
+		return validation, nil
+	}
+
+	err = yaml.Unmarshal(interpolated, &document)
+	if err != nil {
+		validation.AddIssues(yamlErrorIssue(err))
+		return validation, nil
+	}
+
+	validator := manifestValidator{manifestDir: filepath.Dir(pathToManifest)}
+	validator.validateDocument(document)
+	validation.AddIssues(validator.issues...)
+
+	var manifest Manifest
+	if yaml.Unmarshal(interpolated, &manifest) == nil {
+		resolveApplicationPaths(manifest.Applications, pathToManifest)
+		validation.Applications = manifest.Applications
+	}
+
+	return validation, nil
+}
+
+func yamlErrorIssue(err error) ValidationIssue {
+	issue := ValidationIssue{Severity: ValidationError, Message: err.Error()}
+	if matches := yamlErrorLine.FindStringSubmatch(err.Error()); matches != nil {
+		issue.Line, _ = strconv.Atoi(matches[1])
+		issue.Message = matches[2]
+	}
+	return issue
+}
+
+type manifestValidator struct {
+	manifestDir string
+	issues      []ValidationIssue
+}
+
+func (validator *manifestValidator) errorf(path string, format string, args ...interface{}) {
+	validator.issues = append(validator.issues, ValidationIssue{Path: path, Severity: ValidationError, Message: fmt.Sprintf(format, args...)})
+}
+
+func (validator *manifestValidator) warnf(path string, format string, args ...interface{}) {
+	validator.issues = append(validator.issues, ValidationIssue{Path: path, Severity: ValidationWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+func (validator *manifestValidator) validateDocument(document interface{}) {
+	root, ok := document.(map[interface{}]interface{})
+	if !ok {
+		validator.errorf("", "manifest must be a map containing an applications list")
+		return
+	}
+
+	fields := stringKeys(root)
+	for _, key := range sortedKeys(fields) {
+		switch {
+		case key == "applications":
+		case key == "inherit":
+			validator.errorf(key, "inherit is not supported; copy the attributes into each application")
+		case containsString(applicationAttributes, key):
+			validator.errorf(key, "%s must be set on each application; global attributes are not supported", key)
+		default:
+			validator.warnf(key, "unknown attribute %s is ignored", key)
+		}
+	}
+
+	applications, ok := fields["applications"]
+	if !ok {
+		validator.errorf("", "manifest does not contain an applications list")
+		return
+	}
+
+	list, ok := applications.([]interface{})
+	if !ok {
+		validator.errorf("applications", "applications must be a list")
+		return
+	}
+
+	names := map[string]bool{}
+	for i, application := range list {
+		path := fmt.Sprintf("applications[%d]", i)
+		app, isMap := application.(map[interface{}]interface{})
+		if !isMap {
+			validator.errorf(path, "application must be a map of attributes")
+			continue
+		}
+
+		validator.validateApplication(path, stringKeys(app), names)
+	}
+}
+
+func (validator *manifestValidator) validateApplication(path string, fields map[string]interface{}, names map[string]bool) {
+	attributePath := func(key string) string { return path + "." + key }
+
+	name, hasName := fields["name"]
+	if nameString, ok := scalarString(name); !hasName || !ok || nameString == "" {
+		validator.errorf(path, "application must have a name")
+	} else if names[nameString] {
+		validator.errorf(attributePath("name"), "application name %s is used by more than one application", nameString)
+	} else {
+		names[nameString] = true
+	}
+
+	for _, key := range sortedKeys(fields) {
+		value := fields[key]
+		keyPath := attributePath(key)
+
+		switch key {
+		case "name":
+		case "buildpack", "command", "stack":
+			if _, ok := scalarString(value); value != nil && !ok {
+				validator.errorf(keyPath, "%s must be a string", key)
+			}
+		case "buildpacks":
+			validator.validateBuildpacks(keyPath, value)
+		case "disk_quota", "memory":
+			validator.validateByteSize(keyPath, key, value)
+		case "docker":
+			validator.validateDocker(keyPath, value)
+		case "droplet-path", "path":
+			if _, ok := value.(string); !ok {
+				validator.errorf(keyPath, "%s must be a string", key)
+			}
+		case "env":
+			validator.validateEnv(keyPath, value)
+		case "health-check-http-endpoint":
+			if endpoint, ok := value.(string); !ok || !strings.HasPrefix(endpoint, "/") {
+				validator.errorf(keyPath, "health-check-http-endpoint must be a path starting with /")
+			}
+		case "health-check-type":
+			validator.validateHealthCheckType(keyPath, value)
+		case "instances":
+			if instances, ok := value.(int); !ok || instances < 0 {
+				validator.errorf(keyPath, "instances must be a whole number of 0 or more")
+			}
+		case "timeout":
+			if timeout, ok := value.(int); !ok || timeout < 1 {
+				validator.errorf(keyPath, "timeout must be a whole number of seconds greater than 0")
+			}
+		case "no-route", "random-route":
+			if _, ok := value.(bool); !ok {
+				validator.errorf(keyPath, "%s must be true or false", key)
+			}
+		case "routes":
+			validator.validateRoutes(keyPath, value)
+		case "services":
+			validator.validateStringList(keyPath, key, value)
+		case "domain", "domains", "host", "hosts", "no-hostname":
+			validator.warnf(keyPath, "%s is deprecated; use routes instead", key)
+		default:
+			validator.warnf(keyPath, "unknown attribute %s is ignored", key)
+		}
+	}
+
+	validator.validateCombinations(path, fields)
+}
+
+func (validator *manifestValidator) validateCombinations(path string, fields map[string]interface{}) {
+	has := func(key string) bool {
+		_, ok := fields[key]
+		return ok
+	}
+	attributePath := func(key string) string { return path + "." + key }
+
+	if has("buildpack") && has("buildpacks") {
+		validator.errorf(attributePath("buildpacks"), "buildpack and buildpacks cannot be used together; use buildpacks")
+	}
+
+	if has("docker") {
+		for _, key := range []string{"buildpack", "buildpacks", "droplet-path", "path"} {
+			if has(key) {
+				validator.errorf(attributePath(key), "docker and %s cannot be used together", key)
+			}
+		}
+	} else if has("droplet-path") {
+		for _, key := range []string{"buildpack", "buildpacks", "path"} {
+			if has(key) {
+				validator.errorf(attributePath(key), "droplet-path and %s cannot be used together", key)
+			}
+		}
+	}
+
+	if appPath, ok := fields["path"].(string); ok && !has("docker") && !has("droplet-path") {
+		if !filepath.IsAbs(appPath) {
+			appPath = filepath.Join(validator.manifestDir, appPath)
+		}
+		if _, err := os.Stat(appPath); os.IsNotExist(err) {
+			validator.errorf(attributePath("path"), "path %s does not exist", appPath)
+		}
+	}
+
+	if has("health-check-http-endpoint") {
+		if healthCheckType, _ := fields["health-check-type"].(string); strings.ToLower(healthCheckType) != "http" {
+			validator.errorf(attributePath("health-check-http-endpoint"), "health-check-type must be http to set health-check-http-endpoint")
+		}
+	}
+
+	if has("routes") {
+		if noRoute, _ := fields["no-route"].(bool); noRoute {
+			validator.errorf(attributePath("no-route"), "no-route and routes cannot be used together")
+		}
+
+		for _, key := range deprecatedAttributes {
+			if has(key) {
+				validator.errorf(attributePath(key), "routes and %s cannot be used together", key)
+			}
+		}
+	}
+}
+
+func (validator *manifestValidator) validateBuildpacks(path string, value interface{}) {
+	if value == nil {
+		validator.errorf(path, "buildpacks must not be empty")
+		return
+	}
+
+	buildpacks, ok := validator.validateStringList(path, "buildpacks", value)
+	if !ok || len(buildpacks) < 2 {
+		return
+	}
+
+	for i, buildpack := range buildpacks {
+		if buildpack == "null" || buildpack == "default" {
+			validator.errorf(fmt.Sprintf("%s[%d]", path, i), "buildpacks cannot contain %s when more than one buildpack is given", buildpack)
+		}
+	}
+}
+
+func (validator *manifestValidator) validateByteSize(path string, key string, value interface{}) {
+	size, ok := scalarString(value)
+	if !ok {
+		validator.errorf(path, "%s must be a size such as 512M or 1G", key)
+		return
+	}
+
+	var byteSize types.NullByteSizeInMb
+	if err := byteSize.ParseStringValue(size); err != nil {
+		validator.errorf(path, "%s %s is not a valid size; use a number with a unit such as 512M or 1G", key, size)
+	}
+}
+
+func (validator *manifestValidator) validateDocker(path string, value interface{}) {
+	docker, ok := value.(map[interface{}]interface{})
+	if !ok {
+		validator.errorf(path, "docker must be a map with an image")
+		return
+	}
+
+	fields := stringKeys(docker)
+	for _, key := range sortedKeys(fields) {
+		switch key {
+		case "image", "username":
+			if _, isString := scalarString(fields[key]); !isString {
+				validator.errorf(path+"."+key, "docker %s must be a string", key)
+			}
+		default:
+			validator.warnf(path+"."+key, "unknown docker attribute %s is ignored", key)
+		}
+	}
+
+	if _, hasImage := fields["image"]; !hasImage {
+		validator.errorf(path, "docker must have an image")
+	}
+}
+
+func (validator *manifestValidator) validateEnv(path string, value interface{}) {
+	env, ok := value.(map[interface{}]interface{})
+	if !ok {
+		validator.errorf(path, "env must be a map of environment variables")
+		return
+	}
+
+	fields := stringKeys(env)
+	for _, key := range sortedKeys(fields) {
+		if fields[key] == nil {
+			continue
+		}
+		if _, isScalar := scalarString(fields[key]); !isScalar {
+			validator.errorf(path+"."+key, "environment variable %s must be a string, number or boolean", key)
+		}
+	}
+}
+
+func (validator *manifestValidator) validateHealthCheckType(path string, value interface{}) {
+	healthCheckType, _ := value.(string)
+	switch strings.ToLower(healthCheckType) {
+	case "http", "port", "process":
+	case "none":
+		validator.warnf(path, "health-check-type none is deprecated; use process")
+	default:
+		validator.errorf(path, "health-check-type must be http, port or process")
+	}
+}
+
+func (validator *manifestValidator) validateRoutes(path string, value interface{}) {
+	routes, ok := value.([]interface{})
+	if !ok {
+		validator.errorf(path, "routes must be a list")
+		return
+	}
+
+	for i, item := range routes {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		route, isMap := item.(map[interface{}]interface{})
+		if !isMap {
+			validator.errorf(itemPath, "route must be a map with a route attribute")
+			continue
+		}
+
+		fields := stringKeys(route)
+		for _, key := range sortedKeys(fields) {
+			if key != "route" {
+				validator.warnf(itemPath+"."+key, "unknown route attribute %s is ignored", key)
+			}
+		}
+
+		routeString, isString := fields["route"].(string)
+		if !isString {
+			validator.errorf(itemPath, "route must be a map with a route attribute")
+			continue
+		}
+
+		matches := routeRegexp.FindStringSubmatch(routeString)
+		if matches == nil {
+			validator.errorf(itemPath+".route", "route %s is not valid; use HOST.DOMAIN[:PORT][/PATH]", routeString)
+			continue
+		}
+		if port, err := strconv.Atoi(matches[1]); matches[1] != "" && (err != nil || port < 1 || port > 65535) {
+			validator.errorf(itemPath+".route", "route %s has a port outside of 1-65535", routeString)
+		}
+	}
+}
+
+func (validator *manifestValidator) validateStringList(path string, key string, value interface{}) ([]string, bool) {
+	list, ok := value.([]interface{})
+	if !ok {
+		validator.errorf(path, "%s must be a list", key)
+		return nil, false
+	}
+
+	var values []string
+	valid := true
+	for i, item := range list {
+		itemString, isString := scalarString(item)
+		if !isString {
+			validator.errorf(fmt.Sprintf("%s[%d]", path, i), "%s must only contain strings", key)
+			valid = false
+		}
+		values = append(values, itemString)
+	}
+
+	return values, valid
+}
+
+func scalarString(value interface{}) (string, bool) {
+	switch value.(type) {
+	case string, int, int64, uint64, float64, bool:
+		return fmt.Sprint(value), true
+	default:
+		return "", false
+	}
+}
+
+func stringKeys(m map[interface{}]interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	for key, value := range m {
+		fields[fmt.Sprint(key)] = value
+	}
+	return fields
+}
+
+func sortedKeys(fields map[string]interface{}) []string {
+	var keys []string
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
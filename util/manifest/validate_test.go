@@ -0,0 +1,235 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/manifest"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateManifest", func() {
+	var (
+		tmpDir           string
+		pathToManifest   string
+		pathsToVarsFiles []string
+		vars             []template.VarKV
+		manifest         string
+
+		validation ManifestValidation
+		executeErr error
+	)
+
+	issue := func(path string, line int, column int, severity ValidationSeverity, message string) ValidationIssue {
+		return ValidationIssue{Path: path, Position: Position{Line: line, Column: column}, Severity: severity, Message: message}
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "validate-manifest-test")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.Mkdir(filepath.Join(tmpDir, "app-dir"), 0755)).To(Succeed())
+
+		pathToManifest = filepath.Join(tmpDir, "manifest.yml")
+		pathsToVarsFiles = nil
+		vars = nil
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(pathToManifest, []byte(manifest), 0644)).To(Succeed())
+		validation, executeErr = ValidateManifest(pathToManifest, pathsToVarsFiles, vars)
+	})
+
+	When("the manifest is valid", func() {
+		BeforeEach(func() {
+			manifest = `---
+applications:
+- name: web
+  path: app-dir
+  memory: 256M
+  instances: ((instances))
+  health-check-type: http
+  health-check-http-endpoint: /health
+  routes:
+  - route: web.example.com
+  - route: tcp.example.com:1024
+  env:
+    DEBUG: true
+- name: worker
+  docker:
+    image: some-image
+  no-route: true
+`
+			vars = []template.VarKV{{Name: "instances", Value: 2}}
+		})
+
+		It("returns no issues and the interpolated applications", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(validation.Issues).To(BeEmpty())
+			Expect(validation.ErrorCount()).To(Equal(0))
+			Expect(validation.Applications).To(HaveLen(2))
+			Expect(validation.Applications[0].Instances.Value).To(Equal(2))
+			Expect(validation.Applications[0].Path).To(Equal(filepath.Join(tmpDir, "app-dir")))
+		})
+	})
+
+	When("the attributes are invalid", func() {
+		BeforeEach(func() {
+			manifest = `applications:
+- name: web
+  memory: 1GG
+  instances: -1
+  timeout: soon
+  health-check-type: tcp
+  buildpack: ruby_buildpack
+  buildpacks: [ruby_buildpack]
+  routes:
+  - route: not a route
+  - route: web.example.com:99999
+  env:
+    NESTED:
+      key: value
+  host: web
+  sidecars: []
+- name: web
+  docker:
+    image: some-image
+  path: app-dir
+  no-route: yes
+  health-check-http-endpoint: /health
+  random-route: maybe
+`
+		})
+
+		It("reports every problem at its position", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(validation.Issues).To(Equal([]ValidationIssue{
+				issue("applications[0].memory", 3, 3, ValidationError, "memory 1GG is not a valid size; use a number with a unit such as 512M or 1G"),
+				issue("applications[0].instances", 4, 3, ValidationError, "instances must be a whole number of 0 or more"),
+				issue("applications[0].timeout", 5, 3, ValidationError, "timeout must be a whole number of seconds greater than 0"),
+				issue("applications[0].health-check-type", 6, 3, ValidationError, "health-check-type must be http, port or process"),
+				issue("applications[0].buildpacks", 8, 3, ValidationError, "buildpack and buildpacks cannot be used together; use buildpacks"),
+				issue("applications[0].routes[0].route", 10, 5, ValidationError, "route not a route is not valid; use HOST.DOMAIN[:PORT][/PATH]"),
+				issue("applications[0].routes[1].route", 11, 5, ValidationError, "route web.example.com:99999 has a port outside of 1-65535"),
+				issue("applications[0].env.NESTED", 13, 5, ValidationError, "environment variable NESTED must be a string, number or boolean"),
+				issue("applications[0].host", 15, 3, ValidationWarning, "host is deprecated; use routes instead"),
+				issue("applications[0].host", 15, 3, ValidationError, "routes and host cannot be used together"),
+				issue("applications[0].sidecars", 16, 3, ValidationWarning, "unknown attribute sidecars is ignored"),
+				issue("applications[1].name", 17, 3, ValidationError, "application name web is used by more than one application"),
+				issue("applications[1].path", 20, 3, ValidationError, "docker and path cannot be used together"),
+				issue("applications[1].health-check-http-endpoint", 22, 3, ValidationError, "health-check-type must be http to set health-check-http-endpoint"),
+				issue("applications[1].random-route", 23, 3, ValidationError, "random-route must be true or false"),
+			}))
+			Expect(validation.ErrorCount()).To(Equal(13))
+		})
+	})
+
+	When("the app path does not exist", func() {
+		BeforeEach(func() {
+			manifest = `applications:
+- name: web
+  path: missing-dir
+`
+		})
+
+		It("reports the path", func() {
+			Expect(validation.Issues).To(ConsistOf(
+				issue("applications[0].path", 3, 3, ValidationError, "path "+filepath.Join(tmpDir, "missing-dir")+" does not exist"),
+			))
+		})
+	})
+
+	When("the manifest has global attributes", func() {
+		BeforeEach(func() {
+			manifest = `memory: 1G
+inherit: base.yml
+applications:
+- path: app-dir
+`
+		})
+
+		It("reports them and the missing app name", func() {
+			Expect(validation.Issues).To(Equal([]ValidationIssue{
+				issue("memory", 1, 1, ValidationError, "memory must be set on each application; global attributes are not supported"),
+				issue("inherit", 2, 1, ValidationError, "inherit is not supported; copy the attributes into each application"),
+				issue("applications[0]", 4, 1, ValidationError, "application must have a name"),
+			}))
+			Expect(validation.Applications).To(BeNil())
+		})
+	})
+
+	When("the manifest is not valid YAML", func() {
+		BeforeEach(func() {
+			manifest = `applications:
+- name: web
+  memory: [1G
+`
+		})
+
+		It("reports the syntax error with its line", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(validation.Issues).To(HaveLen(1))
+			Expect(validation.Issues[0].Severity).To(Equal(ValidationError))
+			Expect(validation.Issues[0].Line).To(BeNumerically(">", 0))
+		})
+	})
+
+	When("a variable cannot be interpolated", func() {
+		BeforeEach(func() {
+			manifest = `applications:
+- name: ((name))
+`
+		})
+
+		It("reports the missing variable at its position", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(validation.Issues).To(Equal([]ValidationIssue{
+				issue("", 2, 9, ValidationError, "variable ((name)) has no value"),
+			}))
+		})
+	})
+
+	When("the vars file does not exist", func() {
+		BeforeEach(func() {
+			manifest = `applications: []`
+			pathsToVarsFiles = []string{filepath.Join(tmpDir, "missing-vars.yml")}
+		})
+
+		It("returns an error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+
+	Describe("AddIssues", func() {
+		BeforeEach(func() {
+			manifest = `applications:
+- name: web
+  stack: cflinuxfs9
+  services:
+  - db
+  - cache
+`
+		})
+
+		It("positions the issues by their paths", func() {
+			validation.AddIssues(
+				ValidationIssue{Path: "applications[0].services[1]", Severity: ValidationError, Message: "service cache not found"},
+				ValidationIssue{Path: "applications[0].stack", Severity: ValidationError, Message: "stack cflinuxfs9 not found"},
+				ValidationIssue{Path: "applications[0].services[1].unknown", Severity: ValidationWarning, Message: "falls back"},
+			)
+			Expect(validation.Issues).To(Equal([]ValidationIssue{
+				issue("applications[0].stack", 3, 3, ValidationError, "stack cflinuxfs9 not found"),
+				issue("applications[0].services[1]", 6, 3, ValidationError, "service cache not found"),
+				issue("applications[0].services[1].unknown", 6, 3, ValidationWarning, "falls back"),
+			}))
+		})
+	})
+})
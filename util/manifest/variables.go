@@ -0,0 +1,99 @@
+package manifest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// variableRegexp matches a ((variable)) the same way as the template package.
+var variableRegexp = regexp.MustCompile(`\(\((!?[-/\.\w\pL]+)\)\)`)
+
+// ReadVarsDirectory returns a variable for every file in the directory, named
+// after the file and set to its contents without a trailing newline. Hidden
+// files and directories, such as the ones Kubernetes uses to manage mounted
+// secrets, are skipped.
+func ReadVarsDirectory(dir string) (template.StaticVariables, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := template.StaticVariables{}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		// Stat follows symlinks, which mounted secrets usually are.
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			continue
+		}
+
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		value := strings.TrimSuffix(string(contents), "\n")
+		variables[entry.Name()] = strings.TrimSuffix(value, "\r")
+	}
+
+	return variables, nil
+}
+
+// findUnresolvedVariables returns every variable used in the raw manifest
+// that has no value, sorted by name. Variables in comments are ignored.
+func findUnresolvedVariables(rawManifest []byte, variables template.StaticVariables) []UnresolvedVariable {
+	unresolved := map[string]*UnresolvedVariable{}
+
+	for lineIndex, line := range strings.Split(string(rawManifest), "\n") {
+		for _, match := range variableRegexp.FindAllStringSubmatchIndex(line, -1) {
+			if isComment(line[:match[0]]) {
+				break
+			}
+
+			name := strings.TrimPrefix(line[match[2]:match[3]], "!")
+			// A variable such as ((cert.private_key)) selects a key of the
+			// cert variable.
+			name = strings.Split(name, ".")[0]
+			if _, found, _ := variables.Get(template.VariableDefinition{Name: name}); found {
+				continue
+			}
+
+			if unresolved[name] == nil {
+				unresolved[name] = &UnresolvedVariable{Name: name}
+			}
+			unresolved[name].Positions = append(unresolved[name].Positions, Position{Line: lineIndex + 1, Column: match[0] + 1})
+		}
+	}
+
+	var names []string
+	for name := range unresolved {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var unresolvedVariables []UnresolvedVariable
+	for _, name := range names {
+		unresolvedVariables = append(unresolvedVariables, *unresolved[name])
+	}
+	return unresolvedVariables
+}
+
+// isComment returns whether the text preceding a position on a line starts a
+// YAML comment.
+func isComment(textBefore string) bool {
+	trimmed := strings.TrimLeft(textBefore, " ")
+	return strings.HasPrefix(trimmed, "#") || strings.Contains(textBefore, " #")
+}
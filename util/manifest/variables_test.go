@@ -0,0 +1,92 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/manifest"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Variables", func() {
+	var tmpDir string
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "manifest-variables-test")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("ReadVarsDirectory", func() {
+		var varsDir string
+
+		BeforeEach(func() {
+			varsDir = filepath.Join(tmpDir, "secrets")
+			Expect(os.MkdirAll(filepath.Join(varsDir, "..data"), 0755)).To(Succeed())
+			Expect(os.Mkdir(filepath.Join(varsDir, "nested"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(varsDir, "..data", "db_password"), []byte("s3cret\n"), 0600)).To(Succeed())
+			Expect(os.Symlink(filepath.Join("..data", "db_password"), filepath.Join(varsDir, "db_password"))).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(varsDir, "api_key"), []byte("key: not yaml"), 0600)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(varsDir, ".hidden"), []byte("hidden"), 0600)).To(Succeed())
+		})
+
+		It("returns a variable for every visible file", func() {
+			variables, err := ReadVarsDirectory(varsDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(variables).To(Equal(template.StaticVariables{
+				"api_key":     "key: not yaml",
+				"db_password": "s3cret",
+			}))
+		})
+
+		When("the directory does not exist", func() {
+			It("returns an error", func() {
+				_, err := ReadVarsDirectory(filepath.Join(tmpDir, "missing"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("unresolved variables", func() {
+		var pathToManifest string
+
+		BeforeEach(func() {
+			pathToManifest = filepath.Join(tmpDir, "manifest.yml")
+			Expect(ioutil.WriteFile(pathToManifest, []byte(`---
+applications:
+# - name: ((commented))
+- name: ((name))
+  routes:
+  - route: ((name)).((domain))
+  env:
+    CERT: ((cert.certificate))
+    KEY: ((!cert.private_key)) # ((also_commented))
+    SET: ((set))
+`), 0644)).To(Succeed())
+		})
+
+		It("reports every unresolved variable with all its positions", func() {
+			_, err := ReadAndInterpolateManifest(pathToManifest, nil, []template.VarKV{{Name: "set", Value: "value"}})
+			Expect(err).To(MatchError(UnresolvedVariablesError{
+				ManifestPath: pathToManifest,
+				Variables: []UnresolvedVariable{
+					{Name: "cert", Positions: []Position{{Line: 8, Column: 11}, {Line: 9, Column: 10}}},
+					{Name: "domain", Positions: []Position{{Line: 6, Column: 21}}},
+					{Name: "name", Positions: []Position{{Line: 4, Column: 9}, {Line: 6, Column: 12}}},
+				},
+			}))
+			Expect(err.Error()).To(Equal("Expected to find variables: " +
+				"cert (" + pathToManifest + ":8:11, " + pathToManifest + ":9:10), " +
+				"domain (" + pathToManifest + ":6:21), " +
+				"name (" + pathToManifest + ":4:9, " + pathToManifest + ":6:12)"))
+		})
+	})
+})
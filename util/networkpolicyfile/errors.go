@@ -0,0 +1,23 @@
+package networkpolicyfile
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/util/fileerror"
+)
+
+// Kind names network policy files in the errors returned by Parse.
+var Kind = fileerror.Kind{Name: "network policies file", Entries: "policies", Entry: "Policy"}
+
+// InvalidFieldError is returned when a policy in the file has a protocol
+// other than tcp or udp, or ports that are not a port or range of ports.
+// Index starts at 1.
+type InvalidFieldError struct {
+	Index int
+	Field string
+	Value string
+}
+
+func (e InvalidFieldError) Error() string {
+	return fmt.Sprintf("Policy %d has an invalid '%s' value: %s", e.Index, e.Field, e.Value)
+}
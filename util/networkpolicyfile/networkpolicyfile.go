@@ -0,0 +1,119 @@
+// Package networkpolicyfile reads the declarative network policies file used
+// by the apply-network-policies command.
+package networkpolicyfile
+
+import (
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/fileerror"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DefaultProtocol and DefaultPorts are used for policies that do not provide
+// a protocol and ports, the same as add-network-policy.
+const (
+	DefaultProtocol = "tcp"
+	DefaultPorts    = "8080"
+)
+
+// Policy is a desired network policy from a source app in the targeted space
+// to a destination app.
+type Policy struct {
+	Source      string `yaml:"source"`
+	Destination string `yaml:"destination"`
+
+	// DestinationSpace and DestinationOrg default to the targeted space and
+	// org when they are not provided.
+	DestinationSpace string `yaml:"destination_space,omitempty"`
+	DestinationOrg   string `yaml:"destination_org,omitempty"`
+
+	Protocol string `yaml:"protocol,omitempty"`
+
+	// Ports is a port or a range of ports, such as 8080 or 8080-8090.
+	// StartPort and EndPort are parsed from it.
+	Ports     string `yaml:"ports,omitempty"`
+	StartPort int    `yaml:"-"`
+	EndPort   int    `yaml:"-"`
+}
+
+// ReadPolicies reads the policies listed in the provided file. An empty list
+// of policies is valid, but the file must contain the policies key.
+func ReadPolicies(pathToFile string) ([]Policy, error) {
+	bytes, err := ioutil.ReadFile(pathToFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		Policies *[]Policy `yaml:"policies"`
+	}
+
+	err = yaml.Unmarshal(bytes, &raw)
+	if err != nil {
+		return nil, fileerror.InvalidYAMLError{Kind: Kind, Path: pathToFile, Err: err}
+	}
+
+	if raw.Policies == nil {
+		return nil, fileerror.EmptyFileError{Kind: Kind, Path: pathToFile}
+	}
+
+	policies := *raw.Policies
+	for i, policy := range policies {
+		if policy.Source == "" {
+			return nil, fileerror.MissingFieldError{Kind: Kind, Index: i + 1, Field: "source"}
+		}
+		if policy.Destination == "" {
+			return nil, fileerror.MissingFieldError{Kind: Kind, Index: i + 1, Field: "destination"}
+		}
+		if policy.DestinationOrg != "" && policy.DestinationSpace == "" {
+			return nil, fileerror.MissingFieldError{Kind: Kind, Index: i + 1, Field: "destination_space"}
+		}
+
+		if policy.Protocol == "" {
+			policy.Protocol = DefaultProtocol
+		}
+		policy.Protocol = strings.ToLower(policy.Protocol)
+		if policy.Protocol != "tcp" && policy.Protocol != "udp" {
+			return nil, InvalidFieldError{Index: i + 1, Field: "protocol", Value: policy.Protocol}
+		}
+
+		if policy.Ports == "" {
+			policy.Ports = DefaultPorts
+		}
+		policy.StartPort, policy.EndPort, err = parsePorts(policy.Ports)
+		if err != nil {
+			return nil, InvalidFieldError{Index: i + 1, Field: "ports", Value: policy.Ports}
+		}
+
+		policies[i] = policy
+	}
+
+	return policies, nil
+}
+
+func parsePorts(ports string) (int, int, error) {
+	bounds := strings.Split(ports, "-")
+	if len(bounds) > 2 {
+		return 0, 0, strconv.ErrSyntax
+	}
+
+	start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end := start
+	if len(bounds) == 2 {
+		end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if start < 1 || end < start || end > 65535 {
+		return 0, 0, strconv.ErrRange
+	}
+	return start, end, nil
+}
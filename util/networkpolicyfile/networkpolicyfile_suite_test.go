@@ -0,0 +1,13 @@
+package networkpolicyfile_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestNetworkpolicyfile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Network Policy File Suite")
+}
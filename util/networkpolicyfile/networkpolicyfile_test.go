@@ -0,0 +1,147 @@
+package networkpolicyfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/fileerror"
+	. "code.cloudfoundry.org/cli/util/networkpolicyfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadPolicies", func() {
+	var (
+		tmpDir     string
+		pathToFile string
+		policies   []Policy
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "networkpolicyfile")
+		Expect(err).ToNot(HaveOccurred())
+		pathToFile = filepath.Join(tmpDir, "policies.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		policies, executeErr = ReadPolicies(pathToFile)
+	})
+
+	When("the file is valid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte(`---
+policies:
+- source: frontend
+  destination: backend
+- source: frontend
+  destination: metrics
+  destination_org: ops
+  destination_space: monitoring
+  protocol: UDP
+  ports: 8125-8126
+- source: worker
+  destination: backend
+  ports: 9000
+`), 0600)).To(Succeed())
+		})
+
+		It("returns the policies, defaulting the protocol and ports", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(policies).To(Equal([]Policy{
+				{
+					Source:      "frontend",
+					Destination: "backend",
+					Protocol:    "tcp",
+					Ports:       "8080",
+					StartPort:   8080,
+					EndPort:     8080,
+				},
+				{
+					Source:           "frontend",
+					Destination:      "metrics",
+					DestinationOrg:   "ops",
+					DestinationSpace: "monitoring",
+					Protocol:         "udp",
+					Ports:            "8125-8126",
+					StartPort:        8125,
+					EndPort:          8126,
+				},
+				{
+					Source:      "worker",
+					Destination: "backend",
+					Protocol:    "tcp",
+					Ports:       "9000",
+					StartPort:   9000,
+					EndPort:     9000,
+				},
+			}))
+		})
+	})
+
+	When("the file lists no policies", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte("policies: []\n"), 0600)).To(Succeed())
+		})
+
+		It("returns no policies", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(policies).To(BeEmpty())
+		})
+	})
+
+	When("the file does not exist", func() {
+		It("returns the error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+
+	When("the file is not valid YAML", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte("policies: [\n"), 0600)).To(Succeed())
+		})
+
+		It("returns an InvalidYAMLError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(fileerror.InvalidYAMLError{}))
+		})
+	})
+
+	When("the file does not contain the policies key", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte("polices:\n- source: a\n  destination: b\n"), 0600)).To(Succeed())
+		})
+
+		It("returns a MissingPoliciesError", func() {
+			Expect(executeErr).To(MatchError(fileerror.EmptyFileError{Kind: Kind, Path: pathToFile}))
+		})
+	})
+
+	DescribeTable("invalid policies",
+		func(contents string, expectedErr error) {
+			Expect(ioutil.WriteFile(pathToFile, []byte(contents), 0600)).To(Succeed())
+			_, err := ReadPolicies(pathToFile)
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("missing source", "policies:\n- source: a\n  destination: b\n- destination: b\n",
+			fileerror.MissingFieldError{Kind: Kind, Index: 2, Field: "source"}),
+		Entry("missing destination", "policies:\n- source: a\n",
+			fileerror.MissingFieldError{Kind: Kind, Index: 1, Field: "destination"}),
+		Entry("destination org without destination space", "policies:\n- source: a\n  destination: b\n  destination_org: o\n",
+			fileerror.MissingFieldError{Kind: Kind, Index: 1, Field: "destination_space"}),
+		Entry("unknown protocol", "policies:\n- source: a\n  destination: b\n  protocol: icmp\n",
+			InvalidFieldError{Index: 1, Field: "protocol", Value: "icmp"}),
+		Entry("non-numeric ports", "policies:\n- source: a\n  destination: b\n  ports: http\n",
+			InvalidFieldError{Index: 1, Field: "ports", Value: "http"}),
+		Entry("reversed port range", "policies:\n- source: a\n  destination: b\n  ports: 9000-8000\n",
+			InvalidFieldError{Index: 1, Field: "ports", Value: "9000-8000"}),
+		Entry("too many port bounds", "policies:\n- source: a\n  destination: b\n  ports: 1-2-3\n",
+			InvalidFieldError{Index: 1, Field: "ports", Value: "1-2-3"}),
+	)
+})
@@ -0,0 +1,21 @@
+package orgfile
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/util/fileerror"
+)
+
+// Kind names organization files in the errors returned by Parse.
+var Kind = fileerror.Kind{Name: "organization file", Entries: "organizations", Entry: "Organization"}
+
+// DuplicateNameError is returned when two entries in a list have the same
+// name.
+type DuplicateNameError struct {
+	Field string
+	Name  string
+}
+
+func (e DuplicateNameError) Error() string {
+	return fmt.Sprintf("'%s' has more than one entry named %s", e.Field, e.Name)
+}
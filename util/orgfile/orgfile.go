@@ -0,0 +1,121 @@
+// Package orgfile reads and writes the organization file used by the
+// export-org and apply-org commands.
+package orgfile
+
+import (
+	"io"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/util/fileerror"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Org is the desired state of an organization. Users are identified by their
+// username and every other resource by its name.
+type Org struct {
+	Name string `yaml:"name"`
+
+	// Quota is the organization quota assigned to the organization. It must
+	// already exist.
+	Quota string `yaml:"quota,omitempty"`
+
+	// IsolationSegments are the isolation segments the organization is
+	// entitled to, and DefaultIsolationSegment is the one its apps run in
+	// unless their space has its own. The isolation segments must already
+	// exist.
+	IsolationSegments       []string `yaml:"isolation_segments,omitempty"`
+	DefaultIsolationSegment string   `yaml:"default_isolation_segment,omitempty"`
+
+	PrivateDomains []string `yaml:"private_domains,omitempty"`
+
+	Users           []string `yaml:"users,omitempty"`
+	Managers        []string `yaml:"managers,omitempty"`
+	BillingManagers []string `yaml:"billing_managers,omitempty"`
+	Auditors        []string `yaml:"auditors,omitempty"`
+
+	SpaceQuotas []SpaceQuota `yaml:"space_quotas,omitempty"`
+	Spaces      []Space      `yaml:"spaces,omitempty"`
+}
+
+// SpaceQuota is a space quota defined in the organization. Memory limits are
+// in megabytes and -1 means unlimited.
+type SpaceQuota struct {
+	Name                    string `yaml:"name"`
+	MemoryLimit             int    `yaml:"memory_limit"`
+	InstanceMemoryLimit     int    `yaml:"instance_memory_limit"`
+	TotalRoutes             int    `yaml:"total_routes"`
+	TotalServices           int    `yaml:"total_services"`
+	AppInstanceLimit        int    `yaml:"app_instance_limit"`
+	TotalReservedRoutePorts int    `yaml:"total_reserved_route_ports"`
+	NonBasicServicesAllowed bool   `yaml:"non_basic_services_allowed"`
+}
+
+// Space is the desired state of a space in the organization.
+type Space struct {
+	Name string `yaml:"name"`
+
+	// Quota is the name of a space quota in the organization.
+	Quota string `yaml:"quota,omitempty"`
+
+	IsolationSegment string `yaml:"isolation_segment,omitempty"`
+
+	RunningSecurityGroups []string `yaml:"running_security_groups,omitempty"`
+	StagingSecurityGroups []string `yaml:"staging_security_groups,omitempty"`
+
+	Managers   []string `yaml:"managers,omitempty"`
+	Developers []string `yaml:"developers,omitempty"`
+	Auditors   []string `yaml:"auditors,omitempty"`
+}
+
+// ReadOrg reads the organization in the provided file.
+func ReadOrg(pathToFile string) (Org, error) {
+	bytes, err := ioutil.ReadFile(pathToFile)
+	if err != nil {
+		return Org{}, err
+	}
+
+	var org Org
+	err = yaml.UnmarshalStrict(bytes, &org)
+	if err != nil {
+		return Org{}, fileerror.InvalidYAMLError{Kind: Kind, Path: pathToFile, Err: err}
+	}
+
+	if org.Name == "" {
+		return Org{}, fileerror.MissingFieldError{Kind: Kind, Field: "name"}
+	}
+
+	spaceQuotas := map[string]bool{}
+	for i, spaceQuota := range org.SpaceQuotas {
+		if spaceQuota.Name == "" {
+			return Org{}, fileerror.MissingFieldError{Kind: Kind, List: "space_quotas", Index: i + 1, Field: "name"}
+		}
+		if spaceQuotas[spaceQuota.Name] {
+			return Org{}, DuplicateNameError{Field: "space_quotas", Name: spaceQuota.Name}
+		}
+		spaceQuotas[spaceQuota.Name] = true
+	}
+
+	spaces := map[string]bool{}
+	for i, space := range org.Spaces {
+		if space.Name == "" {
+			return Org{}, fileerror.MissingFieldError{Kind: Kind, List: "spaces", Index: i + 1, Field: "name"}
+		}
+		if spaces[space.Name] {
+			return Org{}, DuplicateNameError{Field: "spaces", Name: space.Name}
+		}
+		spaces[space.Name] = true
+	}
+
+	return org, nil
+}
+
+// WriteOrg writes the organization as YAML.
+func WriteOrg(org Org, writer io.Writer) error {
+	bytes, err := yaml.Marshal(org)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(append([]byte("---\n"), bytes...))
+	return err
+}
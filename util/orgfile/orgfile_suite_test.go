@@ -0,0 +1,13 @@
+package orgfile_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestOrgfile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Org File Suite")
+}
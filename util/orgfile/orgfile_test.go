@@ -0,0 +1,179 @@
+package orgfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/fileerror"
+	. "code.cloudfoundry.org/cli/util/orgfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Org File", func() {
+	var (
+		tmpDir     string
+		pathToFile string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "orgfile")
+		Expect(err).ToNot(HaveOccurred())
+		pathToFile = filepath.Join(tmpDir, "org.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("ReadOrg", func() {
+		var (
+			org        Org
+			executeErr error
+		)
+
+		writeOrg := func(contents string) {
+			Expect(ioutil.WriteFile(pathToFile, []byte(contents), 0600)).To(Succeed())
+		}
+
+		JustBeforeEach(func() {
+			org, executeErr = ReadOrg(pathToFile)
+		})
+
+		When("the file is valid", func() {
+			BeforeEach(func() {
+				writeOrg(`---
+name: some-org
+quota: default
+isolation_segments: [iso-1, iso-2]
+default_isolation_segment: iso-1
+private_domains: [apps.example.com]
+managers: [alice]
+space_quotas:
+- name: small
+  memory_limit: 1024
+  instance_memory_limit: -1
+  total_routes: 10
+  total_services: -1
+  app_instance_limit: 5
+  total_reserved_route_ports: 2
+  non_basic_services_allowed: true
+spaces:
+- name: dev
+  quota: small
+  isolation_segment: iso-2
+  running_security_groups: [public_networks]
+  staging_security_groups: [dns]
+  developers: [bob]
+`)
+			})
+
+			It("returns the organization", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(org).To(Equal(Org{
+					Name:                    "some-org",
+					Quota:                   "default",
+					IsolationSegments:       []string{"iso-1", "iso-2"},
+					DefaultIsolationSegment: "iso-1",
+					PrivateDomains:          []string{"apps.example.com"},
+					Managers:                []string{"alice"},
+					SpaceQuotas: []SpaceQuota{
+						{
+							Name:                    "small",
+							MemoryLimit:             1024,
+							InstanceMemoryLimit:     -1,
+							TotalRoutes:             10,
+							TotalServices:           -1,
+							AppInstanceLimit:        5,
+							TotalReservedRoutePorts: 2,
+							NonBasicServicesAllowed: true,
+						},
+					},
+					Spaces: []Space{
+						{
+							Name:                  "dev",
+							Quota:                 "small",
+							IsolationSegment:      "iso-2",
+							RunningSecurityGroups: []string{"public_networks"},
+							StagingSecurityGroups: []string{"dns"},
+							Developers:            []string{"bob"},
+						},
+					},
+				}))
+			})
+		})
+
+		When("the file does not exist", func() {
+			It("returns the error", func() {
+				_, ok := executeErr.(*os.PathError)
+				Expect(ok).To(BeTrue())
+			})
+		})
+
+		When("the file has an unknown field", func() {
+			BeforeEach(func() {
+				writeOrg("name: some-org\nspace: dev\n")
+			})
+
+			It("returns an InvalidYAMLError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(fileerror.InvalidYAMLError{}))
+			})
+		})
+
+		When("the organization has no name", func() {
+			BeforeEach(func() {
+				writeOrg("quota: default\n")
+			})
+
+			It("returns a MissingFieldError", func() {
+				Expect(executeErr).To(MatchError(fileerror.MissingFieldError{Kind: Kind, Field: "name"}))
+			})
+		})
+
+		When("a space has no name", func() {
+			BeforeEach(func() {
+				writeOrg("name: some-org\nspaces:\n- name: dev\n- quota: small\n")
+			})
+
+			It("returns a MissingFieldError", func() {
+				Expect(executeErr).To(MatchError(fileerror.MissingFieldError{Kind: Kind, List: "spaces", Index: 2, Field: "name"}))
+			})
+		})
+
+		When("two space quotas have the same name", func() {
+			BeforeEach(func() {
+				writeOrg("name: some-org\nspace_quotas:\n- name: small\n- name: small\n")
+			})
+
+			It("returns a DuplicateNameError", func() {
+				Expect(executeErr).To(MatchError(DuplicateNameError{Field: "space_quotas", Name: "small"}))
+			})
+		})
+	})
+
+	Describe("WriteOrg", func() {
+		It("writes an organization that can be read again", func() {
+			org := Org{
+				Name:     "some-org",
+				Auditors: []string{"carol"},
+				SpaceQuotas: []SpaceQuota{
+					{Name: "small", MemoryLimit: 1024, InstanceMemoryLimit: -1},
+				},
+				Spaces: []Space{{Name: "dev", Quota: "small"}},
+			}
+
+			buffer := NewBuffer()
+			Expect(WriteOrg(org, buffer)).To(Succeed())
+			Expect(buffer).To(Say("---\nname: some-org\n"))
+			Expect(buffer).To(Say("auditors:\n- carol\n"))
+
+			Expect(ioutil.WriteFile(pathToFile, buffer.Contents(), 0600)).To(Succeed())
+			readOrg, err := ReadOrg(pathToFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readOrg).To(Equal(org))
+		})
+	})
+})
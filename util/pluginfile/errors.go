@@ -0,0 +1,6 @@
+package pluginfile
+
+import "code.cloudfoundry.org/cli/util/fileerror"
+
+// Kind names plugin files in the errors returned by Parse.
+var Kind = fileerror.Kind{Name: "plugin file", Entries: "plugins", Entry: "Plugin"}
@@ -0,0 +1,68 @@
+// Package pluginfile reads and writes the plugin file used by the
+// install-plugins command to install a fixed set of plugins.
+package pluginfile
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/util/fileerror"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Plugin is the desired state of a single plugin.
+type Plugin struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+
+	// Repository restricts the search for the plugin to this registered plugin
+	// repository. When neither Repository nor URL is provided, all registered
+	// repositories are searched.
+	Repository string `yaml:"repository,omitempty"`
+
+	// URL is the location of the plugin binary. It takes precedence over
+	// Repository and allows installing versions that the repositories no
+	// longer list.
+	URL string `yaml:"url,omitempty"`
+
+	// Checksums are the hex encoded SHA-256 checksums of the plugin binary,
+	// keyed by platform, e.g. linux64, osx or win64.
+	Checksums map[string]string `yaml:"checksums,omitempty"`
+}
+
+type pluginFile struct {
+	Plugins []Plugin `yaml:"plugins"`
+}
+
+// ReadPlugins reads the plugins listed in the provided file.
+func ReadPlugins(pathToFile string) ([]Plugin, error) {
+	bytes, err := ioutil.ReadFile(pathToFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw pluginFile
+	err = yaml.Unmarshal(bytes, &raw)
+	if err != nil {
+		return nil, fileerror.InvalidYAMLError{Kind: Kind, Path: pathToFile, Err: err}
+	}
+
+	if len(raw.Plugins) == 0 {
+		return nil, fileerror.EmptyFileError{Kind: Kind, Path: pathToFile}
+	}
+
+	for i, plugin := range raw.Plugins {
+		if plugin.Name == "" {
+			return nil, fileerror.MissingFieldError{Kind: Kind, Index: i + 1, Field: "name"}
+		}
+		if plugin.Version == "" {
+			return nil, fileerror.MissingFieldError{Kind: Kind, Index: i + 1, Field: "version"}
+		}
+	}
+
+	return raw.Plugins, nil
+}
+
+// MarshalPlugins returns the plugin file listing the provided plugins.
+func MarshalPlugins(plugins []Plugin) ([]byte, error) {
+	return yaml.Marshal(pluginFile{Plugins: plugins})
+}
@@ -0,0 +1,13 @@
+package pluginfile_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPluginfile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plugin File Suite")
+}
@@ -0,0 +1,136 @@
+package pluginfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/fileerror"
+	. "code.cloudfoundry.org/cli/util/pluginfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin File", func() {
+	Describe("ReadPlugins", func() {
+		var (
+			tmpDir     string
+			pathToFile string
+			plugins    []Plugin
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "pluginfile")
+			Expect(err).ToNot(HaveOccurred())
+			pathToFile = filepath.Join(tmpDir, "cf-plugins.yml")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			plugins, executeErr = ReadPlugins(pathToFile)
+		})
+
+		When("the file is valid", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pathToFile, []byte(`---
+plugins:
+- name: some-plugin
+  version: 1.2.3
+  repository: CF-Community
+  checksums:
+    linux64: some-sha256
+- name: other-plugin
+  version: 0.1.0
+  url: https://example.com/other-plugin
+`), 0600)).To(Succeed())
+			})
+
+			It("returns the plugins", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plugins).To(Equal([]Plugin{
+					{
+						Name:       "some-plugin",
+						Version:    "1.2.3",
+						Repository: "CF-Community",
+						Checksums:  map[string]string{"linux64": "some-sha256"},
+					},
+					{
+						Name:    "other-plugin",
+						Version: "0.1.0",
+						URL:     "https://example.com/other-plugin",
+					},
+				}))
+			})
+		})
+
+		When("the file does not exist", func() {
+			It("returns the error", func() {
+				Expect(os.IsNotExist(executeErr)).To(BeTrue())
+			})
+		})
+
+		When("the file is not valid YAML", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pathToFile, []byte("plugins: [\n"), 0600)).To(Succeed())
+			})
+
+			It("returns an InvalidYAMLError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(fileerror.InvalidYAMLError{}))
+			})
+		})
+
+		When("the file lists no plugins", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pathToFile, []byte("plugins: []\n"), 0600)).To(Succeed())
+			})
+
+			It("returns an EmptyFileError", func() {
+				Expect(executeErr).To(MatchError(fileerror.EmptyFileError{Kind: Kind, Path: pathToFile}))
+			})
+		})
+
+		When("a plugin is missing its name", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pathToFile, []byte("plugins:\n- name: a\n  version: 1.0.0\n- version: 1.0.0\n"), 0600)).To(Succeed())
+			})
+
+			It("returns a MissingFieldError", func() {
+				Expect(executeErr).To(MatchError(fileerror.MissingFieldError{Kind: Kind, Index: 2, Field: "name"}))
+			})
+		})
+
+		When("a plugin is missing its version", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(pathToFile, []byte("plugins:\n- name: a\n"), 0600)).To(Succeed())
+			})
+
+			It("returns a MissingFieldError", func() {
+				Expect(executeErr).To(MatchError(fileerror.MissingFieldError{Kind: Kind, Index: 1, Field: "version"}))
+			})
+		})
+	})
+
+	Describe("MarshalPlugins", func() {
+		It("returns the plugin file in YAML, omitting empty fields", func() {
+			bytes, err := MarshalPlugins([]Plugin{
+				{
+					Name:      "some-plugin",
+					Version:   "1.2.3",
+					Checksums: map[string]string{"osx": "some-sha256"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(bytes)).To(Equal(`plugins:
+- name: some-plugin
+  version: 1.2.3
+  checksums:
+    osx: some-sha256
+`))
+		})
+	})
+})
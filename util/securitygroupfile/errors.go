@@ -0,0 +1,13 @@
+package securitygroupfile
+
+import "fmt"
+
+// InvalidJSONError is returned when the file is not a JSON array of rules.
+type InvalidJSONError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidJSONError) Error() string {
+	return fmt.Sprintf("Incorrect json format: file: %s: %s", e.Path, e.Err)
+}
@@ -0,0 +1,346 @@
+package securitygroupfile
+
+import (
+	"bytes"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ProblemKind is the kind of problem found in a rule by Lint.
+type ProblemKind string
+
+const (
+	// Errors, which Cloud Controller would reject or which make a rule
+	// meaningless.
+	InvalidProtocol          ProblemKind = "invalid-protocol"
+	InvalidDestination       ProblemKind = "invalid-destination"
+	InvalidPorts             ProblemKind = "invalid-ports"
+	MissingPorts             ProblemKind = "missing-ports"
+	PortsNotAllowed          ProblemKind = "ports-not-allowed"
+	MissingICMPTypeOrCode    ProblemKind = "missing-icmp-type-or-code"
+	ICMPTypeOrCodeNotAllowed ProblemKind = "icmp-type-or-code-not-allowed"
+
+	// Warnings, for rules that are valid but probably not intended.
+	BroadRule       ProblemKind = "broad-rule"
+	ShadowedRule    ProblemKind = "shadowed-rule"
+	OverlappingRule ProblemKind = "overlapping-rule"
+)
+
+// Problem is a problem found in a rule by Lint.
+type Problem struct {
+	// Index is the position of the rule in the file, starting at 1.
+	Index int
+	Kind  ProblemKind
+
+	// OtherIndex is the position of the earlier rule that shadows or overlaps
+	// the rule.
+	OtherIndex int
+}
+
+// IsError returns true when the rule is invalid, and false when the problem
+// is only a warning.
+func (problem Problem) IsError() bool {
+	switch problem.Kind {
+	case BroadRule, ShadowedRule, OverlappingRule:
+		return false
+	default:
+		return true
+	}
+}
+
+// Lint returns the problems found in the rules, in the order of the rules.
+// Each rule has at most one problem. A rule is shadowed when an earlier rule
+// allows all of its traffic, and overlaps an earlier rule when they allow
+// some of the same traffic.
+func Lint(rules []Rule) []Problem {
+	var (
+		problems []Problem
+		parsed   []parsedRule
+	)
+
+	for i, rule := range rules {
+		current, kind := parseRule(rule)
+		if kind != "" {
+			problems = append(problems, Problem{Index: i + 1, Kind: kind})
+			continue
+		}
+
+		switch {
+		case current.allowsEverything():
+			problems = append(problems, Problem{Index: i + 1, Kind: BroadRule})
+		default:
+			for _, earlier := range parsed {
+				if earlier.covers(current) {
+					problems = append(problems, Problem{Index: i + 1, Kind: ShadowedRule, OtherIndex: earlier.index})
+					break
+				}
+				if earlier.overlaps(current) {
+					problems = append(problems, Problem{Index: i + 1, Kind: OverlappingRule, OtherIndex: earlier.index})
+					break
+				}
+			}
+		}
+
+		current.index = i + 1
+		parsed = append(parsed, current)
+	}
+
+	return problems
+}
+
+type ipRange struct {
+	start net.IP
+	end   net.IP
+}
+
+type portRange struct {
+	start int
+	end   int
+}
+
+type parsedRule struct {
+	index        int
+	protocol     string
+	destinations []ipRange
+	ports        []portRange
+	icmpType     int
+	icmpCode     int
+}
+
+var (
+	allIPv4 = ipRange{start: net.IPv4(0, 0, 0, 0).To16(), end: net.IPv4(255, 255, 255, 255).To16()}
+	allIPv6 = ipRange{start: net.IPv6zero, end: net.IP(bytes.Repeat([]byte{0xff}, net.IPv6len))}
+)
+
+func parseRule(rule Rule) (parsedRule, ProblemKind) {
+	parsed := parsedRule{protocol: strings.ToLower(rule.Protocol)}
+
+	switch parsed.protocol {
+	case "tcp", "udp", "icmp", "all":
+	default:
+		return parsedRule{}, InvalidProtocol
+	}
+
+	destinations, ok := parseDestinations(rule.Destination)
+	if !ok {
+		return parsedRule{}, InvalidDestination
+	}
+	parsed.destinations = destinations
+
+	switch parsed.protocol {
+	case "tcp", "udp":
+		if rule.Ports == "" {
+			return parsedRule{}, MissingPorts
+		}
+		ports, ok := parsePorts(rule.Ports)
+		if !ok {
+			return parsedRule{}, InvalidPorts
+		}
+		parsed.ports = ports
+	default:
+		if rule.Ports != "" {
+			return parsedRule{}, PortsNotAllowed
+		}
+	}
+
+	if parsed.protocol == "icmp" {
+		if !rule.Type.IsSet || !rule.Code.IsSet {
+			return parsedRule{}, MissingICMPTypeOrCode
+		}
+		parsed.icmpType = rule.Type.Value
+		parsed.icmpCode = rule.Code.Value
+	} else if rule.Type.IsSet || rule.Code.IsSet {
+		return parsedRule{}, ICMPTypeOrCodeNotAllowed
+	}
+
+	return parsed, ""
+}
+
+// parseDestinations parses comma separated IP addresses, CIDRs and ranges of
+// IP addresses such as 10.0.0.1-10.0.0.255.
+func parseDestinations(destinations string) ([]ipRange, bool) {
+	if strings.TrimSpace(destinations) == "" {
+		return nil, false
+	}
+
+	var ranges []ipRange
+	for _, destination := range strings.Split(destinations, ",") {
+		destination = strings.TrimSpace(destination)
+
+		switch {
+		case strings.Contains(destination, "/"):
+			ip, network, err := net.ParseCIDR(destination)
+			if err != nil {
+				return nil, false
+			}
+			start := network.IP.To16()
+			end := make(net.IP, net.IPv6len)
+			offset := 0
+			if ip.To4() != nil {
+				offset = net.IPv6len - net.IPv4len
+			}
+			copy(end, start)
+			for i := range network.Mask {
+				end[offset+i] |= ^network.Mask[i]
+			}
+			ranges = append(ranges, ipRange{start: start, end: end})
+		case strings.Contains(destination, "-"):
+			bounds := strings.SplitN(destination, "-", 2)
+			start := net.ParseIP(strings.TrimSpace(bounds[0]))
+			end := net.ParseIP(strings.TrimSpace(bounds[1]))
+			if start == nil || end == nil || (start.To4() == nil) != (end.To4() == nil) || bytes.Compare(start.To16(), end.To16()) > 0 {
+				return nil, false
+			}
+			ranges = append(ranges, ipRange{start: start.To16(), end: end.To16()})
+		default:
+			ip := net.ParseIP(destination)
+			if ip == nil {
+				return nil, false
+			}
+			ranges = append(ranges, ipRange{start: ip.To16(), end: ip.To16()})
+		}
+	}
+	return ranges, true
+}
+
+// parsePorts parses comma separated ports and ranges of ports such as
+// 8080-8090.
+func parsePorts(ports string) ([]portRange, bool) {
+	var ranges []portRange
+	for _, port := range strings.Split(ports, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(port), "-", 2)
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, false
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				return nil, false
+			}
+		}
+		if start < 1 || end > 65535 || start > end {
+			return nil, false
+		}
+		ranges = append(ranges, portRange{start: start, end: end})
+	}
+	return ranges, true
+}
+
+// allowsEverything returns true when the rule allows traffic on every port to
+// every IPv4 or IPv6 address.
+func (rule parsedRule) allowsEverything() bool {
+	allDestinations := ipRangesCover(rule.destinations, []ipRange{allIPv4}) || ipRangesCover(rule.destinations, []ipRange{allIPv6})
+	if !allDestinations {
+		return false
+	}
+
+	switch rule.protocol {
+	case "all":
+		return true
+	case "tcp", "udp":
+		return portRangesCover(rule.ports, []portRange{{start: 1, end: 65535}})
+	default:
+		return false
+	}
+}
+
+// covers returns true when the rule allows all the traffic that the other
+// rule allows.
+func (rule parsedRule) covers(other parsedRule) bool {
+	if !ipRangesCover(rule.destinations, other.destinations) {
+		return false
+	}
+
+	switch {
+	case rule.protocol == "all":
+		return true
+	case rule.protocol != other.protocol:
+		return false
+	case rule.protocol == "icmp":
+		return (rule.icmpType == -1 || rule.icmpType == other.icmpType) &&
+			(rule.icmpCode == -1 || rule.icmpCode == other.icmpCode)
+	default:
+		return portRangesCover(rule.ports, other.ports)
+	}
+}
+
+// overlaps returns true when the rule allows some of the traffic that the
+// other rule allows.
+func (rule parsedRule) overlaps(other parsedRule) bool {
+	if !ipRangesOverlap(rule.destinations, other.destinations) {
+		return false
+	}
+
+	switch {
+	case rule.protocol == "all" || other.protocol == "all":
+		return true
+	case rule.protocol != other.protocol:
+		return false
+	case rule.protocol == "icmp":
+		return (rule.icmpType == -1 || other.icmpType == -1 || rule.icmpType == other.icmpType) &&
+			(rule.icmpCode == -1 || other.icmpCode == -1 || rule.icmpCode == other.icmpCode)
+	default:
+		return portRangesOverlap(rule.ports, other.ports)
+	}
+}
+
+// ipRangesCover returns true when each of the other ranges is inside one of
+// the ranges.
+func ipRangesCover(ranges []ipRange, others []ipRange) bool {
+	for _, other := range others {
+		covered := false
+		for _, r := range ranges {
+			if bytes.Compare(r.start, other.start) <= 0 && bytes.Compare(other.end, r.end) <= 0 {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func ipRangesOverlap(ranges []ipRange, others []ipRange) bool {
+	for _, other := range others {
+		for _, r := range ranges {
+			if bytes.Compare(r.start, other.end) <= 0 && bytes.Compare(other.start, r.end) <= 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// portRangesCover returns true when each of the other ranges is inside one of
+// the ranges.
+func portRangesCover(ranges []portRange, others []portRange) bool {
+	for _, other := range others {
+		covered := false
+		for _, r := range ranges {
+			if r.start <= other.start && other.end <= r.end {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func portRangesOverlap(ranges []portRange, others []portRange) bool {
+	for _, other := range others {
+		for _, r := range ranges {
+			if r.start <= other.end && other.start <= r.end {
+				return true
+			}
+		}
+	}
+	return false
+}
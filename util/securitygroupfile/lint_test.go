@@ -0,0 +1,116 @@
+package securitygroupfile_test
+
+import (
+	"code.cloudfoundry.org/cli/types"
+	. "code.cloudfoundry.org/cli/util/securitygroupfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lint", func() {
+	icmp := func(destination string, icmpType int, code int) Rule {
+		return Rule{
+			Protocol:    "icmp",
+			Destination: destination,
+			Type:        types.NullInt{IsSet: true, Value: icmpType},
+			Code:        types.NullInt{IsSet: true, Value: code},
+		}
+	}
+
+	It("returns no problems for valid, separate rules", func() {
+		Expect(Lint([]Rule{
+			{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80,443"},
+			{Protocol: "TCP", Destination: "10.0.1.1-10.0.1.20", Ports: "8080-8090"},
+			{Protocol: "udp", Destination: "10.0.0.0/24", Ports: "53"},
+			{Protocol: "all", Destination: "192.168.1.1,192.168.1.5"},
+			icmp("10.0.0.0/8", 0, -1),
+			{Protocol: "tcp", Destination: "2001:db8::/32", Ports: "443"},
+		})).To(BeEmpty())
+	})
+
+	DescribeTable("invalid rules",
+		func(rule Rule, kind ProblemKind) {
+			problems := Lint([]Rule{rule})
+			Expect(problems).To(Equal([]Problem{{Index: 1, Kind: kind}}))
+			Expect(problems[0].IsError()).To(BeTrue())
+		},
+		Entry("unknown protocol", Rule{Protocol: "http", Destination: "10.0.0.1", Ports: "80"}, InvalidProtocol),
+		Entry("missing destination", Rule{Protocol: "all"}, InvalidDestination),
+		Entry("malformed CIDR", Rule{Protocol: "all", Destination: "10.0.0.0/33"}, InvalidDestination),
+		Entry("malformed IP address", Rule{Protocol: "all", Destination: "10.0.0.256"}, InvalidDestination),
+		Entry("reversed range", Rule{Protocol: "all", Destination: "10.0.0.9-10.0.0.1"}, InvalidDestination),
+		Entry("mixed range", Rule{Protocol: "all", Destination: "10.0.0.1-2001:db8::1"}, InvalidDestination),
+		Entry("missing ports", Rule{Protocol: "tcp", Destination: "10.0.0.1"}, MissingPorts),
+		Entry("port out of range", Rule{Protocol: "udp", Destination: "10.0.0.1", Ports: "0-80"}, InvalidPorts),
+		Entry("reversed port range", Rule{Protocol: "tcp", Destination: "10.0.0.1", Ports: "90-80"}, InvalidPorts),
+		Entry("non-numeric port", Rule{Protocol: "tcp", Destination: "10.0.0.1", Ports: "http"}, InvalidPorts),
+		Entry("ports with all", Rule{Protocol: "all", Destination: "10.0.0.1", Ports: "80"}, PortsNotAllowed),
+		Entry("icmp without type", Rule{Protocol: "icmp", Destination: "10.0.0.1", Code: types.NullInt{IsSet: true}}, MissingICMPTypeOrCode),
+		Entry("type with tcp", Rule{Protocol: "tcp", Destination: "10.0.0.1", Ports: "80", Type: types.NullInt{IsSet: true}}, ICMPTypeOrCodeNotAllowed),
+	)
+
+	DescribeTable("rules that allow everything",
+		func(rule Rule) {
+			problems := Lint([]Rule{rule})
+			Expect(problems).To(Equal([]Problem{{Index: 1, Kind: BroadRule}}))
+			Expect(problems[0].IsError()).To(BeFalse())
+		},
+		Entry("all protocols to 0.0.0.0/0", Rule{Protocol: "all", Destination: "0.0.0.0/0"}),
+		Entry("all tcp ports to 0.0.0.0/0", Rule{Protocol: "tcp", Destination: "0.0.0.0/0", Ports: "1-65535"}),
+		Entry("all protocols to the whole IPv4 range", Rule{Protocol: "all", Destination: "0.0.0.0-255.255.255.255"}),
+		Entry("all protocols to ::/0", Rule{Protocol: "all", Destination: "::/0"}),
+	)
+
+	It("does not warn about 0.0.0.0/0 rules on a few ports", func() {
+		Expect(Lint([]Rule{{Protocol: "udp", Destination: "0.0.0.0/0", Ports: "53"}})).To(BeEmpty())
+	})
+
+	DescribeTable("rules that are shadowed by an earlier rule",
+		func(earlier Rule, later Rule) {
+			problems := Lint([]Rule{earlier, {Protocol: "udp", Destination: "172.16.0.1", Ports: "53"}, later})
+			Expect(problems).To(Equal([]Problem{{Index: 3, Kind: ShadowedRule, OtherIndex: 1}}))
+			Expect(problems[0].IsError()).To(BeFalse())
+		},
+		Entry("duplicate",
+			Rule{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"},
+			Rule{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "443"}),
+		Entry("smaller CIDR and port range",
+			Rule{Protocol: "tcp", Destination: "10.0.0.0/16", Ports: "80,8000-9000"},
+			Rule{Protocol: "tcp", Destination: "10.0.5.0/24,10.0.6.1", Ports: "8080"}),
+		Entry("covered by all protocols",
+			Rule{Protocol: "all", Destination: "10.0.0.0/8"},
+			Rule{Protocol: "udp", Destination: "10.1.0.1-10.1.0.9", Ports: "53"}),
+		Entry("icmp covered by all types",
+			icmp("10.0.0.0/8", -1, -1),
+			icmp("10.0.0.1", 8, 0)),
+	)
+
+	DescribeTable("rules that overlap an earlier rule",
+		func(earlier Rule, later Rule) {
+			Expect(Lint([]Rule{earlier, later})).To(Equal([]Problem{{Index: 2, Kind: OverlappingRule, OtherIndex: 1}}))
+		},
+		Entry("overlapping destinations and ports",
+			Rule{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80-90"},
+			Rule{Protocol: "tcp", Destination: "10.0.0.128-10.0.1.10", Ports: "85-100"}),
+		Entry("narrower rule followed by a wider one",
+			Rule{Protocol: "udp", Destination: "10.0.0.1", Ports: "53"},
+			Rule{Protocol: "all", Destination: "10.0.0.0/24"}),
+	)
+
+	It("does not compare rules with different protocols or destinations", func() {
+		Expect(Lint([]Rule{
+			{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80"},
+			{Protocol: "udp", Destination: "10.0.0.0/24", Ports: "80"},
+			{Protocol: "tcp", Destination: "10.0.1.0/24", Ports: "80"},
+			{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "81"},
+		})).To(BeEmpty())
+	})
+
+	It("does not compare rules with errors", func() {
+		Expect(Lint([]Rule{
+			{Protocol: "tcp", Destination: "10.0.0.0/33", Ports: "80"},
+			{Protocol: "tcp", Destination: "10.0.0.0/24", Ports: "80"},
+		})).To(Equal([]Problem{{Index: 1, Kind: InvalidDestination}}))
+	})
+})
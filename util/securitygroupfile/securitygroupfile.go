@@ -0,0 +1,44 @@
+// Package securitygroupfile reads and lints the JSON rules file used by the
+// create-security-group and update-security-group commands.
+package securitygroupfile
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Rule is a security group rule in a rules file.
+type Rule struct {
+	Protocol    string `json:"protocol"`
+	Destination string `json:"destination"`
+
+	// Ports is a comma separated list of ports and ranges of ports, such as
+	// 80,443 or 8080-8090. It is only used by the tcp and udp protocols.
+	Ports string `json:"ports,omitempty"`
+
+	// Type and Code are the ICMP type and code. -1 allows all types or codes.
+	Type types.NullInt `json:"type"`
+	Code types.NullInt `json:"code"`
+
+	Log         types.NullBool `json:"log"`
+	Description string         `json:"description,omitempty"`
+}
+
+// ReadRules reads the rules in the provided file, which must contain a single
+// JSON array of rules.
+func ReadRules(pathToFile string) ([]Rule, error) {
+	bytes, err := ioutil.ReadFile(pathToFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []Rule
+	err = json.Unmarshal(bytes, &rules)
+	if err != nil {
+		return nil, InvalidJSONError{Path: pathToFile, Err: err}
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,13 @@
+package securitygroupfile_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecuritygroupfile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Security Group File Suite")
+}
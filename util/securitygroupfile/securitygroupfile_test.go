@@ -0,0 +1,82 @@
+package securitygroupfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/types"
+	. "code.cloudfoundry.org/cli/util/securitygroupfile"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadRules", func() {
+	var (
+		tmpDir     string
+		pathToFile string
+		rules      []Rule
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "securitygroupfile")
+		Expect(err).ToNot(HaveOccurred())
+		pathToFile = filepath.Join(tmpDir, "rules.json")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		rules, executeErr = ReadRules(pathToFile)
+	})
+
+	When("the file is valid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte(`[
+				{"protocol": "tcp", "destination": "10.0.11.0/24", "ports": "80,443", "description": "web", "log": true},
+				{"protocol": "icmp", "destination": "10.0.0.1", "type": 0, "code": -1}
+			]`), 0600)).To(Succeed())
+		})
+
+		It("returns the rules", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(rules).To(Equal([]Rule{
+				{
+					Protocol:    "tcp",
+					Destination: "10.0.11.0/24",
+					Ports:       "80,443",
+					Description: "web",
+					Log:         types.NullBool{IsSet: true, Value: true},
+				},
+				{
+					Protocol:    "icmp",
+					Destination: "10.0.0.1",
+					Type:        types.NullInt{IsSet: true, Value: 0},
+					Code:        types.NullInt{IsSet: true, Value: -1},
+				},
+			}))
+		})
+	})
+
+	When("the file is not a JSON array", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToFile, []byte(`{"protocol": "tcp"}`), 0600)).To(Succeed())
+		})
+
+		It("returns an InvalidJSONError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(InvalidJSONError{}))
+			Expect(executeErr.(InvalidJSONError).Path).To(Equal(pathToFile))
+		})
+	})
+
+	When("the file does not exist", func() {
+		It("returns the error", func() {
+			_, ok := executeErr.(*os.PathError)
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,96 @@
+// Package stoppedapps reads and writes the record of the apps stopped by the
+// stop-all command, which start-all --restore uses to start only the apps
+// that were running before.
+package stoppedapps
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// App is an app that was running when stop-all stopped it.
+type App struct {
+	GUID      string `json:"guid"`
+	Name      string `json:"name"`
+	SpaceGUID string `json:"space_guid"`
+}
+
+// Record is the set of stopped apps, by app GUID.
+type Record map[string]App
+
+// Add adds the apps to the record.
+func (record Record) Add(apps ...App) {
+	for _, app := range apps {
+		record[app.GUID] = app
+	}
+}
+
+// Remove removes the apps with the given GUIDs from the record.
+func (record Record) Remove(appGUIDs ...string) {
+	for _, guid := range appGUIDs {
+		delete(record, guid)
+	}
+}
+
+// Has returns whether the app with the given GUID is in the record.
+func (record Record) Has(appGUID string) bool {
+	_, ok := record[appGUID]
+	return ok
+}
+
+// Read returns the record stored at path. A missing file is an empty record.
+func Read(path string) (Record, error) {
+	record := Record{}
+
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return record, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []App
+	err = json.Unmarshal(bytes, &apps)
+	if err != nil {
+		return nil, err
+	}
+
+	record.Add(apps...)
+	return record, nil
+}
+
+// Write stores the record at path, sorted by app GUID, creating the parent
+// directory if needed. An empty record removes the file.
+func Write(path string, record Record) error {
+	if len(record) == 0 {
+		err := os.Remove(path)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	apps := make([]App, 0, len(record))
+	for _, app := range record {
+		apps = append(apps, app)
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].GUID < apps[j].GUID
+	})
+
+	bytes, err := json.MarshalIndent(apps, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bytes, 0600)
+}
@@ -0,0 +1,13 @@
+package stoppedapps_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestStoppedapps(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Stopped Apps Suite")
+}
@@ -0,0 +1,93 @@
+package stoppedapps_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/stoppedapps"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stopped Apps", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "stopped-apps")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "cf", "stopped-apps.json")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("Read", func() {
+		When("the file does not exist", func() {
+			It("returns an empty record", func() {
+				record, err := Read(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(record).To(BeEmpty())
+			})
+		})
+
+		When("the file is not valid JSON", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(path, []byte("not json"), 0600)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				_, err := Read(path)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Write", func() {
+		It("writes a record that can be read back", func() {
+			record := Record{}
+			record.Add(
+				App{GUID: "app-guid-2", Name: "app-2", SpaceGUID: "space-guid"},
+				App{GUID: "app-guid-1", Name: "app-1", SpaceGUID: "space-guid"},
+			)
+			Expect(Write(path, record)).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			readRecord, err := Read(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(readRecord).To(Equal(record))
+			Expect(readRecord.Has("app-guid-1")).To(BeTrue())
+			Expect(readRecord.Has("app-guid-3")).To(BeFalse())
+		})
+
+		When("the record is empty", func() {
+			BeforeEach(func() {
+				record := Record{}
+				record.Add(App{GUID: "app-guid-1"})
+				Expect(Write(path, record)).To(Succeed())
+			})
+
+			It("removes the file", func() {
+				record, err := Read(path)
+				Expect(err).ToNot(HaveOccurred())
+				record.Remove("app-guid-1")
+
+				Expect(Write(path, record)).To(Succeed())
+				_, err = os.Stat(path)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+
+				Expect(Write(path, record)).To(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package tlsconfig
+
+import "fmt"
+
+// InvalidCACertificateError is returned when the CA certificate file does not
+// contain any PEM encoded certificates.
+type InvalidCACertificateError struct {
+	Path string
+}
+
+func (e InvalidCACertificateError) Error() string {
+	return fmt.Sprintf("No PEM encoded certificates found in %s", e.Path)
+}
+
+// InvalidClientCertificateError is returned when the client certificate or
+// its private key cannot be loaded.
+type InvalidClientCertificateError struct {
+	CertPath string
+	KeyPath  string
+	Err      error
+}
+
+func (e InvalidClientCertificateError) Error() string {
+	return fmt.Sprintf("Unable to load client certificate %s with key %s: %s", e.CertPath, e.KeyPath, e.Err)
+}
@@ -0,0 +1,50 @@
+// Package tlsconfig loads the CA certificate and client certificate set with
+// 'cf api' for use by the Cloud Controller, UAA, plugin repository and NOAA
+// connections.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+)
+
+// LoadCertificates returns the certificate pool used to verify servers and
+// the certificates presented to servers that request a client certificate.
+//
+// The pool contains the system certificates and the certificates in
+// caCertFile. It is nil when caCertFile is empty, so that the system pool is
+// used. No client certificate is returned when clientCertFile is empty.
+func LoadCertificates(caCertFile string, clientCertFile string, clientKeyFile string) (*x509.CertPool, []tls.Certificate, error) {
+	var (
+		rootCAs      *x509.CertPool
+		certificates []tls.Certificate
+	)
+
+	if caCertFile != "" {
+		pemCerts, err := ioutil.ReadFile(caCertFile)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rootCAs, err = x509.SystemCertPool()
+		if err != nil {
+			// The system pool is not available on Windows.
+			rootCAs = x509.NewCertPool()
+		}
+
+		if !rootCAs.AppendCertsFromPEM(pemCerts) {
+			return nil, nil, InvalidCACertificateError{Path: caCertFile}
+		}
+	}
+
+	if clientCertFile != "" || clientKeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+		if err != nil {
+			return nil, nil, InvalidClientCertificateError{CertPath: clientCertFile, KeyPath: clientKeyFile, Err: err}
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	return rootCAs, certificates, nil
+}
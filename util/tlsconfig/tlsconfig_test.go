@@ -0,0 +1,128 @@
+package tlsconfig_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/tlsconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LoadCertificates", func() {
+	var (
+		dir            string
+		caCertFile     string
+		clientCertFile string
+		clientKeyFile  string
+		certPEM        []byte
+		keyPEM         []byte
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "tlsconfig")
+		Expect(err).ToNot(HaveOccurred())
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "some-ca"},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).ToNot(HaveOccurred())
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		Expect(err).ToNot(HaveOccurred())
+
+		certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+		keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+		caCertFile = filepath.Join(dir, "ca.pem")
+		clientCertFile = filepath.Join(dir, "client.pem")
+		clientKeyFile = filepath.Join(dir, "client-key.pem")
+		Expect(ioutil.WriteFile(caCertFile, certPEM, 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(clientCertFile, certPEM, 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(clientKeyFile, keyPEM, 0600)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	When("no files are provided", func() {
+		It("returns no pool and no certificates", func() {
+			rootCAs, certificates, err := LoadCertificates("", "", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCAs).To(BeNil())
+			Expect(certificates).To(BeEmpty())
+		})
+	})
+
+	When("a CA certificate file is provided", func() {
+		It("adds the certificates to the pool", func() {
+			rootCAs, certificates, err := LoadCertificates(caCertFile, "", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCAs).ToNot(BeNil())
+			Expect(rootCAs.Subjects()).To(ContainElement(ContainSubstring("some-ca")))
+			Expect(certificates).To(BeEmpty())
+		})
+
+		When("the file does not contain a certificate", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(caCertFile, []byte("not a certificate"), 0600)).To(Succeed())
+			})
+
+			It("returns an InvalidCACertificateError", func() {
+				_, _, err := LoadCertificates(caCertFile, "", "")
+				Expect(err).To(MatchError(InvalidCACertificateError{Path: caCertFile}))
+			})
+		})
+
+		When("the file does not exist", func() {
+			It("returns the error", func() {
+				_, _, err := LoadCertificates(filepath.Join(dir, "missing.pem"), "", "")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+
+	When("a client certificate and key are provided", func() {
+		It("returns the client certificate", func() {
+			rootCAs, certificates, err := LoadCertificates("", clientCertFile, clientKeyFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rootCAs).To(BeNil())
+			Expect(certificates).To(HaveLen(1))
+		})
+
+		When("the key does not match the certificate", func() {
+			BeforeEach(func() {
+				otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).ToNot(HaveOccurred())
+				keyDER, err := x509.MarshalECPrivateKey(otherKey)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ioutil.WriteFile(clientKeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)).To(Succeed())
+			})
+
+			It("returns an InvalidClientCertificateError", func() {
+				_, _, err := LoadCertificates("", clientCertFile, clientKeyFile)
+				Expect(err).To(BeAssignableToTypeOf(InvalidClientCertificateError{}))
+				Expect(err.(InvalidClientCertificateError).CertPath).To(Equal(clientCertFile))
+			})
+		})
+	})
+})
@@ -89,6 +89,12 @@ func (display *RequestLoggerFileWriter) DisplayRequestHeader(method string, uri
 	return display.DisplayMessage(fmt.Sprintf("%s %s %s", method, uri, httpProtocol))
 }
 
+// DisplayRequestID does nothing, because requests are logged as they are
+// sent and received.
+func (*RequestLoggerFileWriter) DisplayRequestID(id string) error {
+	return nil
+}
+
 func (display *RequestLoggerFileWriter) DisplayResponseHeader(httpProtocol string, status string) error {
 	return display.DisplayMessage(fmt.Sprintf("%s %s", httpProtocol, status))
 }
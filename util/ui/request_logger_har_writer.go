@@ -0,0 +1,562 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/version"
+)
+
+const (
+	// harVersion is the version of the HTTP Archive format that is written.
+	harVersion = "1.2"
+
+	// harTrailer closes the entries and the log of an HTTP Archive. New entries
+	// are written over it, followed by a new trailer, so that the file does not
+	// have to be read and rewritten for every request.
+	harTrailer = "\n]}}\n"
+)
+
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// harEntryOffset is where an entry was written in a file, and what was
+// written there.
+type harEntryOffset struct {
+	start   int64
+	end     int64
+	first   bool
+	written []byte
+}
+
+// harPendingEntry is an entry whose request has been recorded and that is
+// completed once the response is received.
+type harPendingEntry struct {
+	entry       *harEntry
+	offsets     map[string]harEntryOffset
+	requestURI  string
+	host        string
+	requestTime time.Time
+}
+
+// RequestLoggerHARWriter records requests and responses to files as HTTP
+// Archives (HAR). Each request is added to the files as an entry when it is
+// sent, and the entry is completed once its response is received, so requests
+// that never receive a response are still recorded.
+//
+// Responses are paired with their requests by the request ID displayed with
+// both. Without a request ID, a response completes the last request.
+type RequestLoggerHARWriter struct {
+	ui            *UI
+	lock          *sync.Mutex
+	filePaths     []string
+	dumpSanitizer *regexp.Regexp
+
+	pending    map[string]*harPendingEntry
+	current    *harPendingEntry
+	requestID  string
+	inResponse bool
+}
+
+func newRequestLoggerHARWriter(ui *UI, lock *sync.Mutex, filePaths []string) *RequestLoggerHARWriter {
+	return &RequestLoggerHARWriter{
+		ui:            ui,
+		lock:          lock,
+		filePaths:     filePaths,
+		dumpSanitizer: regexp.MustCompile(tokenRegexp),
+		pending:       map[string]*harPendingEntry{},
+	}
+}
+
+func (display *RequestLoggerHARWriter) DisplayBody([]byte) error {
+	display.setBody("", RedactedValue)
+	return nil
+}
+
+func (display *RequestLoggerHARWriter) DisplayDump(dump string) error {
+	display.setBody("", display.dumpSanitizer.ReplaceAllString(dump, RedactedValue))
+	return nil
+}
+
+func (display *RequestLoggerHARWriter) DisplayHeader(name string, value string) error {
+	if display.current == nil {
+		return nil
+	}
+
+	entry := display.current.entry
+	header := harNameValue{Name: name, Value: value}
+	if display.inResponse {
+		entry.Response.Headers = append(entry.Response.Headers, header)
+		if strings.EqualFold(name, "Location") {
+			entry.Response.RedirectURL = value
+		}
+	} else {
+		entry.Request.Headers = append(entry.Request.Headers, header)
+	}
+	return nil
+}
+
+func (display *RequestLoggerHARWriter) DisplayHost(name string) error {
+	if display.current != nil {
+		display.current.host = name
+	}
+	return nil
+}
+
+func (display *RequestLoggerHARWriter) DisplayJSONBody(body []byte) error {
+	if body == nil || len(body) == 0 {
+		return nil
+	}
+
+	sanitized, err := SanitizeJSON(body)
+	if err != nil {
+		display.setBody("application/json", string(body))
+		return nil
+	}
+
+	display.setBody("application/json", string(sanitized))
+	return nil
+}
+
+// DisplayMessage records the message as the request body, since that is
+// where request loggers display the bodies they cannot show as JSON. Messages
+// about a response are added to the entry's comment.
+func (display *RequestLoggerHARWriter) DisplayMessage(msg string) error {
+	if display.current == nil {
+		return nil
+	}
+
+	if display.inResponse {
+		entry := display.current.entry
+		if entry.Comment != "" {
+			entry.Comment += "\n"
+		}
+		entry.Comment += msg
+		return nil
+	}
+
+	display.setBody("", msg)
+	return nil
+}
+
+// DisplayRequestID identifies the request that the following request or
+// response belongs to, so that the response completes the entry of its own
+// request when requests are sent concurrently.
+func (display *RequestLoggerHARWriter) DisplayRequestID(id string) error {
+	display.requestID = id
+	return nil
+}
+
+func (display *RequestLoggerHARWriter) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
+	if display.current == nil {
+		return nil
+	}
+
+	display.current.entry.Request.Method = method
+	display.current.entry.Request.HTTPVersion = httpProtocol
+	display.current.requestURI = uri
+	return nil
+}
+
+func (display *RequestLoggerHARWriter) DisplayResponseHeader(httpProtocol string, status string) error {
+	if display.current == nil {
+		return nil
+	}
+
+	entry := display.current.entry
+	entry.Response.HTTPVersion = httpProtocol
+	code := strings.SplitN(status, " ", 2)
+	entry.Response.Status, _ = strconv.Atoi(code[0])
+	if len(code) == 2 {
+		entry.Response.StatusText = code[1]
+	}
+	return nil
+}
+
+// DisplayType starts a new entry for a request, or the response of the
+// entry of the request. The time between the two is recorded as the time
+// spent waiting for the response.
+func (display *RequestLoggerHARWriter) DisplayType(name string, requestDate time.Time) error {
+	if name == "RESPONSE" {
+		display.inResponse = true
+		if display.requestID != "" {
+			display.current = display.pending[display.requestID]
+		}
+		if display.current != nil {
+			elapsed := float64(requestDate.Sub(display.current.requestTime)) / float64(time.Millisecond)
+			if elapsed < 0 {
+				elapsed = 0
+			}
+			display.current.entry.Time = elapsed
+			display.current.entry.Timings.Wait = elapsed
+		}
+		return nil
+	}
+
+	display.inResponse = false
+	display.current = &harPendingEntry{
+		offsets:     map[string]harEntryOffset{},
+		requestTime: requestDate,
+	}
+	if display.requestID != "" {
+		display.pending[display.requestID] = display.current
+	}
+	display.current.entry = &harEntry{
+		StartedDateTime: requestDate.Format(time.RFC3339Nano),
+		Request: harRequest{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+	}
+	return nil
+}
+
+func (display *RequestLoggerHARWriter) HandleInternalError(err error) {
+	display.ui.DisplayWarning(err.Error())
+}
+
+func (display *RequestLoggerHARWriter) Start() error {
+	display.lock.Lock()
+	return nil
+}
+
+// Stop writes the current entry to every file, replacing the incomplete entry
+// written for the same request. An entry is forgotten once its response is
+// written.
+func (display *RequestLoggerHARWriter) Stop() error {
+	defer display.lock.Unlock()
+
+	current := display.current
+	if display.inResponse && display.requestID != "" {
+		delete(display.pending, display.requestID)
+	}
+	display.requestID = ""
+
+	if current == nil {
+		return nil
+	}
+	current.entry.Request.URL = display.requestURL(current)
+
+	for _, filePath := range display.filePaths {
+		err := display.writeEntry(filePath, current)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeEntry writes the pending entry at the end of the file's entries. When
+// the entry was already written for the request and is still unchanged in the
+// file, it is written over and the entries written after it are moved.
+func (display *RequestLoggerHARWriter) writeEntry(filePath string, pending *harPendingEntry) error {
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModeDir|os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	size := info.Size()
+	offset, ok := pending.offsets[filePath]
+	if !ok || !display.entryUnchanged(file, size, offset) {
+		offset, err = display.entriesEnd(file, size)
+		if err != nil {
+			return err
+		}
+		size = offset.end + int64(len(harTrailer))
+	}
+
+	raw, err := json.Marshal(pending.entry)
+	if err != nil {
+		return err
+	}
+	if offset.first {
+		raw = append([]byte("\n"), raw...)
+	} else {
+		raw = append([]byte(",\n"), raw...)
+	}
+
+	following := make([]byte, size-offset.end)
+	_, err = file.ReadAt(following, offset.end)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	_, err = file.WriteAt(append(raw, following...), offset.start)
+	if err != nil {
+		return err
+	}
+	err = file.Truncate(offset.start + int64(len(raw)) + int64(len(following)))
+	if err != nil {
+		return err
+	}
+
+	moved := offset.start + int64(len(raw)) - offset.end
+	for _, other := range display.pending {
+		otherOffset, ok := other.offsets[filePath]
+		if ok && other != pending && otherOffset.start >= offset.end {
+			otherOffset.start += moved
+			otherOffset.end += moved
+			other.offsets[filePath] = otherOffset
+		}
+	}
+
+	offset.end = offset.start + int64(len(raw))
+	offset.written = raw
+	pending.offsets[filePath] = offset
+	return nil
+}
+
+// entryUnchanged returns true when the file still contains what was last
+// written for an entry at its offset.
+func (*RequestLoggerHARWriter) entryUnchanged(file *os.File, size int64, offset harEntryOffset) bool {
+	if offset.end > size || offset.end-offset.start != int64(len(offset.written)) {
+		return false
+	}
+
+	current := make([]byte, len(offset.written))
+	_, err := file.ReadAt(current, offset.start)
+	return err == nil && string(current) == string(offset.written)
+}
+
+// entriesEnd returns where the next entry is written in the file. A new file
+// is started with no entries, and an HTTP Archive that was not written by the
+// CLI is rewritten so that it ends with the trailer.
+func (*RequestLoggerHARWriter) entriesEnd(file *os.File, size int64) (harEntryOffset, error) {
+	har := harFile{
+		Log: harLog{
+			Version: harVersion,
+			Creator: harCreator{Name: "cf", Version: version.VersionString()},
+			Entries: []harEntry{},
+		},
+	}
+
+	tail := make([]byte, len(harTrailer)+1)
+	if size >= int64(len(tail)) {
+		_, err := file.ReadAt(tail, size-int64(len(tail)))
+		if err != nil {
+			return harEntryOffset{}, err
+		}
+		if string(tail[1:]) == harTrailer {
+			start := size - int64(len(harTrailer))
+			return harEntryOffset{start: start, end: start, first: tail[0] == '['}, nil
+		}
+	}
+
+	if size > 0 {
+		raw, err := ioutil.ReadAll(io.NewSectionReader(file, 0, size))
+		if err != nil {
+			return harEntryOffset{}, err
+		}
+		err = json.Unmarshal(raw, &har)
+		if err != nil {
+			return harEntryOffset{}, err
+		}
+	}
+
+	creator, err := json.Marshal(har.Log.Creator)
+	if err != nil {
+		return harEntryOffset{}, err
+	}
+	raw := []byte(fmt.Sprintf(`{"log":{"version":%q,"creator":%s,"entries":[`, har.Log.Version, creator))
+	for i, entry := range har.Log.Entries {
+		rawEntry, err := json.Marshal(entry)
+		if err != nil {
+			return harEntryOffset{}, err
+		}
+		if i > 0 {
+			raw = append(raw, ',')
+		}
+		raw = append(raw, '\n')
+		raw = append(raw, rawEntry...)
+	}
+
+	start := int64(len(raw))
+	raw = append(raw, harTrailer...)
+	_, err = file.WriteAt(raw, 0)
+	if err != nil {
+		return harEntryOffset{}, err
+	}
+	err = file.Truncate(int64(len(raw)))
+	if err != nil {
+		return harEntryOffset{}, err
+	}
+	return harEntryOffset{start: start, end: start, first: len(har.Log.Entries) == 0}, nil
+}
+
+// requestURL returns the full URL of the pending entry's request. Request
+// loggers only display the request URI and host, and the CLI only talks to
+// its servers over HTTPS unless they are proxied.
+func (*RequestLoggerHARWriter) requestURL(pending *harPendingEntry) string {
+	requestURL := url.URL{Scheme: "https", Host: pending.host}
+	parsed, err := url.ParseRequestURI(pending.requestURI)
+	if err != nil {
+		return requestURL.String() + pending.requestURI
+	}
+
+	requestURL.Path = parsed.Path
+	requestURL.RawPath = parsed.RawPath
+	requestURL.RawQuery = parsed.RawQuery
+
+	query := parsed.Query()
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pending.entry.Request.QueryString = []harNameValue{}
+	for _, name := range names {
+		if keysToSanitize.MatchString(name) {
+			for i := range query[name] {
+				query[name][i] = RedactedValue
+			}
+			requestURL.RawQuery = query.Encode()
+		}
+		for _, value := range query[name] {
+			pending.entry.Request.QueryString = append(pending.entry.Request.QueryString, harNameValue{Name: name, Value: value})
+		}
+	}
+
+	return requestURL.String()
+}
+
+func (display *RequestLoggerHARWriter) setBody(mimeType string, text string) {
+	if display.current == nil {
+		return
+	}
+
+	entry := display.current.entry
+	if display.inResponse {
+		if mimeType == "" {
+			mimeType = display.headerValue(entry.Response.Headers, "Content-Type")
+		}
+		entry.Response.Content = harContent{
+			Size:     len(text),
+			MimeType: mimeType,
+			Text:     text,
+		}
+		return
+	}
+
+	if mimeType == "" {
+		mimeType = display.headerValue(entry.Request.Headers, "Content-Type")
+	}
+	entry.Request.PostData = &harPostData{
+		MimeType: mimeType,
+		Text:     text,
+	}
+}
+
+func (*RequestLoggerHARWriter) headerValue(headers []harNameValue, name string) string {
+	for _, header := range headers {
+		if strings.EqualFold(header.Name, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
+
+// RequestLoggerHARWriter returns a RequestLoggerHARWriter that cannot
+// overwrite another RequestLoggerHARWriter or RequestLoggerFileWriter.
+func (ui *UI) RequestLoggerHARWriter(filePaths []string) *RequestLoggerHARWriter {
+	return newRequestLoggerHARWriter(ui, ui.fileLock, filePaths)
+}
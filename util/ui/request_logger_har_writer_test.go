@@ -0,0 +1,250 @@
+package ui_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Request Logger HAR Writer", func() {
+	var (
+		ui       *UI
+		display  *RequestLoggerHARWriter
+		tmpdir   string
+		harFile1 string
+		harFile2 string
+		sentAt   time.Time
+	)
+
+	readHAR := func(path string) map[string]interface{} {
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+
+		var har map[string]interface{}
+		Expect(json.Unmarshal(contents, &har)).To(Succeed())
+		return har
+	}
+
+	entries := func(path string) []interface{} {
+		return readHAR(path)["log"].(map[string]interface{})["entries"].([]interface{})
+	}
+
+	logRequest := func(id string, method string, uri string) {
+		Expect(display.Start()).To(Succeed())
+		Expect(display.DisplayRequestID(id)).To(Succeed())
+		Expect(display.DisplayType("REQUEST", sentAt)).To(Succeed())
+		Expect(display.DisplayRequestHeader(method, uri, "HTTP/1.1")).To(Succeed())
+		Expect(display.DisplayHost("api.example.com")).To(Succeed())
+		Expect(display.DisplayHeader("Authorization", RedactedValue)).To(Succeed())
+		Expect(display.DisplayHeader("Content-Type", "application/json")).To(Succeed())
+		Expect(display.DisplayJSONBody([]byte(`{"name":"some-app","password":"some-password"}`))).To(Succeed())
+		Expect(display.Stop()).To(Succeed())
+	}
+
+	logResponse := func(id string, status string) {
+		Expect(display.Start()).To(Succeed())
+		Expect(display.DisplayRequestID(id)).To(Succeed())
+		Expect(display.DisplayType("RESPONSE", sentAt.Add(1500*time.Millisecond))).To(Succeed())
+		Expect(display.DisplayResponseHeader("HTTP/1.1", status)).To(Succeed())
+		Expect(display.DisplayHeader("Content-Type", "application/json")).To(Succeed())
+		Expect(display.DisplayHeader("Location", "https://api.example.com/v3/apps/some-guid")).To(Succeed())
+		Expect(display.DisplayMessage("[Rate limit: 95 requests remaining]")).To(Succeed())
+		Expect(display.DisplayJSONBody([]byte(`{"guid":"some-guid","token":"some-token"}`))).To(Succeed())
+		Expect(display.Stop()).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ui = NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		sentAt = time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		var err error
+		tmpdir, err = ioutil.TempDir("", "request_logger_har")
+		Expect(err).ToNot(HaveOccurred())
+
+		harFile1 = filepath.Join(tmpdir, "sub_dir", "trace1.har")
+		harFile2 = filepath.Join(tmpdir, "trace2.har")
+		display = ui.RequestLoggerHARWriter([]string{harFile1, harFile2})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpdir)).To(Succeed())
+	})
+
+	When("a request is sent", func() {
+		BeforeEach(func() {
+			logRequest("1", "POST", "/v3/apps?names=some-app&password=some-password")
+		})
+
+		It("writes an HTTP Archive to every file", func() {
+			for _, path := range []string{harFile1, harFile2} {
+				log := readHAR(path)["log"].(map[string]interface{})
+				Expect(log["version"]).To(Equal("1.2"))
+				Expect(log["creator"]).To(HaveKeyWithValue("name", "cf"))
+				Expect(log["entries"]).To(HaveLen(1))
+			}
+		})
+
+		It("records the request with redacted secrets", func() {
+			entry := entries(harFile1)[0].(map[string]interface{})
+			Expect(entry["startedDateTime"]).To(Equal("2018-01-02T03:04:05Z"))
+
+			request := entry["request"].(map[string]interface{})
+			Expect(request["method"]).To(Equal("POST"))
+			Expect(request["httpVersion"]).To(Equal("HTTP/1.1"))
+			Expect(request["url"]).To(Equal("https://api.example.com/v3/apps?names=some-app&password=%5BPRIVATE+DATA+HIDDEN%5D"))
+			Expect(request["queryString"]).To(ConsistOf(
+				map[string]interface{}{"name": "names", "value": "some-app"},
+				map[string]interface{}{"name": "password", "value": RedactedValue},
+			))
+			Expect(request["headers"]).To(ContainElement(map[string]interface{}{"name": "Authorization", "value": RedactedValue}))
+
+			postData := request["postData"].(map[string]interface{})
+			Expect(postData["mimeType"]).To(Equal("application/json"))
+			Expect(postData["text"]).To(ContainSubstring(`"name": "some-app"`))
+			Expect(postData["text"]).To(ContainSubstring(RedactedValue))
+			Expect(postData["text"]).ToNot(ContainSubstring("some-password"))
+		})
+
+		It("records an empty response until the response is received", func() {
+			entry := entries(harFile1)[0].(map[string]interface{})
+			Expect(entry["response"]).To(HaveKeyWithValue("status", BeNumerically("==", 0)))
+		})
+
+		When("the response is received", func() {
+			BeforeEach(func() {
+				logResponse("1", "201 Created")
+			})
+
+			It("completes the entry with the response and timings", func() {
+				Expect(entries(harFile1)).To(HaveLen(1))
+				Expect(entries(harFile2)).To(HaveLen(1))
+
+				entry := entries(harFile1)[0].(map[string]interface{})
+				Expect(entry["time"]).To(BeNumerically("==", 1500))
+				Expect(entry["timings"]).To(HaveKeyWithValue("wait", BeNumerically("==", 1500)))
+				Expect(entry["comment"]).To(Equal("[Rate limit: 95 requests remaining]"))
+
+				response := entry["response"].(map[string]interface{})
+				Expect(response["status"]).To(BeNumerically("==", 201))
+				Expect(response["statusText"]).To(Equal("Created"))
+				Expect(response["redirectURL"]).To(Equal("https://api.example.com/v3/apps/some-guid"))
+
+				content := response["content"].(map[string]interface{})
+				Expect(content["mimeType"]).To(Equal("application/json"))
+				Expect(content["text"]).To(ContainSubstring(`"guid": "some-guid"`))
+				Expect(content["text"]).ToNot(ContainSubstring("some-token"))
+			})
+
+			When("another request is sent", func() {
+				BeforeEach(func() {
+					logRequest("2", "GET", "/v3/apps")
+					logResponse("2", "200 OK")
+				})
+
+				It("appends a new entry", func() {
+					Expect(entries(harFile1)).To(HaveLen(2))
+					entry := entries(harFile1)[1].(map[string]interface{})
+					Expect(entry["request"]).To(HaveKeyWithValue("url", "https://api.example.com/v3/apps"))
+				})
+			})
+		})
+	})
+
+	When("requests are sent concurrently", func() {
+		BeforeEach(func() {
+			logRequest("1", "POST", "/v3/apps")
+			logRequest("2", "GET", "/v3/spaces")
+			logResponse("2", "200 OK")
+			logResponse("1", "201 Created")
+		})
+
+		It("completes the entry of each request with its own response", func() {
+			for _, path := range []string{harFile1, harFile2} {
+				Expect(entries(path)).To(HaveLen(2))
+
+				first := entries(path)[0].(map[string]interface{})
+				Expect(first["request"]).To(HaveKeyWithValue("url", "https://api.example.com/v3/apps"))
+				Expect(first["response"]).To(HaveKeyWithValue("status", BeNumerically("==", 201)))
+
+				second := entries(path)[1].(map[string]interface{})
+				Expect(second["request"]).To(HaveKeyWithValue("url", "https://api.example.com/v3/spaces"))
+				Expect(second["response"]).To(HaveKeyWithValue("status", BeNumerically("==", 200)))
+			}
+		})
+	})
+
+	When("requests are not identified", func() {
+		BeforeEach(func() {
+			logRequest("", "GET", "/v3/apps")
+			logResponse("", "200 OK")
+		})
+
+		It("completes the entry of the last request", func() {
+			Expect(entries(harFile1)).To(HaveLen(1))
+			Expect(entries(harFile1)[0].(map[string]interface{})["response"]).To(HaveKeyWithValue("status", BeNumerically("==", 200)))
+		})
+	})
+
+	When("the request body is not JSON", func() {
+		BeforeEach(func() {
+			Expect(display.Start()).To(Succeed())
+			Expect(display.DisplayType("REQUEST", sentAt)).To(Succeed())
+			Expect(display.DisplayRequestHeader("POST", "/oauth/token", "HTTP/1.1")).To(Succeed())
+			Expect(display.DisplayHost("uaa.example.com")).To(Succeed())
+			Expect(display.DisplayHeader("Content-Type", "application/x-www-form-urlencoded")).To(Succeed())
+			Expect(display.DisplayBody([]byte("password=some-password"))).To(Succeed())
+			Expect(display.Stop()).To(Succeed())
+		})
+
+		It("records the redacted value", func() {
+			request := entries(harFile1)[0].(map[string]interface{})["request"].(map[string]interface{})
+			Expect(request["postData"]).To(Equal(map[string]interface{}{
+				"mimeType": "application/x-www-form-urlencoded",
+				"text":     RedactedValue,
+			}))
+		})
+	})
+
+	When("the file is an HTTP Archive written by another program", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(harFile1), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(harFile1, []byte(`{
+  "log": {
+    "version": "1.2",
+    "creator": {"name": "some-browser", "version": "1.0"},
+    "entries": [{"startedDateTime": "2018-01-01T00:00:00Z", "comment": "some-entry"}]
+  }
+}`), 0600)).To(Succeed())
+
+			logRequest("1", "GET", "/v3/apps")
+			logResponse("1", "200 OK")
+		})
+
+		It("keeps its entries and appends the new entry", func() {
+			Expect(readHAR(harFile1)["log"]).To(HaveKeyWithValue("creator", map[string]interface{}{"name": "some-browser", "version": "1.0"}))
+			Expect(entries(harFile1)).To(HaveLen(2))
+			Expect(entries(harFile1)[0]).To(HaveKeyWithValue("comment", "some-entry"))
+			Expect(entries(harFile1)[1].(map[string]interface{})["request"]).To(HaveKeyWithValue("url", "https://api.example.com/v3/apps"))
+		})
+	})
+
+	When("the file is not an HTTP Archive", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(harFile2, []byte("REQUEST: some text trace"), 0600)).To(Succeed())
+		})
+
+		It("returns an error", func() {
+			Expect(display.Start()).To(Succeed())
+			Expect(display.DisplayType("REQUEST", sentAt)).To(Succeed())
+			Expect(display.Stop()).To(HaveOccurred())
+		})
+	})
+})
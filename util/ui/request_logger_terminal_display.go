@@ -70,6 +70,12 @@ func (display *RequestLoggerTerminalDisplay) DisplayRequestHeader(method string,
 	return nil
 }
 
+// DisplayRequestID does nothing, because requests are logged as they are
+// sent and received.
+func (*RequestLoggerTerminalDisplay) DisplayRequestID(id string) error {
+	return nil
+}
+
 func (display *RequestLoggerTerminalDisplay) DisplayResponseHeader(httpProtocol string, status string) error {
 	fmt.Fprintf(display.ui.Out, "%s %s\n", httpProtocol, status)
 	return nil
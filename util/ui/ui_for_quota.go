@@ -0,0 +1,19 @@
+package ui
+
+import "github.com/fatih/color"
+
+// DisplayQuotaUsageTable outputs a table with a header row, coloring the cells
+// marked in highlighted red. highlighted has the same shape as table, without
+// the header row.
+func (ui *UI) DisplayQuotaUsageTable(table [][]string, highlighted [][]bool) {
+	redColor := color.New(color.FgRed, color.Bold)
+
+	for i, row := range highlighted {
+		for j, highlight := range row {
+			if highlight {
+				table[i+1][j] = ui.modifyColor(table[i+1][j], redColor)
+			}
+		}
+	}
+	ui.DisplayTableWithHeader("", table, DefaultTableSpacePadding)
+}
@@ -0,0 +1,49 @@
+package ui_test
+
+import (
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("UI", func() {
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorEnabled)
+
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		ui.Out = NewBuffer()
+		ui.Err = NewBuffer()
+	})
+
+	Describe("DisplayQuotaUsageTable", func() {
+		It("displays a table with red coloring for the highlighted cells", func() {
+			ui.DisplayQuotaUsageTable(
+				[][]string{
+					{"name", "memory", "routes"},
+					{"org-1", "10M / 20M", "9 / 10"},
+					{"org-2", "1M / 20M", "1 / 10"},
+				},
+				[][]bool{
+					{false, false, true},
+					{false, false, false},
+				},
+			)
+
+			Expect(ui.Out).To(Say("\x1b\\[1mname\x1b\\[0m\\s+\x1b\\[1mmemory\x1b\\[0m\\s+\x1b\\[1mroutes\x1b\\[0m"))
+			Expect(ui.Out).To(Say("org-1\\s+10M / 20M\\s+\x1b\\[31;1m9 / 10\x1b\\[0m"))
+			Expect(ui.Out).To(Say("org-2\\s+1M / 20M\\s+1 / 10"))
+		})
+	})
+})